@@ -121,3 +121,7 @@ func (p *badProvider) GetMapping(key, provider string) ([]byte, string, error) {
 func (p *badProvider) GetMappings(key string) ([]string, error) {
 	return nil, nil
 }
+
+func (p *badProvider) Discover(req plugin.DiscoverRequest) (plugin.DiscoverResult, error) {
+	return plugin.DiscoverResult{}, nil
+}
@@ -166,3 +166,7 @@ func (p *simpleProvider) GetMapping(key, provider string) ([]byte, string, error
 func (p *simpleProvider) GetMappings(key string) ([]string, error) {
 	return nil, nil
 }
+
+func (p *simpleProvider) Discover(req plugin.DiscoverRequest) (plugin.DiscoverResult, error) {
+	return plugin.DiscoverResult{}, nil
+}
@@ -9,6 +9,8 @@ import (
 	"cloud.google.com/go/storage"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
 
 	"gocloud.dev/blob/gcsblob"
 
@@ -64,6 +66,54 @@ func ResolveGoogleCredentials(ctx context.Context, scope string) (*google.Creden
 	return credentials, nil
 }
 
+// GoogleTokenSourceOptions overrides how ResolveGoogleTokenSource resolves credentials, so that they can be configured
+// explicitly rather than through ambient gcloud configuration.
+type GoogleTokenSourceOptions struct {
+	// CredentialsFile is the path of a credentials file to use instead of resolving credentials from the environment.
+	// Besides service account keys, this can be a workload identity federation credential configuration file.
+	CredentialsFile string
+	// ImpersonateServiceAccount is the email address of a service account to impersonate with the credentials.
+	ImpersonateServiceAccount string
+	// Delegates are the service accounts in the delegation chain used to impersonate ImpersonateServiceAccount.
+	Delegates []string
+}
+
+// ResolveGoogleTokenSource returns a token source for the given scope, resolving credentials as configured by opts.
+func ResolveGoogleTokenSource(
+	ctx context.Context, scope string, opts GoogleTokenSourceOptions,
+) (oauth2.TokenSource, error) {
+	var credentials *google.Credentials
+	if opts.CredentialsFile != "" {
+		contents, err := os.ReadFile(opts.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read credentials file: %w", err)
+		}
+		credentials, err = google.CredentialsFromJSON(ctx, contents, scope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse credentials from %s: %w", opts.CredentialsFile, err)
+		}
+	} else {
+		var err error
+		credentials, err = ResolveGoogleCredentials(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.ImpersonateServiceAccount == "" {
+		return credentials.TokenSource, nil
+	}
+	ts, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: opts.ImpersonateServiceAccount,
+		Scopes:          []string{scope},
+		Delegates:       opts.Delegates,
+	}, option.WithTokenSource(credentials.TokenSource))
+	if err != nil {
+		return nil, fmt.Errorf("unable to impersonate %s: %w", opts.ImpersonateServiceAccount, err)
+	}
+	return ts, nil
+}
+
 func GoogleCredentialsMux(ctx context.Context) (*blob.URLMux, error) {
 	credentials, err := ResolveGoogleCredentials(ctx, storage.ScopeReadWrite)
 	if err != nil {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 //nolint:paralleltest
@@ -71,3 +73,35 @@ func TestResolveGoogleCredentials_OAuthAccessToken(t *testing.T) {
 	actualAccessToken := token.AccessToken
 	assert.Equal(t, expectedAccessToken, actualAccessToken)
 }
+
+//nolint:paralleltest
+func TestResolveGoogleTokenSource_CredentialsFile(t *testing.T) {
+	t.Setenv("GOOGLE_CREDENTIALS", "")
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "")
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	err := os.WriteFile(path, []byte(`{"type": "authorized_user", "client_id": "id", "client_secret": "secret",
+		"refresh_token": "token"}`), 0o600)
+	require.NoError(t, err)
+
+	ts, err := ResolveGoogleTokenSource(ctx, "some-scope", GoogleTokenSourceOptions{CredentialsFile: path})
+	assert.NoError(t, err)
+	assert.NotNil(t, ts)
+
+	_, err = ResolveGoogleTokenSource(ctx, "some-scope", GoogleTokenSourceOptions{
+		CredentialsFile: filepath.Join(t.TempDir(), "missing.json"),
+	})
+	assert.ErrorContains(t, err, "unable to read credentials file")
+}
+
+//nolint:paralleltest
+func TestResolveGoogleTokenSource_Impersonation(t *testing.T) {
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "your-access-token")
+
+	ts, err := ResolveGoogleTokenSource(context.Background(), "some-scope", GoogleTokenSourceOptions{
+		ImpersonateServiceAccount: "deployer@project.iam.gserviceaccount.com",
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, ts)
+}
@@ -15,13 +15,16 @@
 package backend
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pulumi/pulumi/pkg/v3/display"
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -61,3 +64,21 @@ func makeResourcePreEvent(urn, resType string, op display.StepOp, retainOnDelete
 
 	return event
 }
+
+// TestPreviewOnly tests that only the preview is applied when PreviewOnly is set.
+func TestPreviewOnly(t *testing.T) {
+	t.Parallel()
+
+	var dryRuns []bool
+	apply := func(ctx context.Context, kind apitype.UpdateKind, stack Stack, op UpdateOperation,
+		opts ApplierOptions, events chan<- engine.Event,
+	) (*deploy.Plan, display.ResourceChanges, result.Result) {
+		dryRuns = append(dryRuns, opts.DryRun)
+		return nil, display.ResourceChanges{}, nil
+	}
+
+	op := UpdateOperation{Opts: UpdateOptions{PreviewOnly: true}}
+	_, res := PreviewThenPromptThenExecute(context.Background(), apitype.RefreshUpdate, nil, op, apply)
+	assert.Nil(t, res)
+	assert.Equal(t, []bool{true}, dryRuns)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -255,6 +256,77 @@ type SpecificDeploymentExporter interface {
 	ExportDeploymentForVersion(ctx context.Context, stack Stack, version string) (*apitype.UntypedDeployment, error)
 }
 
+// DeploymentStreamer is an interface defining an additional capability of a Backend, specifically the ability to
+// export and import a stack's deployment as a stream, one resource at a time, rather than holding all of it in memory.
+// This isn't a requirement for all backends and should be checked for dynamically.
+type DeploymentStreamer interface {
+	// ExportDeploymentStream writes the deployment for the given stack to w as a deployment stream.
+	ExportDeploymentStream(ctx context.Context, stack Stack, w io.Writer) error
+	// ImportDeploymentStream imports the deployment read from the given deployment stream into the indicated stack.
+	ImportDeploymentStream(ctx context.Context, stack Stack, r *stack.DeploymentStreamReader) error
+}
+
+// HistoryImporter is an interface defining an additional capability of a Backend, specifically the ability to import
+// a deployment along with the update that produced it, so that the update shows up in the stack's history, as when
+// copying a stack from another backend. This isn't a requirement for all backends and should be checked for
+// dynamically.
+type HistoryImporter interface {
+	// ImportUpdate imports the given deployment into the indicated stack and records it in the stack's history as the
+	// given update.
+	ImportUpdate(ctx context.Context, stack Stack, update UpdateInfo, deployment *apitype.UntypedDeployment) error
+}
+
+// StackTemplater is an interface defining an additional capability of a Backend, specifically the ability to hold
+// defaults shared by the stacks created in it, such as their secrets provider, tags and configuration. This isn't a
+// requirement for all backends and should be checked for dynamically.
+type StackTemplater interface {
+	// GetStackTemplate returns the defaults for new stacks, merged with those of the named stack template if name
+	// isn't empty. It returns nil if the backend holds no defaults.
+	GetStackTemplate(ctx context.Context, name string) (*StackTemplate, error)
+}
+
+// StackTemplate holds the defaults that new stacks are created with.
+type StackTemplate struct {
+	// SecretsProvider is the secrets provider new stacks use, unless another is chosen.
+	SecretsProvider string `json:"secretsProvider,omitempty"`
+	// Tags are the tags new stacks are given.
+	Tags map[apitype.StackTagName]string `json:"tags,omitempty"`
+	// RequiredTags are the names of the tags every new stack has to be given.
+	RequiredTags []apitype.StackTagName `json:"requiredTags,omitempty"`
+	// Config is the configuration new stacks start out with, keyed by configuration key.
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// Merge returns the template with the settings of other applied over its own.
+func (t StackTemplate) Merge(other StackTemplate) StackTemplate {
+	result := StackTemplate{
+		SecretsProvider: t.SecretsProvider,
+		Tags:            make(map[apitype.StackTagName]string, len(t.Tags)+len(other.Tags)),
+		Config:          make(map[string]string, len(t.Config)+len(other.Config)),
+	}
+	if other.SecretsProvider != "" {
+		result.SecretsProvider = other.SecretsProvider
+	}
+	for _, m := range []map[apitype.StackTagName]string{t.Tags, other.Tags} {
+		for k, v := range m {
+			result.Tags[k] = v
+		}
+	}
+	for _, m := range []map[string]string{t.Config, other.Config} {
+		for k, v := range m {
+			result.Config[k] = v
+		}
+	}
+	required := make(map[apitype.StackTagName]bool)
+	for _, tag := range append(append([]apitype.StackTagName{}, t.RequiredTags...), other.RequiredTags...) {
+		if !required[tag] {
+			required[tag] = true
+			result.RequiredTags = append(result.RequiredTags, tag)
+		}
+	}
+	return result
+}
+
 // UpdateOperation is a complete stack update operation (preview, update, import, refresh, or destroy).
 type UpdateOperation struct {
 	Proj               *workspace.Project
@@ -297,6 +369,8 @@ type UpdateOptions struct {
 	AutoApprove bool
 	// SkipPreview, when true, causes the preview step to be skipped.
 	SkipPreview bool
+	// PreviewOnly, when true, causes only the preview step to be run.
+	PreviewOnly bool
 }
 
 // QueryOptions configures a query to operate against a backend and the engine.
@@ -321,7 +395,8 @@ type CancellationScopeSource interface {
 	NewScope(events chan<- engine.Event, isPreview bool) CancellationScope
 }
 
-// NewBackendClient returns a deploy.BackendClient that wraps the given Backend.
+// NewBackendClient returns a deploy.BackendClient that wraps the given Backend. The client also implements
+// deploy.OutputMetadataBackendClient.
 func NewBackendClient(backend Backend, secretsProvider secrets.Provider) deploy.BackendClient {
 	return &backendClient{backend: backend, secretsProvider: secretsProvider}
 }
@@ -333,6 +408,26 @@ type backendClient struct {
 
 // GetStackOutputs returns the outputs of the stack with the given name.
 func (c *backendClient) GetStackOutputs(ctx context.Context, name string) (resource.PropertyMap, error) {
+	outputs, _, err := c.GetStackOutputsWithMetadata(ctx, name)
+	return outputs, err
+}
+
+// GetStackOutputsWithMetadata returns the outputs of the stack with the given name along with their metadata.
+func (c *backendClient) GetStackOutputsWithMetadata(
+	ctx context.Context, name string,
+) (resource.PropertyMap, resource.OutputMetadataMap, error) {
+	res, err := c.getRootStackResource(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res == nil {
+		return resource.PropertyMap{}, nil, nil
+	}
+	return res.Outputs, res.OutputMetadata, nil
+}
+
+// getRootStackResource returns the root stack resource of the stack with the given name, if it has one.
+func (c *backendClient) getRootStackResource(ctx context.Context, name string) (*resource.State, error) {
 	ref, err := c.backend.ParseStackReference(name)
 	if err != nil {
 		return nil, err
@@ -352,10 +447,7 @@ func (c *backendClient) GetStackOutputs(ctx context.Context, name string) (resou
 	if err != nil {
 		return nil, fmt.Errorf("getting root stack resources: %w", err)
 	}
-	if res == nil {
-		return resource.PropertyMap{}, nil
-	}
-	return res.Outputs, nil
+	return res, nil
 }
 
 func (c *backendClient) GetStackResourceOutputs(
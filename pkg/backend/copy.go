@@ -0,0 +1,122 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/edit"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// CopyStackOptions controls how CopyStack copies a stack.
+type CopyStackOptions struct {
+	// SecretsProvider decrypts the secrets in the deployments of the source stack.
+	SecretsProvider secrets.Provider
+	// SecretsManager encrypts the secrets in the deployments written to the destination stack.
+	SecretsManager secrets.Manager
+}
+
+// CopyStack copies the history, state and tags of the stack src to the stack dest, which may belong to another
+// backend. Every deployment copied has its secrets re-encrypted with the destination's secrets manager and its URNs
+// rewritten for the destination's name.
+//
+// The updates in the history of src are copied oldest first if its backend can export them. Backends that can't
+// import them as they are record each of them as an import of its deployment instead.
+func CopyStack(ctx context.Context, src, dest Stack, opts CopyStackOptions) error {
+	if exporter, ok := src.Backend().(SpecificDeploymentExporter); ok {
+		updates, err := src.Backend().GetHistory(ctx, src.Ref(), 0 /*pageSize*/, 0 /*page*/)
+		if err != nil {
+			return fmt.Errorf("getting the history of stack %s: %w", src.Ref(), err)
+		}
+		sort.Slice(updates, func(i, j int) bool { return updates[i].Version < updates[j].Version })
+
+		for _, update := range updates {
+			deployment, err := exporter.ExportDeploymentForVersion(ctx, src, strconv.Itoa(update.Version))
+			if err != nil {
+				return fmt.Errorf("exporting version %d of stack %s: %w", update.Version, src.Ref(), err)
+			}
+			if deployment, err = copyDeployment(ctx, deployment, dest.Ref(), opts); err != nil {
+				return fmt.Errorf("copying version %d of stack %s: %w", update.Version, src.Ref(), err)
+			}
+			if importer, ok := dest.Backend().(HistoryImporter); ok {
+				err = importer.ImportUpdate(ctx, dest, update, deployment)
+			} else {
+				err = ImportStackDeployment(ctx, dest, deployment)
+			}
+			if err != nil {
+				return fmt.Errorf("importing version %d of stack %s: %w", update.Version, src.Ref(), err)
+			}
+		}
+	}
+
+	// The current state may have moved on from the last update, for example if it was imported, so it's copied last.
+	deployment, err := ExportStackDeployment(ctx, src)
+	if err != nil {
+		return fmt.Errorf("exporting stack %s: %w", src.Ref(), err)
+	}
+	if deployment, err = copyDeployment(ctx, deployment, dest.Ref(), opts); err != nil {
+		return fmt.Errorf("copying stack %s: %w", src.Ref(), err)
+	}
+	if err := ImportStackDeployment(ctx, dest, deployment); err != nil {
+		return fmt.Errorf("importing into stack %s: %w", dest.Ref(), err)
+	}
+
+	if tags := src.Tags(); len(tags) > 0 && dest.Backend().SupportsTags() {
+		if err := UpdateStackTags(ctx, dest, tags); err != nil {
+			return fmt.Errorf("copying the tags of stack %s: %w", src.Ref(), err)
+		}
+	}
+	return nil
+}
+
+// copyDeployment returns a copy of the given deployment for the stack ref, with its secrets re-encrypted with
+// opts.SecretsManager.
+func copyDeployment(
+	ctx context.Context, deployment *apitype.UntypedDeployment, ref StackReference, opts CopyStackOptions,
+) (*apitype.UntypedDeployment, error) {
+	snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, opts.SecretsProvider)
+	if err != nil {
+		return nil, err
+	}
+	dep, err := stack.SerializeDeployment(snap, opts.SecretsManager, false /* showSecrets */)
+	if err != nil {
+		return nil, err
+	}
+
+	var project tokens.PackageName
+	if name, ok := ref.Project(); ok {
+		project = tokens.PackageName(name)
+	}
+	if err := edit.RenameStack(dep, ref.Name(), project); err != nil {
+		return nil, err
+	}
+
+	byts, err := json.Marshal(dep)
+	if err != nil {
+		return nil, err
+	}
+	return &apitype.UntypedDeployment{
+		Version:    apitype.DeploymentSchemaVersionCurrent,
+		Deployment: json.RawMessage(byts),
+	}, nil
+}
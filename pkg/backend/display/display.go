@@ -72,8 +72,17 @@ func ShowEvents(
 	if opts.EventLogPath != "" {
 		events, done = startEventLogger(events, done, opts)
 	}
+	if opts.DriftReportPath != "" {
+		events, done = startDriftReporter(stack, proj, events, done, opts)
+	}
+	if opts.ProfileSteps {
+		events, done = startStepProfiler(events, done, opts)
+	}
 
 	streamPreview := cmdutil.IsTruthy(os.Getenv("PULUMI_ENABLE_STREAMING_JSON_PREVIEW"))
+	if isPreview && !opts.JSONDisplay {
+		events, done = startImpactAnalyzer(events, done, opts)
+	}
 
 	if opts.JSONDisplay {
 		if isPreview && !streamPreview {
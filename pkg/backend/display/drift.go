@@ -0,0 +1,216 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// driftReporter builds the drift report of a refresh from its engine events.
+type driftReporter struct {
+	// refreshing holds the resources whose refreshes have started.
+	refreshing map[resource.URN]bool
+	report     apitype.DriftReport
+}
+
+func newDriftReporter(stack tokens.StackName, proj tokens.PackageName) *driftReporter {
+	return &driftReporter{
+		refreshing: make(map[resource.URN]bool),
+		report: apitype.DriftReport{
+			Version:   apitype.DriftReportVersion,
+			Project:   string(proj),
+			Stack:     stack.String(),
+			Resources: []apitype.DriftedResource{},
+		},
+	}
+}
+
+// handle records the drift described by the given event, if any.
+func (r *driftReporter) handle(e engine.Event) {
+	switch p := e.Payload().(type) {
+	case engine.ResourcePreEventPayload:
+		if p.Metadata.Op == deploy.OpRefresh {
+			r.refreshing[p.Metadata.URN] = true
+		}
+	case engine.ResourceOutputsEventPayload:
+		// The outputs of a refreshed resource carry the operation its refresh amounts to.
+		md := p.Metadata
+		if !r.refreshing[md.URN] {
+			return
+		}
+		delete(r.refreshing, md.URN)
+		r.report.Summary.Refreshed++
+
+		drifted := apitype.DriftedResource{URN: string(md.URN), Type: string(md.Type)}
+		if md.Old != nil {
+			drifted.ID = string(md.Old.ID)
+		}
+		switch md.Op {
+		case deploy.OpDelete:
+			drifted.Deleted = true
+			r.report.Summary.Deleted++
+		case deploy.OpUpdate:
+			if md.Old == nil || md.New == nil {
+				return
+			}
+			drifted.Properties = propertyDrift(nil, md.Old.Outputs.Diff(md.New.Outputs))
+			if len(drifted.Properties) == 0 {
+				return
+			}
+			r.report.Summary.Drifted++
+		default:
+			return
+		}
+		r.report.Resources = append(r.report.Resources, drifted)
+	}
+}
+
+// propertyDrift returns the drift of each changed leaf property in the given diff, under the given path.
+func propertyDrift(path resource.PropertyPath, diff *resource.ObjectDiff) []apitype.PropertyDrift {
+	if diff == nil {
+		return nil
+	}
+	keys := diff.Keys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var drift []apitype.PropertyDrift
+	for _, k := range keys {
+		p := append(append(resource.PropertyPath{}, path...), string(k))
+		if v, ok := diff.Adds[k]; ok {
+			drift = append(drift, apitype.PropertyDrift{Path: p.String(), Kind: apitype.PropertyAdded, New: driftValue(v)})
+		} else if v, ok := diff.Deletes[k]; ok {
+			drift = append(drift, apitype.PropertyDrift{
+				Path: p.String(), Kind: apitype.PropertyDeleted, Old: driftValue(v),
+			})
+		} else if d, ok := diff.Updates[k]; ok {
+			drift = append(drift, valueDrift(p, d)...)
+		}
+	}
+	return drift
+}
+
+// valueDrift returns the drift of each changed leaf property in the given value diff, under the given path.
+func valueDrift(path resource.PropertyPath, diff resource.ValueDiff) []apitype.PropertyDrift {
+	switch {
+	case diff.Object != nil:
+		return propertyDrift(path, diff.Object)
+	case diff.Array != nil:
+		indices := make([]int, 0, len(diff.Array.Adds)+len(diff.Array.Deletes)+len(diff.Array.Updates))
+		for _, m := range []map[int]resource.PropertyValue{diff.Array.Adds, diff.Array.Deletes} {
+			for i := range m {
+				indices = append(indices, i)
+			}
+		}
+		for i := range diff.Array.Updates {
+			indices = append(indices, i)
+		}
+		sort.Ints(indices)
+
+		var drift []apitype.PropertyDrift
+		for _, i := range indices {
+			p := append(append(resource.PropertyPath{}, path...), i)
+			if v, ok := diff.Array.Adds[i]; ok {
+				drift = append(drift, apitype.PropertyDrift{Path: p.String(), Kind: apitype.PropertyAdded, New: driftValue(v)})
+			} else if v, ok := diff.Array.Deletes[i]; ok {
+				drift = append(drift, apitype.PropertyDrift{
+					Path: p.String(), Kind: apitype.PropertyDeleted, Old: driftValue(v),
+				})
+			} else {
+				drift = append(drift, valueDrift(p, diff.Array.Updates[i])...)
+			}
+		}
+		return drift
+	default:
+		return []apitype.PropertyDrift{{
+			Path: path.String(), Kind: apitype.PropertyUpdated, Old: driftValue(diff.Old), New: driftValue(diff.New),
+		}}
+	}
+}
+
+// driftValue returns the JSON representation of a property value in a drift report, with secrets masked.
+func driftValue(v resource.PropertyValue) interface{} {
+	switch {
+	case v.IsSecret():
+		return "[secret]"
+	case v.IsComputed() || v.IsOutput() && !v.OutputValue().Known:
+		return "[unknown]"
+	case v.IsOutput():
+		return driftValue(v.OutputValue().Element)
+	case v.IsArray():
+		arr := make([]interface{}, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = driftValue(e)
+		}
+		return arr
+	case v.IsObject():
+		obj := make(map[string]interface{}, len(v.ObjectValue()))
+		for k, e := range v.ObjectValue() {
+			obj[string(k)] = driftValue(e)
+		}
+		return obj
+	default:
+		return v.Mappable()
+	}
+}
+
+// startDriftReporter writes the drift report of the refresh whose events are read from the given channel to the
+// file at opts.DriftReportPath once all of them have been read.
+func startDriftReporter(
+	stack tokens.StackName, proj tokens.PackageName, events <-chan engine.Event, done chan<- bool, opts Options,
+) (<-chan engine.Event, chan<- bool) {
+	reporter := newDriftReporter(stack, proj)
+	outEvents, outDone := make(chan engine.Event), make(chan bool)
+	go func() {
+		defer close(done)
+
+		for e := range events {
+			reporter.handle(e)
+			outEvents <- e
+			if e.Type == engine.CancelEvent {
+				break
+			}
+		}
+
+		<-outDone
+
+		if err := writeDriftReport(opts.DriftReportPath, reporter.report); err != nil {
+			stderr := opts.Stderr
+			if stderr == nil {
+				stderr = os.Stderr
+			}
+			fmt.Fprintf(stderr, "error: could not write the drift report: %v\n", err)
+		}
+	}()
+
+	return outEvents, outDone
+}
+
+// writeDriftReport writes the given drift report to the file at path.
+func writeDriftReport(path string, report apitype.DriftReport) error {
+	byts, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(byts, '\n'), 0o600)
+}
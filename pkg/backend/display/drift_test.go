@@ -0,0 +1,145 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// refreshEvents returns the events of refreshing a resource with the given outputs, where the refresh amounts to
+// op and finds the given new outputs.
+func refreshEvents(name string, op display.StepOp, old, new resource.PropertyMap) []engine.Event {
+	urn := resource.NewURN("dev", "proj", "", "aws:s3/bucket:Bucket", name)
+	oldState := &engine.StepEventStateMetadata{URN: urn, Type: urn.Type(), ID: resource.ID(name + "-id"), Outputs: old}
+	var newState *engine.StepEventStateMetadata
+	if new != nil {
+		newState = &engine.StepEventStateMetadata{URN: urn, Type: urn.Type(), ID: oldState.ID, Outputs: new}
+	}
+	return []engine.Event{
+		engine.NewEvent(engine.ResourcePreEventPayload{Metadata: engine.StepEventMetadata{
+			Op: deploy.OpRefresh, URN: urn, Type: urn.Type(), Old: oldState, New: oldState,
+		}}),
+		engine.NewEvent(engine.ResourceOutputsEventPayload{Metadata: engine.StepEventMetadata{
+			Op: op, URN: urn, Type: urn.Type(), Old: oldState, New: newState,
+		}}),
+	}
+}
+
+func TestDriftReporter(t *testing.T) {
+	t.Parallel()
+
+	old := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"acl":  "private",
+		"tags": map[string]interface{}{"env": "dev", "owner": "alice"},
+		"rules": []interface{}{
+			map[string]interface{}{"days": 30},
+			map[string]interface{}{"days": 60},
+		},
+	})
+	old["password"] = resource.MakeSecret(resource.NewStringProperty("hunter2"))
+	drifted := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"acl":  "public-read",
+		"tags": map[string]interface{}{"env": "dev", "team": "platform"},
+		"rules": []interface{}{
+			map[string]interface{}{"days": 30},
+		},
+	})
+	drifted["password"] = resource.MakeSecret(resource.NewStringProperty("hunter3"))
+
+	var events []engine.Event
+	events = append(events, refreshEvents("same", deploy.OpSame, old, old)...)
+	events = append(events, refreshEvents("drifted", deploy.OpUpdate, old, drifted)...)
+	events = append(events, refreshEvents("deleted", deploy.OpDelete, old, nil)...)
+
+	reporter := newDriftReporter(tokens.MustParseStackName("dev"), "proj")
+	for _, e := range events {
+		reporter.handle(e)
+	}
+
+	assert.Equal(t, apitype.DriftReport{
+		Version: apitype.DriftReportVersion,
+		Project: "proj",
+		Stack:   "dev",
+		Summary: apitype.DriftSummary{Refreshed: 3, Drifted: 1, Deleted: 1},
+		Resources: []apitype.DriftedResource{
+			{
+				URN:  "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::drifted",
+				Type: "aws:s3/bucket:Bucket",
+				ID:   "drifted-id",
+				Properties: []apitype.PropertyDrift{
+					{Path: "acl", Kind: apitype.PropertyUpdated, Old: "private", New: "public-read"},
+					{Path: "password", Kind: apitype.PropertyUpdated, Old: "[secret]", New: "[secret]"},
+					{
+						Path: "rules[1]", Kind: apitype.PropertyDeleted,
+						Old: map[string]interface{}{"days": float64(60)},
+					},
+					{Path: "tags.owner", Kind: apitype.PropertyDeleted, Old: "alice"},
+					{Path: "tags.team", Kind: apitype.PropertyAdded, New: "platform"},
+				},
+			},
+			{
+				URN:     "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::deleted",
+				Type:    "aws:s3/bucket:Bucket",
+				ID:      "deleted-id",
+				Deleted: true,
+			},
+		},
+	}, reporter.report)
+}
+
+func TestStartDriftReporter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "drift.json")
+	events, done := make(chan engine.Event), make(chan bool)
+	outEvents, outDone := startDriftReporter(
+		tokens.MustParseStackName("dev"), "proj", events, done, Options{DriftReportPath: path})
+
+	// Events are passed on to the display as they're read, which is done once it sees the cancel event.
+	go func() {
+		for e := range outEvents {
+			if e.Type == engine.CancelEvent {
+				break
+			}
+		}
+		close(outDone)
+	}()
+	for _, e := range refreshEvents("deleted", deploy.OpDelete, resource.PropertyMap{}, nil) {
+		events <- e
+	}
+	events <- engine.NewCancelEvent()
+	<-done
+
+	byts, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var report apitype.DriftReport
+	require.NoError(t, json.Unmarshal(byts, &report))
+	assert.Equal(t, apitype.DriftSummary{Refreshed: 1, Deleted: 1}, report.Summary)
+	require.Len(t, report.Resources, 1)
+	assert.True(t, report.Resources[0].Deleted)
+}
@@ -0,0 +1,244 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// changingResource records a resource that a preview plans to update or replace.
+type changingResource struct {
+	urn resource.URN
+	op  display.StepOp
+	// keys holds the properties that cause the resource to be replaced.
+	keys []resource.PropertyKey
+	// changed holds the properties that change.
+	changed map[resource.PropertyKey]bool
+	// propertyDeps holds the resources that each of the resource's new inputs depends on.
+	propertyDeps map[resource.PropertyKey][]resource.URN
+}
+
+// impactAnalyzer works out, from the engine events of a preview, which of the resources the preview updates or
+// replaces do so because a resource they depend on is replaced. A resource is only considered to change because of
+// another if one of its changing properties depends on that resource, so resources whose SDKs don't record property
+// dependencies are never reported.
+type impactAnalyzer struct {
+	changing map[resource.URN]*changingResource
+	// order holds the changing resources in the order they were planned.
+	order []resource.URN
+}
+
+func newImpactAnalyzer() *impactAnalyzer {
+	return &impactAnalyzer{changing: make(map[resource.URN]*changingResource)}
+}
+
+// handle records the change described by the given event, if any.
+func (a *impactAnalyzer) handle(e engine.Event) {
+	payload, ok := e.Payload().(engine.ResourcePreEventPayload)
+	if !ok {
+		return
+	}
+	md := payload.Metadata
+
+	var op display.StepOp
+	switch md.Op {
+	case deploy.OpUpdate:
+		op = deploy.OpUpdate
+	case deploy.OpReplace, deploy.OpCreateReplacement, deploy.OpDeleteReplaced:
+		op = deploy.OpReplace
+	default:
+		return
+	}
+
+	// A replacement takes several steps; they're recorded as one.
+	r, has := a.changing[md.URN]
+	if !has {
+		r = &changingResource{urn: md.URN, op: op, changed: make(map[resource.PropertyKey]bool)}
+		a.changing[md.URN] = r
+		a.order = append(a.order, md.URN)
+	}
+	if op == deploy.OpReplace {
+		r.op = op
+	}
+	for _, k := range md.Keys {
+		if !hasKey(r.keys, k) {
+			r.keys = append(r.keys, k)
+		}
+		r.changed[k] = true
+	}
+	for _, k := range md.Diffs {
+		r.changed[k] = true
+	}
+	if md.New != nil && md.New.State != nil && md.New.State.PropertyDependencies != nil {
+		r.propertyDeps = md.New.State.PropertyDependencies
+	}
+}
+
+func hasKey(keys []resource.PropertyKey, key resource.PropertyKey) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// impacts returns the impact of each replacement that causes other resources to change, in the order the
+// replacements were planned. Replacements that are themselves caused by another replacement are only listed among
+// that replacement's dependents.
+func (a *impactAnalyzer) impacts() []display.ReplacementImpact {
+	// dependents maps each changing resource to the changing resources with properties that depend on it, in the order
+	// they were planned, along with those properties.
+	type dependent struct {
+		urn        resource.URN
+		properties []resource.PropertyKey
+	}
+	dependents := make(map[resource.URN][]dependent)
+	for _, urn := range a.order {
+		r := a.changing[urn]
+		causes := make(map[resource.URN][]resource.PropertyKey)
+		var order []resource.URN
+		for k, deps := range r.propertyDeps {
+			if !r.changed[k] {
+				continue
+			}
+			for _, dep := range deps {
+				if _, has := a.changing[dep]; !has || dep == urn {
+					continue
+				}
+				if _, has := causes[dep]; !has {
+					order = append(order, dep)
+				}
+				causes[dep] = append(causes[dep], k)
+			}
+		}
+		for _, dep := range order {
+			properties := causes[dep]
+			sort.Slice(properties, func(i, j int) bool { return properties[i] < properties[j] })
+			dependents[dep] = append(dependents[dep], dependent{urn: urn, properties: properties})
+		}
+	}
+
+	var impacts []display.ReplacementImpact
+	caused := make(map[resource.URN]bool)
+	for _, urn := range a.order {
+		r := a.changing[urn]
+		if r.op != deploy.OpReplace {
+			continue
+		}
+
+		impact := display.ReplacementImpact{URN: urn, ReplaceReasons: r.keys}
+		visited := map[resource.URN]bool{urn: true}
+		for queue := []resource.URN{urn}; len(queue) > 0; queue = queue[1:] {
+			cause := queue[0]
+			for _, d := range dependents[cause] {
+				if visited[d.urn] {
+					continue
+				}
+				visited[d.urn] = true
+				caused[d.urn] = true
+				impact.Dependents = append(impact.Dependents, display.ImpactedResource{
+					Op:         a.changing[d.urn].op,
+					URN:        d.urn,
+					DependsOn:  cause,
+					Properties: d.properties,
+				})
+				queue = append(queue, d.urn)
+			}
+		}
+		if len(impact.Dependents) != 0 {
+			impacts = append(impacts, impact)
+		}
+	}
+
+	// Drop the replacements that are part of another's impact.
+	filtered := impacts[:0]
+	for _, impact := range impacts {
+		if !caused[impact.URN] {
+			filtered = append(filtered, impact)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+// writeReplacementImpact writes a summary of the given replacement impacts.
+func writeReplacementImpact(w io.Writer, impacts []display.ReplacementImpact) {
+	if len(impacts) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\nReplacement impact:\n")
+	for _, impact := range impacts {
+		reasons := make([]string, len(impact.ReplaceReasons))
+		for i, k := range impact.ReplaceReasons {
+			reasons[i] = string(k)
+		}
+		if len(reasons) == 0 {
+			fmt.Fprintf(w, "    %s is replaced, which causes:\n", impact.URN)
+		} else {
+			fmt.Fprintf(w, "    %s is replaced because of %s, which causes:\n", impact.URN, strings.Join(reasons, ", "))
+		}
+		for _, d := range impact.Dependents {
+			properties := make([]string, len(d.Properties))
+			for i, k := range d.Properties {
+				properties[i] = string(k)
+			}
+			fmt.Fprintf(w, "        %-8s %s (%s depends on %s)\n",
+				d.Op, d.URN, strings.Join(properties, ", "), d.DependsOn.Name())
+		}
+	}
+}
+
+// startImpactAnalyzer works out the impact of the replacements planned by the preview whose events are read from
+// the given channel, and writes a summary of it once all of them have been read.
+func startImpactAnalyzer(
+	events <-chan engine.Event, done chan<- bool, opts Options,
+) (<-chan engine.Event, chan<- bool) {
+	analyzer := newImpactAnalyzer()
+	outEvents, outDone := make(chan engine.Event), make(chan bool)
+	go func() {
+		defer close(done)
+
+		for e := range events {
+			analyzer.handle(e)
+			outEvents <- e
+			if e.Type == engine.CancelEvent {
+				break
+			}
+		}
+
+		<-outDone
+
+		w := opts.Stdout
+		if w == nil {
+			w = os.Stdout
+		}
+		writeReplacementImpact(w, analyzer.impacts())
+	}()
+
+	return outEvents, outDone
+}
@@ -0,0 +1,89 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestImpactAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newImpactAnalyzer()
+
+	urn := func(typ, name string) resource.URN {
+		return resource.NewURN("dev", "proj", "", tokens.Type(typ), name)
+	}
+	vpc, subnet := urn("aws:ec2/vpc:Vpc", "vpc"), urn("aws:ec2/subnet:Subnet", "subnet")
+	instance, bucket := urn("aws:ec2/instance:Instance", "instance"), urn("aws:s3/bucket:Bucket", "bucket")
+	sg := urn("aws:ec2/securityGroup:SecurityGroup", "sg")
+
+	pre := func(op display.StepOp, urn resource.URN, keys, diffs []resource.PropertyKey,
+		deps map[resource.PropertyKey][]resource.URN,
+	) {
+		state := &engine.StepEventStateMetadata{
+			URN:   urn,
+			Type:  urn.Type(),
+			State: &resource.State{URN: urn, Type: urn.Type(), PropertyDependencies: deps},
+		}
+		analyzer.handle(engine.NewEvent(engine.ResourcePreEventPayload{Metadata: engine.StepEventMetadata{
+			Op: op, URN: urn, Type: urn.Type(), New: state, Res: state, Keys: keys, Diffs: diffs,
+		}}))
+	}
+
+	pre(deploy.OpCreateReplacement, vpc, []resource.PropertyKey{"cidrBlock"}, []resource.PropertyKey{"cidrBlock"}, nil)
+	pre(deploy.OpReplace, vpc, []resource.PropertyKey{"cidrBlock"}, []resource.PropertyKey{"cidrBlock"}, nil)
+	// The subnet is replaced because its VPC is.
+	pre(deploy.OpCreateReplacement, subnet, []resource.PropertyKey{"vpcId"}, []resource.PropertyKey{"vpcId"},
+		map[resource.PropertyKey][]resource.URN{"vpcId": {vpc}})
+	// The security group depends on the VPC, but changes for an unrelated reason.
+	pre(deploy.OpUpdate, sg, nil, []resource.PropertyKey{"description"},
+		map[resource.PropertyKey][]resource.URN{"vpcId": {vpc}})
+	// The instance is updated because its subnet is replaced, but not because of the bucket.
+	pre(deploy.OpUpdate, instance, nil, []resource.PropertyKey{"subnetId"},
+		map[resource.PropertyKey][]resource.URN{"subnetId": {subnet}, "tags": {bucket}})
+	// The bucket is replaced, but nothing changes because of it.
+	pre(deploy.OpCreateReplacement, bucket, []resource.PropertyKey{"bucket"}, []resource.PropertyKey{"bucket"}, nil)
+	pre(deploy.OpDeleteReplaced, subnet, nil, nil, nil)
+	pre(deploy.OpDeleteReplaced, vpc, nil, nil, nil)
+
+	impacts := analyzer.impacts()
+	assert.Equal(t, []display.ReplacementImpact{{
+		URN:            vpc,
+		ReplaceReasons: []resource.PropertyKey{"cidrBlock"},
+		Dependents: []display.ImpactedResource{
+			{Op: deploy.OpReplace, URN: subnet, DependsOn: vpc, Properties: []resource.PropertyKey{"vpcId"}},
+			{Op: deploy.OpUpdate, URN: instance, DependsOn: subnet, Properties: []resource.PropertyKey{"subnetId"}},
+		},
+	}}, impacts)
+
+	var buf bytes.Buffer
+	writeReplacementImpact(&buf, impacts)
+	assert.Equal(t, `
+Replacement impact:
+    urn:pulumi:dev::proj::aws:ec2/vpc:Vpc::vpc is replaced because of cidrBlock, which causes:
+        replace  urn:pulumi:dev::proj::aws:ec2/subnet:Subnet::subnet (vpcId depends on vpc)
+        update   urn:pulumi:dev::proj::aws:ec2/instance:Instance::instance (subnetId depends on subnet)
+`, buf.String())
+}
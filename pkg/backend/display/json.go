@@ -85,7 +85,7 @@ func stateForJSONOutput(s *resource.State, opts Options) *resource.State {
 	return resource.NewState(s.Type, s.URN, s.Custom, s.Delete, s.ID, inputs,
 		outputs, s.Parent, s.Protect, s.External, s.Dependencies, s.InitErrors, s.Provider,
 		s.PropertyDependencies, s.PendingReplacement, s.AdditionalSecretOutputs, s.Aliases, &s.CustomTimeouts,
-		s.ImportID, s.RetainOnDelete, s.DeletedWith, s.Created, s.Modified, s.SourcePosition)
+		s.ImportID, s.RetainOnDelete, s.DeletedWith, s.Created, s.Modified, s.SourcePosition, &s.RetryPolicy)
 }
 
 // ShowJSONEvents renders incremental engine events to stdout.
@@ -119,7 +119,10 @@ func ShowPreviewDigest(events <-chan engine.Event, done chan<- bool, opts Option
 
 	// Now loop and accumulate our digest until the event stream is closed, or we hit a cancellation.
 	var digest display.PreviewDigest
+	analyzer := newImpactAnalyzer()
 	for e := range events {
+		analyzer.handle(e)
+
 		// In the event of cancellation, break out of the loop immediately.
 		if e.Type == engine.CancelEvent {
 			break
@@ -216,6 +219,8 @@ func ShowPreviewDigest(events <-chan engine.Event, done chan<- bool, opts Option
 			contract.Failf("unknown event type '%s'", e.Type)
 		}
 	}
+	digest.ReplacementImpact = analyzer.impacts()
+
 	// Finally, go ahead and render the JSON to stdout.
 	out, err := json.MarshalIndent(&digest, "", "    ")
 	contract.Assertf(err == nil, "unexpected JSON error: %v", err)
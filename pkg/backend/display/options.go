@@ -51,6 +51,8 @@ type Options struct {
 	Type                   Type                // type of display (rich diff, progress, or query).
 	JSONDisplay            bool                // true if we should emit the entire diff as JSON.
 	EventLogPath           string              // the path to the file to use for logging events, if any.
+	DriftReportPath        string              // the path to write the drift report of a refresh to, if any.
+	ProfileSteps           bool                // true to print a summary of the slowest steps after the update.
 	Debug                  bool                // true to enable debug output.
 	Stdin                  io.Reader           // the reader to use for stdin. Defaults to os.Stdin if unset.
 	Stdout                 io.Writer           // the writer to use for stdout. Defaults to os.Stdout if unset.
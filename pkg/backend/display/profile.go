@@ -0,0 +1,238 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// profiledSteps is the number of slowest steps listed in a step profile.
+const profiledSteps = 10
+
+// stepTiming records when the steps for a single resource started and finished.
+type stepTiming struct {
+	urn    resource.URN
+	op     display.StepOp
+	start  time.Time
+	end    time.Time
+	failed bool
+	// deps holds the resources this resource depends on, including its provider.
+	deps []resource.URN
+}
+
+func (t *stepTiming) duration() time.Duration {
+	return t.end.Sub(t.start)
+}
+
+// stepProfiler records the wall-clock durations of the steps of an update from its engine events.
+type stepProfiler struct {
+	now     func() time.Time
+	timings map[resource.URN]*stepTiming
+	// order holds the resources in the order their steps started.
+	order []resource.URN
+}
+
+func newStepProfiler(now func() time.Time) *stepProfiler {
+	return &stepProfiler{now: now, timings: make(map[resource.URN]*stepTiming)}
+}
+
+// handle records the start or end of the step described by the given event, if any.
+func (p *stepProfiler) handle(e engine.Event) {
+	switch payload := e.Payload().(type) {
+	case engine.ResourcePreEventPayload:
+		md := payload.Metadata
+		// Same steps do no work, and the root stack's step lasts for the whole update.
+		if md.Op == deploy.OpSame || md.Type == resource.RootStackType {
+			return
+		}
+		if t, has := p.timings[md.URN]; has {
+			// A replacement takes several steps; they're timed as one.
+			if t.op != md.Op {
+				t.op = deploy.OpReplace
+			}
+			return
+		}
+		t := &stepTiming{urn: md.URN, op: md.Op, start: p.now(), deps: stepDependencies(md)}
+		p.timings[md.URN] = t
+		p.order = append(p.order, md.URN)
+	case engine.ResourceOutputsEventPayload:
+		if t, has := p.timings[payload.Metadata.URN]; has {
+			t.end = p.now()
+		}
+	case engine.ResourceOperationFailedPayload:
+		if t, has := p.timings[payload.Metadata.URN]; has {
+			t.end, t.failed = p.now(), true
+		}
+	}
+}
+
+// stepDependencies returns the resources that the resource affected by a step depends on.
+func stepDependencies(md engine.StepEventMetadata) []resource.URN {
+	var deps []resource.URN
+	if state := md.Res; state != nil && state.State != nil {
+		deps = append(deps, state.State.Dependencies...)
+	}
+	if md.Provider != "" {
+		if ref, err := providers.ParseReference(md.Provider); err == nil {
+			deps = append(deps, ref.URN())
+		}
+	}
+	return deps
+}
+
+// finished returns the timings of the steps that finished, in the order they started.
+func (p *stepProfiler) finished() []*stepTiming {
+	var timings []*stepTiming
+	for _, urn := range p.order {
+		if t := p.timings[urn]; !t.end.IsZero() {
+			timings = append(timings, t)
+		}
+	}
+	return timings
+}
+
+// slowest returns the timings of the n slowest steps, slowest first.
+func (p *stepProfiler) slowest(n int) []*stepTiming {
+	timings := p.finished()
+	sort.SliceStable(timings, func(i, j int) bool {
+		return timings[i].duration() > timings[j].duration()
+	})
+	if len(timings) > n {
+		timings = timings[:n]
+	}
+	return timings
+}
+
+// longestChain returns the chain of steps that took longest in total because each of them had to wait for the one
+// before it to finish, in the order they ran. A step waits for a related resource if one depends on the other: a
+// resource is created after its dependencies, and deleted before them.
+func (p *stepProfiler) longestChain() []*stepTiming {
+	timings := p.finished()
+
+	related := make(map[resource.URN][]resource.URN)
+	for _, t := range timings {
+		for _, dep := range t.deps {
+			related[t.urn] = append(related[t.urn], dep)
+			related[dep] = append(related[dep], t.urn)
+		}
+	}
+
+	// Steps are visited in the order they started, so every step that a step waited for has been visited before it.
+	total := make(map[resource.URN]time.Duration)
+	prev := make(map[resource.URN]*stepTiming)
+	var last *stepTiming
+	for _, t := range timings {
+		var waited *stepTiming
+		for _, urn := range related[t.urn] {
+			r, has := p.timings[urn]
+			if !has || r.end.IsZero() || r.end.After(t.start) {
+				continue
+			}
+			if waited == nil || r.end.After(waited.end) {
+				waited = r
+			}
+		}
+		total[t.urn] = t.duration()
+		if waited != nil {
+			prev[t.urn] = waited
+			total[t.urn] += total[waited.urn]
+		}
+		if last == nil || total[t.urn] > total[last.urn] {
+			last = t
+		}
+	}
+
+	var chain []*stepTiming
+	for t := last; t != nil; t = prev[t.urn] {
+		chain = append([]*stepTiming{t}, chain...)
+	}
+	return chain
+}
+
+// report writes a summary of the slowest steps and the longest chain of steps that ran one after another.
+func (p *stepProfiler) report(w io.Writer) {
+	slowest := p.slowest(profiledSteps)
+	if len(slowest) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\nStep profile:\n")
+	fmt.Fprintf(w, "    Slowest steps:\n")
+	writeStepTimings(w, slowest)
+
+	chain := p.longestChain()
+	var total time.Duration
+	for _, t := range chain {
+		total += t.duration()
+	}
+	fmt.Fprintf(w, "    Longest serialization chain (%d steps, %v):\n", len(chain), roundDuration(total))
+	writeStepTimings(w, chain)
+}
+
+func writeStepTimings(w io.Writer, timings []*stepTiming) {
+	for _, t := range timings {
+		op := string(t.op)
+		if t.failed {
+			op += " (failed)"
+		}
+		fmt.Fprintf(w, "        %-10v %-18s %s\n", roundDuration(t.duration()), op, t.urn)
+	}
+}
+
+func roundDuration(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
+
+// startStepProfiler records the durations of the steps of the update whose events are read from the given channel,
+// and writes a summary of them once all of them have been read. The summary goes to stderr when the events are
+// displayed as JSON, so as not to break up the JSON on stdout.
+func startStepProfiler(events <-chan engine.Event, done chan<- bool, opts Options) (<-chan engine.Event, chan<- bool) {
+	profiler := newStepProfiler(time.Now)
+	outEvents, outDone := make(chan engine.Event), make(chan bool)
+	go func() {
+		defer close(done)
+
+		for e := range events {
+			profiler.handle(e)
+			outEvents <- e
+			if e.Type == engine.CancelEvent {
+				break
+			}
+		}
+
+		<-outDone
+
+		w, fallback := opts.Stdout, io.Writer(os.Stdout)
+		if opts.JSONDisplay {
+			w, fallback = opts.Stderr, os.Stderr
+		}
+		if w == nil {
+			w = fallback
+		}
+		profiler.report(w)
+	}()
+
+	return outEvents, outDone
+}
@@ -0,0 +1,106 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestStepProfiler(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	var now time.Time
+	profiler := newStepProfiler(func() time.Time { return now })
+
+	urn := func(typ, name string) resource.URN {
+		return resource.NewURN("dev", "proj", "", tokens.Type(typ), name)
+	}
+	stack := urn("pulumi:pulumi:Stack", "proj-dev")
+	vpc, subnet := urn("aws:ec2/vpc:Vpc", "vpc"), urn("aws:ec2/subnet:Subnet", "subnet")
+	db, bucket := urn("aws:rds/instance:Instance", "db"), urn("aws:s3/bucket:Bucket", "bucket")
+	role := urn("aws:iam/role:Role", "role")
+
+	metadata := func(op display.StepOp, urn resource.URN, deps ...resource.URN) engine.StepEventMetadata {
+		state := &engine.StepEventStateMetadata{
+			URN:   urn,
+			Type:  urn.Type(),
+			State: &resource.State{URN: urn, Type: urn.Type(), Dependencies: deps},
+		}
+		return engine.StepEventMetadata{Op: op, URN: urn, Type: urn.Type(), New: state, Res: state}
+	}
+	at := func(seconds int, e engine.Event) {
+		now = start.Add(time.Duration(seconds) * time.Second)
+		profiler.handle(e)
+	}
+	pre := func(seconds int, md engine.StepEventMetadata) {
+		at(seconds, engine.NewEvent(engine.ResourcePreEventPayload{Metadata: md}))
+	}
+	outputs := func(seconds int, md engine.StepEventMetadata) {
+		at(seconds, engine.NewEvent(engine.ResourceOutputsEventPayload{Metadata: md}))
+	}
+
+	pre(0, metadata(deploy.OpCreate, stack))
+	pre(0, metadata(deploy.OpSame, role))
+	outputs(0, metadata(deploy.OpSame, role))
+	pre(0, metadata(deploy.OpCreate, vpc))
+	pre(0, metadata(deploy.OpUpdate, bucket))
+	outputs(10, metadata(deploy.OpCreate, vpc))
+	pre(10, metadata(deploy.OpCreate, subnet, vpc))
+	outputs(13, metadata(deploy.OpCreate, subnet, vpc))
+	pre(13, metadata(deploy.OpCreateReplacement, db, subnet))
+	outputs(20, metadata(deploy.OpUpdate, bucket))
+	pre(70, metadata(deploy.OpDeleteReplaced, db, subnet))
+	at(73, engine.NewEvent(engine.ResourceOperationFailedPayload{
+		Metadata: metadata(deploy.OpDeleteReplaced, db, subnet),
+	}))
+	outputs(80, metadata(deploy.OpCreate, stack))
+
+	urns := func(timings []*stepTiming) []resource.URN {
+		var urns []resource.URN
+		for _, t := range timings {
+			urns = append(urns, t.urn)
+		}
+		return urns
+	}
+	assert.Equal(t, []resource.URN{db, bucket, vpc, subnet}, urns(profiler.slowest(10)))
+	assert.Equal(t, []resource.URN{db, bucket}, urns(profiler.slowest(2)))
+	assert.Equal(t, []resource.URN{vpc, subnet, db}, urns(profiler.longestChain()))
+
+	var buf bytes.Buffer
+	profiler.report(&buf)
+	assert.Equal(t, `
+Step profile:
+    Slowest steps:
+        1m0s       replace (failed)   urn:pulumi:dev::proj::aws:rds/instance:Instance::db
+        20s        update             urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket
+        10s        create             urn:pulumi:dev::proj::aws:ec2/vpc:Vpc::vpc
+        3s         create             urn:pulumi:dev::proj::aws:ec2/subnet:Subnet::subnet
+    Longest serialization chain (3 steps, 1m13s):
+        10s        create             urn:pulumi:dev::proj::aws:ec2/vpc:Vpc::vpc
+        3s         create             urn:pulumi:dev::proj::aws:ec2/subnet:Subnet::subnet
+        1m0s       replace (failed)   urn:pulumi:dev::proj::aws:rds/instance:Instance::db
+`, buf.String())
+}
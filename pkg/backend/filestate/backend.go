@@ -16,6 +16,7 @@ package filestate
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,12 +25,15 @@ import (
 	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	_ "github.com/go-sql-driver/mysql" // driver for mysql://
 	"github.com/gofrs/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib" // driver for postgres://
 
 	user "github.com/tweekmonster/luser"
 	"gocloud.dev/blob"
@@ -89,6 +93,9 @@ type Backend interface {
 
 	// Upgrade to the latest state store version.
 	Upgrade(ctx context.Context, opts *UpgradeOptions) error
+
+	// ForceUnlock removes the locks on a stack whose holders are known to have exited.
+	ForceUnlock(ctx context.Context, stackRef backend.StackReference) error
 }
 
 type localBackend struct {
@@ -105,7 +112,28 @@ type localBackend struct {
 
 	lockID string
 
-	gzip bool
+	// locker serializes the acquisition of stack locks, if the bucket supports it.
+	locker stackLocker
+
+	// leases tracks the renewal of the leases on the locks this backend holds, keyed by lock path.
+	leases      map[string]*leaseRenewal
+	leasesMutex sync.Mutex
+
+	// compression is the format state files are compressed with when written.
+	compression stateCompression
+
+	// readOnly is true if the backend is in read-only mode, in which any operation that would write to the state of
+	// a stack fails.
+	readOnly bool
+
+	// deltaUploads is true if the bucket is in cloud object storage, where an update sends its first save as a
+	// journal entry against the existing checkpoint file rather than uploading the whole checkpoint again. This only
+	// applies to updates that use the checkpoint journal, which is opt-in and isn't used for encrypted state.
+	deltaUploads bool
+
+	// stateVersions holds the checksum of the checkpoint file content this backend last read or wrote for each
+	// stack, keyed by fully qualified stack name, so that writes can be refused if another update changed the file.
+	stateVersions sync.Map
 
 	Env env.Env
 
@@ -119,6 +147,12 @@ type localBackend struct {
 	store referenceStore
 }
 
+// Assert we implement the backend.SpecificDeploymentExporter, backend.HistoryImporter and backend.StackTemplater
+// interfaces.
+var _ backend.SpecificDeploymentExporter = &localBackend{}
+var _ backend.HistoryImporter = &localBackend{}
+var _ backend.StackTemplater = &localBackend{}
+
 type localBackendReference struct {
 	name    tokens.StackName
 	project tokens.Name
@@ -175,6 +209,7 @@ func (r *localBackendReference) Validate() error       { return r.store.Validate
 func (r *localBackendReference) StackBasePath() string { return r.store.StackBasePath(r) }
 func (r *localBackendReference) HistoryDir() string    { return r.store.HistoryDir(r) }
 func (r *localBackendReference) BackupDir() string     { return r.store.BackupDir(r) }
+func (r *localBackendReference) JournalDir() string    { return r.store.JournalDir(r) }
 
 func IsFileStateBackendURL(urlstr string) bool {
 	u, err := url.Parse(urlstr)
@@ -182,15 +217,31 @@ func IsFileStateBackendURL(urlstr string) bool {
 		return false
 	}
 
-	return blob.DefaultURLMux().ValidBucketScheme(u.Scheme)
+	return blob.DefaultURLMux().ValidBucketScheme(u.Scheme) || sqlDialects[u.Scheme] != nil ||
+		isBackendPluginScheme(u.Scheme)
 }
 
 const FilePathPrefix = "file://"
 
+// cloudBucketSchemes are the URL schemes of the cloud object storage services supported as buckets.
+var cloudBucketSchemes = []string{"s3://", "azblob://", "gs://"}
+
+// isCloudBucketURL returns true if the given bucket URL is for cloud object storage.
+func isCloudBucketURL(u string) bool {
+	for _, scheme := range cloudBucketSchemes {
+		if strings.HasPrefix(u, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
 // New constructs a new filestate backend,
 // using the given URL as the root for storage.
 // The URL must use one of the schemes supported by the go-cloud blob package.
 // Thes inclue: file, s3, gs, azblob.
+// State can also be stored in a PostgreSQL or MySQL database, using a postgres:// or mysql:// URL.
+// Any other scheme is handled by the backend plugin of the same name, if one is installed.
 func New(ctx context.Context, d diag.Sink, originalURL string, project *workspace.Project) (Backend, error) {
 	return newLocalBackend(ctx, d, originalURL, project, nil)
 }
@@ -216,7 +267,8 @@ func newLocalBackend(
 	}
 
 	if !IsFileStateBackendURL(originalURL) {
-		return nil, fmt.Errorf("local URL %s has an illegal prefix; expected one of: %s",
+		return nil, fmt.Errorf("local URL %s has an illegal prefix; expected one of: %s, postgres, mysql, "+
+			"or the scheme of an installed backend plugin",
 			originalURL, strings.Join(blob.DefaultURLMux().BucketSchemes(), ", "))
 	}
 
@@ -241,12 +293,20 @@ func newLocalBackend(
 		}
 	}
 
-	bucket, err := blobmux.OpenBucket(ctx, u)
+	var bucket *blob.Bucket
+	if blobmux.ValidBucketScheme(p.Scheme) {
+		bucket, err = blobmux.OpenBucket(ctx, u)
+	} else if dialect := sqlDialects[p.Scheme]; dialect != nil {
+		bucket, err = openSQLBucket(ctx, dialect, p)
+	} else {
+		bucket, err = openPluginBucket(ctx, d, p)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to open bucket %s: %w", u, err)
+		return nil, fmt.Errorf("unable to open bucket %s: %w", p.Redacted(), err)
 	}
 
-	if !strings.HasPrefix(u, FilePathPrefix) {
+	// Databases and backend plugins interpret the path of the URL themselves.
+	if !strings.HasPrefix(u, FilePathPrefix) && blobmux.ValidBucketScheme(p.Scheme) {
 		bucketSubDir := strings.TrimLeft(p.Path, "/")
 		if bucketSubDir != "" {
 			if !strings.HasSuffix(bucketSubDir, "/") {
@@ -263,19 +323,40 @@ func newLocalBackend(
 		return nil, err
 	}
 
-	gzipCompression := opts.Env.GetBool(env.SelfManagedGzip)
+	compression := noCompression
+	if s := opts.Env.GetString(env.SelfManagedCompression); s != "" {
+		if compression, err = parseStateCompression(s); err != nil {
+			return nil, err
+		}
+	} else if opts.Env.GetBool(env.SelfManagedGzip) {
+		compression = gzipCompression
+	}
+
+	var locker stackLocker
+	var sqlb *sqlBucket
+	if bucket.As(&sqlb) {
+		locker = sqlb
+	}
 
-	wbucket := &wrappedBucket{bucket: bucket}
+	var wbucket Bucket = &wrappedBucket{bucket: bucket}
 	bucket = nil // prevent accidental use of unwrapped bucket
 
+	readOnly := backend.IsReadOnly(opts.Env, originalURL)
+	if readOnly {
+		wbucket = &readOnlyBucket{Bucket: wbucket}
+	}
+
 	backend := &localBackend{
-		d:           d,
-		originalURL: originalURL,
-		url:         u,
-		bucket:      wbucket,
-		lockID:      lockID.String(),
-		gzip:        gzipCompression,
-		Env:         opts.Env,
+		d:            d,
+		originalURL:  originalURL,
+		url:          u,
+		bucket:       wbucket,
+		lockID:       lockID.String(),
+		locker:       locker,
+		compression:  compression,
+		readOnly:     readOnly,
+		deltaUploads: isCloudBucketURL(u),
+		Env:          opts.Env,
 	}
 	backend.currentProject.Store(project)
 
@@ -342,6 +423,10 @@ func newLocalBackend(
 }
 
 func (b *localBackend) Upgrade(ctx context.Context, opts *UpgradeOptions) error {
+	if err := b.checkWritable("upgrade the backend"); err != nil {
+		return err
+	}
+
 	if opts == nil {
 		opts = &UpgradeOptions{}
 	}
@@ -605,7 +690,7 @@ func (b *localBackend) ListPolicyPacks(ctx context.Context, orgName string, _ ba
 }
 
 func (b *localBackend) SupportsTags() bool {
-	return false
+	return true
 }
 
 func (b *localBackend) SupportsOrganizations() bool {
@@ -671,8 +756,12 @@ func currentProjectContradictsWorkspace(stack *localBackendReference) bool {
 func (b *localBackend) CreateStack(ctx context.Context, stackRef backend.StackReference,
 	root string, opts *backend.CreateStackOptions,
 ) (backend.Stack, error) {
-	if opts != nil && len(opts.Teams) > 0 {
-		return nil, backend.ErrTeamsNotSupported
+	if opts == nil {
+		opts = &backend.CreateStackOptions{}
+	}
+
+	if err := b.checkWritable("create stack " + stackRef.String()); err != nil {
+		return nil, err
 	}
 
 	localStackRef, err := b.getReference(stackRef)
@@ -699,12 +788,25 @@ func (b *localBackend) CreateStack(ctx context.Context, stackRef backend.StackRe
 		return nil, &backend.StackAlreadyExistsError{StackName: string(stackName)}
 	}
 
+	// TODO: This should load project config and pass it as the last parameter to GetEnvironmentTagsForCurrentStack.
+	tags, err := backend.GetEnvironmentTagsForCurrentStack(root, b.currentProject.Load(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting stack tags: %w", err)
+	}
+
+	// Write the metadata first, so that a stack never exists without it.
+	if len(tags) > 0 || len(opts.Teams) > 0 {
+		if err := b.saveStackMetadata(ctx, localStackRef, &stackMetadata{Tags: tags, Teams: opts.Teams}); err != nil {
+			return nil, err
+		}
+	}
+
 	_, err = b.saveStack(ctx, localStackRef, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	stack := newStack(localStackRef, b)
+	stack := newStack(localStackRef, tags, b)
 	b.d.Infof(diag.Message("", "Created stack '%s'"), stack.Ref())
 
 	return stack, nil
@@ -724,39 +826,66 @@ func (b *localBackend) GetStack(ctx context.Context, stackRef backend.StackRefer
 		return nil, err
 	}
 
-	return newStack(localStackRef, b), nil
+	meta, err := b.getStackMetadata(ctx, localStackRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStack(localStackRef, meta.Tags, b), nil
 }
 
 func (b *localBackend) ListStacks(
-	ctx context.Context, filter backend.ListStacksFilter, _ backend.ContinuationToken) (
+	ctx context.Context, filter backend.ListStacksFilter, inContToken backend.ContinuationToken) (
 	[]backend.StackSummary, backend.ContinuationToken, error,
 ) {
-	stacks, err := b.getLocalStacks(ctx)
+	pageToken := blob.FirstPageToken
+	if inContToken != nil {
+		var err error
+		if pageToken, err = base64.RawURLEncoding.DecodeString(*inContToken); err != nil {
+			return nil, nil, fmt.Errorf("invalid continuation token: %w", err)
+		}
+	}
+
+	var project tokens.Name
+	if filter.Project != nil {
+		project = tokens.Name(*filter.Project)
+	}
+	stacks, nextPageToken, err := b.store.ListReferencesPage(ctx, project, pageToken, b.listPageSize())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Note that the provided stack filter is only partially honored, since fields like organizations and tags
-	// aren't persisted in the local backend.
-	results := slice.Prealloc[backend.StackSummary](len(stacks))
+	// Note that the provided stack filter is only partially honored, since organizations aren't persisted in the
+	// local backend.
+	refs := slice.Prealloc[*localBackendReference](len(stacks))
 	for _, stackRef := range stacks {
 		// We can check for project name filter here, but be careful about legacy stores where project is always blank.
 		stackProject, hasProject := stackRef.Project()
 		if filter.Project != nil && hasProject && string(stackProject) != *filter.Project {
 			continue
 		}
+		refs = append(refs, stackRef)
+	}
 
-		chk, err := b.getCheckpoint(ctx, stackRef)
-		if err != nil {
+	if filter.TagName != nil {
+		if refs, err = b.filterByTag(ctx, refs, filter); err != nil {
 			return nil, nil, err
 		}
-		results = append(results, newLocalStackSummary(stackRef, chk))
 	}
 
-	return results, nil, nil
+	var outContToken backend.ContinuationToken
+	if len(nextPageToken) > 0 {
+		token := base64.RawURLEncoding.EncodeToString(nextPageToken)
+		outContToken = &token
+	}
+	return newStackSummaryPage(ctx, b, refs).stackSummaries(), outContToken, nil
 }
 
 func (b *localBackend) RemoveStack(ctx context.Context, stack backend.Stack, force bool) (bool, error) {
+	if err := b.checkWritable("remove stack " + stack.Ref().String()); err != nil {
+		return false, err
+	}
+
 	localStackRef, err := b.getReference(stack.Ref())
 	if err != nil {
 		return false, err
@@ -784,6 +913,10 @@ func (b *localBackend) RemoveStack(ctx context.Context, stack backend.Stack, for
 func (b *localBackend) RenameStack(ctx context.Context, stack backend.Stack,
 	newName tokens.QName,
 ) (backend.StackReference, error) {
+	if err := b.checkWritable("rename stack " + stack.Ref().String()); err != nil {
+		return nil, err
+	}
+
 	localStackRef, err := b.getReference(stack.Ref())
 	if err != nil {
 		return nil, err
@@ -854,8 +987,15 @@ func (b *localBackend) renameStack(ctx context.Context, oldRef *localBackendRefe
 	// To remove the old stack, just make a backup of the file and don't write out anything new.
 	file := b.stackPath(ctx, oldRef)
 	backupTarget(ctx, b.bucket, file, false)
+	if err = b.removeJournal(ctx, oldRef); err != nil {
+		return err
+	}
+	b.forgetStateVersion(oldRef)
 
-	// And rename the history folder as well.
+	// Move the stack's metadata and history folder as well.
+	if err = b.renameStackMetadata(ctx, oldRef, newRef); err != nil {
+		return err
+	}
 	if err = b.renameHistory(ctx, oldRef, newRef); err != nil {
 		return err
 	}
@@ -898,6 +1038,10 @@ func (b *localBackend) Preview(ctx context.Context, stack backend.Stack,
 func (b *localBackend) Update(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("update stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	err := b.Lock(ctx, stack.Ref())
 	if err != nil {
 		return nil, result.FromError(err)
@@ -910,6 +1054,10 @@ func (b *localBackend) Update(ctx context.Context, stack backend.Stack,
 func (b *localBackend) Import(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation, imports []deploy.Import,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("import resources into stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	err := b.Lock(ctx, stack.Ref())
 	if err != nil {
 		return nil, result.FromError(err)
@@ -923,6 +1071,10 @@ func (b *localBackend) Import(ctx context.Context, stack backend.Stack,
 func (b *localBackend) Refresh(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("refresh stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	err := b.Lock(ctx, stack.Ref())
 	if err != nil {
 		return nil, result.FromError(err)
@@ -935,6 +1087,10 @@ func (b *localBackend) Refresh(ctx context.Context, stack backend.Stack,
 func (b *localBackend) Destroy(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("destroy stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	err := b.Lock(ctx, stack.Ref())
 	if err != nil {
 		return nil, result.FromError(err)
@@ -951,6 +1107,10 @@ func (b *localBackend) Query(ctx context.Context, op backend.QueryOperation) err
 func (b *localBackend) Watch(ctx context.Context, stk backend.Stack,
 	op backend.UpdateOperation, paths []string,
 ) result.Result {
+	if err := b.checkWritable("watch stack " + stk.Ref().String()); err != nil {
+		return result.FromError(err)
+	}
+
 	return backend.Watch(ctx, b, stk, op, b.apply, paths)
 }
 
@@ -978,12 +1138,46 @@ func (b *localBackend) apply(
 			colors.SpecHeadline+"%s (%s):"+colors.Reset+"\n"), actionLabel, stackRef)
 	}
 
+	// Like the service, use this opportunity to pick up any new tags from the environment and Pulumi.yaml.
+	if !opts.DryRun {
+		tags, err := backend.GetMergedStackTags(ctx, stack, op.Root, op.Proj, op.StackConfiguration.Config)
+		if err != nil {
+			return nil, nil, result.FromError(fmt.Errorf("getting stack tags: %w", err))
+		}
+		if len(tags) > 0 {
+			if err := b.updateStackTags(ctx, localStackRef, tags); err != nil {
+				return nil, nil, result.FromError(err)
+			}
+		}
+	}
+
 	// Start the update.
 	update, err := b.newUpdate(ctx, op.SecretsProvider, localStackRef, op)
 	if err != nil {
 		return nil, nil, result.FromError(err)
 	}
 
+	// Let the backend's webhooks know the update has started.
+	var hooks []webhook
+	var payload webhookPayload
+	if !opts.DryRun {
+		if hooks, err = b.getWebhooks(ctx, localStackRef.project); err != nil {
+			b.d.Warningf(diag.Message("", "Not calling webhooks: %v"), err)
+		}
+		user, _, _, _ := b.CurrentUser()
+		payload = webhookPayload{
+			Event:        webhookUpdateStarted,
+			Organization: "organization",
+			Project:      localStackRef.project,
+			Stack:        localStackRef.name.String(),
+			Kind:         kind,
+			User:         user,
+			Message:      op.M.Message,
+			StartTime:    time.Now().Unix(),
+		}
+		b.sendWebhooks(ctx, hooks, payload)
+	}
+
 	// Spawn a display loop to show events on the CLI.
 	displayEvents := make(chan engine.Event)
 	displayDone := make(chan bool)
@@ -1047,6 +1241,11 @@ func (b *localBackend) apply(
 	scope.Close() // Don't take any cancellations anymore, we're shutting down.
 	close(engineEvents)
 	err = manager.Close()
+	if err == nil {
+		// Fold the journal written during the update back into the checkpoint file, so the history and backup copies
+		// taken below see the final state.
+		err = persister.Compact()
+	}
 	// Historically we ignored this error (using IgnoreClose so it would log to the V11 log).
 	// To minimize the immediate blast radius of this to start with we're just going to write an error to the user.
 	if err != nil {
@@ -1081,6 +1280,13 @@ func (b *localBackend) apply(
 	if !opts.DryRun {
 		saveErr = b.addToHistory(ctx, localStackRef, info)
 		backupErr = b.backupStack(ctx, localStackRef)
+
+		payload.Event = webhookUpdateSucceeded
+		if updateRes != nil {
+			payload.Event = webhookUpdateFailed
+		}
+		payload.Result, payload.EndTime, payload.Changes = backendUpdateResult, end, changes
+		b.sendWebhooks(ctx, hooks, payload)
 	}
 
 	if updateRes != nil {
@@ -1221,9 +1427,44 @@ func (b *localBackend) ExportDeployment(ctx context.Context,
 	}, nil
 }
 
+func (b *localBackend) ExportDeploymentForVersion(
+	ctx context.Context,
+	stk backend.Stack,
+	version string,
+) (*apitype.UntypedDeployment, error) {
+	localStackRef, err := b.getReference(stk.Ref())
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := strconv.Atoi(version)
+	if err != nil || v < 1 {
+		return nil, fmt.Errorf("%q is not a valid stack version", version)
+	}
+
+	chk, err := b.getHistoricalCheckpoint(ctx, localStackRef, v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	data, err := encoding.JSON.Marshal(chk.Latest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apitype.UntypedDeployment{
+		Version:    3,
+		Deployment: json.RawMessage(data),
+	}, nil
+}
+
 func (b *localBackend) ImportDeployment(ctx context.Context, stk backend.Stack,
 	deployment *apitype.UntypedDeployment,
 ) error {
+	if err := b.checkWritable("import a deployment into stack " + stk.Ref().String()); err != nil {
+		return err
+	}
+
 	localStackRef, err := b.getReference(stk.Ref())
 	if err != nil {
 		return err
@@ -1245,6 +1486,36 @@ func (b *localBackend) ImportDeployment(ctx context.Context, stk backend.Stack,
 	return err
 }
 
+// ImportUpdate imports the given deployment into the indicated stack and records it in the stack's history as the
+// given update.
+func (b *localBackend) ImportUpdate(ctx context.Context, stk backend.Stack,
+	update backend.UpdateInfo, deployment *apitype.UntypedDeployment,
+) error {
+	if err := b.checkWritable("import an update into stack " + stk.Ref().String()); err != nil {
+		return err
+	}
+
+	localStackRef, err := b.getReference(stk.Ref())
+	if err != nil {
+		return err
+	}
+
+	err = b.Lock(ctx, localStackRef)
+	if err != nil {
+		return err
+	}
+	defer b.Unlock(ctx, localStackRef)
+
+	chk, err := stack.MarshalUntypedDeploymentToVersionedCheckpoint(localStackRef.FullyQualifiedName(), deployment)
+	if err != nil {
+		return err
+	}
+	if _, _, err = b.saveCheckpoint(ctx, localStackRef, chk); err != nil {
+		return err
+	}
+	return b.addToHistory(ctx, localStackRef, update)
+}
+
 func (b *localBackend) CurrentUser() (string, []string, *workspace.TokenInformation, error) {
 	user, err := user.Current()
 	if err != nil {
@@ -1253,19 +1524,33 @@ func (b *localBackend) CurrentUser() (string, []string, *workspace.TokenInformat
 	return user.Username, nil, nil, nil
 }
 
-func (b *localBackend) getLocalStacks(ctx context.Context) ([]*localBackendReference, error) {
-	return b.store.ListReferences(ctx)
-}
-
 // UpdateStackTags updates the stacks's tags, replacing all existing tags.
 func (b *localBackend) UpdateStackTags(ctx context.Context,
 	stack backend.Stack, tags map[apitype.StackTagName]string,
 ) error {
-	// The local backend does not currently persist tags.
-	return errors.New("stack tags not supported in --local mode")
+	if err := b.checkWritable("update the tags of stack " + stack.Ref().String()); err != nil {
+		return err
+	}
+
+	localStackRef, err := b.getReference(stack.Ref())
+	if err != nil {
+		return err
+	}
+
+	err = b.Lock(ctx, localStackRef)
+	if err != nil {
+		return err
+	}
+	defer b.Unlock(ctx, localStackRef)
+
+	return b.updateStackTags(ctx, localStackRef, tags)
 }
 
 func (b *localBackend) CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error {
+	if err := b.checkWritable("cancel the current update of stack " + stackRef.String()); err != nil {
+		return err
+	}
+
 	// Try to delete ALL the lock files
 	allFiles, err := listBucket(ctx, b.bucket, stackLockDir(stackRef.FullyQualifiedName()))
 	if err != nil {
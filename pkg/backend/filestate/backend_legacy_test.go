@@ -338,13 +338,13 @@ func TestHtmlEscaping_legacy(t *testing.T) {
 	assert.Contains(t, state, "<html@tags>")
 }
 
-func TestLocalBackendRejectsStackInitOptions_legacy(t *testing.T) {
+func TestLocalBackendRecordsStackTeams_legacy(t *testing.T) {
 	t.Parallel()
 
-	// Here, we provide options that illegally specify a team on a
-	// backend that does not support teams. We expect this to create
-	// an error later when we call CreateStack.
-	illegalOptions := &backend.CreateStackOptions{Teams: []string{"red-team"}}
+	// Here, we provide options that specify a team. The local backend
+	// doesn't control access to stacks, but records the teams in the
+	// stack's metadata.
+	teamOptions := &backend.CreateStackOptions{Teams: []string{"red-team"}}
 
 	// • Create a mock local backend
 	tmpDir := markLegacyStore(t, t.TempDir())
@@ -356,8 +356,13 @@ func TestLocalBackendRejectsStackInitOptions_legacy(t *testing.T) {
 	// • Simulate `pulumi stack init`, passing non-nil init options
 	fakeStackRef, err := local.ParseStackReference("foobar")
 	assert.NoError(t, err)
-	_, err = local.CreateStack(ctx, fakeStackRef, "", illegalOptions)
-	assert.ErrorIs(t, err, backend.ErrTeamsNotSupported)
+	_, err = local.CreateStack(ctx, fakeStackRef, "", teamOptions)
+	require.NoError(t, err)
+
+	lb := local.(*localBackend)
+	meta, err := lb.getStackMetadata(ctx, fakeStackRef.(*localBackendReference))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"red-team"}, meta.Teams)
 }
 
 // markLegacyStore marks the given directory as a legacy store.
@@ -553,12 +553,12 @@ func TestHtmlEscaping(t *testing.T) {
 	assert.Contains(t, state, "<html@tags>")
 }
 
-func TestLocalBackendRejectsStackInitOptions(t *testing.T) {
+func TestLocalBackendRecordsStackTeams(t *testing.T) {
 	t.Parallel()
-	// Here, we provide options that illegally specify a team on a
-	// backend that does not support teams. We expect this to create
-	// an error later when we call CreateStack.
-	illegalOptions := &backend.CreateStackOptions{Teams: []string{"red-team"}}
+	// Here, we provide options that specify a team. The local backend
+	// doesn't control access to stacks, but records the teams in the
+	// stack's metadata.
+	teamOptions := &backend.CreateStackOptions{Teams: []string{"red-team"}}
 
 	// • Create a mock local backend
 	tmpDir := t.TempDir()
@@ -570,8 +570,13 @@ func TestLocalBackendRejectsStackInitOptions(t *testing.T) {
 	// • Simulate `pulumi stack init`, passing non-nil init options
 	fakeStackRef, err := local.ParseStackReference("organization/b/foobar")
 	assert.NoError(t, err)
-	_, err = local.CreateStack(ctx, fakeStackRef, "", illegalOptions)
-	assert.ErrorIs(t, err, backend.ErrTeamsNotSupported)
+	_, err = local.CreateStack(ctx, fakeStackRef, "", teamOptions)
+	require.NoError(t, err)
+
+	lb := local.(*localBackend)
+	meta, err := lb.getStackMetadata(ctx, fakeStackRef.(*localBackendReference))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"red-team"}, meta.Teams)
 }
 
 func TestLegacyFolderStructure(t *testing.T) {
@@ -1306,6 +1311,34 @@ func TestCreateStack_gzip(t *testing.T) {
 	assert.FileExists(t, filepath.Join(stateDir, ".pulumi", "stacks", "testproj", "foo.json.gz"))
 }
 
+func TestCreateStack_zstd(t *testing.T) {
+	t.Parallel()
+
+	stateDir := t.TempDir()
+	ctx := context.Background()
+
+	s := make(env.MapStore)
+	s[env.SelfManagedCompression.Var().Name()] = "zstd"
+
+	b, err := newLocalBackend(
+		ctx,
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(stateDir),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(s)},
+	)
+	require.NoError(t, err)
+
+	fooRef, err := b.ParseStackReference("foo")
+	require.NoError(t, err)
+
+	_, err = b.CreateStack(ctx, fooRef, "", nil)
+	require.NoError(t, err)
+
+	// With PULUMI_SELF_MANAGED_STATE_COMPRESSION=zstd,
+	// we'll store state into zstd compressed files.
+	assert.FileExists(t, filepath.Join(stateDir, ".pulumi", "stacks", "testproj", "foo.json.zst"))
+}
+
 func TestCreateStack_retainCheckpoints(t *testing.T) {
 	t.Parallel()
 
@@ -1396,3 +1429,96 @@ func TestDisableIntegrityChecking(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, snap)
 }
+
+func TestHistory_versionsAndLimit(t *testing.T) {
+	t.Parallel()
+
+	stateDir := t.TempDir()
+	ctx := context.Background()
+
+	s := make(env.MapStore)
+	s[env.SelfManagedHistoryLimit.Var().Name()] = "2"
+
+	b, err := newLocalBackend(
+		ctx,
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(stateDir),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(s)},
+	)
+	require.NoError(t, err)
+
+	fooRef, err := b.parseStackReference("foo")
+	require.NoError(t, err)
+	fooStack, err := b.CreateStack(ctx, fooRef, "", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		err = b.addToHistory(ctx, fooRef, backend.UpdateInfo{Kind: apitype.UpdateUpdate})
+		require.NoError(t, err)
+	}
+
+	// Only the two most recent updates are kept, but they retain their version numbers.
+	history, err := b.GetHistory(ctx, fooRef, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 3, history[0].Version)
+	assert.Equal(t, 2, history[1].Version)
+
+	files, err := listBucket(ctx, b.bucket, fooRef.HistoryDir())
+	require.NoError(t, err)
+	assert.Len(t, files, 4, "expected a history and a checkpoint file per update")
+
+	dep, err := b.ExportDeploymentForVersion(ctx, fooStack, "3")
+	require.NoError(t, err)
+	assert.Equal(t, 3, dep.Version)
+
+	_, err = b.ExportDeploymentForVersion(ctx, fooStack, "1")
+	assert.ErrorContains(t, err, "has no update with version 1")
+
+	_, err = b.ExportDeploymentForVersion(ctx, fooStack, "latest")
+	assert.ErrorContains(t, err, `"latest" is not a valid stack version`)
+}
+
+func TestHistory_unversionedEntries(t *testing.T) {
+	t.Parallel()
+
+	stateDir := t.TempDir()
+	ctx := context.Background()
+
+	b, err := newLocalBackend(
+		ctx,
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(stateDir),
+		&workspace.Project{Name: "testproj"}, nil,
+	)
+	require.NoError(t, err)
+
+	fooRef, err := b.parseStackReference("foo")
+	require.NoError(t, err)
+	_, err = b.CreateStack(ctx, fooRef, "", nil)
+	require.NoError(t, err)
+
+	// Older versions of the CLI didn't record a version in history files.
+	err = b.bucket.WriteAll(ctx,
+		path.Join(fooRef.HistoryDir(), "foo-1.history.json"), []byte(`{"kind":"update"}`), nil)
+	require.NoError(t, err)
+
+	err = b.addToHistory(ctx, fooRef, backend.UpdateInfo{Kind: apitype.RefreshUpdate})
+	require.NoError(t, err)
+
+	history, err := b.GetHistory(ctx, fooRef, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, apitype.RefreshUpdate, history[0].Kind)
+	assert.Equal(t, 2, history[0].Version)
+	assert.Equal(t, 1, history[1].Version)
+}
+
+func TestIsCloudBucketURL(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isCloudBucketURL("s3://bucket/path"))
+	assert.True(t, isCloudBucketURL("azblob://container"))
+	assert.True(t, isCloudBucketURL("gs://bucket"))
+	assert.False(t, isCloudBucketURL("file:///tmp/state"))
+	assert.False(t, isCloudBucketURL("mem://"))
+}
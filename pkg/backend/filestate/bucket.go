@@ -1,6 +1,7 @@
 package filestate
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"gocloud.dev/blob"
+	"gocloud.dev/blob/driver"
 )
 
 // Bucket is a wrapper around an underlying gocloud blob.Bucket.  It ensures that we pass all paths
@@ -18,10 +20,16 @@ type Bucket interface {
 	Copy(ctx context.Context, dstKey, srcKey string, opts *blob.CopyOptions) (err error)
 	Delete(ctx context.Context, key string) (err error)
 	List(opts *blob.ListOptions) *blob.ListIterator
+	ListPage(
+		ctx context.Context, pageToken []byte, pageSize int, opts *blob.ListOptions,
+	) ([]*blob.ListObject, []byte, error)
 	SignedURL(ctx context.Context, key string, opts *blob.SignedURLOptions) (string, error)
 	ReadAll(ctx context.Context, key string) (_ []byte, err error)
 	WriteAll(ctx context.Context, key string, p []byte, opts *blob.WriterOptions) (err error)
 	Exists(ctx context.Context, key string) (bool, error)
+	Attributes(ctx context.Context, key string) (*blob.Attributes, error)
+	NewReader(ctx context.Context, key string, opts *blob.ReaderOptions) (*blob.Reader, error)
+	NewWriter(ctx context.Context, key string, opts *blob.WriterOptions) (*blob.Writer, error)
 }
 
 // wrappedBucket encapsulates a true gocloud blob.Bucket, but ensures that all paths we send to it
@@ -46,6 +54,14 @@ func (b *wrappedBucket) List(opts *blob.ListOptions) *blob.ListIterator {
 	return b.bucket.List(&optsCopy)
 }
 
+func (b *wrappedBucket) ListPage(
+	ctx context.Context, pageToken []byte, pageSize int, opts *blob.ListOptions,
+) ([]*blob.ListObject, []byte, error) {
+	optsCopy := *opts
+	optsCopy.Prefix = filepath.ToSlash(opts.Prefix)
+	return b.bucket.ListPage(ctx, pageToken, pageSize, &optsCopy)
+}
+
 func (b *wrappedBucket) SignedURL(ctx context.Context, key string, opts *blob.SignedURLOptions) (string, error) {
 	return b.bucket.SignedURL(ctx, filepath.ToSlash(key), opts)
 }
@@ -62,6 +78,18 @@ func (b *wrappedBucket) Exists(ctx context.Context, key string) (bool, error) {
 	return b.bucket.Exists(ctx, filepath.ToSlash(key))
 }
 
+func (b *wrappedBucket) Attributes(ctx context.Context, key string) (*blob.Attributes, error) {
+	return b.bucket.Attributes(ctx, filepath.ToSlash(key))
+}
+
+func (b *wrappedBucket) NewReader(ctx context.Context, key string, opts *blob.ReaderOptions) (*blob.Reader, error) {
+	return b.bucket.NewReader(ctx, filepath.ToSlash(key), opts)
+}
+
+func (b *wrappedBucket) NewWriter(ctx context.Context, key string, opts *blob.WriterOptions) (*blob.Writer, error) {
+	return b.bucket.NewWriter(ctx, filepath.ToSlash(key), opts)
+}
+
 // listBucket returns a list of all files in the bucket within a given directory. go-cloud sorts the results by key
 func listBucket(ctx context.Context, bucket Bucket, dir string) ([]*blob.ListObject, error) {
 	bucketIter := bucket.List(&blob.ListOptions{
@@ -109,3 +137,48 @@ func removeAllByPrefix(ctx context.Context, bucket Bucket, dir string) error {
 
 	return nil
 }
+
+// newBufferedReader returns a reader for the given range of an object whose content has already been read in full.
+// A negative length reads to the end of the object.
+func newBufferedReader(content []byte, offset, length int64, attrs driver.ReaderAttributes) driver.Reader {
+	attrs.Size = int64(len(content))
+	if offset > attrs.Size {
+		offset = attrs.Size
+	}
+	content = content[offset:]
+	if length >= 0 && length < int64(len(content)) {
+		content = content[:length]
+	}
+	return &bufferedReader{Reader: bytes.NewReader(content), attrs: attrs}
+}
+
+// bufferedReader serves the content of an object that has already been read in full, for drivers whose stores
+// can't stream objects.
+type bufferedReader struct {
+	*bytes.Reader
+
+	attrs driver.ReaderAttributes
+}
+
+func (r *bufferedReader) Close() error                         { return nil }
+func (r *bufferedReader) Attributes() *driver.ReaderAttributes { return &r.attrs }
+func (r *bufferedReader) As(interface{}) bool                  { return false }
+
+// bufferedWriter buffers the content of an object, and writes it in one go when closed. As with other drivers, the
+// write is abandoned if the context is canceled before the writer is closed.
+type bufferedWriter struct {
+	ctx   context.Context
+	write func(ctx context.Context, content []byte) error
+	buf   bytes.Buffer
+}
+
+func (w *bufferedWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *bufferedWriter) Close() error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	return w.write(w.ctx, w.buf.Bytes())
+}
@@ -0,0 +1,241 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"gocloud.dev/blob"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// checksumMetadataKey is the blob metadata key under which the SHA-256 hash of a checkpoint file's content is
+// recorded. Buckets that don't support metadata simply don't record it, and their checkpoints aren't verified.
+const checksumMetadataKey = "pulumi-sha256"
+
+// CorruptCheckpointError is returned when a stack's checkpoint file doesn't match the checksum recorded when it was
+// written, or is too damaged to be read.
+type CorruptCheckpointError struct {
+	// Stack is the name of the stack.
+	Stack string
+	// File is the checkpoint file that is corrupted.
+	File string
+	// Backup is the most recent valid backup of the checkpoint, if one was found.
+	Backup string
+	// Err describes the corruption.
+	Err error
+}
+
+func (e *CorruptCheckpointError) Error() string {
+	msg := fmt.Sprintf("the checkpoint for stack %s in %s is corrupted: %v", e.Stack, e.File, e.Err)
+	if e.Backup == "" {
+		return msg + "; no valid backup was found"
+	}
+	return fmt.Sprintf("%s; the most recent valid backup is %s, set %s=true to restore the stack from it",
+		msg, e.Backup, env.SelfManagedRestoreCorrupted.Var().Name())
+}
+
+func (e *CorruptCheckpointError) Unwrap() error {
+	return e.Err
+}
+
+// checkpointChecksum returns the hex-encoded SHA-256 hash of a checkpoint file's content.
+func checkpointChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// withChecksum returns a copy of the given writer options that records the checksum of the given content.
+func withChecksum(opts *blob.WriterOptions, content []byte) *blob.WriterOptions {
+	return withChecksumValue(opts, checkpointChecksum(content))
+}
+
+// withChecksumValue returns a copy of the given writer options that records the given hex-encoded SHA-256 hash as
+// the checksum of the content written.
+func withChecksumValue(opts *blob.WriterOptions, checksum string) *blob.WriterOptions {
+	var result blob.WriterOptions
+	if opts != nil {
+		result = *opts
+	}
+	metadata := make(map[string]string, len(result.Metadata)+1)
+	for k, v := range result.Metadata {
+		metadata[k] = v
+	}
+	metadata[checksumMetadataKey] = checksum
+	result.Metadata = metadata
+	return &result
+}
+
+// verifyChecksum checks the content read from the given file against the checksum recorded when it was written, if
+// any.
+func (b *localBackend) verifyChecksum(ctx context.Context, file string, content []byte) error {
+	attrs, err := b.bucket.Attributes(ctx, file)
+	if err != nil {
+		// Not being able to verify the checksum is no reason to refuse to read the file.
+		logging.V(5).Infof("error reading attributes of %s, skipping checksum verification: %v", file, err)
+		return nil
+	}
+	expected := attrs.Metadata[checksumMetadataKey]
+	if expected == "" {
+		return nil
+	}
+	if actual := checkpointChecksum(content); actual != expected {
+		return fmt.Errorf("its SHA-256 hash is %s but %s was recorded when it was written", actual, expected)
+	}
+	return nil
+}
+
+// isTruncated returns true if the given error from decoding a checkpoint shows that its content is damaged, rather
+// than, say, written by a newer version of the CLI.
+func isTruncated(err error) bool {
+	var syntaxErr *json.SyntaxError
+	return errors.As(err, &syntaxErr) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, gzip.ErrChecksum) ||
+		errors.Is(err, gzip.ErrHeader)
+}
+
+// decodeCheckpoint verifies and decodes the content of a checkpoint file. Corruption is reported as a
+// CorruptCheckpointError.
+func (b *localBackend) decodeCheckpoint(
+	ctx context.Context, ref *localBackendReference, file string, content []byte,
+) (*apitype.CheckpointV3, error) {
+	if err := b.verifyChecksum(ctx, file, content); err != nil {
+		return nil, &CorruptCheckpointError{Stack: ref.String(), File: file, Err: err}
+	}
+	content, err := decryptCheckpoint(ctx, content)
+	if err != nil {
+		if isTruncated(err) {
+			return nil, &CorruptCheckpointError{Stack: ref.String(), File: file, Err: err}
+		}
+		return nil, err
+	}
+	chk, err := stack.UnmarshalVersionedCheckpointToLatestCheckpoint(detectMarshaler(content), content)
+	if err != nil {
+		if isTruncated(err) {
+			return nil, &CorruptCheckpointError{Stack: ref.String(), File: file, Err: err}
+		}
+		return nil, err
+	}
+	return chk, nil
+}
+
+// checkpointBackups returns the files that may hold earlier copies of the given stack's checkpoint, most recently
+// written first: the .bak file written alongside the checkpoint, the backups taken before each update, and the
+// checkpoints saved in the stack's history.
+func (b *localBackend) checkpointBackups(ctx context.Context, ref *localBackendReference, file string) []string {
+	type candidate struct {
+		key     string
+		modTime time.Time
+	}
+	var candidates []candidate
+
+	for _, c := range stateCompressions {
+		bak := trimCompressionExt(file) + c.ext() + ".bak"
+		if attrs, err := b.bucket.Attributes(ctx, bak); err == nil {
+			candidates = append(candidates, candidate{bak, attrs.ModTime})
+		}
+	}
+
+	// Backups are named <stack>.<timestamp>.json[.gz|.zst].
+	if files, err := listBucket(ctx, b.bucket, ref.BackupDir()); err == nil {
+		for _, f := range files {
+			if !f.IsDir && strings.HasPrefix(path.Base(f.Key), ref.name.String()+".") {
+				candidates = append(candidates, candidate{f.Key, f.ModTime})
+			}
+		}
+	}
+
+	if history, err := b.listHistory(ctx, ref); err == nil {
+		for _, f := range history {
+			candidates = append(candidates, candidate{historyCheckpointFile(f.Key), f.ModTime})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].modTime.After(candidates[j].modTime)
+	})
+	keys := make([]string, len(candidates))
+	for i, c := range candidates {
+		keys[i] = c.key
+	}
+	return keys
+}
+
+// recoverCheckpoint handles a corrupted checkpoint file by looking for its most recent valid backup. If restoring
+// corrupted stacks is enabled, the backup replaces the checkpoint, and its content is returned; otherwise the
+// corruption is returned as an error naming the backup.
+func (b *localBackend) recoverCheckpoint(
+	ctx context.Context, ref *localBackendReference, corrupt *CorruptCheckpointError,
+) ([]byte, *apitype.CheckpointV3, error) {
+	var backup []byte
+	var chk *apitype.CheckpointV3
+	for _, file := range b.checkpointBackups(ctx, ref, corrupt.File) {
+		content, err := b.bucket.ReadAll(ctx, file)
+		if err != nil {
+			continue
+		}
+		if chk, err = b.decodeCheckpoint(ctx, ref, file, content); err != nil {
+			logging.V(5).Infof("skipping backup %s of stack %s: %v", file, ref, err)
+			continue
+		}
+		backup, corrupt.Backup = content, file
+		break
+	}
+	if corrupt.Backup == "" || !b.Env.GetBool(env.SelfManagedRestoreCorrupted) {
+		return nil, nil, corrupt
+	}
+
+	// Keep the corrupted file around for inspection, then rewrite the checkpoint from the backup. Any journal
+	// belongs to the corrupted checkpoint, so it has to go too.
+	if err := b.bucket.Copy(ctx, corrupt.File+".corrupt", corrupt.File, nil); err != nil {
+		logging.V(5).Infof("error copying %s aside: %v", corrupt.File, err)
+	}
+	if err := b.removeJournal(ctx, ref); err != nil {
+		return nil, nil, err
+	}
+	data, err := json.Marshal(chk)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, _, content, err := b.writeCheckpoint(ctx, ref, &apitype.VersionedCheckpoint{
+		Version:    apitype.DeploymentSchemaVersionCurrent,
+		Checkpoint: json.RawMessage(data),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("restoring stack %s from %s: %w", ref, corrupt.Backup, err)
+	}
+
+	b.d.Warningf(diag.Message("", "restored stack %s from %s, as its checkpoint was corrupted: %v"),
+		ref, corrupt.Backup, corrupt.Err)
+	logging.V(5).Infof("restored %s (%d bytes) from backup %s", corrupt.File, len(backup), corrupt.Backup)
+	return content, chk, nil
+}
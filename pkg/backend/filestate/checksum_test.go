@@ -0,0 +1,136 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+func TestCheckpointChecksum_recorded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+
+	file := b.stackPath(ctx, ref)
+	content, err := b.bucket.ReadAll(ctx, file)
+	require.NoError(t, err)
+	attrs, err := b.bucket.Attributes(ctx, file)
+	require.NoError(t, err)
+	assert.Equal(t, checkpointChecksum(content), attrs.Metadata[checksumMetadataKey])
+}
+
+// corruptCheckpoint saves two updates to the stack, so that there's a backup of its checkpoint, then flips a bit in
+// the checkpoint without updating its checksum.
+func corruptCheckpoint(t *testing.T, b *localBackend, ref *localBackendReference) string {
+	t.Helper()
+
+	ctx := context.Background()
+	sm := b64.NewBase64SecretsManager()
+	_, err := b.saveStack(ctx, ref, journalTestSnapshot(2, 0, "first"), sm)
+	require.NoError(t, err)
+	_, err = b.saveStack(ctx, ref, journalTestSnapshot(2, 0, "second"), sm)
+	require.NoError(t, err)
+
+	file := b.stackPath(ctx, ref)
+	attrs, err := b.bucket.Attributes(ctx, file)
+	require.NoError(t, err)
+	content, err := b.bucket.ReadAll(ctx, file)
+	require.NoError(t, err)
+
+	content[len(content)/2] ^= 0x01
+	err = b.bucket.WriteAll(ctx, file, content, &blob.WriterOptions{Metadata: attrs.Metadata})
+	require.NoError(t, err)
+	return file
+}
+
+func TestCheckpointChecksum_mismatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	file := corruptCheckpoint(t, b, ref)
+
+	_, err := b.getCheckpoint(ctx, ref)
+	var corrupt *CorruptCheckpointError
+	require.True(t, errors.As(err, &corrupt), "expected a CorruptCheckpointError, got %v", err)
+	assert.Equal(t, file, corrupt.File)
+	assert.Equal(t, file+".bak", corrupt.Backup)
+	assert.ErrorContains(t, err, "PULUMI_SELF_MANAGED_STATE_RESTORE_CORRUPTED=true")
+
+	// The corrupted checkpoint is left alone.
+	exists, err := b.bucket.Exists(ctx, file+".corrupt")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestCheckpointChecksum_restore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{
+		env.SelfManagedRestoreCorrupted.Var().Name(): "true",
+	})
+	file := corruptCheckpoint(t, b, ref)
+
+	// The .bak file holds the first update.
+	chk, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	require.Len(t, chk.Latest.Resources, 2)
+	assert.Equal(t, "first", chk.Latest.Resources[0].Inputs["input"])
+
+	exists, err := b.bucket.Exists(ctx, file+".corrupt")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// The restored checkpoint has a valid checksum, so reading it again doesn't restore anything.
+	err = b.bucket.Delete(ctx, file+".corrupt")
+	require.NoError(t, err)
+	chk, err = b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "first", chk.Latest.Resources[0].Inputs["input"])
+	exists, err = b.bucket.Exists(ctx, file+".corrupt")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestCheckpointChecksum_truncated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+
+	// Files written without a checksum are still checked for truncation.
+	file := b.stackPath(ctx, ref)
+	content, err := b.bucket.ReadAll(ctx, file)
+	require.NoError(t, err)
+	err = b.bucket.WriteAll(ctx, file, content[:len(content)/2], nil)
+	require.NoError(t, err)
+
+	_, err = b.getCheckpoint(ctx, ref)
+	var corrupt *CorruptCheckpointError
+	require.True(t, errors.As(err, &corrupt), "expected a CorruptCheckpointError, got %v", err)
+	assert.Empty(t, corrupt.Backup)
+	assert.ErrorContains(t, err, "no valid backup was found")
+}
@@ -0,0 +1,200 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"gocloud.dev/blob"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+)
+
+// zstdExt is the extension of state files compressed with zstd.
+const zstdExt = ".zst"
+
+// stateCompression is the compression format the filestate backend writes state files with. Files in any format are
+// always readable, regardless of the format we write.
+type stateCompression string
+
+const (
+	noCompression   stateCompression = "none"
+	gzipCompression stateCompression = "gzip"
+	zstdCompression stateCompression = "zstd"
+)
+
+// stateCompressions lists every supported compression format.
+var stateCompressions = []stateCompression{noCompression, gzipCompression, zstdCompression}
+
+// parseStateCompression parses the value of PULUMI_SELF_MANAGED_STATE_COMPRESSION.
+func parseStateCompression(s string) (stateCompression, error) {
+	for _, c := range stateCompressions {
+		if strings.EqualFold(s, string(c)) {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("unknown state compression %q; expected one of none, gzip or zstd", s)
+}
+
+// ext returns the extension added to the names of files written with this compression.
+func (c stateCompression) ext() string {
+	switch c {
+	case gzipCompression:
+		return encoding.GZIPExt
+	case zstdCompression:
+		return zstdExt
+	default:
+		return ""
+	}
+}
+
+// marshaler wraps m to compress and decompress with this compression.
+func (c stateCompression) marshaler(m encoding.Marshaler) encoding.Marshaler {
+	switch c {
+	case gzipCompression:
+		return encoding.Gzip(m)
+	case zstdCompression:
+		return zstdMarshaler{m}
+	default:
+		return m
+	}
+}
+
+// writerOptions returns the options to write files with this compression, so that buckets record the right content
+// type for them.
+func (c stateCompression) writerOptions() *blob.WriterOptions {
+	switch c {
+	case gzipCompression:
+		return &blob.WriterOptions{ContentType: "application/gzip"}
+	case zstdCompression:
+		return &blob.WriterOptions{ContentType: "application/zstd"}
+	default:
+		return &blob.WriterOptions{ContentType: "application/json"}
+	}
+}
+
+// writer returns a writer that compresses what's written to it with this compression before writing it to w. Closing
+// the writer flushes it, but doesn't close w.
+func (c stateCompression) writer(w io.Writer) (io.WriteCloser, error) {
+	switch c {
+	case gzipCompression:
+		return gzip.NewWriter(w), nil
+	case zstdCompression:
+		return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	default:
+		return nopWriteCloser{w}, nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// decompressReader returns a reader of the decompressed content of the JSON file read from r, detecting how it was
+// compressed in the same way as detectMarshaler. Files encrypted at rest have to be read whole to be decrypted, so
+// they're rejected with errCannotStream.
+func decompressReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	// A peek that comes up short just means the file is too small to be compressed or encrypted.
+	magic, _ := br.Peek(len(encryptedCheckpointMagic))
+	switch {
+	case isEncryptedCheckpoint(magic):
+		return nil, fmt.Errorf("%w: it's encrypted", errCannotStream)
+	case encoding.IsCompressed(magic):
+		return gzip.NewReader(br)
+	case isZstdCompressed(magic):
+		dec, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
+
+// trimCompressionExt removes the extension of any supported compression from the given file name.
+func trimCompressionExt(file string) string {
+	for _, c := range stateCompressions {
+		if ext := c.ext(); ext != "" && strings.HasSuffix(file, ext) {
+			return strings.TrimSuffix(file, ext)
+		}
+	}
+	return file
+}
+
+// isCompressionExt returns true if ext is the extension of a supported compression.
+func isCompressionExt(ext string) bool {
+	return ext != "" && trimCompressionExt(ext) == ""
+}
+
+// detectMarshaler returns the marshaler to read the given JSON file contents with, decompressing them if needed.
+func detectMarshaler(data []byte) encoding.Marshaler {
+	switch {
+	case encoding.IsCompressed(data):
+		return encoding.Gzip(encoding.JSON)
+	case isZstdCompressed(data):
+		return zstdMarshaler{encoding.JSON}
+	default:
+		return encoding.JSON
+	}
+}
+
+// zstdMagic is the magic number that starts every zstd frame.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// isZstdCompressed returns true if data is zstd compressed.
+func isZstdCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, zstdMagic)
+}
+
+// zstdMarshaler is a Marshaler that compresses the output of another Marshaler with zstd.
+type zstdMarshaler struct {
+	inner encoding.Marshaler
+}
+
+func (m zstdMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.inner.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	defer enc.Close()
+	return enc.EncodeAll(data, nil), nil
+}
+
+func (m zstdMarshaler) Unmarshal(data []byte, v interface{}) error {
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return err
+	}
+	defer dec.Close()
+	inflated, err := dec.DecodeAll(data, nil)
+	if err != nil {
+		return fmt.Errorf("decompressing zstd data: %w", err)
+	}
+	return m.inner.Unmarshal(inflated, v)
+}
@@ -0,0 +1,144 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestParseStateCompression(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range stateCompressions {
+		got, err := parseStateCompression(string(c))
+		require.NoError(t, err)
+		assert.Equal(t, c, got)
+	}
+
+	got, err := parseStateCompression("ZSTD")
+	require.NoError(t, err)
+	assert.Equal(t, zstdCompression, got)
+
+	_, err = parseStateCompression("brotli")
+	assert.ErrorContains(t, err, `unknown state compression "brotli"`)
+}
+
+func TestStateCompression_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]interface{}{"stack": "foo", "html": "<tags>"}
+	for _, c := range stateCompressions {
+		c := c
+		t.Run(string(c), func(t *testing.T) {
+			t.Parallel()
+
+			data, err := c.marshaler(encoding.JSON).Marshal(value)
+			require.NoError(t, err)
+			assert.Equal(t, c == zstdCompression, isZstdCompressed(data))
+			assert.Equal(t, c == gzipCompression, encoding.IsCompressed(data))
+
+			var got map[string]interface{}
+			require.NoError(t, detectMarshaler(data).Unmarshal(data, &got))
+			assert.Equal(t, value, got)
+		})
+	}
+}
+
+func TestTrimCompressionExt(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "foo.json", trimCompressionExt("foo.json"))
+	assert.Equal(t, "foo.json", trimCompressionExt("foo.json.gz"))
+	assert.Equal(t, "foo.json", trimCompressionExt("foo.json.zst"))
+	assert.Equal(t, "foo.json.bak", trimCompressionExt("foo.json.bak"))
+	assert.True(t, isCompressionExt(".zst"))
+	assert.False(t, isCompressionExt(".json"))
+	assert.False(t, isCompressionExt(""))
+}
+
+func TestInvalidStateCompression(t *testing.T) {
+	t.Parallel()
+
+	s := make(env.MapStore)
+	s[env.SelfManagedCompression.Var().Name()] = "lz4"
+
+	_, err := newLocalBackend(
+		context.Background(),
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(t.TempDir()),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(s)},
+	)
+	assert.ErrorContains(t, err, `unknown state compression "lz4"`)
+}
+
+// Tests that state written with one compression can be read, and is then replaced, by a backend configured with
+// another.
+func TestStateCompression_switch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateDir := t.TempDir()
+	stacksDir := filepath.Join(stateDir, ".pulumi", "stacks", "testproj")
+
+	newBackend := func(compression stateCompression) *localBackend {
+		s := make(env.MapStore)
+		s[env.SelfManagedCompression.Var().Name()] = string(compression)
+		b, err := newLocalBackend(
+			ctx,
+			diagtest.LogSink(t), "file://"+filepath.ToSlash(stateDir),
+			&workspace.Project{Name: "testproj"},
+			&localBackendOptions{Env: env.NewEnv(s)},
+		)
+		require.NoError(t, err)
+		return b
+	}
+
+	gz := newBackend(gzipCompression)
+	ref, err := gz.ParseStackReference("foo")
+	require.NoError(t, err)
+	_, err = gz.CreateStack(ctx, ref, "", nil)
+	require.NoError(t, err)
+	require.NoError(t, gz.newSnapshotPersister(ctx, ref.(*localBackendReference)).Save(journalTestSnapshot(2, -1, "")))
+	assert.FileExists(t, filepath.Join(stacksDir, "foo.json.gz"))
+
+	zst := newBackend(zstdCompression)
+	ref, err = zst.ParseStackReference("foo")
+	require.NoError(t, err)
+	lref := ref.(*localBackendReference)
+
+	chk, err := zst.getCheckpoint(ctx, lref)
+	require.NoError(t, err)
+	assert.Len(t, chk.Latest.Resources, 2)
+
+	require.NoError(t, zst.newSnapshotPersister(ctx, lref).Save(journalTestSnapshot(3, -1, "")))
+	assert.FileExists(t, filepath.Join(stacksDir, "foo.json.zst"))
+	assert.NoFileExists(t, filepath.Join(stacksDir, "foo.json.gz"))
+
+	// A backend writing uncompressed state still reads the zstd file.
+	plain := newBackend(noCompression)
+	chk, err = plain.getCheckpoint(ctx, lref)
+	require.NoError(t, err)
+	assert.Len(t, chk.Latest.Resources, 3)
+}
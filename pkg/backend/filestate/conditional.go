@@ -0,0 +1,238 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	azblobblob "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// ConflictError is returned when a write to the state of a stack is refused because the state changed since this
+// backend last read or wrote it, as happens when two updates of a stack run at once without being locked out of each
+// other.
+type ConflictError struct {
+	// Stack is the name of the stack.
+	Stack string
+	// File is the checkpoint file that changed.
+	File string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("the state of stack %s in %s was changed by another update while this one was running",
+		e.Stack, e.File)
+}
+
+// stateVersion identifies the state of a stack that a backend last read or wrote.
+type stateVersion struct {
+	// checksum is the checksum of the content of the stack's checkpoint file.
+	checksum string
+	// entries is the number of journal entries written against that checkpoint file.
+	entries int
+}
+
+// recordStateVersion records the checksum of the content of the stack's checkpoint file that this backend last read
+// or wrote, and the number of journal entries written against it. Writes to the stack's state are only made while it
+// is still in that state.
+func (b *localBackend) recordStateVersion(ref *localBackendReference, checksum string, entries int) {
+	b.stateVersions.Store(ref.FullyQualifiedName(), stateVersion{checksum: checksum, entries: entries})
+}
+
+// recordJournalEntry records that this backend wrote the journal entry with the given sequence number against the
+// version of the stack's checkpoint file it last read or wrote.
+func (b *localBackend) recordJournalEntry(ref *localBackendReference, sequence int) {
+	if v, ok := b.stateVersions.Load(ref.FullyQualifiedName()); ok {
+		version := v.(stateVersion)
+		version.entries = sequence
+		b.stateVersions.Store(ref.FullyQualifiedName(), version)
+	}
+}
+
+// forgetStateVersion forgets the version of the stack's checkpoint file, as when the stack is removed.
+func (b *localBackend) forgetStateVersion(ref *localBackendReference) {
+	b.stateVersions.Delete(ref.FullyQualifiedName())
+}
+
+// checkStateVersion checks that the stack's checkpoint file hasn't changed since this backend last read or wrote it.
+// It returns the file and its attributes, or nil attributes if there's no version to check against.
+func (b *localBackend) checkStateVersion(
+	ctx context.Context, ref *localBackendReference,
+) (file string, _ *blob.Attributes, _ error) {
+	file = b.stackPath(ctx, ref)
+	v, ok := b.stateVersions.Load(ref.FullyQualifiedName())
+	if !ok {
+		return file, nil, nil
+	}
+
+	attrs, err := b.bucket.Attributes(ctx, file)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return file, nil, &ConflictError{Stack: ref.String(), File: file}
+		}
+		return file, nil, fmt.Errorf("reading attributes of %s: %w", file, err)
+	}
+	// Buckets that don't support metadata don't record checksums, so only their own preconditions apply.
+	if actual := attrs.Metadata[checksumMetadataKey]; actual != "" && actual != v.(stateVersion).checksum {
+		return file, nil, &ConflictError{Stack: ref.String(), File: file}
+	}
+	return file, attrs, nil
+}
+
+// withStateVersion checks that the stack's checkpoint file hasn't changed since this backend last read or wrote it,
+// and returns a copy of the given writer options that makes writing the checkpoint to file fail if it changes before
+// the write is made.
+func (b *localBackend) withStateVersion(
+	ctx context.Context, ref *localBackendReference, file string, opts *blob.WriterOptions,
+) (*blob.WriterOptions, error) {
+	current, attrs, err := b.checkStateVersion(ctx, ref)
+	if err != nil || attrs == nil {
+		return opts, err
+	}
+	if current != file {
+		// The checkpoint is moving to a file in another compression, which mustn't have been written in between.
+		attrs = nil
+	}
+	return withPrecondition(opts, attrs), nil
+}
+
+// conflictError returns the error for a write of content with the given checksum to the stack's checkpoint file that
+// was refused by its precondition. If the file already holds that content, as when a write that succeeded is retried,
+// the write is taken to have been made and nil is returned.
+func (b *localBackend) conflictError(ctx context.Context, ref *localBackendReference, file, checksum string) error {
+	if attrs, err := b.bucket.Attributes(ctx, file); err == nil && attrs.Metadata[checksumMetadataKey] == checksum {
+		return nil
+	}
+	return &ConflictError{Stack: ref.String(), File: file}
+}
+
+// journalEntryOptions checks that the stack's state hasn't changed since this backend last read or wrote it, and
+// returns a copy of the given writer options that makes writing the journal entry with the given sequence number to
+// file fail if another update writes an entry there first. An entry left behind by an earlier checkpoint may be
+// replaced.
+func (b *localBackend) journalEntryOptions(
+	ctx context.Context, ref *localBackendReference, j *checkpointJournal, sequence int, file string,
+	opts *blob.WriterOptions,
+) (*blob.WriterOptions, error) {
+	if _, _, err := b.checkStateVersion(ctx, ref); err != nil {
+		return nil, err
+	}
+	// Entries we didn't see were written by another update, as when resuming a journal that's still being written.
+	if v, ok := b.stateVersions.Load(ref.FullyQualifiedName()); ok && v.(stateVersion).entries != sequence-1 {
+		return nil, &ConflictError{Stack: ref.String(), File: file}
+	}
+
+	attrs, err := b.bucket.Attributes(ctx, file)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return withPrecondition(opts, nil), nil
+		}
+		return nil, fmt.Errorf("reading attributes of %s: %w", file, err)
+	}
+	entry, err := b.readJournalEntry(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil && entry.Base == j.base {
+		return nil, &ConflictError{Stack: ref.String(), File: file}
+	}
+	return withPrecondition(opts, attrs), nil
+}
+
+// withPrecondition returns a copy of the given writer options that makes the write fail unless the object written
+// still has the given attributes, or doesn't exist if attrs is nil. Buckets without conditional writes, like the
+// local filesystem, write unconditionally.
+func withPrecondition(opts *blob.WriterOptions, attrs *blob.Attributes) *blob.WriterOptions {
+	var result blob.WriterOptions
+	if opts != nil {
+		result = *opts
+	}
+	beforeWrite := result.BeforeWrite
+	result.BeforeWrite = func(asFunc func(interface{}) bool) error {
+		if beforeWrite != nil {
+			if err := beforeWrite(asFunc); err != nil {
+				return err
+			}
+		}
+
+		// Amazon S3
+		var uploader *s3manager.Uploader
+		if asFunc(&uploader) {
+			header, value := "If-None-Match", "*"
+			if attrs != nil {
+				header, value = "If-Match", attrs.ETag
+			}
+			uploader.RequestOptions = append(uploader.RequestOptions, func(r *request.Request) {
+				r.HTTPRequest.Header.Set(header, value)
+			})
+			return nil
+		}
+
+		// Google Cloud Storage, which tracks versions by generation rather than entity tag.
+		var obj **storage.ObjectHandle
+		if asFunc(&obj) {
+			conds := storage.Conditions{DoesNotExist: true}
+			var objAttrs storage.ObjectAttrs
+			if attrs != nil && attrs.As(&objAttrs) {
+				conds = storage.Conditions{GenerationMatch: objAttrs.Generation}
+			}
+			*obj = (*obj).If(conds)
+			return nil
+		}
+
+		// Azure Blob Storage
+		var uploadOpts *azblob.UploadStreamOptions
+		if asFunc(&uploadOpts) {
+			conds := &azblobblob.ModifiedAccessConditions{}
+			if attrs != nil {
+				etag := azcore.ETag(attrs.ETag)
+				conds.IfMatch = &etag
+			} else {
+				anyETag := azcore.ETagAny
+				conds.IfNoneMatch = &anyETag
+			}
+			uploadOpts.AccessConditions = &azblobblob.AccessConditions{ModifiedAccessConditions: conds}
+		}
+		return nil
+	}
+	return &result
+}
+
+// isPreconditionFailed returns true if the given error from a write shows that it was refused by a precondition set
+// with withPrecondition.
+func isPreconditionFailed(err error) bool {
+	if gcerrors.Code(err) == gcerrors.FailedPrecondition {
+		return true
+	}
+	var awsErr awserr.RequestFailure
+	if errors.As(err, &awsErr) {
+		return awsErr.StatusCode() == http.StatusPreconditionFailed || awsErr.StatusCode() == http.StatusConflict
+	}
+	var azErr *azcore.ResponseError
+	if errors.As(err, &azErr) {
+		return azErr.StatusCode == http.StatusPreconditionFailed || azErr.StatusCode == http.StatusConflict
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// newConflictTestBackends returns two backends sharing the same bucket, as two concurrent updates would, and a
+// reference to the stack "foo" in both.
+func newConflictTestBackends(t *testing.T, s env.MapStore) (*localBackend, *localBackend, *localBackendReference) {
+	t.Helper()
+
+	b1, ref := newJournalTestBackend(t, s)
+	b2, err := newLocalBackend(
+		context.Background(),
+		diagtest.LogSink(t), b1.url,
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(s)},
+	)
+	require.NoError(t, err)
+	return b1, b2, ref
+}
+
+func TestConditionalWrites_conflict(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b1, b2, ref := newConflictTestBackends(t, env.MapStore{
+		env.SelfManagedJournal.Var().Name(): "false",
+	})
+
+	// Both updates read the same checkpoint, then the first one writes to it.
+	_, err := b2.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	_, err = b1.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	snap := journalTestSnapshot(3, 0, "first")
+	_, err = b1.saveStack(ctx, ref, snap, snap.SecretsManager)
+	require.NoError(t, err)
+
+	// The second update's write would lose the first one's, so it's refused.
+	snap = journalTestSnapshot(3, 0, "second")
+	_, err = b2.saveStack(ctx, ref, snap, snap.SecretsManager)
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, ref.String(), conflict.Stack)
+
+	chk, err := b1.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "first", resourceInputs(chk)[0])
+
+	// The first update keeps writing, and the second can write once it has read the new checkpoint.
+	snap = journalTestSnapshot(3, 1, "first")
+	_, err = b1.saveStack(ctx, ref, snap, snap.SecretsManager)
+	require.NoError(t, err)
+	_, err = b2.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	snap = journalTestSnapshot(3, 0, "second")
+	_, err = b2.saveStack(ctx, ref, snap, snap.SecretsManager)
+	assert.NoError(t, err)
+}
+
+func TestConditionalWrites_journalConflict(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b1, b2, ref := newConflictTestBackends(t, env.MapStore{})
+
+	_, err := b1.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	_, err = b2.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+
+	// Both updates journal their first saves against the same checkpoint, so the second one's entry would clash with
+	// the first's.
+	b1.deltaUploads, b2.deltaUploads = true, true
+	sp1, sp2 := b1.newSnapshotPersister(ctx, ref), b2.newSnapshotPersister(ctx, ref)
+	require.NoError(t, sp1.Save(journalTestSnapshot(3, 0, "first")))
+
+	err = sp2.Save(journalTestSnapshot(3, 0, "second"))
+	var conflict *ConflictError
+	assert.ErrorAs(t, err, &conflict)
+}
+
+func TestConditionalWrites_removedStack(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b1, b2, ref := newConflictTestBackends(t, env.MapStore{
+		env.SelfManagedJournal.Var().Name(): "false",
+	})
+
+	_, err := b2.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	require.NoError(t, b1.removeStack(ctx, ref))
+
+	// Writing would bring back a stack that another update removed.
+	snap := journalTestSnapshot(1, 0, "second")
+	_, err = b2.saveStack(ctx, ref, snap, snap.SecretsManager)
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+
+	// A backend that removed the stack itself forgets its version, so the stack can be created again.
+	_, err = b1.saveStack(ctx, ref, snap, snap.SecretsManager)
+	assert.NoError(t, err)
+}
+
+func TestConflictError_retriedWrite(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	file := b.stackPath(ctx, ref)
+	content, err := b.bucket.ReadAll(ctx, file)
+	require.NoError(t, err)
+
+	// A write refused because the file already holds what we wrote was made by an earlier attempt.
+	assert.NoError(t, b.conflictError(ctx, ref, file, checkpointChecksum(content)))
+	var conflict *ConflictError
+	assert.ErrorAs(t, b.conflictError(ctx, ref, file, checkpointChecksum([]byte("other"))), &conflict)
+}
+
+func TestWithPrecondition_azure(t *testing.T) {
+	t.Parallel()
+
+	beforeWrite := func(opts *blob.WriterOptions) *azblob.UploadStreamOptions {
+		uploadOpts := &azblob.UploadStreamOptions{}
+		require.NoError(t, opts.BeforeWrite(func(i interface{}) bool {
+			p, ok := i.(**azblob.UploadStreamOptions)
+			if ok {
+				*p = uploadOpts
+			}
+			return ok
+		}))
+		return uploadOpts
+	}
+
+	uploadOpts := beforeWrite(withPrecondition(nil, &blob.Attributes{ETag: `"0x8D"`}))
+	require.NotNil(t, uploadOpts.AccessConditions)
+	assert.Equal(t, azcore.ETag(`"0x8D"`), *uploadOpts.AccessConditions.ModifiedAccessConditions.IfMatch)
+
+	uploadOpts = beforeWrite(withPrecondition(nil, nil))
+	require.NotNil(t, uploadOpts.AccessConditions)
+	assert.Equal(t, azcore.ETagAny, *uploadOpts.AccessConditions.ModifiedAccessConditions.IfNoneMatch)
+}
@@ -0,0 +1,93 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+// untypedDeployment returns the deployment of the given snapshot.
+func untypedDeployment(t *testing.T, snap *deploy.Snapshot) *apitype.UntypedDeployment {
+	t.Helper()
+
+	dep, err := stack.SerializeDeployment(snap, nil, false /* showSecrets */)
+	require.NoError(t, err)
+	byts, err := json.Marshal(dep)
+	require.NoError(t, err)
+	return &apitype.UntypedDeployment{Version: apitype.DeploymentSchemaVersionCurrent, Deployment: byts}
+}
+
+func TestCopyStack(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	src, srcRef := newJournalTestBackend(t, make(env.MapStore))
+	srcStack, err := src.GetStack(ctx, srcRef)
+	require.NoError(t, err)
+	for i := 1; i <= 2; i++ {
+		update := backend.UpdateInfo{Kind: apitype.UpdateUpdate, Message: "update"}
+		require.NoError(t, src.ImportUpdate(ctx, srcStack, update, untypedDeployment(t, journalTestSnapshot(i, -1, ""))))
+	}
+	require.NoError(t, src.ImportDeployment(ctx, srcStack, untypedDeployment(t, journalTestSnapshot(3, 2, "changed"))))
+	require.NoError(t, src.UpdateStackTags(ctx, srcStack, map[apitype.StackTagName]string{"team": "infra"}))
+	srcStack, err = src.GetStack(ctx, srcRef)
+	require.NoError(t, err)
+
+	// Copy the stack to another backend, under another name.
+	dest, _ := newJournalTestBackend(t, make(env.MapStore))
+	destRef, err := dest.ParseStackReference("bar")
+	require.NoError(t, err)
+	destStack, err := dest.CreateStack(ctx, destRef, "", nil)
+	require.NoError(t, err)
+	require.NoError(t, backend.CopyStack(ctx, srcStack, destStack, backend.CopyStackOptions{
+		SecretsProvider: b64.Base64SecretsProvider,
+		SecretsManager:  b64.NewBase64SecretsManager(),
+	}))
+
+	// Each update is copied into the history.
+	history, err := dest.GetHistory(ctx, destRef, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "update", history[0].Message)
+	dep, err := dest.ExportDeploymentForVersion(ctx, destStack, "1")
+	require.NoError(t, err)
+	var v1 apitype.DeploymentV3
+	require.NoError(t, json.Unmarshal(dep.Deployment, &v1))
+	assert.Len(t, v1.Resources, 1)
+
+	// The current state is copied last, with its URNs renamed.
+	chk, err := dest.getCheckpoint(ctx, destRef.(*localBackendReference))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"initial", "initial", "changed"}, resourceInputs(chk))
+	for _, res := range chk.Latest.Resources {
+		assert.Equal(t, "bar", res.URN.Stack().String())
+	}
+
+	destStack, err = dest.GetStack(ctx, destRef)
+	require.NoError(t, err)
+	assert.Equal(t, "infra", destStack.Tags()["team"])
+}
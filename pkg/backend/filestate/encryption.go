@@ -0,0 +1,123 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+// encryptedCheckpointMagic starts every checkpoint file that's encrypted at rest. It isn't valid JSON, so versions of
+// the CLI that can't decrypt the file fail to read it instead of mistaking it for some other checkpoint.
+var encryptedCheckpointMagic = []byte("PULUMI-ENCRYPTED-CHECKPOINT\n")
+
+// encryptedCheckpoint is the content of a checkpoint file encrypted at rest, following encryptedCheckpointMagic.
+type encryptedCheckpoint struct {
+	// SecretsProviders describes the secrets manager the checkpoint was encrypted with.
+	SecretsProviders apitype.SecretsProvidersV1 `json:"secrets_providers"`
+	// Ciphertext is the base64 encoded content the checkpoint file would have had unencrypted, encrypted with the
+	// secrets manager.
+	Ciphertext string `json:"ciphertext"`
+}
+
+// isEncryptedCheckpoint returns true if the given checkpoint file content is encrypted at rest.
+func isEncryptedCheckpoint(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedCheckpointMagic)
+}
+
+// encryptsState returns true if checkpoint files are encrypted at rest when they're written.
+func (b *localBackend) encryptsState() bool {
+	return b.Env.GetBool(env.SelfManagedEncryptState)
+}
+
+// encryptCheckpoint encrypts the content of the file written for the given checkpoint with the checkpoint's own
+// secrets manager, so that nothing in the file is readable without it.
+func encryptCheckpoint(
+	ctx context.Context, checkpoint *apitype.VersionedCheckpoint, content []byte,
+) ([]byte, error) {
+	var chk struct {
+		Latest *struct {
+			SecretsProviders *apitype.SecretsProvidersV1 `json:"secrets_providers,omitempty"`
+			Resources        []json.RawMessage           `json:"resources,omitempty"`
+		} `json:"latest,omitempty"`
+	}
+	if err := json.Unmarshal(checkpoint.Checkpoint, &chk); err != nil {
+		return nil, fmt.Errorf("reading the secrets manager of the checkpoint: %w", err)
+	}
+	if chk.Latest == nil || chk.Latest.SecretsProviders == nil {
+		// A stack that has never been deployed has no secrets manager yet, but nor does it have anything to hide.
+		if chk.Latest != nil && len(chk.Latest.Resources) > 0 {
+			return nil, fmt.Errorf("the checkpoint can't be encrypted as it has no secrets manager")
+		}
+		return content, nil
+	}
+
+	sp := *chk.Latest.SecretsProviders
+	sm, err := stack.DefaultSecretsProvider.OfType(sp.Type, sp.State)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := sm.Encrypter()
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := enc.EncryptValue(ctx, base64.StdEncoding.EncodeToString(content))
+	if err != nil {
+		return nil, fmt.Errorf("encrypting the checkpoint: %w", err)
+	}
+
+	envelope, err := json.Marshal(encryptedCheckpoint{SecretsProviders: sp, Ciphertext: ciphertext})
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, encryptedCheckpointMagic...), envelope...), nil
+}
+
+// decryptCheckpoint returns the content of a checkpoint file as it was before it was encrypted at rest. Content that
+// isn't encrypted is returned as it is.
+func decryptCheckpoint(ctx context.Context, content []byte) ([]byte, error) {
+	if !isEncryptedCheckpoint(content) {
+		return content, nil
+	}
+
+	var envelope encryptedCheckpoint
+	if err := json.Unmarshal(content[len(encryptedCheckpointMagic):], &envelope); err != nil {
+		return nil, fmt.Errorf("reading the encrypted checkpoint: %w", err)
+	}
+	sm, err := stack.DefaultSecretsProvider.OfType(envelope.SecretsProviders.Type, envelope.SecretsProviders.State)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := sm.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := dec.DecryptValue(ctx, envelope.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting the checkpoint: %w", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting the checkpoint: %w", err)
+	}
+	return decoded, nil
+}
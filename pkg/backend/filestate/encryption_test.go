@@ -0,0 +1,125 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+func TestEncryptedCheckpoint_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, compression := range stateCompressions {
+		compression := compression
+		t.Run(string(compression), func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			b, ref := newJournalTestBackend(t, env.MapStore{
+				env.SelfManagedCompression.Var().Name():  string(compression),
+				env.SelfManagedEncryptState.Var().Name(): "true",
+			})
+			_, err := b.saveStack(ctx, ref, journalTestSnapshot(3, 1, "changed"), b64.NewBase64SecretsManager())
+			require.NoError(t, err)
+
+			// Nothing in the file is readable without the secrets manager.
+			byts, err := b.bucket.ReadAll(ctx, b.stackPath(ctx, ref))
+			require.NoError(t, err)
+			assert.True(t, isEncryptedCheckpoint(byts))
+			assert.NotContains(t, string(byts), "changed")
+			assert.NotContains(t, string(byts), "res-1")
+
+			chk, err := b.getCheckpoint(ctx, ref)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"initial", "changed", "initial"}, resourceInputs(chk))
+
+			// Streaming falls back to reading the whole checkpoint.
+			_, dep := exportStreamedDeployment(t, b, ref)
+			assert.Len(t, dep.Resources, 3)
+		})
+	}
+}
+
+func TestEncryptedCheckpoint_importStream(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{
+		env.SelfManagedEncryptState.Var().Name(): "true",
+	})
+	_, err := b.saveStack(ctx, ref, journalTestSnapshot(2, 0, "changed"), b64.NewBase64SecretsManager())
+	require.NoError(t, err)
+
+	stk, err := b.GetStack(ctx, ref)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, b.ExportDeploymentStream(ctx, stk, &buf))
+
+	otherRef, err := b.ParseStackReference("bar")
+	require.NoError(t, err)
+	other, err := b.CreateStack(ctx, otherRef, "", nil)
+	require.NoError(t, err)
+	r, err := stack.NewDeploymentStreamReader(&buf)
+	require.NoError(t, err)
+	require.NoError(t, b.ImportDeploymentStream(ctx, other, r))
+
+	// The imported checkpoint is encrypted too.
+	byts, err := b.bucket.ReadAll(ctx, b.stackPath(ctx, otherRef.(*localBackendReference)))
+	require.NoError(t, err)
+	assert.True(t, isEncryptedCheckpoint(byts))
+
+	chk, err := b.getCheckpoint(ctx, otherRef.(*localBackendReference))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"changed", "initial"}, resourceInputs(chk))
+}
+
+func TestEncryptedCheckpoint_noJournal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{
+		env.SelfManagedEncryptState.Var().Name(): "true",
+	})
+	assert.Nil(t, b.newSnapshotPersister(ctx, ref).journal)
+}
+
+func TestEncryptedCheckpoint_noSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{
+		env.SelfManagedEncryptState.Var().Name(): "true",
+	})
+
+	// A stack without resources has nothing to hide, so it's written as it is ...
+	byts, err := b.bucket.ReadAll(ctx, b.stackPath(ctx, ref))
+	require.NoError(t, err)
+	assert.False(t, isEncryptedCheckpoint(byts))
+
+	// ... but resources are never written in the clear.
+	snap := journalTestSnapshot(1, 0, "changed")
+	snap.SecretsManager = nil
+	_, err = b.saveStack(ctx, ref, snap, nil)
+	assert.ErrorContains(t, err, "no secrets manager")
+}
@@ -0,0 +1,413 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// defaultJournalCompactionInterval is the number of journal entries written for a stack before its checkpoint file is
+// rewritten in full, unless overridden by PULUMI_SELF_MANAGED_STATE_JOURNAL_COMPACTION_INTERVAL.
+const defaultJournalCompactionInterval = 64
+
+// journalEntry is a single record in a stack's checkpoint journal.
+//
+// Rather than rewriting the whole checkpoint file every time the engine saves a snapshot, the filestate backend
+// writes each save as a new object under the stack's JournalDir. An entry describes the state after the save relative
+// to the state after the previous entry (or the checkpoint file itself, for the first entry): the resources between
+// Prefix and Suffix of the previous resource list are replaced with Latest.Resources, and every other field replaces
+// the previous value wholesale.
+//
+// Bucket objects are written atomically, so a crash leaves an entry either complete or missing and never corrupts
+// the checkpoint file. Base ties the journal to the exact checkpoint file it was written against, so entries left over
+// from an earlier checkpoint are never applied to a newer one.
+type journalEntry struct {
+	// Sequence is the position of this entry in the journal, starting at 1.
+	Sequence int `json:"sequence"`
+	// Base is the hash of the checkpoint file that this journal applies to.
+	Base string `json:"base"`
+	// Stack is the stack to update.
+	Stack tokens.QName `json:"stack"`
+	// Config contains a bag of optional configuration keys/values.
+	Config config.Map `json:"config,omitempty"`
+	// Latest is the deployment after this entry, except that it only holds the resources that changed.
+	Latest *apitype.DeploymentV3 `json:"latest,omitempty"`
+	// Prefix is the number of resources kept from the start of the previous resource list.
+	Prefix int `json:"prefix"`
+	// Suffix is the number of resources kept from the end of the previous resource list.
+	Suffix int `json:"suffix"`
+}
+
+// journalRecord is the on-disk form of a journalEntry, which carries a checksum to catch damaged entries.
+type journalRecord struct {
+	// Checksum is the hash of the compact JSON encoding of Entry.
+	Checksum string `json:"checksum"`
+	// Entry is the encoded journalEntry.
+	Entry json.RawMessage `json:"entry"`
+}
+
+// checkpointJournal tracks the journal that a single snapshot persister appends to.
+type checkpointJournal struct {
+	// base is the hash of the checkpoint file the journal applies to, or empty if none has been written yet.
+	base string
+	// sequence is the sequence number of the last entry written.
+	sequence int
+	// resources holds the hash of each resource in the last state that was saved.
+	resources [][sha256.Size]byte
+	// latest is the last state that was saved, kept so that the journal can be compacted.
+	latest *apitype.CheckpointV3
+	// resumed is true once the journal has tried to resume from the stack's existing checkpoint file.
+	resumed bool
+}
+
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// marshalCompact encodes v as JSON without indentation or HTML escaping.
+func marshalCompact(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// hashResources returns the hash of each resource in the given deployment, in order.
+func hashResources(dep *apitype.DeploymentV3) ([][sha256.Size]byte, error) {
+	if dep == nil {
+		return nil, nil
+	}
+	hashes := make([][sha256.Size]byte, len(dep.Resources))
+	for i, res := range dep.Resources {
+		b, err := marshalCompact(res)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling resource %v: %w", res.URN, err)
+		}
+		hashes[i] = sha256.Sum256(b)
+	}
+	return hashes, nil
+}
+
+func (b *localBackend) journalCompactionInterval() int {
+	if n := b.Env.GetInt(env.SelfManagedJournalCompactionInterval); n > 0 {
+		return n
+	}
+	return defaultJournalCompactionInterval
+}
+
+// saveStackJournaled saves the given snapshot by appending an entry to the stack's checkpoint journal. The journal is
+// compacted into a full checkpoint file instead when none has been written yet or enough entries have accumulated.
+func (b *localBackend) saveStackJournaled(
+	ctx context.Context,
+	ref *localBackendReference, snap *deploy.Snapshot,
+	sm secrets.Manager,
+	j *checkpointJournal,
+) (string, error) {
+	contract.Requiref(ref != nil, "ref", "ref was nil")
+	contract.Requiref(j != nil, "j", "journal was nil")
+
+	chk := &apitype.CheckpointV3{Stack: ref.FullyQualifiedName()}
+	if snap != nil {
+		dep, err := stack.SerializeDeployment(snap, sm, false /* showSecrets */)
+		if err != nil {
+			return "", fmt.Errorf("serializing checkpoint: %w", err)
+		}
+		chk.Latest = dep
+	}
+	hashes, err := hashResources(chk.Latest)
+	if err != nil {
+		return "", fmt.Errorf("serializing checkpoint: %w", err)
+	}
+
+	if j.base == "" && !j.resumed && b.deltaUploads {
+		// Uploading the whole checkpoint is slow, so build on the one already in the bucket if we can.
+		j.resumed = true
+		if err := b.resumeJournal(ctx, ref, j); err != nil {
+			logging.V(5).Infof("not resuming checkpoint journal for %s: %v", ref.FullyQualifiedName(), err)
+		}
+	}
+
+	var file string
+	if j.base == "" || j.sequence >= b.journalCompactionInterval() {
+		file, err = b.compactJournal(ctx, ref, j, chk, hashes)
+	} else {
+		file, err = b.appendJournal(ctx, ref, j, chk, hashes)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if !backend.DisableIntegrityChecking && snap != nil {
+		// As with saveStack, check the integrity only after writing, as the snapshot may contain resource state
+		// updates we don't want to lose.
+		if verifyerr := snap.VerifyIntegrity(); verifyerr != nil {
+			return "", fmt.Errorf(
+				"%s: snapshot integrity failure; it was already written, but is invalid: %w", file, verifyerr)
+		}
+	}
+
+	return file, nil
+}
+
+// compactJournal rewrites the stack's checkpoint file in full with the given state and starts a new journal for it.
+func (b *localBackend) compactJournal(
+	ctx context.Context,
+	ref *localBackendReference,
+	j *checkpointJournal,
+	chk *apitype.CheckpointV3,
+	hashes [][sha256.Size]byte,
+) (string, error) {
+	chkJSON, err := encoding.JSON.Marshal(chk)
+	if err != nil {
+		return "", fmt.Errorf("marshalling checkpoint: %w", err)
+	}
+	_, file, written, err := b.writeCheckpoint(ctx, ref, &apitype.VersionedCheckpoint{
+		Version:    apitype.DeploymentSchemaVersionCurrent,
+		Checkpoint: json.RawMessage(chkJSON),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The old journal can only be dropped once the checkpoint that replaces it has been written. Any entries we fail to
+	// delete refer to the old checkpoint file and so are ignored from now on.
+	if err := b.removeJournal(ctx, ref); err != nil {
+		logging.V(5).Infof("error removing checkpoint journal for %s: %v", ref.FullyQualifiedName(), err)
+	}
+
+	j.base, j.sequence, j.resources, j.latest = hashBytes(written), 0, hashes, chk
+	return file, nil
+}
+
+// appendJournal writes the difference between the last saved state and the given state as a new journal entry.
+func (b *localBackend) appendJournal(
+	ctx context.Context,
+	ref *localBackendReference,
+	j *checkpointJournal,
+	chk *apitype.CheckpointV3,
+	hashes [][sha256.Size]byte,
+) (string, error) {
+	// Steps generally touch a handful of resources in the middle or at the end of the list, so keeping the longest
+	// common prefix and suffix captures most of the state without needing a general diff.
+	prev := j.resources
+	prefix := 0
+	for prefix < len(prev) && prefix < len(hashes) && prev[prefix] == hashes[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(prev)-prefix && suffix < len(hashes)-prefix &&
+		prev[len(prev)-1-suffix] == hashes[len(hashes)-1-suffix] {
+		suffix++
+	}
+
+	entry := journalEntry{
+		Sequence: j.sequence + 1,
+		Base:     j.base,
+		Stack:    chk.Stack,
+		Config:   chk.Config,
+		Prefix:   prefix,
+		Suffix:   suffix,
+	}
+	if chk.Latest != nil {
+		latest := *chk.Latest
+		latest.Resources = latest.Resources[prefix : len(latest.Resources)-suffix]
+		entry.Latest = &latest
+	}
+
+	raw, err := marshalCompact(entry)
+	if err != nil {
+		return "", fmt.Errorf("marshalling journal entry: %w", err)
+	}
+	m, ext := b.compression.marshaler(encoding.JSON), "json"+b.compression.ext()
+	byts, err := m.Marshal(journalRecord{Checksum: hashBytes(raw), Entry: raw})
+	if err != nil {
+		return "", fmt.Errorf("marshalling journal entry: %w", err)
+	}
+
+	file := path.Join(ref.JournalDir(), fmt.Sprintf("%010d.%s", entry.Sequence, ext))
+	opts, err := b.journalEntryOptions(ctx, ref, j, entry.Sequence, file, b.compression.writerOptions())
+	if err != nil {
+		return "", err
+	}
+	if err := b.bucket.WriteAll(ctx, file, byts, opts); err != nil {
+		if isPreconditionFailed(err) {
+			return "", &ConflictError{Stack: ref.String(), File: file}
+		}
+		return "", fmt.Errorf("An IO error occurred while writing the journal entry: %w", err)
+	}
+	b.recordJournalEntry(ref, entry.Sequence)
+	logging.V(7).Infof("Saved stack %s journal entry to: %s", ref.FullyQualifiedName(), file)
+
+	j.sequence, j.resources, j.latest = entry.Sequence, hashes, chk
+	return file, nil
+}
+
+// readJournalEntry reads the journal entry stored in the given file. Missing files and IO errors are returned as
+// errors, while damaged entries are reported by a nil entry.
+func (b *localBackend) readJournalEntry(ctx context.Context, file string) (*journalEntry, error) {
+	byts, err := b.bucket.ReadAll(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("reading journal entry %s: %w", file, err)
+	}
+	var record journalRecord
+	if err := detectMarshaler(byts).Unmarshal(byts, &record); err != nil {
+		logging.V(5).Infof("ignoring damaged journal entry %s: %v", file, err)
+		return nil, nil
+	}
+	var raw bytes.Buffer
+	if err := json.Compact(&raw, record.Entry); err != nil || hashBytes(raw.Bytes()) != record.Checksum {
+		logging.V(5).Infof("ignoring journal entry %s with a bad checksum", file)
+		return nil, nil
+	}
+	var entry journalEntry
+	if err := json.Unmarshal(raw.Bytes(), &entry); err != nil {
+		logging.V(5).Infof("ignoring damaged journal entry %s: %v", file, err)
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// replayJournal applies the stack's journal entries that were written against the checkpoint file contents in base
+// to chk. Replay stops at the first entry that is damaged, out of sequence, or written against another checkpoint.
+// It returns the number of entries applied, and whether that was every entry in the journal.
+func (b *localBackend) replayJournal(
+	ctx context.Context,
+	ref *localBackendReference,
+	base []byte,
+	chk *apitype.CheckpointV3,
+) (applied int, complete bool, _ error) {
+	files, err := listBucket(ctx, b.bucket, ref.JournalDir())
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return 0, true, nil
+		}
+		return 0, false, fmt.Errorf("listing checkpoint journal: %w", err)
+	}
+
+	hash := hashBytes(base)
+	// listBucket returns files sorted by key, which for zero-padded sequence numbers is the order they were written.
+	for i, file := range files {
+		entry, err := b.readJournalEntry(ctx, file.Key)
+		if err != nil {
+			return applied, false, err
+		}
+		if entry == nil || entry.Base != hash || entry.Sequence != i+1 {
+			break
+		}
+		if err := applyJournalEntry(chk, entry); err != nil {
+			logging.V(5).Infof("ignoring journal entry %s: %v", file.Key, err)
+			break
+		}
+		applied++
+	}
+	return applied, applied == len(files), nil
+}
+
+// resumeJournal points the given journal at the stack's existing checkpoint file and the entries already written
+// against it, so that the next save is written as a journal entry instead of uploading the whole checkpoint again.
+// The journal is left as it is if the checkpoint file can't be used as a base, and the next save rewrites it.
+func (b *localBackend) resumeJournal(ctx context.Context, ref *localBackendReference, j *checkpointJournal) error {
+	file, _, _, err := b.checkpointFile(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if b.stackPath(ctx, ref) != file {
+		// The checkpoint file is in another compression, so it has to be rewritten anyway.
+		return nil
+	}
+	content, err := b.bucket.ReadAll(ctx, file)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil
+		}
+		return err
+	}
+	chk, err := b.decodeCheckpoint(ctx, ref, file, content)
+	if err != nil {
+		return err
+	}
+	applied, complete, err := b.replayJournal(ctx, ref, content, chk)
+	if err != nil || !complete {
+		// Entries that can't be applied are only dropped by rewriting the checkpoint file.
+		return err
+	}
+	hashes, err := hashResources(chk.Latest)
+	if err != nil {
+		return err
+	}
+
+	j.base, j.sequence, j.resources, j.latest = hashBytes(content), applied, hashes, chk
+	return nil
+}
+
+// applyJournalEntry updates chk to the state described by the given journal entry.
+func applyJournalEntry(chk *apitype.CheckpointV3, entry *journalEntry) error {
+	var prev []apitype.ResourceV3
+	if chk.Latest != nil {
+		prev = chk.Latest.Resources
+	}
+	if entry.Prefix < 0 || entry.Suffix < 0 || entry.Prefix+entry.Suffix > len(prev) {
+		return fmt.Errorf("entry keeps %d+%d resources of %d", entry.Prefix, entry.Suffix, len(prev))
+	}
+
+	chk.Stack, chk.Config = entry.Stack, entry.Config
+	if entry.Latest == nil {
+		chk.Latest = nil
+		return nil
+	}
+
+	resources := make([]apitype.ResourceV3, 0, entry.Prefix+len(entry.Latest.Resources)+entry.Suffix)
+	resources = append(resources, prev[:entry.Prefix]...)
+	resources = append(resources, entry.Latest.Resources...)
+	resources = append(resources, prev[len(prev)-entry.Suffix:]...)
+
+	latest := *entry.Latest
+	latest.Resources = resources
+	chk.Latest = &latest
+	return nil
+}
+
+// removeJournal deletes all journal entries for the given stack.
+func (b *localBackend) removeJournal(ctx context.Context, ref *localBackendReference) error {
+	err := removeAllByPrefix(ctx, b.bucket, ref.JournalDir())
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newJournalTestBackend(t *testing.T, s env.MapStore) (*localBackend, *localBackendReference) {
+	t.Helper()
+
+	if _, ok := s[env.SelfManagedJournal.Var().Name()]; !ok {
+		s[env.SelfManagedJournal.Var().Name()] = "true"
+	}
+	ctx := context.Background()
+	b, err := newLocalBackend(
+		ctx,
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(t.TempDir()),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(s)},
+	)
+	require.NoError(t, err)
+
+	ref, err := b.ParseStackReference("foo")
+	require.NoError(t, err)
+	_, err = b.CreateStack(ctx, ref, "", nil)
+	require.NoError(t, err)
+
+	return b, ref.(*localBackendReference)
+}
+
+// journalTestSnapshot returns a snapshot with n resources, where the input of the resource at index changed is
+// set to value.
+func journalTestSnapshot(n, changed int, value string) *deploy.Snapshot {
+	resources := make([]*resource.State, n)
+	for i := range resources {
+		input := "initial"
+		if i == changed {
+			input = value
+		}
+		resources[i] = &resource.State{
+			URN:  resource.NewURN("foo", "testproj", "", "a:b:c", fmt.Sprintf("res-%d", i)),
+			Type: "a:b:c",
+			Inputs: resource.PropertyMap{
+				"input": resource.NewStringProperty(input),
+			},
+		}
+	}
+	return deploy.NewSnapshot(deploy.Manifest{}, b64.NewBase64SecretsManager(), resources, nil)
+}
+
+// readBaseCheckpoint reads the stack's checkpoint file, ignoring any journal.
+func readBaseCheckpoint(t *testing.T, b *localBackend, ref *localBackendReference) *apitype.CheckpointV3 {
+	t.Helper()
+
+	ctx := context.Background()
+	byts, err := b.bucket.ReadAll(ctx, b.stackPath(ctx, ref))
+	require.NoError(t, err)
+	chk, err := stack.UnmarshalVersionedCheckpointToLatestCheckpoint(encoding.JSON, byts)
+	require.NoError(t, err)
+	return chk
+}
+
+func journalFiles(t *testing.T, b *localBackend, ref *localBackendReference) []string {
+	t.Helper()
+
+	files, err := listBucket(context.Background(), b.bucket, ref.JournalDir())
+	require.NoError(t, err)
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = objectName(f)
+	}
+	return names
+}
+
+func resourceInputs(chk *apitype.CheckpointV3) []string {
+	var inputs []string
+	for _, res := range chk.Latest.Resources {
+		inputs = append(inputs, res.Inputs["input"].(string))
+	}
+	return inputs
+}
+
+func TestJournal_appendAndCompact(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := make(env.MapStore)
+	s[env.SelfManagedJournalCompactionInterval.Var().Name()] = "3"
+	b, ref := newJournalTestBackend(t, s)
+	persister := b.newSnapshotPersister(ctx, ref)
+
+	// The first save writes the checkpoint file in full.
+	require.NoError(t, persister.Save(journalTestSnapshot(1, -1, "")))
+	assert.Empty(t, journalFiles(t, b, ref))
+	assert.Len(t, readBaseCheckpoint(t, b, ref).Latest.Resources, 1)
+
+	// Later saves only append to the journal.
+	require.NoError(t, persister.Save(journalTestSnapshot(2, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(3, 1, "changed")))
+	assert.Equal(t, []string{"0000000001.json", "0000000002.json"}, journalFiles(t, b, ref))
+	assert.Len(t, readBaseCheckpoint(t, b, ref).Latest.Resources, 1)
+
+	chk, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"initial", "changed", "initial"}, resourceInputs(chk))
+
+	// An update to a resource in the middle only records that resource.
+	require.NoError(t, persister.Save(journalTestSnapshot(3, 1, "changed again")))
+	entry, err := b.readJournalEntry(ctx, filepath.ToSlash(filepath.Join(ref.JournalDir(), "0000000003.json")))
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, 1, entry.Prefix)
+	assert.Equal(t, 1, entry.Suffix)
+	assert.Len(t, entry.Latest.Resources, 1)
+
+	// Once the compaction interval is reached, the journal is folded into the checkpoint file.
+	require.NoError(t, persister.Save(journalTestSnapshot(3, 2, "last")))
+	assert.Empty(t, journalFiles(t, b, ref))
+	assert.Equal(t, []string{"initial", "initial", "last"}, resourceInputs(readBaseCheckpoint(t, b, ref)))
+
+	// Compacting again at the end of the update does the same for any remaining entries.
+	require.NoError(t, persister.Save(journalTestSnapshot(2, -1, "")))
+	assert.Len(t, journalFiles(t, b, ref), 1)
+	require.NoError(t, persister.Compact())
+	assert.Empty(t, journalFiles(t, b, ref))
+	assert.Equal(t, []string{"initial", "initial"}, resourceInputs(readBaseCheckpoint(t, b, ref)))
+}
+
+func TestJournal_deltaUploads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, make(env.MapStore))
+	b.deltaUploads = true
+	_, err := b.saveStack(ctx, ref, journalTestSnapshot(3, -1, ""), b64.NewBase64SecretsManager())
+	require.NoError(t, err)
+	base, err := b.bucket.ReadAll(ctx, b.stackPath(ctx, ref))
+	require.NoError(t, err)
+
+	// The first save of an update builds on the checkpoint file already in the bucket.
+	persister := b.newSnapshotPersister(ctx, ref)
+	require.NoError(t, persister.Save(journalTestSnapshot(3, 1, "changed")))
+	assert.Equal(t, []string{"0000000001.json"}, journalFiles(t, b, ref))
+	byts, err := b.bucket.ReadAll(ctx, b.stackPath(ctx, ref))
+	require.NoError(t, err)
+	assert.Equal(t, base, byts)
+
+	entry, err := b.readJournalEntry(ctx, filepath.ToSlash(filepath.Join(ref.JournalDir(), "0000000001.json")))
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Len(t, entry.Latest.Resources, 1)
+
+	// A later update carries on from the journal left behind.
+	persister = b.newSnapshotPersister(ctx, ref)
+	require.NoError(t, persister.Save(journalTestSnapshot(3, 2, "changed")))
+	assert.Equal(t, []string{"0000000001.json", "0000000002.json"}, journalFiles(t, b, ref))
+
+	chk, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"initial", "initial", "changed"}, resourceInputs(chk))
+
+	require.NoError(t, persister.Compact())
+	assert.Empty(t, journalFiles(t, b, ref))
+	assert.Equal(t, []string{"initial", "initial", "changed"}, resourceInputs(readBaseCheckpoint(t, b, ref)))
+}
+
+func TestJournal_deltaUploadsStaleEntries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, make(env.MapStore))
+	b.deltaUploads = true
+	persister := b.newSnapshotPersister(ctx, ref)
+	require.NoError(t, persister.Save(journalTestSnapshot(2, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(2, 0, "changed")))
+	require.Len(t, journalFiles(t, b, ref), 2)
+
+	// Replace the checkpoint file without touching the journal, so its entry no longer applies.
+	chk, err := stack.SerializeCheckpoint(ref.FullyQualifiedName(), journalTestSnapshot(1, -1, ""),
+		b64.NewBase64SecretsManager(), false /* showSecrets */)
+	require.NoError(t, err)
+	_, _, _, err = b.writeCheckpoint(ctx, ref, chk)
+	require.NoError(t, err)
+
+	// The next update can't build on the journal, so rewrites the checkpoint file instead.
+	persister = b.newSnapshotPersister(ctx, ref)
+	require.NoError(t, persister.Save(journalTestSnapshot(2, 1, "changed")))
+	assert.Empty(t, journalFiles(t, b, ref))
+	assert.Equal(t, []string{"initial", "changed"}, resourceInputs(readBaseCheckpoint(t, b, ref)))
+}
+
+func TestJournal_ignoresDamagedEntries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, make(env.MapStore))
+	persister := b.newSnapshotPersister(ctx, ref)
+
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, persister.Save(journalTestSnapshot(i, -1, "")))
+	}
+
+	// Simulate a torn write of the second entry: it and everything after it must be ignored.
+	file := filepath.ToSlash(filepath.Join(ref.JournalDir(), "0000000002.json"))
+	require.NoError(t, b.bucket.WriteAll(ctx, file, []byte(`{"checksum": "abc", "entry": {"sequ`), nil))
+
+	chk, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Len(t, chk.Latest.Resources, 2)
+}
+
+func TestJournal_ignoresStaleEntries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, make(env.MapStore))
+	persister := b.newSnapshotPersister(ctx, ref)
+
+	require.NoError(t, persister.Save(journalTestSnapshot(1, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(2, -1, "")))
+
+	// Replace the checkpoint file without touching the journal, as would happen if we crashed while compacting.
+	chk, err := stack.SerializeCheckpoint(ref.FullyQualifiedName(), journalTestSnapshot(5, -1, ""),
+		b64.NewBase64SecretsManager(), false /* showSecrets */)
+	require.NoError(t, err)
+	_, _, _, err = b.writeCheckpoint(ctx, ref, chk)
+	require.NoError(t, err)
+	assert.Len(t, journalFiles(t, b, ref), 1)
+
+	latest, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Len(t, latest.Latest.Resources, 5)
+
+	// Writing a checkpoint in full clears the journal.
+	_, _, err = b.saveCheckpoint(ctx, ref, chk)
+	require.NoError(t, err)
+	assert.Empty(t, journalFiles(t, b, ref))
+}
+
+func TestJournal_disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := make(env.MapStore)
+	s[env.SelfManagedJournal.Var().Name()] = "false"
+	b, ref := newJournalTestBackend(t, s)
+	persister := b.newSnapshotPersister(ctx, ref)
+
+	require.NoError(t, persister.Save(journalTestSnapshot(1, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(2, -1, "")))
+	assert.Empty(t, journalFiles(t, b, ref))
+	assert.Len(t, readBaseCheckpoint(t, b, ref).Latest.Resources, 2)
+}
+
+// Tests that the journal is only used when it's enabled, because older CLIs don't read it.
+func TestJournal_optIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, err := newLocalBackend(
+		ctx,
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(t.TempDir()),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(make(env.MapStore))},
+	)
+	require.NoError(t, err)
+	ref, err := b.ParseStackReference("foo")
+	require.NoError(t, err)
+
+	assert.Nil(t, b.newSnapshotPersister(ctx, ref.(*localBackendReference)).journal)
+}
+
+func TestJournal_removedWithStack(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, make(env.MapStore))
+	persister := b.newSnapshotPersister(ctx, ref)
+
+	require.NoError(t, persister.Save(journalTestSnapshot(1, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(0, -1, "")))
+	assert.Len(t, journalFiles(t, b, ref), 1)
+
+	require.NoError(t, b.removeStack(ctx, ref))
+	assert.Empty(t, journalFiles(t, b, ref))
+}
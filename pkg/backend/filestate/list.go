@@ -0,0 +1,277 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// defaultListPageSize is the number of objects listed at a time when listing stacks, unless overridden by
+// PULUMI_SELF_MANAGED_STATE_LIST_PAGE_SIZE.
+const defaultListPageSize = 1000
+
+// summaryWorkers is the number of checkpoints read at once when summarizing a page of stacks. Reading a checkpoint
+// spends most of its time waiting on the bucket, so this is more than the number of CPUs.
+const summaryWorkers = 32
+
+// listPageSize returns the number of objects to list at a time when listing stacks.
+func (b *localBackend) listPageSize() int {
+	if n := b.Env.GetInt(env.SelfManagedListPageSize); n > 0 {
+		return n
+	}
+	return defaultListPageSize
+}
+
+// stackSummaryPage is a page of stack summaries. The details of the summaries are read the first time any of them is
+// needed, from the checkpoints of all of the page's stacks in parallel, so listing stacks only to get at their names
+// doesn't read any checkpoint at all.
+type stackSummaryPage struct {
+	ctx  context.Context
+	b    *localBackend
+	refs []*localBackendReference
+
+	once      sync.Once
+	summaries []*localStackSummary
+}
+
+func newStackSummaryPage(
+	ctx context.Context, b *localBackend, refs []*localBackendReference,
+) *stackSummaryPage {
+	page := &stackSummaryPage{ctx: ctx, b: b, refs: refs}
+	page.summaries = make([]*localStackSummary, len(refs))
+	for i, ref := range refs {
+		page.summaries[i] = &localStackSummary{name: ref, page: page}
+	}
+	return page
+}
+
+// stackSummaries returns the summaries of the page as backend.StackSummary values.
+func (p *stackSummaryPage) stackSummaries() []backend.StackSummary {
+	results := make([]backend.StackSummary, len(p.summaries))
+	for i, s := range p.summaries {
+		results[i] = s
+	}
+	return results
+}
+
+// load reads the details of all of the page's summaries, if they haven't been read yet. A stack whose checkpoint
+// can't be read is summarized without any.
+func (p *stackSummaryPage) load() {
+	p.once.Do(func() {
+		pool := newWorkerPool(summaryWorkers, len(p.refs))
+		defer pool.Close()
+
+		for i, ref := range p.refs {
+			s, ref := p.summaries[i], ref
+			pool.Enqueue(func() error {
+				lastUpdate, resourceCount, err := p.b.readStackSummary(p.ctx, ref)
+				if err != nil {
+					logging.V(5).Infof("error summarizing stack %s: %v", ref.FullyQualifiedName(), err)
+					return nil
+				}
+				// No lock necessary; each task writes to its own summary.
+				s.lastUpdate, s.resourceCount = lastUpdate, resourceCount
+				return nil
+			})
+		}
+		contract.IgnoreError(pool.Wait())
+	})
+}
+
+// readStackSummary returns the time of the last update of the given stack and the number of resources it has, or nil
+// for either if the stack has no deployment.
+//
+// Only the manifest of the stack's checkpoint is decoded, its resources are just counted as the checkpoint is read.
+// Checkpoints that have journal entries to replay onto them or were written by an older version of the CLI are read
+// into memory instead, as they can only be made sense of as a whole.
+func (b *localBackend) readStackSummary(
+	ctx context.Context, ref *localBackendReference,
+) (*time.Time, *int, error) {
+	lastUpdate, resourceCount, err := b.readStackSummaryStream(ctx, ref)
+	if !errors.Is(err, errCannotStream) {
+		return lastUpdate, resourceCount, err
+	}
+
+	logging.V(5).Infof("reading checkpoint for stack %s into memory: %v", ref.FullyQualifiedName(), err)
+	chk, err := b.getCheckpoint(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if chk == nil || chk.Latest == nil {
+		return nil, nil, nil
+	}
+	count := len(chk.Latest.Resources)
+	if t := chk.Latest.Manifest.Time; !t.IsZero() {
+		return &t, &count, nil
+	}
+	return nil, &count, nil
+}
+
+func (b *localBackend) readStackSummaryStream(
+	ctx context.Context, ref *localBackendReference,
+) (*time.Time, *int, error) {
+	journal, err := listBucket(ctx, b.bucket, ref.JournalDir())
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return nil, nil, fmt.Errorf("listing checkpoint journal: %w", err)
+	}
+	if len(journal) > 0 {
+		return nil, nil, fmt.Errorf("%w: it has journal entries", errCannotStream)
+	}
+
+	rd, err := b.bucket.NewReader(ctx, b.stackPath(ctx, ref), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer contract.IgnoreClose(rd)
+
+	content, err := decompressReader(rd)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer contract.IgnoreClose(content)
+
+	dec := json.NewDecoder(content)
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return nil, nil, err
+	}
+	version := 0
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch key, _ := tok.(string); key {
+		case "version":
+			if err := dec.Decode(&version); err != nil {
+				return nil, nil, err
+			}
+		case "checkpoint":
+			if version != apitype.DeploymentSchemaVersionCurrent {
+				return nil, nil, fmt.Errorf("%w: it has version %d", errCannotStream, version)
+			}
+			return readCheckpointSummary(dec)
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return nil, nil, errors.New("checkpoint is missing")
+}
+
+// readCheckpointSummary reads a checkpoint from dec, returning the time of its latest deployment and the number of
+// resources in it.
+func readCheckpointSummary(dec *json.Decoder) (*time.Time, *int, error) {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return nil, nil, err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		// Checkpoints are written with Go's default field names, and read case insensitively.
+		if key, _ := tok.(string); strings.EqualFold(key, "latest") {
+			return readDeploymentSummary(dec)
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil, nil, err
+		}
+	}
+	return nil, nil, nil
+}
+
+// readDeploymentSummary reads a deployment from dec, returning its time and the number of resources in it.
+func readDeploymentSummary(dec *json.Decoder) (*time.Time, *int, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if tok == nil {
+		return nil, nil, nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("expected {, found %v", tok)
+	}
+
+	var lastUpdate *time.Time
+	count := 0
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch key, _ := tok.(string); strings.ToLower(key) {
+		case "manifest":
+			var manifest apitype.ManifestV1
+			if err := dec.Decode(&manifest); err != nil {
+				return nil, nil, err
+			}
+			if !manifest.Time.IsZero() {
+				lastUpdate = &manifest.Time
+			}
+		case "resources":
+			if count, err = countJSONArray(dec); err != nil {
+				return nil, nil, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return lastUpdate, &count, nil
+}
+
+// countJSONArray reads an array from dec, returning the number of elements in it. A null array has none.
+func countJSONArray(dec *json.Decoder) (int, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, err
+	}
+	if tok == nil {
+		return 0, nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("expected [, found %v", tok)
+	}
+
+	count := 0
+	for dec.More() {
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return 0, err
+		}
+		count++
+	}
+	return count, expectJSONDelim(dec, ']')
+}
@@ -0,0 +1,129 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+func TestListStacks_paginated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, _ := newJournalTestBackend(t, env.MapStore{env.SelfManagedListPageSize.Var().Name(): "2"})
+	for i := 0; i < 4; i++ {
+		ref, err := b.ParseStackReference(fmt.Sprintf("stack-%d", i))
+		require.NoError(t, err)
+		_, err = b.CreateStack(ctx, ref, "", nil)
+		require.NoError(t, err)
+	}
+
+	var names []string
+	var token backend.ContinuationToken
+	pages := 0
+	for {
+		summaries, next, err := b.ListStacks(ctx, backend.ListStacksFilter{}, token)
+		require.NoError(t, err)
+		for _, s := range summaries {
+			names = append(names, s.Name().Name().String())
+		}
+		pages++
+		if next == nil {
+			break
+		}
+		token = next
+	}
+
+	assert.ElementsMatch(t, []string{"foo", "stack-0", "stack-1", "stack-2", "stack-3"}, names)
+	assert.Greater(t, pages, 2)
+}
+
+func TestListStacks_projectFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, _ := newJournalTestBackend(t, env.MapStore{})
+	ref, err := b.ParseStackReference("organization/otherproj/bar")
+	require.NoError(t, err)
+	_, err = b.CreateStack(ctx, ref, "", nil)
+	require.NoError(t, err)
+
+	project := "testproj"
+	summaries, next, err := b.ListStacks(ctx, backend.ListStacksFilter{Project: &project}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "foo", summaries[0].Name().Name().String())
+}
+
+func TestListStacks_summaries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	snap := journalTestSnapshot(3, -1, "")
+	snap.Manifest.Time = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	_, err := b.saveStack(ctx, ref, snap, b64.NewBase64SecretsManager())
+	require.NoError(t, err)
+
+	emptyRef, err := b.ParseStackReference("empty")
+	require.NoError(t, err)
+	_, err = b.CreateStack(ctx, emptyRef, "", nil)
+	require.NoError(t, err)
+
+	summaries, _, err := b.ListStacks(ctx, backend.ListStacksFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	for _, s := range summaries {
+		switch s.Name().Name().String() {
+		case "foo":
+			require.NotNil(t, s.LastUpdate())
+			assert.True(t, snap.Manifest.Time.Equal(*s.LastUpdate()))
+			require.NotNil(t, s.ResourceCount())
+			assert.Equal(t, 3, *s.ResourceCount())
+		case "empty":
+			assert.Nil(t, s.LastUpdate())
+			assert.Nil(t, s.ResourceCount())
+		}
+	}
+}
+
+func TestListStacks_summaryWithJournal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	persister := b.newSnapshotPersister(ctx, ref)
+	require.NoError(t, persister.Save(journalTestSnapshot(1, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(2, -1, "")))
+	require.NotEmpty(t, journalFiles(t, b, ref))
+
+	// The resources in the journal are counted too.
+	lastUpdate, count, err := b.readStackSummary(ctx, ref)
+	require.NoError(t, err)
+	assert.Nil(t, lastUpdate)
+	require.NotNil(t, count)
+	assert.Equal(t, 2, *count)
+}
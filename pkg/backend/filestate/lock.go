@@ -25,22 +25,38 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/shirou/gopsutil/v3/process"
+	"gocloud.dev/gcerrors"
+
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/fsutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
+// defaultLockLease is how long a stack lock stays valid without its holder renewing it, unless overridden by
+// PULUMI_SELF_MANAGED_STATE_LOCK_LEASE.
+const defaultLockLease = 5 * time.Minute
+
 type lockContent struct {
 	Pid       int       `json:"pid"`
 	Username  string    `json:"username"`
 	Hostname  string    `json:"hostname"`
 	Timestamp time.Time `json:"timestamp"`
+	// LockID identifies the backend instance holding the lock.
+	LockID string `json:"lockID,omitempty"`
+	// Heartbeat is the last time the holder renewed its lease on the lock.
+	Heartbeat time.Time `json:"heartbeat"`
+	// Lease is how long after Heartbeat the lock is considered abandoned. Locks written by older versions of the CLI
+	// have no lease and never expire.
+	Lease time.Duration `json:"lease,omitempty"`
 }
 
-func newLockContent() (*lockContent, error) {
+func newLockContent(lockID string, lease time.Duration) (*lockContent, error) {
 	u, err := user.Current()
 	if err != nil {
 		return nil, err
@@ -49,15 +65,70 @@ func newLockContent() (*lockContent, error) {
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	return &lockContent{
 		Pid:       os.Getpid(),
 		Username:  u.Username,
 		Hostname:  hostname,
-		Timestamp: time.Now(),
+		Timestamp: now,
+		LockID:    lockID,
+		Heartbeat: now,
+		Lease:     lease,
 	}, nil
 }
 
-// checkForLock looks for any existing locks for this stack, and returns a helpful diagnostic if there is one.
+// expired returns true if the holder of the lock has stopped renewing its lease.
+func (l *lockContent) expired(now time.Time) bool {
+	return l.Lease > 0 && now.After(l.Heartbeat.Add(l.Lease))
+}
+
+// holderDead returns true if the process holding the lock is known to have exited, along with the reason why it is
+// or isn't believed to be dead. hostname is the name of the machine we are running on: only processes on it can be
+// checked directly, everything else relies on the lock's lease.
+func (l *lockContent) holderDead(now time.Time, hostname string) (bool, string) {
+	if l.Hostname == hostname && l.Pid > 0 {
+		alive, err := process.PidExists(int32(l.Pid))
+		if err == nil {
+			if alive {
+				return false, fmt.Sprintf("process %v is still running", l.Pid)
+			}
+			return true, fmt.Sprintf("process %v is no longer running", l.Pid)
+		}
+		logging.V(5).Infof("error checking for process %v: %v", l.Pid, err)
+	}
+
+	switch {
+	case l.Lease == 0:
+		return false, "the lock has no lease, so its holder can't be checked"
+	case l.expired(now):
+		return true, "its lease expired at " + l.Heartbeat.Add(l.Lease).Format(time.RFC3339)
+	default:
+		return false, "its lease is valid until " + l.Heartbeat.Add(l.Lease).Format(time.RFC3339)
+	}
+}
+
+func (b *localBackend) lockLease() time.Duration {
+	if n := b.Env.GetInt(env.SelfManagedLockLease); n > 0 {
+		return time.Duration(n) * time.Second
+	}
+	return defaultLockLease
+}
+
+// readLock reads the content of the lock file at the given key.
+func (b *localBackend) readLock(ctx context.Context, key string) (*lockContent, error) {
+	content, err := b.bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	l := &lockContent{}
+	if err := json.Unmarshal(content, &l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// checkForLock looks for any existing locks for this stack, and returns a helpful diagnostic if there is one. Locks
+// whose lease has expired and whose holder is known to have exited are removed.
 func (b *localBackend) checkForLock(ctx context.Context, stackRef backend.StackReference) error {
 	stackName := stackRef.FullyQualifiedName()
 	allFiles, err := listBucket(ctx, b.bucket, stackLockDir(stackName))
@@ -79,42 +150,83 @@ func (b *localBackend) checkForLock(ctx context.Context, stackRef backend.StackR
 		}
 	}
 
-	if len(lockKeys) > 0 {
-		errorString := fmt.Sprintf("the stack is currently locked by %v lock(s). Either wait for the other "+
-			"process(es) to end or delete the lock file with `pulumi cancel`.", len(lockKeys))
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
 
-		for _, lock := range lockKeys {
-			content, err := b.bucket.ReadAll(ctx, lock)
-			if err != nil {
-				return err
+	var locks []string
+	for _, lock := range lockKeys {
+		l, err := b.readLock(ctx, lock)
+		if err != nil {
+			if gcerrors.Code(err) == gcerrors.NotFound {
+				// The lock was released between listing and reading it.
+				continue
 			}
-			l := &lockContent{}
-			err = json.Unmarshal(content, &l)
-			if err != nil {
+			return err
+		}
+
+		if dead, reason := l.holderDead(now, hostname); dead && l.expired(now) {
+			b.d.Warningf(diag.Message("", "removing stale lock %v created by %v@%v (pid %v): %v"),
+				b.url+"/"+lock, l.Username, l.Hostname, l.Pid, reason)
+			if err := b.bucket.Delete(ctx, lock); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
 				return err
 			}
+			continue
+		}
 
-			errorString += fmt.Sprintf("\n  %v: created by %v@%v (pid %v) at %v",
-				b.url+"/"+lock,
-				l.Username,
-				l.Hostname,
-				l.Pid,
-				l.Timestamp.Format(time.RFC3339),
-			)
+		line := fmt.Sprintf("\n  %v: created by %v@%v (pid %v) at %v",
+			b.url+"/"+lock,
+			l.Username,
+			l.Hostname,
+			l.Pid,
+			l.Timestamp.Format(time.RFC3339),
+		)
+		if l.Lease > 0 {
+			line += ", last renewed at " + l.Heartbeat.Format(time.RFC3339)
 		}
+		locks = append(locks, line)
+	}
 
+	if len(locks) > 0 {
+		errorString := fmt.Sprintf("the stack is currently locked by %v lock(s). Either wait for the other "+
+			"process(es) to end or delete the lock file with `pulumi cancel`.", len(locks))
+		for _, line := range locks {
+			errorString += line
+		}
 		return errors.New(errorString)
 	}
 	return nil
 }
 
+// stackLocker is implemented by buckets that can serialize the acquisition of stack locks across processes. Without
+// one, two processes that check for locks at the same time can both find none and both take the lock.
+type stackLocker interface {
+	// withLock runs fn while holding an exclusive lock on name, that other calls with the same name wait for.
+	withLock(ctx context.Context, name string, fn func() error) error
+}
+
+// withStackLocker runs fn under the bucket's lock for the given stack's locks, if it has one.
+func (b *localBackend) withStackLocker(ctx context.Context, stackRef backend.StackReference, fn func() error) error {
+	if b.locker == nil {
+		return fn()
+	}
+	return b.locker.withLock(ctx, stackLockDir(stackRef.FullyQualifiedName()), fn)
+}
+
 func (b *localBackend) Lock(ctx context.Context, stackRef backend.StackReference) error {
-	//
+	return b.withStackLocker(ctx, stackRef, func() error {
+		return b.lock(ctx, stackRef)
+	})
+}
+
+func (b *localBackend) lock(ctx context.Context, stackRef backend.StackReference) error {
 	err := b.checkForLock(ctx, stackRef)
 	if err != nil {
 		return err
 	}
-	lockContent, err := newLockContent()
+	lockContent, err := newLockContent(b.lockID, b.lockLease())
 	if err != nil {
 		return err
 	}
@@ -131,10 +243,14 @@ func (b *localBackend) Lock(ctx context.Context, stackRef backend.StackReference
 		b.Unlock(ctx, stackRef)
 		return err
 	}
+	b.renewLease(ctx, b.lockPath(stackRef), lockContent)
 	return nil
 }
 
 func (b *localBackend) Unlock(ctx context.Context, stackRef backend.StackReference) {
+	// Stop renewing the lease first, so that we don't write the lock back after deleting it.
+	b.stopLeaseRenewal(b.lockPath(stackRef))
+
 	err := b.bucket.Delete(ctx, b.lockPath(stackRef))
 	if err != nil {
 		b.d.Errorf(
@@ -144,6 +260,135 @@ func (b *localBackend) Unlock(ctx context.Context, stackRef backend.StackReferen
 	}
 }
 
+// leaseRenewal is a running renewal of the lease on a lock held by this backend.
+type leaseRenewal struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// renewLease starts periodically rewriting the lock at lockPath with a fresh heartbeat, until stopLeaseRenewal is
+// called or ctx is canceled.
+func (b *localBackend) renewLease(ctx context.Context, lockPath string, content *lockContent) {
+	b.stopLeaseRenewal(lockPath)
+
+	ctx, cancel := context.WithCancel(ctx)
+	renewal := &leaseRenewal{cancel: cancel, done: make(chan struct{})}
+	b.leasesMutex.Lock()
+	if b.leases == nil {
+		b.leases = make(map[string]*leaseRenewal)
+	}
+	b.leases[lockPath] = renewal
+	b.leasesMutex.Unlock()
+
+	go func() {
+		defer close(renewal.done)
+
+		// Renew well within the lease, so that a few failed writes don't let it expire.
+		ticker := time.NewTicker(content.Lease / 5)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				exists, err := b.bucket.Exists(ctx, lockPath)
+				if err == nil && !exists {
+					// Someone else broke our lock, writing it back would hide that from them.
+					b.d.Warningf(diag.Message("", "the lock at %v was removed by another process"),
+						path.Join(b.url, lockPath))
+					return
+				}
+
+				content.Heartbeat = now
+				byts, err := json.Marshal(content)
+				contract.AssertNoErrorf(err, "marshalling lock content")
+				if err := b.bucket.WriteAll(ctx, lockPath, byts, nil); err != nil {
+					logging.V(5).Infof("error renewing the lease on lock %v: %v", lockPath, err)
+				}
+			}
+		}
+	}()
+}
+
+// stopLeaseRenewal stops renewing the lease on the lock at lockPath, if we are, and waits for any in flight renewal
+// to finish.
+func (b *localBackend) stopLeaseRenewal(lockPath string) {
+	b.leasesMutex.Lock()
+	renewal, has := b.leases[lockPath]
+	delete(b.leases, lockPath)
+	b.leasesMutex.Unlock()
+
+	if has {
+		renewal.cancel()
+		<-renewal.done
+	}
+}
+
+// ForceUnlock removes the locks on the given stack whose holders are known to have exited, either because their
+// process is no longer running on this machine or because they stopped renewing their lease. Locks that may still be
+// in use are left in place and reported in the returned error.
+func (b *localBackend) ForceUnlock(ctx context.Context, stackRef backend.StackReference) error {
+	return b.withStackLocker(ctx, stackRef, func() error {
+		return b.forceUnlock(ctx, stackRef)
+	})
+}
+
+func (b *localBackend) forceUnlock(ctx context.Context, stackRef backend.StackReference) error {
+	allFiles, err := listBucket(ctx, b.bucket, stackLockDir(stackRef.FullyQualifiedName()))
+	if err != nil {
+		// Don't error if it just wasn't found
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	var live []string
+	for _, file := range allFiles {
+		if file.IsDir {
+			continue
+		}
+
+		l, err := b.readLock(ctx, file.Key)
+		if err != nil {
+			if gcerrors.Code(err) == gcerrors.NotFound {
+				continue
+			}
+			live = append(live, fmt.Sprintf("\n  %v: %v", b.url+"/"+file.Key, err))
+			continue
+		}
+
+		dead, reason := l.holderDead(now, hostname)
+		if !dead {
+			live = append(live, fmt.Sprintf("\n  %v: created by %v@%v (pid %v); %v",
+				b.url+"/"+file.Key, l.Username, l.Hostname, l.Pid, reason))
+			continue
+		}
+
+		if err := b.bucket.Delete(ctx, file.Key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+		b.d.Infof(diag.Message("", "removed lock %v created by %v@%v (pid %v): %v"),
+			b.url+"/"+file.Key, l.Username, l.Hostname, l.Pid, reason)
+	}
+
+	if len(live) > 0 {
+		errorString := fmt.Sprintf("refusing to remove %v lock(s) whose holder may still be running. "+
+			"Use `pulumi cancel` without --force-unlock to remove them anyway.", len(live))
+		for _, line := range live {
+			errorString += line
+		}
+		return errors.New(errorString)
+	}
+	return nil
+}
+
 func lockDir() string {
 	return path.Join(workspace.BookkeepingDir, workspace.LockDir)
 }
@@ -0,0 +1,188 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newLockTestBackend(t *testing.T, s env.MapStore) (*localBackend, backend.StackReference) {
+	t.Helper()
+
+	ctx := context.Background()
+	b, err := newLocalBackend(
+		ctx,
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(t.TempDir()),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(s)},
+	)
+	require.NoError(t, err)
+
+	ref, err := b.ParseStackReference("foo")
+	require.NoError(t, err)
+	_, err = b.CreateStack(ctx, ref, "", nil)
+	require.NoError(t, err)
+	return b, ref
+}
+
+// writeTestLock writes a lock for the given stack as if it were held by another process.
+func writeTestLock(t *testing.T, b *localBackend, ref backend.StackReference, name string, l *lockContent) string {
+	t.Helper()
+
+	content, err := json.Marshal(l)
+	require.NoError(t, err)
+	key := path.Join(stackLockDir(ref.FullyQualifiedName()), name+".json")
+	require.NoError(t, b.bucket.WriteAll(context.Background(), key, content, nil))
+	return key
+}
+
+func lockExists(t *testing.T, b *localBackend, key string) bool {
+	t.Helper()
+
+	exists, err := b.bucket.Exists(context.Background(), key)
+	require.NoError(t, err)
+	return exists
+}
+
+// deadPid returns the pid of a process on this machine that has exited.
+func deadPid(t *testing.T) int {
+	t.Helper()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	require.NoError(t, cmd.Run())
+	return cmd.ProcessState.Pid()
+}
+
+func TestLock_renewsLease(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := make(env.MapStore)
+	s[env.SelfManagedLockLease.Var().Name()] = "1"
+	b, ref := newLockTestBackend(t, s)
+
+	require.NoError(t, b.Lock(ctx, ref))
+	l, err := b.readLock(ctx, b.lockPath(ref))
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, l.Lease)
+	assert.Equal(t, b.lockID, l.LockID)
+
+	// The lease is renewed every fifth of its duration.
+	assert.Eventually(t, func() bool {
+		renewed, err := b.readLock(ctx, b.lockPath(ref))
+		require.NoError(t, err)
+		return renewed.Heartbeat.After(l.Heartbeat)
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// Once unlocked, the lock must not be written back by a renewal.
+	b.Unlock(ctx, ref)
+	time.Sleep(500 * time.Millisecond)
+	assert.False(t, lockExists(t, b, b.lockPath(ref)))
+}
+
+func TestLock_takesOverExpiredLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newLockTestBackend(t, make(env.MapStore))
+
+	stale := writeTestLock(t, b, ref, "stale", &lockContent{
+		Pid:       1,
+		Username:  "someone",
+		Hostname:  "elsewhere",
+		Heartbeat: time.Now().Add(-time.Hour),
+		Lease:     time.Minute,
+	})
+
+	require.NoError(t, b.Lock(ctx, ref))
+	defer b.Unlock(ctx, ref)
+	assert.False(t, lockExists(t, b, stale))
+}
+
+func TestLock_liveLockBlocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newLockTestBackend(t, make(env.MapStore))
+
+	live := writeTestLock(t, b, ref, "live", &lockContent{
+		Pid:       1,
+		Username:  "someone",
+		Hostname:  "elsewhere",
+		Timestamp: time.Now(),
+		Heartbeat: time.Now(),
+		Lease:     time.Minute,
+	})
+
+	err := b.Lock(ctx, ref)
+	assert.ErrorContains(t, err, "the stack is currently locked by 1 lock(s)")
+	assert.ErrorContains(t, err, "someone@elsewhere (pid 1)")
+	assert.ErrorContains(t, err, "last renewed at")
+	assert.True(t, lockExists(t, b, live))
+	assert.False(t, lockExists(t, b, b.lockPath(ref)))
+}
+
+func TestForceUnlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newLockTestBackend(t, make(env.MapStore))
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	now := time.Now()
+
+	expired := writeTestLock(t, b, ref, "expired", &lockContent{
+		Pid: 1, Hostname: "elsewhere", Heartbeat: now.Add(-time.Hour), Lease: time.Minute,
+	})
+	exited := writeTestLock(t, b, ref, "exited", &lockContent{
+		Pid: deadPid(t), Hostname: hostname, Heartbeat: now,
+	})
+	renewed := writeTestLock(t, b, ref, "renewed", &lockContent{
+		Pid: 1, Hostname: "elsewhere", Heartbeat: now, Lease: time.Minute,
+	})
+	legacy := writeTestLock(t, b, ref, "legacy", &lockContent{
+		Pid: 1, Hostname: "elsewhere", Timestamp: now.Add(-time.Hour),
+	})
+	running := writeTestLock(t, b, ref, "running", &lockContent{
+		Pid: os.Getpid(), Hostname: hostname, Heartbeat: now.Add(-time.Hour), Lease: time.Minute,
+	})
+
+	err = b.ForceUnlock(ctx, ref)
+	assert.ErrorContains(t, err, "refusing to remove 3 lock(s)")
+	assert.ErrorContains(t, err, "its lease is valid until")
+	assert.ErrorContains(t, err, "the lock has no lease")
+	assert.ErrorContains(t, err, "is still running")
+
+	assert.False(t, lockExists(t, b, expired))
+	assert.False(t, lockExists(t, b, exited))
+	assert.True(t, lockExists(t, b, renewed))
+	assert.True(t, lockExists(t, b, legacy))
+	assert.True(t, lockExists(t, b, running))
+}
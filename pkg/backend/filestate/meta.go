@@ -16,9 +16,11 @@ package filestate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 
+	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
@@ -104,7 +106,11 @@ func ensurePulumiMeta(ctx context.Context, b Bucket, e env.Env) (*pulumiMeta, er
 	// Implementation detail:
 	// For version 0, WriteTo won't write the metadata file.
 	// See [pulumiMeta.WriteTo] for details on why.
-	if err := meta.WriteTo(ctx, b); err != nil {
+	//
+	// A backend in read-only mode can't write the file,
+	// but it can still use the layout the file would have recorded.
+	var readOnlyErr *backend.ReadOnlyError
+	if err := meta.WriteTo(ctx, b); err != nil && !errors.As(err, &readOnlyErr) {
 		return nil, err
 	}
 
@@ -0,0 +1,206 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/driver"
+	"gocloud.dev/gcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// isBackendPluginScheme returns true if a backend plugin is installed for URLs with the given scheme. The http and
+// https schemes always refer to the Pulumi Cloud, so they are never looked up.
+func isBackendPluginScheme(scheme string) bool {
+	if scheme == "" || scheme == "http" || scheme == "https" {
+		return false
+	}
+	_, err := workspace.GetPluginPath(diag.DefaultSink(io.Discard, io.Discard, diag.FormatOptions{Color: colors.Never}),
+		workspace.BackendPlugin, scheme, nil, nil)
+	return err == nil
+}
+
+// openPluginBucket loads the backend plugin for the scheme of the given URL, and returns a bucket that stores objects
+// through it. The plugin is passed the whole URL, and is responsible for interpreting its path.
+func openPluginBucket(ctx context.Context, d diag.Sink, u *url.URL) (*blob.Bucket, error) {
+	pctx, err := plugin.NewContext(d, d, nil, nil, "", nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	be, err := plugin.NewBackend(pctx, u.Scheme, nil)
+	if err != nil {
+		contract.IgnoreClose(pctx)
+		return nil, fmt.Errorf("loading backend plugin for %s://: %w", u.Scheme, err)
+	}
+
+	if err := be.Configure(ctx, u.String()); err != nil {
+		contract.IgnoreClose(be)
+		contract.IgnoreClose(pctx)
+		return nil, fmt.Errorf("configuring backend plugin for %s://: %w", u.Scheme, err)
+	}
+
+	return newPluginBucket(be, pctx), nil
+}
+
+// newPluginBucket returns a bucket that stores objects using the given backend plugin. Closing the bucket closes the
+// plugin and the given closers.
+func newPluginBucket(be plugin.Backend, closers ...io.Closer) *blob.Bucket {
+	return blob.NewBucket(&pluginBucket{backend: be, closers: closers})
+}
+
+// pluginBucket is a gocloud blob driver that forwards every operation to a backend plugin.
+type pluginBucket struct {
+	backend plugin.Backend
+	closers []io.Closer
+}
+
+var _ driver.Bucket = (*pluginBucket)(nil)
+
+func (b *pluginBucket) ErrorCode(err error) gcerrors.ErrorCode {
+	switch status.Code(err) {
+	case codes.OK:
+		return gcerrors.OK
+	case codes.NotFound:
+		return gcerrors.NotFound
+	case codes.AlreadyExists:
+		return gcerrors.AlreadyExists
+	case codes.InvalidArgument:
+		return gcerrors.InvalidArgument
+	case codes.Internal:
+		return gcerrors.Internal
+	case codes.Unimplemented:
+		return gcerrors.Unimplemented
+	case codes.FailedPrecondition:
+		return gcerrors.FailedPrecondition
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return gcerrors.PermissionDenied
+	case codes.ResourceExhausted:
+		return gcerrors.ResourceExhausted
+	case codes.Canceled:
+		return gcerrors.Canceled
+	case codes.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	default:
+		return gcerrors.Unknown
+	}
+}
+
+func (b *pluginBucket) As(i interface{}) bool {
+	p, ok := i.(*plugin.Backend)
+	if ok {
+		*p = b.backend
+	}
+	return ok
+}
+
+func (b *pluginBucket) ErrorAs(err error, i interface{}) bool {
+	return errors.As(err, i)
+}
+
+func (b *pluginBucket) Attributes(ctx context.Context, key string) (*driver.Attributes, error) {
+	obj, err := b.backend.Attributes(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &driver.Attributes{
+		ContentType: obj.ContentType,
+		ModTime:     obj.ModTime,
+		Size:        obj.Size,
+	}, nil
+}
+
+func (b *pluginBucket) ListPaged(ctx context.Context, opts *driver.ListOptions) (*driver.ListPage, error) {
+	resp, err := b.backend.List(ctx, &plugin.BackendListRequest{
+		Prefix:    opts.Prefix,
+		Delimiter: opts.Delimiter,
+		PageSize:  opts.PageSize,
+		PageToken: string(opts.PageToken),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &driver.ListPage{
+		Objects: make([]*driver.ListObject, len(resp.Objects)),
+	}
+	for i, obj := range resp.Objects {
+		page.Objects[i] = &driver.ListObject{
+			Key:     obj.Key,
+			ModTime: obj.ModTime,
+			Size:    obj.Size,
+			IsDir:   obj.IsDir,
+		}
+	}
+	if resp.NextPageToken != "" {
+		page.NextPageToken = []byte(resp.NextPageToken)
+	}
+	return page, nil
+}
+
+func (b *pluginBucket) NewRangeReader(
+	ctx context.Context, key string, offset, length int64, opts *driver.ReaderOptions,
+) (driver.Reader, error) {
+	content, contentType, err := b.backend.Read(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBufferedReader(content, offset, length, driver.ReaderAttributes{ContentType: contentType}), nil
+}
+
+func (b *pluginBucket) NewTypedWriter(
+	ctx context.Context, key, contentType string, opts *driver.WriterOptions,
+) (driver.Writer, error) {
+	return &bufferedWriter{
+		ctx: ctx,
+		write: func(ctx context.Context, content []byte) error {
+			return b.backend.Write(ctx, key, content, contentType)
+		},
+	}, nil
+}
+
+func (b *pluginBucket) Copy(ctx context.Context, dstKey, srcKey string, opts *driver.CopyOptions) error {
+	return b.backend.Copy(ctx, dstKey, srcKey)
+}
+
+func (b *pluginBucket) Delete(ctx context.Context, key string) error {
+	return b.backend.Delete(ctx, key)
+}
+
+func (b *pluginBucket) SignedURL(ctx context.Context, key string, opts *driver.SignedURLOptions) (string, error) {
+	return "", status.Error(codes.Unimplemented, "backend plugins do not support signed URLs")
+}
+
+func (b *pluginBucket) Close() error {
+	err := b.backend.Close()
+	for _, c := range b.closers {
+		contract.IgnoreClose(c)
+	}
+	return err
+}
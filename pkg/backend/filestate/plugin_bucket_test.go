@@ -0,0 +1,231 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/gcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// memoryBackend is an in-memory plugin.Backend, standing in for a backend plugin.
+type memoryBackend struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	closed  bool
+}
+
+var _ plugin.Backend = (*memoryBackend)(nil)
+
+func newMemoryBackend() *memoryBackend {
+	return &memoryBackend{objects: map[string][]byte{}}
+}
+
+func (b *memoryBackend) Close() error {
+	b.closed = true
+	return nil
+}
+
+func (b *memoryBackend) Configure(ctx context.Context, url string) error {
+	return nil
+}
+
+func (b *memoryBackend) Attributes(ctx context.Context, key string) (*plugin.BackendObject, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	content, ok := b.objects[key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", key)
+	}
+	return &plugin.BackendObject{Key: key, Size: int64(len(content)), ModTime: time.Now()}, nil
+}
+
+func (b *memoryBackend) List(ctx context.Context, req *plugin.BackendListRequest) (*plugin.BackendListResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var keys []string
+	for k := range b.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	resp := &plugin.BackendListResponse{}
+	dirs := map[string]bool{}
+	for _, k := range keys {
+		if !strings.HasPrefix(k, req.Prefix) {
+			continue
+		}
+		if req.Delimiter != "" {
+			if i := strings.Index(k[len(req.Prefix):], req.Delimiter); i >= 0 {
+				dir := k[:len(req.Prefix)+i+len(req.Delimiter)]
+				if !dirs[dir] {
+					dirs[dir] = true
+					resp.Objects = append(resp.Objects, plugin.BackendObject{Key: dir, IsDir: true})
+				}
+				continue
+			}
+		}
+		resp.Objects = append(resp.Objects, plugin.BackendObject{Key: k, Size: int64(len(b.objects[k]))})
+	}
+	return resp, nil
+}
+
+func (b *memoryBackend) Read(ctx context.Context, key string) ([]byte, string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	content, ok := b.objects[key]
+	if !ok {
+		return nil, "", status.Errorf(codes.NotFound, "%s not found", key)
+	}
+	return content, "", nil
+}
+
+func (b *memoryBackend) Write(ctx context.Context, key string, content []byte, contentType string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.objects[key] = append([]byte(nil), content...)
+	return nil
+}
+
+func (b *memoryBackend) Copy(ctx context.Context, dstKey, srcKey string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	content, ok := b.objects[srcKey]
+	if !ok {
+		return status.Errorf(codes.NotFound, "%s not found", srcKey)
+	}
+	b.objects[dstKey] = content
+	return nil
+}
+
+func (b *memoryBackend) Delete(ctx context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.objects[key]; !ok {
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	}
+	delete(b.objects, key)
+	return nil
+}
+
+func TestPluginBucket(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mem := newMemoryBackend()
+	bucket := newPluginBucket(mem)
+
+	err := bucket.WriteAll(ctx, "dir/a.json", []byte(`{"a":1}`), nil)
+	require.NoError(t, err)
+	err = bucket.WriteAll(ctx, "dir/sub/b.json", []byte(`{"b":2}`), nil)
+	require.NoError(t, err)
+
+	data, err := bucket.ReadAll(ctx, "dir/a.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(data))
+
+	exists, err := bucket.Exists(ctx, "dir/a.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = bucket.Exists(ctx, "dir/missing.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = bucket.ReadAll(ctx, "dir/missing.json")
+	assert.Equal(t, gcerrors.NotFound, gcerrors.Code(err))
+
+	files, err := listBucket(ctx, &wrappedBucket{bucket: bucket}, "dir")
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Equal(t, "dir/a.json", files[0].Key)
+	assert.Equal(t, "dir/sub/", files[1].Key)
+	assert.True(t, files[1].IsDir)
+
+	err = bucket.Copy(ctx, "dir/c.json", "dir/a.json", nil)
+	require.NoError(t, err)
+	err = bucket.Delete(ctx, "dir/a.json")
+	require.NoError(t, err)
+	data, err = bucket.ReadAll(ctx, "dir/c.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(data))
+
+	var be plugin.Backend
+	require.True(t, bucket.As(&be))
+	assert.Same(t, mem, be)
+
+	require.NoError(t, bucket.Close())
+	assert.True(t, mem.closed)
+}
+
+func TestPluginBucket_stacks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, err := newLocalBackend(ctx, diagtest.LogSink(t), "file://"+filepath.ToSlash(t.TempDir()),
+		&workspace.Project{Name: "testproj"}, nil)
+	require.NoError(t, err)
+
+	// Swap the bucket out for one backed by a plugin.
+	mem := newMemoryBackend()
+	b.bucket = &wrappedBucket{bucket: newPluginBucket(mem)}
+	b.store = newProjectReferenceStore(b.bucket, b.currentProject.Load)
+
+	fooRef, err := b.ParseStackReference("foo")
+	require.NoError(t, err)
+	_, err = b.CreateStack(ctx, fooRef, "", nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, mem.objects, ".pulumi/stacks/testproj/foo.json")
+
+	stacks, _, err := b.ListStacks(ctx, backend.ListStacksFilter{}, nil /* inContToken */)
+	require.NoError(t, err)
+	require.Len(t, stacks, 1)
+	assert.Equal(t, "foo", stacks[0].Name().String())
+
+	stk, err := b.GetStack(ctx, fooRef)
+	require.NoError(t, err)
+	_, err = b.RemoveStack(ctx, stk, false)
+	require.NoError(t, err)
+	assert.NotContains(t, mem.objects, ".pulumi/stacks/testproj/foo.json")
+}
+
+func TestIsFileStateBackendURL_pluginScheme(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsFileStateBackendURL("file:///tmp/state"))
+	assert.False(t, IsFileStateBackendURL("https://api.pulumi.com"))
+	assert.False(t, IsFileStateBackendURL("nosuchbackend://state"))
+}
@@ -0,0 +1,53 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+
+	"gocloud.dev/blob"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+)
+
+// checkWritable returns a *backend.ReadOnlyError for the given operation if the backend is in read-only mode.
+func (b *localBackend) checkWritable(operation string) error {
+	if b.readOnly {
+		return &backend.ReadOnlyError{Operation: operation}
+	}
+	return nil
+}
+
+// readOnlyBucket is a Bucket that refuses every write. It backs a backend in read-only mode, so that nothing can
+// change the state it reads even if an operation slips past the checks made by the backend itself.
+type readOnlyBucket struct {
+	Bucket
+}
+
+func (b *readOnlyBucket) Copy(ctx context.Context, dstKey, srcKey string, opts *blob.CopyOptions) error {
+	return &backend.ReadOnlyError{Operation: "write " + dstKey}
+}
+
+func (b *readOnlyBucket) Delete(ctx context.Context, key string) error {
+	return &backend.ReadOnlyError{Operation: "delete " + key}
+}
+
+func (b *readOnlyBucket) WriteAll(ctx context.Context, key string, p []byte, opts *blob.WriterOptions) error {
+	return &backend.ReadOnlyError{Operation: "write " + key}
+}
+
+func (b *readOnlyBucket) NewWriter(ctx context.Context, key string, opts *blob.WriterOptions) (*blob.Writer, error) {
+	return nil, &backend.ReadOnlyError{Operation: "write " + key}
+}
@@ -0,0 +1,105 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newReadOnlyTestBackend(t *testing.T, dir string) *localBackend {
+	t.Helper()
+
+	b, err := newLocalBackend(
+		context.Background(),
+		diagtest.LogSink(t), "file://"+filepath.ToSlash(dir),
+		&workspace.Project{Name: "testproj"},
+		&localBackendOptions{Env: env.NewEnv(env.MapStore{env.ReadOnly.Var().Name(): "true"})},
+	)
+	require.NoError(t, err)
+	return b
+}
+
+func TestReadOnly_rejectsWrites(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	_, err := b.saveStack(ctx, ref, journalTestSnapshot(2, 0, "initial"), b64.NewBase64SecretsManager())
+	require.NoError(t, err)
+	dir := filepath.FromSlash(b.url[len(FilePathPrefix):])
+
+	rb := newReadOnlyTestBackend(t, dir)
+	stk, err := rb.GetStack(ctx, ref)
+	require.NoError(t, err)
+	require.NotNil(t, stk)
+
+	// Reading the state still works.
+	deployment, err := rb.ExportDeployment(ctx, stk)
+	require.NoError(t, err)
+
+	// Anything that would write to it doesn't.
+	var readOnlyErr *backend.ReadOnlyError
+	assert.ErrorAs(t, rb.ImportDeployment(ctx, stk, deployment), &readOnlyErr)
+	_, err = rb.RemoveStack(ctx, stk, true)
+	assert.ErrorAs(t, err, &readOnlyErr)
+	_, err = rb.RenameStack(ctx, stk, "bar")
+	assert.ErrorAs(t, err, &readOnlyErr)
+	barRef, err := rb.ParseStackReference("bar")
+	require.NoError(t, err)
+	_, err = rb.CreateStack(ctx, barRef, "", nil)
+	assert.ErrorAs(t, err, &readOnlyErr)
+	_, res := rb.Update(ctx, stk, backend.UpdateOperation{})
+	require.NotNil(t, res)
+	assert.ErrorAs(t, res.Error(), &readOnlyErr)
+	assert.ErrorAs(t, rb.CancelCurrentUpdate(ctx, ref), &readOnlyErr)
+
+	// Writes that get past those checks are still refused by the bucket.
+	assert.ErrorAs(t, rb.Lock(ctx, ref), &readOnlyErr)
+	_, err = rb.saveStack(ctx, ref, journalTestSnapshot(2, 0, "changed"), b64.NewBase64SecretsManager())
+	assert.ErrorAs(t, err, &readOnlyErr)
+
+	// The state is unchanged.
+	chk, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "initial", resourceInputs(chk)[0])
+	stk, err = b.GetStack(ctx, ref)
+	require.NoError(t, err)
+	assert.NotNil(t, stk)
+}
+
+func TestReadOnly_newBucket(t *testing.T) {
+	t.Parallel()
+
+	// Opening an empty bucket in read-only mode doesn't write its metadata file.
+	dir := t.TempDir()
+	b := newReadOnlyTestBackend(t, dir)
+	_, isProjectStore := b.store.(*projectReferenceStore)
+	assert.True(t, isProjectStore)
+
+	_, err := os.Stat(filepath.Join(dir, ".pulumi", "meta.yaml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 )
 
 // localSnapshotManager is a simple SnapshotManager implementation that persists snapshots
@@ -29,10 +30,26 @@ type localSnapshotPersister struct {
 
 	ref     *localBackendReference
 	backend *localBackend
+
+	// journal is the checkpoint journal that saves are appended to, or nil if journaling is disabled.
+	journal *checkpointJournal
 }
 
 func (sp *localSnapshotPersister) Save(snapshot *deploy.Snapshot) error {
-	_, err := sp.backend.saveStack(sp.ctx, sp.ref, snapshot, snapshot.SecretsManager)
+	if sp.journal == nil {
+		_, err := sp.backend.saveStack(sp.ctx, sp.ref, snapshot, snapshot.SecretsManager)
+		return err
+	}
+	_, err := sp.backend.saveStackJournaled(sp.ctx, sp.ref, snapshot, snapshot.SecretsManager, sp.journal)
+	return err
+}
+
+// Compact folds any journal entries written by this persister back into the stack's checkpoint file.
+func (sp *localSnapshotPersister) Compact() error {
+	if sp.journal == nil || sp.journal.sequence == 0 {
+		return nil
+	}
+	_, err := sp.backend.compactJournal(sp.ctx, sp.ref, sp.journal, sp.journal.latest, sp.journal.resources)
 	return err
 }
 
@@ -40,5 +57,12 @@ func (b *localBackend) newSnapshotPersister(
 	ctx context.Context,
 	ref *localBackendReference,
 ) *localSnapshotPersister {
-	return &localSnapshotPersister{ctx: ctx, ref: ref, backend: b}
+	sp := &localSnapshotPersister{ctx: ctx, ref: ref, backend: b}
+	// The journal is opt-in because older CLIs read only the checkpoint file, and would lose the journal's entries if
+	// an update was interrupted before they were compacted. Journal entries also hold resource states in the clear, so
+	// they're not used when checkpoints are encrypted at rest.
+	if b.Env.GetBool(env.SelfManagedJournal) && !b.encryptsState() {
+		sp.journal = &checkpointJournal{}
+	}
+	return sp
 }
@@ -41,16 +41,19 @@ type localStack struct {
 	// a snapshot representing the latest deployment state, allocated on first use. It's valid for the
 	// snapshot itself to be nil.
 	snapshot atomic.Pointer[*deploy.Snapshot]
+	// the stack's tags.
+	tags map[apitype.StackTagName]string
 	// a pointer to the backend this stack belongs to.
 	b *localBackend
 }
 
-func newStack(ref *localBackendReference, b *localBackend) backend.Stack {
+func newStack(ref *localBackendReference, tags map[apitype.StackTagName]string, b *localBackend) backend.Stack {
 	contract.Requiref(ref != nil, "ref", "ref was nil")
 
 	return &localStack{
-		ref: ref,
-		b:   b,
+		ref:  ref,
+		tags: tags,
+		b:    b,
 	}
 }
 
@@ -69,7 +72,7 @@ func (s *localStack) Snapshot(ctx context.Context, secretsProvider secrets.Provi
 	return snap, nil
 }
 func (s *localStack) Backend() backend.Backend              { return s.b }
-func (s *localStack) Tags() map[apitype.StackTagName]string { return nil }
+func (s *localStack) Tags() map[apitype.StackTagName]string { return s.tags }
 
 func (s *localStack) Remove(ctx context.Context, force bool) (bool, error) {
 	return backend.RemoveStack(ctx, s, force)
@@ -126,32 +129,26 @@ func (s *localStack) DefaultSecretManager(info *workspace.ProjectStack) (secrets
 	return passphrase.NewPromptingPassphraseSecretsManager(info, false /* rotatePassphraseSecretsProvider */)
 }
 
+// localStackSummary is a summary of a local stack. Its last update time and resource count are read from the stack's
+// checkpoint the first time they're needed, along with those of the other stacks listed with it.
 type localStackSummary struct {
 	name backend.StackReference
-	chk  *apitype.CheckpointV3
-}
+	page *stackSummaryPage
 
-func newLocalStackSummary(name backend.StackReference, chk *apitype.CheckpointV3) localStackSummary {
-	return localStackSummary{name: name, chk: chk}
+	lastUpdate    *time.Time
+	resourceCount *int
 }
 
-func (lss localStackSummary) Name() backend.StackReference {
+func (lss *localStackSummary) Name() backend.StackReference {
 	return lss.name
 }
 
-func (lss localStackSummary) LastUpdate() *time.Time {
-	if lss.chk != nil && lss.chk.Latest != nil {
-		if t := lss.chk.Latest.Manifest.Time; !t.IsZero() {
-			return &t
-		}
-	}
-	return nil
+func (lss *localStackSummary) LastUpdate() *time.Time {
+	lss.page.load()
+	return lss.lastUpdate
 }
 
-func (lss localStackSummary) ResourceCount() *int {
-	if lss.chk != nil && lss.chk.Latest != nil {
-		count := len(lss.chk.Latest.Resources)
-		return &count
-	}
-	return nil
+func (lss *localStackSummary) ResourceCount() *int {
+	lss.page.load()
+	return lss.resourceCount
 }
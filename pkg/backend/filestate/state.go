@@ -192,12 +192,27 @@ func (b *localBackend) getCheckpoint(ctx context.Context, ref *localBackendRefer
 	if err != nil {
 		return nil, err
 	}
-	m := encoding.JSON
-	if encoding.IsCompressed(bytes) {
-		m = encoding.Gzip(m)
+	chk, err := b.decodeCheckpoint(ctx, ref, chkpath, bytes)
+	if err != nil {
+		// Refuse to use a corrupted checkpoint, but fall back to a backup of it if allowed to. The corrupted file is
+		// replaced as a whole, so it's not written against a version.
+		var corrupt *CorruptCheckpointError
+		if !errors.As(err, &corrupt) {
+			return nil, err
+		}
+		b.forgetStateVersion(ref)
+		if bytes, chk, err = b.recoverCheckpoint(ctx, ref, corrupt); err != nil {
+			return nil, err
+		}
 	}
 
-	return stack.UnmarshalVersionedCheckpointToLatestCheckpoint(m, bytes)
+	// Apply any journal entries written since this checkpoint file was last rewritten in full.
+	applied, _, err := b.replayJournal(ctx, ref, bytes, chk)
+	if err != nil {
+		return nil, err
+	}
+	b.recordStateVersion(ref, checkpointChecksum(bytes), applied)
+	return chk, nil
 }
 
 func (b *localBackend) saveCheckpoint(
@@ -205,47 +220,64 @@ func (b *localBackend) saveCheckpoint(
 	ref *localBackendReference,
 	checkpoint *apitype.VersionedCheckpoint,
 ) (backupFile string, file string, _ error) {
+	// Any journal left behind describes changes to the checkpoint we're about to replace, so it must go first. If we
+	// crash after this point we lose nothing, as the new checkpoint supersedes it anyway.
+	if err := b.removeJournal(ctx, ref); err != nil {
+		return "", "", err
+	}
+
+	backupFile, file, _, err := b.writeCheckpoint(ctx, ref, checkpoint)
+	return backupFile, file, err
+}
+
+// writeCheckpoint writes out the full checkpoint file for the given stack, returning the bytes that were written.
+func (b *localBackend) writeCheckpoint(
+	ctx context.Context,
+	ref *localBackendReference,
+	checkpoint *apitype.VersionedCheckpoint,
+) (backupFile string, file string, _ []byte, _ error) {
 	// Make a serializable stack and then use the encoder to encode it.
-	file = b.stackPath(ctx, ref)
-	m, ext := encoding.Detect(strings.TrimSuffix(file, ".gz"))
-	if m == nil {
-		return "", "", fmt.Errorf("resource serialization failed; illegal markup extension: '%v'", ext)
+	file, filePlain, m, err := b.checkpointFile(ctx, ref)
+	if err != nil {
+		return "", "", nil, err
 	}
-	if filepath.Ext(file) == "" {
-		file = file + ext
+
+	byts, err := m.Marshal(checkpoint)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("An IO error occurred while marshalling the checkpoint: %w", err)
 	}
-	if b.gzip {
-		if filepath.Ext(file) != encoding.GZIPExt {
-			file = file + ".gz"
+	if b.encryptsState() {
+		if byts, err = encryptCheckpoint(ctx, checkpoint, byts); err != nil {
+			return "", "", nil, err
 		}
-		m = encoding.Gzip(m)
-	} else {
-		file = strings.TrimSuffix(file, ".gz")
 	}
 
-	byts, err := m.Marshal(checkpoint)
+	// The checkpoint is only written if no other update has changed it since we last read or wrote it. Its checksum
+	// is recorded alongside it so that corruption can be detected when it's read back.
+	checksum := checkpointChecksum(byts)
+	checksumOpts := withChecksumValue(b.compression.writerOptions(), checksum)
+	opts, err := b.withStateVersion(ctx, ref, file, checksumOpts)
 	if err != nil {
-		return "", "", fmt.Errorf("An IO error occurred while marshalling the checkpoint: %w", err)
+		return "", "", nil, err
 	}
-
-	// Back up the existing file if it already exists. Don't delete the original, the following WriteAll will
-	// atomically replace it anyway and various other bits of the system depend on being able to find the
-	// .json file to know the stack currently exists (see https://github.com/pulumi/pulumi/issues/9033 for
-	// context).
-	filePlain := strings.TrimSuffix(file, ".gz")
-	fileGzip := filePlain + ".gz"
-	// We need to make sure that an out of date state file doesn't exist so we
-	// only keep the file of the type we are working with.
-	bckGzip := backupTarget(ctx, b.bucket, fileGzip, b.gzip)
-	bckPlain := backupTarget(ctx, b.bucket, filePlain, !b.gzip)
-	if b.gzip {
-		backupFile = bckGzip
-	} else {
-		backupFile = bckPlain
+	write := func() error {
+		err := b.bucket.WriteAll(ctx, file, byts, opts)
+		if err != nil && isPreconditionFailed(err) {
+			return b.conflictError(ctx, ref, file, checksum)
+		}
+		return err
 	}
 
+	backupFile = b.backupCheckpoint(ctx, filePlain)
+
 	// And now write out the new snapshot file, overwriting that location.
-	if err = b.bucket.WriteAll(ctx, file, byts, nil); err != nil {
+	if err = write(); err != nil {
+		// There's no point retrying a write that the backend refuses to make.
+		var readOnlyErr *backend.ReadOnlyError
+		var conflictErr *ConflictError
+		if errors.As(err, &readOnlyErr) || errors.As(err, &conflictErr) {
+			return backupFile, "", nil, err
+		}
 
 		b.mutex.Lock()
 		defer b.mutex.Unlock()
@@ -262,7 +294,11 @@ func (b *localBackend) saveCheckpoint(
 			Backoff:  &backoff,
 			Accept: func(try int, nextRetryTime time.Duration) (bool, interface{}, error) {
 				// And now write out the new snapshot file, overwriting that location.
-				err := b.bucket.WriteAll(ctx, file, byts, nil)
+				err := write()
+				var conflictErr *ConflictError
+				if errors.As(err, &conflictErr) {
+					return false, nil, err
+				}
 				if err != nil {
 					logging.V(7).Infof("Error while writing snapshot to: %s (attempt=%d, error=%s)", file, try, err)
 					if try > 10 {
@@ -274,20 +310,58 @@ func (b *localBackend) saveCheckpoint(
 			},
 		})
 		if err != nil {
-			return backupFile, "", err
+			return backupFile, "", nil, err
 		}
 	}
 
+	b.recordStateVersion(ref, checksum, 0)
 	logging.V(7).Infof("Saved stack %s checkpoint to: %s (backup=%s)", ref.FullyQualifiedName(), file, backupFile)
 
 	// And if we are retaining historical checkpoint information, write it out again
 	if b.Env.GetBool(env.SelfManagedRetainCheckpoints) {
-		if err = b.bucket.WriteAll(ctx, fmt.Sprintf("%v.%v", file, time.Now().UnixNano()), byts, nil); err != nil {
-			return backupFile, "", fmt.Errorf("An IO error occurred while writing the new snapshot file: %w", err)
+		err = b.bucket.WriteAll(ctx, fmt.Sprintf("%v.%v", file, time.Now().UnixNano()), byts, checksumOpts)
+		if err != nil {
+			return backupFile, "", nil, fmt.Errorf("An IO error occurred while writing the new snapshot file: %w", err)
 		}
 	}
 
-	return backupFile, file, nil
+	return backupFile, file, byts, nil
+}
+
+// checkpointFile returns the file the checkpoint for the given stack is written to, the same name without the
+// extension of the compression it's written with, and the marshaler to write it with.
+func (b *localBackend) checkpointFile(
+	ctx context.Context, ref *localBackendReference,
+) (file, filePlain string, _ encoding.Marshaler, _ error) {
+	file = b.stackPath(ctx, ref)
+	filePlain = trimCompressionExt(file)
+	m, ext := encoding.Detect(filePlain)
+	if m == nil {
+		return "", "", nil, fmt.Errorf("resource serialization failed; illegal markup extension: '%v'", ext)
+	}
+	if filepath.Ext(filePlain) == "" {
+		filePlain = filePlain + ext
+	}
+	return filePlain + b.compression.ext(), filePlain, b.compression.marshaler(m), nil
+}
+
+// backupCheckpoint backs up the existing checkpoint file with the given name, in any compression, before it's
+// replaced. It returns the backup of the file in the compression we write with, if any.
+func (b *localBackend) backupCheckpoint(ctx context.Context, filePlain string) (backupFile string) {
+	// Back up the existing file if it already exists. Don't delete the original, the following WriteAll will
+	// atomically replace it anyway and various other bits of the system depend on being able to find the
+	// .json file to know the stack currently exists (see https://github.com/pulumi/pulumi/issues/9033 for
+	// context).
+	//
+	// We need to make sure that an out of date state file doesn't exist so we
+	// only keep the file of the type we are working with.
+	for _, c := range stateCompressions {
+		bck := backupTarget(ctx, b.bucket, filePlain+c.ext(), c == b.compression)
+		if c == b.compression {
+			backupFile = bck
+		}
+	}
+	return backupFile
 }
 
 func (b *localBackend) saveStack(
@@ -328,6 +402,15 @@ func (b *localBackend) removeStack(ctx context.Context, ref *localBackendReferen
 	file := b.stackPath(ctx, ref)
 	backupTarget(ctx, b.bucket, file, false)
 
+	if err := b.removeJournal(ctx, ref); err != nil {
+		return err
+	}
+	b.forgetStateVersion(ref)
+
+	if err := b.removeStackMetadata(ctx, ref); err != nil {
+		return err
+	}
+
 	historyDir := ref.HistoryDir()
 	return removeAllByPrefix(ctx, b.bucket, historyDir)
 }
@@ -376,7 +459,7 @@ func (b *localBackend) backupStack(ctx context.Context, ref *localBackendReferen
 	stackFile := filepath.Base(stackPath)
 	ext := filepath.Ext(stackFile)
 	base := strings.TrimSuffix(stackFile, ext)
-	if ext2 := filepath.Ext(base); ext2 != "" && ext == encoding.GZIPExt {
+	if ext2 := filepath.Ext(base); ext2 != "" && isCompressionExt(ext) {
 		// base: stack-name.json, ext: .gz
 		// ->
 		// base: stack-name, ext: .json.gz
@@ -396,14 +479,13 @@ func (b *localBackend) stackPath(ctx context.Context, ref *localBackendReference
 	// "dir" option to listBucket is always suffixed with "/". Also means we don't need to save any
 	// results in a slice.
 	plainPath := filepath.ToSlash(ref.StackBasePath()) + ".json"
-	gzipedPath := plainPath + ".gz"
 
 	bucketIter := b.bucket.List(&blob.ListOptions{
 		Delimiter: "/",
 		Prefix:    plainPath,
 	})
 
-	var plainObj *blob.ListObject
+	var latest *blob.ListObject
 	for {
 		file, err := bucketIter.Next(ctx)
 		if err == io.EOF {
@@ -414,35 +496,43 @@ func (b *localBackend) stackPath(ctx context.Context, ref *localBackendReference
 			return plainPath
 		}
 
-		// plainObj will always come out first since allObjs is sorted by Key
-		if file.Key == plainPath {
-			plainObj = file
-		} else if file.Key == gzipedPath {
-			// We have a plain .json file and it was modified after this gzipped one so use it.
-			if plainObj != nil && plainObj.ModTime.After(file.ModTime) {
-				return plainPath
-			}
-			// else use the gzipped object
-			return gzipedPath
+		// Skip anything that isn't the stack file itself in one of the supported compressions (e.g. *.bak files).
+		if trimCompressionExt(file.Key) != plainPath {
+			continue
+		}
+
+		// The plain .json file will always come out first since objects are sorted by Key. A compressed file
+		// wins unless an earlier file was modified after it.
+		if latest == nil || !latest.ModTime.After(file.ModTime) {
+			latest = file
 		}
 	}
-	// Couldn't find any objects, assume nongzipped path?
-	return plainPath
+	if latest == nil {
+		// Couldn't find any objects, assume nongzipped path?
+		return plainPath
+	}
+	return latest.Key
 }
 
-// getHistory returns locally stored update history. The first element of the result will be
-// the most recent update record.
-func (b *localBackend) getHistory(
-	ctx context.Context,
-	stack *localBackendReference,
-	pageSize int, page int,
-) ([]backend.UpdateInfo, error) {
-	contract.Requiref(stack != nil, "stack", "must not be nil")
+// defaultHistoryLimit is the number of updates kept in each stack's history, unless overridden by
+// PULUMI_SELF_MANAGED_STATE_HISTORY_LIMIT.
+const defaultHistoryLimit = 1000
 
-	dir := stack.HistoryDir()
-	// TODO: we could consider optimizing the list operation using `page` and `pageSize`.
-	// Unfortunately, this is mildly invasive given the gocloud List API.
-	allFiles, err := listBucket(ctx, b.bucket, dir)
+// historyLimit returns the number of updates to keep in each stack's history, or 0 to keep every update.
+func (b *localBackend) historyLimit() int {
+	switch n := b.Env.GetInt(env.SelfManagedHistoryLimit); {
+	case n < 0:
+		return 0
+	case n == 0:
+		return defaultHistoryLimit
+	default:
+		return n
+	}
+}
+
+// listHistory returns the history files for the given stack, oldest first.
+func (b *localBackend) listHistory(ctx context.Context, stack *localBackendReference) ([]*blob.ListObject, error) {
+	allFiles, err := listBucket(ctx, b.bucket, stack.HistoryDir())
 	if err != nil {
 		// History doesn't exist until a stack has been updated.
 		if gcerrors.Code(err) == gcerrors.NotFound {
@@ -451,23 +541,60 @@ func (b *localBackend) getHistory(
 		return nil, err
 	}
 
+	// filter down to just history entries. listBucket returns the array sorted by file name, and because of how we
+	// name files, older updates come before newer ones.
 	var historyEntries []*blob.ListObject
-
-	// filter down to just history entries, reversing list to be in most recent order.
-	// listBucket returns the array sorted by file name, but because of how we name files, older updates come before
-	// newer ones.
-	for i := len(allFiles) - 1; i >= 0; i-- {
-		file := allFiles[i]
-		filepath := file.Key
-
+	for _, file := range allFiles {
 		// ignore checkpoints
-		if !strings.HasSuffix(filepath, ".history.json") &&
-			!strings.HasSuffix(filepath, ".history.json.gz") {
+		if !strings.HasSuffix(trimCompressionExt(file.Key), ".history.json") {
 			continue
 		}
-
 		historyEntries = append(historyEntries, file)
 	}
+	return historyEntries, nil
+}
+
+// readHistory reads the update recorded in the given history file. Updates recorded by older versions of the CLI don't
+// have a version, so they are numbered by their position in the history instead: index is the number of older
+// history files.
+func (b *localBackend) readHistory(ctx context.Context, file string, index int) (backend.UpdateInfo, error) {
+	var update backend.UpdateInfo
+	byts, err := b.bucket.ReadAll(ctx, file)
+	if err != nil {
+		return update, fmt.Errorf("reading history file %s: %w", file, err)
+	}
+	err = detectMarshaler(byts).Unmarshal(byts, &update)
+	if err != nil {
+		return update, fmt.Errorf("reading history file %s: %w", file, err)
+	}
+	if update.Version == 0 {
+		update.Version = index + 1
+	}
+	return update, nil
+}
+
+// historyCheckpointFile returns the name of the checkpoint file saved alongside the given history file.
+func historyCheckpointFile(historyFile string) string {
+	i := strings.LastIndex(historyFile, ".history.")
+	contract.Assertf(i >= 0, "history file %q must contain .history.", historyFile)
+	return historyFile[:i] + ".checkpoint." + historyFile[i+len(".history."):]
+}
+
+// getHistory returns locally stored update history. The first element of the result will be
+// the most recent update record.
+func (b *localBackend) getHistory(
+	ctx context.Context,
+	stack *localBackendReference,
+	pageSize int, page int,
+) ([]backend.UpdateInfo, error) {
+	contract.Requiref(stack != nil, "stack", "must not be nil")
+
+	// TODO: we could consider optimizing the list operation using `page` and `pageSize`.
+	// Unfortunately, this is mildly invasive given the gocloud List API.
+	historyEntries, err := b.listHistory(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
 
 	start := 0
 	end := len(historyEntries) - 1
@@ -484,28 +611,71 @@ func (b *localBackend) getHistory(
 
 	var updates []backend.UpdateInfo
 
+	// Walk the history in most recent order.
 	for i := start; i <= end; i++ {
-		file := historyEntries[i]
-		filepath := file.Key
+		index := len(historyEntries) - 1 - i
+		update, err := b.readHistory(ctx, historyEntries[index].Key, index)
+		if err != nil {
+			return nil, err
+		}
 
-		var update backend.UpdateInfo
-		b, err := b.bucket.ReadAll(ctx, filepath)
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// getHistoricalCheckpoint loads the checkpoint saved after the update with the given version.
+func (b *localBackend) getHistoricalCheckpoint(
+	ctx context.Context,
+	ref *localBackendReference,
+	version int,
+) (*apitype.CheckpointV3, error) {
+	contract.Requiref(ref != nil, "ref", "must not be nil")
+
+	historyEntries, err := b.listHistory(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// Versions increase with every update, so search from the most recent one down.
+	for index := len(historyEntries) - 1; index >= 0; index-- {
+		update, err := b.readHistory(ctx, historyEntries[index].Key, index)
 		if err != nil {
-			return nil, fmt.Errorf("reading history file %s: %w", filepath, err)
+			return nil, err
 		}
-		m := encoding.JSON
-		if encoding.IsCompressed(b) {
-			m = encoding.Gzip(m)
+		if update.Version < version {
+			break
 		}
-		err = m.Unmarshal(b, &update)
-		if err != nil {
-			return nil, fmt.Errorf("reading history file %s: %w", filepath, err)
+		if update.Version != version {
+			continue
 		}
 
-		updates = append(updates, update)
+		file := historyCheckpointFile(historyEntries[index].Key)
+		byts, err := b.bucket.ReadAll(ctx, file)
+		if err != nil {
+			return nil, fmt.Errorf("reading checkpoint file %s: %w", file, err)
+		}
+		if byts, err = decryptCheckpoint(ctx, byts); err != nil {
+			return nil, fmt.Errorf("reading checkpoint file %s: %w", file, err)
+		}
+		return stack.UnmarshalVersionedCheckpointToLatestCheckpoint(detectMarshaler(byts), byts)
 	}
 
-	return updates, nil
+	return nil, fmt.Errorf("stack %v has no update with version %d", ref.FullyQualifiedName(), version)
+}
+
+// pruneHistory deletes the oldest updates in the given history files, along with their checkpoints, so that at most
+// limit updates remain.
+func (b *localBackend) pruneHistory(ctx context.Context, historyEntries []*blob.ListObject, limit int) {
+	for i := 0; i < len(historyEntries)-limit; i++ {
+		file := historyEntries[i].Key
+		for _, f := range []string{historyCheckpointFile(file), file} {
+			if err := b.bucket.Delete(ctx, f); err != nil {
+				logging.V(5).Infof("error deleting history file %s: %v", f, err)
+			}
+		}
+	}
 }
 
 func (b *localBackend) renameHistory(ctx context.Context, oldName, newName *localBackendReference) error {
@@ -560,10 +730,22 @@ func (b *localBackend) addToHistory(ctx context.Context, ref *localBackendRefere
 	// Prefix for the update and checkpoint files.
 	pathPrefix := path.Join(dir, fmt.Sprintf("%s-%d", ref.name, time.Now().UnixNano()))
 
-	m, ext := encoding.JSON, "json"
-	if b.gzip {
-		m = encoding.Gzip(m)
-		ext += ".gz"
+	m, ext := b.compression.marshaler(encoding.JSON), "json"+b.compression.ext()
+
+	// Number this update after the most recent one.
+	historyEntries, err := b.listHistory(ctx, ref)
+	if err != nil {
+		return err
+	}
+	update.Version = len(historyEntries) + 1
+	if n := len(historyEntries); n > 0 {
+		last, err := b.readHistory(ctx, historyEntries[n-1].Key, n-1)
+		if err != nil {
+			return err
+		}
+		if last.Version >= update.Version {
+			update.Version = last.Version + 1
+		}
 	}
 
 	// Save the history file.
@@ -573,11 +755,19 @@ func (b *localBackend) addToHistory(ctx context.Context, ref *localBackendRefere
 	}
 
 	historyFile := fmt.Sprintf("%s.history.%s", pathPrefix, ext)
-	if err = b.bucket.WriteAll(ctx, historyFile, byts, nil); err != nil {
+	if err = b.bucket.WriteAll(ctx, historyFile, byts, b.compression.writerOptions()); err != nil {
 		return err
 	}
 
 	// Make a copy of the checkpoint file. (Assuming it already exists.)
 	checkpointFile := fmt.Sprintf("%s.checkpoint.%s", pathPrefix, ext)
-	return b.bucket.Copy(ctx, checkpointFile, b.stackPath(ctx, ref), nil)
+	if err = b.bucket.Copy(ctx, checkpointFile, b.stackPath(ctx, ref), nil); err != nil {
+		return err
+	}
+
+	// Finally, drop the oldest updates if the history has grown past its limit.
+	if limit := b.historyLimit(); limit > 0 {
+		b.pruneHistory(ctx, historyEntries, limit-1)
+	}
+	return nil
 }
@@ -46,6 +46,10 @@ var (
 	// BackupsDir is a path under the state's root directory
 	// where the filestate backend stores backups of stacks.
 	BackupsDir = filepath.Join(workspace.BookkeepingDir, workspace.BackupDir)
+
+	// JournalsDir is a path under the state's root directory
+	// where the filestate backend stores checkpoint journals for all stacks.
+	JournalsDir = filepath.Join(workspace.BookkeepingDir, workspace.JournalDir)
 )
 
 // referenceStore stores and provides access to stack information.
@@ -60,7 +64,7 @@ type referenceStore interface {
 	//
 	// This is the path to the file without the extension.
 	// The real file path is StackBasePath + ".json"
-	// or StackBasePath + ".json.gz".
+	// or StackBasePath + ".json.gz" or StackBasePath + ".json.zst".
 	StackBasePath(*localBackendReference) string
 
 	// HistoryDir returns the path to the directory
@@ -75,9 +79,24 @@ type referenceStore interface {
 	// This must be under BackupsDir.
 	BackupDir(*localBackendReference) string
 
+	// JournalDir returns the path to the directory
+	// where journal entries for this stack's checkpoint are stored.
+	//
+	// This must be under JournalsDir.
+	JournalDir(*localBackendReference) string
+
 	// ListReferences lists all stack references in the store.
 	ListReferences(context.Context) ([]*localBackendReference, error)
 
+	// ListReferencesPage lists the stack references in a page of at most pageSize objects of the store,
+	// starting at the given page token (blob.FirstPageToken for the first page).
+	// If project is not empty, only the stacks of that project are listed, if the store can tell them apart.
+	//
+	// The returned page token is empty once there are no more pages.
+	ListReferencesPage(
+		ctx context.Context, project tokens.Name, pageToken []byte, pageSize int,
+	) ([]*localBackendReference, []byte, error)
+
 	// ParseReference parses a localBackendReference from a string.
 	ParseReference(ref string) (*localBackendReference, error)
 
@@ -133,6 +152,11 @@ func (p *projectReferenceStore) BackupDir(stack *localBackendReference) string {
 	return filepath.Join(BackupsDir, fsutil.NamePath(stack.project), stack.name.String())
 }
 
+func (p *projectReferenceStore) JournalDir(stack *localBackendReference) string {
+	contract.Requiref(stack.project != "", "ref.project", "must not be empty")
+	return filepath.Join(JournalsDir, fsutil.NamePath(stack.project), stack.name.String())
+}
+
 func (p *projectReferenceStore) ParseReference(stackRef string) (*localBackendReference, error) {
 	// We accept the following forms:
 	//
@@ -265,51 +289,90 @@ func (p *projectReferenceStore) ListReferences(ctx context.Context) ([]*localBac
 			return nil, fmt.Errorf("list bucket: %w", err)
 		}
 
-		if file.IsDir {
-			continue
+		if ref, ok := p.referenceForObject(file); ok {
+			stacks = append(stacks, ref)
 		}
+	}
+	return stacks, nil
+}
 
-		// Key is in the form,
-		//   $StacksDir/$projName/$stackName.json[.gz]
-		// We want to extract projName and stackName from it.
+func (p *projectReferenceStore) ListReferencesPage(
+	ctx context.Context, project tokens.Name, pageToken []byte, pageSize int,
+) ([]*localBackendReference, []byte, error) {
+	prefix := filepath.ToSlash(StacksDir) + "/"
+	if project != "" {
+		prefix += fsutil.NamePath(project) + "/"
+	}
+	files, nextPageToken, err := p.bucket.ListPage(ctx, pageToken, pageSize, &blob.ListOptions{
+		Prefix: prefix,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("list bucket: %w", err)
+	}
 
-		parts := strings.Split(strings.TrimPrefix(file.Key, prefix), "/")
-		if len(parts) != 2 {
-			continue // skip paths too shallow or too deep
+	stacks := slice.Prealloc[*localBackendReference](len(files))
+	for _, file := range files {
+		if ref, ok := p.referenceForObject(file); ok {
+			stacks = append(stacks, ref)
 		}
-		projName := parts[0]
-		objName := parts[1]
+	}
+	return stacks, nextPageToken, nil
+}
 
-		if !tokens.IsName(projName) {
-			// If this isn't a valid Name
-			// it won't be a project directory,
-			// so skip it.
-			continue
-		}
+// referenceForObject returns a reference to the stack whose checkpoint is the given object,
+// or false if it isn't a checkpoint.
+func (p *projectReferenceStore) referenceForObject(file *blob.ListObject) (*localBackendReference, bool) {
+	if file.IsDir {
+		return nil, false
+	}
 
-		// Skip files without valid extensions (e.g., *.bak files).
-		ext := filepath.Ext(objName)
-		// But accept gzip compression
-		if ext == encoding.GZIPExt {
-			objName = strings.TrimSuffix(objName, encoding.GZIPExt)
-			ext = filepath.Ext(objName)
-		}
+	// Key is in the form,
+	//   $StacksDir/$projName/$stackName.json[.gz]
+	// We want to extract projName and stackName from it.
 
-		if _, has := encoding.Marshalers[ext]; !has {
-			continue
-		}
+	parts := strings.Split(strings.TrimPrefix(file.Key, filepath.ToSlash(StacksDir)+"/"), "/")
+	if len(parts) != 2 {
+		return nil, false // skip paths too shallow or too deep
+	}
+	projName := parts[0]
 
-		// Read in this stack's information.
-		name := objName[:len(objName)-len(ext)]
-		parsedName, err := tokens.ParseStackName(name)
-		if err != nil {
-			// This looked like a stack file, but it wasn't a valid stack name so skip it.
-			continue
-		}
+	if !tokens.IsName(projName) {
+		// If this isn't a valid Name
+		// it won't be a project directory,
+		// so skip it.
+		return nil, false
+	}
 
-		stacks = append(stacks, p.newReference(tokens.Name(projName), parsedName))
+	name, ok := parseCheckpointName(parts[1])
+	if !ok {
+		return nil, false
 	}
-	return stacks, nil
+	return p.newReference(tokens.Name(projName), name), true
+}
+
+// parseCheckpointName returns the name of the stack whose checkpoint has the given file name,
+// or false if it isn't the name of a checkpoint.
+func parseCheckpointName(objName string) (tokens.StackName, bool) {
+	// Skip files without valid extensions (e.g., *.bak files).
+	ext := filepath.Ext(objName)
+	// But accept compressed files
+	if isCompressionExt(ext) {
+		objName = strings.TrimSuffix(objName, ext)
+		ext = filepath.Ext(objName)
+	}
+
+	if _, has := encoding.Marshalers[ext]; !has {
+		return tokens.StackName{}, false
+	}
+
+	// Read in this stack's information.
+	name := objName[:len(objName)-len(ext)]
+	parsedName, err := tokens.ParseStackName(name)
+	if err != nil {
+		// This looked like a stack file, but it wasn't a valid stack name so skip it.
+		return tokens.StackName{}, false
+	}
+	return parsedName, true
 }
 
 // legacyReferenceStore is a referenceStore that stores stack
@@ -354,6 +417,11 @@ func (p *legacyReferenceStore) BackupDir(stack *localBackendReference) string {
 	return filepath.Join(BackupsDir, stack.name.String())
 }
 
+func (p *legacyReferenceStore) JournalDir(stack *localBackendReference) string {
+	contract.Requiref(stack.project == "", "ref.project", "must be empty")
+	return filepath.Join(JournalsDir, stack.name.String())
+}
+
 func (p *legacyReferenceStore) ParseReference(stackRef string) (*localBackendReference, error) {
 	parsedName, err := tokens.ParseStackName(stackRef)
 	if err != nil {
@@ -377,33 +445,45 @@ func (p *legacyReferenceStore) ListReferences(ctx context.Context) ([]*localBack
 	stacks := slice.Prealloc[*localBackendReference](len(files))
 
 	for _, file := range files {
-		if file.IsDir {
-			continue
+		if ref, ok := p.referenceForObject(file); ok {
+			stacks = append(stacks, ref)
 		}
+	}
 
-		objName := objectName(file)
-		// Skip files without valid extensions (e.g., *.bak files).
-		ext := filepath.Ext(objName)
-		// But accept gzip compression
-		if ext == encoding.GZIPExt {
-			objName = strings.TrimSuffix(objName, encoding.GZIPExt)
-			ext = filepath.Ext(objName)
-		}
+	return stacks, nil
+}
 
-		if _, has := encoding.Marshalers[ext]; !has {
-			continue
-		}
+func (p *legacyReferenceStore) ListReferencesPage(
+	ctx context.Context, _ tokens.Name, pageToken []byte, pageSize int,
+) ([]*localBackendReference, []byte, error) {
+	// Legacy stacks don't belong to projects, so all of them are listed.
+	files, nextPageToken, err := p.bucket.ListPage(ctx, pageToken, pageSize, &blob.ListOptions{
+		Delimiter: "/",
+		Prefix:    StacksDir + "/",
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing stacks: %w", err)
+	}
 
-		// Read in this stack's information.
-		name := objName[:len(objName)-len(ext)]
-		parsedName, err := tokens.ParseStackName(name)
-		if err != nil {
-			// This looked like a stack file, but it wasn't a valid stack name so skip it.
-			continue
+	stacks := slice.Prealloc[*localBackendReference](len(files))
+	for _, file := range files {
+		if ref, ok := p.referenceForObject(file); ok {
+			stacks = append(stacks, ref)
 		}
+	}
+	return stacks, nextPageToken, nil
+}
 
-		stacks = append(stacks, p.newReference(parsedName))
+// referenceForObject returns a reference to the stack whose checkpoint is the given object,
+// or false if it isn't a checkpoint.
+func (p *legacyReferenceStore) referenceForObject(file *blob.ListObject) (*localBackendReference, bool) {
+	if file.IsDir {
+		return nil, false
 	}
 
-	return stacks, nil
+	name, ok := parseCheckpointName(objectName(file))
+	if !ok {
+		return nil, false
+	}
+	return p.newReference(name), true
 }
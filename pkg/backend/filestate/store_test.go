@@ -40,6 +40,7 @@ func TestLegacyReferenceStore_referencePaths(t *testing.T) {
 	assert.Equal(t, ".pulumi/stacks/foo", ref.StackBasePath())
 	assert.Equal(t, ".pulumi/history/foo", ref.HistoryDir())
 	assert.Equal(t, ".pulumi/backups/foo", ref.BackupDir())
+	assert.Equal(t, ".pulumi/journals/foo", ref.JournalDir())
 }
 
 func TestProjectReferenceStore_referencePaths(t *testing.T) {
@@ -56,6 +57,7 @@ func TestProjectReferenceStore_referencePaths(t *testing.T) {
 	assert.Equal(t, ".pulumi/stacks/myproject/mystack", ref.StackBasePath())
 	assert.Equal(t, ".pulumi/history/myproject/mystack", ref.HistoryDir())
 	assert.Equal(t, ".pulumi/backups/myproject/mystack", ref.BackupDir())
+	assert.Equal(t, ".pulumi/journals/myproject/mystack", ref.JournalDir())
 }
 
 func TestProjectReferenceStore_ParseReference(t *testing.T) {
@@ -247,6 +249,13 @@ func TestLegacyReferenceStore_ListReferences(t *testing.T) {
 			},
 			want: []tokens.QName{"foo"},
 		},
+		{
+			desc: "zstd compressed",
+			files: []string{
+				".pulumi/stacks/foo.json.zst",
+			},
+			want: []tokens.QName{"foo"},
+		},
 		{
 			desc: "multiple",
 			files: []string{
@@ -330,6 +339,14 @@ func TestProjectReferenceStore_List(t *testing.T) {
 			stacks:   []tokens.QName{"organization/foo/bar"},
 			projects: []tokens.Name{"foo"},
 		},
+		{
+			desc: "zstd compressed",
+			files: []string{
+				".pulumi/stacks/foo/bar.json.zst",
+			},
+			stacks:   []tokens.QName{"organization/foo/bar"},
+			projects: []tokens.Name{"foo"},
+		},
 		{
 			desc: "multiple",
 			files: []string{
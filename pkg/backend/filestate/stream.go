@@ -0,0 +1,331 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// errCannotStream is returned when a checkpoint can't be streamed, and has to be read into memory instead.
+var errCannotStream = errors.New("checkpoint can't be streamed")
+
+// ExportDeploymentStream writes the deployment for the given stack to w as a deployment stream, reading the
+// checkpoint file one resource at a time.
+//
+// Checkpoints that have journal entries to replay onto them, are corrupted, or were written by an older version of
+// the CLI are read into memory instead, as they can only be made sense of as a whole.
+func (b *localBackend) ExportDeploymentStream(ctx context.Context, stk backend.Stack, w io.Writer) error {
+	ref, err := b.getReference(stk.Ref())
+	if err != nil {
+		return err
+	}
+
+	err = b.exportDeploymentStream(ctx, ref, w)
+	if !errors.Is(err, errCannotStream) {
+		return err
+	}
+
+	logging.V(5).Infof("reading checkpoint for stack %s into memory: %v", ref.FullyQualifiedName(), err)
+	deployment, err := b.ExportDeployment(ctx, stk)
+	if err != nil {
+		return err
+	}
+	return stack.EncodeUntypedDeploymentStream(w, deployment)
+}
+
+func (b *localBackend) exportDeploymentStream(ctx context.Context, ref *localBackendReference, w io.Writer) error {
+	journal, err := listBucket(ctx, b.bucket, ref.JournalDir())
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return fmt.Errorf("listing checkpoint journal: %w", err)
+	}
+	if len(journal) > 0 {
+		return fmt.Errorf("%w: it has journal entries", errCannotStream)
+	}
+
+	// Verify the checkpoint before writing any of it out, as a corrupted checkpoint is only found to be corrupted
+	// once all of it has been read.
+	file := b.stackPath(ctx, ref)
+	if err := b.verifyChecksumStream(ctx, file); err != nil {
+		return fmt.Errorf("%w: %v", errCannotStream, err)
+	}
+
+	rd, err := b.bucket.NewReader(ctx, file, nil)
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	defer contract.IgnoreClose(rd)
+
+	content, err := decompressReader(rd)
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	defer contract.IgnoreClose(content)
+
+	if err := encodeCheckpointStream(w, json.NewDecoder(content)); err != nil {
+		if errors.Is(err, errCannotStream) {
+			return err
+		}
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	return nil
+}
+
+// verifyChecksumStream checks the content of the given file against the checksum recorded when it was written, if
+// any, without reading all of it into memory.
+func (b *localBackend) verifyChecksumStream(ctx context.Context, file string) error {
+	attrs, err := b.bucket.Attributes(ctx, file)
+	if err != nil {
+		// Not being able to verify the checksum is no reason to refuse to read the file.
+		logging.V(5).Infof("error reading attributes of %s, skipping checksum verification: %v", file, err)
+		return nil
+	}
+	expected := attrs.Metadata[checksumMetadataKey]
+	if expected == "" {
+		return nil
+	}
+
+	rd, err := b.bucket.NewReader(ctx, file, nil)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(rd)
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rd); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != expected {
+		return fmt.Errorf("its SHA-256 hash is %s but %s was recorded when it was written", actual, expected)
+	}
+	return nil
+}
+
+// encodeCheckpointStream reads a versioned checkpoint from dec, and writes its latest deployment to w as a deployment
+// stream. Only checkpoints of the current version are streamed, nothing is written for any other.
+func encodeCheckpointStream(w io.Writer, dec *json.Decoder) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	version := 0
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch key, _ := tok.(string); key {
+		case "version":
+			if err := dec.Decode(&version); err != nil {
+				return err
+			}
+		case "checkpoint":
+			if version != apitype.DeploymentSchemaVersionCurrent {
+				return fmt.Errorf("%w: it has version %d", errCannotStream, version)
+			}
+			return encodeLatestStream(w, version, dec)
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+		}
+	}
+	return errors.New("checkpoint is missing")
+}
+
+// encodeLatestStream reads a checkpoint from dec, and writes its latest deployment to w as a deployment stream.
+func encodeLatestStream(w io.Writer, version int, dec *json.Decoder) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		// Checkpoints are written with Go's default field names, and read case insensitively.
+		if key, _ := tok.(string); strings.EqualFold(key, "latest") {
+			return stack.EncodeDeploymentStream(w, version, dec)
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return err
+		}
+	}
+
+	// A checkpoint without a deployment is written as one that's null.
+	return stack.EncodeDeploymentStream(w, version, json.NewDecoder(strings.NewReader("null")))
+}
+
+func expectJSONDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %v, found %v", want, tok)
+	}
+	return nil
+}
+
+// ImportDeploymentStream imports the deployment read from the given deployment stream into the indicated stack.
+//
+// The checkpoint is written to a temporary file as the stream is read, and is only uploaded to the bucket once all of
+// the stream has been read successfully, so an invalid deployment leaves the stack unchanged.
+func (b *localBackend) ImportDeploymentStream(
+	ctx context.Context, stk backend.Stack, r *stack.DeploymentStreamReader,
+) error {
+	if err := b.checkWritable("import a deployment into stack " + stk.Ref().String()); err != nil {
+		return err
+	}
+
+	ref, err := b.getReference(stk.Ref())
+	if err != nil {
+		return err
+	}
+
+	err = b.Lock(ctx, ref)
+	if err != nil {
+		return err
+	}
+	defer b.Unlock(ctx, ref)
+
+	stackName := ref.FullyQualifiedName()
+
+	// Older deployments have to be migrated as a whole, and checkpoints encrypted at rest have to be encrypted as a
+	// whole.
+	if r.Version() != apitype.DeploymentSchemaVersionCurrent || b.encryptsState() {
+		deployment, err := r.ReadUntypedDeployment()
+		if err != nil {
+			return err
+		}
+		chk, err := stack.MarshalUntypedDeploymentToVersionedCheckpoint(stackName, deployment)
+		if err != nil {
+			return err
+		}
+		_, _, err = b.saveCheckpoint(ctx, ref, chk)
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "pulumi-import-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		contract.IgnoreClose(tmp)
+		contract.IgnoreError(os.Remove(tmp.Name()))
+	}()
+
+	checksum, err := b.writeCheckpointStream(tmp, stackName, r)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// Any journal left behind describes changes to the checkpoint we're about to replace.
+	if err := b.removeJournal(ctx, ref); err != nil {
+		return err
+	}
+
+	file, filePlain, _, err := b.checkpointFile(ctx, ref)
+	if err != nil {
+		return err
+	}
+	opts, err := b.withStateVersion(ctx, ref, file, withChecksumValue(b.compression.writerOptions(), checksum))
+	if err != nil {
+		return err
+	}
+	backupFile := b.backupCheckpoint(ctx, filePlain)
+
+	wr, err := b.bucket.NewWriter(ctx, file, opts)
+	if err != nil {
+		return fmt.Errorf("An IO error occurred while writing the new snapshot file: %w", err)
+	}
+	if _, err := io.Copy(wr, tmp); err != nil {
+		contract.IgnoreClose(wr)
+		return fmt.Errorf("An IO error occurred while writing the new snapshot file: %w", err)
+	}
+	if err := wr.Close(); err != nil {
+		if !isPreconditionFailed(err) {
+			return fmt.Errorf("An IO error occurred while writing the new snapshot file: %w", err)
+		}
+		if err := b.conflictError(ctx, ref, file, checksum); err != nil {
+			return err
+		}
+	}
+	b.recordStateVersion(ref, checksum, 0)
+
+	logging.V(7).Infof("Saved stack %s checkpoint to: %s (backup=%s)", stackName, file, backupFile)
+
+	// And if we are retaining historical checkpoint information, write it out again
+	if b.Env.GetBool(env.SelfManagedRetainCheckpoints) {
+		if err := b.bucket.Copy(ctx, fmt.Sprintf("%v.%v", file, time.Now().UnixNano()), file, nil); err != nil {
+			return fmt.Errorf("An IO error occurred while writing the new snapshot file: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeCheckpointStream writes the checkpoint for the named stack, holding the deployment read from r, to w in the
+// compression we write with. It returns the checksum of what was written.
+func (b *localBackend) writeCheckpointStream(
+	w io.Writer, stackName tokens.QName, r *stack.DeploymentStreamReader,
+) (string, error) {
+	hash := sha256.New()
+	cw, err := b.compression.writer(io.MultiWriter(w, hash))
+	if err != nil {
+		return "", err
+	}
+
+	name, err := json.Marshal(stackName)
+	if err != nil {
+		return "", err
+	}
+	header := fmt.Sprintf(`{"version":%d,"checkpoint":{"stack":%s,"latest":`, r.Version(), name)
+	if _, err := io.WriteString(cw, header); err != nil {
+		return "", err
+	}
+	if _, err := r.WriteTo(cw); err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(cw, "}}\n"); err != nil {
+		return "", err
+	}
+	if err := cw.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+// exportStreamedDeployment exports the stack's deployment as a stream, and reads it back into a deployment.
+func exportStreamedDeployment(t *testing.T, b *localBackend, ref *localBackendReference) (int, *apitype.DeploymentV3) {
+	t.Helper()
+
+	ctx := context.Background()
+	stk, err := b.GetStack(ctx, ref)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, b.ExportDeploymentStream(ctx, stk, &buf))
+	lines := strings.Count(buf.String(), "\n")
+
+	r, err := stack.NewDeploymentStreamReader(&buf)
+	require.NoError(t, err)
+	deployment, err := r.ReadUntypedDeployment()
+	require.NoError(t, err)
+
+	var dep apitype.DeploymentV3
+	require.NoError(t, json.Unmarshal(deployment.Deployment, &dep))
+	return lines, &dep
+}
+
+func TestDeploymentStream_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, compression := range stateCompressions {
+		compression := compression
+		t.Run(string(compression), func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			b, ref := newJournalTestBackend(t, env.MapStore{
+				env.SelfManagedCompression.Var().Name(): string(compression),
+			})
+			_, err := b.saveStack(ctx, ref, journalTestSnapshot(3, 1, "changed"), b64.NewBase64SecretsManager())
+			require.NoError(t, err)
+
+			// A version line, the manifest, the secrets providers and a line for each resource.
+			lines, dep := exportStreamedDeployment(t, b, ref)
+			assert.Equal(t, 6, lines)
+			assert.Equal(t, []string{"initial", "changed", "initial"},
+				resourceInputs(&apitype.CheckpointV3{Latest: dep}))
+
+			// Import the stream into another stack.
+			var buf bytes.Buffer
+			stk, err := b.GetStack(ctx, ref)
+			require.NoError(t, err)
+			require.NoError(t, b.ExportDeploymentStream(ctx, stk, &buf))
+
+			otherRef, err := b.ParseStackReference("bar")
+			require.NoError(t, err)
+			other, err := b.CreateStack(ctx, otherRef, "", nil)
+			require.NoError(t, err)
+			r, err := stack.NewDeploymentStreamReader(&buf)
+			require.NoError(t, err)
+			require.NoError(t, b.ImportDeploymentStream(ctx, other, r))
+
+			chk, err := b.getCheckpoint(ctx, otherRef.(*localBackendReference))
+			require.NoError(t, err)
+			assert.Equal(t, "organization/testproj/bar", string(chk.Stack))
+			assert.Equal(t, []string{"initial", "changed", "initial"}, resourceInputs(chk))
+		})
+	}
+}
+
+func TestDeploymentStream_exportJournaled(t *testing.T) {
+	t.Parallel()
+
+	// A checkpoint with journal entries is read into memory, but exported all the same.
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	persister := b.newSnapshotPersister(ctx, ref)
+	require.NoError(t, persister.Save(journalTestSnapshot(1, -1, "")))
+	require.NoError(t, persister.Save(journalTestSnapshot(2, 1, "changed")))
+	require.NotEmpty(t, journalFiles(t, b, ref))
+
+	_, dep := exportStreamedDeployment(t, b, ref)
+	assert.Equal(t, []string{"initial", "changed"}, resourceInputs(&apitype.CheckpointV3{Latest: dep}))
+}
+
+func TestDeploymentStream_importInvalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	_, err := b.saveStack(ctx, ref, journalTestSnapshot(2, -1, ""), b64.NewBase64SecretsManager())
+	require.NoError(t, err)
+	stk, err := b.GetStack(ctx, ref)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, stack.EncodeUntypedDeploymentStream(&buf, &apitype.UntypedDeployment{
+		Version:    3,
+		Deployment: json.RawMessage(`{"manifest":{},"resources":[{"urn":"a"},{"urn":"b"}]}`),
+	}))
+	r, err := stack.NewDeploymentStreamReader(&buf)
+	require.NoError(t, err)
+	r.OnEnd = func() error { return errors.New("invalid") }
+
+	// A deployment found to be invalid once all of it has been read leaves the stack unchanged.
+	assert.ErrorContains(t, b.ImportDeploymentStream(ctx, stk, r), "invalid")
+	chk, err := b.getCheckpoint(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"initial", "initial"}, resourceInputs(chk))
+}
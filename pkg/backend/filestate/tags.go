@@ -0,0 +1,165 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/util/validation"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// stackMetadata is the metadata the filestate backend stores for a stack, in a file alongside its checkpoint.
+type stackMetadata struct {
+	// Tags are the stack's tags.
+	Tags map[apitype.StackTagName]string `json:"tags,omitempty"`
+	// Teams are the teams the stack was created for. The filestate backend doesn't control access to stacks, so
+	// these are only recorded for tooling that organizes stacks by team.
+	Teams []string `json:"teams,omitempty"`
+}
+
+// stackMetadataPath returns the path of the file holding the metadata of the given stack. Like *.bak files, it isn't
+// mistaken for a checkpoint because its extension isn't one that checkpoints are stored with.
+func stackMetadataPath(ref *localBackendReference) string {
+	return filepath.ToSlash(ref.StackBasePath()) + ".json.meta"
+}
+
+// getStackMetadata reads the metadata of the given stack, returning empty metadata if none has been stored.
+func (b *localBackend) getStackMetadata(ctx context.Context, ref *localBackendReference) (*stackMetadata, error) {
+	file := stackMetadataPath(ref)
+	byts, err := b.bucket.ReadAll(ctx, file)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return &stackMetadata{}, nil
+		}
+		return nil, fmt.Errorf("reading stack metadata %q: %w", file, err)
+	}
+
+	var meta stackMetadata
+	if err := json.Unmarshal(byts, &meta); err != nil {
+		return nil, fmt.Errorf("parsing stack metadata %q: %w", file, err)
+	}
+	return &meta, nil
+}
+
+// saveStackMetadata writes the metadata of the given stack.
+func (b *localBackend) saveStackMetadata(
+	ctx context.Context, ref *localBackendReference, meta *stackMetadata,
+) error {
+	if err := validation.ValidateStackTags(meta.Tags); err != nil {
+		return fmt.Errorf("validating stack tags: %w", err)
+	}
+
+	byts, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("marshalling stack metadata: %w", err)
+	}
+
+	file := stackMetadataPath(ref)
+	if err := b.bucket.WriteAll(ctx, file, byts, nil); err != nil {
+		return fmt.Errorf("writing stack metadata %q: %w", file, err)
+	}
+	return nil
+}
+
+// removeStackMetadata removes the metadata of the given stack, if any.
+func (b *localBackend) removeStackMetadata(ctx context.Context, ref *localBackendReference) error {
+	err := b.bucket.Delete(ctx, stackMetadataPath(ref))
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return err
+	}
+	return nil
+}
+
+// renameStackMetadata moves the metadata of a stack that's being renamed to the stack's new name.
+func (b *localBackend) renameStackMetadata(
+	ctx context.Context, oldRef, newRef *localBackendReference,
+) error {
+	exists, err := b.bucket.Exists(ctx, stackMetadataPath(oldRef))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	if err := b.bucket.Copy(ctx, stackMetadataPath(newRef), stackMetadataPath(oldRef), nil); err != nil {
+		return fmt.Errorf("copying stack metadata: %w", err)
+	}
+	return b.removeStackMetadata(ctx, oldRef)
+}
+
+// updateStackTags replaces the tags of the given stack, keeping the rest of its metadata.
+func (b *localBackend) updateStackTags(
+	ctx context.Context, ref *localBackendReference, tags map[apitype.StackTagName]string,
+) error {
+	meta, err := b.getStackMetadata(ctx, ref)
+	if err != nil {
+		return err
+	}
+	meta.Tags = tags
+	return b.saveStackMetadata(ctx, ref, meta)
+}
+
+// matchesTagFilter returns true if a stack with the given tags passes the tag filter of the given stack filter.
+func matchesTagFilter(tags map[apitype.StackTagName]string, filter backend.ListStacksFilter) bool {
+	if filter.TagName == nil {
+		return true
+	}
+	value, has := tags[*filter.TagName]
+	if !has {
+		return false
+	}
+	return filter.TagValue == nil || value == *filter.TagValue
+}
+
+// filterByTag returns the stacks whose tags pass the tag filter of the given stack filter, reading the metadata of
+// the stacks in parallel.
+func (b *localBackend) filterByTag(
+	ctx context.Context, refs []*localBackendReference, filter backend.ListStacksFilter,
+) ([]*localBackendReference, error) {
+	matches := make([]bool, len(refs))
+	pool := newWorkerPool(summaryWorkers, len(refs))
+	defer pool.Close()
+	for i, ref := range refs {
+		i, ref := i, ref
+		pool.Enqueue(func() error {
+			meta, err := b.getStackMetadata(ctx, ref)
+			if err != nil {
+				return err
+			}
+			// No lock necessary; matches is pre-allocated.
+			matches[i] = matchesTagFilter(meta.Tags, filter)
+			return nil
+		})
+	}
+	if err := pool.Wait(); err != nil {
+		return nil, err
+	}
+
+	filtered := refs[:0]
+	for i, ref := range refs {
+		if matches[i] {
+			filtered = append(filtered, ref)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+func TestStackTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	assert.True(t, b.SupportsTags())
+
+	// New stacks are tagged with their project, as in the service.
+	stk, err := b.GetStack(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "testproj", stk.Tags()[apitype.ProjectNameTag])
+
+	tags := map[apitype.StackTagName]string{"env": "prod", "team": "infra"}
+	require.NoError(t, b.UpdateStackTags(ctx, stk, tags))
+
+	stk, err = b.GetStack(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, tags, stk.Tags())
+
+	// Invalid tags are rejected.
+	err = b.UpdateStackTags(ctx, stk, map[apitype.StackTagName]string{"no spaces": "v"})
+	assert.ErrorContains(t, err, "validating stack tags")
+}
+
+func TestStackTags_listFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, fooRef := newJournalTestBackend(t, env.MapStore{})
+	barRef, err := b.ParseStackReference("bar")
+	require.NoError(t, err)
+	bar, err := b.CreateStack(ctx, barRef, "", nil)
+	require.NoError(t, err)
+	foo, err := b.GetStack(ctx, fooRef)
+	require.NoError(t, err)
+
+	require.NoError(t, b.UpdateStackTags(ctx, foo, map[apitype.StackTagName]string{"env": "prod"}))
+	require.NoError(t, b.UpdateStackTags(ctx, bar, map[apitype.StackTagName]string{"env": "dev"}))
+
+	list := func(name string, value *string) []string {
+		summaries, _, err := b.ListStacks(ctx, backend.ListStacksFilter{TagName: &name, TagValue: value}, nil)
+		require.NoError(t, err)
+		var names []string
+		for _, s := range summaries {
+			names = append(names, s.Name().Name().String())
+		}
+		return names
+	}
+
+	prod := "prod"
+	assert.Equal(t, []string{"foo"}, list("env", &prod))
+	assert.ElementsMatch(t, []string{"foo", "bar"}, list("env", nil))
+	assert.Empty(t, list("owner", nil))
+}
+
+func TestStackTags_renameAndRemove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{})
+	stk, err := b.GetStack(ctx, ref)
+	require.NoError(t, err)
+	tags := map[apitype.StackTagName]string{"env": "prod"}
+	require.NoError(t, b.UpdateStackTags(ctx, stk, tags))
+
+	// Renaming a stack keeps its tags.
+	newRef, err := b.RenameStack(ctx, stk, "renamed")
+	require.NoError(t, err)
+	stk, err = b.GetStack(ctx, newRef)
+	require.NoError(t, err)
+	assert.Equal(t, tags, stk.Tags())
+
+	exists, err := b.bucket.Exists(ctx, stackMetadataPath(ref))
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Removing a stack removes its tags.
+	_, err = b.RemoveStack(ctx, stk, false)
+	require.NoError(t, err)
+	exists, err = b.bucket.Exists(ctx, stackMetadataPath(newRef.(*localBackendReference)))
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
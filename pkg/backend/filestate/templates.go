@@ -0,0 +1,79 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// stackTemplatesPath is the file holding the defaults and stack templates of the backend.
+var stackTemplatesPath = filepath.Join(workspace.BookkeepingDir, "stack-templates.json")
+
+// stackTemplatesFile is the content of the file at stackTemplatesPath.
+type stackTemplatesFile struct {
+	// Defaults are the defaults every new stack is created with.
+	Defaults backend.StackTemplate `json:"defaults"`
+	// Templates are the named stack templates that can be chosen when creating a stack, which are applied over the
+	// defaults.
+	Templates map[string]backend.StackTemplate `json:"templates,omitempty"`
+}
+
+// GetStackTemplate returns the defaults for new stacks held in the backend, merged with those of the named stack
+// template if name isn't empty.
+func (b *localBackend) GetStackTemplate(ctx context.Context, name string) (*backend.StackTemplate, error) {
+	byts, err := b.bucket.ReadAll(ctx, stackTemplatesPath)
+	if err != nil {
+		if gcerrors.Code(err) != gcerrors.NotFound {
+			return nil, fmt.Errorf("reading %s: %w", stackTemplatesPath, err)
+		}
+		if name != "" {
+			return nil, fmt.Errorf("no stack template named %s: the backend has no stack templates", name)
+		}
+		return nil, nil
+	}
+	var file stackTemplatesFile
+	if err := json.Unmarshal(byts, &file); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", stackTemplatesPath, err)
+	}
+
+	if name == "" {
+		return &file.Defaults, nil
+	}
+	template, ok := file.Templates[name]
+	if !ok {
+		names := make([]string, 0, len(file.Templates))
+		for n := range file.Templates {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no stack template named %s: the backend has no stack templates", name)
+		}
+		return nil, fmt.Errorf("no stack template named %s; the backend's stack templates are %s",
+			name, strings.Join(names, ", "))
+	}
+	merged := file.Defaults.Merge(template)
+	return &merged, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+func TestGetStackTemplate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, _ := newJournalTestBackend(t, env.MapStore{})
+
+	// Without a stack templates file there are no defaults.
+	template, err := b.GetStackTemplate(ctx, "")
+	require.NoError(t, err)
+	assert.Nil(t, template)
+	_, err = b.GetStackTemplate(ctx, "prod")
+	assert.ErrorContains(t, err, "no stack template named prod")
+
+	require.NoError(t, b.bucket.WriteAll(ctx, stackTemplatesPath, []byte(`{
+		"defaults": {
+			"secretsProvider": "passphrase",
+			"tags": {"team": "platform"},
+			"requiredTags": ["owner"],
+			"config": {"aws:region": "us-west-2"}
+		},
+		"templates": {
+			"prod": {
+				"secretsProvider": "awskms://alias/prod",
+				"tags": {"env": "prod"},
+				"requiredTags": ["owner", "cost-center"],
+				"config": {"aws:region": "us-east-1", "replicas": "3"}
+			}
+		}
+	}`), nil))
+
+	template, err = b.GetStackTemplate(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, &backend.StackTemplate{
+		SecretsProvider: "passphrase",
+		Tags:            map[apitype.StackTagName]string{"team": "platform"},
+		RequiredTags:    []apitype.StackTagName{"owner"},
+		Config:          map[string]string{"aws:region": "us-west-2"},
+	}, template)
+
+	// Named templates are applied over the defaults.
+	template, err = b.GetStackTemplate(ctx, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, &backend.StackTemplate{
+		SecretsProvider: "awskms://alias/prod",
+		Tags:            map[apitype.StackTagName]string{"team": "platform", "env": "prod"},
+		RequiredTags:    []apitype.StackTagName{"owner", "cost-center"},
+		Config:          map[string]string{"aws:region": "us-east-1", "replicas": "3"},
+	}, template)
+
+	_, err = b.GetStackTemplate(ctx, "staging")
+	assert.ErrorContains(t, err, "no stack template named staging; the backend's stack templates are prod")
+}
@@ -0,0 +1,189 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// webhooksPath is the file listing the webhooks of the backend.
+var webhooksPath = filepath.Join(workspace.BookkeepingDir, "webhooks.json")
+
+// webhookTimeout is how long a webhook has to respond before it's given up on.
+const webhookTimeout = 10 * time.Second
+
+// webhookSignatureHeader is the header carrying the signature of a webhook payload, as it is for the webhooks of the
+// Pulumi Cloud.
+const webhookSignatureHeader = "Pulumi-Webhook-Signature"
+
+// webhookEventHeader is the header carrying the kind of event a webhook payload describes.
+const webhookEventHeader = "Pulumi-Webhook-Event"
+
+// webhookEvent is the kind of event a webhook is called for.
+type webhookEvent string
+
+const (
+	webhookUpdateStarted   webhookEvent = "update_started"
+	webhookUpdateSucceeded webhookEvent = "update_succeeded"
+	webhookUpdateFailed    webhookEvent = "update_failed"
+)
+
+// webhooksFile is the content of the file at webhooksPath.
+type webhooksFile struct {
+	Webhooks []webhook `json:"webhooks"`
+}
+
+// webhook is a URL that's called on the events of the stacks in the backend.
+type webhook struct {
+	// URL is the URL that payloads are posted to.
+	URL string `json:"url"`
+	// Project limits the webhook to the stacks of the named project. Every stack's events are posted if empty.
+	Project tokens.Name `json:"project,omitempty"`
+	// Events limits the webhook to the given events. Every event is posted if empty.
+	Events []webhookEvent `json:"events,omitempty"`
+}
+
+// matches returns true if the webhook is called for the given event.
+func (w webhook) matches(event webhookEvent) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookPayload is the body posted to a webhook.
+type webhookPayload struct {
+	Event        webhookEvent            `json:"event"`
+	Organization string                  `json:"organization"`
+	Project      tokens.Name             `json:"project"`
+	Stack        string                  `json:"stack"`
+	Kind         apitype.UpdateKind      `json:"kind"`
+	User         string                  `json:"user,omitempty"`
+	Message      string                  `json:"message,omitempty"`
+	Result       backend.UpdateResult    `json:"result,omitempty"`
+	StartTime    int64                   `json:"startTime"`
+	EndTime      int64                   `json:"endTime,omitempty"`
+	Changes      display.ResourceChanges `json:"resourceChanges,omitempty"`
+}
+
+// getWebhooks returns the webhooks of the backend that are called for the stacks of the given project.
+func (b *localBackend) getWebhooks(ctx context.Context, project tokens.Name) ([]webhook, error) {
+	byts, err := b.bucket.ReadAll(ctx, webhooksPath)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", webhooksPath, err)
+	}
+	var file webhooksFile
+	if err := json.Unmarshal(byts, &file); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", webhooksPath, err)
+	}
+
+	var hooks []webhook
+	for _, w := range file.Webhooks {
+		if w.Project == "" || w.Project == project {
+			hooks = append(hooks, w)
+		}
+	}
+	return hooks, nil
+}
+
+// sendWebhooks posts the given payload to each of the given webhooks that's called for its event. Webhooks are
+// notifications, so failing to call one is reported as a warning rather than failing the update.
+func (b *localBackend) sendWebhooks(ctx context.Context, hooks []webhook, payload webhookPayload) {
+	body, err := json.Marshal(payload)
+	contract.AssertNoErrorf(err, "marshalling webhook payload")
+
+	var signature string
+	if secret := b.Env.GetString(env.SelfManagedWebhookSecret); secret != "" {
+		signature = signWebhookPayload(secret, body)
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range hooks {
+		if !w.matches(payload.Event) {
+			continue
+		}
+		w := w
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := postWebhook(ctx, w.URL, payload.Event, body, signature); err != nil {
+				b.d.Warningf(diag.Message("", "Calling webhook %s: %v"), w.URL, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// signWebhookPayload returns the hex encoded HMAC-SHA256 of the given payload, keyed with secret.
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// postWebhook posts a webhook payload to the given URL.
+func postWebhook(ctx context.Context, url string, event webhookEvent, body []byte, signature string) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, string(event))
+	if signature != "" {
+		req.Header.Set(webhookSignatureHeader, signature)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	contract.IgnoreClose(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+)
+
+// webhookRecorder is a webhook endpoint that records the requests it receives, keyed by path.
+type webhookRecorder struct {
+	mu       sync.Mutex
+	requests map[string][]*http.Request
+	bodies   map[string][][]byte
+}
+
+func newWebhookRecorder(t *testing.T) (*webhookRecorder, *httptest.Server) {
+	rec := &webhookRecorder{requests: map[string][]*http.Request{}, bodies: map[string][][]byte{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		rec.requests[r.URL.Path] = append(rec.requests[r.URL.Path], r)
+		rec.bodies[r.URL.Path] = append(rec.bodies[r.URL.Path], body)
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return rec, srv
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, env.MapStore{
+		env.SelfManagedWebhookSecret.Var().Name(): "s3cr3t",
+	})
+	rec, srv := newWebhookRecorder(t)
+
+	file, err := json.Marshal(webhooksFile{Webhooks: []webhook{
+		{URL: srv.URL + "/all"},
+		{URL: srv.URL + "/project", Project: "testproj"},
+		{URL: srv.URL + "/other", Project: "otherproj"},
+		{URL: srv.URL + "/failures", Events: []webhookEvent{webhookUpdateFailed}},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, b.bucket.WriteAll(ctx, webhooksPath, file, nil))
+
+	hooks, err := b.getWebhooks(ctx, ref.project)
+	require.NoError(t, err)
+	assert.Len(t, hooks, 3)
+
+	payload := webhookPayload{
+		Event:   webhookUpdateStarted,
+		Project: ref.project,
+		Stack:   ref.name.String(),
+		Kind:    apitype.UpdateUpdate,
+	}
+	b.sendWebhooks(ctx, hooks, payload)
+	payload.Event = webhookUpdateFailed
+	b.sendWebhooks(ctx, hooks, payload)
+
+	assert.Len(t, rec.requests["/all"], 2)
+	assert.Len(t, rec.requests["/project"], 2)
+	assert.Empty(t, rec.requests["/other"])
+	require.Len(t, rec.requests["/failures"], 1)
+
+	// Payloads are signed with the backend's webhook secret.
+	req, body := rec.requests["/failures"][0], rec.bodies["/failures"][0]
+	assert.Equal(t, "update_failed", req.Header.Get(webhookEventHeader))
+	assert.Equal(t, signWebhookPayload("s3cr3t", body), req.Header.Get(webhookSignatureHeader))
+	var got webhookPayload
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, payload, got)
+}
+
+func TestWebhooks_none(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, ref := newJournalTestBackend(t, make(env.MapStore))
+	hooks, err := b.getWebhooks(ctx, ref.project)
+	require.NoError(t, err)
+	assert.Empty(t, hooks)
+}
+
+func TestPostWebhook_errorStatus(t *testing.T) {
+	t.Parallel()
+
+	rec, srv := newWebhookRecorder(t)
+	err := postWebhook(context.Background(), srv.URL+"/broken", webhookUpdateStarted, []byte("{}"), "")
+	assert.ErrorContains(t, err, "500")
+	assert.Empty(t, rec.requests["/broken"][0].Header.Get(webhookSignatureHeader))
+}
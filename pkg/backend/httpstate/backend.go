@@ -49,6 +49,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
@@ -192,6 +193,10 @@ type cloudBackend struct {
 	escClient    esc_client.Client
 	capabilities func(context.Context) capabilities
 
+	// readOnly is true if the backend is in read-only mode, in which any operation that would write to the state of
+	// a stack fails.
+	readOnly bool
+
 	// The current project, if any.
 	currentProject *workspace.Project
 }
@@ -218,10 +223,19 @@ func New(d diag.Sink, cloudURL string, project *workspace.Project, insecure bool
 		client:         apiClient,
 		escClient:      escClient,
 		capabilities:   capabilities,
+		readOnly:       backend.IsReadOnly(env.Global(), cloudURL),
 		currentProject: project,
 	}, nil
 }
 
+// checkWritable returns a *backend.ReadOnlyError for the given operation if the backend is in read-only mode.
+func (b *cloudBackend) checkWritable(operation string) error {
+	if b.readOnly {
+		return &backend.ReadOnlyError{Operation: operation}
+	}
+	return nil
+}
+
 // loginWithBrowser uses a web-browser to log into the cloud and returns the cloud backend for it.
 func loginWithBrowser(
 	ctx context.Context,
@@ -933,6 +947,10 @@ func (b *cloudBackend) CreateStack(
 ) (
 	backend.Stack, error,
 ) {
+	if err := b.checkWritable("create stack " + stackRef.String()); err != nil {
+		return nil, err
+	}
+
 	if opts == nil {
 		opts = &backend.CreateStackOptions{}
 	}
@@ -1013,6 +1031,10 @@ func (b *cloudBackend) ListStacks(
 }
 
 func (b *cloudBackend) RemoveStack(ctx context.Context, stack backend.Stack, force bool) (bool, error) {
+	if err := b.checkWritable("remove stack " + stack.Ref().String()); err != nil {
+		return false, err
+	}
+
 	stackID, err := b.getCloudStackIdentifier(stack.Ref())
 	if err != nil {
 		return false, err
@@ -1024,6 +1046,10 @@ func (b *cloudBackend) RemoveStack(ctx context.Context, stack backend.Stack, for
 func (b *cloudBackend) RenameStack(ctx context.Context, stack backend.Stack,
 	newName tokens.QName,
 ) (backend.StackReference, error) {
+	if err := b.checkWritable("rename stack " + stack.Ref().String()); err != nil {
+		return nil, err
+	}
+
 	stackID, err := b.getCloudStackIdentifier(stack.Ref())
 	if err != nil {
 		return nil, err
@@ -1090,12 +1116,20 @@ func (b *cloudBackend) Preview(ctx context.Context, stack backend.Stack,
 func (b *cloudBackend) Update(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("update stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.UpdateUpdate, stack, op, b.apply)
 }
 
 func (b *cloudBackend) Import(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation, imports []deploy.Import,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("import resources into stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	op.Imports = imports
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.ResourceImportUpdate, stack, op, b.apply)
 }
@@ -1103,18 +1137,30 @@ func (b *cloudBackend) Import(ctx context.Context, stack backend.Stack,
 func (b *cloudBackend) Refresh(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("refresh stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.RefreshUpdate, stack, op, b.apply)
 }
 
 func (b *cloudBackend) Destroy(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation,
 ) (sdkDisplay.ResourceChanges, result.Result) {
+	if err := b.checkWritable("destroy stack " + stack.Ref().String()); err != nil {
+		return nil, result.FromError(err)
+	}
+
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.DestroyUpdate, stack, op, b.apply)
 }
 
 func (b *cloudBackend) Watch(ctx context.Context, stk backend.Stack,
 	op backend.UpdateOperation, paths []string,
 ) result.Result {
+	if err := b.checkWritable("watch stack " + stk.Ref().String()); err != nil {
+		return result.FromError(err)
+	}
+
 	return backend.Watch(ctx, b, stk, op, b.apply, paths)
 }
 
@@ -1274,7 +1320,7 @@ func (b *cloudBackend) apply(
 	}
 
 	permalink := b.getPermalink(update, updateMeta.version, opts.DryRun)
-	return b.runEngineAction(ctx, kind, stack.Ref(), op, update, updateMeta.leaseToken, permalink, events, opts.DryRun)
+	return b.runEngineAction(ctx, kind, stack, op, update, updateMeta.leaseToken, permalink, events, opts.DryRun)
 }
 
 // getPermalink returns a link to the update in the Pulumi Console.
@@ -1295,15 +1341,17 @@ func (b *cloudBackend) query(ctx context.Context, op backend.QueryOperation,
 }
 
 func (b *cloudBackend) runEngineAction(
-	ctx context.Context, kind apitype.UpdateKind, stackRef backend.StackReference,
+	ctx context.Context, kind apitype.UpdateKind, stack backend.Stack,
 	op backend.UpdateOperation, update client.UpdateIdentifier, token, permalink string,
 	callerEventsOpt chan<- engine.Event, dryRun bool,
 ) (*deploy.Plan, sdkDisplay.ResourceChanges, result.Result) {
 	contract.Assertf(token != "", "persisted actions require a token")
+	stackRef := stack.Ref()
 	u, err := b.newUpdate(ctx, stackRef, op, update, token)
 	if err != nil {
 		return nil, nil, result.FromError(err)
 	}
+	u.target.Tags = stack.Tags()
 
 	// displayEvents renders the event to the console and Pulumi service. The processor for the
 	// will signal all events have been proceed when a value is written to the displayDone channel.
@@ -1393,6 +1441,10 @@ func (b *cloudBackend) runEngineAction(
 }
 
 func (b *cloudBackend) CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error {
+	if err := b.checkWritable("cancel the current update of stack " + stackRef.String()); err != nil {
+		return err
+	}
+
 	stackID, err := b.getCloudStackIdentifier(stackRef)
 	if err != nil {
 		return err
@@ -1569,6 +1621,10 @@ func (b *cloudBackend) exportDeployment(
 func (b *cloudBackend) ImportDeployment(ctx context.Context, stack backend.Stack,
 	deployment *apitype.UntypedDeployment,
 ) error {
+	if err := b.checkWritable("import a deployment into stack " + stack.Ref().String()); err != nil {
+		return err
+	}
+
 	stackID, err := b.getCloudStackIdentifier(stack.Ref())
 	if err != nil {
 		return err
@@ -1786,6 +1842,10 @@ func IsValidAccessToken(ctx context.Context, cloudURL string,
 func (b *cloudBackend) UpdateStackTags(ctx context.Context,
 	stack backend.Stack, tags map[apitype.StackTagName]string,
 ) error {
+	if err := b.checkWritable("update the tags of stack " + stack.Ref().String()); err != nil {
+		return err
+	}
+
 	stackID, err := b.getCloudStackIdentifier(stack.Ref())
 	if err != nil {
 		return err
@@ -1799,6 +1859,10 @@ const pulumiOperationHeader = "Pulumi operation"
 func (b *cloudBackend) RunDeployment(ctx context.Context, stackRef backend.StackReference,
 	req apitype.CreateDeploymentRequest, opts display.Options,
 ) error {
+	if err := b.checkWritable("run a deployment of stack " + stackRef.String()); err != nil {
+		return err
+	}
+
 	stackID, err := b.getCloudStackIdentifier(stackRef)
 	if err != nil {
 		return err
@@ -1937,14 +2001,33 @@ type httpstateBackendClient struct {
 }
 
 func (c httpstateBackendClient) GetStackOutputs(ctx context.Context, name string) (resource.PropertyMap, error) {
-	// When using the cloud backend, require that stack references are fully qualified so they
-	// look like "<org>/<project>/<stack>"
+	if err := checkStackReferenceName(name); err != nil {
+		return nil, err
+	}
+	return c.backend.GetStackOutputs(ctx, name)
+}
+
+func (c httpstateBackendClient) GetStackOutputsWithMetadata(
+	ctx context.Context, name string,
+) (resource.PropertyMap, resource.OutputMetadataMap, error) {
+	if err := checkStackReferenceName(name); err != nil {
+		return nil, nil, err
+	}
+	if client, ok := c.backend.(deploy.OutputMetadataBackendClient); ok {
+		return client.GetStackOutputsWithMetadata(ctx, name)
+	}
+	outputs, err := c.backend.GetStackOutputs(ctx, name)
+	return outputs, nil, err
+}
+
+// checkStackReferenceName checks that a stack reference's name is fully qualified, as the cloud backend requires, so
+// that it looks like "<org>/<project>/<stack>".
+func checkStackReferenceName(name string) error {
 	if strings.Count(name, "/") != 2 {
-		return nil, errors.New("a stack reference's name should be of the form " +
+		return errors.New("a stack reference's name should be of the form " +
 			"'<organization>/<project>/<stack>'. See https://pulumi.io/help/stack-reference for more information.")
 	}
-
-	return c.backend.GetStackOutputs(ctx, name)
+	return nil
 }
 
 func (c httpstateBackendClient) GetStackResourceOutputs(
@@ -34,6 +34,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/version"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/httputil"
@@ -204,26 +205,53 @@ type httpClient interface {
 }
 
 // defaultHTTPClient is an implementation of httpClient that provides a basic implementation of Do
-// using the specified *http.Client, with retry support.
+// using the specified *http.Client, with retry support and a circuit breaker.
 type defaultHTTPClient struct {
-	client *http.Client
+	client  *http.Client
+	retry   httputil.RetryOpts
+	breaker *circuitBreaker
+}
+
+// newDefaultHTTPClient returns a defaultHTTPClient that retries requests, and trips its circuit breaker, as
+// configured by the environment. Problems with the configuration are reported to d.
+func newDefaultHTTPClient(client *http.Client, d diag.Sink, e env.Env) *defaultHTTPClient {
+	return &defaultHTTPClient{
+		client:  client,
+		retry:   retryOptions(d, e),
+		breaker: newCircuitBreaker(d, e),
+	}
 }
 
 func (c *defaultHTTPClient) Do(req *http.Request, policy retryPolicy) (*http.Response, error) {
-	if policy.shouldRetry(req) {
-		// Wait 1s before retrying on failure. Then increase by 2x until the
-		// maximum delay is reached. Stop after maxRetryCount requests have
-		// been made.
-		opts := httputil.RetryOpts{
-			Delay:    durationPtr(time.Second),
-			Backoff:  float64Ptr(2.0),
-			MaxDelay: durationPtr(30 * time.Second),
-
-			MaxRetryCount: intPtr(4),
-		}
+	if err := c.breaker.allow(req.URL.Host); err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req, policy)
+	failed, reason := requestFailure(res, err, c.retry.RetryableStatusCodes)
+	c.breaker.record(req.URL.Host, failed, reason)
+	return res, err
+}
+
+func (c *defaultHTTPClient) do(req *http.Request, policy retryPolicy) (*http.Response, error) {
+	opts := c.retry
+	if opts.MaxRetryCount == nil {
+		opts = retryOptions(nil, env.NewEnv(env.MapStore{}))
+	}
+
+	switch {
+	case policy.shouldRetry(req):
+		// Wait before retrying on failure, then increase the delay by the backoff factor until the maximum delay
+		// is reached. Stop after MaxRetryCount requests have been made.
+		return httputil.DoWithRetryOpts(req, c.client, opts)
+	case policy != retryNone && hasStatusCode(opts.RetryableStatusCodes, http.StatusTooManyRequests):
+		// Requests that are rate limited were never handled by the service, so they're safe to retry even if the
+		// request itself isn't.
+		opts.RetryableStatusCodes = []int{http.StatusTooManyRequests}
 		return httputil.DoWithRetryOpts(req, c.client, opts)
+	default:
+		return c.client.Do(req)
 	}
-	return c.client.Do(req)
 }
 
 // pulumiAPICall makes an HTTP request to the Pulumi API.
@@ -2,9 +2,15 @@ package client
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 )
 
 func TestRetryPolicy_String(t *testing.T) {
@@ -99,3 +105,138 @@ func TestRetryPolicy_shouldRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryOptions(t *testing.T) {
+	t.Parallel()
+
+	opts := retryOptions(nil, env.NewEnv(env.MapStore{
+		env.APIRetryMaxAttempts.Var().Name():     "7",
+		env.APIRetryDelay.Var().Name():           "250",
+		env.APIRetryBackoff.Var().Name():         "1.5",
+		env.APIRetryableStatusCodes.Var().Name(): "429, 503",
+	}))
+	assert.Equal(t, 7, *opts.MaxRetryCount)
+	assert.Equal(t, 250*time.Millisecond, *opts.Delay)
+	assert.Equal(t, defaultRetryMaxDelay, *opts.MaxDelay)
+	assert.Equal(t, 1.5, *opts.Backoff)
+	assert.Equal(t, []int{429, 503}, opts.RetryableStatusCodes)
+
+	// Malformed settings fall back to their defaults.
+	opts = retryOptions(nil, env.NewEnv(env.MapStore{
+		env.APIRetryBackoff.Var().Name():         "fast",
+		env.APIRetryableStatusCodes.Var().Name(): "429,oops",
+	}))
+	assert.Equal(t, defaultRetryBackoff, *opts.Backoff)
+	assert.Equal(t, defaultRetryableStatusCodes(), opts.RetryableStatusCodes)
+}
+
+func TestDefaultHTTPClient_retriesRateLimitedPosts(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tries++
+		switch tries {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	c := newDefaultHTTPClient(server.Client(), nil, env.NewEnv(env.MapStore{
+		env.APIRetryDelay.Var().Name(): "1",
+	}))
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	// POSTs aren't retried by default, except when they're rate limited.
+	res, err := c.Do(req, retryGetMethod)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, 2, tries)
+	assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+}
+
+// The circuit breaker is disabled by default, so that repeated failures never stop requests from being sent.
+func TestDefaultHTTPClient_circuitBreakerDisabled(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tries++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newDefaultHTTPClient(server.Client(), nil, env.NewEnv(env.MapStore{
+		env.APIRetryMaxAttempts.Var().Name(): "1",
+	}))
+	assert.Nil(t, c.breaker)
+
+	for i := 0; i < 10; i++ {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		res, err := c.Do(req, retryGetMethod)
+		require.NoError(t, err)
+		res.Body.Close()
+	}
+	assert.Equal(t, 10, tries)
+}
+
+func TestDefaultHTTPClient_circuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	status := http.StatusServiceUnavailable
+	tries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tries++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	c := newDefaultHTTPClient(server.Client(), nil, env.NewEnv(env.MapStore{
+		env.APIRetryMaxAttempts.Var().Name():        "1",
+		env.APICircuitBreakerThreshold.Var().Name(): "2",
+	}))
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+
+	get := func() (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		res, err := c.Do(req, retryGetMethod)
+		if res != nil {
+			res.Body.Close()
+		}
+		return res, err
+	}
+
+	// Two failures in a row trip the breaker, so the third request is never sent.
+	for i := 0; i < 2; i++ {
+		_, err := get()
+		require.NoError(t, err)
+	}
+	_, err := get()
+	var circuitErr *CircuitOpenError
+	require.ErrorAs(t, err, &circuitErr)
+	assert.Equal(t, 2, circuitErr.Failures)
+	assert.Equal(t, "503 Service Unavailable", circuitErr.LastErr)
+	assert.Equal(t, defaultCircuitBreakerCooldown, circuitErr.RetryIn)
+	assert.Contains(t, err.Error(), env.APICircuitBreakerThreshold.Var().Name())
+	assert.Equal(t, 2, tries)
+
+	// Once the cooldown has passed requests are sent again, and a success closes the breaker.
+	now = now.Add(defaultCircuitBreakerCooldown)
+	status = http.StatusOK
+	for i := 0; i < 3; i++ {
+		res, err := get()
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Equal(t, 5, tries)
+}
@@ -0,0 +1,245 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/httputil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryDelay       = time.Second
+	defaultRetryMaxDelay    = 30 * time.Second
+	defaultRetryBackoff     = 2.0
+
+	defaultCircuitBreakerCooldown = 30 * time.Second
+)
+
+// retryOptions returns the options requests to the service are retried with, as configured by the environment. Any
+// setting that's missing or malformed is reported to d and falls back to its default.
+func retryOptions(d diag.Sink, e env.Env) httputil.RetryOpts {
+	opts := httputil.RetryOpts{
+		Delay:         durationPtr(defaultRetryDelay),
+		Backoff:       float64Ptr(defaultRetryBackoff),
+		MaxDelay:      durationPtr(defaultRetryMaxDelay),
+		MaxRetryCount: intPtr(defaultRetryMaxAttempts),
+	}
+
+	if n := e.GetInt(env.APIRetryMaxAttempts); n > 0 {
+		opts.MaxRetryCount = intPtr(n)
+	}
+	if n := e.GetInt(env.APIRetryDelay); n > 0 {
+		opts.Delay = durationPtr(time.Duration(n) * time.Millisecond)
+	}
+	if n := e.GetInt(env.APIRetryMaxDelay); n > 0 {
+		opts.MaxDelay = durationPtr(time.Duration(n) * time.Millisecond)
+	}
+	if s := e.GetString(env.APIRetryBackoff); s != "" {
+		backoff, err := strconv.ParseFloat(s, 64)
+		if err != nil || backoff < 1 {
+			warnf(d, "ignoring %s=%q: the backoff must be a number no less than 1",
+				env.APIRetryBackoff.Var().Name(), s)
+		} else {
+			opts.Backoff = float64Ptr(backoff)
+		}
+	}
+
+	opts.RetryableStatusCodes = defaultRetryableStatusCodes()
+	if s := e.GetString(env.APIRetryableStatusCodes); s != "" {
+		codes, err := parseStatusCodes(s)
+		if err != nil {
+			warnf(d, "ignoring %s=%q: %v", env.APIRetryableStatusCodes.Var().Name(), s, err)
+		} else {
+			opts.RetryableStatusCodes = codes
+		}
+	}
+
+	return opts
+}
+
+// defaultRetryableStatusCodes returns the status codes that are retried by default: 429 (Too Many Requests), and
+// every 5xx code.
+func defaultRetryableStatusCodes() []int {
+	codes := []int{http.StatusTooManyRequests}
+	for code := 500; code <= 599; code++ {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// parseStatusCodes parses a comma separated list of HTTP status codes.
+func parseStatusCodes(s string) ([]int, error) {
+	var codes []int
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		code, err := strconv.Atoi(field)
+		if err != nil || code < 100 || code > 599 {
+			return nil, fmt.Errorf("%q is not an HTTP status code", field)
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+func hasStatusCode(codes []int, code int) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func warnf(d diag.Sink, format string, args ...interface{}) {
+	if d == nil {
+		logging.Warningf(format, args...)
+		return
+	}
+	d.Warningf(diag.Message("", format), args...)
+}
+
+// circuitBreaker stops requests from being made to the service once too many in a row have failed, so that an
+// outage fails fast instead of every request waiting out its own retries.
+//
+// The breaker trips after threshold consecutive failures. Requests then fail immediately until cooldown has passed,
+// after which they're attempted again; the first success closes the breaker, and another failure trips it again.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	diag      diag.Sink
+
+	m         sync.Mutex
+	failures  int       // the number of consecutive failed requests.
+	lastErr   string    // a description of the last failure.
+	openUntil time.Time // requests fail immediately before this time.
+}
+
+// newCircuitBreaker returns the circuit breaker configured by the environment, or nil if it's disabled. The breaker
+// is opt-in, as failing fast would otherwise fail long-running updates, e.g. when their checkpoints can't be saved
+// during an outage that they'd have outlasted by retrying.
+func newCircuitBreaker(d diag.Sink, e env.Env) *circuitBreaker {
+	threshold := e.GetInt(env.APICircuitBreakerThreshold)
+	if threshold <= 0 {
+		return nil
+	}
+
+	cooldown := defaultCircuitBreakerCooldown
+	if n := e.GetInt(env.APICircuitBreakerCooldown); n > 0 {
+		cooldown = time.Duration(n) * time.Second
+	}
+
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		diag:      d,
+	}
+}
+
+// CircuitOpenError is returned for requests that aren't made because too many requests to the service in a row
+// have failed.
+type CircuitOpenError struct {
+	Host     string        // the host requests were sent to.
+	Failures int           // the number of consecutive failed requests.
+	LastErr  string        // a description of the last failure.
+	RetryIn  time.Duration // how long until requests are attempted again.
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("pulumi service: not sending requests to %s for %v after %d consecutive requests failed "+
+		"(last: %s); set %s to change how many failures are tolerated",
+		e.Host, e.RetryIn.Round(time.Second), e.Failures, e.LastErr, env.APICircuitBreakerThreshold.Var().Name())
+}
+
+// allow returns a *CircuitOpenError if requests to host shouldn't be made right now.
+func (b *circuitBreaker) allow(host string) error {
+	if b == nil {
+		return nil
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if wait := b.openUntil.Sub(b.now()); wait > 0 {
+		return &CircuitOpenError{
+			Host:     host,
+			Failures: b.failures,
+			LastErr:  b.lastErr,
+			RetryIn:  wait,
+		}
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of a request to host, after any retries.
+func (b *circuitBreaker) record(host string, failed bool, reason string) {
+	if b == nil {
+		return
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if !failed {
+		if b.failures >= b.threshold {
+			logging.V(apiRequestLogLevel).Infof("requests to %s are succeeding again", host)
+		}
+		b.failures, b.lastErr, b.openUntil = 0, "", time.Time{}
+		return
+	}
+
+	b.failures++
+	b.lastErr = reason
+	if b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+		if b.failures == b.threshold {
+			warnf(b.diag, "%d consecutive requests to %s have failed (last: %s); pausing requests for %v",
+				b.failures, host, reason, b.cooldown)
+		}
+	}
+}
+
+// requestFailure reports whether the outcome of a request counts as a failure for the circuit breaker, and why.
+// Only transient failures, those that would have been retried, count; cancellation doesn't.
+func requestFailure(res *http.Response, err error, retryable []int) (bool, string) {
+	switch {
+	case err != nil:
+		var circuitErr *CircuitOpenError
+		if errors.Is(err, context.Canceled) || errors.As(err, &circuitErr) {
+			return false, ""
+		}
+		return true, err.Error()
+	case hasStatusCode(retryable, res.StatusCode):
+		return true, res.Status
+	default:
+		return false, ""
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// ReadOnly can be set to true to put every backend in read-only mode for the current command, in which any
+// operation that would write to the state of a stack fails with a *ReadOnlyError.
+var ReadOnly bool
+
+// IsReadOnly returns true if the backend with the given URL is in read-only mode, either because ReadOnly or
+// PULUMI_READ_ONLY is set, or because it was logged in to with `pulumi login --read-only`.
+func IsReadOnly(e env.Env, url string) bool {
+	return ReadOnly || e.GetBool(env.ReadOnly) || workspace.GetCloudReadOnly(url)
+}
+
+// ReadOnlyError is returned by backends in read-only mode for any operation that would write to the state of a
+// stack.
+type ReadOnlyError struct {
+	// Operation describes what couldn't be done, e.g. "update stack dev".
+	Operation string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("cannot %s: the backend is in read-only mode; log in again without --read-only "+
+		"and unset %s to make changes", e.Operation, env.ReadOnly.Var().Name())
+}
@@ -76,6 +76,7 @@ var _ engine.SnapshotManager = (*SnapshotManager)(nil)
 
 type mutationRequest struct {
 	mutator func() bool
+	durable bool
 	result  chan<- error
 }
 
@@ -102,9 +103,20 @@ func (sm *SnapshotManager) Close() error {
 // You should never observe or mutate the global snapshot without using this function unless
 // you have a very good justification.
 func (sm *SnapshotManager) mutate(mutator func() bool) error {
+	return sm.request(mutator, false)
+}
+
+// mutateDurably is like mutate, but when checkpoint writes are batched it does not return until the mutation has
+// been persisted. It is used to record pending operations, which must be persisted before the engine asks a provider
+// to perform them so that an interrupted update leaves a record of the operations it may have left incomplete.
+func (sm *SnapshotManager) mutateDurably(mutator func() bool) error {
+	return sm.request(mutator, true)
+}
+
+func (sm *SnapshotManager) request(mutator func() bool, durable bool) error {
 	result := make(chan error)
 	select {
-	case sm.mutationRequests <- mutationRequest{mutator: mutator, result: result}:
+	case sm.mutationRequests <- mutationRequest{mutator: mutator, durable: durable, result: result}:
 		return <-result
 	case <-sm.cancel:
 		return errors.New("snapshot manager closed")
@@ -246,6 +258,29 @@ func (ssm *sameSnapshotMutation) mustWrite(step *deploy.SameStep) bool {
 		return true
 	}
 
+	// If the frozen attribute of this resource has changed, we must write the checkpoint.
+	if old.Frozen != new.Frozen {
+		logging.V(9).Infof("SnapshotManager: mustWrite() true because of Frozen")
+		return true
+	}
+
+	// If the retry policy of this resource has changed, we must write the checkpoint.
+	if old.RetryPolicy.MaxRetries != new.RetryPolicy.MaxRetries ||
+		old.RetryPolicy.InitialDelay != new.RetryPolicy.InitialDelay ||
+		old.RetryPolicy.MaxDelay != new.RetryPolicy.MaxDelay ||
+		(len(old.RetryPolicy.RetryOn) != 0 || len(new.RetryPolicy.RetryOn) != 0) &&
+			!reflect.DeepEqual(old.RetryPolicy.RetryOn, new.RetryPolicy.RetryOn) {
+		logging.V(9).Infof("SnapshotManager: mustWrite() true because of RetryPolicy")
+		return true
+	}
+
+	// If the metadata of this resource's outputs has changed, we must write the checkpoint.
+	if (len(old.OutputMetadata) != 0 || len(new.OutputMetadata) != 0) &&
+		!reflect.DeepEqual(old.OutputMetadata, new.OutputMetadata) {
+		logging.V(9).Infof("SnapshotManager: mustWrite() true because of OutputMetadata")
+		return true
+	}
+
 	// If the source position of this resource has changed, we must write the checkpoint.
 	if old.SourcePosition != new.SourcePosition {
 		logging.V(9).Infof("SnapshotManager: mustWrite() true because of SourcePosition")
@@ -325,7 +360,7 @@ func (ssm *sameSnapshotMutation) End(step deploy.Step, successful bool) error {
 
 func (sm *SnapshotManager) doCreate(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doCreate(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateDurably(func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeCreating)
 		return true
 	})
@@ -369,7 +404,7 @@ func (csm *createSnapshotMutation) End(step deploy.Step, successful bool) error
 
 func (sm *SnapshotManager) doUpdate(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doUpdate(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateDurably(func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeUpdating)
 		return true
 	})
@@ -399,7 +434,7 @@ func (usm *updateSnapshotMutation) End(step deploy.Step, successful bool) error
 
 func (sm *SnapshotManager) doDelete(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doDelete(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateDurably(func() bool {
 		sm.markOperationPending(step.Old(), resource.OperationTypeDeleting)
 		return true
 	})
@@ -446,7 +481,7 @@ func (rsm *replaceSnapshotMutation) End(step deploy.Step, successful bool) error
 
 func (sm *SnapshotManager) doRead(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doRead(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateDurably(func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeReading)
 		return true
 	})
@@ -512,7 +547,7 @@ func (rsm *removePendingReplaceSnapshotMutation) End(step deploy.Step, successfu
 
 func (sm *SnapshotManager) doImport(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doImport(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateDurably(func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeImporting)
 		return true
 	})
@@ -651,7 +686,11 @@ func (sm *SnapshotManager) snap() *deploy.Snapshot {
 	}
 
 	manifest.Magic = manifest.NewMagic()
-	return deploy.NewSnapshot(manifest, secretsManager, resources, operations)
+	snap := deploy.NewSnapshot(manifest, secretsManager, resources, operations)
+	if sm.baseSnapshot != nil {
+		snap.SecretsRotations = sm.baseSnapshot.SecretsRotations
+	}
+	return snap
 }
 
 // saveSnapshot persists the current snapshot and optionally verifies it afterwards.
@@ -660,6 +699,11 @@ func (sm *SnapshotManager) saveSnapshot() error {
 	if err != nil {
 		return fmt.Errorf("failed to normalize URN references: %w", err)
 	}
+	return sm.persist(snap)
+}
+
+// persist persists the given snapshot, taken by saveSnapshot or the batched service loop, and verifies it afterwards.
+func (sm *SnapshotManager) persist(snap *deploy.Snapshot) error {
 	if err := sm.persister.Save(snap); err != nil {
 		return fmt.Errorf("failed to save snapshot: %w", err)
 	}
@@ -717,6 +761,133 @@ func (sm *SnapshotManager) unsafeServiceLoop(mutationRequests chan mutationReque
 	}
 }
 
+// batchedServiceLoop applies mutations as they occur but persists Snapshots in the background, coalescing the writes
+// for mutations that occur within the given window of each other into a single write. Mutations that record pending
+// operations are not acknowledged until a Snapshot that includes them has been persisted, so every persisted Snapshot
+// records the operations that may have been started since the one before it. Each Snapshot is taken between two
+// mutations, so a Snapshot persisted before a crash is consistent, if older than the in-memory state.
+//
+// If a write fails, the error is returned for each subsequent mutation and by Close.
+func (sm *SnapshotManager) batchedServiceLoop(
+	window time.Duration, mutationRequests chan mutationRequest, done chan error,
+) {
+	snaps, written := make(chan *deploy.Snapshot), make(chan error)
+	go func() {
+		for snap := range snaps {
+			written <- sm.persist(snap)
+		}
+	}()
+	defer close(snaps)
+
+	var (
+		dirty    bool           // True if there are mutations that have not been persisted.
+		elided   bool           // True if there are elided writes that must be flushed by Close.
+		waiting  []chan<- error // The durable requests awaiting the next write.
+		inflight []chan<- error // The durable requests awaiting the write in progress.
+		writing  bool           // True if a write is in progress.
+		due      bool           // True if the window for the dirty mutations has elapsed.
+		failure  error          // The first write failure, if any.
+		timer    *time.Timer    // The timer for the window of the dirty mutations, if any.
+		timeout  <-chan time.Time
+	)
+	startTimer := func() {
+		if timer == nil && !due {
+			timer = time.NewTimer(window)
+			timeout = timer.C
+		}
+	}
+	startWrite := func() {
+		if timer != nil {
+			timer.Stop()
+			timer, timeout = nil, nil
+		}
+		snap, err := sm.snap().NormalizeURNReferences()
+		if err != nil {
+			err = fmt.Errorf("failed to normalize URN references: %w", err)
+			for _, result := range waiting {
+				result <- err
+			}
+			waiting, failure = nil, err
+			return
+		}
+		inflight, waiting = waiting, nil
+		dirty, elided, due, writing = false, false, false, true
+		snaps <- snap
+	}
+	finishWrite := func(err error) {
+		for _, result := range inflight {
+			result <- err
+		}
+		inflight, writing = nil, false
+		if err != nil && failure == nil {
+			failure = err
+		}
+	}
+
+serviceLoop:
+	for {
+		select {
+		case request := <-mutationRequests:
+			if failure != nil {
+				request.result <- failure
+				continue
+			}
+			if !request.mutator() {
+				elided = true
+				request.result <- nil
+				continue
+			}
+			dirty = true
+			if request.durable {
+				waiting = append(waiting, request.result)
+			} else {
+				request.result <- nil
+			}
+
+			// If a write is in progress, the write after it will include this mutation.
+			if len(waiting) > 0 && !writing {
+				startWrite()
+			} else {
+				startTimer()
+			}
+		case <-timeout:
+			timer, timeout = nil, nil
+			if writing {
+				due = true
+			} else {
+				startWrite()
+			}
+		case err := <-written:
+			finishWrite(err)
+			switch {
+			case failure != nil:
+				for _, result := range waiting {
+					result <- failure
+				}
+				waiting = nil
+			case len(waiting) > 0 || dirty && due:
+				startWrite()
+			case dirty:
+				startTimer()
+			}
+		case <-sm.cancel:
+			break serviceLoop
+		}
+	}
+
+	if writing {
+		finishWrite(<-written)
+	}
+	if timer != nil {
+		timer.Stop()
+	}
+	if failure == nil && (dirty || elided) {
+		logging.V(9).Infof("SnapshotManager: flushing batched writes...")
+		failure = sm.saveSnapshot()
+	}
+	done <- failure
+}
+
 // NewSnapshotManager creates a new SnapshotManager for the given stack name, using the given persister, default secrets
 // manager and base snapshot.
 //
@@ -745,6 +916,10 @@ func NewSnapshotManager(
 
 	if env.SkipCheckpoints.Value() {
 		serviceLoop = manager.unsafeServiceLoop
+	} else if window := env.CheckpointBatchWindow.Value(); window > 0 {
+		serviceLoop = func(mutationRequests chan mutationRequest, done chan error) {
+			manager.batchedServiceLoop(time.Duration(window)*time.Millisecond, mutationRequests, done)
+		}
 	}
 
 	go serviceLoop(mutationRequests, done)
@@ -316,6 +316,46 @@ func TestWriteCheckpointOnceUnsafe(t *testing.T) {
 	assert.Len(t, sp.SavedSnapshots, 1)
 }
 
+// This test checks that when checkpoint writes are batched via env.CheckpointBatchWindow, only the mutations that
+// record pending operations are written before Close, and that each of those writes includes the mutations before it.
+//
+//nolint:paralleltest // mutates environment variables
+func TestWriteCheckpointsBatched(t *testing.T) {
+	t.Setenv(env.CheckpointBatchWindow.Var().Name(), "60000")
+
+	resourceA := NewResource("a")
+	resourceB := NewResource("b")
+	manager, sp := MockSetup(t, NewSnapshot(nil))
+
+	create := func(state *resource.State) {
+		step := deploy.NewCreateStep(nil, &MockRegisterResourceEvent{}, state)
+		mutation, err := manager.BeginMutation(step)
+		require.NoError(t, err)
+		err = mutation.End(step, true /* successful */)
+		require.NoError(t, err)
+	}
+
+	// Beginning the first create is written straight away, but ending it is deferred.
+	create(resourceA)
+	require.Len(t, sp.SavedSnapshots, 1)
+	assert.Len(t, sp.LastSnap().Resources, 0)
+	assert.Len(t, sp.LastSnap().PendingOperations, 1)
+
+	// Beginning the second create writes the end of the first along with it.
+	create(resourceB)
+	require.Len(t, sp.SavedSnapshots, 2)
+	assert.Len(t, sp.LastSnap().Resources, 1)
+	assert.Len(t, sp.LastSnap().PendingOperations, 1)
+	assert.Equal(t, resourceB.URN, sp.LastSnap().PendingOperations[0].Resource.URN)
+
+	// Close flushes the end of the second create.
+	err := manager.Close()
+	require.NoError(t, err)
+	require.Len(t, sp.SavedSnapshots, 3)
+	assert.Len(t, sp.LastSnap().Resources, 2)
+	assert.Len(t, sp.LastSnap().PendingOperations, 0)
+}
+
 // This test exercises same steps with meaningful changes to properties _other_ than `Dependencies` in order to ensure
 // that the snapshot is written.
 func TestSamesWithOtherMeaningfulChanges(t *testing.T) {
@@ -353,6 +393,16 @@ func TestSamesWithOtherMeaningfulChanges(t *testing.T) {
 	changes = append(changes, NewResource(resourceA.URN))
 	changes[4].SourcePosition = "project:///foo.ts#1,2"
 
+	// Change the resource retry policy.
+	changes = append(changes, NewResource(resourceA.URN))
+	changes[5].RetryPolicy = resource.RetryPolicy{MaxRetries: 3, RetryOn: []string{"throttled"}}
+
+	// Change the metadata of the resource outputs.
+	changes = append(changes, NewResource(resourceA.URN))
+	changes[6].OutputMetadata = map[resource.PropertyKey]resource.OutputMetadata{
+		"foo": {Description: "The foo output."},
+	}
+
 	snap := NewSnapshot([]*resource.State{
 		provider,
 		resourceP,
@@ -18,11 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/pulumi/pulumi/pkg/v3/display"
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/operations"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
@@ -147,6 +149,34 @@ func ImportStackDeployment(ctx context.Context, s Stack, deployment *apitype.Unt
 	return s.Backend().ImportDeployment(ctx, s, deployment)
 }
 
+// ExportStackDeploymentStream writes the given stack's deployment to w as a deployment stream. Backends that can't
+// stream deployments export the whole deployment and then convert it.
+func ExportStackDeploymentStream(ctx context.Context, s Stack, w io.Writer) error {
+	if streamer, ok := s.Backend().(DeploymentStreamer); ok {
+		return streamer.ExportDeploymentStream(ctx, s, w)
+	}
+
+	deployment, err := s.Backend().ExportDeployment(ctx, s)
+	if err != nil {
+		return err
+	}
+	return stack.EncodeUntypedDeploymentStream(w, deployment)
+}
+
+// ImportStackDeploymentStream imports the deployment read from the given deployment stream into the indicated stack.
+// Backends that can't stream deployments read the whole deployment and then import it.
+func ImportStackDeploymentStream(ctx context.Context, s Stack, r *stack.DeploymentStreamReader) error {
+	if streamer, ok := s.Backend().(DeploymentStreamer); ok {
+		return streamer.ImportDeploymentStream(ctx, s, r)
+	}
+
+	deployment, err := r.ReadUntypedDeployment()
+	if err != nil {
+		return err
+	}
+	return s.Backend().ImportDeployment(ctx, s, deployment)
+}
+
 // UpdateStackTags updates the stacks's tags, replacing all existing tags.
 func UpdateStackTags(ctx context.Context, s Stack, tags map[apitype.StackTagName]string) error {
 	return s.Backend().UpdateStackTags(ctx, s, tags)
@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/backend/filestate"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
@@ -30,6 +31,7 @@ import (
 func newCancelCmd() *cobra.Command {
 	var yes bool
 	var stack string
+	var forceUnlock bool
 	cmd := &cobra.Command{
 		Use:   "cancel [<stack-name>]",
 		Args:  cmdutil.MaximumNArgs(1),
@@ -41,7 +43,10 @@ func newCancelCmd() *cobra.Command {
 			"inconsistent state if a resource operation was pending when the update was canceled.\n" +
 			"\n" +
 			"After this command completes successfully, the stack will be ready for further\n" +
-			"updates.",
+			"updates.\n" +
+			"\n" +
+			"For DIY backends, --force-unlock only removes the stack's locks whose holders are\n" +
+			"known to have exited, and fails if any holder may still be running.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
 			// Use the stack provided or, if missing, default to the current one.
@@ -62,8 +67,23 @@ func newCancelCmd() *cobra.Command {
 				return err
 			}
 
-			// Ensure the user really wants to do this.
 			stackName := s.Ref().Name().String()
+			if forceUnlock {
+				lb, ok := s.Backend().(filestate.Backend)
+				if !ok {
+					return errors.New("--force-unlock is only supported by DIY backends")
+				}
+				if err := lb.ForceUnlock(ctx, s.Ref()); err != nil {
+					return err
+				}
+
+				msg := fmt.Sprintf("%sAny stale locks for '%s' have been removed!%s",
+					colors.SpecAttention, stackName, colors.Reset)
+				fmt.Println(opts.Color.Colorize(msg))
+				return nil
+			}
+
+			// Ensure the user really wants to do this.
 			prompt := fmt.Sprintf("This will irreversibly cancel the currently running update for '%s'!", stackName)
 			if cmdutil.Interactive() && (!yes && !confirmPrompt(prompt, stackName, opts)) {
 				return result.FprintBailf(os.Stdout, "confirmation declined")
@@ -86,6 +106,9 @@ func newCancelCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(
 		&yes, "yes", "y", false,
 		"Skip confirmation prompts, and proceed with cancellation anyway")
+	cmd.PersistentFlags().BoolVar(
+		&forceUnlock, "force-unlock", false,
+		"Only remove locks whose holders are known to have exited, rather than canceling the update")
 	cmd.PersistentFlags().StringVarP(
 		&stack, "stack", "s", "",
 		"The name of the stack to operate on. Defaults to the current stack")
@@ -36,7 +36,11 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/age"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/chain"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/cloud"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/external"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/namespaced"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/passphrase"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
@@ -221,7 +225,7 @@ func copySingleConfigKey(configKey string, path bool, currentStack backend.Stack
 		decrypter = config.NewPanicCrypter()
 	}
 
-	encrypter, _, cerr := getStackEncrypter(destinationStack, destinationProjectStack)
+	encrypter, _, cerr := getStackConfigEncrypter(destinationStack, destinationProjectStack, key)
 	if cerr != nil {
 		return cerr
 	}
@@ -239,6 +243,25 @@ func copySingleConfigKey(configKey string, path bool, currentStack backend.Stack
 	return saveProjectStack(destinationStack, destinationProjectStack)
 }
 
+// copyConfigMap copies cfg, re-encrypting each of its values with the encrypter returned by encrypter for its key.
+func copyConfigMap(cfg config.Map, decrypter config.Decrypter,
+	encrypter func(key config.Key) (config.Encrypter, error),
+) (config.Map, error) {
+	newConfig := make(config.Map, len(cfg))
+	for key, val := range cfg {
+		enc, err := encrypter(key)
+		if err != nil {
+			return nil, err
+		}
+		newVal, err := val.Copy(decrypter, enc)
+		if err != nil {
+			return nil, err
+		}
+		newConfig[key] = newVal
+	}
+	return newConfig, nil
+}
+
 func copyEntireConfigMap(currentStack backend.Stack,
 	currentProjectStack *workspace.ProjectStack, destinationStack backend.Stack,
 	destinationProjectStack *workspace.ProjectStack,
@@ -258,12 +281,8 @@ func copyEntireConfigMap(currentStack backend.Stack,
 		decrypter = config.NewPanicCrypter()
 	}
 
-	encrypter, _, cerr := getStackEncrypter(destinationStack, destinationProjectStack)
-	if cerr != nil {
-		return false, cerr
-	}
-
-	newProjectConfig, err := currentConfig.Copy(decrypter, encrypter)
+	newProjectConfig, err := copyConfigMap(currentConfig, decrypter,
+		stackConfigEncrypters(destinationStack, destinationProjectStack))
 	if err != nil {
 		return false, err
 	}
@@ -491,17 +510,10 @@ func newConfigRefreshCmd(stk *string) *cobra.Command {
 				// what we kept in the statefile. That would go well with the pluginification of secret
 				// providers as well, but for now just switch on the secret provider type and ask it to fill in
 				// the config file for us.
-				if deployment.SecretsProviders.Type == passphrase.Type {
-					err = passphrase.EditProjectStack(ps, deployment.SecretsProviders.State)
-				} else if deployment.SecretsProviders.Type == cloud.Type {
-					err = cloud.EditProjectStack(ps, deployment.SecretsProviders.State)
-				} else {
-					// Anything else assume we can just clear all the secret bits
-					ps.EncryptionSalt = ""
-					ps.SecretsProvider = ""
-					ps.EncryptedKey = ""
-				}
-
+				ps.FallbackProviders = nil
+				ps.NamespaceProviders = nil
+				err = editProjectStackSecretsProvider(ps, deployment.SecretsProviders.Type,
+					deployment.SecretsProviders.State)
 				if err != nil {
 					return err
 				}
@@ -544,6 +556,31 @@ func newConfigRefreshCmd(stk *string) *cobra.Command {
 	return refreshCmd
 }
 
+// editProjectStackSecretsProvider restores the secrets provider configuration of ps from the state of a secrets
+// manager of type ty.
+func editProjectStackSecretsProvider(ps *workspace.ProjectStack, ty string, state json.RawMessage) error {
+	switch ty {
+	case passphrase.Type:
+		return passphrase.EditProjectStack(ps, state)
+	case cloud.Type:
+		return cloud.EditProjectStack(ps, state)
+	case age.Type:
+		return age.EditProjectStack(ps, state)
+	case external.Type:
+		return external.EditProjectStack(ps, state)
+	case chain.Type:
+		return chain.EditProjectStack(ps, state, editProjectStackSecretsProvider)
+	case namespaced.Type:
+		return namespaced.EditProjectStack(ps, state, editProjectStackSecretsProvider)
+	default:
+		// Anything else assume we can just clear all the secret bits
+		ps.EncryptionSalt = ""
+		ps.SecretsProvider = ""
+		ps.EncryptedKey = ""
+		return nil
+	}
+}
+
 func newConfigSetCmd(stack *string) *cobra.Command {
 	var plaintext bool
 	var secret bool
@@ -619,7 +656,7 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 			if secret {
 				// We're always going to save, so can ignore the bool for if getStackEncrypter changed the
 				// config data.
-				c, _, cerr := getStackEncrypter(s, ps)
+				c, _, cerr := getStackConfigEncrypter(s, ps, key)
 				if cerr != nil {
 					return cerr
 				}
@@ -723,7 +760,7 @@ func newConfigSetAllCmd(stack *string) *cobra.Command {
 				}
 				// We're always going to save, so can ignore the bool for if getStackEncrypter changed the
 				// config data.
-				c, _, cerr := getStackEncrypter(stack, ps)
+				c, _, cerr := getStackConfigEncrypter(stack, ps, key)
 				if cerr != nil {
 					return cerr
 				}
@@ -1338,6 +1375,13 @@ func getStackConfigurationWithFallback(
 		}, sm, nil
 	}
 
+	// Check that the secrets manager works before any operation starts, rather than failing part way through. This
+	// is only done when the configuration has secrets, so that e.g. a locked passphrase secrets manager can still be
+	// used for stacks that don't.
+	if err := secrets.Validate(ctx, sm); err != nil {
+		return backend.StackConfiguration{}, nil, fmt.Errorf("validating secrets provider: %w", err)
+	}
+
 	crypter, err := sm.Decrypter()
 	if err != nil {
 		return backend.StackConfiguration{}, nil, fmt.Errorf("getting configuration decrypter: %w", err)
@@ -16,13 +16,21 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"os/user"
 	"strings"
 
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/age"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/cache"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/chain"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/cloud"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/external"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/namespaced"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/passphrase"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
@@ -41,6 +49,48 @@ func getStackEncrypter(s backend.Stack, ps *workspace.ProjectStack) (config.Encr
 	return enc, needsSave, nil
 }
 
+// getStackConfigEncrypter returns the encrypter for the config value key: that of the secrets provider for key's
+// namespace, if the stack has one, and the stack's encrypter otherwise.
+func getStackConfigEncrypter(
+	s backend.Stack, ps *workspace.ProjectStack, key config.Key,
+) (config.Encrypter, bool, error) {
+	p, ok := ps.ConfigSecretsProvider(key.Namespace())
+	if !ok {
+		return getStackEncrypter(s, ps)
+	}
+
+	sm, err := newConfigSecretsManager(s, p)
+	if err != nil {
+		return nil, false, err
+	}
+	enc, err := sm.Encrypter()
+	if err != nil {
+		return nil, false, err
+	}
+	return namespaced.NewEncrypter(p.Namespace, enc), false, nil
+}
+
+// stackConfigEncrypters returns a function that returns the encrypter for a config value key like
+// getStackConfigEncrypter, but which constructs each of the stack's secrets managers at most once.
+func stackConfigEncrypters(s backend.Stack, ps *workspace.ProjectStack) func(key config.Key) (config.Encrypter, error) {
+	encrypters := map[string]config.Encrypter{}
+	return func(key config.Key) (config.Encrypter, error) {
+		var namespace string
+		if _, ok := ps.ConfigSecretsProvider(key.Namespace()); ok {
+			namespace = key.Namespace()
+		}
+		if enc, ok := encrypters[namespace]; ok {
+			return enc, nil
+		}
+		enc, _, err := getStackConfigEncrypter(s, ps, key)
+		if err != nil {
+			return nil, err
+		}
+		encrypters[namespace] = enc
+		return enc, nil
+	}
+}
+
 func getStackDecrypter(s backend.Stack, ps *workspace.ProjectStack) (config.Decrypter, bool, error) {
 	sm, needsSave, err := getStackSecretsManager(s, ps)
 	if err != nil {
@@ -57,9 +107,86 @@ func getStackDecrypter(s backend.Stack, ps *workspace.ProjectStack) (config.Decr
 func getStackSecretsManager(s backend.Stack, ps *workspace.ProjectStack) (secrets.Manager, bool, error) {
 	oldConfig := deepcopy.Copy(ps).(*workspace.ProjectStack)
 
+	sm, err := newProjectStackSecretsManager(s, ps)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The config values of namespaces with secrets providers of their own are encrypted with those instead.
+	if len(ps.NamespaceProviders) > 0 {
+		namespaces := make(map[string]secrets.Manager, len(ps.NamespaceProviders))
+		for _, p := range ps.NamespaceProviders {
+			nsm, err := newConfigSecretsManager(s, p)
+			if err != nil {
+				return nil, false, err
+			}
+			namespaces[p.Namespace] = nsm
+		}
+		sm, err = namespaced.NewNamespacedSecretsManager(sm, namespaces)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Secrets that were encrypted before the secrets provider changed can still be decrypted by the previous
+	// providers. Their configuration is copied so that it's never modified.
+	if len(ps.FallbackProviders) > 0 {
+		fallbacks := make([]secrets.Manager, 0, len(ps.FallbackProviders))
+		for _, f := range ps.FallbackProviders {
+			fallback, err := newProjectStackSecretsManager(s, &workspace.ProjectStack{
+				SecretsProvider: f.SecretsProvider,
+				EncryptedKey:    f.EncryptedKey,
+				EncryptionSalt:  f.EncryptionSalt,
+			})
+			if err != nil {
+				return nil, false, fmt.Errorf("creating fallback secrets manager: %w", err)
+			}
+			fallbacks = append(fallbacks, fallback)
+		}
+		sm, err = chain.NewChainedSecretsManager(sm, fallbacks...)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Share decrypted secrets with the other components of this operation, e.g. the engine's snapshot loader.
+	sm = cache.NewCachingSecretsManager(sm, cache.Default)
+
+	if path := env.SecretsAuditLog.Value(); path != "" {
+		sm = secrets.NewAuditingSecretsManager(sm, secrets.NewFileAuditSink(path), s.Ref().String(), auditCaller())
+	}
+
+	// Handle if the configuration changed any of EncryptedKey, etc
+	needsSave := needsSaveProjectStackAfterSecretManger(s, oldConfig, ps)
+	return stack.NewCachingSecretsManager(sm), needsSave, nil
+}
+
+// stackSecretsProvider returns the provider used to construct the secrets managers recorded in the state of s.
+func stackSecretsProvider(s backend.Stack) secrets.Provider {
+	if path := env.SecretsAuditLog.Value(); path != "" {
+		return secrets.NewAuditingProvider(stack.DefaultSecretsProvider, secrets.NewFileAuditSink(path),
+			s.Ref().String(), auditCaller())
+	}
+	return stack.DefaultSecretsProvider
+}
+
+// auditCaller returns the name of the local user, which is recorded as the caller of audited secret decryptions.
+func auditCaller() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// newProjectStackSecretsManager creates the secrets manager for the secrets provider configured in ps.
+func newProjectStackSecretsManager(s backend.Stack, ps *workspace.ProjectStack) (secrets.Manager, error) {
 	var sm secrets.Manager
 	var err error
-	if ps.SecretsProvider != passphrase.Type && ps.SecretsProvider != "default" && ps.SecretsProvider != "" {
+	if age.IsAgeSecretsProvider(ps.SecretsProvider) {
+		sm, err = age.NewAgeSecretsManager(ps, ps.SecretsProvider, false /* rotateSecretsProvider */)
+	} else if external.IsExternalSecretsProvider(ps.SecretsProvider) {
+		sm, err = external.NewExternalSecretsManager(ps, ps.SecretsProvider, false /* rotateSecretsProvider */)
+	} else if ps.SecretsProvider != passphrase.Type && ps.SecretsProvider != "default" && ps.SecretsProvider != "" {
 		sm, err = cloud.NewCloudSecretsManager(
 			ps, ps.SecretsProvider, false /* rotateSecretsProvider */)
 	} else if ps.EncryptionSalt != "" {
@@ -69,12 +196,63 @@ func getStackSecretsManager(s backend.Stack, ps *workspace.ProjectStack) (secret
 		sm, err = s.DefaultSecretManager(ps)
 	}
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
+	return secrets.NewInstrumentedSecretsManager(sm, secrets.DefaultMetrics), nil
+}
 
-	// Handle if the configuration changed any of EncryptedKey, etc
-	needsSave := needsSaveProjectStackAfterSecretManger(s, oldConfig, ps)
-	return stack.NewCachingSecretsManager(sm), needsSave, nil
+// newConfigSecretsManager returns the secrets manager for the config values of a namespace. Its configuration is
+// copied so that it's never modified.
+func newConfigSecretsManager(s backend.Stack, p workspace.ConfigSecretsProvider) (secrets.Manager, error) {
+	sm, err := newProjectStackSecretsManager(s, &workspace.ProjectStack{
+		SecretsProvider: p.SecretsProvider,
+		EncryptedKey:    p.EncryptedKey,
+		EncryptionSalt:  p.EncryptionSalt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating secrets manager for namespace %q: %w", p.Namespace, err)
+	}
+	return sm, nil
+}
+
+// keepPreviousSecretsProvider records the secrets provider configuration in previous as a fallback of the stack, so
+// that secrets encrypted with it can still be decrypted after the stack's secrets provider changes.
+func keepPreviousSecretsProvider(project *workspace.Project, s backend.Stack, previous *workspace.ProjectStack) error {
+	ps, err := loadProjectStack(project, s)
+	if err != nil {
+		return err
+	}
+
+	fallback := workspace.FallbackSecretsProvider{
+		SecretsProvider: previous.SecretsProvider,
+		EncryptedKey:    previous.EncryptedKey,
+		EncryptionSalt:  previous.EncryptionSalt,
+	}
+	if fallback.SecretsProvider == "" && fallback.EncryptionSalt == "" {
+		// The stack was using the backend's default secrets manager, which needs no fallback.
+		return nil
+	}
+	ps.FallbackProviders = append([]workspace.FallbackSecretsProvider{fallback}, ps.FallbackProviders...)
+	return saveProjectStack(s, ps)
+}
+
+// newDetachedSecretsManager creates a secrets manager for the given secrets provider that isn't tied to the
+// configuration of any stack. Its state is only recorded in the deployments it serializes, e.g. when exporting a
+// deployment for import into a stack that uses a different secrets provider.
+func newDetachedSecretsManager(s backend.Stack, secretsProvider string) (secrets.Manager, error) {
+	ps := &workspace.ProjectStack{}
+	switch {
+	case secretsProvider == "" || secretsProvider == "default":
+		return s.DefaultSecretManager(ps)
+	case secretsProvider == passphrase.Type:
+		return passphrase.NewPromptingPassphraseSecretsManager(ps, false /* rotateSecretsProvider */)
+	case age.IsAgeSecretsProvider(secretsProvider):
+		return age.NewAgeSecretsManager(ps, secretsProvider, false /* rotateSecretsProvider */)
+	case external.IsExternalSecretsProvider(secretsProvider):
+		return external.NewExternalSecretsManager(ps, secretsProvider, false /* rotateSecretsProvider */)
+	default:
+		return cloud.NewCloudSecretsManager(ps, secretsProvider, false /* rotateSecretsProvider */)
+	}
 }
 
 func needsSaveProjectStackAfterSecretManger(stack backend.Stack,
@@ -95,12 +273,16 @@ func needsSaveProjectStackAfterSecretManger(stack backend.Stack,
 
 func validateSecretsProvider(typ string) error {
 	kind := strings.SplitN(typ, ":", 2)[0]
-	supportedKinds := []string{"default", "passphrase", "awskms", "azurekeyvault", "gcpkms", "hashivault"}
+	supportedKinds := []string{"default", "passphrase", "awskms", "azurekeyvault", "gcpkms", "hashivault", "age"}
 	for _, supportedKind := range supportedKinds {
 		if kind == supportedKind {
 			return nil
 		}
 	}
+	// Any other scheme is supported if a helper for it is installed.
+	if external.IsExternalSecretsProvider(typ) {
+		return nil
+	}
 	return fmt.Errorf("unknown secrets provider type '%s' (supported values: %s)",
 		kind,
 		strings.Join(supportedKinds, ","))
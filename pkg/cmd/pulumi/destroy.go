@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"os"
 
+	survey "github.com/AlecAivazis/survey/v2"
+	surveycore "github.com/AlecAivazis/survey/v2/core"
 	mapset "github.com/deckarep/golang-set/v2"
 
 	"github.com/spf13/cobra"
@@ -29,7 +31,6 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/graph"
-	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -64,10 +65,14 @@ func newDestroyCmd() *cobra.Command {
 	var showSames bool
 	var skipPreview bool
 	var suppressOutputs bool
+	var profileSteps bool
 	var suppressPermalink string
 	var yes bool
 	var targets *[]string
+	var excludes *[]string
 	var targetDependents bool
+	var continueOnError bool
+	var stepHooks []string
 	var excludeProtected bool
 
 	use, cmdArgs := "destroy", cmdutil.NoArgs
@@ -125,6 +130,7 @@ func newDestroyCmd() *cobra.Command {
 				IsInteractive:        interactive,
 				Type:                 displayType,
 				EventLogPath:         eventLogPath,
+				ProfileSteps:         profileSteps,
 				Debug:                debug,
 				JSONDisplay:          jsonDisplay,
 			}
@@ -144,7 +150,7 @@ func newDestroyCmd() *cobra.Command {
 
 				err = validateUnsupportedRemoteFlags(false, nil, false, "", jsonDisplay, nil,
 					nil, refresh, showConfig, false, showReplacementSteps, showSames, false,
-					suppressOutputs, "default", targets, nil, nil,
+					suppressOutputs, "default", targets, *excludes, nil, nil,
 					targetDependents, "", stackConfigFile)
 				if err != nil {
 					return result.FromError(err)
@@ -192,7 +198,7 @@ func newDestroyCmd() *cobra.Command {
 				return result.FromError(fmt.Errorf("gathering environment metadata: %w", err))
 			}
 
-			snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+			snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 			if err != nil {
 				return result.FromError(err)
 			}
@@ -263,12 +269,29 @@ func newDestroyCmd() *cobra.Command {
 				}
 			}
 
+			destroyTargets := deploy.NewUrnTargets(targetUrns).Excluding(*excludes)
+			if len(*targets) > 0 {
+				confirmed, err := confirmTargetDependents(ctx, s, destroyTargets, targetDependents,
+					interactive && !yes && !jsonDisplay, opts.Display)
+				if err != nil {
+					return result.FromError(err)
+				}
+				targetDependents = confirmed
+			}
+
+			hooks, err := makeStepHooks(stepHooks)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			opts.Engine = engine.UpdateOptions{
 				Parallel:                  parallel,
 				Debug:                     debug,
 				Refresh:                   refreshOption,
-				Targets:                   deploy.NewUrnTargets(targetUrns),
+				Targets:                   destroyTargets,
 				TargetDependents:          targetDependents,
+				ContinueOnError:           continueOnError,
+				StepHooks:                 hooks,
 				UseLegacyDiff:             useLegacyDiff(),
 				DisableProviderPreview:    disableProviderPreview(),
 				DisableResourceReferences: disableResourceReferences(),
@@ -283,14 +306,14 @@ func newDestroyCmd() *cobra.Command {
 				Opts:               opts,
 				StackConfiguration: cfg,
 				SecretsManager:     sm,
-				SecretsProvider:    stack.DefaultSecretsProvider,
+				SecretsProvider:    stackSecretsProvider(s),
 				Scopes:             backend.CancellationScopes,
 			})
 
 			if res == nil && protectedCount > 0 && !jsonDisplay {
 				fmt.Printf("All unprotected resources were destroyed. There are still %d protected resources"+
 					" associated with this stack.\n", protectedCount)
-			} else if res == nil && len(*targets) == 0 {
+			} else if res == nil && len(*targets) == 0 && len(*excludes) == 0 {
 				if !jsonDisplay && !remove {
 					fmt.Printf("The resources in the stack have been deleted, but the history and configuration "+
 						"associated with the stack are still maintained. \nIf you want to remove the stack "+
@@ -338,9 +361,24 @@ func newDestroyCmd() *cobra.Command {
 		"Specify a single resource URN to destroy. All resources necessary to destroy this target will also be destroyed."+
 			" Multiple resources can be specified using: --target urn1 --target urn2."+
 			" Wildcards (*, **) are also supported")
+	excludes = cmd.PersistentFlags().StringArray(
+		"exclude", []string{},
+		"Specify a single resource URN to exclude from the destroy. Excluded resources will not be destroyed."+
+			" Multiple resources can be specified using: --exclude urn1 --exclude urn2."+
+			" Wildcards (*, **) are also supported")
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
-		"Allows destroying of dependent targets discovered but not specified in --target list")
+		"Allows destroying of dependent targets discovered but not specified in --target list, "+
+			"without confirming them")
+	cmd.PersistentFlags().StringArrayVar(
+		&stepHooks, "step-hook", nil,
+		"Call the step hook implemented by the pulumi-step-hook-<name> executable before and after each step, "+
+			"letting it veto steps before they are applied. Multiple hooks can be specified using "+
+			"--step-hook name1 --step-hook name2")
+	cmd.PersistentFlags().BoolVar(
+		&continueOnError, "continue-on-error", false,
+		"Continue destroying the resources that don't depend on a failed resource, rather than stopping the destroy at the "+
+			"first failure")
 	cmd.PersistentFlags().BoolVar(&excludeProtected, "exclude-protected", false, "Do not destroy protected resources."+
 		" Destroy all other resources.")
 
@@ -373,6 +411,9 @@ func newDestroyCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressOutputs, "suppress-outputs", false,
 		"Suppress display of stack outputs (in case they contain sensitive values)")
+	cmd.PersistentFlags().BoolVar(
+		&profileSteps, "profile-steps", false,
+		"Print the durations of the slowest steps, and the longest chain of steps that had to run one after another")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
@@ -435,10 +476,82 @@ func separateProtected(resources []*resource.State) (
 	return allResources.Difference(transitiveProtected).ToSlice(), transitiveProtected.ToSlice()
 }
 
+// targetDependentClosure returns the resources that have to be destroyed along with the given targets because they
+// depend on them, directly or indirectly, or are their descendants, in the order they appear in the given resources.
+func targetDependentClosure(resources []*resource.State, targets deploy.UrnTargets) []*resource.State {
+	dg := graph.NewDependencyGraph(resources)
+	closure := make(map[resource.URN]bool)
+	for _, r := range resources {
+		if targets.Contains(r.URN) {
+			closure[r.URN] = true
+			for _, dep := range dg.DependingOn(r, nil, true) {
+				closure[dep.URN] = true
+			}
+		}
+	}
+
+	var dependents []*resource.State
+	for _, r := range resources {
+		if closure[r.URN] && !targets.Contains(r.URN) {
+			dependents = append(dependents, r)
+		}
+	}
+	return dependents
+}
+
+// confirmTargetDependents shows the resources that destroying the given targets also destroys, and returns true if
+// they may be destroyed: either because they were allowed with --target-dependents, or because the user confirmed
+// them when prompted. Without either, destroying them is an error, as they weren't asked for.
+func confirmTargetDependents(ctx context.Context, s backend.Stack, targets deploy.UrnTargets,
+	targetDependents, prompt bool, opts display.Options,
+) (bool, error) {
+	snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
+	if err != nil {
+		return false, err
+	} else if snap == nil {
+		return targetDependents, nil
+	}
+	dependents := targetDependentClosure(snap.Resources, targets)
+	if len(dependents) == 0 {
+		return targetDependents, nil
+	}
+
+	out := opts.Stdout
+	if out == nil {
+		out = os.Stdout
+	}
+	if !opts.JSONDisplay {
+		fmt.Fprintf(out, "Destroying the targets also destroys %d resources that depend on them:\n", len(dependents))
+		for _, r := range dependents {
+			fmt.Fprintf(out, "    %s\n", r.URN)
+		}
+		fmt.Fprintln(out)
+	}
+
+	if targetDependents {
+		return true, nil
+	}
+	if !prompt {
+		return false, fmt.Errorf("destroying the targets also destroys %d resources that depend on them; "+
+			"pass --target-dependents to destroy them too", len(dependents))
+	}
+
+	confirm := false
+	// Only disable survey's colors for this prompt.
+	defer func(disableColor bool) { surveycore.DisableColor = disableColor }(surveycore.DisableColor)
+	surveycore.DisableColor = true
+	if err := survey.AskOne(&survey.Confirm{
+		Message: "Destroy these resources too?",
+	}, &confirm, surveyIcons(opts.Color)); err != nil || !confirm {
+		return false, result.FprintBailf(out, "confirmation declined")
+	}
+	return true, nil
+}
+
 // Returns the number of protected resources that remain. Appends all unprotected resources to `targetUrns`.
 func handleExcludeProtected(ctx context.Context, s backend.Stack) ([]string, int, error) {
 	// Get snapshot
-	snapshot, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+	snapshot, err := s.Snapshot(ctx, stackSecretsProvider(s))
 	if err != nil {
 		return nil, 0, err
 	} else if snapshot == nil {
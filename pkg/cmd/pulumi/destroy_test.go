@@ -0,0 +1,54 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestTargetDependentClosure(t *testing.T) {
+	t.Parallel()
+
+	urn := func(name string) resource.URN {
+		return resource.NewURN("dev", "proj", "", "pkgA:m:typA", name)
+	}
+	vpc := &resource.State{URN: urn("vpc")}
+	subnet := &resource.State{URN: urn("subnet"), Dependencies: []resource.URN{vpc.URN}}
+	db := &resource.State{URN: urn("db"), Dependencies: []resource.URN{subnet.URN}}
+	dbChild := &resource.State{URN: urn("db-child"), Parent: db.URN}
+	bucket := &resource.State{URN: urn("bucket")}
+	resources := []*resource.State{vpc, subnet, db, dbChild, bucket}
+
+	names := func(resources []*resource.State) []string {
+		var names []string
+		for _, r := range resources {
+			names = append(names, r.URN.Name())
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"subnet", "db", "db-child"},
+		names(targetDependentClosure(resources, deploy.NewUrnTargets([]string{string(vpc.URN)}))))
+	assert.Equal(t, []string{"db-child"},
+		names(targetDependentClosure(resources, deploy.NewUrnTargets([]string{string(db.URN)}))))
+	assert.Empty(t, targetDependentClosure(resources, deploy.NewUrnTargets([]string{string(bucket.URN)})))
+	assert.Empty(t, targetDependentClosure(resources,
+		deploy.NewUrnTargets([]string{string(subnet.URN), string(db.URN), string(dbChild.URN)})))
+}
@@ -164,6 +164,94 @@ type importFile struct {
 	Resources []importSpec            `json:"resources"`
 }
 
+// componentSpec describes a component resource that some of the resources to import should be children of.
+type componentSpec struct {
+	Type   tokens.Type `json:"type"`
+	Name   string      `json:"name"`
+	Parent string      `json:"parent,omitempty"`
+	Remote bool        `json:"remote,omitempty"`
+	// Resources holds the names of the resources to import, or of other components, that are the component's
+	// children.
+	Resources []string `json:"resources"`
+
+	// LogicalName is the component's Pulumi name (i.e. the first argument to `new Component`).
+	LogicalName string `json:"logicalName,omitempty"`
+}
+
+// componentMapping maps the resources to import to the components they should be imported as children of.
+type componentMapping struct {
+	Components []componentSpec `json:"components"`
+}
+
+func readComponentMapping(p string) (componentMapping, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return componentMapping{}, err
+	}
+	defer contract.IgnoreClose(f)
+
+	var result componentMapping
+	if err = json.NewDecoder(f).Decode(&result); err != nil {
+		return componentMapping{}, err
+	}
+	return result, nil
+}
+
+// applyComponentMapping adds the components in the given mapping to the import file, and makes each of the resources
+// they list their children. The components are imported along with the resources, so their URNs, and those of their
+// children, match the ones the components will have when the generated definitions are added to them.
+func applyComponentMapping(f importFile, m componentMapping) (importFile, error) {
+	// Copy the resources so that the caller's import file isn't modified.
+	f.Resources = append([]importSpec{}, f.Resources...)
+
+	names := map[string]int{}
+	for i, spec := range f.Resources {
+		names[spec.Name] = i
+	}
+	for _, c := range m.Components {
+		if c.Name == "" {
+			return importFile{}, fmt.Errorf("component of type '%v' has no name", c.Type)
+		}
+		if _, ok := names[c.Name]; ok {
+			return importFile{}, fmt.Errorf("component '%v' has the same name as another resource", c.Name)
+		}
+		if _, ok := f.NameTable[c.Name]; ok {
+			return importFile{}, fmt.Errorf("component '%v' has the same name as an entry in 'nameTable'", c.Name)
+		}
+		names[c.Name] = len(f.Resources)
+		f.Resources = append(f.Resources, importSpec{
+			Type:        c.Type,
+			Name:        c.Name,
+			LogicalName: c.LogicalName,
+			Parent:      c.Parent,
+			Component:   true,
+			Remote:      c.Remote,
+		})
+	}
+
+	parents := map[string]string{}
+	for _, c := range m.Components {
+		for _, child := range c.Resources {
+			i, ok := names[child]
+			if !ok {
+				return importFile{}, fmt.Errorf("component '%v' lists '%v', which is not a resource to import",
+					c.Name, child)
+			}
+			if other, ok := parents[child]; ok {
+				return importFile{}, fmt.Errorf("'%v' is listed by both component '%v' and component '%v'",
+					child, other, c.Name)
+			}
+			if parent := f.Resources[i].Parent; parent != "" && parent != c.Name {
+				return importFile{}, fmt.Errorf("component '%v' lists '%v', which already has the parent '%v'",
+					c.Name, child, parent)
+			}
+			parents[child] = c.Name
+			f.Resources[i].Parent = c.Name
+		}
+	}
+	return f, nil
+}
+
 func readImportFile(p string) (importFile, error) {
 	f, err := os.Open(p)
 	if err != nil {
@@ -443,7 +531,7 @@ func getCurrentDeploymentForStack(
 	if err != nil {
 		return nil, err
 	}
-	snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, stack.DefaultSecretsProvider)
+	snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, stackSecretsProvider(s))
 	if err != nil {
 		switch err {
 		case stack.ErrDeploymentSchemaVersionTooOld:
@@ -491,6 +579,12 @@ func generateImportedDefinitions(ctx *plugin.Context,
 
 	var resources []*resource.State
 	for _, i := range imports {
+		// Local components are defined by the program itself, so there's no definition to generate for them. Their
+		// children are generated with a parent option that refers to them by name.
+		if i.Component && !i.Remote {
+			continue
+		}
+
 		var parentType tokens.Type
 		if i.Parent != "" {
 			parentType = i.Parent.QualifiedType()
@@ -531,6 +625,7 @@ func newImportCmd() *cobra.Command {
 	var parentSpec string
 	var providerSpec string
 	var importFilePath string
+	var componentsFilePath string
 	var outputFilePath string
 	var generateCode bool
 
@@ -553,6 +648,8 @@ func newImportCmd() *cobra.Command {
 	var properties []string
 
 	var from string
+	var fromProvider string
+	var discoverFilters []string
 
 	cmd := &cobra.Command{
 		Use:   "import [type] [name] [id]",
@@ -630,7 +727,35 @@ func newImportCmd() *cobra.Command {
 			"You can use `pulumi preview` with the `--import-file` option to emit an import file\n" +
 			"for all resources that need creating from the preview. This will fill in all the name,\n" +
 			"type, parent and provider information for you and just require you to fill in resource\n" +
-			"IDs and any properties.\n",
+			"IDs and any properties.\n" +
+			"\n" +
+			"To import resources as the children of component resources rather than as top-level\n" +
+			"resources, pass `--components` with a JSON file that matches the following format:\n" +
+			"\n" +
+			"    {\n" +
+			"        \"components\": [\n" +
+			"            {\n" +
+			"                \"type\": \"component-type-token\",\n" +
+			"                \"name\": \"name\",\n" +
+			"                \"parent\": \"optional-parent-name\",\n" +
+			"                \"resources\": [\"child-resource-or-component-names\"],\n" +
+			"            },\n" +
+			"            ...\n" +
+			"        ]\n" +
+			"    }\n" +
+			"\n" +
+			"Each component is imported along with the resources, and each of the resources and\n" +
+			"components it lists is made its child. The definitions generated for the children\n" +
+			"refer to their component by name as their parent, so that they can be added to the\n" +
+			"component's definition with the URNs they were imported with.\n" +
+			"\n" +
+			"Rather than listing the resources to import, you can ask a provider that supports\n" +
+			"resource discovery to enumerate them by passing `--from-provider` with the name of\n" +
+			"its package, configured from the stack's configuration. Each `--filter key=value`\n" +
+			"narrows the resources discovered: `type` filters by resource type and may be repeated,\n" +
+			"while the meaning of other keys, such as a tag to match, is specific to the provider.\n" +
+			"The import file built from the discovered resources is written to the current\n" +
+			"directory, so that it can be edited and passed to `--file` in a later run.\n",
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			ctx := commandContext()
 
@@ -645,7 +770,21 @@ func newImportCmd() *cobra.Command {
 			}
 
 			var importFile importFile
-			if importFilePath != "" {
+			if fromProvider != "" {
+				if importFilePath != "" || from != "" {
+					contract.IgnoreError(cmd.Help())
+					return result.Errorf("a provider to discover resources from may not be specified in conjunction " +
+						"with an import file or a converter")
+				}
+				if len(args) != 0 || parentSpec != "" || providerSpec != "" || len(properties) != 0 {
+					contract.IgnoreError(cmd.Help())
+					return result.Errorf("an inline resource may not be specified in conjunction with a provider to " +
+						"discover resources from")
+				}
+			} else if len(discoverFilters) != 0 {
+				contract.IgnoreError(cmd.Help())
+				return result.Errorf("filters may only be specified in conjunction with --from-provider")
+			} else if importFilePath != "" {
 				if len(args) != 0 || parentSpec != "" || providerSpec != "" || len(properties) != 0 {
 					contract.IgnoreError(cmd.Help())
 					return result.Errorf("an inline resource may not be specified in conjunction with an import file")
@@ -822,6 +961,78 @@ func newImportCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
+			cfg, sm, err := getStackConfiguration(ctx, s, proj, nil)
+			if err != nil {
+				return result.FromError(fmt.Errorf("getting stack configuration: %w", err))
+			}
+
+			decrypter, err := sm.Decrypter()
+			if err != nil {
+				return result.FromError(fmt.Errorf("getting stack decrypter: %w", err))
+			}
+			encrypter, err := sm.Encrypter()
+			if err != nil {
+				return result.FromError(fmt.Errorf("getting stack encrypter: %w", err))
+			}
+
+			stackName := s.Ref().Name().String()
+			configErr := workspace.ValidateStackConfigAndApplyProjectConfig(
+				stackName,
+				proj,
+				cfg.Environment,
+				cfg.Config,
+				encrypter,
+				decrypter)
+			if configErr != nil {
+				return result.FromError(fmt.Errorf("validating stack config: %w", configErr))
+			}
+
+			if fromProvider != "" {
+				req, err := parseDiscoverFilters(discoverFilters)
+				if err != nil {
+					return result.FromError(err)
+				}
+
+				pkg := tokens.Package(fromProvider)
+				target := &deploy.Target{Config: cfg.Config, Decrypter: decrypter}
+				providerConfig, err := target.GetPackageConfig(pkg)
+				if err != nil {
+					return result.FromError(fmt.Errorf("could not fetch configuration for provider '%v': %w", pkg, err))
+				}
+
+				discovered, err := discoverResources(pCtx, pkg, providerConfig, req)
+				if err != nil {
+					return result.FromError(fmt.Errorf("could not discover resources: %w", err))
+				}
+				if len(discovered.Resources) == 0 {
+					return result.Errorf("the %v provider did not discover any resources to import", pkg)
+				}
+
+				f, err := makeImportFileFromDiscoveredResources(discovered.Resources)
+				if err != nil {
+					return result.FromError(err)
+				}
+				importFile = f
+
+				path, err := writeImportFileToTemp(importFile)
+				if err != nil {
+					return result.FromError(err)
+				}
+				pCtx.Diag.Infof(diag.Message("",
+					"Discovered %d resources; the import file was written to %s"), len(discovered.Resources), path)
+			}
+
+			if componentsFilePath != "" {
+				m, err := readComponentMapping(componentsFilePath)
+				if err != nil {
+					return result.FromError(fmt.Errorf("could not read components file: %w", err))
+				}
+				importFile, err = applyComponentMapping(importFile, m)
+				if err != nil {
+					return result.FromError(fmt.Errorf("invalid components file: %w", err))
+				}
+			}
+
 			imports, nameTable, err := parseImportFile(importFile, s.Ref().Name(), proj.Name, protectResources)
 			if err != nil {
 				return result.FromError(err)
@@ -885,32 +1096,6 @@ func newImportCmd() *cobra.Command {
 				return result.FromError(fmt.Errorf("gathering environment metadata: %w", err))
 			}
 
-			cfg, sm, err := getStackConfiguration(ctx, s, proj, nil)
-			if err != nil {
-				return result.FromError(fmt.Errorf("getting stack configuration: %w", err))
-			}
-
-			decrypter, err := sm.Decrypter()
-			if err != nil {
-				return result.FromError(fmt.Errorf("getting stack decrypter: %w", err))
-			}
-			encrypter, err := sm.Encrypter()
-			if err != nil {
-				return result.FromError(fmt.Errorf("getting stack encrypter: %w", err))
-			}
-
-			stackName := s.Ref().Name().String()
-			configErr := workspace.ValidateStackConfigAndApplyProjectConfig(
-				stackName,
-				proj,
-				cfg.Environment,
-				cfg.Config,
-				encrypter,
-				decrypter)
-			if configErr != nil {
-				return result.FromError(fmt.Errorf("validating stack config: %w", configErr))
-			}
-
 			opts.Engine = engine.UpdateOptions{
 				Parallel:      parallel,
 				Debug:         debug,
@@ -925,7 +1110,7 @@ func newImportCmd() *cobra.Command {
 				Opts:               opts,
 				StackConfiguration: cfg,
 				SecretsManager:     sm,
-				SecretsProvider:    stack.DefaultSecretsProvider,
+				SecretsProvider:    stackSecretsProvider(s),
 				Scopes:             backend.CancellationScopes,
 			}, imports)
 
@@ -999,6 +1184,15 @@ func newImportCmd() *cobra.Command {
 		&properties, "properties", nil, "The property names to use for the import in the format name1,name2")
 	cmd.PersistentFlags().StringVarP(
 		&importFilePath, "file", "f", "", "The path to a JSON-encoded file containing a list of resources to import")
+	cmd.PersistentFlags().StringVar(
+		&fromProvider, "from-provider", "",
+		"The name of a provider package to ask to discover the resources to import")
+	cmd.PersistentFlags().StringArrayVar(
+		&discoverFilters, "filter", nil,
+		"A key=value filter on the resources discovered by --from-provider; may be repeated")
+	cmd.PersistentFlags().StringVar(
+		&componentsFilePath, "components", "",
+		"The path to a JSON-encoded file mapping the resources to import to the component resources they are children of")
 	cmd.PersistentFlags().StringVarP(
 		&outputFilePath, "out", "o", "", "The path to the file that will contain the generated resource declarations")
 	cmd.PersistentFlags().BoolVar(
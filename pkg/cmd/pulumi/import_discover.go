@@ -0,0 +1,123 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// parseDiscoverFilters parses the `key=value` filters passed to `pulumi import --from-provider` into a discovery
+// request. The `type` key restricts discovery to the given resource type and may be repeated; all other keys are
+// passed to the provider as-is.
+func parseDiscoverFilters(filters []string) (plugin.DiscoverRequest, error) {
+	var req plugin.DiscoverRequest
+	for _, f := range filters {
+		key, value, ok := strings.Cut(f, "=")
+		if !ok || key == "" {
+			return plugin.DiscoverRequest{}, fmt.Errorf("filter '%v' must be of the form key=value", f)
+		}
+		if key == "type" {
+			req.Types = append(req.Types, tokens.Type(value))
+			continue
+		}
+		if req.Filters == nil {
+			req.Filters = map[string]string{}
+		}
+		if _, has := req.Filters[key]; has {
+			return plugin.DiscoverRequest{}, fmt.Errorf("filter '%v' is specified more than once", key)
+		}
+		req.Filters[key] = value
+	}
+	return req, nil
+}
+
+// discoverResources loads the provider for the given package, configures it with the given configuration, and asks
+// it to enumerate the resources that match the given request.
+func discoverResources(ctx *plugin.Context, pkg tokens.Package, config resource.PropertyMap,
+	req plugin.DiscoverRequest,
+) (plugin.DiscoverResult, error) {
+	provider, err := ctx.Host.Provider(pkg, nil)
+	if err != nil {
+		return plugin.DiscoverResult{}, fmt.Errorf("could not load provider '%v': %w", pkg, err)
+	}
+	defer contract.IgnoreError(ctx.Host.CloseProvider(provider))
+
+	urn := resource.NewURN("", "", "", providers.MakeProviderType(pkg), "default")
+	inputs, failures, err := provider.CheckConfig(urn, nil, config, false)
+	if err != nil {
+		return plugin.DiscoverResult{}, fmt.Errorf("could not validate configuration for provider '%v': %w", pkg, err)
+	}
+	if len(failures) != 0 {
+		msgs := make([]string, len(failures))
+		for i, f := range failures {
+			msgs[i] = f.Reason
+		}
+		return plugin.DiscoverResult{}, fmt.Errorf(
+			"invalid configuration for provider '%v': %v", pkg, strings.Join(msgs, "; "))
+	}
+	if err := provider.Configure(inputs); err != nil {
+		return plugin.DiscoverResult{}, fmt.Errorf("could not configure provider '%v': %w", pkg, err)
+	}
+
+	return provider.Discover(req)
+}
+
+// makeImportFileFromDiscoveredResources builds an import file that imports each of the given discovered resources.
+// Resources are sorted by type and name so that the file is stable across runs. Each resource is imported with the
+// name the provider suggests, or with its ID if the provider doesn't suggest one; names that are already taken are
+// made unique with a numeric suffix.
+func makeImportFileFromDiscoveredResources(resources []plugin.DiscoveredResource) (importFile, error) {
+	sorted := make([]plugin.DiscoveredResource, len(resources))
+	copy(sorted, resources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Type != sorted[j].Type {
+			return sorted[i].Type < sorted[j].Type
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	specs := make([]importSpec, len(sorted))
+	taken := map[string]bool{}
+	for i, r := range sorted {
+		if r.Type == "" {
+			return importFile{}, fmt.Errorf("the provider discovered a resource with ID '%v' but no type", r.ID)
+		}
+		if r.ID == "" {
+			return importFile{}, fmt.Errorf("the provider discovered a resource of type '%v' but no ID", r.Type)
+		}
+
+		base := r.Name
+		if base == "" {
+			base = string(r.ID)
+		}
+		name := base
+		for n := 2; taken[name]; n++ {
+			name = fmt.Sprintf("%s-%d", base, n)
+		}
+		taken[name] = true
+
+		specs[i] = importSpec{Type: r.Type, Name: name, ID: r.ID}
+	}
+
+	return importFile{Resources: specs}, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/importer"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -463,3 +464,142 @@ func TestImportFileMarshal(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expected, buffer.String())
 }
+
+// Test that a component mapping adds its components to the import file and makes them the parents of the resources
+// they list, so that their URNs are nested under the components.
+func TestApplyComponentMapping(t *testing.T) {
+	t.Parallel()
+
+	f := importFile{
+		Resources: []importSpec{
+			{Name: "bucket", ID: "bucket-id", Type: "aws:s3/bucket:Bucket"},
+			{Name: "queue", ID: "queue-id", Type: "aws:sqs/queue:Queue"},
+			{Name: "role", ID: "role-id", Type: "aws:iam/role:Role"},
+		},
+	}
+	m := componentMapping{
+		Components: []componentSpec{
+			{Name: "app", Type: "my:index:App", Resources: []string{"web", "role"}},
+			{Name: "web", Type: "my:index:Web", LogicalName: "web-tier", Resources: []string{"bucket", "queue"}},
+		},
+	}
+
+	f, err := applyComponentMapping(f, m)
+	require.NoError(t, err)
+	assert.Equal(t, []importSpec{
+		{Name: "bucket", ID: "bucket-id", Type: "aws:s3/bucket:Bucket", Parent: "web"},
+		{Name: "queue", ID: "queue-id", Type: "aws:sqs/queue:Queue", Parent: "web"},
+		{Name: "role", ID: "role-id", Type: "aws:iam/role:Role", Parent: "app"},
+		{Name: "app", Type: "my:index:App", Component: true},
+		{Name: "web", LogicalName: "web-tier", Type: "my:index:Web", Component: true, Parent: "app"},
+	}, f.Resources)
+
+	imports, nt, err := parseImportFile(f, tokens.MustParseStackName("stack"), "proj", false)
+	require.NoError(t, err)
+	web := resource.URN("urn:pulumi:stack::proj::my:index:App$my:index:Web::web-tier")
+	assert.Equal(t, web, imports[0].Parent)
+	assert.Equal(t, web, imports[1].Parent)
+	assert.Equal(t, resource.URN("urn:pulumi:stack::proj::my:index:App::app"), imports[2].Parent)
+	assert.Equal(t, "web", nt[web])
+}
+
+func TestApplyComponentMapping_errors(t *testing.T) {
+	t.Parallel()
+
+	f := importFile{
+		NameTable: map[string]resource.URN{"prov": "urn:pulumi:stack::proj::pulumi:providers:aws::prov"},
+		Resources: []importSpec{
+			{Name: "bucket", ID: "bucket-id", Type: "aws:s3/bucket:Bucket"},
+			{Name: "queue", ID: "queue-id", Type: "aws:sqs/queue:Queue", Parent: "other"},
+		},
+	}
+
+	cases := []struct {
+		name       string
+		components []componentSpec
+		expected   string
+	}{
+		{
+			name:       "missing name",
+			components: []componentSpec{{Type: "my:index:Web"}},
+			expected:   "component of type 'my:index:Web' has no name",
+		},
+		{
+			name:       "name clashes with resource",
+			components: []componentSpec{{Name: "bucket", Type: "my:index:Web"}},
+			expected:   "component 'bucket' has the same name as another resource",
+		},
+		{
+			name:       "name clashes with name table",
+			components: []componentSpec{{Name: "prov", Type: "my:index:Web"}},
+			expected:   "component 'prov' has the same name as an entry in 'nameTable'",
+		},
+		{
+			name:       "unknown child",
+			components: []componentSpec{{Name: "web", Type: "my:index:Web", Resources: []string{"topic"}}},
+			expected:   "component 'web' lists 'topic', which is not a resource to import",
+		},
+		{
+			name: "child listed twice",
+			components: []componentSpec{
+				{Name: "web", Type: "my:index:Web", Resources: []string{"bucket"}},
+				{Name: "api", Type: "my:index:Api", Resources: []string{"bucket"}},
+			},
+			expected: "'bucket' is listed by both component 'web' and component 'api'",
+		},
+		{
+			name:       "child already has a parent",
+			components: []componentSpec{{Name: "web", Type: "my:index:Web", Resources: []string{"queue"}}},
+			expected:   "component 'web' lists 'queue', which already has the parent 'other'",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := applyComponentMapping(f, componentMapping{Components: tt.components})
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestParseDiscoverFilters(t *testing.T) {
+	t.Parallel()
+
+	req, err := parseDiscoverFilters([]string{
+		"type=aws:s3/bucket:Bucket", "tag:env=dev", "type=aws:sqs/queue:Queue", "query=a=b",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, plugin.DiscoverRequest{
+		Types:   []tokens.Type{"aws:s3/bucket:Bucket", "aws:sqs/queue:Queue"},
+		Filters: map[string]string{"tag:env": "dev", "query": "a=b"},
+	}, req)
+
+	_, err = parseDiscoverFilters([]string{"tag:env"})
+	assert.EqualError(t, err, "filter 'tag:env' must be of the form key=value")
+
+	_, err = parseDiscoverFilters([]string{"tag:env=dev", "tag:env=prod"})
+	assert.EqualError(t, err, "filter 'tag:env' is specified more than once")
+}
+
+func TestMakeImportFileFromDiscoveredResources(t *testing.T) {
+	t.Parallel()
+
+	f, err := makeImportFileFromDiscoveredResources([]plugin.DiscoveredResource{
+		{Type: "aws:sqs/queue:Queue", Name: "jobs", ID: "https://sqs/jobs"},
+		{Type: "aws:s3/bucket:Bucket", Name: "logs", ID: "logs-1"},
+		{Type: "aws:s3/bucket:Bucket", ID: "assets-1"},
+		{Type: "aws:s3/bucket:Bucket", Name: "logs", ID: "logs-2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, importFile{Resources: []importSpec{
+		{Type: "aws:s3/bucket:Bucket", Name: "assets-1", ID: "assets-1"},
+		{Type: "aws:s3/bucket:Bucket", Name: "logs", ID: "logs-1"},
+		{Type: "aws:s3/bucket:Bucket", Name: "logs-2", ID: "logs-2"},
+		{Type: "aws:sqs/queue:Queue", Name: "jobs", ID: "https://sqs/jobs"},
+	}}, f)
+
+	_, err = makeImportFileFromDiscoveredResources([]plugin.DiscoveredResource{{Type: "aws:s3/bucket:Bucket"}})
+	assert.EqualError(t, err, "the provider discovered a resource of type 'aws:s3/bucket:Bucket' but no ID")
+}
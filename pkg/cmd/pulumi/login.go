@@ -84,7 +84,17 @@ func newLoginCmd() *cobra.Command {
 			"\n" +
 			"Azure Blob:\n" +
 			"\n" +
-			"    $ pulumi login azblob://my-pulumi-state-bucket\n",
+			"    $ pulumi login azblob://my-pulumi-state-bucket\n" +
+			"\n" +
+			"Pass the global --read-only flag to log in in read-only mode, for auditing or investigating a stack\n" +
+			"without any risk of changing it. Until you log in again without --read-only, operations that would\n" +
+			"write to the state of a stack, such as `pulumi up` or `pulumi stack import`, fail; operations that\n" +
+			"only read it, such as `pulumi preview`, `pulumi stack export` and `pulumi stack output`, still work.\n" +
+			"\n" +
+			"Access tokens are kept in `~/.pulumi/credentials.json` by default. Set `PULUMI_CREDENTIALS_STORE` to\n" +
+			"`keychain` to keep them in the macOS keychain, to `libsecret` to keep them in the Secret Service using\n" +
+			"`secret-tool`, or to `<name>` to keep them with the credential helper `pulumi-credential-<name>`.\n" +
+			"Existing tokens are moved into the selected store, which is remembered for later commands.\n",
 		Args: cmdutil.MaximumNArgs(1),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
@@ -162,6 +172,16 @@ func newLoginCmd() *cobra.Command {
 				return fmt.Errorf("problem logging in: %w", err)
 			}
 
+			// Remember whether this backend was logged in to in read-only mode, so that later commands are too.
+			account, err := workspace.GetAccount(be.URL())
+			if err != nil {
+				return fmt.Errorf("getting stored credentials: %w", err)
+			}
+			account.ReadOnly = backend.ReadOnly
+			if err := workspace.StoreAccount(be.URL(), account, true); err != nil {
+				return fmt.Errorf("storing credentials: %w", err)
+			}
+
 			if currentUser, _, _, err := be.CurrentUser(); err == nil {
 				// TODO should we print the token information here? (via team MyTeam token MyToken)
 				fmt.Printf("Logged in to %s as %s (%s)\n", be.Name(), currentUser, be.URL())
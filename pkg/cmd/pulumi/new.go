@@ -473,7 +473,11 @@ func newNewCmd() *cobra.Command {
 			"* `pulumi new --secrets-provider=\"awskms://1234abcd-12ab-34cd-56ef-1234567890ab?region=us-east-1\"`\n" +
 			"* `pulumi new --secrets-provider=\"azurekeyvault://mykeyvaultname.vault.azure.net/keys/mykeyname\"`\n" +
 			"* `pulumi new --secrets-provider=\"gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k\"`\n" +
-			"* `pulumi new --secrets-provider=\"hashivault://mykey\"`" +
+			"* `pulumi new --secrets-provider=\"hashivault://mykey\"`\n" +
+			"* `pulumi new --secrets-provider=\"hashivault://mykey?address=https://vault:8200&auth=approle\"`\n" +
+			"\n" +
+			"To encrypt secrets for one or more age recipients, use:\n" +
+			"* `pulumi new --secrets-provider=\"age://<recipient>[,<recipient>...]\"`" +
 			"\n\n" +
 			"To create a project from a specific source control location, pass the url as follows e.g.\n" +
 			"* `pulumi new https://gitlab.com/<user>/<repo>`\n" +
@@ -574,7 +578,7 @@ func newNewCmd() *cobra.Command {
 		"Skip prompts and proceed with default values")
 	cmd.PersistentFlags().StringVar(
 		&args.secretsProvider, "secrets-provider", "default", "The type of the provider that should be used to encrypt and "+
-			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault)")
+			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault, age)")
 	cmd.PersistentFlags().BoolVarP(
 		&args.listTemplates, "list-templates", "l", false,
 		"List locally installed templates and exit")
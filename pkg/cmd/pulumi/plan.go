@@ -0,0 +1,93 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+func newPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Inspect update plans",
+		Long: "Inspect update plans.\n" +
+			"\n" +
+			"Subcommands of this command render the plans saved by `pulumi preview --save-plan`\n" +
+			"in a human-readable form, so that they can be reviewed before they are applied\n" +
+			"with `pulumi up --plan`. They only read plan files and never need a stack.",
+		Args:   cmdutil.NoArgs,
+		Hidden: !hasExperimentalCommands(),
+	}
+
+	cmd.AddCommand(newPlanDiffCmd())
+	cmd.AddCommand(newPlanExplainCmd())
+	return cmd
+}
+
+// loadPlanFile reads the plan saved at the given path. Plans are inspected in their serialized form, so secret values
+// remain encrypted and no secrets provider is needed.
+func loadPlanFile(path string) (*apitype.DeploymentPlanV1, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(f)
+
+	var plan apitype.DeploymentPlanV1
+	if err := json.NewDecoder(f).Decode(&plan); err != nil {
+		return nil, fmt.Errorf("could not read plan %q: %w", path, err)
+	}
+	return &plan, nil
+}
+
+// sortedPlanURNs returns the URNs of the given resource plans in sorted order.
+func sortedPlanURNs(plans ...map[resource.URN]apitype.ResourcePlanV1) []resource.URN {
+	seen := map[resource.URN]bool{}
+	var urns []resource.URN
+	for _, plan := range plans {
+		for urn := range plan {
+			if !seen[urn] {
+				seen[urn] = true
+				urns = append(urns, urn)
+			}
+		}
+	}
+	sort.Slice(urns, func(i, j int) bool { return urns[i] < urns[j] })
+	return urns
+}
+
+// planInputKeys returns the sorted names of the inputs that the given plan diff adds, updates, or deletes.
+func planInputKeys(diff apitype.PlanDiffV1) []string {
+	var keys []string
+	for k := range diff.Adds {
+		keys = append(keys, k)
+	}
+	for k := range diff.Updates {
+		keys = append(keys, k)
+	}
+	keys = append(keys, diff.Deletes...)
+	sort.Strings(keys)
+	return keys
+}
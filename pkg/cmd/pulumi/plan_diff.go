@@ -0,0 +1,293 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// The kinds of difference between two plans' config values or resource plans.
+const (
+	planChangeAdded   = "added"
+	planChangeRemoved = "removed"
+	planChangeChanged = "changed"
+)
+
+// planConfigChange is a config key whose value differs between two plans.
+type planConfigChange struct {
+	Key  string `json:"key"`
+	Kind string `json:"kind"`
+}
+
+// planResourceChange is a resource whose plan differs between two plans.
+type planResourceChange struct {
+	URN  resource.URN `json:"urn"`
+	Kind string       `json:"kind"`
+	// The steps planned for the resource by the first and second plans.
+	OldSteps []apitype.OpType `json:"oldSteps,omitempty"`
+	NewSteps []apitype.OpType `json:"newSteps,omitempty"`
+	// The inputs whose planned changes differ.
+	Inputs []string `json:"inputs,omitempty"`
+	// The other fields of the goal state, such as protect or parent, that differ.
+	Options []string `json:"options,omitempty"`
+}
+
+// planDiff describes the differences between two plans.
+type planDiff struct {
+	Config    []planConfigChange   `json:"config,omitempty"`
+	Resources []planResourceChange `json:"resources,omitempty"`
+}
+
+func newPlanDiffCmd() *cobra.Command {
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "diff <plan-a> <plan-b>",
+		Args:  cmdutil.ExactArgs(2),
+		Short: "Show the differences between two update plans",
+		Long: "Show the differences between two update plans.\n" +
+			"\n" +
+			"This command compares two plans saved by `pulumi preview --save-plan` and lists the config\n" +
+			"values and resources that they plan differently. For each resource, it shows the steps each\n" +
+			"plan would perform and the inputs and options whose planned values differ. Secret values are\n" +
+			"compared in their encrypted form, so a secret re-encrypted with the same value may be reported\n" +
+			"as changed.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			a, err := loadPlanFile(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := loadPlanFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff := diffPlans(a, b)
+			if jsonOut {
+				return printJSON(diff)
+			}
+			printPlanDiff(os.Stdout, diff)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the differences as JSON")
+
+	return cmd
+}
+
+// diffPlans returns the differences between plans a and b.
+func diffPlans(a, b *apitype.DeploymentPlanV1) planDiff {
+	var diff planDiff
+
+	keys := map[string]bool{}
+	for k := range a.Config {
+		keys[k.String()] = true
+	}
+	for k := range b.Config {
+		keys[k.String()] = true
+	}
+	configValues := func(plan *apitype.DeploymentPlanV1) map[string]interface{} {
+		values := map[string]interface{}{}
+		for k, v := range plan.Config {
+			values[k.String()] = v
+		}
+		return values
+	}
+	aConfig, bConfig := configValues(a), configValues(b)
+	for k := range keys {
+		if kind := diffPlanValues(aConfig, bConfig, k); kind != "" {
+			diff.Config = append(diff.Config, planConfigChange{Key: k, Kind: kind})
+		}
+	}
+	sort.Slice(diff.Config, func(i, j int) bool { return diff.Config[i].Key < diff.Config[j].Key })
+
+	for _, urn := range sortedPlanURNs(a.ResourcePlans, b.ResourcePlans) {
+		aPlan, inA := a.ResourcePlans[urn]
+		bPlan, inB := b.ResourcePlans[urn]
+		switch {
+		case !inB:
+			diff.Resources = append(diff.Resources, planResourceChange{
+				URN: urn, Kind: planChangeRemoved, OldSteps: aPlan.Steps,
+			})
+		case !inA:
+			diff.Resources = append(diff.Resources, planResourceChange{
+				URN: urn, Kind: planChangeAdded, NewSteps: bPlan.Steps,
+			})
+		default:
+			change := diffResourcePlans(aPlan, bPlan)
+			if !reflect.DeepEqual(aPlan.Steps, bPlan.Steps) || len(change.Inputs) > 0 || len(change.Options) > 0 {
+				change.URN, change.Kind = urn, planChangeChanged
+				diff.Resources = append(diff.Resources, change)
+			}
+		}
+	}
+
+	return diff
+}
+
+// diffResourcePlans returns the inputs and goal options that two plans for the same resource plan differently.
+func diffResourcePlans(a, b apitype.ResourcePlanV1) planResourceChange {
+	change := planResourceChange{OldSteps: a.Steps, NewSteps: b.Steps}
+	if a.Goal == nil || b.Goal == nil {
+		return change
+	}
+
+	// An input is planned differently if one plan adds, updates, or deletes it and the other doesn't, or if they set
+	// it to different values.
+	plannedInputs := func(diff apitype.PlanDiffV1) map[string]interface{} {
+		inputs := map[string]interface{}{}
+		for k, v := range diff.Adds {
+			inputs[k] = v
+		}
+		for k, v := range diff.Updates {
+			inputs[k] = v
+		}
+		for _, k := range diff.Deletes {
+			inputs[k] = nil
+		}
+		return inputs
+	}
+	aInputs, bInputs := plannedInputs(a.Goal.InputDiff), plannedInputs(b.Goal.InputDiff)
+	seen := map[string]bool{}
+	for _, k := range append(planInputKeys(a.Goal.InputDiff), planInputKeys(b.Goal.InputDiff)...) {
+		if !seen[k] && diffPlanValues(aInputs, bInputs, k) != "" {
+			change.Inputs = append(change.Inputs, k)
+		}
+		seen[k] = true
+	}
+	sort.Strings(change.Inputs)
+
+	// The remaining fields of the goal are compared by their serialized names, so that new fields are picked up
+	// without changes here.
+	aOptions, bOptions := goalOptions(a.Goal), goalOptions(b.Goal)
+	for k := range aOptions {
+		if diffPlanValues(aOptions, bOptions, k) != "" {
+			change.Options = append(change.Options, k)
+		}
+	}
+	for k := range bOptions {
+		if _, ok := aOptions[k]; !ok {
+			change.Options = append(change.Options, k)
+		}
+	}
+	sort.Strings(change.Options)
+
+	return change
+}
+
+// goalOptions returns the fields of the given goal other than its input and output diffs, keyed by their serialized
+// names.
+func goalOptions(goal *apitype.GoalV1) map[string]interface{} {
+	bytes, err := json.Marshal(goal)
+	contract.AssertNoErrorf(err, "marshaling a goal cannot fail")
+	var options map[string]interface{}
+	err = json.Unmarshal(bytes, &options)
+	contract.AssertNoErrorf(err, "unmarshaling a marshaled goal cannot fail")
+	delete(options, "inputDiff")
+	delete(options, "outputDiff")
+	return options
+}
+
+// diffPlanValues returns the kind of difference between the values of key in a and b, or the empty string if they
+// are the same.
+func diffPlanValues(a, b map[string]interface{}, key string) string {
+	aValue, inA := a[key]
+	bValue, inB := b[key]
+	switch {
+	case inA && !inB:
+		return planChangeRemoved
+	case !inA && inB:
+		return planChangeAdded
+	case !reflect.DeepEqual(aValue, bValue):
+		return planChangeChanged
+	default:
+		return ""
+	}
+}
+
+// printPlanDiff renders the given plan differences for humans.
+func printPlanDiff(w io.Writer, diff planDiff) {
+	if len(diff.Config) == 0 && len(diff.Resources) == 0 {
+		fmt.Fprintln(w, "The plans are identical")
+		return
+	}
+
+	symbol := func(kind string) string {
+		switch kind {
+		case planChangeAdded:
+			return "+"
+		case planChangeRemoved:
+			return "-"
+		default:
+			return "~"
+		}
+	}
+	steps := func(steps []apitype.OpType) string {
+		if len(steps) == 0 {
+			return "(none)"
+		}
+		names := make([]string, len(steps))
+		for i, s := range steps {
+			names[i] = string(s)
+		}
+		return strings.Join(names, ", ")
+	}
+
+	if len(diff.Config) > 0 {
+		fmt.Fprintln(w, "Config:")
+		for _, c := range diff.Config {
+			fmt.Fprintf(w, "    %s %s\n", symbol(c.Kind), c.Key)
+		}
+	}
+	if len(diff.Resources) > 0 {
+		fmt.Fprintln(w, "Resources:")
+		for _, r := range diff.Resources {
+			fmt.Fprintf(w, "    %s %s\n", symbol(r.Kind), r.URN)
+			switch r.Kind {
+			case planChangeAdded:
+				fmt.Fprintf(w, "        steps: %s\n", steps(r.NewSteps))
+			case planChangeRemoved:
+				fmt.Fprintf(w, "        steps: %s\n", steps(r.OldSteps))
+			default:
+				if reflect.DeepEqual(r.OldSteps, r.NewSteps) {
+					fmt.Fprintf(w, "        steps: %s\n", steps(r.NewSteps))
+				} else {
+					fmt.Fprintf(w, "        steps: %s => %s\n", steps(r.OldSteps), steps(r.NewSteps))
+				}
+			}
+			if len(r.Inputs) > 0 {
+				fmt.Fprintf(w, "        inputs: %s\n", strings.Join(r.Inputs, ", "))
+			}
+			if len(r.Options) > 0 {
+				fmt.Fprintf(w, "        options: %s\n", strings.Join(r.Options, ", "))
+			}
+		}
+	}
+}
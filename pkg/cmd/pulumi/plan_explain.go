@@ -0,0 +1,166 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+// planStepExplanation is a step planned for a resource, along with the reason it was planned.
+type planStepExplanation struct {
+	Op     apitype.OpType `json:"op"`
+	Reason string         `json:"reason"`
+}
+
+// planResourceExplanation explains the steps planned for a single resource.
+type planResourceExplanation struct {
+	URN   resource.URN          `json:"urn"`
+	Steps []planStepExplanation `json:"steps"`
+}
+
+func newPlanExplainCmd() *cobra.Command {
+	var jsonOut bool
+	var showSames bool
+
+	cmd := &cobra.Command{
+		Use:   "explain <plan>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Explain why each step of an update plan was planned",
+		Long: "Explain why each step of an update plan was planned.\n" +
+			"\n" +
+			"This command lists the steps that a plan saved by `pulumi preview --save-plan` constrains\n" +
+			"`pulumi up --plan` to, along with the reason each one was planned: for example, the inputs\n" +
+			"whose changes cause a resource to be updated or replaced. Resources that the plan leaves\n" +
+			"unchanged are omitted unless `--show-sames` is passed.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			plan, err := loadPlanFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			explanations := explainPlan(plan, showSames)
+			if jsonOut {
+				return printJSON(explanations)
+			}
+			printPlanExplanation(os.Stdout, explanations)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the explanation as JSON")
+	cmd.PersistentFlags().BoolVar(
+		&showSames, "show-sames", false, "Also explain the resources that the plan leaves unchanged")
+
+	return cmd
+}
+
+// explainPlan explains the steps planned for each resource in the given plan, in URN order. Resources whose only
+// planned step is a same are omitted unless showSames is true.
+func explainPlan(plan *apitype.DeploymentPlanV1, showSames bool) []planResourceExplanation {
+	explanations := []planResourceExplanation{}
+	for _, urn := range sortedPlanURNs(plan.ResourcePlans) {
+		rp := plan.ResourcePlans[urn]
+		if !showSames && (len(rp.Steps) == 0 || len(rp.Steps) == 1 && rp.Steps[0] == apitype.OpSame) {
+			continue
+		}
+
+		explanation := planResourceExplanation{URN: urn, Steps: []planStepExplanation{}}
+		for _, op := range rp.Steps {
+			explanation.Steps = append(explanation.Steps, planStepExplanation{
+				Op:     op,
+				Reason: explainPlanStep(op, rp.Goal),
+			})
+		}
+		explanations = append(explanations, explanation)
+	}
+	return explanations
+}
+
+// explainPlanStep returns the reason a step with the given op was planned for a resource with the given goal, which
+// is nil if the program doesn't register the resource.
+func explainPlanStep(op apitype.OpType, goal *apitype.GoalV1) string {
+	var inputs string
+	if goal != nil {
+		inputs = strings.Join(planInputKeys(goal.InputDiff), ", ")
+	}
+
+	switch op {
+	case apitype.OpSame:
+		return "none of its inputs changed"
+	case apitype.OpCreate:
+		return "the program registers a resource that is not in the stack's state"
+	case apitype.OpUpdate:
+		if inputs == "" {
+			return "the provider reported a change that doesn't involve its inputs"
+		}
+		return "its inputs changed: " + inputs
+	case apitype.OpReplace, apitype.OpCreateReplacement:
+		reason := "the provider can't change its inputs in place"
+		if inputs != "" {
+			reason += ": " + inputs
+		}
+		return reason
+	case apitype.OpDeleteReplaced:
+		if goal != nil && goal.DeleteBeforeReplace != nil && *goal.DeleteBeforeReplace {
+			return "it is replaced, and is deleted before its replacement is created"
+		}
+		return "it is replaced, and is deleted after its replacement is created"
+	case apitype.OpDelete:
+		if goal == nil {
+			return "the program no longer registers it"
+		}
+		return "it is deleted before it is recreated"
+	case apitype.OpRead, apitype.OpReadReplacement:
+		return "the program reads it as an external resource"
+	case apitype.OpImport, apitype.OpImportReplacement:
+		if goal != nil && goal.ID != "" {
+			return fmt.Sprintf("the program imports it with ID %q", goal.ID)
+		}
+		return "the program imports it"
+	case apitype.OpRefresh:
+		return "its state is refreshed from the provider"
+	case apitype.OpReadDiscard, apitype.OpDiscardReplaced:
+		return "the program no longer reads it, so it is removed from the stack's state"
+	case apitype.OpRemovePendingReplace:
+		return "it is pending replacement from an earlier update"
+	default:
+		return "it is planned by the engine"
+	}
+}
+
+// printPlanExplanation renders the given plan explanation for humans.
+func printPlanExplanation(w io.Writer, explanations []planResourceExplanation) {
+	if len(explanations) == 0 {
+		fmt.Fprintln(w, "The plan makes no changes")
+		return
+	}
+
+	for _, e := range explanations {
+		fmt.Fprintln(w, e.URN)
+		for _, s := range e.Steps {
+			fmt.Fprintf(w, "    %s: %s\n", s.Op, s.Reason)
+		}
+	}
+}
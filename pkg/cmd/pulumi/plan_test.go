@@ -0,0 +1,158 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+const (
+	planTestBucket = resource.URN("urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket")
+	planTestQueue  = resource.URN("urn:pulumi:dev::proj::aws:sqs/queue:Queue::queue")
+	planTestTopic  = resource.URN("urn:pulumi:dev::proj::aws:sns/topic:Topic::topic")
+)
+
+func TestDiffPlans(t *testing.T) {
+	t.Parallel()
+
+	a := &apitype.DeploymentPlanV1{
+		Config: config.Map{
+			config.MustMakeKey("aws", "region"): config.NewValue("us-east-1"),
+			config.MustMakeKey("proj", "size"):  config.NewValue("small"),
+		},
+		ResourcePlans: map[resource.URN]apitype.ResourcePlanV1{
+			planTestBucket: {
+				Goal: &apitype.GoalV1{
+					Type: "aws:s3/bucket:Bucket", Name: "bucket", Custom: true,
+					InputDiff: apitype.PlanDiffV1{Updates: map[string]interface{}{"acl": "private"}},
+				},
+				Steps: []apitype.OpType{apitype.OpUpdate},
+			},
+			planTestQueue: {
+				Goal:  &apitype.GoalV1{Type: "aws:sqs/queue:Queue", Name: "queue", Custom: true},
+				Steps: []apitype.OpType{apitype.OpSame},
+			},
+			planTestTopic: {Steps: []apitype.OpType{apitype.OpDelete}},
+		},
+	}
+	b := &apitype.DeploymentPlanV1{
+		Config: config.Map{
+			config.MustMakeKey("aws", "region"): config.NewValue("us-west-2"),
+		},
+		ResourcePlans: map[resource.URN]apitype.ResourcePlanV1{
+			planTestBucket: {
+				Goal: &apitype.GoalV1{
+					Type: "aws:s3/bucket:Bucket", Name: "bucket", Custom: true, Protect: true,
+					InputDiff: apitype.PlanDiffV1{
+						Updates: map[string]interface{}{"acl": "private", "bucket": "renamed"},
+					},
+				},
+				Steps: []apitype.OpType{apitype.OpReplace},
+			},
+			planTestQueue: {
+				Goal:  &apitype.GoalV1{Type: "aws:sqs/queue:Queue", Name: "queue", Custom: true},
+				Steps: []apitype.OpType{apitype.OpSame},
+			},
+		},
+	}
+
+	diff := diffPlans(a, b)
+	assert.Equal(t, []planConfigChange{
+		{Key: "aws:region", Kind: planChangeChanged},
+		{Key: "proj:size", Kind: planChangeRemoved},
+	}, diff.Config)
+	assert.Equal(t, []planResourceChange{
+		{
+			URN:      planTestBucket,
+			Kind:     planChangeChanged,
+			OldSteps: []apitype.OpType{apitype.OpUpdate},
+			NewSteps: []apitype.OpType{apitype.OpReplace},
+			Inputs:   []string{"bucket"},
+			Options:  []string{"protect"},
+		},
+		{URN: planTestTopic, Kind: planChangeRemoved, OldSteps: []apitype.OpType{apitype.OpDelete}},
+	}, diff.Resources)
+
+	var buf bytes.Buffer
+	printPlanDiff(&buf, diff)
+	assert.Equal(t, `Config:
+    ~ aws:region
+    - proj:size
+Resources:
+    ~ urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket
+        steps: update => replace
+        inputs: bucket
+        options: protect
+    - urn:pulumi:dev::proj::aws:sns/topic:Topic::topic
+        steps: delete
+`, buf.String())
+
+	buf.Reset()
+	printPlanDiff(&buf, diffPlans(b, b))
+	assert.Equal(t, "The plans are identical\n", buf.String())
+}
+
+func TestExplainPlan(t *testing.T) {
+	t.Parallel()
+
+	plan := &apitype.DeploymentPlanV1{
+		ResourcePlans: map[resource.URN]apitype.ResourcePlanV1{
+			planTestBucket: {
+				Goal: &apitype.GoalV1{
+					Type: "aws:s3/bucket:Bucket", Name: "bucket", Custom: true,
+					InputDiff: apitype.PlanDiffV1{
+						Adds:    map[string]interface{}{"tags": map[string]interface{}{"env": "dev"}},
+						Updates: map[string]interface{}{"acl": "private"},
+					},
+				},
+				Steps: []apitype.OpType{apitype.OpUpdate},
+			},
+			planTestQueue: {
+				Goal:  &apitype.GoalV1{Type: "aws:sqs/queue:Queue", Name: "queue", Custom: true},
+				Steps: []apitype.OpType{apitype.OpSame},
+			},
+			planTestTopic: {Steps: []apitype.OpType{apitype.OpDelete}},
+		},
+	}
+
+	explanations := explainPlan(plan, false)
+	assert.Equal(t, []planResourceExplanation{
+		{
+			URN:   planTestBucket,
+			Steps: []planStepExplanation{{Op: apitype.OpUpdate, Reason: "its inputs changed: acl, tags"}},
+		},
+		{
+			URN:   planTestTopic,
+			Steps: []planStepExplanation{{Op: apitype.OpDelete, Reason: "the program no longer registers it"}},
+		},
+	}, explanations)
+
+	assert.Len(t, explainPlan(plan, true), 3)
+
+	var buf bytes.Buffer
+	printPlanExplanation(&buf, explanations)
+	assert.Equal(t, `urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket
+    update: its inputs changed: acl, tags
+urn:pulumi:dev::proj::aws:sns/topic:Topic::topic
+    delete: the program no longer registers it
+`, buf.String())
+}
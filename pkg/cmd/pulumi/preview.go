@@ -29,7 +29,6 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
-	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/promise"
@@ -257,11 +256,16 @@ func newPreviewCmd() *cobra.Command {
 	var showSames bool
 	var showReads bool
 	var suppressOutputs bool
+	var profileSteps bool
 	var suppressPermalink string
 	var targets []string
+	var excludes []string
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var stepHooks []string
+	var recordProviderResponses string
+	var replayProviderResponses string
 
 	use, cmdArgs := "preview", cmdutil.NoArgs
 	if remoteSupported() {
@@ -304,6 +308,7 @@ func newPreviewCmd() *cobra.Command {
 				Type:                   displayType,
 				JSONDisplay:            jsonDisplay,
 				EventLogPath:           eventLogPath,
+				ProfileSteps:           profileSteps,
 				Debug:                  debug,
 			}
 
@@ -322,7 +327,7 @@ func newPreviewCmd() *cobra.Command {
 
 				err := validateUnsupportedRemoteFlags(expectNop, configArray, configPath, client, jsonDisplay,
 					policyPackPaths, policyPackConfigPaths, refresh, showConfig, showPolicyRemediations,
-					showReplacementSteps, showSames, showReads, suppressOutputs, "default", &targets, replaces,
+					showReplacementSteps, showSames, showReads, suppressOutputs, "default", &targets, excludes, replaces,
 					targetReplaces, targetDependents, planFilePath, stackConfigFile)
 				if err != nil {
 					return result.FromError(err)
@@ -408,6 +413,25 @@ func newPreviewCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
+			hooks, err := makeStepHooks(stepHooks)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			var providerResponses *engine.ProviderResponses
+			switch {
+			case recordProviderResponses != "" && replayProviderResponses != "":
+				return result.FromError(errors.New(
+					"--record-provider-responses and --replay-provider-responses cannot be used together"))
+			case recordProviderResponses != "":
+				providerResponses = engine.NewProviderResponses(engine.RecordProviderResponses, nil)
+			case replayProviderResponses != "":
+				providerResponses, err = readProviderResponses(replayProviderResponses, decrypter, encrypter)
+				if err != nil {
+					return result.FromError(fmt.Errorf("reading provider responses: %w", err))
+				}
+			}
+
 			opts := backend.UpdateOptions{
 				Engine: engine.UpdateOptions{
 					LocalPolicyPacks:          engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
@@ -419,8 +443,10 @@ func newPreviewCmd() *cobra.Command {
 					DisableProviderPreview:    disableProviderPreview(),
 					DisableResourceReferences: disableResourceReferences(),
 					DisableOutputValues:       disableOutputValues(),
-					Targets:                   deploy.NewUrnTargets(targetURNs),
+					Targets:                   deploy.NewUrnTargets(targetURNs).Excluding(excludes),
 					TargetDependents:          targetDependents,
+					StepHooks:                 hooks,
+					ProviderResponses:         providerResponses,
 					// If we're trying to save a plan then we _need_ to generate it. We also turn this on in
 					// experimental mode to just get more testing of it.
 					GeneratePlan: hasExperimentalCommands() || planFilePath != "",
@@ -445,7 +471,7 @@ func newPreviewCmd() *cobra.Command {
 				Opts:               opts,
 				StackConfiguration: cfg,
 				SecretsManager:     sm,
-				SecretsProvider:    stack.DefaultSecretsProvider,
+				SecretsProvider:    stackSecretsProvider(s),
 				Scopes:             backend.CancellationScopes,
 			}, events)
 			// If we made an events channel then we need to close it to trigger the exit of the import goroutine above.
@@ -481,6 +507,11 @@ func newPreviewCmd() *cobra.Command {
 						cmdutil.Diag().Infof(diag.RawMessage("" /*urn*/, buf.String()))
 					}
 				}
+				if recordProviderResponses != "" {
+					if err := writeProviderResponses(recordProviderResponses, providerResponses, encrypter); err != nil {
+						return result.FromError(fmt.Errorf("writing provider responses: %w", err))
+					}
+				}
 				if importFilePromise != nil {
 					importFile, err := importFilePromise.Result(ctx)
 					if err != nil {
@@ -545,6 +576,10 @@ func newPreviewCmd() *cobra.Command {
 		&targets, "target", "t", []string{},
 		"Specify a single resource URN to update. Other resources will not be updated."+
 			" Multiple resources can be specified using --target urn1 --target urn2")
+	cmd.PersistentFlags().StringArrayVar(
+		&excludes, "exclude", []string{},
+		"Specify a single resource URN to exclude from the update. Excluded resources will not be updated."+
+			" Multiple resources can be specified using --exclude urn1 --exclude urn2")
 	cmd.PersistentFlags().StringArrayVar(
 		&replaces, "replace", []string{},
 		"Specify resources to replace. Multiple resources can be specified using --replace urn1 --replace urn2")
@@ -555,6 +590,19 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().StringArrayVar(
+		&stepHooks, "step-hook", nil,
+		"Call the step hook implemented by the pulumi-step-hook-<name> executable before and after each step, "+
+			"letting it veto steps before they are applied. Multiple hooks can be specified using "+
+			"--step-hook name1 --step-hook name2")
+	cmd.PersistentFlags().StringVar(
+		&recordProviderResponses, "record-provider-responses", "",
+		"Record the responses of providers to the preview's calls to the given file, "+
+			"so that the preview can be replayed with --replay-provider-responses")
+	cmd.PersistentFlags().StringVar(
+		&replayProviderResponses, "replay-provider-responses", "",
+		"Answer the preview's provider calls with the responses recorded in the given file "+
+			"by --record-provider-responses, instead of loading and calling the providers")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -595,6 +643,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressOutputs, "suppress-outputs", false,
 		"Suppress display of stack outputs (in case they contain sensitive values)")
+	cmd.PersistentFlags().BoolVar(
+		&profileSteps, "profile-steps", false,
+		"Print the durations of the slowest steps, and the longest chain of steps that had to run one after another")
 
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
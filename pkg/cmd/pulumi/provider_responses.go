@@ -0,0 +1,134 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// providerResponsesVersion is the version of the format that provider responses are saved in.
+const providerResponsesVersion = 1
+
+// providerResponsesFile is the format that `pulumi preview --record-provider-responses` saves provider responses in.
+type providerResponsesFile struct {
+	Version   int                                   `json:"version"`
+	Responses map[string]serializedProviderResponse `json:"responses"`
+}
+
+// serializedProviderResponse is the saved form of an engine.ProviderResponse. Secret properties are encrypted with
+// the stack's secrets manager.
+type serializedProviderResponse struct {
+	ID              resource.ID            `json:"id,omitempty"`
+	Inputs          map[string]interface{} `json:"inputs,omitempty"`
+	Properties      map[string]interface{} `json:"properties,omitempty"`
+	Failures        []plugin.CheckFailure  `json:"failures,omitempty"`
+	Diff            *plugin.DiffResult     `json:"diff,omitempty"`
+	Error           string                 `json:"error,omitempty"`
+	DiffUnavailable bool                   `json:"diffUnavailable,omitempty"`
+}
+
+func writeProviderResponses(path string, responses *engine.ProviderResponses, enc config.Encrypter) error {
+	file := providerResponsesFile{
+		Version:   providerResponsesVersion,
+		Responses: map[string]serializedProviderResponse{},
+	}
+	for key, r := range responses.Responses() {
+		serialized := serializedProviderResponse{
+			ID:              r.ID,
+			Failures:        r.Failures,
+			Diff:            r.Diff,
+			Error:           r.Error,
+			DiffUnavailable: r.DiffUnavailable,
+		}
+		if r.Inputs != nil {
+			inputs, err := stack.SerializeProperties(r.Inputs, enc, false /* showSecrets */)
+			if err != nil {
+				return err
+			}
+			serialized.Inputs = inputs
+		}
+		if r.Properties != nil {
+			props, err := stack.SerializeProperties(r.Properties, enc, false /* showSecrets */)
+			if err != nil {
+				return err
+			}
+			serialized.Properties = props
+		}
+		file.Responses[key] = serialized
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(f)
+
+	encoder := json.NewEncoder(f)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(file)
+}
+
+func readProviderResponses(path string, dec config.Decrypter, enc config.Encrypter) (*engine.ProviderResponses, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(f)
+
+	var file providerResponsesFile
+	if err := json.NewDecoder(f).Decode(&file); err != nil {
+		return nil, err
+	}
+	if file.Version != providerResponsesVersion {
+		return nil, fmt.Errorf("unsupported provider responses version %d", file.Version)
+	}
+
+	responses := make(map[string]*engine.ProviderResponse, len(file.Responses))
+	for key, r := range file.Responses {
+		response := &engine.ProviderResponse{
+			ID:              r.ID,
+			Failures:        r.Failures,
+			Diff:            r.Diff,
+			Error:           r.Error,
+			DiffUnavailable: r.DiffUnavailable,
+		}
+		if r.Inputs != nil {
+			inputs, err := stack.DeserializeProperties(r.Inputs, dec, enc)
+			if err != nil {
+				return nil, err
+			}
+			response.Inputs = inputs
+		}
+		if r.Properties != nil {
+			props, err := stack.DeserializeProperties(r.Properties, dec, enc)
+			if err != nil {
+				return nil, err
+			}
+			response.Properties = props
+		}
+		responses[key] = response
+	}
+	return engine.NewProviderResponses(engine.ReplayProviderResponses, responses), nil
+}
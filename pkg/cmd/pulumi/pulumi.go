@@ -45,6 +45,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/v3/backend/httpstate/client"
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
 	"github.com/pulumi/pulumi/pkg/v3/version"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
@@ -252,6 +253,10 @@ func NewPulumiCmd() *cobra.Command {
 				cmdutil.Diag().Warningf(checkVersionMsg)
 			}
 
+			if cmdutil.TracingRootSpan != nil {
+				secrets.DefaultMetrics.Report(cmdutil.TracingRootSpan)
+			}
+
 			logging.Flush()
 			cmdutil.CloseTracing()
 
@@ -269,6 +274,8 @@ func NewPulumiCmd() *cobra.Command {
 		"Enable emojis in the output")
 	cmd.PersistentFlags().BoolVar(&backend.DisableIntegrityChecking, "disable-integrity-checking", false,
 		"Disable integrity checking of checkpoint files")
+	cmd.PersistentFlags().BoolVar(&backend.ReadOnly, "read-only", false,
+		"Put the backend in read-only mode, so that no operation can write to the state of a stack")
 	cmd.PersistentFlags().BoolVar(&logFlow, "logflow", false,
 		"Flow log settings to child processes (like plugins)")
 	cmd.PersistentFlags().BoolVar(&logToStderr, "logtostderr", false,
@@ -365,6 +372,7 @@ func NewPulumiCmd() *cobra.Command {
 				newConvertCmd(),
 				newWatchCmd(),
 				newLogsCmd(),
+				newPlanCmd(),
 			},
 		},
 		// We have a set of options that are useful for developers of pulumi
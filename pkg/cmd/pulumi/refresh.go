@@ -29,7 +29,6 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
-	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -58,10 +57,16 @@ func newRefreshCmd() *cobra.Command {
 	var showReplacementSteps bool
 	var showSames bool
 	var skipPreview bool
+	var previewOnly bool
+	var driftReportPath string
 	var suppressOutputs bool
+	var profileSteps bool
+	var stepHooks []string
 	var suppressPermalink string
 	var yes bool
 	var targets *[]string
+	var excludes *[]string
+	var properties []string
 
 	// Flags for handling pending creates
 	var skipPendingCreates bool
@@ -83,18 +88,36 @@ func newRefreshCmd() *cobra.Command {
 			"the program text isn't updated accordingly, subsequent updates may still appear to be out of\n" +
 			"sync with respect to the cloud provider's source of truth.\n" +
 			"\n" +
+			"With `--preview-only`, pass `--drift-report <file>` to also write a JSON report of the resources\n" +
+			"that drifted, and of how each of their properties changed, for dashboards and policy automation.\n" +
+			"\n" +
 			"The program to run is loaded from the project in the current directory. Use the `-C` or\n" +
 			"`--cwd` flag to use a different directory.",
 		Args: cmdArgs,
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			ctx := commandContext()
 
+			if previewOnly {
+				if skipPreview {
+					return result.FromError(errors.New("cannot set both --preview-only and --skip-preview"))
+				}
+				if remoteArgs.remote {
+					return result.FromError(errors.New("--preview-only is not supported for remote operations"))
+				}
+				if clearPendingCreates || len(*importPendingCreates) > 0 {
+					return result.FromError(errors.New(
+						"--preview-only cannot be used with --clear-pending-creates or --import-pending-creates"))
+				}
+			} else if driftReportPath != "" {
+				return result.FromError(errors.New("--drift-report can only be used with --preview-only"))
+			}
+
 			// Remote implies we're skipping previews.
 			if remoteArgs.remote {
 				skipPreview = true
 			}
 
-			yes = yes || skipPreview || skipConfirmations()
+			yes = yes || skipPreview || previewOnly || skipConfirmations()
 			interactive := cmdutil.Interactive()
 			if !interactive && !yes {
 				return result.FromError(
@@ -105,6 +128,7 @@ func newRefreshCmd() *cobra.Command {
 			if err != nil {
 				return result.FromError(err)
 			}
+			opts.PreviewOnly = previewOnly
 
 			displayType := display.DisplayProgress
 			if diffDisplay {
@@ -120,6 +144,8 @@ func newRefreshCmd() *cobra.Command {
 				IsInteractive:        interactive,
 				Type:                 displayType,
 				EventLogPath:         eventLogPath,
+				ProfileSteps:         profileSteps,
+				DriftReportPath:      driftReportPath,
 				Debug:                debug,
 				JSONDisplay:          jsonDisplay,
 			}
@@ -139,7 +165,7 @@ func newRefreshCmd() *cobra.Command {
 
 				err = validateUnsupportedRemoteFlags(expectNop, nil, false, "", jsonDisplay, nil,
 					nil, "", showConfig, false, showReplacementSteps, showSames, false,
-					suppressOutputs, "default", targets, nil, nil,
+					suppressOutputs, "default", targets, *excludes, nil, nil,
 					false, "", stackConfigFile)
 				if err != nil {
 					return result.FromError(err)
@@ -224,13 +250,13 @@ func newRefreshCmd() *cobra.Command {
 				}
 			}
 
-			snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+			snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 			if err != nil {
 				return result.FromError(fmt.Errorf("getting snapshot: %w", err))
 			}
 
 			// We then allow the user to interactively handle remaining pending creates.
-			if interactive && hasPendingCreates(snap) && !skipPendingCreates {
+			if interactive && hasPendingCreates(snap) && !skipPendingCreates && !previewOnly {
 				if err := filterMapPendingCreates(ctx, s, opts.Display,
 					yes, interactiveFixPendingCreate); err != nil {
 					return result.FromError(err)
@@ -252,6 +278,20 @@ func newRefreshCmd() *cobra.Command {
 			targetUrns := []string{}
 			targetUrns = append(targetUrns, *targets...)
 
+			hooks, err := makeStepHooks(stepHooks)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			refreshProperties := make([]resource.PropertyPath, len(properties))
+			for i, p := range properties {
+				path, err := resource.ParsePropertyPath(p)
+				if err != nil {
+					return result.FromError(fmt.Errorf("invalid property path %q: %w", p, err))
+				}
+				refreshProperties[i] = path
+			}
+
 			opts.Engine = engine.UpdateOptions{
 				Parallel:                  parallel,
 				Debug:                     debug,
@@ -259,7 +299,9 @@ func newRefreshCmd() *cobra.Command {
 				DisableProviderPreview:    disableProviderPreview(),
 				DisableResourceReferences: disableResourceReferences(),
 				DisableOutputValues:       disableOutputValues(),
-				Targets:                   deploy.NewUrnTargets(targetUrns),
+				Targets:                   deploy.NewUrnTargets(targetUrns).Excluding(*excludes),
+				RefreshProperties:         refreshProperties,
+				StepHooks:                 hooks,
 				Experimental:              hasExperimentalCommands(),
 			}
 
@@ -270,7 +312,7 @@ func newRefreshCmd() *cobra.Command {
 				Opts:               opts,
 				StackConfiguration: cfg,
 				SecretsManager:     sm,
-				SecretsProvider:    stack.DefaultSecretsProvider,
+				SecretsProvider:    stackSecretsProvider(s),
 				Scopes:             backend.CancellationScopes,
 			})
 
@@ -307,6 +349,14 @@ func newRefreshCmd() *cobra.Command {
 	targets = cmd.PersistentFlags().StringArrayP(
 		"target", "t", []string{},
 		"Specify a single resource URN to refresh. Multiple resource can be specified using: --target urn1 --target urn2")
+	excludes = cmd.PersistentFlags().StringArray(
+		"exclude", []string{},
+		"Specify a single resource URN to exclude from the refresh."+
+			" Multiple resources can be specified using: --exclude urn1 --exclude urn2")
+	cmd.PersistentFlags().StringSliceVar(
+		&properties, "properties", nil,
+		"Only refresh the given properties of each resource, leaving the rest of its state as it was. "+
+			"Multiple properties can be specified using: --properties tags,status")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().BoolVar(
@@ -327,9 +377,23 @@ func newRefreshCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(
 		&skipPreview, "skip-preview", "f", false,
 		"Do not calculate a preview before performing the refresh")
+	cmd.PersistentFlags().BoolVar(
+		&previewOnly, "preview-only", false,
+		"Only show a preview of the refresh, without changing the stack's state")
+	cmd.PersistentFlags().StringVar(
+		&driftReportPath, "drift-report", "",
+		"Write a JSON report of the drift found by --preview-only to the file at this path")
 	cmd.PersistentFlags().BoolVar(
 		&suppressOutputs, "suppress-outputs", false,
 		"Suppress display of stack outputs (in case they contain sensitive values)")
+	cmd.PersistentFlags().BoolVar(
+		&profileSteps, "profile-steps", false,
+		"Print the durations of the slowest steps, and the longest chain of steps that had to run one after another")
+	cmd.PersistentFlags().StringArrayVar(
+		&stepHooks, "step-hook", nil,
+		"Call the step hook implemented by the pulumi-step-hook-<name> executable before and after each step, "+
+			"letting it veto steps before they are applied. Multiple hooks can be specified using "+
+			"--step-hook name1 --step-hook name2")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
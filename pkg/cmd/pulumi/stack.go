@@ -27,7 +27,6 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
-	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
@@ -66,7 +65,7 @@ func newStackCmd() *cobra.Command {
 				return nil
 			}
 
-			snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+			snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 			if err != nil {
 				return err
 			}
@@ -190,13 +189,23 @@ func newStackCmd() *cobra.Command {
 	cmd.AddCommand(newStackTagCmd())
 	cmd.AddCommand(newStackRenameCmd())
 	cmd.AddCommand(newStackChangeSecretsProviderCmd())
+	cmd.AddCommand(newStackRotateSecretsCmd())
 	cmd.AddCommand(newStackHistoryCmd())
 	cmd.AddCommand(newStackUnselectCmd())
+	cmd.AddCommand(newStackCopyCmd())
 
 	return cmd
 }
 
 func fprintStackOutputs(w io.Writer, outputs map[string]interface{}) error {
+	return fprintStackOutputsWithDescriptions(w, outputs, nil)
+}
+
+// fprintStackOutputsWithDescriptions prints a table of stack outputs. If metadata is non-nil, the table has a column
+// that holds the description of each output.
+func fprintStackOutputsWithDescriptions(w io.Writer, outputs map[string]interface{},
+	metadata map[resource.PropertyKey]resource.OutputMetadata,
+) error {
 	_, err := fmt.Fprintf(w, "Current stack outputs (%d):\n", len(outputs))
 	if err != nil {
 		return err
@@ -215,11 +224,19 @@ func fprintStackOutputs(w io.Writer, outputs map[string]interface{}) error {
 
 	rows := []cmdutil.TableRow{}
 	for _, key := range outKeys {
-		rows = append(rows, cmdutil.TableRow{Columns: []string{key, stringifyOutput(outputs[key])}})
+		columns := []string{key, stringifyOutput(outputs[key])}
+		if metadata != nil {
+			columns = append(columns, metadata[resource.PropertyKey(key)].Description)
+		}
+		rows = append(rows, cmdutil.TableRow{Columns: columns})
 	}
 
+	headers := []string{"OUTPUT", "VALUE"}
+	if metadata != nil {
+		headers = append(headers, "DESCRIPTION")
+	}
 	return cmdutil.FprintTable(w, cmdutil.Table{
-		Headers: []string{"OUTPUT", "VALUE"},
+		Headers: headers,
 		Rows:    rows,
 		Prefix:  "    ",
 	})
@@ -17,17 +17,23 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 	"github.com/spf13/cobra"
 )
@@ -35,7 +41,11 @@ import (
 type stackChangeSecretsProviderCmd struct {
 	stdout io.Writer
 
-	stack string
+	stack        string
+	keepPrevious bool
+	namespace    string
+	dryRun       bool
+	verify       bool
 }
 
 func newStackChangeSecretsProviderCmd() *cobra.Command {
@@ -45,7 +55,8 @@ func newStackChangeSecretsProviderCmd() *cobra.Command {
 		Args:  cmdutil.ExactArgs(1),
 		Short: "Change the secrets provider for a stack",
 		Long: "Change the secrets provider for a stack. " +
-			"Valid secret providers types are `default`, `passphrase`, `awskms`, `azurekeyvault`, `gcpkms`, `hashivault`.\n\n" +
+			"Valid secret providers types are `default`, `passphrase`, `awskms`, `azurekeyvault`, `gcpkms`, `hashivault`, " +
+			"`age`, or any `<scheme>://` URL handled by a `pulumi-secrets-<scheme>` helper on the PATH.\n\n" +
 			"To change to using the Pulumi Default Secrets Provider, use the following:\n" +
 			"\n" +
 			"pulumi stack change-secrets-provider default" +
@@ -61,7 +72,22 @@ func newStackChangeSecretsProviderCmd() *cobra.Command {
 			"\"azurekeyvault://mykeyvaultname.vault.azure.net/keys/mykeyname\"`\n" +
 			"* `pulumi stack change-secrets-provider " +
 			"\"gcpkms://projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k>\"`\n" +
-			"* `pulumi stack change-secrets-provider \"hashivault://mykey\"`",
+			"* `pulumi stack change-secrets-provider \"hashivault://mykey\"`\n" +
+			"* `pulumi stack change-secrets-provider \"hashivault://mykey?auth=approle\"`\n" +
+			"\n" +
+			"To change the stack to use age, pass one or more recipients and set PULUMI_AGE_IDENTITY_FILE to decrypt:\n" +
+			"\n" +
+			"* `pulumi stack change-secrets-provider \"age://<recipient>[,<recipient>...]\"`\n" +
+			"\n" +
+			"Pass `--keep-previous` to keep the previous secrets provider as a fallback, so that secrets encrypted\n" +
+			"by team members who haven't picked up the new configuration yet can still be decrypted.\n\n" +
+			"Pass `--namespace` to change the secrets provider of a single config namespace instead, e.g. to\n" +
+			"encrypt `aws:*` values with a KMS key and everything else with the stack's secrets provider:\n" +
+			"\n" +
+			"* `pulumi stack change-secrets-provider --namespace aws \"awskms://alias/ExampleAlias?region=us-east-1\"`\n\n" +
+			"Pass `--verify` to check that every secret of the stack can be decrypted with the old secrets provider\n" +
+			"and round-trips through the new one before the stack is migrated, and `--dry-run` to only run these\n" +
+			"checks without changing the stack.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
 			return scspcmd.Run(ctx, args)
@@ -71,6 +97,18 @@ func newStackChangeSecretsProviderCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVarP(
 		&scspcmd.stack, "stack", "s", "",
 		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().BoolVar(
+		&scspcmd.keepPrevious, "keep-previous", false,
+		"Keep the previous secrets provider as a fallback for decrypting secrets")
+	cmd.PersistentFlags().StringVar(
+		&scspcmd.namespace, "namespace", "",
+		"Change the secrets provider of the config values of this namespace only")
+	cmd.PersistentFlags().BoolVar(
+		&scspcmd.dryRun, "dry-run", false,
+		"Check that the stack's secrets can be re-encrypted with the new secrets provider without changing the stack")
+	cmd.PersistentFlags().BoolVar(
+		&scspcmd.verify, "verify", false,
+		"Verify that the stack's secrets round-trip through the new secrets provider before migrating them")
 
 	return cmd
 }
@@ -119,26 +157,242 @@ func (cmd *stackChangeSecretsProviderCmd) Run(ctx context.Context, args []string
 	}
 
 	secretsProvider := args[0]
+	if cmd.namespace != "" {
+		if cmd.dryRun || cmd.verify {
+			return errors.New("--dry-run and --verify can't be used with --namespace")
+		}
+		return changeConfigSecretsProvider(
+			currentStack, currentProjectStack, decrypter, cmd.namespace, secretsProvider, stdout)
+	}
+
 	// If we're setting the secrets provider to the same provider then do a rotation.
 	rotateProvider := secretsProvider == currentProjectStack.SecretsProvider ||
 		// passphrase doesn't get saved to stack state, so if we're changing to passphrase see if
 		// the current secrets provider is empty
 		((secretsProvider == "passphrase") && (currentProjectStack.SecretsProvider == ""))
-	// Create the new secrets provider and set to the currentStack
-	if err := createSecretsManager(ctx, currentStack, secretsProvider, rotateProvider,
+	if cmd.dryRun || cmd.verify {
+		// Check the new secrets provider against a copy of the stack's configuration, so that nothing is changed
+		// unless every secret round-trips.
+		next := deepcopy.Copy(currentProjectStack).(*workspace.ProjectStack)
+		newSecretsManager, err := newSecretsManagerForProvider(currentStack, next, secretsProvider, rotateProvider)
+		if err != nil {
+			return err
+		}
+		if err := verifySecretsProviderChange(
+			ctx, currentStack, currentProjectStack, decrypter, newSecretsManager, stdout); err != nil {
+			return err
+		}
+		if cmd.dryRun {
+			fmt.Fprintf(stdout, "Dry run: the stack was not changed\n")
+			return nil
+		}
+		if err := saveProjectStack(currentStack, next); err != nil {
+			return err
+		}
+	} else if err := createSecretsManager(ctx, currentStack, secretsProvider, rotateProvider,
 		false /*creatingStack*/); err != nil {
+		// Create the new secrets provider and set to the currentStack
 		return err
 	}
+	if cmd.keepPrevious {
+		if err := keepPreviousSecretsProvider(project, currentStack, currentProjectStack); err != nil {
+			return err
+		}
+	}
 
 	// Fixup the checkpoint
 	fmt.Fprintf(stdout, "Migrating old configuration and state to new secrets provider\n")
-	return migrateOldConfigAndCheckpointToNewSecretsProvider(ctx, project, currentStack, currentProjectStack, decrypter)
+	return migrateOldConfigAndCheckpointToNewSecretsProvider(
+		ctx, project, currentStack, currentProjectStack, decrypter, nil /*rotation*/)
 }
 
+// verifySecretsProviderChange decrypts every secret in the configuration and state of a stack, re-encrypts it with
+// newSecretsManager and checks that it decrypts back to the same value, without changing the stack. It reports each
+// secret that fails, and returns an error if any did.
+func verifySecretsProviderChange(ctx context.Context, s backend.Stack, ps *workspace.ProjectStack,
+	decrypter config.Decrypter, newSecretsManager secrets.Manager, stdout io.Writer,
+) error {
+	enc, err := newSecretsManager.Encrypter()
+	if err != nil {
+		return err
+	}
+	dec, err := newSecretsManager.Decrypter()
+	if err != nil {
+		return err
+	}
+
+	checked, failed := 0, 0
+	fail := func(name string, err error) {
+		fmt.Fprintf(stdout, "  %s: %v\n", name, err)
+		failed++
+	}
+
+	fmt.Fprintf(stdout, "Verifying configuration secrets\n")
+	keys := ps.Config.SecureKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	for _, key := range keys {
+		// The config values of namespaces with secrets providers of their own aren't migrated.
+		if _, ok := ps.ConfigSecretsProvider(key.Namespace()); ok {
+			continue
+		}
+		checked++
+		val := ps.Config[key]
+		plaintext, err := val.Value(decrypter)
+		if err != nil {
+			fail(key.String(), fmt.Errorf("decrypting with the current secrets provider: %w", err))
+			continue
+		}
+		newVal, err := val.Copy(decrypter, enc)
+		if err != nil {
+			fail(key.String(), fmt.Errorf("encrypting with the new secrets provider: %w", err))
+			continue
+		}
+		roundTripped, err := newVal.Value(dec)
+		if err != nil {
+			fail(key.String(), fmt.Errorf("decrypting with the new secrets provider: %w", err))
+		} else if roundTripped != plaintext {
+			fail(key.String(), errors.New("the value decrypted by the new secrets provider differs"))
+		}
+	}
+
+	fmt.Fprintf(stdout, "Verifying state secrets\n")
+	checkpoint, err := s.ExportDeployment(ctx)
+	if err != nil {
+		return err
+	}
+	snap, err := stack.DeserializeUntypedDeployment(ctx, checkpoint, stackSecretsProvider(s))
+	if err != nil {
+		return checkDeploymentVersionError(err, s.Ref().Name().String())
+	}
+	if snap != nil {
+		for _, res := range snap.Resources {
+			for _, secret := range stateSecrets(res) {
+				checked++
+				name := fmt.Sprintf("%s[%s]", res.URN, secret.path)
+				serialized, err := stack.SerializePropertyValue(secret.value.SecretValue().Element, config.NopEncrypter,
+					true /*showSecrets*/)
+				if err != nil {
+					fail(name, err)
+					continue
+				}
+				plaintext, err := json.Marshal(serialized)
+				if err != nil {
+					fail(name, err)
+					continue
+				}
+				ciphertext, err := enc.EncryptValue(ctx, string(plaintext))
+				if err != nil {
+					fail(name, fmt.Errorf("encrypting with the new secrets provider: %w", err))
+					continue
+				}
+				roundTripped, err := dec.DecryptValue(ctx, ciphertext)
+				if err != nil {
+					fail(name, fmt.Errorf("decrypting with the new secrets provider: %w", err))
+				} else if roundTripped != string(plaintext) {
+					fail(name, errors.New("the value decrypted by the new secrets provider differs"))
+				}
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d secrets failed to round-trip through the new secrets provider", failed, checked)
+	}
+	fmt.Fprintf(stdout, "Verified %d secrets\n", checked)
+	return nil
+}
+
+// stateSecret is a secret value in the inputs or outputs of a resource.
+type stateSecret struct {
+	path  resource.PropertyPath
+	value resource.PropertyValue
+}
+
+// stateSecrets returns the secret values in the inputs and outputs of res. Nested secrets are encrypted as part of
+// their parent, so they aren't returned separately.
+func stateSecrets(res *resource.State) []stateSecret {
+	var found []stateSecret
+	var walk func(path resource.PropertyPath, v resource.PropertyValue)
+	walk = func(path resource.PropertyPath, v resource.PropertyValue) {
+		switch {
+		case v.IsSecret():
+			found = append(found, stateSecret{path: path, value: v})
+		case v.IsArray():
+			for i, e := range v.ArrayValue() {
+				walk(append(path[:len(path):len(path)], i), e)
+			}
+		case v.IsObject():
+			obj := v.ObjectValue()
+			for _, k := range obj.StableKeys() {
+				walk(append(path[:len(path):len(path)], string(k)), obj[k])
+			}
+		}
+	}
+	walk(resource.PropertyPath{"inputs"}, resource.NewObjectProperty(res.Inputs))
+	walk(resource.PropertyPath{"outputs"}, resource.NewObjectProperty(res.Outputs))
+	return found
+}
+
+// changeConfigSecretsProvider sets the secrets provider for the config values of namespace, and re-encrypts the
+// existing config values of namespace with it. The stack's state is left alone: it's encrypted with the stack's
+// secrets provider, and records the secrets providers of its namespaces the next time it's updated.
+func changeConfigSecretsProvider(s backend.Stack, ps *workspace.ProjectStack, decrypter config.Decrypter,
+	namespace, secretsProvider string, stdout io.Writer,
+) error {
+	if secretsProvider == "" || secretsProvider == "default" {
+		return fmt.Errorf("the default secrets provider can't be used for the config namespace %q", namespace)
+	}
+
+	var p workspace.ProjectStack
+	if _, err := newSecretsManagerForProvider(s, &p, secretsProvider, false /*rotateSecretsProvider*/); err != nil {
+		return err
+	}
+	provider := workspace.ConfigSecretsProvider{
+		Namespace:       namespace,
+		SecretsProvider: p.SecretsProvider,
+		EncryptedKey:    p.EncryptedKey,
+		EncryptionSalt:  p.EncryptionSalt,
+	}
+	replaced := false
+	for i, existing := range ps.NamespaceProviders {
+		if existing.Namespace == namespace {
+			ps.NamespaceProviders[i], replaced = provider, true
+		}
+	}
+	if !replaced {
+		ps.NamespaceProviders = append(ps.NamespaceProviders, provider)
+	}
+
+	fmt.Fprintf(stdout, "Migrating configuration of namespace %q to new secrets provider\n", namespace)
+	var encrypter config.Encrypter
+	for key, val := range ps.Config {
+		if key.Namespace() != namespace {
+			continue
+		}
+		if encrypter == nil {
+			enc, _, err := getStackConfigEncrypter(s, ps, key)
+			if err != nil {
+				return err
+			}
+			encrypter = enc
+		}
+		newVal, err := val.Copy(decrypter, encrypter)
+		if err != nil {
+			return err
+		}
+		ps.Config[key] = newVal
+	}
+	return saveProjectStack(s, ps)
+}
+
+// migrateOldConfigAndCheckpointToNewSecretsProvider re-encrypts the secrets in the stack's configuration and state
+// with its new secrets provider. If rotation is non-nil, it's filled in with the number of secrets that were
+// re-encrypted and recorded in the stack's state.
 func migrateOldConfigAndCheckpointToNewSecretsProvider(ctx context.Context,
 	project *workspace.Project,
 	currentStack backend.Stack,
 	currentConfig *workspace.ProjectStack, decrypter config.Decrypter,
+	rotation *deploy.SecretsRotation,
 ) error {
 	// Reload the project stack after the new secrets provider is in place
 	reloadedProjectStack, err := loadProjectStack(project, currentStack)
@@ -161,8 +415,16 @@ func migrateOldConfigAndCheckpointToNewSecretsProvider(ctx context.Context,
 		return err
 	}
 
-	// Create a copy of the current config map and re-encrypt using the new secrets provider
-	newProjectConfig, err := currentConfig.Config.Copy(decrypter, newEncrypter)
+	// Create a copy of the current config map and re-encrypt using the new secrets provider. The config values of
+	// namespaces with secrets providers of their own stay encrypted with those.
+	configEncrypters := stackConfigEncrypters(currentStack, reloadedProjectStack)
+	newProjectConfig, err := copyConfigMap(currentConfig.Config, decrypter,
+		func(key config.Key) (config.Encrypter, error) {
+			if _, ok := reloadedProjectStack.ConfigSecretsProvider(key.Namespace()); ok {
+				return configEncrypters(key)
+			}
+			return newEncrypter, nil
+		})
 	if err != nil {
 		return err
 	}
@@ -182,11 +444,17 @@ func migrateOldConfigAndCheckpointToNewSecretsProvider(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	snap, err := stack.DeserializeUntypedDeployment(ctx, checkpoint, stack.DefaultSecretsProvider)
+	snap, err := stack.DeserializeUntypedDeployment(ctx, checkpoint, stackSecretsProvider(currentStack))
 	if err != nil {
 		return checkDeploymentVersionError(err, currentStack.Ref().Name().String())
 	}
 
+	if rotation != nil {
+		rotation.ConfigSecrets = len(currentConfig.Config.SecureKeys())
+		rotation.StateSecrets = countStateSecrets(snap)
+		snap.SecretsRotations = append(snap.SecretsRotations, *rotation)
+	}
+
 	// Reserialize the Snapshopshot with the NewSecrets Manager
 	reserializedDeployment, err := stack.SerializeDeployment(snap, newSecretsManager, false /*showSecrets*/)
 	if err != nil {
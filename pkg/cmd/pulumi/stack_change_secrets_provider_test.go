@@ -48,7 +48,7 @@ func TestChangeSecretsProvider_Invalid(t *testing.T) {
 	err := cmd.Run(context.Background(), []string{"not_a_secret"})
 	require.Error(t, err)
 	assert.ErrorContains(t, err, "unknown secrets provider type 'not_a_secret' "+
-		"(supported values: default,passphrase,awskms,azurekeyvault,gcpkms,hashivault)")
+		"(supported values: default,passphrase,awskms,azurekeyvault,gcpkms,hashivault,age)")
 }
 
 func mockStdin(t *testing.T, input string) {
@@ -278,3 +278,28 @@ runtime: mock
 	require.NoError(t, err)
 	assert.Equal(t, "bar", val)
 }
+
+func TestStateSecrets(t *testing.T) {
+	t.Parallel()
+
+	res := &resource.State{
+		Inputs: resource.PropertyMap{
+			"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		},
+		Outputs: resource.PropertyMap{
+			"name": resource.NewStringProperty("db"),
+			"users": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewObjectProperty(resource.PropertyMap{
+					"token": resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+						"nested": resource.MakeSecret(resource.NewStringProperty("abc")),
+					})),
+				}),
+			}),
+		},
+	}
+
+	found := stateSecrets(res)
+	require.Len(t, found, 2)
+	assert.Equal(t, "inputs.password", found[0].path.String())
+	assert.Equal(t, "outputs.users[0].token", found[1].path.String())
+}
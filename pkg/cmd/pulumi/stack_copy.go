@@ -0,0 +1,200 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/backend/filestate"
+	"github.com/pulumi/pulumi/pkg/v3/backend/httpstate"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+type stackCopyCmd struct {
+	stdout io.Writer
+
+	stack           string
+	dest            string
+	secretsProvider string
+}
+
+func newStackCopyCmd() *cobra.Command {
+	var sccmd stackCopyCmd
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Args:  cmdutil.NoArgs,
+		Short: "Copy a stack to another backend",
+		Long: "Copy a stack to another backend.\n" +
+			"\n" +
+			"The stack's history, state, tags and configuration are copied to a new stack in the backend given by\n" +
+			"`--dest`, with its secrets re-encrypted with the new stack's secrets provider. The source stack is left\n" +
+			"as it is, and the current backend and stack don't change. For example:\n" +
+			"\n" +
+			"* `pulumi stack copy --dest https://api.pulumi.com/acme/my-project/production`\n" +
+			"* `pulumi stack copy --dest s3://my-bucket/organization/my-project/production`\n" +
+			"\n" +
+			"Backends that can't import the source stack's updates as they are record each of them as an import.\n" +
+			"If the new stack has the same name as the source stack, they share the stack configuration file,\n" +
+			"which is re-encrypted for the new stack.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := commandContext()
+			return sccmd.Run(ctx)
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&sccmd.stack, "stack", "s", "",
+		"The name of the stack to copy. Defaults to the current stack")
+	cmd.PersistentFlags().StringVar(
+		&sccmd.dest, "dest", "",
+		"The stack to copy to, as `<backend-url>/<org>/<project>/<stack>`")
+	cmd.PersistentFlags().StringVar(
+		&sccmd.secretsProvider, "secrets-provider", "", possibleSecretsProviderChoices)
+	contract.AssertNoErrorf(cmd.MarkPersistentFlagRequired("dest"), `Could not mark "dest" as required`)
+
+	return cmd
+}
+
+func (cmd *stackCopyCmd) Run(ctx context.Context) error {
+	stdout := cmd.stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+
+	opts := display.Options{
+		Color: cmdutil.GetGlobalColorization(),
+	}
+
+	if cmd.secretsProvider != "" {
+		if err := validateSecretsProvider(cmd.secretsProvider); err != nil {
+			return err
+		}
+	}
+	destURL, destName, err := parseStackCopyDest(cmd.dest)
+	if err != nil {
+		return err
+	}
+
+	project, root, err := readProject()
+	if err != nil {
+		return err
+	}
+	src, err := requireStack(ctx, cmd.stack, stackLoadOnly, opts)
+	if err != nil {
+		return err
+	}
+	srcConfig, err := loadProjectStack(project, src)
+	if err != nil {
+		return err
+	}
+	var decrypter config.Decrypter = config.NewPanicCrypter()
+	if srcConfig.Config.HasSecureValue() {
+		if decrypter, _, err = getStackDecrypter(src, srcConfig); err != nil {
+			return err
+		}
+	}
+
+	destBackend, err := backendForURL(ctx, destURL, project, opts)
+	if err != nil {
+		return err
+	}
+	destRef, err := destBackend.ParseStackReference(destName)
+	if err != nil {
+		return err
+	}
+	dest, err := destBackend.CreateStack(ctx, destRef, root, nil /*opts*/)
+	if err != nil {
+		return fmt.Errorf("could not create stack: %w", err)
+	}
+
+	// The copy gets a secrets provider of its own, which its configuration and state are re-encrypted with.
+	destConfig := deepcopy.Copy(srcConfig).(*workspace.ProjectStack)
+	destConfig.SecretsProvider, destConfig.EncryptedKey, destConfig.EncryptionSalt = "", "", ""
+	sm, err := newSecretsManagerForProvider(dest, destConfig, cmd.secretsProvider, false /*rotateSecretsProvider*/)
+	if err != nil {
+		return err
+	}
+	enc, err := sm.Encrypter()
+	if err != nil {
+		return err
+	}
+	for key, val := range destConfig.Config {
+		// The config values of namespaces with secrets providers of their own are copied as they are.
+		if _, ok := destConfig.ConfigSecretsProvider(key.Namespace()); ok {
+			continue
+		}
+		if destConfig.Config[key], err = val.Copy(decrypter, enc); err != nil {
+			return fmt.Errorf("re-encrypting config value %v: %w", key, err)
+		}
+	}
+	if err := workspace.SaveProjectStack(dest.Ref().Name().Q(), destConfig); err != nil {
+		return fmt.Errorf("saving stack config: %w", err)
+	}
+
+	fmt.Fprintf(stdout, "Copying stack %s to %s\n", src.Ref(), cmd.dest)
+	err = backend.CopyStack(ctx, src, dest, backend.CopyStackOptions{
+		SecretsProvider: stackSecretsProvider(src),
+		SecretsManager:  sm,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "Copied stack %s to %s\n", src.Ref(), cmd.dest)
+	return nil
+}
+
+// parseStackCopyDest splits the destination of a stack copy into the URL of its backend and the name of the stack.
+func parseStackCopyDest(dest string) (url string, stackName string, _ error) {
+	parts := strings.Split(dest, "/")
+	n := len(parts)
+	if n < 4 || parts[n-3] == "" || parts[n-2] == "" || parts[n-1] == "" {
+		return "", "", fmt.Errorf("invalid destination %q: expected <backend-url>/<org>/<project>/<stack>", dest)
+	}
+	url = strings.Join(parts[:n-3], "/")
+	if !strings.Contains(url, "://") {
+		return "", "", fmt.Errorf("invalid destination %q: expected <backend-url>/<org>/<project>/<stack>", dest)
+	}
+	return url, strings.Join(parts[n-3:], "/"), nil
+}
+
+// backendForURL returns the backend at the given URL, logging in to it if needed without making it the current
+// backend.
+func backendForURL(
+	ctx context.Context, url string, project *workspace.Project, opts display.Options,
+) (backend.Backend, error) {
+	if filestate.IsFileStateBackendURL(url) {
+		return filestate.New(ctx, cmdutil.Diag(), url, project)
+	}
+
+	insecure := workspace.GetCloudInsecure(url)
+	lm := httpstate.NewLoginManager()
+	_, err := lm.Login(ctx, url, insecure, "pulumi", "Pulumi stacks", httpstate.WelcomeUser, false /*current*/, opts)
+	if err != nil {
+		return nil, err
+	}
+	return httpstate.New(cmdutil.Diag(), url, project, insecure)
+}
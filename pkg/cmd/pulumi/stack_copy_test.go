@@ -0,0 +1,53 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStackCopyDest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dest  string
+		url   string
+		stack string
+	}{
+		{"https://api.pulumi.com/acme/proj/dev", "https://api.pulumi.com", "acme/proj/dev"},
+		{"s3://bucket/prefix/organization/proj/dev", "s3://bucket/prefix", "organization/proj/dev"},
+		{"file:///tmp/state/organization/proj/dev", "file:///tmp/state", "organization/proj/dev"},
+		{"file://~/organization/proj/dev", "file://~", "organization/proj/dev"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.dest, func(t *testing.T) {
+			t.Parallel()
+
+			url, stack, err := parseStackCopyDest(tt.dest)
+			require.NoError(t, err)
+			assert.Equal(t, tt.url, url)
+			assert.Equal(t, tt.stack, stack)
+		})
+	}
+
+	for _, dest := range []string{"acme/proj/dev", "https://api.pulumi.com/proj/dev", "s3://bucket/org//dev"} {
+		_, _, err := parseStackCopyDest(dest)
+		assert.ErrorContains(t, err, "expected <backend-url>/<org>/<project>/<stack>", dest)
+	}
+}
@@ -15,7 +15,9 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 
@@ -33,6 +35,8 @@ func newStackExportCmd() *cobra.Command {
 	var stackName string
 	var version string
 	var showSecrets bool
+	var secretsProvider string
+	var format string
 
 	cmd := &cobra.Command{
 		Use:   "export",
@@ -43,19 +47,43 @@ func newStackExportCmd() *cobra.Command {
 			"The deployment can then be hand-edited and used to update the stack via\n" +
 			"`pulumi stack import`. This process may be used to correct inconsistencies\n" +
 			"in a stack's state due to failed deployments, manual changes to cloud\n" +
-			"resources, etc.",
+			"resources, etc.\n" +
+			"\n" +
+			"Stacks with very many resources can be exported with `--format ndjson`, which streams the\n" +
+			"deployment one resource per line instead of holding all of it in memory.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
 			opts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
 			}
 
+			if secretsProvider != "" {
+				if showSecrets {
+					return errors.New("--show-secrets and --secrets-provider cannot be used together")
+				}
+				if err := validateSecretsProvider(secretsProvider); err != nil {
+					return err
+				}
+			}
+
+			stream, err := isStreamedDeploymentFormat(format)
+			if err != nil {
+				return err
+			}
+			if stream && (showSecrets || secretsProvider != "") {
+				return errors.New("--show-secrets and --secrets-provider cannot be used with --format ndjson")
+			}
+
 			// Fetch the current stack and export its deployment
 			s, err := requireStack(ctx, stackName, stackLoadOnly, opts)
 			if err != nil {
 				return err
 			}
 
+			if stream {
+				return exportDeploymentStream(ctx, s, version, file)
+			}
+
 			var deployment *apitype.UntypedDeployment
 			// Export the latest version of the checkpoint by default. Otherwise, we require that
 			// the backend/stack implements the ability the export previous checkpoints.
@@ -90,7 +118,7 @@ func newStackExportCmd() *cobra.Command {
 
 			if showSecrets {
 				// log show secrets event
-				snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, stack.DefaultSecretsProvider)
+				snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, stackSecretsProvider(s))
 				if err != nil {
 					return checkDeploymentVersionError(err, stackName)
 				}
@@ -111,6 +139,11 @@ func newStackExportCmd() *cobra.Command {
 				}
 
 				log3rdPartySecretsProviderDecryptionEvent(ctx, s, "", "pulumi stack export")
+			} else if secretsProvider != "" {
+				deployment, err = reencryptDeployment(ctx, s, deployment, secretsProvider)
+				if err != nil {
+					return err
+				}
 			}
 
 			// Write the deployment.
@@ -133,5 +166,97 @@ func newStackExportCmd() *cobra.Command {
 		&version, "version", "", "", "Previous stack version to export. (If unset, will export the latest.)")
 	cmd.Flags().BoolVarP(
 		&showSecrets, "show-secrets", "", false, "Emit secrets in plaintext in exported stack. Defaults to `false`")
+	cmd.Flags().StringVar(
+		&format, "format", "json",
+		"The format to write the deployment in: `json` for a single JSON object, or `ndjson` to stream it with one"+
+			" resource per line, without holding the whole deployment in memory")
+	cmd.Flags().StringVar(
+		&secretsProvider, "secrets-provider", "",
+		"Re-encrypt the secrets in the exported stack for the given secrets provider, e.g. when migrating the stack"+
+			" to another backend. Takes the same values as `pulumi stack change-secrets-provider`")
 	return cmd
 }
+
+// isStreamedDeploymentFormat returns true if the given value of --format asks for a deployment to be streamed as
+// NDJSON (see stack.EncodeDeploymentStream), rather than read or written as a single JSON object.
+func isStreamedDeploymentFormat(format string) (bool, error) {
+	switch format {
+	case "", "json":
+		return false, nil
+	case "ndjson":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown format %q; expected json or ndjson", format)
+	}
+}
+
+// exportDeploymentStream writes the deployment of the given stack to the given file, or standard out, as a
+// deployment stream.
+func exportDeploymentStream(ctx context.Context, s backend.Stack, version, file string) (err error) {
+	writer := os.Stdout
+	if file != "" {
+		writer, err = os.Create(file)
+		if err != nil {
+			return fmt.Errorf("could not open file: %w", err)
+		}
+		defer func() {
+			if closeErr := writer.Close(); err == nil && closeErr != nil {
+				err = fmt.Errorf("could not export deployment: %w", closeErr)
+			}
+		}()
+	}
+
+	if version == "" {
+		if err := backend.ExportStackDeploymentStream(ctx, s, writer); err != nil {
+			return fmt.Errorf("could not export deployment: %w", err)
+		}
+		return nil
+	}
+
+	// Previous deployments can only be exported whole, and then streamed.
+	be := s.Backend()
+	specificExpBE, ok := be.(backend.SpecificDeploymentExporter)
+	if !ok {
+		return fmt.Errorf("the current backend (%s) does not provide the ability to export previous deployments",
+			be.Name())
+	}
+	deployment, err := specificExpBE.ExportDeploymentForVersion(ctx, s, version)
+	if err != nil {
+		return err
+	}
+	if err := stack.EncodeUntypedDeploymentStream(writer, deployment); err != nil {
+		return fmt.Errorf("could not export deployment: %w", err)
+	}
+	return nil
+}
+
+// reencryptDeployment re-encrypts the secrets in the deployment for the given secrets provider. The stack itself is
+// left unchanged.
+func reencryptDeployment(
+	ctx context.Context, s backend.Stack, deployment *apitype.UntypedDeployment, secretsProvider string,
+) (*apitype.UntypedDeployment, error) {
+	snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, stackSecretsProvider(s))
+	if err != nil {
+		return nil, checkDeploymentVersionError(err, s.Ref().Name().String())
+	}
+
+	sm, err := newDetachedSecretsManager(s, secretsProvider)
+	if err != nil {
+		return nil, fmt.Errorf("creating secrets manager: %w", err)
+	}
+
+	serializedDeployment, err := stack.SerializeDeployment(snap, sm, false /* showSecrets */)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(serializedDeployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apitype.UntypedDeployment{
+		Version:    3,
+		Deployment: data,
+	}, nil
+}
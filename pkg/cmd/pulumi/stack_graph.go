@@ -15,15 +15,17 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/graph"
 	"github.com/pulumi/pulumi/pkg/v3/graph/dotconv"
-	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
-	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/spf13/cobra"
@@ -46,6 +48,9 @@ var shortNodeName bool
 
 func newStackGraphCmd() *cobra.Command {
 	var stackName string
+	var format string
+	var filters []string
+	var depth int
 
 	cmd := &cobra.Command{
 		Use:   "graph [filename]",
@@ -55,18 +60,41 @@ func newStackGraphCmd() *cobra.Command {
 			"\n" +
 			"This command can be used to view the dependency graph that a Pulumi program\n" +
 			"emitted when it was run. This graph is output in the DOT format. This command operates\n" +
-			"on your stack's most recent deployment.",
+			"on your stack's most recent deployment.\n" +
+			"\n" +
+			"Pass `--format json` to output a typed graph instead, for visualization and impact-analysis\n" +
+			"tools. Its nodes are the stack's resources, including providers, and each of its edges has\n" +
+			"a kind: `parent` from a child to its parent, `provider` from a resource to its provider,\n" +
+			"`property` from a resource to one its properties depend on, and `dependsOn` from a resource\n" +
+			"to any other resource it depends on.\n" +
+			"\n" +
+			"Pass `--filter` to only graph the resources whose `type`, `name` or `urn` match a pattern,\n" +
+			"in which `*` matches anything, and `--depth` to also graph the resources up to that many\n" +
+			"edges away from them, in either direction. For example,\n" +
+			"\n" +
+			"    $ pulumi stack graph --format json --filter type=aws:s3* --depth 1 graph.json",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
 			opts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
 			}
 
+			if format != "dot" && format != "json" {
+				return fmt.Errorf("unknown graph format %q: expected dot or json", format)
+			}
+			graphFilters, err := parseGraphFilters(filters)
+			if err != nil {
+				return err
+			}
+			if depth < 0 {
+				return fmt.Errorf("invalid depth %d: must not be negative", depth)
+			}
+
 			s, err := requireStack(ctx, stackName, stackLoadOnly, opts)
 			if err != nil {
 				return err
 			}
-			snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+			snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 			if err != nil {
 				return err
 			}
@@ -76,13 +104,20 @@ func newStackGraphCmd() *cobra.Command {
 				return fmt.Errorf("unable to find snapshot for stack %q", stackName)
 			}
 
-			dg := makeDependencyGraph(snap)
+			resources := selectGraphResources(snap.Resources, graphFilters, depth)
 			file, err := os.Create(args[0])
 			if err != nil {
 				return err
 			}
 
-			if err := dotconv.Print(dg, file); err != nil {
+			if format == "json" {
+				enc := json.NewEncoder(file)
+				enc.SetIndent("", "    ")
+				err = enc.Encode(makeJSONGraph(resources))
+			} else {
+				err = dotconv.Print(makeDependencyGraph(resources), file)
+			}
+			if err != nil {
 				_ = file.Close()
 				return err
 			}
@@ -104,6 +139,12 @@ func newStackGraphCmd() *cobra.Command {
 		"Sets the color of parent edges in the graph")
 	cmd.PersistentFlags().BoolVar(&shortNodeName, "short-node-name", false,
 		"Sets the resource name as the node label for each node of the graph")
+	cmd.PersistentFlags().StringVar(&format, "format", "dot",
+		"The format to output the graph in: dot or json")
+	cmd.PersistentFlags().StringArrayVar(&filters, "filter", nil,
+		"Only graph resources whose type, name or urn matches a pattern, given as key=pattern; may be repeated")
+	cmd.PersistentFlags().IntVar(&depth, "depth", 0,
+		"Also graph the resources up to this many edges away from those matched by --filter")
 	return cmd
 }
 
@@ -223,14 +264,14 @@ func (dg *dependencyGraph) Roots() []graph.Edge {
 	return rootEdges
 }
 
-// Makes a dependency graph from a deployment snapshot, allocating a vertex
-// for every resource in the graph.
-func makeDependencyGraph(snapshot *deploy.Snapshot) *dependencyGraph {
+// Makes a dependency graph from the resources of a deployment snapshot, allocating a vertex
+// for every resource in the graph. Edges to resources that aren't in the graph are left out.
+func makeDependencyGraph(resources []*resource.State) *dependencyGraph {
 	dg := &dependencyGraph{
 		vertices: make(map[resource.URN]*dependencyVertex),
 	}
 
-	for _, resource := range snapshot.Resources {
+	for _, resource := range resources {
 		vertex := &dependencyVertex{
 			graph:    dg,
 			resource: resource,
@@ -253,7 +294,10 @@ func makeDependencyGraph(snapshot *deploy.Snapshot) *dependencyGraph {
 			// Incoming edges are directly stored within the checkpoint file; they represent
 			// resources on which this vertex immediately depends upon.
 			for _, dep := range vertex.resource.Dependencies {
-				vertexWeDependOn := vertex.graph.vertices[dep]
+				vertexWeDependOn, has := vertex.graph.vertices[dep]
+				if !has {
+					continue
+				}
 				edge := &dependencyEdge{to: vertex, from: vertexWeDependOn, labels: depBlame[dep]}
 				vertex.incomingEdges = append(vertex.incomingEdges, edge)
 				vertexWeDependOn.outgoingEdges = append(vertexWeDependOn.outgoingEdges, edge)
@@ -264,8 +308,7 @@ func makeDependencyGraph(snapshot *deploy.Snapshot) *dependencyGraph {
 		// is also displayed as part of this graph, although with different colored
 		// edges.
 		if !ignoreParentEdges {
-			if parent := vertex.resource.Parent; parent != resource.URN("") {
-				parentVertex := dg.vertices[parent]
+			if parentVertex, has := dg.vertices[vertex.resource.Parent]; has {
 				vertex.outgoingEdges = append(vertex.outgoingEdges, &parentEdge{
 					to:   parentVertex,
 					from: vertex,
@@ -276,3 +319,217 @@ func makeDependencyGraph(snapshot *deploy.Snapshot) *dependencyGraph {
 
 	return dg
 }
+
+// A graphFilter selects the resources whose value for a key matches a pattern.
+type graphFilter struct {
+	key     string
+	pattern *regexp.Regexp
+}
+
+// matches returns true if the given resource matches the filter.
+func (f graphFilter) matches(res *resource.State) bool {
+	switch f.key {
+	case "type":
+		return f.pattern.MatchString(string(res.Type))
+	case "name":
+		return f.pattern.MatchString(res.URN.Name())
+	default:
+		return f.pattern.MatchString(string(res.URN))
+	}
+}
+
+// parseGraphFilters parses the key=pattern filters passed with --filter. Patterns match a whole value, and `*` in
+// them matches any sequence of characters.
+func parseGraphFilters(args []string) ([]graphFilter, error) {
+	filters := make([]graphFilter, 0, len(args))
+	for _, arg := range args {
+		key, pattern, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid filter %q: expected key=pattern", arg)
+		}
+		if key != "type" && key != "name" && key != "urn" {
+			return nil, fmt.Errorf("invalid filter %q: the key must be type, name or urn", arg)
+		}
+		parts := strings.Split(pattern, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		filters = append(filters, graphFilter{
+			key:     key,
+			pattern: regexp.MustCompile("^" + strings.Join(parts, ".*") + "$"),
+		})
+	}
+	return filters, nil
+}
+
+// graphNeighbors returns the URNs of the resources each resource has an edge to or from, in any graph format.
+func graphNeighbors(resources []*resource.State) map[resource.URN][]resource.URN {
+	neighbors := make(map[resource.URN][]resource.URN)
+	link := func(a, b resource.URN) {
+		if a != "" && b != "" {
+			neighbors[a] = append(neighbors[a], b)
+			neighbors[b] = append(neighbors[b], a)
+		}
+	}
+	for _, res := range resources {
+		link(res.URN, res.Parent)
+		if ref, ok := graphProvider(res); ok {
+			link(res.URN, ref)
+		}
+		for _, dep := range res.Dependencies {
+			link(res.URN, dep)
+		}
+		for _, deps := range res.PropertyDependencies {
+			for _, dep := range deps {
+				link(res.URN, dep)
+			}
+		}
+	}
+	return neighbors
+}
+
+// selectGraphResources returns the resources matched by every one of the given filters, along with those up to depth
+// edges away from them, in snapshot order. Every resource is selected if there are no filters.
+func selectGraphResources(resources []*resource.State, filters []graphFilter, depth int) []*resource.State {
+	if len(filters) == 0 {
+		return resources
+	}
+
+	selected := make(map[resource.URN]bool)
+	var frontier []resource.URN
+	for _, res := range resources {
+		matches := true
+		for _, f := range filters {
+			matches = matches && f.matches(res)
+		}
+		if matches && !selected[res.URN] {
+			selected[res.URN] = true
+			frontier = append(frontier, res.URN)
+		}
+	}
+
+	neighbors := graphNeighbors(resources)
+	for i := 0; i < depth && len(frontier) > 0; i++ {
+		var next []resource.URN
+		for _, urn := range frontier {
+			for _, n := range neighbors[urn] {
+				if !selected[n] {
+					selected[n] = true
+					next = append(next, n)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	result := make([]*resource.State, 0, len(selected))
+	for _, res := range resources {
+		if selected[res.URN] {
+			result = append(result, res)
+		}
+	}
+	return result
+}
+
+// graphProvider returns the URN of the provider of the given resource, if it has one.
+func graphProvider(res *resource.State) (resource.URN, bool) {
+	if res.Provider == "" {
+		return "", false
+	}
+	ref, err := providers.ParseReference(res.Provider)
+	if err != nil {
+		return "", false
+	}
+	return ref.URN(), true
+}
+
+// jsonGraph is the typed dependency graph output by --format json.
+type jsonGraph struct {
+	Nodes []jsonGraphNode `json:"nodes"`
+	Edges []jsonGraphEdge `json:"edges"`
+}
+
+// jsonGraphNode is a resource in the typed dependency graph.
+type jsonGraphNode struct {
+	URN  resource.URN `json:"urn"`
+	Type string       `json:"type"`
+	Name string       `json:"name"`
+	ID   resource.ID  `json:"id,omitempty"`
+	// Kind is one of "custom", "component" or "provider".
+	Kind string `json:"kind"`
+}
+
+// jsonGraphEdge is an edge in the typed dependency graph, from the resource that depends on another to the resource it
+// depends on.
+type jsonGraphEdge struct {
+	From resource.URN `json:"from"`
+	To   resource.URN `json:"to"`
+	// Kind is one of "parent", "provider", "property" or "dependsOn".
+	Kind string `json:"kind"`
+	// Properties are the properties that depend on the other resource, for property edges.
+	Properties []string `json:"properties,omitempty"`
+}
+
+// makeJSONGraph returns the typed dependency graph of the given resources. Edges to resources that aren't among them
+// are left out.
+func makeJSONGraph(resources []*resource.State) jsonGraph {
+	g := jsonGraph{Nodes: []jsonGraphNode{}, Edges: []jsonGraphEdge{}}
+	included := make(map[resource.URN]bool, len(resources))
+	for _, res := range resources {
+		included[res.URN] = true
+	}
+
+	for _, res := range resources {
+		kind := "component"
+		if providers.IsProviderType(res.Type) {
+			kind = "provider"
+		} else if res.Custom {
+			kind = "custom"
+		}
+		g.Nodes = append(g.Nodes, jsonGraphNode{
+			URN: res.URN, Type: string(res.Type), Name: res.URN.Name(), ID: res.ID, Kind: kind,
+		})
+
+		if !ignoreParentEdges && included[res.Parent] {
+			g.Edges = append(g.Edges, jsonGraphEdge{From: res.URN, To: res.Parent, Kind: "parent"})
+		}
+		if ref, ok := graphProvider(res); ok && included[ref] {
+			g.Edges = append(g.Edges, jsonGraphEdge{From: res.URN, To: ref, Kind: "provider"})
+		}
+		if ignoreDependencyEdges {
+			continue
+		}
+
+		blame := make(map[resource.URN][]string)
+		for k, deps := range res.PropertyDependencies {
+			for _, dep := range deps {
+				blame[dep] = append(blame[dep], string(k))
+			}
+		}
+		seen := make(map[resource.URN]bool)
+		deps := append(append([]resource.URN{}, res.Dependencies...), sortedURNs(blame)...)
+		for _, dep := range deps {
+			if seen[dep] || !included[dep] {
+				continue
+			}
+			seen[dep] = true
+			if props, ok := blame[dep]; ok {
+				sort.Strings(props)
+				g.Edges = append(g.Edges, jsonGraphEdge{From: res.URN, To: dep, Kind: "property", Properties: props})
+			} else {
+				g.Edges = append(g.Edges, jsonGraphEdge{From: res.URN, To: dep, Kind: "dependsOn"})
+			}
+		}
+	}
+	return g
+}
+
+// sortedURNs returns the keys of the given map in order.
+func sortedURNs(m map[resource.URN][]string) []resource.URN {
+	urns := make([]resource.URN, 0, len(m))
+	for urn := range m {
+		urns = append(urns, urn)
+	}
+	sort.Slice(urns, func(i, j int) bool { return urns[i] < urns[j] })
+	return urns
+}
@@ -0,0 +1,121 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// graphTestResources returns a provider, a component, and two resources in the component, where the queue depends on
+// the bucket's arn and the bucket explicitly depends on the component.
+func graphTestResources() []*resource.State {
+	urn := func(typ tokens.Type, name string) resource.URN {
+		return resource.NewURN("dev", "proj", "", typ, name)
+	}
+	provider := &resource.State{
+		URN: urn("pulumi:providers:aws", "default"), Type: "pulumi:providers:aws", ID: "prov-id", Custom: true,
+	}
+	component := &resource.State{URN: urn("my:index:Component", "comp"), Type: "my:index:Component"}
+	bucket := &resource.State{
+		URN: urn("aws:s3/bucket:Bucket", "bucket"), Type: "aws:s3/bucket:Bucket", ID: "bucket-id", Custom: true,
+		Parent:       component.URN,
+		Provider:     string(provider.URN) + "::prov-id",
+		Dependencies: []resource.URN{component.URN},
+	}
+	queue := &resource.State{
+		URN: urn("aws:sqs/queue:Queue", "queue"), Type: "aws:sqs/queue:Queue", ID: "queue-id", Custom: true,
+		Parent:               component.URN,
+		Provider:             string(provider.URN) + "::prov-id",
+		Dependencies:         []resource.URN{bucket.URN},
+		PropertyDependencies: map[resource.PropertyKey][]resource.URN{"policy": {bucket.URN}},
+	}
+	return []*resource.State{provider, component, bucket, queue}
+}
+
+func TestMakeJSONGraph(t *testing.T) {
+	t.Parallel()
+
+	resources := graphTestResources()
+	provider, component, bucket, queue := resources[0].URN, resources[1].URN, resources[2].URN, resources[3].URN
+
+	g := makeJSONGraph(resources)
+	assert.Equal(t, []jsonGraphNode{
+		{URN: provider, Type: "pulumi:providers:aws", Name: "default", ID: "prov-id", Kind: "provider"},
+		{URN: component, Type: "my:index:Component", Name: "comp", Kind: "component"},
+		{URN: bucket, Type: "aws:s3/bucket:Bucket", Name: "bucket", ID: "bucket-id", Kind: "custom"},
+		{URN: queue, Type: "aws:sqs/queue:Queue", Name: "queue", ID: "queue-id", Kind: "custom"},
+	}, g.Nodes)
+	assert.Equal(t, []jsonGraphEdge{
+		{From: bucket, To: component, Kind: "parent"},
+		{From: bucket, To: provider, Kind: "provider"},
+		{From: bucket, To: component, Kind: "dependsOn"},
+		{From: queue, To: component, Kind: "parent"},
+		{From: queue, To: provider, Kind: "provider"},
+		{From: queue, To: bucket, Kind: "property", Properties: []string{"policy"}},
+	}, g.Edges)
+
+	// Edges to resources outside the graph are left out.
+	g = makeJSONGraph(resources[3:])
+	assert.Len(t, g.Nodes, 1)
+	assert.Empty(t, g.Edges)
+}
+
+func TestSelectGraphResources(t *testing.T) {
+	t.Parallel()
+
+	resources := graphTestResources()
+	names := func(rs []*resource.State) []string {
+		var result []string
+		for _, r := range rs {
+			result = append(result, r.URN.Name())
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"default", "comp", "bucket", "queue"}, names(selectGraphResources(resources, nil, 0)))
+
+	filters, err := parseGraphFilters([]string{"type=aws:sqs*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue"}, names(selectGraphResources(resources, filters, 0)))
+	assert.Equal(t, []string{"default", "comp", "bucket", "queue"}, names(selectGraphResources(resources, filters, 1)))
+
+	// Every filter has to match.
+	filters, err = parseGraphFilters([]string{"type=aws:*", "name=b*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bucket"}, names(selectGraphResources(resources, filters, 0)))
+
+	_, err = parseGraphFilters([]string{"type"})
+	assert.ErrorContains(t, err, "expected key=pattern")
+	_, err = parseGraphFilters([]string{"id=foo"})
+	assert.ErrorContains(t, err, "the key must be type, name or urn")
+}
+
+func TestMakeDependencyGraph_filtered(t *testing.T) {
+	t.Parallel()
+
+	// Vertices for resources outside the graph aren't dereferenced.
+	dg := makeDependencyGraph(graphTestResources()[3:])
+	require.Len(t, dg.vertices, 1)
+	for _, v := range dg.vertices {
+		assert.Empty(t, v.Ins())
+		assert.Empty(t, v.Outs())
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/hashicorp/go-multierror"
@@ -28,9 +29,12 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 )
 
@@ -38,6 +42,7 @@ func newStackImportCmd() *cobra.Command {
 	var force bool
 	var file string
 	var stackName string
+	var format string
 	cmd := &cobra.Command{
 		Use:   "import",
 		Args:  cmdutil.MaximumNArgs(0),
@@ -47,13 +52,21 @@ func newStackImportCmd() *cobra.Command {
 			"A deployment that was exported from a stack using `pulumi stack export` and\n" +
 			"hand-edited to correct inconsistencies due to failed updates, manual changes\n" +
 			"to cloud resources, etc. can be reimported to the stack using this command.\n" +
-			"The updated deployment will be read from standard in.",
+			"The updated deployment will be read from standard in.\n" +
+			"\n" +
+			"Deployments exported with `--format ndjson` are imported with the same flag, which streams\n" +
+			"the deployment one resource per line instead of holding all of it in memory.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
 			opts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
 			}
 
+			stream, err := isStreamedDeploymentFormat(format)
+			if err != nil {
+				return err
+			}
+
 			// Fetch the current stack and import a deployment.
 			s, err := requireStack(ctx, stackName, stackLoadOnly, opts)
 			if err != nil {
@@ -69,6 +82,14 @@ func newStackImportCmd() *cobra.Command {
 				}
 			}
 
+			if stream {
+				if err := importDeploymentStream(ctx, s, reader, force); err != nil {
+					return err
+				}
+				fmt.Printf("Import complete.\n")
+				return nil
+			}
+
 			// Read the checkpoint from stdin.  We decode this into a json.RawMessage so as not to lose any fields
 			// sent by the server that the client CLI does not recognize (enabling round-tripping).
 			var deployment apitype.UntypedDeployment
@@ -79,7 +100,7 @@ func newStackImportCmd() *cobra.Command {
 			// We do, however, now want to unmarshal the json.RawMessage into a real, typed deployment.  We do this so
 			// we can check that the deployment doesn't contain resources from a stack other than the selected one. This
 			// catches errors wherein someone imports the wrong stack's deployment (which can seriously hork things).
-			snapshot, err := stack.DeserializeUntypedDeployment(ctx, &deployment, stack.DefaultSecretsProvider)
+			snapshot, err := stack.DeserializeUntypedDeployment(ctx, &deployment, stackSecretsProvider(s))
 			if err != nil {
 				return checkDeploymentVersionError(err, s.Ref().Name().String())
 			}
@@ -98,6 +119,10 @@ func newStackImportCmd() *cobra.Command {
 		"Force the import to occur, even if apparent errors are discovered beforehand (not recommended)")
 	cmd.PersistentFlags().StringVarP(
 		&file, "file", "", "", "A filename to read stack input from")
+	cmd.PersistentFlags().StringVar(
+		&format, "format", "json",
+		"The format of the deployment: `json` for a single JSON object, or `ndjson` for a deployment streamed with"+
+			" one resource per line by `pulumi stack export --format ndjson`")
 
 	return cmd
 }
@@ -166,3 +191,117 @@ func saveSnapshot(ctx context.Context, s backend.Stack, snapshot *deploy.Snapsho
 	}
 	return nil
 }
+
+// importDeploymentStream imports the deployment stream read from r into the given stack. The deployment is checked
+// in the same way as saveSnapshot does, one resource at a time, and the import is abandoned if any check fails.
+func importDeploymentStream(ctx context.Context, s backend.Stack, r io.Reader, force bool) error {
+	sr, err := stack.NewDeploymentStreamReader(r)
+	if err != nil {
+		return err
+	}
+
+	checker := newStreamImportChecker(s.Ref().Name(), force)
+	sr.OnResource = checker.resource
+	sr.OnPendingOperation = checker.pendingOperation
+	sr.OnEnd = checker.end
+
+	if err := backend.ImportStackDeploymentStream(ctx, s, sr); err != nil {
+		return fmt.Errorf("could not import deployment: %w", err)
+	}
+	return nil
+}
+
+// streamImportChecker checks the resources of a deployment that's being imported as they're streamed. It remembers
+// only their URNs, rather than the whole deployment.
+type streamImportChecker struct {
+	stackName tokens.StackName
+	force     bool
+
+	urns      map[resource.URN]bool
+	providers map[providers.Reference]bool
+	result    error
+	integrity error
+}
+
+func newStreamImportChecker(stackName tokens.StackName, force bool) *streamImportChecker {
+	return &streamImportChecker{
+		stackName: stackName,
+		force:     force,
+		urns:      make(map[resource.URN]bool),
+		providers: make(map[providers.Reference]bool),
+	}
+}
+
+func (c *streamImportChecker) resource(res apitype.ResourceV3) error {
+	if res.URN.Stack() != c.stackName.Q() {
+		msg := fmt.Sprintf("resource '%s' is from a different stack (%s != %s)",
+			res.URN, res.URN.Stack(), c.stackName)
+		if c.force {
+			cmdutil.Diag().Warningf(diag.Message("" /*urn*/, msg))
+		} else {
+			c.result = multierror.Append(c.result, errors.New(msg))
+		}
+	}
+
+	if c.integrity == nil {
+		c.integrity = c.verify(res)
+	}
+	c.urns[res.URN] = true
+	return nil
+}
+
+// verify checks the same invariants as deploy.Snapshot.VerifyIntegrity for the next resource of the deployment.
+func (c *streamImportChecker) verify(res apitype.ResourceV3) error {
+	urn := res.URN
+	if providers.IsProviderType(res.Type) {
+		ref, err := providers.NewReference(urn, res.ID)
+		if err != nil {
+			return fmt.Errorf("provider %s is not referenceable: %w", urn, err)
+		}
+		c.providers[ref] = true
+	}
+	if provider := res.Provider; provider != "" {
+		ref, err := providers.ParseReference(provider)
+		if err != nil {
+			return fmt.Errorf("failed to parse provider reference for resource %s: %w", urn, err)
+		}
+		if !c.providers[ref] && !res.PendingReplacement {
+			return fmt.Errorf("resource %s refers to unknown provider %s", urn, ref)
+		}
+	}
+	if par := res.Parent; par != "" && !c.urns[par] {
+		return fmt.Errorf("child resource %s refers to missing parent %s", urn, par)
+	}
+	for _, dep := range res.Dependencies {
+		if !c.urns[dep] {
+			return fmt.Errorf("resource %s dependency %s refers to missing resource", urn, dep)
+		}
+	}
+	if c.urns[urn] && !res.Delete {
+		return fmt.Errorf("duplicate resource %s (not marked for deletion)", urn)
+	}
+	return nil
+}
+
+// pendingOperation explicitly clears out any pending operations.
+func (c *streamImportChecker) pendingOperation(op apitype.OperationV2) (bool, error) {
+	msg := fmt.Sprintf("removing pending operation '%s' on '%s' from snapshot", op.Type, op.Resource.URN)
+	cmdutil.Diag().Warningf(diag.Message(op.Resource.URN, msg))
+	return false, nil
+}
+
+func (c *streamImportChecker) end() error {
+	if c.integrity != nil {
+		msg := fmt.Sprintf("state file contains errors: %v", c.integrity)
+		if c.force {
+			cmdutil.Diag().Warningf(diag.Message("", msg))
+		} else {
+			c.result = multierror.Append(c.result, errors.New(msg))
+		}
+	}
+	if c.result != nil {
+		return multierror.Append(c.result,
+			errors.New("importing this file could be dangerous; rerun with --force to proceed anyway"))
+	}
+	return nil
+}
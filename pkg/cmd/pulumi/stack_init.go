@@ -24,13 +24,16 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 const (
 	possibleSecretsProviderChoices = "The type of the provider that should be used to encrypt and decrypt secrets\n" +
-		"(possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault)"
+		"(possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault, age)"
 )
 
 func newStackInitCmd() *cobra.Command {
@@ -64,9 +67,27 @@ func newStackInitCmd() *cobra.Command {
 			"* `pulumi stack init --secrets-provider=\"gcpkms://projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k>\"`\n" +
 			"* `pulumi stack init --secrets-provider=\"hashivault://mykey\"\n`" +
 			"\n" +
+			"To encrypt secrets for one or more age recipients, use the following, and set\n" +
+			"`PULUMI_AGE_IDENTITY_FILE` to the identity files to decrypt them with:\n" +
+			"\n" +
+			"* `pulumi stack init --secrets-provider=\"age://<recipient>[,<recipient>...]\"`\n" +
+			"\n" +
+			"The `hashivault` secrets provider logs in to Vault with `VAULT_TOKEN` by default. Its `auth` parameter\n" +
+			"selects the `approle` or `kubernetes` auth method instead, and `namespace` sets the Vault namespace:\n" +
+			"\n" +
+			"* `pulumi stack init --secrets-provider=\"hashivault://mykey?namespace=<ns>&auth=kubernetes&role=<role>\"`\n" +
+			"\n" +
+			"Any other `<scheme>://` secrets provider is handled by a `pulumi-secrets-<scheme>` helper on the PATH.\n" +
+			"\n" +
 			"A stack can be created based on the configuration of an existing stack by passing the\n" +
 			"`--copy-config-from` flag.\n" +
-			"* `pulumi stack init --copy-config-from dev`",
+			"* `pulumi stack init --copy-config-from dev`\n" +
+			"\n" +
+			"Local and cloud object storage backends can hold defaults shared by every new stack, in\n" +
+			"`.pulumi/stack-templates.json`: a secrets provider, tags, tags that every stack has to be\n" +
+			"given, and configuration. Named stack templates in the same file are applied over the defaults\n" +
+			"when selected with the `--stack-template` flag, and tags can be given with the `--tag` flag.\n" +
+			"* `pulumi stack init prod --stack-template production --tag owner=platform`",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := commandContext()
 			return sicmd.Run(ctx, args)
@@ -83,6 +104,10 @@ func newStackInitCmd() *cobra.Command {
 	cmd.PersistentFlags().StringArrayVar(&sicmd.teams, "teams", nil, "A list of team "+
 		"names that should have permission to read and update this stack,"+
 		" once created")
+	cmd.PersistentFlags().StringVar(
+		&sicmd.stackTemplate, "stack-template", "", "The name of the backend's stack template to create the stack from")
+	cmd.PersistentFlags().StringArrayVar(
+		&sicmd.tags, "tag", nil, "A tag to give the stack, as name=value; may be repeated")
 	return cmd
 }
 
@@ -93,6 +118,8 @@ type stackInitCmd struct {
 	stackToCopy     string
 	noSelect        bool
 	teams           []string
+	stackTemplate   string
+	tags            []string
 
 	// currentBackend is a reference to the top-level currentBackend function.
 	// This is used to override the default implementation for testing purposes.
@@ -100,9 +127,6 @@ type stackInitCmd struct {
 }
 
 func (cmd *stackInitCmd) Run(ctx context.Context, args []string) error {
-	if cmd.secretsProvider == "" {
-		cmd.secretsProvider = "default"
-	}
 	if cmd.currentBackend == nil {
 		cmd.currentBackend = currentBackend
 	}
@@ -131,6 +155,22 @@ func (cmd *stackInitCmd) Run(ctx context.Context, args []string) error {
 		cmd.stackName = args[0]
 	}
 
+	// Apply the defaults the backend holds for new stacks.
+	template, err := getStackTemplate(ctx, b, cmd.stackTemplate)
+	if err != nil {
+		return err
+	}
+	tags, err := stackInitTags(b, template, cmd.tags)
+	if err != nil {
+		return err
+	}
+	if cmd.secretsProvider == "" && template != nil {
+		cmd.secretsProvider = template.SecretsProvider
+	}
+	if cmd.secretsProvider == "" {
+		cmd.secretsProvider = "default"
+	}
+
 	// Validate secrets provider type
 	if err := validateSecretsProvider(cmd.secretsProvider); err != nil {
 		return err
@@ -178,6 +218,20 @@ func (cmd *stackInitCmd) Run(ctx context.Context, args []string) error {
 		return err
 	}
 
+	if len(tags) > 0 {
+		if err := backend.UpdateStackTags(ctx, newStack, tags); err != nil {
+			return fmt.Errorf("tagging stack %s: %w", cmd.stackName, err)
+		}
+	}
+	if template != nil && len(template.Config) > 0 {
+		if projectErr != nil {
+			return projectErr
+		}
+		if err := applyStackTemplateConfig(proj, newStack, template.Config); err != nil {
+			return err
+		}
+	}
+
 	if cmd.stackToCopy != "" {
 		if projectErr != nil {
 			return projectErr
@@ -234,3 +288,78 @@ func newCreateStackOptions(teams []string) *backend.CreateStackOptions {
 		Teams: validTeams,
 	}
 }
+
+// getStackTemplate returns the defaults the given backend holds for new stacks, merged with those of the named stack
+// template if name isn't empty. It returns nil if the backend holds no defaults.
+func getStackTemplate(ctx context.Context, b backend.Backend, name string) (*backend.StackTemplate, error) {
+	templater, ok := b.(backend.StackTemplater)
+	if !ok {
+		if name != "" {
+			return nil, fmt.Errorf("the current backend (%s) does not support stack templates", b.Name())
+		}
+		return nil, nil
+	}
+	return templater.GetStackTemplate(ctx, name)
+}
+
+// stackInitTags returns the tags a new stack is given: those of its stack template, overridden by the name=value
+// pairs passed with --tag. Every tag the template requires has to be given.
+func stackInitTags(
+	b backend.Backend, template *backend.StackTemplate, args []string,
+) (map[apitype.StackTagName]string, error) {
+	tags := make(map[apitype.StackTagName]string)
+	var required []apitype.StackTagName
+	if template != nil {
+		for name, value := range template.Tags {
+			tags[name] = value
+		}
+		required = template.RequiredTags
+	}
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid tag %q: expected name=value", arg)
+		}
+		tags[name] = value
+	}
+
+	var missing []string
+	for _, name := range required {
+		if _, ok := tags[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("new stacks must be given the tags %s; pass them with --tag name=value",
+			strings.Join(missing, ", "))
+	}
+	if len(tags) > 0 && !b.SupportsTags() {
+		return nil, fmt.Errorf("the current backend (%s) does not support stack tags", b.Name())
+	}
+	return tags, nil
+}
+
+// applyStackTemplateConfig sets the configuration of a stack template on a new stack. Keys without a namespace are
+// in the namespace of the project.
+func applyStackTemplateConfig(proj *workspace.Project, s backend.Stack, cfg map[string]string) error {
+	ps, err := loadProjectStack(proj, s)
+	if err != nil {
+		return err
+	}
+	if ps.Config == nil {
+		ps.Config = make(config.Map)
+	}
+	for k, v := range cfg {
+		if !strings.Contains(k, tokens.TokenDelimiter) {
+			k = fmt.Sprintf("%s:%s", proj.Name, k)
+		}
+		key, err := config.ParseKey(k)
+		if err != nil {
+			return fmt.Errorf("invalid stack template configuration key %q: %w", k, err)
+		}
+		if _, has := ps.Config[key]; !has {
+			ps.Config[key] = config.NewValue(v)
+		}
+	}
+	return saveProjectStack(s, ps)
+}
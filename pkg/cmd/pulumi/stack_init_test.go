@@ -6,6 +6,7 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,3 +100,35 @@ func TestNewCreateStackOptsFiltersWhitespace(t *testing.T) {
 		})
 	}
 }
+
+func TestStackInitTags(t *testing.T) {
+	t.Parallel()
+
+	b := &backend.MockBackend{
+		NameF:         func() string { return "mock" },
+		SupportsTagsF: func() bool { return true },
+	}
+	template := &backend.StackTemplate{
+		Tags:         map[apitype.StackTagName]string{"team": "platform", "env": "dev"},
+		RequiredTags: []apitype.StackTagName{"owner"},
+	}
+
+	// Tags passed with --tag override those of the template.
+	tags, err := stackInitTags(b, template, []string{"owner=alice", "env=prod"})
+	require.NoError(t, err)
+	assert.Equal(t, map[apitype.StackTagName]string{"team": "platform", "env": "prod", "owner": "alice"}, tags)
+
+	_, err = stackInitTags(b, template, nil)
+	assert.ErrorContains(t, err, "new stacks must be given the tags owner")
+
+	_, err = stackInitTags(b, nil, []string{"owner"})
+	assert.ErrorContains(t, err, `invalid tag "owner": expected name=value`)
+
+	// Backends without tags can still create stacks without any.
+	b.SupportsTagsF = func() bool { return false }
+	tags, err = stackInitTags(b, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+	_, err = stackInitTags(b, nil, []string{"owner=alice"})
+	assert.ErrorContains(t, err, "the current backend (mock) does not support stack tags")
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
@@ -59,15 +60,19 @@ func newStackOutputCmd() *cobra.Command {
 		&socmd.stackName, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
 	cmd.PersistentFlags().BoolVar(
 		&socmd.showSecrets, "show-secrets", false, "Display outputs which are marked as secret in plaintext")
+	cmd.PersistentFlags().BoolVar(
+		&socmd.showMetadata, "show-metadata", false,
+		"Display the descriptions of outputs, and with --json also their schemas")
 
 	return cmd
 }
 
 type stackOutputCmd struct {
-	stackName   string
-	showSecrets bool
-	jsonOut     bool
-	shellOut    bool
+	stackName    string
+	showSecrets  bool
+	showMetadata bool
+	jsonOut      bool
+	shellOut     bool
 
 	OS string // defaults to runtime.GOOS
 
@@ -99,13 +104,24 @@ func (cmd *stackOutputCmd) Run(ctx context.Context, args []string) error {
 		stdout = cmd.Stdout
 	}
 
+	if cmd.shellOut && cmd.showMetadata {
+		return errors.New("--show-metadata cannot be used with --shell")
+	}
+
 	var outw stackOutputWriter
+	var metadataOutw *metadataStackOutputWriter
 	if cmd.shellOut && cmd.jsonOut {
 		return errors.New("only one of --json and --shell may be set")
+	} else if cmd.jsonOut && cmd.showMetadata {
+		metadataOutw = &metadataStackOutputWriter{W: &jsonStackOutputWriter{W: stdout}}
+		outw = metadataOutw
 	} else if cmd.jsonOut {
 		outw = &jsonStackOutputWriter{W: stdout}
 	} else if cmd.shellOut {
 		outw = newShellStackOutputWriter(stdout, osys)
+	} else if cmd.showMetadata {
+		metadataOutw = &metadataStackOutputWriter{W: &consoleStackOutputWriter{W: stdout}}
+		outw = metadataOutw
 	} else {
 		outw = &consoleStackOutputWriter{W: stdout}
 	}
@@ -115,7 +131,7 @@ func (cmd *stackOutputCmd) Run(ctx context.Context, args []string) error {
 	if err != nil {
 		return err
 	}
-	snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+	snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 	if err != nil {
 		return err
 	}
@@ -127,6 +143,15 @@ func (cmd *stackOutputCmd) Run(ctx context.Context, args []string) error {
 	if outputs == nil {
 		outputs = make(map[string]interface{})
 	}
+	if metadataOutw != nil {
+		metadataOutw.Metadata, err = getStackOutputMetadata(snap)
+		if err != nil {
+			return fmt.Errorf("getting output metadata: %w", err)
+		}
+		if metadataOutw.Metadata == nil {
+			metadataOutw.Metadata = map[resource.PropertyKey]resource.OutputMetadata{}
+		}
+	}
 
 	// If there is an argument, just print that property.  Else, print them all (similar to `pulumi stack`).
 	if len(args) > 0 {
@@ -175,6 +200,60 @@ func (w *consoleStackOutputWriter) WriteMany(outputs map[string]interface{}) err
 	return fprintStackOutputs(w.W, outputs)
 }
 
+// outputWithMetadata is a stack output together with its metadata, as shown by `pulumi stack output --show-metadata`.
+type outputWithMetadata struct {
+	Value       interface{} `json:"value"`
+	Description string      `json:"description,omitempty"`
+	Schema      string      `json:"schema,omitempty"`
+}
+
+// metadataStackOutputWriter writes stack outputs together with their metadata. With JSON, each output is written as
+// an object holding its value, description and schema; otherwise descriptions are shown alongside values.
+type metadataStackOutputWriter struct {
+	W        stackOutputWriter
+	Metadata map[resource.PropertyKey]resource.OutputMetadata
+}
+
+var _ stackOutputWriter = (*metadataStackOutputWriter)(nil)
+
+func (w *metadataStackOutputWriter) WriteOne(name string, v interface{}) error {
+	switch out := w.W.(type) {
+	case *jsonStackOutputWriter:
+		return out.WriteOne(name, w.withMetadata(name, v))
+	case *consoleStackOutputWriter:
+		if err := out.WriteOne(name, v); err != nil {
+			return err
+		}
+		if d := w.Metadata[resource.PropertyKey(name)].Description; d != "" {
+			_, err := fmt.Fprintf(out.W, "%v\n", d)
+			return err
+		}
+		return nil
+	default:
+		return w.W.WriteOne(name, v)
+	}
+}
+
+func (w *metadataStackOutputWriter) WriteMany(outputs map[string]interface{}) error {
+	switch out := w.W.(type) {
+	case *jsonStackOutputWriter:
+		withMetadata := make(map[string]interface{}, len(outputs))
+		for k, v := range outputs {
+			withMetadata[k] = w.withMetadata(k, v)
+		}
+		return out.WriteMany(withMetadata)
+	case *consoleStackOutputWriter:
+		return fprintStackOutputsWithDescriptions(out.W, outputs, w.Metadata)
+	default:
+		return w.W.WriteMany(outputs)
+	}
+}
+
+func (w *metadataStackOutputWriter) withMetadata(name string, v interface{}) outputWithMetadata {
+	md := w.Metadata[resource.PropertyKey(name)]
+	return outputWithMetadata{Value: v, Description: md.Description, Schema: md.Schema}
+}
+
 // jsonStackOutputWriter writes stack outputs as machine-parseable JSON.
 type jsonStackOutputWriter struct {
 	W io.Writer
@@ -260,6 +339,15 @@ func (w *powershellStackOutputWriter) WriteMany(outputs map[string]interface{})
 	return nil
 }
 
+// getStackOutputMetadata returns the metadata of the outputs of the stack in the given snapshot.
+func getStackOutputMetadata(snap *deploy.Snapshot) (map[resource.PropertyKey]resource.OutputMetadata, error) {
+	state, err := stack.GetRootStackResource(snap)
+	if err != nil || state == nil {
+		return nil, err
+	}
+	return state.OutputMetadata, nil
+}
+
 func getStackOutputs(snap *deploy.Snapshot, showSecrets bool) (map[string]interface{}, error) {
 	state, err := stack.GetRootStackResource(snap)
 	if err != nil {
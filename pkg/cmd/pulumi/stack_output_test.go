@@ -249,6 +249,110 @@ func TestStackOutputCmd_json(t *testing.T) {
 	}
 }
 
+// Tests that 'pulumi stack output --show-metadata' shows the metadata recorded for outputs.
+func TestStackOutputCmd_metadata(t *testing.T) {
+	t.Parallel()
+
+	snap := deploy.Snapshot{
+		Resources: []*resource.State{
+			{
+				Type: resource.RootStackType,
+				Outputs: resource.PropertyMap{
+					"bucketName": resource.NewStringProperty("mybucket-1234"),
+					"region":     resource.NewStringProperty("us-west-2"),
+				},
+				OutputMetadata: map[resource.PropertyKey]resource.OutputMetadata{
+					"bucketName": {Description: "The name of the bucket.", Schema: `{"type":"string"}`},
+				},
+			},
+		},
+	}
+	requireStack := func(context.Context,
+		string, stackLoadOption, display.Options,
+	) (backend.Stack, error) {
+		return &backend.MockStack{
+			SnapshotF: func(_ context.Context, _ secrets.Provider) (*deploy.Snapshot, error) {
+				return &snap, nil
+			},
+		}, nil
+	}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		var stdoutBuff bytes.Buffer
+		cmd := stackOutputCmd{
+			requireStack: requireStack,
+			showMetadata: true,
+			jsonOut:      true,
+			Stdout:       &stdoutBuff,
+		}
+		require.NoError(t, cmd.Run(context.Background(), nil))
+
+		var got interface{}
+		require.NoError(t, json.Unmarshal(stdoutBuff.Bytes(), &got))
+		assert.Equal(t, map[string]interface{}{
+			"bucketName": map[string]interface{}{
+				"value":       "mybucket-1234",
+				"description": "The name of the bucket.",
+				"schema":      `{"type":"string"}`,
+			},
+			"region": map[string]interface{}{
+				"value": "us-west-2",
+			},
+		}, got)
+	})
+
+	t.Run("json single property", func(t *testing.T) {
+		t.Parallel()
+
+		var stdoutBuff bytes.Buffer
+		cmd := stackOutputCmd{
+			requireStack: requireStack,
+			showMetadata: true,
+			jsonOut:      true,
+			Stdout:       &stdoutBuff,
+		}
+		require.NoError(t, cmd.Run(context.Background(), []string{"bucketName"}))
+
+		var got interface{}
+		require.NoError(t, json.Unmarshal(stdoutBuff.Bytes(), &got))
+		assert.Equal(t, map[string]interface{}{
+			"value":       "mybucket-1234",
+			"description": "The name of the bucket.",
+			"schema":      `{"type":"string"}`,
+		}, got)
+	})
+
+	t.Run("console", func(t *testing.T) {
+		t.Parallel()
+
+		var stdoutBuff bytes.Buffer
+		cmd := stackOutputCmd{
+			requireStack: requireStack,
+			showMetadata: true,
+			Stdout:       &stdoutBuff,
+		}
+		require.NoError(t, cmd.Run(context.Background(), nil))
+
+		stdout := stdoutBuff.String()
+		assert.Contains(t, stdout, "DESCRIPTION")
+		assert.Contains(t, stdout, "The name of the bucket.")
+	})
+
+	t.Run("shell", func(t *testing.T) {
+		t.Parallel()
+
+		cmd := stackOutputCmd{
+			requireStack: requireStack,
+			showMetadata: true,
+			shellOut:     true,
+			Stdout:       &bytes.Buffer{},
+		}
+		assert.ErrorContains(t, cmd.Run(context.Background(), nil), "--show-metadata cannot be used with --shell")
+	})
+}
+
 // Tests the output of 'pulumi stack output --shell'
 // under different conditions.
 func TestStackOutputCmd_shell(t *testing.T) {
@@ -0,0 +1,156 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/spf13/cobra"
+)
+
+type stackRotateSecretsCmd struct {
+	stdout io.Writer
+
+	stack string
+}
+
+func newStackRotateSecretsCmd() *cobra.Command {
+	var srscmd stackRotateSecretsCmd
+	cmd := &cobra.Command{
+		Use:   "rotate-secrets [new-secrets-provider]",
+		Args:  cmdutil.MaximumNArgs(1),
+		Short: "Rotate the key used to encrypt a stack's secrets",
+		Long: "Rotate the key used to encrypt a stack's secrets.\n" +
+			"\n" +
+			"Every secret in the stack's configuration and state is decrypted and re-encrypted with a new data key, and\n" +
+			"the rotation is recorded in the stack's state. For cloud key management services, the new data key is\n" +
+			"wrapped with the latest version of the key. For the passphrase provider, you will be prompted for a new\n" +
+			"passphrase.\n" +
+			"\n" +
+			"To rotate to a different secrets provider at the same time, pass it as an argument:\n" +
+			"\n" +
+			"* `pulumi stack rotate-secrets`\n" +
+			"* `pulumi stack rotate-secrets \"awskms://alias/ExampleAlias?region=us-east-1\"`",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := commandContext()
+			return srscmd.Run(ctx, args)
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&srscmd.stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+
+	return cmd
+}
+
+func (cmd *stackRotateSecretsCmd) Run(ctx context.Context, args []string) error {
+	stdout := cmd.stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+
+	opts := display.Options{
+		Color: cmdutil.GetGlobalColorization(),
+	}
+
+	if len(args) > 0 {
+		if err := validateSecretsProvider(args[0]); err != nil {
+			return err
+		}
+	}
+
+	project, _, err := readProject()
+	if err != nil {
+		return err
+	}
+
+	currentStack, err := requireStack(ctx, cmd.stack, stackLoadOnly, opts)
+	if err != nil {
+		return err
+	}
+
+	currentProjectStack, err := loadProjectStack(project, currentStack)
+	if err != nil {
+		return err
+	}
+
+	previousSecretsProvider := currentProjectStack.SecretsProvider
+	if previousSecretsProvider == "" {
+		if currentProjectStack.EncryptionSalt != "" {
+			previousSecretsProvider = "passphrase"
+		} else {
+			previousSecretsProvider = "default"
+		}
+	}
+
+	secretsProvider := previousSecretsProvider
+	if len(args) > 0 {
+		secretsProvider = args[0]
+	}
+
+	// Build decrypter based on the existing secrets provider
+	var decrypter config.Decrypter
+	if currentProjectStack.Config.HasSecureValue() {
+		dec, needsSave, decerr := getStackDecrypter(currentStack, currentProjectStack)
+		if decerr != nil {
+			return decerr
+		}
+		contract.Assertf(!needsSave, "We're reading a secure value so the encryption information must be present already")
+		decrypter = dec
+	} else {
+		decrypter = config.NewPanicCrypter()
+	}
+
+	// Always generate a new data key, even if the provider is unchanged.
+	if err := createSecretsManager(ctx, currentStack, secretsProvider,
+		true /*rotateSecretsProvider*/, false /*creatingStack*/); err != nil {
+		return err
+	}
+
+	rotation := deploy.SecretsRotation{
+		Time:                    time.Now(),
+		PreviousSecretsProvider: previousSecretsProvider,
+		SecretsProvider:         secretsProvider,
+	}
+	fmt.Fprintf(stdout, "Rotating the secrets in the configuration and state\n")
+	if err := migrateOldConfigAndCheckpointToNewSecretsProvider(
+		ctx, project, currentStack, currentProjectStack, decrypter, &rotation); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Rotated %d configuration secrets and %d state secrets\n",
+		rotation.ConfigSecrets, rotation.StateSecrets)
+	return nil
+}
+
+// countStateSecrets counts the secret values in the inputs and outputs of the snapshot's resources. Nested secrets are
+// encrypted as part of their parent, so they aren't counted separately.
+func countStateSecrets(snap *deploy.Snapshot) int {
+	n := 0
+	for _, res := range snap.Resources {
+		n += len(stateSecrets(res))
+	}
+	return n
+}
@@ -0,0 +1,140 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that we validate the new secrets provider and return an error
+func TestRotateSecrets_Invalid(t *testing.T) {
+	t.Parallel()
+
+	var stdoutBuff bytes.Buffer
+	cmd := stackRotateSecretsCmd{
+		stdout: &stdoutBuff,
+		stack:  "test",
+	}
+	err := cmd.Run(context.Background(), []string{"not_a_secret"})
+	assert.ErrorContains(t, err, "unknown secrets provider type 'not_a_secret'")
+}
+
+// Test that rotating the secrets of a stack re-encrypts its state with the new provider and records the rotation.
+//
+//nolint:paralleltest // mutates global state
+func TestRotateSecrets(t *testing.T) {
+	var stdoutBuff bytes.Buffer
+	cmd := stackRotateSecretsCmd{
+		stdout: &stdoutBuff,
+		stack:  "testStack",
+	}
+
+	snapshot := &deploy.Snapshot{
+		SecretsManager: b64.NewBase64SecretsManager(),
+		Resources: []*resource.State{
+			{
+				URN:  resource.NewURN("testStack", "testProject", "", resource.RootStackType, "testStack"),
+				Type: resource.RootStackType,
+				Outputs: resource.PropertyMap{
+					"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+					"nested": resource.NewObjectProperty(resource.PropertyMap{
+						"token": resource.MakeSecret(resource.NewStringProperty("abc")),
+					}),
+				},
+			},
+		},
+	}
+
+	mockStack := &backend.MockStack{
+		RefF: func() backend.StackReference {
+			return &backend.MockStackReference{
+				StringV: "testStack",
+				NameV:   tokens.MustParseStackName("testStack"),
+			}
+		},
+		SnapshotF: func(_ context.Context, _ secrets.Provider) (*deploy.Snapshot, error) {
+			return snapshot, nil
+		},
+		ExportDeploymentF: func(ctx context.Context) (*apitype.UntypedDeployment, error) {
+			chk, err := stack.SerializeDeployment(snapshot, nil, false)
+			if err != nil {
+				return nil, err
+			}
+			data, err := encoding.JSON.Marshal(chk)
+			if err != nil {
+				return nil, err
+			}
+			return &apitype.UntypedDeployment{
+				Version:    3,
+				Deployment: json.RawMessage(data),
+			}, nil
+		},
+		ImportDeploymentF: func(ctx context.Context, deployment *apitype.UntypedDeployment) error {
+			snap, err := stack.DeserializeUntypedDeployment(ctx, deployment, stack.DefaultSecretsProvider)
+			if err != nil {
+				return err
+			}
+			snapshot = snap
+			return nil
+		},
+	}
+
+	mockBackendInstance(t, &backend.MockBackend{
+		GetStackF: func(ctx context.Context, stackRef backend.StackReference) (backend.Stack, error) {
+			return mockStack, nil
+		},
+	})
+
+	tmpDir := t.TempDir()
+	chdir(t, tmpDir)
+
+	err := os.WriteFile("Pulumi.yaml", []byte(`
+name: testProject
+runtime: mock
+`), 0o600)
+	require.NoError(t, err)
+
+	// passphrase will read from stdin for the new passphrase
+	mockStdin(t, "password123\npassword123\n")
+	err = cmd.Run(context.Background(), []string{"passphrase"})
+	require.NoError(t, err)
+	assert.Equal(t, "Rotating the secrets in the configuration and state\n"+
+		"Rotated 0 configuration secrets and 2 state secrets\n", stdoutBuff.String())
+
+	assert.Equal(t, "passphrase", snapshot.SecretsManager.Type())
+	require.Len(t, snapshot.SecretsRotations, 1)
+	rotation := snapshot.SecretsRotations[0]
+	assert.Equal(t, "default", rotation.PreviousSecretsProvider)
+	assert.Equal(t, "passphrase", rotation.SecretsProvider)
+	assert.Equal(t, 0, rotation.ConfigSecrets)
+	assert.Equal(t, 2, rotation.StateSecrets)
+	assert.False(t, rotation.Time.IsZero())
+}
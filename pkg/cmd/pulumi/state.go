@@ -54,8 +54,12 @@ troubleshooting a stack or when performing specific edits that otherwise would r
 	cmd.AddCommand(newStateEditCommand())
 	cmd.AddCommand(newStateDeleteCommand())
 	cmd.AddCommand(newStateUnprotectCommand())
+	cmd.AddCommand(newStateFreezeCommand())
+	cmd.AddCommand(newStateUnfreezeCommand())
+	cmd.AddCommand(newStateReconcileCommand())
 	cmd.AddCommand(newStateRenameCommand())
 	cmd.AddCommand(newStateUpgradeCommand())
+	cmd.AddCommand(newStateRollbackCommand())
 	return cmd
 }
 
@@ -149,7 +153,7 @@ func runTotalStateEdit(
 func totalStateEdit(ctx context.Context, s backend.Stack, showPrompt bool, opts display.Options,
 	operation func(opts display.Options, snap *deploy.Snapshot) error,
 ) error {
-	snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+	snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 	if err != nil {
 		return err
 	} else if snap == nil {
@@ -222,7 +226,7 @@ func getURNFromState(
 		if err != nil {
 			return "", err
 		}
-		*snap, err = s.Snapshot(ctx, stack.DefaultSecretsProvider)
+		*snap, err = s.Snapshot(ctx, stackSecretsProvider(s))
 		if err != nil {
 			return "", err
 		}
@@ -26,7 +26,6 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
-	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
@@ -132,7 +131,7 @@ func (cmd *stateEditCmd) Run(s backend.Stack) error {
 		cmd.Stdout = os.Stdout
 	}
 
-	snap, err := s.Snapshot(cmd.Ctx, stack.DefaultSecretsProvider)
+	snap, err := s.Snapshot(cmd.Ctx, stackSecretsProvider(s))
 	if err != nil {
 		return err
 	}
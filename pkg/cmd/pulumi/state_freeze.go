@@ -0,0 +1,81 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/edit"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+
+	"github.com/spf13/cobra"
+)
+
+func newStateFreezeCommand() *cobra.Command {
+	var stack string
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "freeze [resource URN]",
+		Short: "Freeze resources in a stack's state",
+		Long: `Freeze a resource in a stack's state
+
+This command sets the 'frozen' bit on a resource. The engine refuses to update, replace or delete a frozen
+resource, even if the resource is not protected, until it is unfrozen with ` + "`pulumi state unfreeze`" + `.
+
+To see the list of URNs in a stack, use ` + "`pulumi stack --show-urns`" + `.`,
+		Args: cmdutil.MaximumNArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := commandContext()
+			yes = yes || skipConfirmations()
+			// Show the confirmation prompt if the user didn't pass the --yes parameter to skip it.
+			showPrompt := !yes
+
+			var urn resource.URN
+
+			if len(args) != 1 {
+				if !cmdutil.Interactive() {
+					return missingNonInteractiveArg("resource URN")
+				}
+				var err error
+				urn, err = getURNFromState(ctx, stack, nil, "Select a resource to freeze:")
+				if err != nil {
+					return fmt.Errorf("failed to select resource: %w", err)
+				}
+			} else {
+				urn = resource.URN(args[0])
+			}
+			return freezeResource(ctx, stack, urn, showPrompt)
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip confirmation prompts")
+
+	return cmd
+}
+
+func freezeResource(ctx context.Context, stackName string, urn resource.URN, showPrompt bool) error {
+	err := runStateEdit(ctx, stackName, showPrompt, urn, edit.FreezeResource)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Resource frozen")
+	return nil
+}
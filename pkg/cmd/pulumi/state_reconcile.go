@@ -0,0 +1,459 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newStateReconcileCommand() *cobra.Command {
+	var stack string
+	var importFilePath string
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Compare a stack's state with the resources that its providers report",
+		Long: `Compare a stack's state with the resources that its providers report
+
+This command asks the provider of each resource in the stack's state to list the resources it can
+see, and reports the resources in the state that no longer exist, for example because they were
+deleted outside of Pulumi. Only providers that support resource discovery can be checked, and only
+resources of the types that a provider can list in full.
+
+If an import file written by ` + "`pulumi preview --import-file`" + ` is given, the resources that the
+program would create are also looked for, and those that already exist outside of the stack's state
+are reported.
+
+The report suggests the ` + "`pulumi state delete`" + ` and ` + "`pulumi import`" + ` commands that bring the state
+back in line with the program. It doesn't change the state itself.`,
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := commandContext()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			s, err := requireStack(ctx, stack, stackLoadOnly, opts)
+			if err != nil {
+				return err
+			}
+			snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
+			if err != nil {
+				return err
+			}
+			if snap == nil {
+				snap = &deploy.Snapshot{}
+			}
+
+			var planned importFile
+			if importFilePath != "" {
+				planned, err = readImportFile(importFilePath)
+				if err != nil {
+					return fmt.Errorf("could not read import file: %w", err)
+				}
+			}
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("get working directory: %w", err)
+			}
+			sink := cmdutil.Diag()
+			pCtx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+			if err != nil {
+				return fmt.Errorf("create plugin context: %w", err)
+			}
+			defer contract.IgnoreClose(pCtx)
+
+			// The stack's configuration is only needed for packages that have no provider in the state yet, so it
+			// is loaded on first use.
+			var target *deploy.Target
+			discover := func(group *discoverGroup) (plugin.DiscoverResult, error) {
+				req := plugin.DiscoverRequest{Types: group.types}
+				if group.provider != nil {
+					return discoverWithStateProvider(pCtx, group.provider, req)
+				}
+
+				if target == nil {
+					proj, _, err := readProject()
+					if err != nil {
+						return plugin.DiscoverResult{}, err
+					}
+					cfg, sm, err := getStackConfiguration(ctx, s, proj, nil)
+					if err != nil {
+						return plugin.DiscoverResult{}, fmt.Errorf("getting stack configuration: %w", err)
+					}
+					decrypter, err := sm.Decrypter()
+					if err != nil {
+						return plugin.DiscoverResult{}, fmt.Errorf("getting stack decrypter: %w", err)
+					}
+					encrypter, err := sm.Encrypter()
+					if err != nil {
+						return plugin.DiscoverResult{}, fmt.Errorf("getting stack encrypter: %w", err)
+					}
+					err = workspace.ValidateStackConfigAndApplyProjectConfig(
+						s.Ref().Name().String(), proj, cfg.Environment, cfg.Config, encrypter, decrypter)
+					if err != nil {
+						return plugin.DiscoverResult{}, fmt.Errorf("validating stack config: %w", err)
+					}
+					target = &deploy.Target{Config: cfg.Config, Decrypter: decrypter}
+				}
+				providerConfig, err := target.GetPackageConfig(group.pkg)
+				if err != nil {
+					return plugin.DiscoverResult{}, fmt.Errorf(
+						"could not fetch configuration for provider '%v': %w", group.pkg, err)
+				}
+				return discoverResources(pCtx, group.pkg, providerConfig, req)
+			}
+
+			report, err := reconcileState(snap, planned, discover)
+			if err != nil {
+				return err
+			}
+			if jsonOut {
+				return printJSON(report)
+			}
+			printReconcileReport(os.Stdout, report)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().StringVar(
+		&importFilePath, "import-file", "",
+		"The path to an import file written by `pulumi preview --import-file`, listing the resources that the "+
+			"program would create")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the report as JSON")
+
+	return cmd
+}
+
+// reconcileReport describes how a stack's state differs from the resources that its providers report.
+type reconcileReport struct {
+	// Missing are the resources in the state that their provider no longer reports. Only resources of the types that
+	// the provider enumerated in full are checked.
+	Missing []missingResource `json:"missing"`
+	// Unmanaged are the resources that the program would create, that exist already but aren't in the state.
+	Unmanaged []unmanagedResource `json:"unmanaged"`
+	// Skipped are the providers whose resources couldn't be listed, or that couldn't list all resources of some types.
+	Skipped []skippedProvider `json:"skipped,omitempty"`
+}
+
+// missingResource is a resource in the state that its provider no longer reports.
+type missingResource struct {
+	URN     resource.URN `json:"urn"`
+	ID      resource.ID  `json:"id"`
+	Command string       `json:"command"`
+}
+
+// unmanagedResource is a resource that the program would create, which exists already but isn't in the state.
+type unmanagedResource struct {
+	Type    tokens.Type `json:"type"`
+	Name    string      `json:"name"`
+	ID      resource.ID `json:"id"`
+	Command string      `json:"command"`
+}
+
+// skippedProvider is a provider whose resources couldn't be listed.
+type skippedProvider struct {
+	Provider string `json:"provider"`
+	Reason   string `json:"reason"`
+}
+
+// discoverGroup is a set of resource types to discover with one provider.
+type discoverGroup struct {
+	pkg tokens.Package
+	// provider is the provider's resource in the state, or nil to use the package's default provider as the stack
+	// configures it.
+	provider *resource.State
+	types    []tokens.Type
+
+	// state holds the resources in the state that the provider manages.
+	state []*resource.State
+	// planned holds the resources that the program would create with the provider.
+	planned []importSpec
+}
+
+// name returns the name that the group's provider is reported under.
+func (g *discoverGroup) name() string {
+	if g.provider != nil {
+		return string(g.provider.URN)
+	}
+	return string(g.pkg)
+}
+
+func (g *discoverGroup) addType(t tokens.Type) {
+	for _, have := range g.types {
+		if have == t {
+			return
+		}
+	}
+	g.types = append(g.types, t)
+}
+
+// reconcileState cross-references the resources in the given snapshot, and the resources that the given import file
+// says the program would create, against the resources that their providers discover. Providers whose discovery
+// fails are reported as skipped. Resources in the state are only reported missing if their provider enumerated all
+// resources of their type; the other types are reported as skipped. Resources that the program would create are only
+// reported if exactly one discovered resource of the same type has the same name, as it isn't clear which to import
+// otherwise.
+func reconcileState(snap *deploy.Snapshot, planned importFile,
+	discover func(group *discoverGroup) (plugin.DiscoverResult, error),
+) (reconcileReport, error) {
+	report := reconcileReport{Missing: []missingResource{}, Unmanaged: []unmanagedResource{}}
+
+	// Group the resources by the provider that manages them, in the order they appear in.
+	var groups []*discoverGroup
+	byProvider := map[string]*discoverGroup{}
+	group := func(key string, pkg tokens.Package, provider *resource.State) *discoverGroup {
+		g, ok := byProvider[key]
+		if !ok {
+			g = &discoverGroup{pkg: pkg, provider: provider}
+			groups, byProvider[key] = append(groups, g), g
+		}
+		return g
+	}
+
+	providerStates := map[string]*resource.State{}
+	defaultProviders := map[tokens.Package]*resource.State{}
+	inState := map[tokens.Type]map[resource.ID]bool{}
+	for _, res := range snap.Resources {
+		if res.Delete {
+			continue
+		}
+		if providers.IsProviderType(res.Type) {
+			ref, err := providers.NewReference(res.URN, res.ID)
+			if err != nil {
+				return reconcileReport{}, err
+			}
+			providerStates[ref.String()] = res
+			pkg := providers.GetProviderPackage(res.Type)
+			if _, has := defaultProviders[pkg]; !has && providers.IsDefaultProvider(res.URN) {
+				defaultProviders[pkg] = res
+			}
+			continue
+		}
+		if !res.Custom || res.External || res.ID == "" {
+			continue
+		}
+
+		if inState[res.Type] == nil {
+			inState[res.Type] = map[resource.ID]bool{}
+		}
+		inState[res.Type][res.ID] = true
+
+		provider, ok := providerStates[res.Provider]
+		if !ok {
+			return reconcileReport{}, fmt.Errorf("could not find provider '%v' of resource '%v'", res.Provider, res.URN)
+		}
+		g := group(res.Provider, providers.GetProviderPackage(provider.Type), provider)
+		g.addType(res.Type)
+		g.state = append(g.state, res)
+	}
+
+	for _, spec := range planned.Resources {
+		if spec.Component {
+			continue
+		}
+		pkg := spec.Type.Package()
+
+		var provider *resource.State
+		if spec.Provider != "" {
+			urn, ok := planned.NameTable[spec.Provider]
+			if !ok {
+				return reconcileReport{}, fmt.Errorf("could not find provider '%v' of resource '%v'", spec.Provider, spec.Name)
+			}
+			for _, p := range providerStates {
+				if p.URN == urn {
+					provider = p
+					break
+				}
+			}
+			if provider == nil {
+				report.Skipped = append(report.Skipped, skippedProvider{
+					Provider: string(urn),
+					Reason:   "the provider is not in the stack's state",
+				})
+				continue
+			}
+		} else {
+			provider = defaultProviders[pkg]
+		}
+
+		var g *discoverGroup
+		if provider != nil {
+			ref, err := providers.NewReference(provider.URN, provider.ID)
+			if err != nil {
+				return reconcileReport{}, err
+			}
+			g = group(ref.String(), pkg, provider)
+		} else {
+			g = group(string(pkg), pkg, nil)
+		}
+		g.addType(spec.Type)
+		g.planned = append(g.planned, spec)
+	}
+
+	for _, g := range groups {
+		discovered, err := discover(g)
+		if err != nil {
+			report.Skipped = append(report.Skipped, skippedProvider{Provider: g.name(), Reason: err.Error()})
+			continue
+		}
+
+		type typeID struct {
+			typ tokens.Type
+			id  resource.ID
+		}
+		type typeName struct {
+			typ  tokens.Type
+			name string
+		}
+		live := map[typeID]bool{}
+		byName := map[typeName][]resource.ID{}
+		for _, r := range discovered.Resources {
+			live[typeID{r.Type, r.ID}] = true
+			if r.Name != "" {
+				key := typeName{r.Type, r.Name}
+				byName[key] = append(byName[key], r.ID)
+			}
+		}
+
+		// A resource that wasn't discovered only doesn't exist if the provider listed every resource of its type.
+		listed := map[tokens.Type]bool{}
+		for _, t := range discovered.Types {
+			listed[t] = true
+		}
+		var unlisted []string
+		skipped := map[tokens.Type]bool{}
+		for _, res := range g.state {
+			if !listed[res.Type] {
+				if !skipped[res.Type] {
+					skipped[res.Type] = true
+					unlisted = append(unlisted, string(res.Type))
+				}
+				continue
+			}
+			if !live[typeID{res.Type, res.ID}] {
+				report.Missing = append(report.Missing, missingResource{
+					URN:     res.URN,
+					ID:      res.ID,
+					Command: shellquote.Join("pulumi", "state", "delete", string(res.URN)),
+				})
+			}
+		}
+		if len(unlisted) != 0 {
+			report.Skipped = append(report.Skipped, skippedProvider{
+				Provider: g.name(),
+				Reason:   "the provider did not list all resources of " + strings.Join(unlisted, ", "),
+			})
+		}
+
+		for _, spec := range g.planned {
+			name := spec.Name
+			if spec.LogicalName != "" {
+				name = spec.LogicalName
+			}
+			ids := byName[typeName{spec.Type, name}]
+			if len(ids) != 1 || inState[spec.Type][ids[0]] {
+				continue
+			}
+
+			args := []string{"pulumi", "import", string(spec.Type), name, string(ids[0])}
+			if urn, ok := planned.NameTable[spec.Parent]; ok {
+				args = append(args, "--parent", spec.Parent+"="+string(urn))
+			}
+			if urn, ok := planned.NameTable[spec.Provider]; ok {
+				args = append(args, "--provider", spec.Provider+"="+string(urn))
+			}
+			report.Unmanaged = append(report.Unmanaged, unmanagedResource{
+				Type:    spec.Type,
+				Name:    name,
+				ID:      ids[0],
+				Command: shellquote.Join(args...),
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// discoverWithStateProvider loads and configures the provider that the given provider resource describes, and asks
+// it to enumerate the resources that match the given request.
+func discoverWithStateProvider(ctx *plugin.Context, provider *resource.State,
+	req plugin.DiscoverRequest,
+) (plugin.DiscoverResult, error) {
+	pkg := providers.GetProviderPackage(provider.Type)
+	version, err := providers.GetProviderVersion(provider.Inputs)
+	if err != nil {
+		return plugin.DiscoverResult{}, fmt.Errorf("parse version for %v provider '%v': %w", pkg, provider.URN, err)
+	}
+
+	p, err := ctx.Host.Provider(pkg, version)
+	if err != nil {
+		return plugin.DiscoverResult{}, fmt.Errorf("could not load provider '%v': %w", pkg, err)
+	}
+	defer contract.IgnoreError(ctx.Host.CloseProvider(p))
+
+	if err := p.Configure(provider.Inputs); err != nil {
+		return plugin.DiscoverResult{}, fmt.Errorf("could not configure provider '%v': %w", provider.URN, err)
+	}
+	return p.Discover(req)
+}
+
+// printReconcileReport prints the given report in a human-readable form.
+func printReconcileReport(w io.Writer, report reconcileReport) {
+	for _, s := range report.Skipped {
+		fmt.Fprintf(w, "warning: could not list the resources of %v: %v\n", s.Provider, s.Reason)
+	}
+	if len(report.Missing) == 0 && len(report.Unmanaged) == 0 {
+		fmt.Fprintln(w, "The stack's state matches the resources that its providers report.")
+		return
+	}
+
+	if len(report.Missing) != 0 {
+		fmt.Fprintf(w, "Resources in the state that no longer exist (%d):\n", len(report.Missing))
+		for _, r := range report.Missing {
+			fmt.Fprintf(w, "    %v (id: %v)\n", r.URN, r.ID)
+			fmt.Fprintf(w, "        %v\n", r.Command)
+		}
+	}
+	if len(report.Unmanaged) != 0 {
+		fmt.Fprintf(w, "Resources of the program that exist but are not in the state (%d):\n", len(report.Unmanaged))
+		for _, r := range report.Unmanaged {
+			fmt.Fprintf(w, "    %v %v (id: %v)\n", r.Type, r.Name, r.ID)
+			fmt.Fprintf(w, "        %v\n", r.Command)
+		}
+	}
+}
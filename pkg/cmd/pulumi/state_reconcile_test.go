@@ -0,0 +1,121 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// TestReconcileState tests that resources that are missing from the live resources and resources of the program that
+// exist outside of the state are reported, and that resources of types that the provider didn't list in full aren't
+// reported missing.
+func TestReconcileState(t *testing.T) {
+	t.Parallel()
+
+	provA := &resource.State{
+		URN:  "urn:pulumi:dev::proj::pulumi:providers:pkgA::default",
+		ID:   "a1",
+		Type: "pulumi:providers:pkgA",
+	}
+	provB := &resource.State{
+		URN:  "urn:pulumi:dev::proj::pulumi:providers:pkgB::default",
+		ID:   "b1",
+		Type: "pulumi:providers:pkgB",
+	}
+	res := func(typ tokens.Type, name, id string, prov *resource.State) *resource.State {
+		return &resource.State{
+			URN:      resource.NewURN("dev", "proj", "", typ, name),
+			ID:       resource.ID(id),
+			Type:     typ,
+			Custom:   true,
+			Provider: string(prov.URN) + "::" + string(prov.ID),
+		}
+	}
+	snap := &deploy.Snapshot{
+		Resources: []*resource.State{
+			provA,
+			provB,
+			res("pkgA:m:typA", "kept", "id-kept", provA),
+			res("pkgA:m:typA", "gone", "id-gone", provA),
+			res("pkgA:m:typC", "unlisted", "id-unlisted", provA),
+			{
+				URN:      "urn:pulumi:dev::proj::pkgB:m:typB::other",
+				ID:       "id-other",
+				Type:     "pkgB:m:typB",
+				Custom:   true,
+				Provider: string(provB.URN) + "::" + string(provB.ID),
+			},
+		},
+	}
+	planned := importFile{
+		Resources: []importSpec{
+			{Type: "pkgA:m:typA", Name: "adopt", ID: "<PLACEHOLDER>"},
+			{Type: "pkgA:m:typA", Name: "ambiguous", ID: "<PLACEHOLDER>"},
+			{Type: "pkgA:m:typA", Name: "new", ID: "<PLACEHOLDER>"},
+		},
+	}
+
+	var asked []tokens.Type
+	report, err := reconcileState(snap, planned,
+		func(group *discoverGroup) (plugin.DiscoverResult, error) {
+			if group.pkg == "pkgB" {
+				return plugin.DiscoverResult{}, errors.New("the pkgB provider does not support resource discovery")
+			}
+			asked = append(asked, group.types...)
+			// The provider can't list all resources of typC, so it leaves it out of the listed types.
+			return plugin.DiscoverResult{
+				Resources: []plugin.DiscoveredResource{
+					{Type: "pkgA:m:typA", Name: "kept", ID: "id-kept"},
+					{Type: "pkgA:m:typA", Name: "adopt", ID: "id-adopt"},
+					{Type: "pkgA:m:typA", Name: "ambiguous", ID: "id-1"},
+					{Type: "pkgA:m:typA", Name: "ambiguous", ID: "id-2"},
+				},
+				Types: []tokens.Type{"pkgA:m:typA"},
+			}, nil
+		})
+	require.NoError(t, err)
+
+	assert.Equal(t, []tokens.Type{"pkgA:m:typA", "pkgA:m:typC"}, asked)
+	assert.Equal(t, []missingResource{{
+		URN:     "urn:pulumi:dev::proj::pkgA:m:typA::gone",
+		ID:      "id-gone",
+		Command: "pulumi state delete urn:pulumi:dev::proj::pkgA:m:typA::gone",
+	}}, report.Missing)
+	assert.Equal(t, []unmanagedResource{{
+		Type:    "pkgA:m:typA",
+		Name:    "adopt",
+		ID:      "id-adopt",
+		Command: "pulumi import pkgA:m:typA adopt id-adopt",
+	}}, report.Unmanaged)
+	assert.Equal(t, []skippedProvider{
+		{
+			Provider: string(provA.URN),
+			Reason:   "the provider did not list all resources of pkgA:m:typC",
+		},
+		{
+			Provider: string(provB.URN),
+			Reason:   "the pkgB provider does not support resource discovery",
+		},
+	}, report.Skipped)
+}
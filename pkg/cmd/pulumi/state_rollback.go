@@ -0,0 +1,115 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	surveycore "github.com/AlecAivazis/survey/v2/core"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+)
+
+func newStateRollbackCommand() *cobra.Command {
+	var stackName string
+	var yes bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "rollback <version>",
+		Short: "Roll back a stack's state to a previous update",
+		Long: `Roll back a stack's state to a previous update
+
+This command replaces the current state of a stack with the state it had after the update with the given version.
+The versions of a stack's updates are shown by ` + "`pulumi stack history`" + `.
+
+Only the state is rolled back: no resources are created, updated or deleted. Run ` + "`pulumi refresh`" + `
+afterwards to reconcile the state with the actual resources, or ` + "`pulumi up`" + ` to bring them back in line
+with the program.`,
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := commandContext()
+			yes = yes || skipConfirmations()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			s, err := requireStack(ctx, stackName, stackLoadOnly, opts)
+			if err != nil {
+				return err
+			}
+
+			version := args[0]
+			if !yes && cmdutil.Interactive() {
+				confirm := false
+				surveycore.DisableColor = true
+				prompt := opts.Color.Colorize(colors.Yellow + "warning" + colors.Reset + ": ")
+				prompt += fmt.Sprintf("This will replace the state of '%s' with its state after update %s. Confirm?",
+					s.Ref().Name(), version)
+				if err = survey.AskOne(&survey.Confirm{
+					Message: prompt,
+				}, &confirm, surveyIcons(opts.Color)); err != nil || !confirm {
+					return result.FprintBailf(os.Stdout, "confirmation declined")
+				}
+			}
+
+			if err := rollbackStack(ctx, s, version, force); err != nil {
+				return err
+			}
+			fmt.Printf("Rolled back '%s' to version %s.\n", s.Ref().Name(), version)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip confirmation prompts")
+	cmd.Flags().BoolVarP(
+		&force, "force", "f", false,
+		"Force the rollback to occur, even if apparent errors are discovered beforehand (not recommended)")
+
+	return cmd
+}
+
+// rollbackStack replaces the current state of the given stack with its state after the update with the given version.
+func rollbackStack(ctx context.Context, s backend.Stack, version string, force bool) error {
+	be := s.Backend()
+	exporter, ok := be.(backend.SpecificDeploymentExporter)
+	if !ok {
+		return fmt.Errorf("the current backend (%s) does not provide the ability to export previous deployments",
+			be.Name())
+	}
+
+	deployment, err := exporter.ExportDeploymentForVersion(ctx, s, version)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := stack.DeserializeUntypedDeployment(ctx, deployment, stackSecretsProvider(s))
+	if err != nil {
+		return checkDeploymentVersionError(err, s.Ref().Name().String())
+	}
+	return saveSnapshot(ctx, s, snapshot, force)
+}
@@ -75,13 +75,17 @@ func newUpCmd() *cobra.Command {
 	var skipPreview bool
 	var showFullOutput bool
 	var suppressOutputs bool
+	var profileSteps bool
 	var suppressPermalink string
 	var yes bool
 	var secretsProvider string
 	var targets []string
+	var excludes []string
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var continueOnError bool
+	var stepHooks []string
 	var planFilePath string
 
 	// up implementation used when the source of the Pulumi program is in the current working directory.
@@ -145,6 +149,12 @@ func newUpCmd() *cobra.Command {
 		if err != nil {
 			return result.FromError(err)
 		}
+
+		hooks, err := makeStepHooks(stepHooks)
+		if err != nil {
+			return result.FromError(err)
+		}
+
 		opts.Engine = engine.UpdateOptions{
 			LocalPolicyPacks:          engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
 			Parallel:                  parallel,
@@ -155,8 +165,10 @@ func newUpCmd() *cobra.Command {
 			DisableProviderPreview:    disableProviderPreview(),
 			DisableResourceReferences: disableResourceReferences(),
 			DisableOutputValues:       disableOutputValues(),
-			Targets:                   deploy.NewUrnTargets(targetURNs),
+			Targets:                   deploy.NewUrnTargets(targetURNs).Excluding(excludes),
 			TargetDependents:          targetDependents,
+			ContinueOnError:           continueOnError,
+			StepHooks:                 hooks,
 			// Trigger a plan to be generated during the preview phase which can be constrained to during the
 			// update phase.
 			GeneratePlan: true,
@@ -186,7 +198,7 @@ func newUpCmd() *cobra.Command {
 			Opts:               opts,
 			StackConfiguration: cfg,
 			SecretsManager:     sm,
-			SecretsProvider:    stack.DefaultSecretsProvider,
+			SecretsProvider:    stackSecretsProvider(s),
 			Scopes:             backend.CancellationScopes,
 		})
 		switch {
@@ -370,11 +382,18 @@ func newUpCmd() *cobra.Command {
 			return result.FromError(err)
 		}
 
+		hooks, err := makeStepHooks(stepHooks)
+		if err != nil {
+			return result.FromError(err)
+		}
+
 		opts.Engine = engine.UpdateOptions{
 			LocalPolicyPacks: engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
 			Parallel:         parallel,
 			Debug:            debug,
 			Refresh:          refreshOption,
+			ContinueOnError:  continueOnError,
+			StepHooks:        hooks,
 			// If we're in experimental mode then we trigger a plan to be generated during the preview phase
 			// which will be constrained to during the update phase.
 			GeneratePlan: hasExperimentalCommands(),
@@ -393,7 +412,7 @@ func newUpCmd() *cobra.Command {
 			Opts:               opts,
 			StackConfiguration: cfg,
 			SecretsManager:     sm,
-			SecretsProvider:    stack.DefaultSecretsProvider,
+			SecretsProvider:    stackSecretsProvider(s),
 			Scopes:             backend.CancellationScopes,
 		})
 		switch {
@@ -467,6 +486,7 @@ func newUpCmd() *cobra.Command {
 				IsInteractive:          interactive,
 				Type:                   displayType,
 				EventLogPath:           eventLogPath,
+				ProfileSteps:           profileSteps,
 				Debug:                  debug,
 				JSONDisplay:            jsonDisplay,
 			}
@@ -486,7 +506,7 @@ func newUpCmd() *cobra.Command {
 
 				err = validateUnsupportedRemoteFlags(expectNop, configArray, path, client, jsonDisplay, policyPackPaths,
 					policyPackConfigPaths, refresh, showConfig, showPolicyRemediations, showReplacementSteps, showSames,
-					showReads, suppressOutputs, secretsProvider, &targets, replaces, targetReplaces,
+					showReads, suppressOutputs, secretsProvider, &targets, excludes, replaces, targetReplaces,
 					targetDependents, planFilePath, stackConfigFile)
 				if err != nil {
 					return result.FromError(err)
@@ -534,7 +554,7 @@ func newUpCmd() *cobra.Command {
 		"Config keys contain a path to a property in a map or list to set")
 	cmd.PersistentFlags().StringVar(
 		&secretsProvider, "secrets-provider", "default", "The type of the provider that should be used to encrypt and "+
-			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault). Only "+
+			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault, age). Only "+
 			"used when creating a new stack from an existing template")
 
 	cmd.PersistentFlags().StringVar(
@@ -550,6 +570,11 @@ func newUpCmd() *cobra.Command {
 		"Specify a single resource URN to update. Other resources will not be updated."+
 			" Multiple resources can be specified using --target urn1 --target urn2."+
 			" Wildcards (*, **) are also supported")
+	cmd.PersistentFlags().StringArrayVar(
+		&excludes, "exclude", []string{},
+		"Specify a single resource URN to exclude from the update. Excluded resources will not be updated."+
+			" Multiple resources can be specified using --exclude urn1 --exclude urn2."+
+			" Wildcards (*, **) are also supported")
 	cmd.PersistentFlags().StringArrayVar(
 		&replaces, "replace", []string{},
 		"Specify a single resource URN to replace. Multiple resources can be specified using --replace urn1 --replace urn2."+
@@ -561,6 +586,15 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().StringArrayVar(
+		&stepHooks, "step-hook", nil,
+		"Call the step hook implemented by the pulumi-step-hook-<name> executable before and after each step, "+
+			"letting it veto steps before they are applied. Multiple hooks can be specified using "+
+			"--step-hook name1 --step-hook name2")
+	cmd.PersistentFlags().BoolVar(
+		&continueOnError, "continue-on-error", false,
+		"Continue updating the resources that don't depend on a failed resource, rather than stopping the update at the "+
+			"first failure")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -605,6 +639,9 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressOutputs, "suppress-outputs", false,
 		"Suppress display of stack outputs (in case they contain sensitive values)")
+	cmd.PersistentFlags().BoolVar(
+		&profileSteps, "profile-steps", false,
+		"Print the durations of the slowest steps, and the longest chain of steps that had to run one after another")
 	cmd.PersistentFlags().BoolVar(
 		&showFullOutput, "show-full-output", true,
 		"Display full length of stack outputs")
@@ -682,7 +719,7 @@ func handleConfig(
 	}
 
 	// Get the existing snapshot.
-	snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+	snap, err := s.Snapshot(ctx, stackSecretsProvider(s))
 	if err != nil {
 		return err
 	}
@@ -45,7 +45,10 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/backend/state"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/age"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/cloud"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/external"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/passphrase"
 	"github.com/pulumi/pulumi/pkg/v3/util/tracing"
 	"github.com/pulumi/pulumi/pkg/v3/version"
@@ -89,6 +92,20 @@ func disableOutputValues() bool {
 	return env.DisableOutputValues.Value()
 }
 
+// makeStepHooks returns the step hooks with the given names, which are passed to the engine to be called before and
+// after each step.
+func makeStepHooks(names []string) ([]deploy.StepHook, error) {
+	var hooks []deploy.StepHook
+	for _, name := range names {
+		hook, err := deploy.NewExecStepHook(name)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
 // skipConfirmations returns whether or not confirmation prompts should
 // be skipped. This should be used by pass any requirement that a --yes
 // parameter has been set for non-interactive scenarios.
@@ -224,16 +241,7 @@ func createSecretsManager(
 	}
 
 	oldConfig := deepcopy.Copy(ps).(*workspace.ProjectStack)
-	if isDefaultSecretsProvider {
-		_, err = stack.DefaultSecretManager(ps)
-	} else if secretsProvider == passphrase.Type {
-		_, err = passphrase.NewPromptingPassphraseSecretsManager(ps, rotateSecretsProvider)
-	} else {
-		// All other non-default secrets providers are handled by the cloud secrets provider which
-		// uses a URL schema to identify the provider
-		_, err = cloud.NewCloudSecretsManager(ps, secretsProvider, rotateSecretsProvider)
-	}
-	if err != nil {
+	if _, err = newSecretsManagerForProvider(stack, ps, secretsProvider, rotateSecretsProvider); err != nil {
 		return err
 	}
 
@@ -247,6 +255,25 @@ func createSecretsManager(
 	return nil
 }
 
+// newSecretsManagerForProvider returns a secrets manager for the given secrets provider, recording its configuration
+// in ps.
+func newSecretsManagerForProvider(
+	stack backend.Stack, ps *workspace.ProjectStack, secretsProvider string, rotateSecretsProvider bool,
+) (secrets.Manager, error) {
+	if secretsProvider == "" || secretsProvider == "default" {
+		return stack.DefaultSecretManager(ps)
+	} else if secretsProvider == passphrase.Type {
+		return passphrase.NewPromptingPassphraseSecretsManager(ps, rotateSecretsProvider)
+	} else if age.IsAgeSecretsProvider(secretsProvider) {
+		return age.NewAgeSecretsManager(ps, secretsProvider, rotateSecretsProvider)
+	} else if external.IsExternalSecretsProvider(secretsProvider) {
+		return external.NewExternalSecretsManager(ps, secretsProvider, rotateSecretsProvider)
+	}
+	// All other non-default secrets providers are handled by the cloud secrets provider which
+	// uses a URL schema to identify the provider
+	return cloud.NewCloudSecretsManager(ps, secretsProvider, rotateSecretsProvider)
+}
+
 // createStack creates a stack with the given name, and optionally selects it as the current.
 func createStack(ctx context.Context,
 	b backend.Backend, stackRef backend.StackReference,
@@ -73,6 +73,7 @@ func validateUnsupportedRemoteFlags(
 	suppressOutputs bool,
 	secretsProvider string,
 	targets *[]string,
+	excludes []string,
 	replaces []string,
 	targetReplaces []string,
 	targetDependents bool,
@@ -128,6 +129,9 @@ func validateUnsupportedRemoteFlags(
 	if targets != nil && len(*targets) > 0 {
 		return errors.New("--target is not supported with --remote")
 	}
+	if len(excludes) > 0 {
+		return errors.New("--exclude is not supported with --remote")
+	}
 	if len(replaces) > 0 {
 		return errors.New("--replace is not supported with --remote")
 	}
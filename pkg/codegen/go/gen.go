@@ -2421,6 +2421,15 @@ func (pkg *pkgContext) genResource(
 	fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sArgs)(nil)).Elem()\n", cgstrings.Camel(name))
 	fmt.Fprintf(w, "}\n")
 
+	// Emit TagsField so that pulumi.AutoTag can find the resource's tags. AutoTag only handles StringMapInput fields.
+	if p := r.TagsProperty; p != nil && !p.Plain && !useGenericVariant {
+		fmt.Fprintf(w, "\n// TagsField returns the name of the field that holds the resource's tags.\n")
+		fmt.Fprintf(w, "// It is used by pulumi.AutoTag.\n")
+		fmt.Fprintf(w, "func (%sArgs) TagsField() string {\n", name)
+		fmt.Fprintf(w, "\treturn %q\n", pkg.fieldName(r, p))
+		fmt.Fprintf(w, "}\n")
+	}
+
 	// Emit resource methods.
 	for _, method := range r.Methods {
 		methodName := Title(method.Name)
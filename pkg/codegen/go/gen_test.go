@@ -612,3 +612,39 @@ func TestRegressTypeDuplicatesInChunking(t *testing.T) {
 		assert.NotContains(t, typedefs1, typ)
 	}
 }
+
+func TestGenerateTagsField(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Tagged": {
+				InputProperties: map[string]schema.PropertySpec{
+					"labels": {TypeSpec: schema.TypeSpec{
+						Type:                 "object",
+						AdditionalProperties: &schema.TypeSpec{Type: "string"},
+					}},
+				},
+				TagsProperty: "labels",
+			},
+			"test:index:Untagged": {
+				InputProperties: map[string]schema.PropertySpec{
+					"name": {TypeSpec: schema.TypeSpec{Type: "string"}},
+				},
+			},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(fs["test/tagged.go"]), "func (TaggedArgs) TagsField() string {\n\treturn \"Labels\"\n}")
+	assert.NotContains(t, string(fs["test/untagged.go"]), "TagsField")
+}
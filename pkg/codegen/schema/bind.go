@@ -1393,6 +1393,24 @@ func (t *types) bindResourceDetails(path, token string, spec ResourceSpec, decl
 		aliases = append(aliases, &Alias{Name: a.Name, Project: a.Project, Type: a.Type})
 	}
 
+	var tagsProperty *Property
+	if spec.TagsProperty != "" {
+		for _, p := range inputProperties {
+			if p.Name == spec.TagsProperty {
+				tagsProperty = p
+				break
+			}
+		}
+		if tagsProperty == nil {
+			diags = diags.Append(errorf(path+"/tagsProperty", "%v has no input property named %s", token,
+				spec.TagsProperty))
+		} else if m, ok := plainType(tagsProperty.Type).(*MapType); !ok || plainType(m.ElementType) != StringType {
+			diags = diags.Append(errorf(path+"/tagsProperty", "input property %s of %v must be a map of strings",
+				spec.TagsProperty, token))
+			tagsProperty = nil
+		}
+	}
+
 	language := make(map[string]interface{})
 	for name, raw := range spec.Language {
 		language[name] = json.RawMessage(raw)
@@ -1411,6 +1429,7 @@ func (t *types) bindResourceDetails(path, token string, spec ResourceSpec, decl
 		IsComponent:        spec.IsComponent,
 		Methods:            methods,
 		IsOverlay:          spec.IsOverlay,
+		TagsProperty:       tagsProperty,
 	}
 	return diags, nil
 }
@@ -401,6 +401,8 @@ type Resource struct {
 	// IsOverlay indicates whether the type is an overlay provided by the package. Overlay code is generated by the
 	// package rather than using the core Pulumi codegen libraries.
 	IsOverlay bool
+	// TagsProperty is the input property that holds the resource's tags or labels, if any.
+	TagsProperty *Property
 }
 
 // The set of resource paths where ReplaceOnChanges is true.
@@ -1116,6 +1118,11 @@ func (pkg *Package) marshalResource(r *Resource) (ResourceSpec, error) {
 		}
 	}
 
+	var tagsProperty string
+	if r.TagsProperty != nil {
+		tagsProperty = r.TagsProperty.Name
+	}
+
 	return ResourceSpec{
 		ObjectTypeSpec:     object,
 		InputProperties:    inputs,
@@ -1125,6 +1132,7 @@ func (pkg *Package) marshalResource(r *Resource) (ResourceSpec, error) {
 		DeprecationMessage: r.DeprecationMessage,
 		IsComponent:        r.IsComponent,
 		Methods:            methods,
+		TagsProperty:       tagsProperty,
 	}, nil
 }
 
@@ -1545,6 +1553,9 @@ type ResourceSpec struct {
 	IsComponent bool `json:"isComponent,omitempty" yaml:"isComponent,omitempty"`
 	// Methods maps method names to functions in this schema.
 	Methods map[string]string `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// TagsProperty is the name of the input property that holds the resource's tags or labels, if any. The property
+	// must be a map of strings.
+	TagsProperty string `json:"tagsProperty,omitempty" yaml:"tagsProperty,omitempty"`
 }
 
 // ReturnTypeSpec is either ObjectTypeSpec or TypeSpec.
@@ -1438,3 +1438,56 @@ func TestFunctionToFunctionSpecTurnaround(t *testing.T) {
 		})
 	}
 }
+
+func TestTagsProperty(t *testing.T) {
+	t.Parallel()
+
+	newSpec := func(tagsProperty string) PackageSpec {
+		return PackageSpec{
+			Name:    "xyz",
+			Version: "0.0.1",
+			Resources: map[string]ResourceSpec{
+				"xyz:index:resource": {
+					InputProperties: map[string]PropertySpec{
+						"name": {TypeSpec: TypeSpec{Type: "string"}},
+						"tags": {TypeSpec: TypeSpec{
+							Type:                 "object",
+							AdditionalProperties: &TypeSpec{Type: "string"},
+						}},
+					},
+					TagsProperty: tagsProperty,
+				},
+			},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		pkg, diags, err := BindSpec(newSpec("tags"), nil)
+		require.NoError(t, err)
+		require.False(t, diags.HasErrors(), diags.Error())
+		require.NotNil(t, pkg.Resources[0].TagsProperty)
+		assert.Equal(t, "tags", pkg.Resources[0].TagsProperty.Name)
+
+		spec, err := pkg.MarshalSpec()
+		require.NoError(t, err)
+		assert.Equal(t, "tags", spec.Resources["xyz:index:resource"].TagsProperty)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags, err := BindSpec(newSpec("labels"), nil)
+		require.NoError(t, err)
+		assert.ErrorContains(t, diags, "xyz:index:resource has no input property named labels")
+	})
+
+	t.Run("not a map of strings", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags, err := BindSpec(newSpec("name"), nil)
+		require.NoError(t, err)
+		assert.ErrorContains(t, diags, "input property name of xyz:index:resource must be a map of strings")
+	})
+}
@@ -45,6 +45,8 @@ type PreviewDigest struct {
 	ChangeSummary ResourceChanges `json:"changeSummary,omitempty"`
 	// MaybeCorrupt indicates whether one or more resources may be corrupt.
 	MaybeCorrupt bool `json:"maybeCorrupt,omitempty"`
+	// ReplacementImpact lists the planned replacements that cause other resources to be updated or replaced.
+	ReplacementImpact []ReplacementImpact `json:"replacementImpact,omitempty"`
 }
 
 // PropertyDiff contains information about the difference in a single property value.
@@ -75,6 +77,29 @@ type PreviewStep struct {
 	DetailedDiff map[string]PropertyDiff `json:"detailedDiff"`
 }
 
+// ReplacementImpact describes the resources that are updated or replaced because a resource is replaced.
+type ReplacementImpact struct {
+	// URN is the resource being replaced.
+	URN resource.URN `json:"urn"`
+	// ReplaceReasons is a list of keys that are causing the replacement.
+	ReplaceReasons []resource.PropertyKey `json:"replaceReasons,omitempty"`
+	// Dependents lists the resources that are updated or replaced because of the replacement, either directly or
+	// through other dependents, in the order they are planned.
+	Dependents []ImpactedResource `json:"dependents"`
+}
+
+// ImpactedResource is a resource that is updated or replaced because a resource that it depends on changes.
+type ImpactedResource struct {
+	// Op is the kind of operation being performed on the resource.
+	Op StepOp `json:"op"`
+	// URN is the resource being affected.
+	URN resource.URN `json:"urn"`
+	// DependsOn is the changing resource that causes this resource to change.
+	DependsOn resource.URN `json:"dependsOn"`
+	// Properties is a list of the keys that change because they depend on DependsOn.
+	Properties []resource.PropertyKey `json:"properties"`
+}
+
 // PreviewDiagnostic is a warning or error emitted during the execution of the preview.
 type PreviewDiagnostic struct {
 	URN      resource.URN  `json:"urn,omitempty"`
@@ -186,6 +186,11 @@ func newDeployment(ctx *Context, info *deploymentContext, opts *deploymentOption
 	// Keep the plugin context open until the context is terminated, to allow for graceful provider cancellation.
 	plugctx = plugctx.WithCancelChannel(ctx.Cancel.Terminated())
 
+	// Record or replay the responses of providers, if asked to.
+	if opts.ProviderResponses != nil && dryRun {
+		plugctx.Host = &providerResponseHost{Host: plugctx.Host, responses: opts.ProviderResponses}
+	}
+
 	// Set up a goroutine that will signal cancellation to the source if the caller context
 	// is cancelled.
 	cancelCtx, cancelFunc := context.WithCancel(context.Background())
@@ -288,6 +293,13 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions,
 	}
 	defer chdir()
 
+	secretScan := deployment.Options.UpdateOptions.SecretScan
+	if secretScan == deploy.SecretScanOff {
+		if secretScan, err = deploy.ParseSecretScanMode(env.SecretScan.Value()); err != nil {
+			return nil, nil, fmt.Errorf("PULUMI_SECRET_SCAN: %w", err)
+		}
+	}
+
 	// Create a new context for cancellation and tracing.
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
@@ -313,12 +325,16 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions,
 			RefreshOnly:               deployment.Options.isRefresh,
 			ReplaceTargets:            deployment.Options.ReplaceTargets,
 			Targets:                   deployment.Options.Targets,
+			RefreshProperties:         deployment.Options.RefreshProperties,
 			TargetDependents:          deployment.Options.TargetDependents,
+			ContinueOnError:           deployment.Options.ContinueOnError,
+			StepHooks:                 deployment.Options.StepHooks,
 			TrustDependencies:         deployment.Options.trustDependencies,
 			UseLegacyDiff:             deployment.Options.UseLegacyDiff,
 			DisableResourceReferences: deployment.Options.DisableResourceReferences,
 			DisableOutputValues:       deployment.Options.DisableOutputValues,
 			GeneratePlan:              deployment.Options.UpdateOptions.GeneratePlan,
+			SecretScan:                secretScan,
 		}
 		newPlan, walkError = deployment.Deployment.Execute(ctx, opts, preview)
 		close(done)
@@ -383,13 +399,18 @@ func checkTargets(targetUrns deploy.UrnTargets, snap *deploy.Snapshot) error {
 		return nil
 	}
 	if snap == nil {
+		// An empty stack has nothing to exclude resources from, so only targeting resources in one is an error.
+		if !targetUrns.HasIncludes() {
+			return nil
+		}
 		return errors.New("targets specified, but snapshot was nil")
 	}
 	urns := map[resource.URN]struct{}{}
 	for _, res := range snap.Resources {
 		urns[res.URN] = struct{}{}
 	}
-	for _, target := range targetUrns.Literals() {
+	literals := append(append([]resource.URN{}, targetUrns.Literals()...), targetUrns.ExcludedLiterals()...)
+	for _, target := range literals {
 		if _, ok := urns[target]; !ok {
 			return fmt.Errorf("no resource named '%s' found", target)
 		}
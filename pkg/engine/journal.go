@@ -154,6 +154,9 @@ func (entries JournalEntries) Snap(base *deploy.Snapshot) (*deploy.Snapshot, err
 	manifest.Magic = manifest.NewMagic()
 
 	snap := deploy.NewSnapshot(manifest, secretsManager, resources, operations)
+	if base != nil {
+		snap.SecretsRotations = base.SecretsRotations
+	}
 	normSnap, err := snap.NormalizeURNReferences()
 	if err != nil {
 		return snap, err
@@ -0,0 +1,146 @@
+package lifecycletest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// failureSummary returns the message of the error diagnostic summarizing the failures of a deployment that continued
+// on error, or "" if there isn't one.
+func failureSummary(events []Event) string {
+	for _, e := range events {
+		if p, ok := e.Payload().(DiagEventPayload); ok && p.Severity == diag.Error &&
+			strings.Contains(p.Message, "failed") && strings.Contains(p.Message, "step") {
+			return p.Message
+		}
+	}
+	return ""
+}
+
+// Tests that an update continues creating the resources that don't depend on one that failed to be created.
+func TestContinueOnErrorUpdate(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					if urn.Name() == "resA" {
+						return "", nil, resource.StatusOK, errors.New("quota exceeded")
+					}
+					return resource.ID(urn.Name() + "-id"), news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.ErrorContains(t, err, "registering resource")
+
+		// resB doesn't depend on resA, so it's still created.
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF, UpdateOptions: UpdateOptions{ContinueOnError: true}},
+	}
+	resA := p.NewURN("pkgA:m:typA", "resA", "")
+
+	var summary string
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			summary = failureSummary(events)
+			return err
+		})
+	require.Error(t, err)
+
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, "default", snap.Resources[0].URN.Name())
+	assert.Equal(t, "resB", snap.Resources[1].URN.Name())
+
+	assert.Contains(t, summary, "1 step failed:")
+	assert.Contains(t, summary, "create failed (1):")
+	assert.Contains(t, summary, string(resA)+": quota exceeded")
+}
+
+// Tests that a destroy continues deleting the resources that don't depend on one that failed to be deleted, and keeps
+// the ones that it depends on.
+func TestContinueOnErrorDestroy(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs resource.PropertyMap, timeout float64,
+				) (resource.Status, error) {
+					if urn.Name() == "resB" {
+						return resource.StatusOK, errors.New("resource is in use")
+					}
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		resA, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		require.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			Dependencies: []resource.URN{resA},
+		})
+		require.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resC", true)
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF, UpdateOptions: UpdateOptions{ContinueOnError: true}},
+	}
+	resA, resB := p.NewURN("pkgA:m:typA", "resA", ""), p.NewURN("pkgA:m:typA", "resB", "")
+
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 4)
+
+	var summary string
+	snap, err = TestOp(Destroy).Run(p.GetProject(), p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			summary = failureSummary(events)
+			return err
+		})
+	require.Error(t, err)
+
+	// resC is deleted, but resA and the provider are kept as resB still depends on them.
+	var names []string
+	for _, r := range snap.Resources {
+		names = append(names, r.URN.Name())
+	}
+	assert.Equal(t, []string{"default", "resA", "resB"}, names)
+
+	assert.Contains(t, summary, "1 step failed, and 2 steps didn't run because of them:")
+	assert.Contains(t, summary, "delete failed (1):\n        "+string(resB)+": resource is in use")
+	assert.Contains(t, summary, "delete skipped (2):")
+	assert.Contains(t, summary, string(resA))
+}
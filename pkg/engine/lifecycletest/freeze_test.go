@@ -0,0 +1,135 @@
+package lifecycletest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Tests that the engine refuses to update, replace or delete frozen resources, and that resources stay frozen until
+// they are unfrozen in the state.
+func TestFrozen(t *testing.T) {
+	t.Parallel()
+
+	var updates, deletes int
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs, newInputs resource.PropertyMap, ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					// A change to foo replaces the resource, and a change to bar updates it.
+					if !oldOutputs["foo"].DeepEquals(newInputs["foo"]) {
+						return plugin.DiffResult{Changes: plugin.DiffSome, ReplaceKeys: []resource.PropertyKey{"foo"}}, nil
+					}
+					if !oldOutputs["bar"].DeepEquals(newInputs["bar"]) {
+						return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+					}
+					return plugin.DiffResult{}, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "created-id", news, resource.StatusOK, nil
+				},
+				UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+					timeout float64, ignoreChanges []string, preview bool,
+				) (resource.PropertyMap, resource.Status, error) {
+					if !preview {
+						updates++
+					}
+					return newInputs, resource.StatusOK, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+					timeout float64,
+				) (resource.Status, error) {
+					deletes++
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}, deploytest.WithoutGrpc),
+	}
+
+	ins := resource.PropertyMap{"foo": resource.NewStringProperty("foo"), "bar": resource.NewStringProperty("bar")}
+	frozen, createResource := true, true
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if createResource {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+				Inputs: ins,
+				Frozen: frozen,
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{Options: TestUpdateOptions{HostF: hostF}}
+	project := p.GetProject()
+
+	// expectDiag returns a validation function that checks that the update reports an error containing the given
+	// message.
+	expectDiag := func(message string) func(workspace.Project, deploy.Target, JournalEntries, []Event, error) error {
+		return func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			found := false
+			for _, e := range events {
+				if payload, ok := e.Payload().(DiagEventPayload); ok && strings.Contains(payload.Message, message) {
+					found = true
+				}
+			}
+			assert.True(t, found, "expected a diagnostic containing %q", message)
+			return err
+		}
+	}
+
+	// Create the resource, frozen.
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.True(t, snap.Resources[1].Frozen)
+
+	// An update is refused.
+	ins["bar"] = resource.NewStringProperty("baz")
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		expectDiag(`unable to update resource "urn:pulumi:test::test::pkgA:m:typA::resA"`))
+	assert.Error(t, err)
+	assert.Equal(t, 0, updates)
+
+	// So is a replacement, even if the program no longer asks for the resource to be frozen.
+	ins["bar"], ins["foo"] = resource.NewStringProperty("bar"), resource.NewStringProperty("qux")
+	frozen = false
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		expectDiag(`unable to replace resource "urn:pulumi:test::test::pkgA:m:typA::resA"`))
+	assert.Error(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, resource.ID("created-id"), snap.Resources[1].ID)
+	assert.True(t, snap.Resources[1].Frozen)
+	assert.Equal(t, 0, deletes)
+
+	// And so is a delete.
+	createResource = false
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		expectDiag("`pulumi state unfreeze 'urn:pulumi:test::test::pkgA:m:typA::resA'`"))
+	assert.Error(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, 0, deletes)
+
+	// Once the resource is unfrozen, it can be replaced.
+	snap.Resources[1].Frozen = false
+	createResource = true
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.False(t, snap.Resources[1].Frozen)
+	assert.Equal(t, 1, deletes)
+}
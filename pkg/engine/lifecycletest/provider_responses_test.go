@@ -0,0 +1,167 @@
+package lifecycletest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Tests that a preview can record the responses of providers, and that a later preview can replay them without
+// loading or calling the providers.
+func TestProviderResponses(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	online := func() (plugin.Provider, error) {
+		return &deploytest.Provider{
+			CheckF: func(urn resource.URN, olds, news resource.PropertyMap,
+				randomSeed []byte,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				calls++
+				return news, nil, nil
+			},
+			DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+				ignoreChanges []string,
+			) (plugin.DiffResult, error) {
+				calls++
+				if oldInputs.DeepEquals(newInputs) {
+					return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+				}
+				return plugin.DiffResult{Changes: plugin.DiffSome, ReplaceKeys: []resource.PropertyKey{"foo"}}, nil
+			},
+			InvokeF: func(tok tokens.ModuleMember,
+				inputs resource.PropertyMap,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				calls++
+				return resource.PropertyMap{"value": resource.NewStringProperty("baz")}, nil, nil
+			},
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				calls++
+				outs := resource.PropertyMap{"bar": resource.NewStringProperty("read")}
+				return plugin.ReadResult{ID: id, Outputs: outs}, resource.StatusOK, nil
+			},
+		}, nil
+	}
+	// The offline provider fails every call, and can't even be configured.
+	var launched int
+	offline := func() (plugin.Provider, error) {
+		launched++
+		return &deploytest.Provider{
+			ConfigureF: func(news resource.PropertyMap) error {
+				return errors.New("the provider can't be configured while offline")
+			},
+			CheckF: func(urn resource.URN, olds, news resource.PropertyMap,
+				randomSeed []byte,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				t.Errorf("unexpected call to Check")
+				return news, nil, nil
+			},
+			DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+				ignoreChanges []string,
+			) (plugin.DiffResult, error) {
+				t.Errorf("unexpected call to Diff")
+				return plugin.DiffResult{}, nil
+			},
+			InvokeF: func(tok tokens.ModuleMember,
+				inputs resource.PropertyMap,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				t.Errorf("unexpected call to Invoke")
+				return nil, nil, nil
+			},
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				t.Errorf("unexpected call to Read")
+				return plugin.ReadResult{}, resource.StatusUnknown, nil
+			},
+		}, nil
+	}
+
+	value := "bar"
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		foo := resource.NewStringProperty(value)
+		if value != "bar" {
+			outs, _, err := monitor.Invoke("pkgA:m:getValue", resource.PropertyMap{}, "", "")
+			if err != nil {
+				return err
+			}
+			foo = outs["value"]
+		}
+
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"foo": foo},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, _, err = monitor.ReadResource("pkgA:m:typA", "resB", "resB-id", "", resource.PropertyMap{}, "", "", "")
+		return err
+	})
+
+	hostF := func(load func() (plugin.Provider, error)) deploytest.PluginHostFactory {
+		return deploytest.NewPluginHostF(nil, nil, programF,
+			deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), load))
+	}
+
+	// ops records the operations of the steps that a preview would perform.
+	var ops []display.StepOp
+	validate := func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+		ops = nil
+		for _, e := range events {
+			if e.Type == ResourcePreEvent {
+				ops = append(ops, e.Payload().(ResourcePreEventPayload).Metadata.Op)
+			}
+		}
+		return err
+	}
+
+	p := &TestPlan{Options: TestUpdateOptions{HostF: hostF(online)}}
+	project, target := p.GetProject(), p.GetTarget(t, nil)
+
+	snap, err := TestOp(Update).Run(project, target, p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	// Record the responses to a preview that changes resA.
+	value = "invoked"
+	calls = 0
+	recorded := NewProviderResponses(RecordProviderResponses, nil)
+	p.Options.UpdateOptions.ProviderResponses = recorded
+	_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient, validate)
+	require.NoError(t, err)
+	// The invoke, the check and diff of resA, the check of its replacement and the read of resB.
+	assert.Equal(t, 5, calls)
+	// The calls above, the check and diff of the default provider's config, and the preview of the replacement's
+	// creation.
+	assert.Len(t, recorded.Responses(), 8)
+	recordedOps := ops
+
+	// Replay them with providers that would fail if they were loaded.
+	p.Options.HostF = hostF(offline)
+	p.Options.UpdateOptions.ProviderResponses = NewProviderResponses(ReplayProviderResponses, recorded.Responses())
+	_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient, validate)
+	require.NoError(t, err)
+	assert.Equal(t, recordedOps, ops)
+	assert.Contains(t, ops, deploy.OpReplace)
+	assert.Contains(t, ops, deploy.OpRead)
+	assert.Equal(t, 0, launched)
+
+	// A preview that makes calls that weren't recorded fails.
+	p.Options.UpdateOptions.ProviderResponses = NewProviderResponses(ReplayProviderResponses, nil)
+	_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient, nil)
+	assert.Error(t, err)
+}
@@ -1608,3 +1608,191 @@ func TestDeletedWithOptionInheritanceMLC(t *testing.T) {
 	}
 	assert.NoError(t, err)
 }
+
+// TestExplicitProviderFollowsParentAlias verifies that an explicit provider whose URN changes because its parent was
+// re-typed is aliased along with its parent, and that the resources it manages follow it without being replaced, even
+// when they are not part of a targeted update.
+func TestExplicitProviderFollowsParentAlias(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	compType := tokens.Type("my:mod:CompA")
+	var compAliases []resource.URN
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		compURN, _, _, err := monitor.RegisterResource(compType, "comp", false, deploytest.ResourceOptions{
+			AliasURNs: compAliases,
+		})
+		require.NoError(t, err)
+
+		provURN, provID, _, err := monitor.RegisterResource(providers.MakeProviderType("pkgA"), "prov", true,
+			deploytest.ResourceOptions{
+				Parent: compURN,
+			})
+		require.NoError(t, err)
+
+		if provID == "" {
+			provID = providers.UnknownID
+		}
+		provRef, err := providers.NewReference(provURN, provID)
+		require.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Parent:   compURN,
+			Provider: provRef.String(),
+		})
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+	project := p.GetProject()
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	// Re-type the parent, aliasing it to its old type. The provider and resource inherit the alias.
+	compAliases = []resource.URN{p.NewURN("my:mod:CompA", "comp", "")}
+	compType = "my:mod:CompB"
+	compURN := p.NewURN("my:mod:CompB", "comp", "")
+	provURN := resource.NewURN("test", "test", compURN.QualifiedType(), providers.MakeProviderType("pkgA"), "prov")
+	resURN := resource.NewURN("test", "test", compURN.QualifiedType(), "pkgA:m:typA", "resA")
+
+	// Only target the provider. The untargeted resource must be updated to refer to the provider's new URN.
+	opts := p.Options
+	opts.Targets = deploy.NewUrnTargetsFromUrns([]resource.URN{provURN})
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), opts, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.NoError(t, snap.VerifyIntegrity())
+
+	// Now run a full update. Nothing should change.
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, entries JournalEntries, _ []Event, err error) error {
+			for _, entry := range entries {
+				assert.Equal(t, deploy.OpSame, entry.Step.Op(), "unexpected step for %v", entry.Step.URN())
+			}
+			return err
+		})
+	require.NoError(t, err)
+	require.NoError(t, snap.VerifyIntegrity())
+
+	require.Len(t, snap.Resources, 3)
+	assert.Equal(t, compURN, snap.Resources[0].URN)
+	assert.Equal(t, provURN, snap.Resources[1].URN)
+	assert.Equal(t, resURN, snap.Resources[2].URN)
+	ref, err := providers.ParseReference(snap.Resources[2].Provider)
+	require.NoError(t, err)
+	assert.Equal(t, provURN, ref.URN())
+}
+
+// TestExplicitProviderOwnAlias verifies that an explicit provider that is renamed or moved under a new parent through
+// its own aliases is aliased without being replaced, and that the resources it manages follow it, even when they are
+// not part of a targeted update and so keep referring to the provider by its old URN.
+func TestExplicitProviderOwnAlias(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		provName string
+		parented bool
+	}{
+		{name: "rename", provName: "provB"},
+		{name: "retype", provName: "provA", parented: true},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			loaders := []*deploytest.ProviderLoader{
+				deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+					return &deploytest.Provider{}, nil
+				}),
+			}
+
+			provName, parented := "provA", false
+			var provAliases []resource.URN
+			programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+				compURN, _, _, err := monitor.RegisterResource("my:mod:Comp", "comp", false)
+				require.NoError(t, err)
+
+				var provParent resource.URN
+				if parented {
+					provParent = compURN
+				}
+				provURN, provID, _, err := monitor.RegisterResource(providers.MakeProviderType("pkgA"), provName, true,
+					deploytest.ResourceOptions{
+						Parent:    provParent,
+						AliasURNs: provAliases,
+					})
+				require.NoError(t, err)
+
+				if provID == "" {
+					provID = providers.UnknownID
+				}
+				provRef, err := providers.NewReference(provURN, provID)
+				require.NoError(t, err)
+
+				_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+					Provider: provRef.String(),
+				})
+				require.NoError(t, err)
+				return nil
+			})
+			hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+			p := &TestPlan{
+				Options: TestUpdateOptions{HostF: hostF},
+			}
+			project := p.GetProject()
+
+			snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+			require.NoError(t, err)
+
+			// Rename or re-parent the provider, aliasing it to its old URN.
+			provAliases = []resource.URN{p.NewProviderURN("pkgA", "provA", "")}
+			provName, parented = c.provName, c.parented
+			provURN := p.NewProviderURN("pkgA", provName, "")
+			if parented {
+				provURN = p.NewProviderURN("pkgA", provName, p.NewURN("my:mod:Comp", "comp", ""))
+			}
+
+			// Only target the provider. The untargeted resource must be updated to refer to the provider's new URN.
+			opts := p.Options
+			opts.Targets = deploy.NewUrnTargetsFromUrns([]resource.URN{provURN})
+			snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), opts, false, p.BackendClient,
+				func(_ workspace.Project, _ deploy.Target, entries JournalEntries, _ []Event, err error) error {
+					for _, entry := range entries {
+						assert.Equal(t, deploy.OpSame, entry.Step.Op(), "unexpected step for %v", entry.Step.URN())
+					}
+					return err
+				})
+			require.NoError(t, err)
+			require.NoError(t, snap.VerifyIntegrity())
+
+			// Now run a full update. Nothing should change.
+			snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+				func(_ workspace.Project, _ deploy.Target, entries JournalEntries, _ []Event, err error) error {
+					for _, entry := range entries {
+						assert.Equal(t, deploy.OpSame, entry.Step.Op(), "unexpected step for %v", entry.Step.URN())
+					}
+					return err
+				})
+			require.NoError(t, err)
+			require.NoError(t, snap.VerifyIntegrity())
+
+			require.Len(t, snap.Resources, 3)
+			assert.Equal(t, provURN, snap.Resources[1].URN)
+			ref, err := providers.ParseReference(snap.Resources[2].Provider)
+			require.NoError(t, err)
+			assert.Equal(t, provURN, ref.URN())
+		})
+	}
+}
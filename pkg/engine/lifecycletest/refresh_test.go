@@ -928,3 +928,87 @@ func TestRefreshUpdateWithDeletedResource(t *testing.T) {
 	snap := p.Run(t, old)
 	assert.Equal(t, 0, len(snap.Resources))
 }
+
+// TestRefreshProperties validates that a refresh limited to some properties only writes those properties back to the
+// state of the targeted resources.
+func TestRefreshProperties(t *testing.T) {
+	t.Parallel()
+
+	p := &TestPlan{}
+
+	resAURN := p.NewURN("pkgA:m:typA", "resA", "")
+	resBURN := p.NewURN("pkgA:m:typA", "resB", "")
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return plugin.ReadResult{
+						ID: "newid",
+						Outputs: resource.NewPropertyMapFromMap(map[string]interface{}{
+							"tags":     map[string]interface{}{"env": "prod", "refreshedAt": "2"},
+							"status":   "stopped",
+							"volatile": "new",
+						}),
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	p.Options.HostF = deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+	p.Options.Targets = deploy.NewUrnTargetsFromUrns([]resource.URN{resAURN})
+	for _, path := range []string{"tags.env", "status"} {
+		parsed, err := resource.ParsePropertyPath(path)
+		assert.NoError(t, err)
+		p.Options.RefreshProperties = append(p.Options.RefreshProperties, parsed)
+	}
+
+	outputs := func() resource.PropertyMap {
+		return resource.NewPropertyMapFromMap(map[string]interface{}{
+			"tags":   map[string]interface{}{"env": "dev", "refreshedAt": "1"},
+			"status": "running",
+			"arn":    "arn:a",
+		})
+	}
+	old := &deploy.Snapshot{}
+	for _, urn := range []resource.URN{resAURN, resBURN} {
+		old.Resources = append(old.Resources, &resource.State{
+			Type:    urn.Type(),
+			URN:     urn,
+			Custom:  true,
+			ID:      "oldid",
+			Inputs:  resource.PropertyMap{},
+			Outputs: outputs(),
+		})
+	}
+
+	p.Steps = []TestStep{{Op: Refresh, SkipPreview: true}}
+	snap := p.Run(t, old)
+
+	provURN := p.NewProviderURN("pkgA", "default", "")
+	for _, res := range snap.Resources {
+		switch res.URN {
+		case provURN:
+			continue
+		case resAURN:
+			assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+				"tags":   map[string]interface{}{"env": "prod", "refreshedAt": "1"},
+				"status": "stopped",
+				"arn":    "arn:a",
+			}), res.Outputs)
+		case resBURN:
+			assert.Equal(t, outputs(), res.Outputs)
+		default:
+			t.Fatalf("unexpected resource %v", res.URN)
+		}
+		assert.Equal(t, resource.ID("oldid"), res.ID)
+	}
+	// The old state must not have been modified.
+	assert.Equal(t, outputs(), old.Resources[0].Outputs)
+}
@@ -0,0 +1,306 @@
+package lifecycletest
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+)
+
+// Tests that a failing Create is retried according to the resource's retry policy, and that the policy is
+// recorded in the snapshot.
+func TestRetryPolicyCreate(t *testing.T) {
+	t.Parallel()
+
+	var creates int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					if atomic.AddInt32(&creates, 1) < 3 {
+						return "", nil, resource.StatusOK, errors.New("throttled")
+					}
+					return "created-id", news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			RetryPolicy: &resource.RetryPolicy{MaxRetries: 3, InitialDelay: 0.001},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&creates))
+
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, resource.ID("created-id"), snap.Resources[1].ID)
+	assert.Equal(t, resource.RetryPolicy{MaxRetries: 3, InitialDelay: 0.001}, snap.Resources[1].RetryPolicy)
+}
+
+// Tests that an operation fails once the retry policy is exhausted.
+func TestRetryPolicyExhausted(t *testing.T) {
+	t.Parallel()
+
+	var creates int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					atomic.AddInt32(&creates, 1)
+					return "", nil, resource.StatusOK, errors.New("throttled")
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			RetryPolicy: &resource.RetryPolicy{MaxRetries: 2, InitialDelay: 0.001},
+		})
+		assert.Error(t, err)
+		return err
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	_, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&creates))
+}
+
+// Tests that partial failures are never retried, as the provider has already created the resource.
+func TestRetryPolicyPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	var creates int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					atomic.AddInt32(&creates, 1)
+					return "created-id", news, resource.StatusPartialFailure, &plugin.InitError{
+						Reasons: []string{"not ready"},
+					}
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			RetryPolicy: &resource.RetryPolicy{MaxRetries: 2, InitialDelay: 0.001},
+		})
+		return err
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&creates))
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, []string{"not ready"}, snap.Resources[1].InitErrors)
+}
+
+// Tests that deletes use the retry policy recorded in the snapshot, even once the resource has been removed from the
+// program.
+func TestRetryPolicyDelete(t *testing.T) {
+	t.Parallel()
+
+	var deletes int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs resource.PropertyMap, timeout float64,
+				) (resource.Status, error) {
+					if atomic.AddInt32(&deletes, 1) < 2 {
+						return resource.StatusOK, errors.New("eventual consistency")
+					}
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	createResource := true
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if createResource {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+				RetryPolicy: &resource.RetryPolicy{MaxRetries: 1, InitialDelay: 0.001},
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	createResource = false
+	snap, err = TestOp(Update).Run(p.GetProject(), p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&deletes))
+	assert.Empty(t, snap.Resources)
+}
+
+// Tests that errors the provider reports as transient are retried even if the resource has no retry policy.
+func TestRetryTransientErrorsByDefault(t *testing.T) {
+	t.Parallel()
+
+	var creates int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					if atomic.AddInt32(&creates, 1) < 2 {
+						return "", nil, resource.StatusOK, rpcerror.New(codes.Unavailable, "service unavailable")
+					}
+					return "created-id", news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&creates))
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, resource.ID("created-id"), snap.Resources[1].ID)
+}
+
+// Tests that a retry policy with patterns only retries the errors they match.
+func TestRetryPolicyRetryOn(t *testing.T) {
+	t.Parallel()
+
+	var creates int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					if atomic.AddInt32(&creates, 1) < 2 {
+						return "", nil, resource.StatusOK, errors.New("request was throttled")
+					}
+					return "", nil, resource.StatusOK, errors.New("access denied")
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			RetryPolicy: &resource.RetryPolicy{MaxRetries: 3, InitialDelay: 0.001, RetryOn: []string{"throttl(ed|ing)"}},
+		})
+		assert.Error(t, err)
+		return err
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	_, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.ErrorContains(t, err, "access denied")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&creates))
+}
+
+// Tests that resources without a retry policy of their own use the policy of their provider.
+func TestRetryPolicyFromProvider(t *testing.T) {
+	t.Parallel()
+
+	var creates int32
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					if urn.Type() == "pkgA:m:typA" && atomic.AddInt32(&creates, 1) < 3 {
+						return "", nil, resource.StatusOK, errors.New("eventual consistency")
+					}
+					return "created-id", news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		provURN, provID, _, err := monitor.RegisterResource(providers.MakeProviderType("pkgA"), "provA", true,
+			deploytest.ResourceOptions{
+				RetryPolicy: &resource.RetryPolicy{InitialDelay: 0.001, RetryOn: []string{"eventual consistency"}},
+			})
+		require.NoError(t, err)
+		provRef, err := providers.NewReference(provURN, provID)
+		require.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Provider: provRef.String(),
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	_, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&creates))
+}
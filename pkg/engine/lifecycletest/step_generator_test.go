@@ -1,7 +1,9 @@
 package lifecycletest
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
@@ -9,6 +11,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -366,3 +369,114 @@ func TestReadNilOutputs(t *testing.T) {
 				"BAIL: step executor errored: step application failed: resource 'my-resource-id' does not exist")
 		})
 }
+
+// TestSpeculativeDiffs tests that a preview diffs independent resources concurrently, and doesn't call the provider
+// more often than it would otherwise.
+func TestSpeculativeDiffs(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	checks, diffs, inFlight := 0, 0, 0
+	overlapped := make(chan struct{})
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CheckF: func(urn resource.URN,
+					olds, news resource.PropertyMap, randomSeed []byte,
+				) (resource.PropertyMap, []plugin.CheckFailure, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					checks++
+					return news, nil, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs, newInputs resource.PropertyMap, ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					mu.Lock()
+					diffs++
+					if urn.Name() == "resA" {
+						mu.Unlock()
+						return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+					}
+					inFlight++
+					if inFlight == 2 {
+						close(overlapped)
+					}
+					mu.Unlock()
+
+					// Wait for the other resource's diff, which only comes while this one's is in flight if the two
+					// are made concurrently.
+					select {
+					case <-overlapped:
+					case <-time.After(10 * time.Second):
+					}
+
+					mu.Lock()
+					inFlight--
+					mu.Unlock()
+					return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+				},
+			}, nil
+		}),
+	}
+
+	value := "old"
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		inputs := resource.PropertyMap{"value": resource.NewStringProperty(value)}
+
+		// Register one resource on its own so that the default provider is loaded before the others register.
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for _, name := range []string{"resB", "resC"} {
+			name := name
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, true, deploytest.ResourceOptions{
+					Inputs: inputs,
+				})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF, UpdateOptions: UpdateOptions{Parallel: 4}},
+	}
+	project := p.GetProject()
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	mu.Lock()
+	checks, diffs = 0, 0
+	mu.Unlock()
+	value = "new"
+
+	_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			updates := 0
+			for _, e := range events {
+				if payload, ok := e.Payload().(ResourcePreEventPayload); ok && payload.Metadata.Op == deploy.OpUpdate {
+					updates++
+				}
+			}
+			assert.Equal(t, 3, updates)
+			return err
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-overlapped:
+	default:
+		assert.Fail(t, "expected the diffs of resB and resC to overlap")
+	}
+	assert.Equal(t, 3, checks)
+	assert.Equal(t, 3, diffs)
+}
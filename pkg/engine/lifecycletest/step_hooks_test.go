@@ -0,0 +1,116 @@
+package lifecycletest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// recordingStepHook is a step hook that records the events it is called with, and vetoes the steps on the resources
+// with the given name.
+type recordingStepHook struct {
+	veto string
+
+	m      sync.Mutex
+	events []deploy.StepHookEvent
+}
+
+func (h *recordingStepHook) Name() string {
+	return "recording"
+}
+
+func (h *recordingStepHook) OnStep(event deploy.StepHookEvent) (deploy.StepHookResult, error) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	h.events = append(h.events, event)
+
+	if event.URN.Name() == h.veto {
+		return deploy.StepHookResult{Veto: true, Message: "outside the change window"}, nil
+	}
+	return deploy.StepHookResult{Message: "recorded " + string(event.Phase)}, nil
+}
+
+// phases returns the phases the hook was called in for the resource with the given name.
+func (h *recordingStepHook) phases(name string) []deploy.StepHookPhase {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	var phases []deploy.StepHookPhase
+	for _, e := range h.events {
+		if e.URN.Name() == name {
+			phases = append(phases, e.Phase)
+		}
+	}
+	return phases
+}
+
+// Tests that step hooks are called before and after each step, and can veto steps.
+func TestStepHooks(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true)
+		assert.ErrorContains(t, err, "registering resource")
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	hook := &recordingStepHook{veto: "resB"}
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF, UpdateOptions: UpdateOptions{
+			StepHooks: []deploy.StepHook{hook},
+		}},
+	}
+
+	var vetoed, annotated bool
+	snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			for _, e := range events {
+				if p, ok := e.Payload().(DiagEventPayload); ok {
+					if p.Severity == diag.Error &&
+						strings.Contains(p.Message, "step hook 'recording' vetoed the create of this resource") &&
+						strings.Contains(p.Message, "outside the change window") {
+						vetoed = true
+					}
+					if p.Severity == diag.Info && strings.Contains(p.Message, "recording: recorded post") {
+						annotated = true
+					}
+				}
+			}
+			return err
+		})
+	require.Error(t, err)
+	assert.True(t, vetoed)
+	assert.True(t, annotated)
+
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, "default", snap.Resources[0].URN.Name())
+	assert.Equal(t, "resA", snap.Resources[1].URN.Name())
+
+	assert.Equal(t, []deploy.StepHookPhase{deploy.StepHookPre, deploy.StepHookPost}, hook.phases("resA"))
+	assert.Equal(t, []deploy.StepHookPhase{deploy.StepHookPre}, hook.phases("resB"))
+	for _, e := range hook.events {
+		assert.False(t, e.Preview)
+		assert.Equal(t, e.URN.Type(), e.Type)
+	}
+}
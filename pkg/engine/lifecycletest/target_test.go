@@ -1953,3 +1953,129 @@ func TestTargetDestroyChildDeleteFails(t *testing.T) {
 	assert.Error(t, err)
 	validateSnap(snap)
 }
+
+func TestExcludeTargets(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	value := "one"
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pulumi:pulumi:Stack", "test", false)
+		assert.NoError(t, err)
+
+		inputs := resource.PropertyMap{"foo": resource.NewStringProperty(value)}
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		assert.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		assert.NoError(t, err)
+
+		return nil
+	})
+
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+	p := &TestPlan{}
+
+	project := p.GetProject()
+
+	// Excluding resB from a fresh update only creates resA.
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), TestUpdateOptions{
+		HostF: hostF,
+		UpdateOptions: UpdateOptions{
+			Targets: deploy.UrnTargets{}.Excluding([]string{"urn:pulumi:test::test::pkgA:m:typA::resB"}),
+		},
+	}, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	// Check we only have three resources, stack, provider, and resA
+	require.Equal(t, 3, len(snap.Resources))
+
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), TestUpdateOptions{HostF: hostF},
+		false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(snap.Resources))
+
+	// Change the inputs of both resources but exclude resB, which should keep its old inputs.
+	value = "two"
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), TestUpdateOptions{
+		HostF: hostF,
+		UpdateOptions: UpdateOptions{
+			Targets: deploy.UrnTargets{}.Excluding([]string{"**resB"}),
+		},
+	}, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(snap.Resources))
+	for _, res := range snap.Resources {
+		switch res.URN.Name() {
+		case "resA":
+			assert.Equal(t, "two", res.Inputs["foo"].StringValue())
+		case "resB":
+			assert.Equal(t, "one", res.Inputs["foo"].StringValue())
+		}
+	}
+}
+
+// TestExcludeTargetsRefreshDestroy checks that refreshes and destroys that only exclude resources work on empty
+// stacks, and that excluded URNs must name resources in the stack like targeted URNs.
+func TestExcludeTargetsRefreshDestroy(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+	p := &TestPlan{}
+	project := p.GetProject()
+
+	resB := p.NewURN("pkgA:m:typA", "resB", "")
+	excludeOpts := func(excludes ...string) TestUpdateOptions {
+		return TestUpdateOptions{
+			HostF: hostF,
+			UpdateOptions: UpdateOptions{
+				Targets: deploy.UrnTargets{}.Excluding(excludes),
+			},
+		}
+	}
+
+	// Excluding resources from an empty stack does nothing.
+	_, err := TestOp(Refresh).Run(project, p.GetTarget(t, nil), excludeOpts(string(resB)), false, p.BackendClient, nil)
+	require.NoError(t, err)
+	_, err = TestOp(Destroy).Run(project, p.GetTarget(t, nil), excludeOpts(string(resB)), false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), TestUpdateOptions{HostF: hostF},
+		false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 3)
+
+	// Excluded URNs that aren't in the stack are rejected.
+	missing := p.NewURN("pkgA:m:typA", "resC", "")
+	_, err = TestOp(Destroy).Run(project, p.GetTarget(t, snap), excludeOpts(string(missing)), false, p.BackendClient,
+		nil)
+	assert.ErrorContains(t, err, fmt.Sprintf("no resource named '%s' found", missing))
+
+	// Destroying everything but resB and its provider leaves just those.
+	snap, err = TestOp(Destroy).Run(project, p.GetTarget(t, snap), excludeOpts(string(resB), "**pulumi:providers:pkgA**"),
+		false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, resB, snap.Resources[1].URN)
+}
@@ -0,0 +1,441 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// ProviderResponseMode is the way a preview uses a set of provider responses.
+type ProviderResponseMode int
+
+const (
+	// RecordProviderResponses calls providers as usual, and records their responses.
+	RecordProviderResponses ProviderResponseMode = iota
+	// ReplayProviderResponses answers calls with the recorded responses instead of calling providers.
+	ReplayProviderResponses
+)
+
+// ProviderResponse is the recorded response to a call to a provider.
+type ProviderResponse struct {
+	// ID holds the ID returned by Create or Read.
+	ID resource.ID
+	// Inputs holds the inputs returned by Read.
+	Inputs resource.PropertyMap
+	// Properties holds the inputs returned by Check or CheckConfig, or the outputs returned by Invoke, Create, Update
+	// or Read.
+	Properties resource.PropertyMap
+	// Failures holds the check failures returned by Check, CheckConfig or Invoke.
+	Failures []plugin.CheckFailure
+	// Diff holds the result of Diff or DiffConfig.
+	Diff *plugin.DiffResult
+	// Error holds the message of the error returned by the call, if any.
+	Error string
+	// DiffUnavailable is true if the error returned by Diff said that the diff is unavailable.
+	DiffUnavailable bool
+}
+
+// err returns the error the call returned, if any.
+func (r *ProviderResponse) err() error {
+	switch {
+	case r.DiffUnavailable:
+		return plugin.DiffUnavailable(r.Error)
+	case r.Error != "":
+		return errors.New(r.Error)
+	default:
+		return nil
+	}
+}
+
+// ProviderResponses holds the responses that providers give to the calls of a preview, keyed by a hash of each call.
+// One preview records them so that a later preview of the same program, configuration and state can replay them
+// instead of calling the providers, and so show exactly the same changes even where the providers can't reach the
+// resources they manage. Providers aren't loaded at all when replaying.
+type ProviderResponses struct {
+	mode ProviderResponseMode
+
+	m         sync.Mutex
+	responses map[string]*ProviderResponse
+}
+
+// NewProviderResponses returns a set of provider responses that is used in the given mode. When replaying, the given
+// responses are the ones to replay; when recording, they are usually empty.
+func NewProviderResponses(mode ProviderResponseMode, responses map[string]*ProviderResponse) *ProviderResponses {
+	if responses == nil {
+		responses = make(map[string]*ProviderResponse)
+	}
+	return &ProviderResponses{mode: mode, responses: responses}
+}
+
+// Mode returns the way the responses are used.
+func (r *ProviderResponses) Mode() ProviderResponseMode {
+	return r.mode
+}
+
+// Responses returns a copy of the responses, keyed by a hash of the call they respond to.
+func (r *ProviderResponses) Responses() map[string]*ProviderResponse {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	responses := make(map[string]*ProviderResponse, len(r.responses))
+	for k, v := range r.responses {
+		responses[k] = v
+	}
+	return responses
+}
+
+// record records the response to the call with the given key.
+func (r *ProviderResponses) record(key string, response *ProviderResponse, callErr error) {
+	if callErr != nil {
+		_, response.DiffUnavailable = callErr.(plugin.DiffUnavailableError)
+		response.Error = callErr.Error()
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.responses[key] = response
+}
+
+// replay returns the recorded response to the call with the given key.
+func (r *ProviderResponses) replay(key string) (*ProviderResponse, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	response, ok := r.responses[key]
+	if !ok {
+		return nil, fmt.Errorf("no provider response was recorded for %v; the program, its configuration "+
+			"or the stack's state have changed since the responses were recorded", key)
+	}
+	return response, nil
+}
+
+// callKey returns the key that identifies a call by its method, its subject and its arguments. The arguments are
+// hashed, so that the key is short and doesn't reveal secret values.
+func callKey(method, subject string, props []resource.PropertyMap, args ...string) (string, error) {
+	h := sha256.New()
+	for _, p := range props {
+		s, err := plugin.MarshalProperties(p, plugin.MarshalOptions{
+			KeepUnknowns:     true,
+			KeepSecrets:      true,
+			KeepResources:    true,
+			KeepOutputValues: true,
+		})
+		if err != nil {
+			return "", err
+		}
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%d:", len(b))
+		h.Write(b)
+	}
+	for _, a := range args {
+		fmt.Fprintf(h, "%d:%s", len(a), a)
+	}
+	return fmt.Sprintf("%s %s %x", method, subject, h.Sum(nil)), nil
+}
+
+// providerResponseHost is a plugin host whose providers record or replay their responses. When replaying, it doesn't
+// load providers, and instead returns ones that can only answer from the responses.
+type providerResponseHost struct {
+	plugin.Host
+
+	responses *ProviderResponses
+}
+
+func (host *providerResponseHost) Provider(pkg tokens.Package, version *semver.Version) (plugin.Provider, error) {
+	if host.responses.mode == ReplayProviderResponses {
+		return &providerResponseProvider{
+			Provider:  &replayOnlyProvider{pkg: pkg, version: version},
+			pkg:       pkg,
+			responses: host.responses,
+		}, nil
+	}
+
+	provider, err := host.Host.Provider(pkg, version)
+	if err != nil || provider == nil {
+		return provider, err
+	}
+	return &providerResponseProvider{Provider: provider, pkg: pkg, responses: host.responses}, nil
+}
+
+func (host *providerResponseHost) CloseProvider(provider plugin.Provider) error {
+	if p, ok := provider.(*providerResponseProvider); ok {
+		provider = p.Provider
+	}
+	if _, ok := provider.(*replayOnlyProvider); ok {
+		return nil
+	}
+	return host.Host.CloseProvider(provider)
+}
+
+// replayOnlyProvider stands in for a provider that isn't loaded because its responses are being replayed. Calls that
+// can't be replayed fail.
+type replayOnlyProvider struct {
+	plugin.UnimplementedProvider
+
+	pkg     tokens.Package
+	version *semver.Version
+}
+
+func (p *replayOnlyProvider) Pkg() tokens.Package {
+	return p.pkg
+}
+
+func (p *replayOnlyProvider) GetPluginInfo() (workspace.PluginInfo, error) {
+	return workspace.PluginInfo{Name: string(p.pkg), Kind: workspace.ResourcePlugin, Version: p.version}, nil
+}
+
+func (p *replayOnlyProvider) Close() error {
+	return nil
+}
+
+func (p *replayOnlyProvider) SignalCancellation() error {
+	return nil
+}
+
+// providerResponseProvider is a provider that records or replays its responses.
+type providerResponseProvider struct {
+	plugin.Provider
+
+	// pkg is the package the provider was loaded for.
+	pkg       tokens.Package
+	responses *ProviderResponses
+	// config identifies the provider's configuration, so that invokes of differently-configured providers of the same
+	// package are told apart.
+	config string
+}
+
+// replaying returns true if the provider's responses are replayed rather than recorded.
+func (p *providerResponseProvider) replaying() bool {
+	return p.responses.mode == ReplayProviderResponses
+}
+
+func (p *providerResponseProvider) CheckConfig(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool,
+) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	key, err := callKey("checkConfig", string(urn), []resource.PropertyMap{olds, news}, strconv.FormatBool(allowUnknowns))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if p.replaying() {
+		response, err := p.responses.replay(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		return response.Properties, response.Failures, response.err()
+	}
+
+	inputs, failures, checkErr := p.Provider.CheckConfig(urn, olds, news, allowUnknowns)
+	p.responses.record(key, &ProviderResponse{Properties: inputs, Failures: failures}, checkErr)
+	return inputs, failures, checkErr
+}
+
+func (p *providerResponseProvider) DiffConfig(urn resource.URN, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string,
+) (plugin.DiffResult, error) {
+	key, err := callKey("diffConfig", string(urn), []resource.PropertyMap{oldInputs, oldOutputs, newInputs},
+		strconv.FormatBool(allowUnknowns), strings.Join(ignoreChanges, ","))
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+
+	if p.replaying() {
+		return p.replayDiff(key)
+	}
+
+	diff, diffErr := p.Provider.DiffConfig(urn, oldInputs, oldOutputs, newInputs, allowUnknowns, ignoreChanges)
+	p.responses.record(key, &ProviderResponse{Diff: &diff}, diffErr)
+	return diff, diffErr
+}
+
+func (p *providerResponseProvider) Configure(inputs resource.PropertyMap) error {
+	config, err := callKey("configure", string(p.pkg), []resource.PropertyMap{inputs})
+	if err != nil {
+		return err
+	}
+	p.config = config
+
+	// The configuration is only needed by the provider itself, so there's nothing to do when replaying.
+	if p.replaying() {
+		return nil
+	}
+	return p.Provider.Configure(inputs)
+}
+
+func (p *providerResponseProvider) Check(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte,
+) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	// The random seed isn't part of the key, as new resources are given a different one on every preview.
+	key, err := callKey("check", string(urn), []resource.PropertyMap{olds, news}, strconv.FormatBool(allowUnknowns))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if p.replaying() {
+		response, err := p.responses.replay(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		return response.Properties, response.Failures, response.err()
+	}
+
+	inputs, failures, checkErr := p.Provider.Check(urn, olds, news, allowUnknowns, randomSeed)
+	p.responses.record(key, &ProviderResponse{Properties: inputs, Failures: failures}, checkErr)
+	return inputs, failures, checkErr
+}
+
+func (p *providerResponseProvider) Diff(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, allowUnknowns bool, ignoreChanges []string,
+) (plugin.DiffResult, error) {
+	key, err := callKey("diff", string(urn), []resource.PropertyMap{oldInputs, oldOutputs, newInputs},
+		string(id), strconv.FormatBool(allowUnknowns), strings.Join(ignoreChanges, ","))
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+
+	if p.replaying() {
+		return p.replayDiff(key)
+	}
+
+	diff, diffErr := p.Provider.Diff(urn, id, oldInputs, oldOutputs, newInputs, allowUnknowns, ignoreChanges)
+	p.responses.record(key, &ProviderResponse{Diff: &diff}, diffErr)
+	return diff, diffErr
+}
+
+// replayDiff returns the recorded response to the Diff or DiffConfig call with the given key.
+func (p *providerResponseProvider) replayDiff(key string) (plugin.DiffResult, error) {
+	response, err := p.responses.replay(key)
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+	var diff plugin.DiffResult
+	if response.Diff != nil {
+		diff = *response.Diff
+	}
+	return diff, response.err()
+}
+
+// Create is only called to preview the creation of a resource, as providers are only wrapped for previews.
+func (p *providerResponseProvider) Create(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	key, err := callKey("create", string(urn), []resource.PropertyMap{news}, strconv.FormatBool(preview))
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+
+	if p.replaying() {
+		response, err := p.responses.replay(key)
+		if err != nil {
+			return "", nil, resource.StatusOK, err
+		}
+		return response.ID, response.Properties, responseStatus(response), response.err()
+	}
+
+	id, outs, status, createErr := p.Provider.Create(urn, news, timeout, preview)
+	p.responses.record(key, &ProviderResponse{ID: id, Properties: outs}, createErr)
+	return id, outs, status, createErr
+}
+
+// Update is only called to preview the update of a resource, as providers are only wrapped for previews.
+func (p *providerResponseProvider) Update(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+) (resource.PropertyMap, resource.Status, error) {
+	key, err := callKey("update", string(urn), []resource.PropertyMap{oldInputs, oldOutputs, newInputs},
+		string(id), strings.Join(ignoreChanges, ","), strconv.FormatBool(preview))
+	if err != nil {
+		return nil, resource.StatusOK, err
+	}
+
+	if p.replaying() {
+		response, err := p.responses.replay(key)
+		if err != nil {
+			return nil, resource.StatusOK, err
+		}
+		return response.Properties, responseStatus(response), response.err()
+	}
+
+	outs, status, updateErr := p.Provider.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges,
+		preview)
+	p.responses.record(key, &ProviderResponse{Properties: outs}, updateErr)
+	return outs, status, updateErr
+}
+
+func (p *providerResponseProvider) Read(urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap,
+) (plugin.ReadResult, resource.Status, error) {
+	key, err := callKey("read", string(urn), []resource.PropertyMap{inputs, state}, string(id))
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusOK, err
+	}
+
+	if p.replaying() {
+		response, err := p.responses.replay(key)
+		if err != nil {
+			return plugin.ReadResult{}, resource.StatusOK, err
+		}
+		read := plugin.ReadResult{ID: response.ID, Inputs: response.Inputs, Outputs: response.Properties}
+		return read, responseStatus(response), response.err()
+	}
+
+	read, status, readErr := p.Provider.Read(urn, id, inputs, state)
+	p.responses.record(key, &ProviderResponse{ID: read.ID, Inputs: read.Inputs, Properties: read.Outputs}, readErr)
+	return read, status, readErr
+}
+
+// responseStatus returns the status to report alongside a replayed response to a Create, Update or Read call.
+func responseStatus(response *ProviderResponse) resource.Status {
+	if response.err() != nil {
+		return resource.StatusUnknown
+	}
+	return resource.StatusOK
+}
+
+func (p *providerResponseProvider) Invoke(tok tokens.ModuleMember,
+	args resource.PropertyMap,
+) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	key, err := callKey("invoke", string(tok), []resource.PropertyMap{args}, p.config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if p.replaying() {
+		response, err := p.responses.replay(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		return response.Properties, response.Failures, response.err()
+	}
+
+	outs, failures, invokeErr := p.Provider.Invoke(tok, args)
+	p.responses.record(key, &ProviderResponse{Properties: outs, Failures: failures}, invokeErr)
+	return outs, failures, invokeErr
+}
@@ -137,10 +137,24 @@ type UpdateOptions struct {
 	// Specific resources to update during a deployment.
 	Targets deploy.UrnTargets
 
+	// RefreshProperties, if set, limits a refresh to the given properties of each refreshed resource; the rest of its
+	// state is left as it was.
+	RefreshProperties []resource.PropertyPath
+
 	// true if we're allowing dependent targets to change, even if not specified in one of the above
 	// XXXTargets lists.
 	TargetDependents bool
 
+	// true to keep running the steps that don't depend on a failed step, rather than stopping at the first failure.
+	ContinueOnError bool
+
+	// The hooks to call before and after each step, which may veto steps before they are applied.
+	StepHooks []deploy.StepHook
+
+	// ProviderResponses, if set, records the responses of providers to calls, or replays previously recorded responses
+	// instead of loading and calling providers. Only previews use them.
+	ProviderResponses *ProviderResponses
+
 	// true if the engine should use legacy diffing behavior during an update.
 	UseLegacyDiff bool
 
@@ -164,6 +178,10 @@ type UpdateOptions struct {
 
 	// Experimental is true if the engine is in experimental mode (i.e. PULUMI_EXPERIMENTAL was set)
 	Experimental bool
+
+	// SecretScan controls what happens to the plaintext outputs of resources that look like secrets. If empty, it's
+	// read from PULUMI_SECRET_SCAN.
+	SecretScan deploy.SecretScanMode
 }
 
 // HasChanges returns true if there are any non-same changes in the resulting summary.
@@ -644,6 +662,15 @@ func (acts *updateActions) OnResourceStepPost(
 		}
 	}
 
+	// If the provider's operation timed out and was cancelled, we don't know whether it took effect. Leave the
+	// mutation unfinished so that its pending operation stays in the checkpoint for `pulumi refresh` to resolve.
+	var timeoutErr *plugin.OperationTimeoutError
+	if errors.As(err, &timeoutErr) {
+		logging.V(7).Infof("OnResourceStepPost(%s): %s timed out, leaving its operation pending",
+			step.URN(), timeoutErr.Operation)
+		return nil
+	}
+
 	// Write out the current snapshot. Note that even if a failure has occurred, we should still have a
 	// safe checkpoint.  Note that any error that occurs when writing the checkpoint trumps the error
 	// reported above.
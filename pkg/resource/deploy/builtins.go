@@ -57,6 +57,10 @@ func (p *builtinProvider) GetMappings(key string) ([]string, error) {
 	return []string{}, nil
 }
 
+func (p *builtinProvider) Discover(req plugin.DiscoverRequest) (plugin.DiscoverResult, error) {
+	return plugin.DiscoverResult{}, errors.New("the builtin provider does not support resource discovery")
+}
+
 // CheckConfig validates the configuration for this resource provider.
 func (p *builtinProvider) CheckConfig(urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool,
@@ -258,7 +262,16 @@ func (p *builtinProvider) readStackReference(inputs resource.PropertyMap) (resou
 		return nil, errors.New("no backend client is available")
 	}
 
-	outputs, err := p.backendClient.GetStackOutputs(p.context, name.StringValue())
+	// Fetch the outputs and their metadata in one go if the backend client supports it, so that the referenced stack
+	// is only read once.
+	var outputs resource.PropertyMap
+	var metadata resource.OutputMetadataMap
+	var err error
+	if client, ok := p.backendClient.(OutputMetadataBackendClient); ok {
+		outputs, metadata, err = client.GetStackOutputsWithMetadata(p.context, name.StringValue())
+	} else {
+		outputs, err = p.backendClient.GetStackOutputs(p.context, name.StringValue())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -275,11 +288,29 @@ func (p *builtinProvider) readStackReference(inputs resource.PropertyMap) (resou
 		return secretOutputs[i].String() < secretOutputs[j].String()
 	})
 
-	return resource.PropertyMap{
+	state := resource.PropertyMap{
 		"name":              name,
 		"outputs":           resource.NewObjectProperty(outputs),
 		"secretOutputNames": resource.NewArrayProperty(secretOutputs),
-	}, nil
+	}
+
+	// Only add the metadata of the outputs if the stack recorded any, so that references to stacks without it don't
+	// change.
+	if len(metadata) != 0 {
+		outputMetadata := resource.PropertyMap{}
+		for k, md := range metadata {
+			v := resource.PropertyMap{}
+			if md.Description != "" {
+				v["description"] = resource.NewStringProperty(md.Description)
+			}
+			if md.Schema != "" {
+				v["schema"] = resource.NewStringProperty(md.Schema)
+			}
+			outputMetadata[k] = resource.NewObjectProperty(v)
+		}
+		state["outputMetadata"] = resource.NewObjectProperty(outputMetadata)
+	}
+	return state, nil
 }
 
 func (p *builtinProvider) readStackResourceOutputs(inputs resource.PropertyMap) (resource.PropertyMap, error) {
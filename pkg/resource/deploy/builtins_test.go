@@ -24,6 +24,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBuiltinProvider(t *testing.T) {
@@ -179,6 +180,50 @@ func TestBuiltinProvider(t *testing.T) {
 
 				assert.Equal(t, "foo", out["outputs"].ObjectValue()["normal"].StringValue())
 				assert.Len(t, out["secretOutputNames"].V, 1)
+				assert.NotContains(t, out, resource.PropertyKey("outputMetadata"))
+			})
+			t.Run("metadata", func(t *testing.T) {
+				t.Parallel()
+				p := &builtinProvider{
+					backendClient: &deploytest.BackendClient{
+						GetStackOutputsF: func(ctx context.Context, name string) (resource.PropertyMap, error) {
+							return resource.PropertyMap{"normal": resource.NewStringProperty("foo")}, nil
+						},
+						GetStackOutputMetadataF: func(
+							ctx context.Context, name string,
+						) (resource.OutputMetadataMap, error) {
+							return resource.OutputMetadataMap{
+								"normal": {Description: "A normal output.", Schema: `{"type":"string"}`},
+							}, nil
+						},
+					},
+				}
+				out, _, err := p.Invoke(readStackOutputs, resource.PropertyMap{
+					"name": resource.NewStringProperty("res-name"),
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+					"normal": map[string]interface{}{
+						"description": "A normal output.",
+						"schema":      `{"type":"string"}`,
+					},
+				}), out["outputMetadata"].ObjectValue())
+			})
+			t.Run("metadata unsupported", func(t *testing.T) {
+				t.Parallel()
+				p := &builtinProvider{
+					backendClient: outputsOnlyBackendClient{outputs: resource.PropertyMap{
+						"normal": resource.NewStringProperty("foo"),
+					}},
+				}
+				out, _, err := p.Invoke(readStackOutputs, resource.PropertyMap{
+					"name": resource.NewStringProperty("res-name"),
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, "foo", out["outputs"].ObjectValue()["normal"].StringValue())
+				assert.NotContains(t, out, resource.PropertyKey("outputMetadata"))
 			})
 		})
 		t.Run(readStackResourceOutputs, func(t *testing.T) {
@@ -256,3 +301,18 @@ func TestBuiltinProvider(t *testing.T) {
 		assert.NoError(t, p.SignalCancellation())
 	})
 }
+
+// outputsOnlyBackendClient is a BackendClient that doesn't implement OutputMetadataBackendClient.
+type outputsOnlyBackendClient struct {
+	outputs resource.PropertyMap
+}
+
+func (c outputsOnlyBackendClient) GetStackOutputs(ctx context.Context, name string) (resource.PropertyMap, error) {
+	return c.outputs, nil
+}
+
+func (c outputsOnlyBackendClient) GetStackResourceOutputs(
+	ctx context.Context, name string,
+) (resource.PropertyMap, error) {
+	return resource.PropertyMap{}, nil
+}
@@ -49,6 +49,18 @@ type BackendClient interface {
 	GetStackResourceOutputs(ctx context.Context, stackName string) (resource.PropertyMap, error)
 }
 
+// OutputMetadataBackendClient is a BackendClient that can also return the metadata of a stack's outputs. Backend
+// clients that don't implement it are treated as having no output metadata.
+type OutputMetadataBackendClient interface {
+	BackendClient
+
+	// GetStackOutputsWithMetadata returns the outputs (if any) for the named stack along with their metadata (if any),
+	// or an error if the stack cannot be found.
+	GetStackOutputsWithMetadata(
+		ctx context.Context, name string,
+	) (resource.PropertyMap, resource.OutputMetadataMap, error)
+}
+
 // Options controls the deployment process.
 type Options struct {
 	Events                    Events     // an optional events callback interface.
@@ -58,11 +70,18 @@ type Options struct {
 	Targets                   UrnTargets // If specified, only operate on specified resources.
 	ReplaceTargets            UrnTargets // If specified, mark the specified resources for replacement.
 	TargetDependents          bool       // true if we're allowing things to proceed, even with unspecified targets
+	ContinueOnError           bool       // true to keep running the steps that don't depend on a failed one.
 	TrustDependencies         bool       // whether or not to trust the resource dependency graph.
 	UseLegacyDiff             bool       // whether or not to use legacy diffing behavior.
 	DisableResourceReferences bool       // true to disable resource reference support.
 	DisableOutputValues       bool       // true to disable output value support.
 	GeneratePlan              bool       // true to enable plan generation.
+	// SecretScan controls what happens to the plaintext outputs of resources that look like secrets.
+	SecretScan SecretScanMode
+	// StepHooks are called before and after each step, and may veto steps before they are applied.
+	StepHooks []StepHook
+	// RefreshProperties, if set, are the only properties of each resource that a refresh writes back to its state.
+	RefreshProperties []resource.PropertyPath
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -88,6 +107,9 @@ type UrnTargets struct {
 
 	literals []resource.URN
 	globs    map[string]*regexp.Regexp
+
+	// excludes is the set of URNs that are never contained in the targets, if any.
+	excludes *UrnTargets
 }
 
 // Create a new set of targets.
@@ -105,12 +127,30 @@ func NewUrnTargets(urnOrGlobs []string) UrnTargets {
 			literals = append(literals, resource.URN(urn))
 		}
 	}
-	return UrnTargets{literals, globs}
+	return UrnTargets{literals: literals, globs: globs}
+}
+
+// Excluding returns a copy of the targets that doesn't contain any of the given URNs or globs, which are interpreted
+// as for NewUrnTargets. Excluding resources constrains an otherwise unconstrained set.
+func (t UrnTargets) Excluding(urnOrGlobs []string) UrnTargets {
+	if len(urnOrGlobs) == 0 {
+		return t
+	}
+	excludes := NewUrnTargets(urnOrGlobs)
+	if t.excludes != nil {
+		excludes.literals = append(excludes.literals, t.excludes.literals...)
+		for glob := range t.excludes.globs {
+			excludes.globs[glob] = nil
+		}
+	}
+	result := t.Clone()
+	result.excludes = &excludes
+	return result
 }
 
 // Create a new set of targets from fully resolved URNs.
 func NewUrnTargetsFromUrns(urns []resource.URN) UrnTargets {
-	return UrnTargets{urns, nil}
+	return UrnTargets{literals: urns}
 }
 
 // Return a copy of the UrnTargets
@@ -123,11 +163,17 @@ func (t UrnTargets) Clone() UrnTargets {
 	return UrnTargets{
 		literals: newLiterals,
 		globs:    newGlobs,
+		excludes: t.excludes,
 	}
 }
 
 // Return if the target set constrains the set of acceptable URNs.
 func (t UrnTargets) IsConstrained() bool {
+	return t.HasIncludes() || t.excludes != nil
+}
+
+// Return if the target set lists the URNs that it contains, as opposed to containing all URNs that aren't excluded.
+func (t UrnTargets) HasIncludes() bool {
 	return len(t.literals) > 0 || len(t.globs) > 0
 }
 
@@ -157,7 +203,10 @@ func (t UrnTargets) getMatcher(glob string) *regexp.Regexp {
 //
 // If method receiver is not initialized, `true` is always returned.
 func (t UrnTargets) Contains(urn resource.URN) bool {
-	if !t.IsConstrained() {
+	if t.Excludes(urn) {
+		return false
+	}
+	if !t.HasIncludes() {
 		return true
 	}
 	for _, literal := range t.literals {
@@ -173,6 +222,11 @@ func (t UrnTargets) Contains(urn resource.URN) bool {
 	return false
 }
 
+// Check if the URN is explicitly excluded from the targets.
+func (t UrnTargets) Excludes(urn resource.URN) bool {
+	return t.excludes != nil && t.excludes.Contains(urn)
+}
+
 // URN literals specified as targets.
 //
 // It doesn't make sense to iterate over all targets, since the list of targets may be
@@ -181,9 +235,17 @@ func (t UrnTargets) Literals() []resource.URN {
 	return t.literals
 }
 
-// Adds a literal iff t is already initialized.
+// URN literals specified as excludes.
+func (t UrnTargets) ExcludedLiterals() []resource.URN {
+	if t.excludes == nil {
+		return nil
+	}
+	return t.excludes.literals
+}
+
+// Adds a literal iff t already lists the URNs it contains.
 func (t *UrnTargets) addLiteral(urn resource.URN) {
-	if t.IsConstrained() {
+	if t.HasIncludes() {
 		t.literals = append(t.literals, urn)
 	}
 }
@@ -177,7 +177,7 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 	ctx, cancel := context.WithCancel(callerCtx)
 
 	// Set up a step generator and executor for this deployment.
-	ex.stepExec = newStepExecutor(ctx, cancel, ex.deployment, opts, preview, false)
+	ex.stepExec = newStepExecutor(ctx, cancel, ex.deployment, opts, preview, opts.ContinueOnError)
 
 	// We iterate the source in its own goroutine because iteration is blocking and we want the main loop to be able to
 	// respond to cancellation requests promptly.
@@ -185,10 +185,20 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 		Event SourceEvent
 		Error error
 	}
-	incomingEvents := make(chan nextEvent)
+	// When provider calls are made speculatively, the source is read ahead of the main loop so that the calls for
+	// the registrations that are waiting can be made while the main loop works through the ones before them.
+	var incomingEvents chan nextEvent
+	if ex.stepGen.speculator != nil {
+		incomingEvents = make(chan nextEvent, ex.stepGen.speculator.lookahead)
+	} else {
+		incomingEvents = make(chan nextEvent)
+	}
 	go func() {
 		for {
 			event, err := src.Next()
+			if err == nil && event != nil {
+				ex.stepGen.speculator.speculate(event)
+			}
 			select {
 			case incomingEvents <- nextEvent{event, err}:
 				if event == nil {
@@ -267,6 +277,9 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 
 	ex.stepExec.WaitForCompletion()
 	logging.V(4).Infof("deploymentExecutor.Execute(...): step executor has completed")
+	if opts.ContinueOnError {
+		ex.stepExec.reportFailures()
+	}
 
 	// Check that we did operations for everything expected in the plan. We mutate ResourcePlan.Ops as we run
 	// so by the time we get here everything in the map should have an empty ops list (except for unneeded
@@ -382,6 +395,14 @@ func (ex *deploymentExecutor) performDeletes(
 	// deleting but we won't until the previous set of deletes fully completes. This approximation
 	// is conservative, but correct.
 	for _, antichain := range deletes {
+		// When continuing on error, resources that are still depended on by resources that failed to be deleted are
+		// kept, too.
+		if ex.stepExec.continueOnError {
+			var blocked []Step
+			antichain, blocked = ex.stepExec.blockedDeletes(antichain)
+			ex.stepExec.skip(blocked)
+		}
+
 		logging.V(4).Infof("deploymentExecutor.Execute(...): beginning delete antichain")
 		tok := ex.stepExec.ExecuteParallel(antichain)
 		tok.Wait(ctx)
@@ -488,7 +509,7 @@ func (ex *deploymentExecutor) refresh(callerCtx context.Context, opts Options, p
 				return fmt.Errorf("could not load provider for resource %v: %w", res.URN, err)
 			}
 
-			step := NewRefreshStep(ex.deployment, res, nil)
+			step := newRefreshStep(ex.deployment, res, nil, opts.RefreshProperties)
 			steps = append(steps, step)
 			resourceToStep[res] = step
 		}
@@ -110,3 +110,39 @@ func TestGlobUrn(t *testing.T) {
 		})
 	}
 }
+
+func TestUrnTargetsExcluding(t *testing.T) {
+	t.Parallel()
+
+	const (
+		a = resource.URN("urn:pulumi:stack::test::typ$aws:resource::a")
+		b = resource.URN("urn:pulumi:stack::test::typ$aws:resource::b")
+		c = resource.URN("urn:pulumi:stack::test::typ$azure:resource::c")
+	)
+
+	// Excluding from an unconstrained set constrains it to everything but the exclusions.
+	targets := UrnTargets{}.Excluding([]string{string(a), "**azure**"})
+	assert.True(t, targets.IsConstrained())
+	assert.False(t, targets.Contains(a))
+	assert.True(t, targets.Contains(b))
+	assert.False(t, targets.Contains(c))
+	assert.True(t, targets.Excludes(c))
+
+	// Exclusions take precedence over targets.
+	targets = NewUrnTargets([]string{"**aws**"}).Excluding([]string{string(a)})
+	assert.False(t, targets.Contains(a))
+	assert.True(t, targets.Contains(b))
+	assert.False(t, targets.Contains(c))
+
+	// Exclusions accumulate and survive cloning.
+	targets = targets.Excluding([]string{string(b)}).Clone()
+	assert.False(t, targets.Contains(a))
+	assert.False(t, targets.Contains(b))
+
+	// Adding literals to a set that only has exclusions doesn't constrain it further.
+	targets = UrnTargets{}.Excluding([]string{string(a)})
+	targets.addLiteral(b)
+	assert.True(t, targets.Contains(c))
+
+	assert.Equal(t, UrnTargets{}, UrnTargets{}.Excluding(nil))
+}
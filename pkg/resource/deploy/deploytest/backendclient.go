@@ -23,6 +23,7 @@ import (
 // BackendClient provides a simple implementation of deploy.BackendClient that defers to a function value.
 type BackendClient struct {
 	GetStackOutputsF         func(ctx context.Context, name string) (resource.PropertyMap, error)
+	GetStackOutputMetadataF  func(ctx context.Context, name string) (resource.OutputMetadataMap, error)
 	GetStackResourceOutputsF func(ctx context.Context, name string) (resource.PropertyMap, error)
 }
 
@@ -31,6 +32,19 @@ func (b *BackendClient) GetStackOutputs(ctx context.Context, name string) (resou
 	return b.GetStackOutputsF(ctx, name)
 }
 
+// GetStackOutputsWithMetadata returns the outputs (if any) for the named stack along with their metadata (if any), or
+// an error if the stack cannot be found.
+func (b *BackendClient) GetStackOutputsWithMetadata(
+	ctx context.Context, name string,
+) (resource.PropertyMap, resource.OutputMetadataMap, error) {
+	outputs, err := b.GetStackOutputsF(ctx, name)
+	if err != nil || b.GetStackOutputMetadataF == nil {
+		return outputs, nil, err
+	}
+	metadata, err := b.GetStackOutputMetadataF(ctx, name)
+	return outputs, metadata, err
+}
+
 // GetStackResourceOutputs returns the resource outputs for a stack, or an error if the stack
 // cannot be found. Resources are retrieved from the latest stack snapshot, which may include
 // ongoing updates. They are returned in a `PropertyMap` mapping resource URN to another
@@ -74,6 +74,7 @@ type Provider struct {
 
 	GetMappingF  func(key, provider string) ([]byte, string, error)
 	GetMappingsF func(key string) ([]string, error)
+	DiscoverF    func(req plugin.DiscoverRequest) (plugin.DiscoverResult, error)
 }
 
 func (prov *Provider) SignalCancellation() error {
@@ -265,3 +266,10 @@ func (prov *Provider) GetMappings(key string) ([]string, error) {
 	}
 	return prov.GetMappingsF(key)
 }
+
+func (prov *Provider) Discover(req plugin.DiscoverRequest) (plugin.DiscoverResult, error) {
+	if prov.DiscoverF == nil {
+		return plugin.DiscoverResult{}, nil
+	}
+	return prov.DiscoverF(req)
+}
@@ -136,6 +136,8 @@ type ResourceOptions struct {
 	Aliases                 []resource.Alias
 	ImportID                resource.ID
 	CustomTimeouts          *resource.CustomTimeouts
+	RetryPolicy             *resource.RetryPolicy
+	Frozen                  bool
 	RetainOnDelete          bool
 	DeletedWith             resource.URN
 	SupportsPartialValues   *bool
@@ -225,6 +227,16 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		}
 	}
 
+	var retryPolicy *pulumirpc.RegisterResourceRequest_RetryPolicy
+	if opts.RetryPolicy != nil {
+		retryPolicy = &pulumirpc.RegisterResourceRequest_RetryPolicy{
+			MaxRetries:   int32(opts.RetryPolicy.MaxRetries),
+			InitialDelay: prepareTestTimeout(opts.RetryPolicy.InitialDelay),
+			MaxDelay:     prepareTestTimeout(opts.RetryPolicy.MaxDelay),
+			RetryOn:      opts.RetryPolicy.RetryOn,
+		}
+	}
+
 	deleteBeforeReplace := false
 	if opts.DeleteBeforeReplace != nil {
 		deleteBeforeReplace = *opts.DeleteBeforeReplace
@@ -265,6 +277,8 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		AliasURNs:                  aliasStrings,
 		ImportId:                   string(opts.ImportID),
 		CustomTimeouts:             timeouts,
+		RetryPolicy:                retryPolicy,
+		Frozen:                     opts.Frozen,
 		SupportsPartialValues:      supportsPartialValues,
 		Remote:                     opts.Remote,
 		ReplaceOnChanges:           opts.ReplaceOnChanges,
@@ -120,7 +120,10 @@ type noopOutputsEvent resource.URN
 func (noopOutputsEvent) event()                        {}
 func (e noopOutputsEvent) URN() resource.URN           { return resource.URN(e) }
 func (noopOutputsEvent) Outputs() resource.PropertyMap { return resource.PropertyMap{} }
-func (noopOutputsEvent) Done()                         {}
+func (noopOutputsEvent) OutputMetadata() map[resource.PropertyKey]resource.OutputMetadata {
+	return nil
+}
+func (noopOutputsEvent) Done() {}
 
 type importer struct {
 	deployment *Deployment
@@ -177,7 +180,7 @@ func (i *importer) getOrCreateStackResource(ctx context.Context) (resource.URN,
 	typ, name := resource.RootStackType, fmt.Sprintf("%s-%s", projectName, stackName)
 	urn := resource.NewURN(stackName.Q(), projectName, "", typ, name)
 	state := resource.NewState(typ, urn, false, false, "", resource.PropertyMap{}, nil, "", false, false, nil, nil, "",
-		nil, false, nil, nil, nil, "", false, "", nil, nil, "")
+		nil, false, nil, nil, nil, "", false, "", nil, nil, "", nil)
 	// TODO(seqnum) should stacks be created with 1? When do they ever get recreated/replaced?
 	if !i.executeSerial(ctx, NewCreateStep(i.deployment, noopEvent(0), state)) {
 		return "", false, false
@@ -273,7 +276,7 @@ func (i *importer) registerProviders(ctx context.Context) (map[resource.URN]stri
 		}
 
 		state := resource.NewState(typ, urn, true, false, "", inputs, nil, "", false, false, nil, nil, "", nil, false,
-			nil, nil, nil, "", false, "", nil, nil, "")
+			nil, nil, nil, "", false, "", nil, nil, "", nil)
 		// TODO(seqnum) should default providers be created with 1? When do they ever get recreated/replaced?
 		if issueCheckErrors(i.deployment, state, urn, failures) {
 			return nil, false, nil
@@ -391,7 +394,7 @@ func (i *importer) importResources(ctx context.Context) error {
 		// Create the new desired state. Note that the resource is protected. Provider might be "" at this point.
 		new := resource.NewState(
 			urn.Type(), urn, !imp.Component, false, imp.ID, resource.PropertyMap{}, nil, parent, imp.Protect,
-			false, nil, nil, provider, nil, false, nil, nil, nil, "", false, "", nil, nil, "")
+			false, nil, nil, provider, nil, false, nil, nil, nil, "", false, "", nil, nil, "", nil)
 		// Set a dummy goal so the resource is tracked as managed.
 		i.deployment.goals.set(urn, &resource.Goal{})
 
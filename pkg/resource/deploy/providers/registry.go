@@ -271,6 +271,12 @@ func (r *Registry) GetMappings(key string) ([]string, error) {
 	return nil, errors.New("the provider registry has no mappings")
 }
 
+func (r *Registry) Discover(req plugin.DiscoverRequest) (plugin.DiscoverResult, error) {
+	contract.Failf("Discover must not be called on the provider registry")
+
+	return plugin.DiscoverResult{}, errors.New("the provider registry has no resources to discover")
+}
+
 // CheckConfig validates the configuration for this resource provider.
 func (r *Registry) CheckConfig(urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool,
@@ -0,0 +1,159 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// SecretScanMode controls what happens to the plaintext outputs of resources that look like secrets.
+type SecretScanMode string
+
+const (
+	// SecretScanOff disables scanning outputs for secrets.
+	SecretScanOff SecretScanMode = ""
+	// SecretScanWarn warns about outputs that look like secrets.
+	SecretScanWarn SecretScanMode = "warn"
+	// SecretScanError encrypts outputs that look like secrets, so that they're never saved in plaintext, and then
+	// fails the deployment.
+	SecretScanError SecretScanMode = "error"
+)
+
+// ParseSecretScanMode parses a SecretScanMode. "off" is accepted as a synonym of SecretScanOff.
+func ParseSecretScanMode(s string) (SecretScanMode, error) {
+	switch mode := SecretScanMode(strings.ToLower(s)); mode {
+	case SecretScanOff, "off":
+		return SecretScanOff, nil
+	case SecretScanWarn, SecretScanError:
+		return mode, nil
+	default:
+		return SecretScanOff, fmt.Errorf("unknown secret scan mode %q; expected off, warn or error", s)
+	}
+}
+
+// SecretFinding is a plaintext property value that looks like a secret.
+type SecretFinding struct {
+	// Path is the path of the value.
+	Path resource.PropertyPath
+	// Reason describes why the value looks like a secret, e.g. "AWS access key ID".
+	Reason string
+}
+
+// secretPatterns are the formats of well-known credentials.
+var secretPatterns = []struct {
+	reason string
+	re     *regexp.Regexp
+}{
+	{"AWS access key ID", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+	{"GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_\-]{20,}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9\-]{10,}\b`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`)},
+	{"Stripe secret key", regexp.MustCompile(`\b[rs]k_live_[0-9A-Za-z]{24,}\b`)},
+	{"Pulumi access token", regexp.MustCompile(`\bpul-[0-9a-f]{40}\b`)},
+	{"private key", regexp.MustCompile(`-----BEGIN ([A-Z]+ )?PRIVATE KEY-----`)},
+}
+
+const (
+	// minEntropyLength is the length of the shortest token whose entropy is checked. Shorter random tokens are
+	// rarely credentials, and their entropy is a poor signal.
+	minEntropyLength = 32
+	// entropyThreshold is the Shannon entropy, in bits per character, above which a token looks random. Hex strings,
+	// such as hashes and IDs, never exceed 4 bits per character, so they aren't flagged.
+	entropyThreshold = 4.5
+)
+
+// tokenSeparators split values into the tokens whose entropy is checked.
+var tokenSeparators = regexp.MustCompile(`[\s"',;:=&?()\[\]{}<>|]+`)
+
+// ScanForSecrets returns the plaintext values of props that look like secrets, ordered by path. Secret and unknown
+// values are ignored.
+func ScanForSecrets(props resource.PropertyMap) []SecretFinding {
+	var findings []SecretFinding
+	for _, k := range props.StableKeys() {
+		findings = scanValue(resource.PropertyPath{string(k)}, props[k], findings)
+	}
+	return findings
+}
+
+func scanValue(path resource.PropertyPath, v resource.PropertyValue, findings []SecretFinding) []SecretFinding {
+	switch {
+	case v.IsString():
+		if reason, ok := looksLikeSecret(v.StringValue()); ok {
+			findings = append(findings, SecretFinding{Path: path, Reason: reason})
+		}
+	case v.IsArray():
+		for i, elem := range v.ArrayValue() {
+			findings = scanValue(appendPath(path, i), elem, findings)
+		}
+	case v.IsObject():
+		obj := v.ObjectValue()
+		for _, k := range obj.StableKeys() {
+			findings = scanValue(appendPath(path, string(k)), obj[k], findings)
+		}
+	}
+	return findings
+}
+
+func appendPath(path resource.PropertyPath, elem interface{}) resource.PropertyPath {
+	result := make(resource.PropertyPath, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, elem)
+}
+
+func looksLikeSecret(s string) (string, bool) {
+	for _, p := range secretPatterns {
+		if p.re.MatchString(s) {
+			return p.reason, true
+		}
+	}
+	for _, token := range tokenSeparators.Split(s, -1) {
+		if len(token) >= minEntropyLength && !strings.Contains(token, "/") && shannonEntropy(token) > entropyThreshold {
+			return "high-entropy string", true
+		}
+	}
+	return "", false
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := map[rune]int{}
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	entropy := 0.0
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// protectSecretFindings marks the top-level properties of props that contain any of findings as secret.
+func protectSecretFindings(props resource.PropertyMap, findings []SecretFinding) {
+	for _, f := range findings {
+		key := resource.PropertyKey(f.Path[0].(string))
+		if !props[key].IsSecret() {
+			props[key] = resource.MakeSecret(props[key])
+		}
+	}
+}
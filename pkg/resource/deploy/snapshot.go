@@ -17,6 +17,7 @@ package deploy
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
@@ -33,6 +34,17 @@ type Snapshot struct {
 	SecretsManager    secrets.Manager      // the manager to use use when seralizing this snapshot.
 	Resources         []*resource.State    // fetches all resources and their associated states.
 	PendingOperations []resource.Operation // all currently pending resource operations.
+	SecretsRotations  []SecretsRotation    // every rotation of the stack's secrets, oldest first.
+}
+
+// SecretsRotation records a rotation of the secrets of a stack, in which every secret in its configuration and state
+// was decrypted and re-encrypted with a new key.
+type SecretsRotation struct {
+	Time                    time.Time // the time at which the rotation happened.
+	PreviousSecretsProvider string    // the secrets provider the secrets were encrypted with before the rotation.
+	SecretsProvider         string    // the secrets provider the secrets were re-encrypted with.
+	ConfigSecrets           int       // the number of secret configuration values that were re-encrypted.
+	StateSecrets            int       // the number of secret values in the state that were re-encrypted.
 }
 
 // NewSnapshot creates a snapshot from the given arguments.  The resources must be in topologically sorted order.
@@ -88,7 +88,8 @@ type RegisterResourceEvent interface {
 
 // RegisterResult is the state of the resource after it has been registered.
 type RegisterResult struct {
-	State *resource.State // the resource state.
+	State  *resource.State // the resource state.
+	Failed bool            // true if the resource's step failed, or didn't run because an earlier step failed.
 }
 
 // RegisterResourceOutputsEvent is an event that asks the engine to complete the provisioning of a resource.
@@ -98,6 +99,8 @@ type RegisterResourceOutputsEvent interface {
 	URN() resource.URN
 	// Outputs returns a property map of output properties to add to a resource before completing.
 	Outputs() resource.PropertyMap
+	// OutputMetadata returns optional documentation and type information for the outputs.
+	OutputMetadata() map[resource.PropertyKey]resource.OutputMetadata
 	// Done indicates that we are done with this step.  It must be called to perform cleanup associated with the step.
 	Done()
 }
@@ -129,5 +132,6 @@ type ReadResourceEvent interface {
 }
 
 type ReadResult struct {
-	State *resource.State
+	State  *resource.State
+	Failed bool // true if the resource's step failed, or didn't run because an earlier step failed.
 }
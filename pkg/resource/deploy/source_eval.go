@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -242,6 +243,7 @@ func (iter *evalSourceIterator) forkRun(
 				DryRun:            iter.src.dryRun,
 				Parallel:          opts.Parallel,
 				Organization:      string(iter.src.runinfo.Target.Organization),
+				StackTags:         stackTags(iter.src.runinfo.Target),
 			})
 
 			// Check if we were asked to Bail.  This a special random constant used for that
@@ -368,7 +370,7 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 		goal: resource.NewGoal(
 			providers.MakeProviderType(req.Package()),
 			req.Name(), true, inputs, "", false, nil, "", nil, nil, nil,
-			nil, nil, nil, "", nil, nil, false, "", ""),
+			nil, nil, nil, "", nil, nil, false, "", "", nil),
 		done: done,
 	}
 	return event, done, nil
@@ -424,6 +426,10 @@ func (d *defaultProviders) handleRequest(req providers.ProviderRequest) (provide
 		return providers.Reference{}, context.Canceled
 	}
 
+	if result.Failed {
+		return providers.Reference{}, fmt.Errorf("registering default provider %s failed", result.State.URN)
+	}
+
 	logging.V(5).Infof("registered default provider for package %s: %s", req, result.State.URN)
 
 	id := result.State.ID
@@ -1038,6 +1044,9 @@ func (rm *resmon) ReadResource(ctx context.Context,
 	}
 
 	contract.Assertf(result != nil, "ReadResource operation returned a nil result")
+	if result.Failed {
+		return nil, rpcerror.Newf(codes.Aborted, "reading resource %s failed", result.State.URN)
+	}
 	marshaled, err := plugin.MarshalProperties(result.State.Outputs, plugin.MarshalOptions{
 		Label:         label,
 		KeepUnknowns:  true,
@@ -1192,6 +1201,7 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	replaceOnChanges := req.GetReplaceOnChanges()
 	id := resource.ID(req.GetImportId())
 	customTimeouts := req.GetCustomTimeouts()
+	retryPolicy := req.GetRetryPolicy()
 	retainOnDelete := req.GetRetainOnDelete()
 	deletedWith, err := resource.ParseOptionalURN(req.GetDeletedWith())
 	if err != nil {
@@ -1385,9 +1395,9 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	logging.V(5).Infof(
 		"ResourceMonitor.RegisterResource received: t=%v, name=%v, custom=%v, #props=%v, parent=%v, protect=%v, "+
 			"provider=%v, deps=%v, deleteBeforeReplace=%v, ignoreChanges=%v, aliases=%v, customTimeouts=%v, "+
-			"providers=%v, replaceOnChanges=%v, retainOnDelete=%v, deletedWith=%v",
+			"providers=%v, replaceOnChanges=%v, retainOnDelete=%v, deletedWith=%v, retryPolicy=%v",
 		t, name, custom, len(props), parent, protect, providerRef, dependencies, deleteBeforeReplace, ignoreChanges,
-		aliases, customTimeouts, providerRefs, replaceOnChanges, retainOnDelete, deletedWith)
+		aliases, customTimeouts, providerRefs, replaceOnChanges, retainOnDelete, deletedWith, retryPolicy)
 
 	// If this is a remote component, fetch its provider and issue the construct call. Otherwise, register the resource.
 	var result *RegisterResult
@@ -1475,11 +1485,17 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 			}
 		}
 
+		retries, err := parseRetryPolicy(retryPolicy)
+		if err != nil {
+			return nil, rpcerror.New(codes.InvalidArgument, err.Error())
+		}
+
 		goal := resource.NewGoal(t, name, custom, props, parent, protect, dependencies,
 			providerRef.String(), nil, propertyDependencies, deleteBeforeReplace, ignoreChanges,
 			additionalSecretKeys, aliases, id, &timeouts, replaceOnChanges, retainOnDelete, deletedWith,
-			sourcePosition,
+			sourcePosition, retries,
 		)
+		goal.Frozen = req.GetFrozen()
 
 		if goal.Parent != "" {
 			rm.resGoalsLock.Lock()
@@ -1509,6 +1525,9 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 			logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, name=%s", name)
 			return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while waiting on step's done channel")
 		}
+		if result != nil && result.Failed {
+			return nil, rpcerror.Newf(codes.Aborted, "registering resource %s failed", result.State.URN)
+		}
 		if result != nil && result.State != nil && result.State.URN != "" {
 			rm.resGoalsLock.Lock()
 			rm.resGoals[result.State.URN] = *goal
@@ -1559,6 +1578,7 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	// • replaceOnChanges
 	// • retainOnDelete
 	// • deletedWith
+	// • retryPolicy
 	// Revisit these semantics in Pulumi v4.0
 	// See this issue for more: https://github.com/pulumi/pulumi/issues/9704
 	if !custom {
@@ -1586,6 +1606,9 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 		rm.checkComponentOption(result.State.URN, "deletedWith", func() bool {
 			return deletedWith != ""
 		})
+		rm.checkComponentOption(result.State.URN, "retryPolicy", func() bool {
+			return retryPolicy != nil && (retryPolicy.MaxRetries > 0 || len(retryPolicy.RetryOn) > 0)
+		})
 	}
 
 	logging.V(5).Infof(
@@ -1653,11 +1676,26 @@ func (rm *resmon) RegisterResourceOutputs(ctx context.Context,
 	}
 	logging.V(5).Infof("ResourceMonitor.RegisterResourceOutputs received: urn=%v, #outs=%v", urn, len(outs))
 
+	var metadata map[resource.PropertyKey]resource.OutputMetadata
+	for k, m := range req.GetOutputMetadata() {
+		if m.GetSchema() != "" && !json.Valid([]byte(m.GetSchema())) {
+			return nil, rpcerror.New(codes.InvalidArgument, fmt.Sprintf("output %q has an invalid JSON schema", k))
+		}
+		if metadata == nil {
+			metadata = make(map[resource.PropertyKey]resource.OutputMetadata)
+		}
+		metadata[resource.PropertyKey(k)] = resource.OutputMetadata{
+			Description: m.GetDescription(),
+			Schema:      m.GetSchema(),
+		}
+	}
+
 	// Now send the step over to the engine to perform.
 	step := &registerResourceOutputsEvent{
-		urn:     urn,
-		outputs: outs,
-		done:    make(chan bool),
+		urn:      urn,
+		outputs:  outs,
+		metadata: metadata,
+		done:     make(chan bool),
 	}
 
 	select {
@@ -1699,9 +1737,10 @@ func (g *registerResourceEvent) Done(result *RegisterResult) {
 }
 
 type registerResourceOutputsEvent struct {
-	urn     resource.URN         // the URN to which this completion applies.
-	outputs resource.PropertyMap // an optional property bag for output properties.
-	done    chan bool            // the channel to communicate with after the operation completes.
+	urn      resource.URN                                     // the URN to which this completion applies.
+	outputs  resource.PropertyMap                             // an optional property bag for output properties.
+	metadata map[resource.PropertyKey]resource.OutputMetadata // optional metadata for the output properties.
+	done     chan bool                                        // the channel to communicate with after the operation completes.
 }
 
 var _ RegisterResourceOutputsEvent = (*registerResourceOutputsEvent)(nil)
@@ -1716,6 +1755,10 @@ func (g *registerResourceOutputsEvent) Outputs() resource.PropertyMap {
 	return g.outputs
 }
 
+func (g *registerResourceOutputsEvent) OutputMetadata() map[resource.PropertyKey]resource.OutputMetadata {
+	return g.metadata
+}
+
 func (g *registerResourceOutputsEvent) Done() {
 	// Communicate the resulting state back to the RPC thread, which is parked awaiting our reply.
 	g.done <- true
@@ -1763,6 +1806,51 @@ func generateTimeoutInSeconds(timeout string) (float64, error) {
 	return duration.Seconds(), nil
 }
 
+// parseRetryPolicy converts a retry policy sent by a language host into its engine representation.
+func parseRetryPolicy(p *pulumirpc.RegisterResourceRequest_RetryPolicy) (*resource.RetryPolicy, error) {
+	if p == nil {
+		return nil, nil
+	}
+	if p.MaxRetries < 0 {
+		return nil, fmt.Errorf("retryPolicy maxRetries must not be negative, got %d", p.MaxRetries)
+	}
+
+	parseDelay := func(field, value string) (float64, error) {
+		if value == "" {
+			return 0, nil
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse retryPolicy %s value %s", field, value)
+		}
+		if duration < 0 {
+			return 0, fmt.Errorf("retryPolicy %s must not be negative, got %s", field, value)
+		}
+		return duration.Seconds(), nil
+	}
+
+	initialDelay, err := parseDelay("initialDelay", p.InitialDelay)
+	if err != nil {
+		return nil, err
+	}
+	maxDelay, err := parseDelay("maxDelay", p.MaxDelay)
+	if err != nil {
+		return nil, err
+	}
+	for _, pattern := range p.RetryOn {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("retryPolicy retryOn pattern %q is not a valid regular expression: %w", pattern, err)
+		}
+	}
+
+	return &resource.RetryPolicy{
+		MaxRetries:   int(p.MaxRetries),
+		InitialDelay: initialDelay,
+		MaxDelay:     maxDelay,
+		RetryOn:      p.RetryOn,
+	}, nil
+}
+
 func decorateResourceSpans(span opentracing.Span, method string, req, resp interface{}, grpcError error) {
 	if req == nil {
 		return
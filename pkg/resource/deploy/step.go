@@ -127,6 +127,10 @@ func (s *SameStep) New() *resource.State    { return s.new }
 func (s *SameStep) Res() *resource.State    { return s.new }
 func (s *SameStep) Logical() bool           { return true }
 
+func (s *SameStep) failRegistration() {
+	s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+}
+
 func (s *SameStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	// Retain the ID and outputs
 	s.new.ID = s.old.ID
@@ -235,6 +239,10 @@ func (s *CreateStep) Diffs() []resource.PropertyKey                { return s.di
 func (s *CreateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 func (s *CreateStep) Logical() bool                                { return !s.replacing }
 
+func (s *CreateStep) failRegistration() {
+	s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+}
+
 func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	var resourceError error
 	resourceStatus := resource.StatusOK
@@ -245,7 +253,14 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 
-		id, outs, rst, err := prov.Create(s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create, s.deployment.preview)
+		var id resource.ID
+		var outs resource.PropertyMap
+		rst, err := retryProviderOperation(s, s.new.RetryPolicy, func() (resource.Status, error) {
+			var rst resource.Status
+			var err error
+			id, outs, rst, err = prov.Create(s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create, s.deployment.preview)
+			return rst, err
+		})
 		if err != nil {
 			if rst != resource.StatusPartialFailure {
 				return rst, nil, err
@@ -393,12 +408,26 @@ func (d deleteProtectedError) Error() string {
 		"`pulumi state unprotect %[2]s`", d.urn, d.urn.Quote())
 }
 
+type deleteFrozenError struct {
+	urn resource.URN
+}
+
+func (d deleteFrozenError) Error() string {
+	return fmt.Sprintf("resource %[1]q cannot be deleted\n"+
+		"because it is frozen. To unfreeze the resource, use the command:\n"+
+		"`pulumi state unfreeze %[2]s`", d.urn, d.urn.Quote())
+}
+
 func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	// Refuse to delete protected resources (unless we're replacing them in
 	// which case we will of checked protect elsewhere)
 	if !s.replacing && s.old.Protect {
 		return resource.StatusOK, nil, deleteProtectedError{urn: s.old.URN}
 	}
+	// Refuse to delete frozen resources, even to replace them.
+	if s.old.Frozen {
+		return resource.StatusOK, nil, deleteFrozenError{urn: s.old.URN}
+	}
 
 	if preview {
 		// Do nothing in preview
@@ -417,7 +446,10 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 
-		if rst, err := prov.Delete(s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs, s.old.CustomTimeouts.Delete); err != nil {
+		rst, err := retryProviderOperation(s, s.old.RetryPolicy, func() (resource.Status, error) {
+			return prov.Delete(s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs, s.old.CustomTimeouts.Delete)
+		})
+		if err != nil {
 			return rst, nil, err
 		}
 	}
@@ -514,6 +546,10 @@ func (s *UpdateStep) Logical() bool                                { return true
 func (s *UpdateStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *UpdateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 
+func (s *UpdateStep) failRegistration() {
+	s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+}
+
 func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	// Always propagate the ID and timestamps even in previews and refreshes.
 	s.new.ID = s.old.ID
@@ -530,8 +566,14 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		}
 
 		// Update to the combination of the old "all" state, but overwritten with new inputs.
-		outs, rst, upderr := prov.Update(s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs,
-			s.new.CustomTimeouts.Update, s.ignoreChanges, s.deployment.preview)
+		var outs resource.PropertyMap
+		rst, upderr := retryProviderOperation(s, s.new.RetryPolicy, func() (resource.Status, error) {
+			var rst resource.Status
+			var err error
+			outs, rst, err = prov.Update(s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs,
+				s.new.CustomTimeouts.Update, s.ignoreChanges, s.deployment.preview)
+			return rst, err
+		})
 		if upderr != nil {
 			if rst != resource.StatusPartialFailure {
 				return rst, nil, upderr
@@ -701,6 +743,10 @@ func (s *ReadStep) New() *resource.State    { return s.new }
 func (s *ReadStep) Res() *resource.State    { return s.new }
 func (s *ReadStep) Logical() bool           { return !s.replacing }
 
+func (s *ReadStep) failRegistration() {
+	s.event.Done(&ReadResult{State: s.new, Failed: true})
+}
+
 func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	urn := s.new.URN
 	id := s.new.ID
@@ -783,10 +829,20 @@ type RefreshStep struct {
 	new        *resource.State // the new resource state, to be used to query the provider
 	done       chan<- bool     // the channel to use to signal completion, if any
 	provider   plugin.Provider // the optional provider to use.
+	// properties, if set, are the only properties that are refreshed; the rest of the old state is kept.
+	properties []resource.PropertyPath
 }
 
 // NewRefreshStep creates a new Refresh step.
 func NewRefreshStep(deployment *Deployment, old *resource.State, done chan<- bool) Step {
+	return newRefreshStep(deployment, old, done, nil)
+}
+
+// newRefreshStep creates a new Refresh step that only refreshes the given properties, or all properties if there are
+// none.
+func newRefreshStep(deployment *Deployment, old *resource.State, done chan<- bool,
+	properties []resource.PropertyPath,
+) Step {
 	contract.Requiref(old != nil, "old", "must not be nil")
 
 	// NOTE: we set the new state to the old state by default so that we don't interpret step failures as deletes.
@@ -795,6 +851,7 @@ func NewRefreshStep(deployment *Deployment, old *resource.State, done chan<- boo
 		old:        old,
 		new:        old,
 		done:       done,
+		properties: properties,
 	}
 }
 
@@ -858,6 +915,9 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 			s.Deployment().Diag().Warningf(diag.RawMessage(s.URN(), msg))
 		}
 	}
+	if len(s.properties) != 0 && refreshed.Outputs != nil {
+		refreshed = s.refreshProperties(refreshed)
+	}
 	outputs := refreshed.Outputs
 
 	// If the provider specified new inputs for this resource, pick them up now. Otherwise, retain the current inputs.
@@ -879,8 +939,9 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider,
 			s.old.PropertyDependencies, s.old.PendingReplacement, s.old.AdditionalSecretOutputs, s.old.Aliases,
 			&s.old.CustomTimeouts, s.old.ImportID, s.old.RetainOnDelete, s.old.DeletedWith, s.old.Created, s.old.Modified,
-			s.old.SourcePosition,
+			s.old.SourcePosition, &s.old.RetryPolicy,
 		)
+		s.new.Frozen = s.old.Frozen
 		var inputsChange, outputsChange bool
 		if s.old != nil {
 			inputsChange = !refreshed.Inputs.DeepEquals(s.old.Inputs)
@@ -902,6 +963,56 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	return rst, nil, err
 }
 
+// refreshProperties returns the result of reading the resource with only the step's properties taken from what was
+// read; every other property, and the resource's ID, keep their old values.
+func (s *RefreshStep) refreshProperties(refreshed plugin.ReadResult) plugin.ReadResult {
+	var invalidPaths []string
+	keep := func(old, read resource.PropertyMap) resource.PropertyMap {
+		// Reset modifies nested values in place, so work on a copy that shares nothing with the old state.
+		props := copyPropertyValue(resource.NewObjectProperty(old)).ObjectValue()
+		for _, path := range s.properties {
+			if !path.Reset(read, props) {
+				invalidPaths = append(invalidPaths, path.String())
+			}
+		}
+		return props
+	}
+
+	result := plugin.ReadResult{ID: s.old.ID, Outputs: keep(s.old.Outputs, refreshed.Outputs)}
+	if refreshed.Inputs != nil {
+		result.Inputs = keep(s.old.Inputs, refreshed.Inputs)
+	}
+
+	if len(invalidPaths) != 0 {
+		s.Deployment().Diag().Warningf(diag.RawMessage(s.URN(), fmt.Sprintf(
+			"cannot refresh the following properties because one or more elements of the path are missing: %q",
+			strings.Join(invalidPaths, ", "))))
+	}
+	return result
+}
+
+// copyPropertyValue returns a deep copy of the given value.
+func copyPropertyValue(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = copyPropertyValue(e)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		obj := make(resource.PropertyMap, len(v.ObjectValue()))
+		for k, e := range v.ObjectValue() {
+			obj[k] = copyPropertyValue(e)
+		}
+		return resource.NewObjectProperty(obj)
+	case v.IsSecret():
+		return resource.MakeSecret(copyPropertyValue(v.SecretValue().Element))
+	default:
+		return v
+	}
+}
+
 type ImportStep struct {
 	deployment    *Deployment                    // the current deployment.
 	reg           RegisterResourceEvent          // the registration intent to convey a URN back to.
@@ -997,6 +1108,10 @@ func (s *ImportStep) Logical() bool                                { return !s.r
 func (s *ImportStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *ImportStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 
+func (s *ImportStep) failRegistration() {
+	s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+}
+
 func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	complete := func() {
 		s.reg.Done(&RegisterResult{State: s.new})
@@ -1060,7 +1175,8 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	s.old = resource.NewState(s.new.Type, s.new.URN, s.new.Custom, false, s.new.ID, inputs, outputs,
 		s.new.Parent, s.new.Protect, false, s.new.Dependencies, s.new.InitErrors, s.new.Provider,
 		s.new.PropertyDependencies, false, nil, nil, &s.new.CustomTimeouts, s.new.ImportID, s.new.RetainOnDelete,
-		s.new.DeletedWith, nil, nil, s.new.SourcePosition)
+		s.new.DeletedWith, nil, nil, s.new.SourcePosition, &s.new.RetryPolicy)
+	s.old.Frozen = s.new.Frozen
 
 	// Import takes a resource that Pulumi did not create and imports it into pulumi state.
 	now := time.Now().UTC()
@@ -41,6 +41,10 @@ const (
 // that we don't do so.
 type StepApplyFailed struct {
 	Err error
+
+	// completed is true if the step's completion function ran regardless of the failure, answering the step's
+	// registration.
+	completed bool
 }
 
 func (saf StepApplyFailed) Error() string {
@@ -111,6 +115,11 @@ type stepExecutor struct {
 	// async promise indicating an error seen by the step executor, if multiple errors are seen this will only
 	// record the first.
 	sawError promise.CompletionSource[struct{}]
+
+	// When continuing on error, the steps that failed and the steps that didn't run because of them.
+	failuresLock sync.Mutex
+	failures     []stepFailure
+	skipped      []Step
 }
 
 //
@@ -187,6 +196,7 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 	se.log(synchronousWorkerID,
 		"registered resource outputs %s: old=#%d, new=#%d", urn, len(reg.New().Outputs), len(outs))
 	reg.New().Outputs = outs
+	reg.New().OutputMetadata = e.OutputMetadata()
 
 	old := se.deployment.Olds()[urn]
 	var oldOuts resource.PropertyMap
@@ -270,7 +280,7 @@ func (se *stepExecutor) WaitForCompletion() {
 // executeChain executes a chain, one step at a time. If any step in the chain fails to execute, or if the
 // context is canceled, the chain stops execution.
 func (se *stepExecutor) executeChain(workerID int, chain chain) {
-	for _, step := range chain {
+	for i, step := range chain {
 		select {
 		case <-se.ctx.Done():
 			se.log(workerID, "step %v on %v canceled", step.Op(), step.URN())
@@ -288,6 +298,9 @@ func (se *stepExecutor) executeChain(workerID int, chain chain) {
 		if err != nil {
 			se.log(workerID, "step %v on %v failed, signalling cancellation", step.Op(), step.URN())
 			se.cancelDueToError(err)
+			if se.continueOnError {
+				se.recordFailure(step, err, chain[i+1:])
+			}
 
 			var saf StepApplyFailed
 			if !errors.As(err, &saf) {
@@ -328,7 +341,13 @@ func (se *stepExecutor) cancelDueToError(err error) {
 // executeStep executes a single step, returning true if the step execution was successful and
 // false if it was not.
 func (se *stepExecutor) executeStep(workerID int, step Step) error {
+	if err := runStepHooks(se.deployment, se.opts.StepHooks, step, StepHookPre, se.preview, nil); err != nil {
+		se.log(workerID, "step %v on %v vetoed by a step hook: %v", step.Op(), step.URN(), err)
+		return err
+	}
+
 	var payload interface{}
+	var scanErr error
 	events := se.opts.Events
 	if events != nil {
 		var err error
@@ -342,6 +361,12 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 	se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
 	status, stepComplete, err := step.Apply(se.preview)
 
+	phase := StepHookPost
+	if err != nil {
+		phase = StepHookFailure
+	}
+	contract.IgnoreError(runStepHooks(se.deployment, se.opts.StepHooks, step, phase, se.preview, err))
+
 	if err == nil {
 		// If we have a state object, and this is a create or update, remember it, as we may need to update it later.
 		if step.Logical() && step.New() != nil {
@@ -401,6 +426,11 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 			}
 		}
 
+		// Check that no secrets are about to be saved in plaintext.
+		if se.opts.SecretScan != SecretScanOff && !se.preview {
+			scanErr = se.scanForSecrets(newState)
+		}
+
 		// If this is not a resource that is managed by Pulumi, then we can ignore it.
 		if _, hasGoal := se.deployment.goals.get(newState.URN); hasGoal {
 			se.deployment.news.set(newState.URN, newState)
@@ -430,10 +460,33 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 
 	if err != nil {
 		se.log(workerID, "step %v on %v failed with an error: %v", step.Op(), step.URN(), err)
-		return StepApplyFailed{err}
+		return StepApplyFailed{Err: err, completed: stepComplete != nil}
 	}
 
-	return nil
+	return scanErr
+}
+
+// scanForSecrets reports the plaintext outputs of a resource that look like secrets. If the scan mode is
+// SecretScanError, the outputs are made secret so that they're saved encrypted, and an error is returned to fail the
+// deployment.
+func (se *stepExecutor) scanForSecrets(state *resource.State) error {
+	findings := ScanForSecrets(state.Outputs)
+	if len(findings) == 0 {
+		return nil
+	}
+
+	for _, f := range findings {
+		se.deployment.Diag().Warningf(diag.RawMessage(state.URN, fmt.Sprintf(
+			"output '%s' looks like a secret (%s) but isn't marked as secret; "+
+				"use the additionalSecretOutputs resource option to encrypt it", f.Path, f.Reason)))
+	}
+	if se.opts.SecretScan != SecretScanError {
+		return nil
+	}
+
+	protectSecretFindings(state.Outputs, findings)
+	return fmt.Errorf("%d output(s) of resource '%s' look like secrets; they were saved as secrets, "+
+		"but must be marked as secret by the program", len(findings), state.URN)
 }
 
 // log is a simple logging helper for the step executor.
@@ -53,6 +53,10 @@ func (e *mockRegisterResourceOutputsEvent) Outputs() resource.PropertyMap {
 	return resource.PropertyMap{}
 }
 
+func (e *mockRegisterResourceOutputsEvent) OutputMetadata() map[resource.PropertyKey]resource.OutputMetadata {
+	return nil
+}
+
 func (e *mockRegisterResourceOutputsEvent) Done() {}
 
 type mockEvents struct {
@@ -0,0 +1,173 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// stepFailure records a step that failed while the deployment continued on error.
+type stepFailure struct {
+	step Step
+	err  error
+}
+
+// registrationStep is implemented by the steps that answer a resource registration or read once they've run. When
+// the deployment continues on error, the registration of a step that fails or doesn't run has to be answered with a
+// failure, so that the program learns of it rather than waiting for the step forever.
+type registrationStep interface {
+	failRegistration()
+}
+
+// recordFailure records the failure of the given step, and the steps of its chain that won't run because of it, and
+// answers any of their registrations that are still outstanding.
+func (se *stepExecutor) recordFailure(step Step, err error, rest []Step) {
+	var saf StepApplyFailed
+	if errors.As(err, &saf) {
+		err = saf.Err
+	}
+
+	se.failuresLock.Lock()
+	se.failures = append(se.failures, stepFailure{step: step, err: err})
+	se.skipped = append(se.skipped, rest...)
+	se.failuresLock.Unlock()
+
+	if reg, ok := step.(registrationStep); ok && !saf.completed {
+		reg.failRegistration()
+	}
+	for _, s := range rest {
+		if reg, ok := s.(registrationStep); ok {
+			reg.failRegistration()
+		}
+	}
+}
+
+// skip records that the given steps won't run because steps they depend on failed.
+func (se *stepExecutor) skip(steps []Step) {
+	se.failuresLock.Lock()
+	defer se.failuresLock.Unlock()
+	se.skipped = append(se.skipped, steps...)
+}
+
+// blockedDeletes splits the given antichain of deletes into the steps that may run, and the steps that mustn't because
+// a resource that failed to be deleted, or wasn't deleted because of a failure, still depends on the resource they
+// delete.
+func (se *stepExecutor) blockedDeletes(deletes antichain) (antichain, []Step) {
+	se.failuresLock.Lock()
+	remaining := make([]Step, 0, len(se.failures)+len(se.skipped))
+	for _, f := range se.failures {
+		remaining = append(remaining, f.step)
+	}
+	remaining = append(remaining, se.skipped...)
+	se.failuresLock.Unlock()
+
+	dependedOn := make(map[resource.URN]bool)
+	for _, step := range remaining {
+		res := step.Old()
+		if res == nil {
+			res = step.New()
+		}
+		if res == nil {
+			continue
+		}
+		for _, dep := range res.Dependencies {
+			dependedOn[dep] = true
+		}
+		if res.Parent != "" {
+			dependedOn[res.Parent] = true
+		}
+		if ref, err := providers.ParseReference(res.Provider); err == nil {
+			dependedOn[ref.URN()] = true
+		}
+	}
+
+	var runnable antichain
+	var blocked []Step
+	for _, step := range deletes {
+		if dependedOn[step.URN()] {
+			blocked = append(blocked, step)
+		} else {
+			runnable = append(runnable, step)
+		}
+	}
+	return runnable, blocked
+}
+
+// reportFailures reports the steps that failed while the deployment continued on error, and the steps that didn't
+// run because of them, grouped by operation.
+func (se *stepExecutor) reportFailures() {
+	se.failuresLock.Lock()
+	defer se.failuresLock.Unlock()
+
+	if len(se.failures) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s failed", pluralSteps(len(se.failures)))
+	if len(se.skipped) > 0 {
+		fmt.Fprintf(&b, ", and %s didn't run because of them", pluralSteps(len(se.skipped)))
+	}
+	b.WriteString(":")
+
+	failed := make([]string, len(se.failures))
+	failedSteps := make([]Step, len(se.failures))
+	for i, f := range se.failures {
+		failed[i] = fmt.Sprintf("%s: %v", f.step.URN(), f.err)
+		failedSteps[i] = f.step
+	}
+	writeStepGroups(&b, "failed", failedSteps, failed)
+
+	skipped := make([]string, len(se.skipped))
+	for i, s := range se.skipped {
+		skipped[i] = string(s.URN())
+	}
+	writeStepGroups(&b, "skipped", se.skipped, skipped)
+
+	se.deployment.Diag().Errorf(diag.RawMessage("", b.String()))
+}
+
+// writeStepGroups writes the given lines, one for each of the given steps, grouped by the steps' operations in the
+// order they first appear.
+func writeStepGroups(b *strings.Builder, verb string, steps []Step, lines []string) {
+	var ops []display.StepOp
+	groups := make(map[display.StepOp][]string)
+	for i, s := range steps {
+		if _, has := groups[s.Op()]; !has {
+			ops = append(ops, s.Op())
+		}
+		groups[s.Op()] = append(groups[s.Op()], lines[i])
+	}
+	for _, op := range ops {
+		fmt.Fprintf(b, "\n    %s %s (%d):", op, verb, len(groups[op]))
+		for _, line := range groups[op] {
+			fmt.Fprintf(b, "\n        %s", line)
+		}
+	}
+}
+
+func pluralSteps(n int) string {
+	if n == 1 {
+		return "1 step"
+	}
+	return fmt.Sprintf("%d steps", n)
+}
@@ -75,6 +75,10 @@ type stepGenerator struct {
 	// targetsActual is the set of targets explicitly targeted by the engine, this can be different from opts.targets if
 	// --target-dependents is true. This does _not_ include resources that have been implicitly targeted, like providers.
 	targetsActual UrnTargets
+
+	// speculator makes the provider calls for resource registrations ahead of the step generator, or is nil if they
+	// aren't made speculatively.
+	speculator *stepSpeculator
 }
 
 // isTargetedForUpdate returns if `res` is targeted for update. The function accommodates
@@ -82,7 +86,7 @@ type stepGenerator struct {
 func (sg *stepGenerator) isTargetedForUpdate(res *resource.State) bool {
 	if sg.opts.Targets.Contains(res.URN) {
 		return true
-	} else if !sg.opts.TargetDependents {
+	} else if !sg.opts.TargetDependents || sg.opts.Targets.Excludes(res.URN) {
 		return false
 	}
 
@@ -116,6 +120,17 @@ func (sg *stepGenerator) Errored() bool {
 	return sg.sawError
 }
 
+// frozenError reports that the given frozen resource can't be updated or replaced, and returns the error that stops
+// the deployment.
+func (sg *stepGenerator) frozenError(urn resource.URN, op string) error {
+	message := fmt.Sprintf("unable to %s resource %q\n"+
+		"as it is frozen. To unfreeze the resource, use the command:\n"+
+		"`pulumi state unfreeze %s`", op, urn, urn.Quote())
+	sg.deployment.ctx.Diag.Errorf(diag.StreamMessage(urn, message, 0))
+	sg.sawError = true
+	return result.BailErrorf(message)
+}
+
 // checkParent checks that the parent given is valid for the given resource type, and returns a default parent
 // if there is one.
 func (sg *stepGenerator) checkParent(parent resource.URN, resourceType tokens.Type) (resource.URN, error) {
@@ -215,6 +230,7 @@ func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, err
 		nil,   /* created */
 		nil,   /* modified */
 		event.SourcePosition(),
+		nil, /* retryPolicy */
 	)
 	old, hasOld := sg.deployment.Olds()[urn]
 
@@ -478,6 +494,9 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 
 	goal := event.Goal()
 
+	// Pick up the results of any provider calls made for this registration ahead of time.
+	spec := sg.speculator.take(goal)
+
 	// Some goal settings are based on the parent settings so make sure our parent is correct.
 	parent, err := sg.checkParent(goal.Parent, goal.Type)
 	if err != nil {
@@ -565,7 +584,9 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 	new := resource.NewState(goal.Type, urn, goal.Custom, false, "", inputs, nil, goal.Parent, goal.Protect, false,
 		goal.Dependencies, goal.InitErrors, goal.Provider, goal.PropertyDependencies, false,
 		goal.AdditionalSecretOutputs, aliasUrns, &goal.CustomTimeouts, "", goal.RetainOnDelete, goal.DeletedWith,
-		createdAt, modifiedAt, goal.SourcePosition)
+		createdAt, modifiedAt, goal.SourcePosition, &goal.RetryPolicy)
+	// A resource stays frozen until it is explicitly unfrozen, even if the program no longer asks for it to be.
+	new.Frozen = goal.Frozen || hasOld && old.Frozen
 
 	// Mark the URN/resource as having been seen. So we can run analyzers on all resources seen, as well as
 	// lookup providers for calculating replacement of resources that use the provider.
@@ -579,6 +600,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 	if err != nil {
 		return nil, err
 	}
+	prov = spec.provider(prov)
 
 	// We only allow unknown property values to be exposed to the provider if we are performing an update preview.
 	allowUnknowns := sg.deployment.preview
@@ -596,6 +618,10 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 			randomSeed = resourcePlan.Seed
 		}
 	}
+	// Otherwise use the seed that any speculative Check was made with, so that its result can be used.
+	if randomSeed == nil && spec != nil {
+		randomSeed = spec.seed
+	}
 	// If the above didn't set the seed, generate a new random one. If we're running with plans but this
 	// resource was missing a seed then if the seed is used later checks will fail.
 	if randomSeed == nil {
@@ -636,6 +662,9 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 		}
 
 		if isReplace := hasOld && !recreating; isReplace {
+			if old.Frozen {
+				return nil, sg.frozenError(urn, "replace")
+			}
 			return []Step{
 				NewImportReplacementStep(sg.deployment, event, old, new, goal.IgnoreChanges, randomSeed),
 				NewReplaceStep(sg.deployment, old, new, nil, nil, nil, true),
@@ -987,6 +1016,15 @@ func (sg *stepGenerator) generateStepsFromDiff(
 
 	// If there were changes check for a replacement vs. an in-place update.
 	if diff.Changes == plugin.DiffSome {
+		// Frozen resources can't be changed at all. Unlike protect, unfreezing a resource always takes an explicit
+		// `pulumi state unfreeze`, so we look at old.Frozen here.
+		if old.Frozen {
+			if diff.Replace() {
+				return nil, sg.frozenError(urn, "replace")
+			}
+			return nil, sg.frozenError(urn, "update")
+		}
+
 		if diff.Replace() {
 			// If this resource is protected we can't replace it because that entails a delete
 			// Note that we do allow unprotecting and replacing to happen in a single update
@@ -2051,5 +2089,6 @@ func newStepGenerator(
 		aliased:              make(map[resource.URN]resource.URN),
 		aliases:              make(map[resource.URN]resource.URN),
 		targetsActual:        opts.Targets.Clone(),
+		speculator:           newStepSpeculator(deployment, opts),
 	}
 }
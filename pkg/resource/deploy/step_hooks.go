@@ -0,0 +1,165 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// StepHookPhase is the point in a step's execution at which a step hook is called.
+type StepHookPhase string
+
+const (
+	// StepHookPre is the phase before a step is applied. Hooks called in this phase may veto the step.
+	StepHookPre StepHookPhase = "pre"
+	// StepHookPost is the phase after a step has been applied successfully.
+	StepHookPost StepHookPhase = "post"
+	// StepHookFailure is the phase after a step has failed to apply.
+	StepHookFailure StepHookPhase = "failure"
+)
+
+// StepHookEvent describes a step to a step hook.
+type StepHookEvent struct {
+	Phase    StepHookPhase  `json:"phase"`
+	Op       display.StepOp `json:"op"`
+	URN      resource.URN   `json:"urn"`
+	Type     tokens.Type    `json:"type"`
+	Provider string         `json:"provider,omitempty"`
+	// Preview is true if the step is being previewed rather than applied.
+	Preview bool `json:"preview"`
+	// Error is the error the step failed with, in the failure phase.
+	Error string `json:"error,omitempty"`
+}
+
+// StepHookResult is a step hook's response to a step event.
+type StepHookResult struct {
+	// Veto is true to stop the step from being applied. It is only honored in the pre phase.
+	Veto bool `json:"veto,omitempty"`
+	// Message is shown alongside the step, and explains the veto if there is one.
+	Message string `json:"message,omitempty"`
+}
+
+// StepHook is an extension point that is called before and after each step other than a same step, so that the
+// steps of a deployment can be checked against or recorded in an external change-management system.
+type StepHook interface {
+	// Name returns the name of the hook, as shown in the messages it annotates steps with.
+	Name() string
+	// OnStep is called with each step event.
+	OnStep(event StepHookEvent) (StepHookResult, error)
+}
+
+// runStepHooks calls the given hooks with the given event, annotating the step with their messages. In the pre phase,
+// it returns an error if any hook vetoes the step or fails; in the other phases, failures are reported as warnings.
+func runStepHooks(d *Deployment, hooks []StepHook, step Step, phase StepHookPhase, preview bool, stepErr error) error {
+	if len(hooks) == 0 || step.Op() == OpSame {
+		return nil
+	}
+
+	event := StepHookEvent{
+		Phase:    phase,
+		Op:       step.Op(),
+		URN:      step.URN(),
+		Type:     step.Type(),
+		Provider: step.Provider(),
+		Preview:  preview,
+	}
+	if stepErr != nil {
+		event.Error = stepErr.Error()
+	}
+
+	for _, hook := range hooks {
+		result, err := hook.OnStep(event)
+		if err != nil {
+			err = fmt.Errorf("step hook '%v' failed: %w", hook.Name(), err)
+			if phase == StepHookPre {
+				return err
+			}
+			d.Diag().Warningf(diag.RawMessage(step.URN(), err.Error()))
+			continue
+		}
+		if result.Veto && phase == StepHookPre {
+			if result.Message == "" {
+				return fmt.Errorf("step hook '%v' vetoed the %v of this resource", hook.Name(), step.Op())
+			}
+			return fmt.Errorf("step hook '%v' vetoed the %v of this resource: %v", hook.Name(), step.Op(), result.Message)
+		}
+		if result.Message != "" {
+			d.Diag().Infof(diag.RawMessage(step.URN(), fmt.Sprintf("%v: %v", hook.Name(), result.Message)))
+		}
+	}
+	return nil
+}
+
+// stepHookPrefix is the prefix of the names of the executables that implement step hooks.
+const stepHookPrefix = "pulumi-step-hook-"
+
+// execStepHook is a step hook implemented by an executable named pulumi-step-hook-<name> on the PATH. The executable
+// is run once for each step event, with the phase as its only argument and the event as JSON on its standard input,
+// and responds with a StepHookResult as JSON on its standard output. Empty output allows the step without a message,
+// and a non-zero exit status fails the hook.
+type execStepHook struct {
+	name string
+	path string
+}
+
+// NewExecStepHook returns the step hook implemented by the executable pulumi-step-hook-<name> on the PATH.
+func NewExecStepHook(name string) (StepHook, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, fmt.Errorf("invalid step hook name %q", name)
+	}
+	path, err := exec.LookPath(stepHookPrefix + name)
+	if err != nil {
+		return nil, fmt.Errorf("could not find step hook '%v': %w", name, err)
+	}
+	return &execStepHook{name: name, path: path}, nil
+}
+
+func (h *execStepHook) Name() string {
+	return h.name
+}
+
+func (h *execStepHook) OnStep(event StepHookEvent) (StepHookResult, error) {
+	input, err := json.Marshal(event)
+	if err != nil {
+		return StepHookResult{}, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(h.path, string(event.Phase))
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = bytes.NewReader(input), &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return StepHookResult{}, fmt.Errorf("%w: %s", err, msg)
+		}
+		return StepHookResult{}, err
+	}
+
+	var result StepHookResult
+	if out := bytes.TrimSpace(stdout.Bytes()); len(out) != 0 {
+		if err := json.Unmarshal(out, &result); err != nil {
+			return StepHookResult{}, fmt.Errorf("could not parse the hook's response: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+)
+
+// defaultRetryInitialDelay is the delay before the first retry of a failed operation if a resource's retry policy
+// does not specify one.
+const defaultRetryInitialDelay = time.Second
+
+// defaultTransientRetries is the number of times an operation that fails with a transient error is retried if the
+// resource's retry policy does not specify a maximum, including when the resource has no retry policy at all.
+const defaultTransientRetries = 3
+
+// retryDelay returns the delay to wait before the given retry attempt (starting at 1) under the given policy. The
+// delay doubles with each attempt and is capped by the policy's maximum delay, if any.
+func retryDelay(policy resource.RetryPolicy, attempt int) time.Duration {
+	initial := defaultRetryInitialDelay
+	if policy.InitialDelay > 0 {
+		initial = time.Duration(policy.InitialDelay * float64(time.Second))
+	}
+
+	delay := float64(initial) * math.Pow(2, float64(attempt-1))
+	if policy.MaxDelay > 0 {
+		delay = math.Min(delay, policy.MaxDelay*float64(time.Second))
+	}
+	return time.Duration(delay)
+}
+
+// effectiveRetryPolicy returns the retry policy that governs the given step's provider operations: the resource's own
+// policy if it has one, and otherwise the policy of the provider resource that manages it.
+func effectiveRetryPolicy(s Step, policy resource.RetryPolicy) resource.RetryPolicy {
+	if policy.IsNotEmpty() || s.Res().Provider == "" {
+		return policy
+	}
+	ref, err := providers.ParseReference(s.Res().Provider)
+	if err != nil {
+		return policy
+	}
+	d := s.Deployment()
+	if d.news != nil {
+		if prov, ok := d.news.get(ref.URN()); ok {
+			return prov.RetryPolicy
+		}
+	}
+	if prov, ok := d.olds[ref.URN()]; ok {
+		return prov.RetryPolicy
+	}
+	return policy
+}
+
+// isTransientError returns true if the given error from a provider operation is likely to go away if the operation is
+// retried: either the provider reported that it was unavailable or throttled, or the error's message matches one of
+// the given patterns.
+func isTransientError(err error, patterns []string) bool {
+	if rpcErr, ok := rpcerror.FromError(err); ok {
+		switch rpcErr.Code() {
+		case codes.Unavailable, codes.ResourceExhausted:
+			return true
+		}
+	}
+	for _, pattern := range patterns {
+		// Patterns are validated when the resource is registered.
+		if re, reErr := regexp.Compile(pattern); reErr == nil && re.MatchString(err.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxRetries returns how many times an operation governed by the given policy may be retried after failing with the
+// given error. A policy without patterns retries every failure. Otherwise only transient errors are retried, up to
+// defaultTransientRetries times if the policy doesn't set a maximum.
+func maxRetries(policy resource.RetryPolicy, err error) int {
+	if len(policy.RetryOn) == 0 && policy.MaxRetries > 0 {
+		return policy.MaxRetries
+	}
+	if !isTransientError(err, policy.RetryOn) {
+		return 0
+	}
+	if policy.MaxRetries > 0 {
+		return policy.MaxRetries
+	}
+	return defaultTransientRetries
+}
+
+// retryProviderOperation invokes op, which performs the provider half of a step, and retries it according to the
+// step's retry policy for as long as it fails. Operations are never retried during previews. Failures that leave the
+// resource partially initialized are never retried: the provider has already changed the resource, and the engine
+// must record that outcome in the snapshot. Nor are operations that timed out, which may still be in progress.
+func retryProviderOperation(s Step, policy resource.RetryPolicy, op func() (resource.Status, error)) (
+	resource.Status, error,
+) {
+	for attempt := 1; ; attempt++ {
+		status, err := op()
+		var timeoutErr *plugin.OperationTimeoutError
+		if err == nil || status == resource.StatusPartialFailure || errors.As(err, &timeoutErr) ||
+			s.Deployment().preview {
+			return status, err
+		}
+		if attempt == 1 {
+			policy = effectiveRetryPolicy(s, policy)
+		}
+		retries := maxRetries(policy, err)
+		if attempt > retries {
+			return status, err
+		}
+
+		delay := retryDelay(policy, attempt)
+		s.Deployment().Diag().Warningf(diag.RawMessage(s.URN(), fmt.Sprintf(
+			"%s failed, retrying in %v (retry %d of %d): %v", s.Op(), delay, attempt, retries, err)))
+
+		select {
+		case <-time.After(delay):
+		case <-s.Deployment().Ctx().Request().Done():
+			return status, err
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+)
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	// Without an explicit initial delay, the default is used and doubled on each attempt.
+	policy := resource.RetryPolicy{MaxRetries: 5}
+	assert.Equal(t, time.Second, retryDelay(policy, 1))
+	assert.Equal(t, 2*time.Second, retryDelay(policy, 2))
+	assert.Equal(t, 8*time.Second, retryDelay(policy, 4))
+
+	// The delay is capped by the maximum delay.
+	policy = resource.RetryPolicy{MaxRetries: 5, InitialDelay: 0.5, MaxDelay: 3}
+	assert.Equal(t, 500*time.Millisecond, retryDelay(policy, 1))
+	assert.Equal(t, 2*time.Second, retryDelay(policy, 3))
+	assert.Equal(t, 3*time.Second, retryDelay(policy, 4))
+	assert.Equal(t, 3*time.Second, retryDelay(policy, 5))
+}
+
+func TestMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	throttled := errors.New("request was throttled")
+	unavailable := rpcerror.New(codes.Unavailable, "service unavailable")
+
+	// Without a policy, only errors the provider reports as transient are retried.
+	assert.Equal(t, 0, maxRetries(resource.RetryPolicy{}, throttled))
+	assert.Equal(t, defaultTransientRetries, maxRetries(resource.RetryPolicy{}, unavailable))
+
+	// A policy without patterns retries every failure.
+	assert.Equal(t, 2, maxRetries(resource.RetryPolicy{MaxRetries: 2}, throttled))
+
+	// A policy with patterns retries matching and transient errors only.
+	policy := resource.RetryPolicy{MaxRetries: 2, RetryOn: []string{"throttl"}}
+	assert.Equal(t, 2, maxRetries(policy, throttled))
+	assert.Equal(t, 2, maxRetries(policy, unavailable))
+	assert.Equal(t, 0, maxRetries(policy, errors.New("access denied")))
+	assert.Equal(t, defaultTransientRetries, maxRetries(resource.RetryPolicy{RetryOn: []string{"throttl"}}, throttled))
+}
@@ -0,0 +1,258 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"sync"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// maxSpeculations bounds the number of registrations whose provider calls are made speculatively at once when the
+// deployment's parallelism is unbounded.
+const maxSpeculations = 256
+
+// stepSpeculator makes the provider Check and Diff calls for resource registrations during a preview as soon as
+// they're read from the source, concurrently up to the deployment's degree of parallelism, rather than one after
+// another as the step generator gets to each registration. The step generator then uses the results of these calls
+// in place of its own, as long as it makes the same ones, which it does unless something it has learned from earlier
+// registrations (e.g. that the resource was deleted earlier in the deployment) changes them.
+type stepSpeculator struct {
+	deployment *Deployment
+	// lookahead is the number of registrations that may be read from the source ahead of the step generator.
+	lookahead int
+	// sem bounds the number of speculations running at once.
+	sem chan struct{}
+
+	m            sync.Mutex
+	speculations map[*resource.Goal]*speculation
+}
+
+// newStepSpeculator returns a speculator for the given deployment, or nil if its provider calls shouldn't be made
+// speculatively.
+func newStepSpeculator(deployment *Deployment, opts Options) *stepSpeculator {
+	// Targeted and planned deployments change the calls the step generator makes in ways that aren't worth modeling.
+	if !deployment.preview || opts.DegreeOfParallelism() <= 1 ||
+		opts.Targets.IsConstrained() || opts.ReplaceTargets.IsConstrained() || deployment.plan != nil {
+		return nil
+	}
+	lookahead := opts.DegreeOfParallelism()
+	if lookahead > maxSpeculations {
+		lookahead = maxSpeculations
+	}
+	return &stepSpeculator{
+		deployment:   deployment,
+		lookahead:    lookahead,
+		sem:          make(chan struct{}, lookahead),
+		speculations: make(map[*resource.Goal]*speculation),
+	}
+}
+
+// speculation holds the results of the provider calls made for a single resource registration.
+type speculation struct {
+	// done is closed once the calls have been made, or the speculation has been abandoned.
+	done chan struct{}
+	// started is true once the calls have begun, and abandoned is true if the step generator got to the registration
+	// before they did; both are guarded by the speculator's lock.
+	started   bool
+	abandoned bool
+
+	urn  resource.URN
+	prov plugin.Provider
+	seed []byte
+
+	// The arguments and results of the Check call.
+	olds, news resource.PropertyMap
+	inputs     resource.PropertyMap
+	failures   []plugin.CheckFailure
+	checked    bool
+
+	// The arguments and results of the Diff call, if the resource has an old state to diff against.
+	old           *resource.State
+	ignoreChanges []string
+	diff          plugin.DiffResult
+	diffed        bool
+}
+
+// speculate starts making the provider calls for the given event, if it's a resource registration whose calls can
+// be predicted.
+func (s *stepSpeculator) speculate(event SourceEvent) {
+	if s == nil {
+		return
+	}
+	e, ok := event.(RegisterResourceEvent)
+	if !ok {
+		return
+	}
+	goal := e.Goal()
+
+	// Aliases, imports, and provider resources call for more than a Check and a Diff, so they're left to the step
+	// generator. So are resources whose providers haven't been loaded yet.
+	if !goal.Custom || goal.ID != "" || len(goal.Aliases) > 0 || providers.IsProviderType(goal.Type) {
+		return
+	}
+	ref, err := providers.ParseReference(goal.Provider)
+	if err != nil {
+		return
+	}
+	prov, ok := s.deployment.GetProvider(ref)
+	if !ok {
+		return
+	}
+
+	// The arguments are worked out here rather than in the speculation's goroutine, as the step generator updates the
+	// goal once it gets to it.
+	spec := &speculation{
+		done:          make(chan struct{}),
+		urn:           s.deployment.generateURN(goal.Parent, goal.Type, goal.Name),
+		prov:          prov,
+		seed:          make([]byte, 32),
+		news:          goal.Properties,
+		ignoreChanges: append([]string(nil), goal.IgnoreChanges...),
+	}
+	n, err := cryptorand.Read(spec.seed)
+	contract.AssertNoErrorf(err, "failed to generate random seed")
+	contract.Assertf(n == len(spec.seed), "generated fewer (%d) than expected (%d) random bytes", n, len(spec.seed))
+	if old, hasOld := s.deployment.Olds()[spec.urn]; hasOld && !old.External {
+		news, err := processIgnoreChanges(goal.Properties, old.Inputs, goal.IgnoreChanges)
+		if err != nil {
+			return
+		}
+		spec.old, spec.olds, spec.news = old, old.Inputs, news
+	}
+
+	s.m.Lock()
+	s.speculations[goal] = spec
+	s.m.Unlock()
+
+	go s.run(spec)
+}
+
+// run makes the provider calls for the given speculation once there's room for it.
+func (s *stepSpeculator) run(spec *speculation) {
+	defer close(spec.done)
+
+	s.sem <- struct{}{}
+	defer func() { <-s.sem }()
+
+	s.m.Lock()
+	if spec.abandoned {
+		s.m.Unlock()
+		return
+	}
+	spec.started = true
+	s.m.Unlock()
+
+	allowUnknowns := s.deployment.preview
+	inputs, failures, err := spec.prov.Check(spec.urn, spec.olds, spec.news, allowUnknowns, spec.seed)
+	if err != nil {
+		logging.V(7).Infof("speculative Check of %v failed: %v", spec.urn, err)
+		return
+	}
+	spec.inputs, spec.failures, spec.checked = inputs, failures, true
+	if spec.old == nil || len(failures) > 0 {
+		return
+	}
+
+	diff, err := spec.prov.Diff(spec.urn, spec.old.ID, spec.old.Inputs, spec.old.Outputs, inputs, allowUnknowns,
+		spec.ignoreChanges)
+	if err != nil {
+		logging.V(7).Infof("speculative Diff of %v failed: %v", spec.urn, err)
+		return
+	}
+	spec.diff, spec.diffed = diff, true
+}
+
+// take returns the speculation for the registration with the given goal, or nil if there isn't one. A speculation
+// whose calls haven't begun yet is abandoned, as the step generator can just as well make them itself.
+func (s *stepSpeculator) take(goal *resource.Goal) *speculation {
+	if s == nil {
+		return nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	spec, has := s.speculations[goal]
+	if !has {
+		return nil
+	}
+	delete(s.speculations, goal)
+	if !spec.started {
+		spec.abandoned = true
+		return nil
+	}
+	return spec
+}
+
+// provider returns a provider that answers the Check and Diff calls made for the speculation's registration from
+// its results, and passes any other calls on to the given provider.
+func (spec *speculation) provider(prov plugin.Provider) plugin.Provider {
+	if spec == nil || prov != spec.prov {
+		return prov
+	}
+	return &speculativeProvider{Provider: prov, spec: spec}
+}
+
+type speculativeProvider struct {
+	plugin.Provider
+
+	spec *speculation
+}
+
+func (p *speculativeProvider) Check(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte,
+) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	spec := p.spec
+	<-spec.done
+	if spec.checked && urn == spec.urn && bytes.Equal(randomSeed, spec.seed) &&
+		olds.DeepEquals(spec.olds) && news.DeepEquals(spec.news) {
+		logging.V(7).Infof("using speculative Check of %v", urn)
+		return spec.inputs, spec.failures, nil
+	}
+	return p.Provider.Check(urn, olds, news, allowUnknowns, randomSeed)
+}
+
+func (p *speculativeProvider) Diff(urn resource.URN, id resource.ID, oldInputs, oldOutputs,
+	newInputs resource.PropertyMap, allowUnknowns bool, ignoreChanges []string,
+) (plugin.DiffResult, error) {
+	spec := p.spec
+	<-spec.done
+	if spec.diffed && urn == spec.urn && id == spec.old.ID && equalStrings(ignoreChanges, spec.ignoreChanges) &&
+		oldInputs.DeepEquals(spec.old.Inputs) && oldOutputs.DeepEquals(spec.old.Outputs) &&
+		newInputs.DeepEquals(spec.inputs) {
+		logging.V(7).Infof("using speculative Diff of %v", urn)
+		return spec.diff, nil
+	}
+	return p.Provider.Diff(urn, id, oldInputs, oldOutputs, newInputs, allowUnknowns, ignoreChanges)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
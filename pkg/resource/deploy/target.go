@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
@@ -27,6 +28,8 @@ type Target struct {
 	Config       config.Map       // optional configuration key/value pairs.
 	Decrypter    config.Decrypter // decrypter for secret configuration values.
 	Snapshot     *Snapshot        // the last snapshot deployed to the target.
+
+	Tags map[apitype.StackTagName]string // the target stack's tags (if any).
 }
 
 // GetPackageConfig returns the set of configuration parameters for the indicated package, if any.
@@ -54,3 +57,15 @@ func (t *Target) GetPackageConfig(pkg tokens.Package) (resource.PropertyMap, err
 	}
 	return result, nil
 }
+
+// stackTags returns the target stack's tags as a plain map, for passing to the language host.
+func stackTags(t *Target) map[string]string {
+	if t == nil || len(t.Tags) == 0 {
+		return nil
+	}
+	tags := make(map[string]string, len(t.Tags))
+	for k, v := range t.Tags {
+		tags[string(k)] = v
+	}
+	return tags
+}
@@ -135,6 +135,18 @@ func UnprotectResource(_ *deploy.Snapshot, res *resource.State) error {
 	return nil
 }
 
+// FreezeResource freezes a resource, so that the engine refuses to update, replace or delete it.
+func FreezeResource(_ *deploy.Snapshot, res *resource.State) error {
+	res.Frozen = true
+	return nil
+}
+
+// UnfreezeResource unfreezes a resource.
+func UnfreezeResource(_ *deploy.Snapshot, res *resource.State) error {
+	res.Frozen = false
+	return nil
+}
+
 // LocateResource returns all resources in the given snapshot that have the given URN.
 func LocateResource(snap *deploy.Snapshot, urn resource.URN) []*resource.State {
 	// If there is no snapshot then return no resources
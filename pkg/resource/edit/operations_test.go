@@ -401,6 +401,29 @@ func TestUnprotectResource(t *testing.T) {
 	assert.False(t, a.Protect)
 }
 
+func TestFreezeAndUnfreezeResource(t *testing.T) {
+	t.Parallel()
+
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	b := NewResource("b", pA)
+	snap := NewSnapshot([]*resource.State{
+		pA,
+		a,
+		b,
+	})
+
+	err := FreezeResource(snap, a)
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{pA, a, b}, snap.Resources)
+	assert.True(t, a.Frozen)
+	assert.False(t, b.Frozen)
+
+	err = UnfreezeResource(snap, a)
+	assert.NoError(t, err)
+	assert.False(t, a.Frozen)
+}
+
 func TestLocateResourceNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -116,7 +116,18 @@ func SerializeDeployment(snap *deploy.Snapshot, sm secrets.Manager, showSecrets
 		if err != nil {
 			return nil, fmt.Errorf("getting encrypter for deployment: %w", err)
 		}
-		enc = e
+
+		// Encrypt every secret that isn't already cached at once, rather than just-in-time, to avoid serial calls to
+		// the encryption endpoint in stacks with a large number of secrets. The ciphertexts are recorded in a
+		// cachingCrypter, which serialization then reads them from.
+		caching, ok := e.(*cachingCrypter)
+		if !ok {
+			caching = &cachingCrypter{encrypter: e, cache: make(map[*resource.Secret]cacheEntry)}
+		}
+		if err := batchEncryptSecrets(context.TODO(), snap, sm, caching, showSecrets); err != nil {
+			return nil, fmt.Errorf("failed to encrypt secret values: %w", err)
+		}
+		enc = caching
 	} else {
 		enc = config.NewPanicCrypter()
 	}
@@ -148,14 +159,106 @@ func SerializeDeployment(snap *deploy.Snapshot, sm secrets.Manager, showSecrets
 		}
 	}
 
+	var rotations []apitype.SecretsRotationV1
+	for _, r := range snap.SecretsRotations {
+		rotations = append(rotations, apitype.SecretsRotationV1{
+			Time:                    r.Time,
+			PreviousSecretsProvider: r.PreviousSecretsProvider,
+			SecretsProvider:         r.SecretsProvider,
+			ConfigSecrets:           r.ConfigSecrets,
+			StateSecrets:            r.StateSecrets,
+		})
+	}
+
 	return &apitype.DeploymentV3{
 		Manifest:          manifest,
 		Resources:         resources,
 		SecretsProviders:  secretsProvider,
 		PendingOperations: operations,
+		SecretsRotations:  rotations,
 	}, nil
 }
 
+// batchEncryptSecrets encrypts every secret in the snapshot that isn't already in the crypter's cache with a single
+// call to BatchEncrypt, and caches the results.
+func batchEncryptSecrets(ctx context.Context, snap *deploy.Snapshot, sm secrets.Manager, c *cachingCrypter,
+	showSecrets bool,
+) error {
+	var found []*resource.Secret
+	for _, res := range snap.Resources {
+		collectSecrets(&found, resource.NewObjectProperty(res.Inputs))
+		collectSecrets(&found, resource.NewObjectProperty(res.Outputs))
+	}
+	for _, op := range snap.PendingOperations {
+		collectSecrets(&found, resource.NewObjectProperty(op.Resource.Inputs))
+		collectSecrets(&found, resource.NewObjectProperty(op.Resource.Outputs))
+	}
+
+	var toEncrypt []*resource.Secret
+	var plaintexts []string
+	seen := make(map[*resource.Secret]bool)
+	for _, secret := range found {
+		if seen[secret] {
+			continue
+		}
+		seen[secret] = true
+
+		plaintext, err := secretPlaintext(secret, showSecrets)
+		if err != nil {
+			return err
+		}
+		if !c.cached(secret, plaintext) {
+			toEncrypt = append(toEncrypt, secret)
+			plaintexts = append(plaintexts, plaintext)
+		}
+	}
+	if len(plaintexts) == 0 {
+		return nil
+	}
+
+	ciphertexts, err := secrets.BatchEncrypt(ctx, sm, plaintexts)
+	if err != nil {
+		return err
+	}
+	if len(ciphertexts) != len(plaintexts) {
+		return fmt.Errorf("expected %d ciphertexts, got %d", len(plaintexts), len(ciphertexts))
+	}
+	for i, secret := range toEncrypt {
+		c.insert(secret, plaintexts[i], ciphertexts[i])
+	}
+	return nil
+}
+
+// collectSecrets collects the secrets in a property value. It doesn't descend into secrets, since nested secrets are
+// encrypted as part of the plaintext of their parent.
+func collectSecrets(secrets *[]*resource.Secret, prop resource.PropertyValue) {
+	switch {
+	case prop.IsSecret():
+		*secrets = append(*secrets, prop.SecretValue())
+	case prop.IsArray():
+		for _, v := range prop.ArrayValue() {
+			collectSecrets(secrets, v)
+		}
+	case prop.IsObject():
+		for _, v := range prop.ObjectValue() {
+			collectSecrets(secrets, v)
+		}
+	}
+}
+
+// secretPlaintext returns the plaintext that is encrypted to serialize the given secret.
+func secretPlaintext(secret *resource.Secret, showSecrets bool) (string, error) {
+	value, err := SerializePropertyValue(secret.Element, config.NopEncrypter, showSecrets)
+	if err != nil {
+		return "", err
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encoding serialized property value: %w", err)
+	}
+	return string(bytes), nil
+}
+
 // UnmarshalUntypedDeployment unmarshals a raw untyped deployment into an up to date deployment object.
 func UnmarshalUntypedDeployment(
 	ctx context.Context,
@@ -257,10 +360,17 @@ func DeserializeDeploymentV3(
 		}
 
 		// Decrypt the collected secrets and create a decrypter that will use the result as a cache.
-		cache, err := d.BulkDecrypt(ctx, ciphertexts)
+		plaintexts, err := secrets.BatchDecrypt(ctx, secretsManager, ciphertexts)
 		if err != nil {
 			return nil, err
 		}
+		if len(plaintexts) != len(ciphertexts) {
+			return nil, fmt.Errorf("expected %d plaintexts, got %d", len(ciphertexts), len(plaintexts))
+		}
+		cache := make(map[string]string, len(ciphertexts))
+		for i, ct := range ciphertexts {
+			cache[ct] = plaintexts[i]
+		}
 		dec = newMapDecrypter(d, cache)
 
 		e, err := secretsManager.Encrypter()
@@ -289,7 +399,17 @@ func DeserializeDeploymentV3(
 		ops = append(ops, desop)
 	}
 
-	return deploy.NewSnapshot(*manifest, secretsManager, resources, ops), nil
+	snap := deploy.NewSnapshot(*manifest, secretsManager, resources, ops)
+	for _, r := range deployment.SecretsRotations {
+		snap.SecretsRotations = append(snap.SecretsRotations, deploy.SecretsRotation{
+			Time:                    r.Time,
+			PreviousSecretsProvider: r.PreviousSecretsProvider,
+			SecretsProvider:         r.SecretsProvider,
+			ConfigSecrets:           r.ConfigSecrets,
+			StateSecrets:            r.StateSecrets,
+		})
+	}
+	return snap, nil
 }
 
 // SerializeResource turns a resource into a structure suitable for serialization.
@@ -339,12 +459,20 @@ func SerializeResource(res *resource.State, enc config.Encrypter, showSecrets bo
 		Created:                 res.Created,
 		Modified:                res.Modified,
 		SourcePosition:          res.SourcePosition,
+		Frozen:                  res.Frozen,
 	}
 
 	if res.CustomTimeouts.IsNotEmpty() {
 		v3Resource.CustomTimeouts = &res.CustomTimeouts
 	}
 
+	if res.RetryPolicy.IsNotEmpty() {
+		v3Resource.RetryPolicy = &res.RetryPolicy
+	}
+	if len(res.OutputMetadata) > 0 {
+		v3Resource.OutputMetadata = res.OutputMetadata
+	}
+
 	return v3Resource, nil
 }
 
@@ -521,11 +649,15 @@ func DeserializeResource(res apitype.ResourceV3, dec config.Decrypter, enc confi
 		return nil, fmt.Errorf("resource '%s' has 'custom' false but non-empty ID", res.URN)
 	}
 
-	return resource.NewState(
+	state := resource.NewState(
 		res.Type, res.URN, res.Custom, res.Delete, res.ID,
 		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider,
 		res.PropertyDependencies, res.PendingReplacement, res.AdditionalSecretOutputs, res.Aliases, res.CustomTimeouts,
-		res.ImportID, res.RetainOnDelete, res.DeletedWith, res.Created, res.Modified, res.SourcePosition), nil
+		res.ImportID, res.RetainOnDelete, res.DeletedWith, res.Created, res.Modified, res.SourcePosition,
+		res.RetryPolicy)
+	state.OutputMetadata = res.OutputMetadata
+	state.Frozen = res.Frozen
+	return state, nil
 }
 
 // DeserializeOperation hydrates a pending resource/operation pair.
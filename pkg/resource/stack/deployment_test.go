@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
 
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -103,6 +105,7 @@ func TestDeploymentSerialization(t *testing.T) {
 		nil,
 		nil,
 		"",
+		nil,
 	)
 
 	dep, err := SerializeResource(res, config.NopEncrypter, false /* showSecrets */)
@@ -192,6 +195,61 @@ func TestDeploymentSerialization(t *testing.T) {
 	assert.Equal(t, 0, len(dep.Outputs["out-empty-map"].(map[string]interface{})))
 }
 
+func TestOutputMetadataSerialization(t *testing.T) {
+	t.Parallel()
+
+	res := &resource.State{
+		Type: "pulumi:pulumi:Stack",
+		URN:  "urn:pulumi:stack::project::pulumi:pulumi:Stack::project-stack",
+		Outputs: resource.PropertyMap{
+			"endpoint": resource.NewStringProperty("https://example.com"),
+		},
+		OutputMetadata: map[resource.PropertyKey]resource.OutputMetadata{
+			"endpoint": {
+				Description: "The public endpoint.",
+				Schema:      `{"type":"string"}`,
+			},
+		},
+	}
+
+	dep, err := SerializeResource(res, config.NopEncrypter, false /* showSecrets */)
+	require.NoError(t, err)
+	assert.Equal(t, res.OutputMetadata, dep.OutputMetadata)
+
+	back, err := DeserializeResource(dep, config.NopDecrypter, config.NopEncrypter)
+	require.NoError(t, err)
+	assert.Equal(t, res.OutputMetadata, back.OutputMetadata)
+}
+
+func TestSecretsRotationsSerialization(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	snap := deploy.NewSnapshot(deploy.Manifest{}, b64.NewBase64SecretsManager(), nil, nil)
+	snap.SecretsRotations = []deploy.SecretsRotation{{
+		Time:                    time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC),
+		PreviousSecretsProvider: "passphrase",
+		SecretsProvider:         "awskms://alias/key",
+		ConfigSecrets:           2,
+		StateSecrets:            5,
+	}}
+
+	dep, err := SerializeDeployment(snap, nil, false /* showSecrets */)
+	require.NoError(t, err)
+	require.Len(t, dep.SecretsRotations, 1)
+
+	// The rotations are valid according to the deployment schema.
+	bytes, err := json.Marshal(dep)
+	require.NoError(t, err)
+	untyped := &apitype.UntypedDeployment{Version: apitype.DeploymentSchemaVersionCurrent, Deployment: bytes}
+	require.NoError(t, ValidateUntypedDeployment(untyped))
+
+	back, err := DeserializeUntypedDeployment(ctx, untyped, b64.Base64SecretsProvider)
+	require.NoError(t, err)
+	assert.Equal(t, snap.SecretsRotations, back.SecretsRotations)
+}
+
 func TestLoadTooNewDeployment(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -20,8 +20,13 @@ import (
 	"fmt"
 
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/age"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/cache"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/chain"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/cloud"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/external"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/namespaced"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/passphrase"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/service"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -50,6 +55,14 @@ func (defaultSecretsProvider) OfType(ty string, state json.RawMessage) (secrets.
 		sm, err = service.NewServiceSecretsManagerFromState(state)
 	case cloud.Type:
 		sm, err = cloud.NewCloudSecretsManagerFromState(state)
+	case age.Type:
+		sm, err = age.NewAgeSecretsManagerFromState(state)
+	case external.Type:
+		sm, err = external.NewExternalSecretsManagerFromState(state)
+	case chain.Type:
+		sm, err = chain.NewChainedSecretsManagerFromState(state, DefaultSecretsProvider)
+	case namespaced.Type:
+		sm, err = namespaced.NewNamespacedSecretsManagerFromState(state, DefaultSecretsProvider)
 	default:
 		return nil, fmt.Errorf("no known secrets provider for type %q", ty)
 	}
@@ -57,7 +70,12 @@ func (defaultSecretsProvider) OfType(ty string, state json.RawMessage) (secrets.
 		return nil, fmt.Errorf("constructing secrets manager of type %q: %w", ty, err)
 	}
 
-	return NewCachingSecretsManager(sm), nil
+	// Composite secrets managers are made of managers constructed by this provider, which are instrumented instead.
+	if ty != chain.Type && ty != namespaced.Type {
+		sm = secrets.NewInstrumentedSecretsManager(sm, secrets.DefaultMetrics)
+	}
+
+	return NewCachingSecretsManager(cache.NewCachingSecretsManager(sm, cache.Default)), nil
 }
 
 type cacheEntry struct {
@@ -110,6 +128,18 @@ func (csm *cachingSecretsManager) Decrypter() (config.Decrypter, error) {
 	}, nil
 }
 
+func (csm *cachingSecretsManager) Validate(ctx context.Context) error {
+	return secrets.Validate(ctx, csm.manager)
+}
+
+func (csm *cachingSecretsManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	return secrets.BatchEncrypt(ctx, csm.manager, plaintexts)
+}
+
+func (csm *cachingSecretsManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	return secrets.BatchDecrypt(ctx, csm.manager, ciphertexts)
+}
+
 type cachingCrypter struct {
 	encrypter config.Encrypter
 	decrypter config.Decrypter
@@ -147,6 +177,12 @@ func (c *cachingCrypter) encryptSecret(secret *resource.Secret, plaintext string
 	return ciphertext, nil
 }
 
+// cached returns true if the cache holds a ciphertext for the given secret and plaintext.
+func (c *cachingCrypter) cached(secret *resource.Secret, plaintext string) bool {
+	entry, ok := c.cache[secret]
+	return ok && entry.plaintext == plaintext
+}
+
 // insert associates the given secret with the given plain- and ciphertext in the cache.
 func (c *cachingCrypter) insert(secret *resource.Secret, plaintext, ciphertext string) {
 	c.cache[secret] = cacheEntry{plaintext, ciphertext}
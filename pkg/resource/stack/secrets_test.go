@@ -9,7 +9,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
@@ -18,8 +20,10 @@ import (
 )
 
 type testSecretsManager struct {
-	encryptCalls int
-	decryptCalls int
+	encryptCalls      int
+	decryptCalls      int
+	batchEncryptCalls int
+	batchDecryptCalls int
 }
 
 func (t *testSecretsManager) Type() string { return "test" }
@@ -34,6 +38,16 @@ func (t *testSecretsManager) Decrypter() (config.Decrypter, error) {
 	return t, nil
 }
 
+func (t *testSecretsManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	t.batchEncryptCalls++
+	return secrets.DefaultBatchEncrypt(ctx, t, plaintexts)
+}
+
+func (t *testSecretsManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	t.batchDecryptCalls++
+	return secrets.DefaultBatchDecrypt(ctx, t, ciphertexts)
+}
+
 func (t *testSecretsManager) EncryptValue(
 	ctx context.Context, plaintext string,
 ) (string, error) {
@@ -267,3 +281,73 @@ func TestMapCrypter(t *testing.T) {
 	assert.Equal(t, 1, d.bulkDecryptCalls)
 	assert.Equal(t, 0, d.decryptCalls)
 }
+
+type testSecretsProvider struct {
+	sm secrets.Manager
+}
+
+func (p *testSecretsProvider) OfType(ty string, state json.RawMessage) (secrets.Manager, error) {
+	return p.sm, nil
+}
+
+func TestBatchEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	sm := &testSecretsManager{}
+	csm := NewCachingSecretsManager(sm)
+
+	newResource := func(name string, props resource.PropertyMap) *resource.State {
+		return &resource.State{
+			URN:     resource.NewURN("stack", "proj", "", "pkg:index:typ", name),
+			Type:    "pkg:index:typ",
+			Inputs:  props,
+			Outputs: props,
+		}
+	}
+	snap := deploy.NewSnapshot(deploy.Manifest{}, csm, []*resource.State{
+		newResource("a", resource.PropertyMap{
+			"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+			"nested": resource.NewObjectProperty(resource.PropertyMap{
+				"list": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.MakeSecret(resource.NewStringProperty("one")),
+					resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+						"inner": resource.MakeSecret(resource.NewStringProperty("two")),
+					})),
+				}),
+			}),
+		}),
+		newResource("b", resource.PropertyMap{
+			"token": resource.MakeSecret(resource.NewStringProperty("abc")),
+		}),
+	}, nil)
+
+	// All of the secrets are encrypted in a single batch. Nested secrets are encrypted as part of their parent, and
+	// secrets shared by the inputs and outputs are only encrypted once.
+	deployment, err := SerializeDeployment(snap, nil, false /* showSecrets */)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sm.batchEncryptCalls)
+	assert.Equal(t, 4, sm.encryptCalls)
+
+	// Serializing the snapshot again re-uses the cached ciphertexts.
+	_, err = SerializeDeployment(snap, nil, false /* showSecrets */)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sm.batchEncryptCalls)
+	assert.Equal(t, 4, sm.encryptCalls)
+
+	// Deserializing decrypts all of the secrets in a single batch.
+	deployment.SecretsProviders = &apitype.SecretsProvidersV1{Type: "test"}
+	bytes, err := json.Marshal(deployment)
+	require.NoError(t, err)
+	var roundTripped apitype.DeploymentV3
+	require.NoError(t, json.Unmarshal(bytes, &roundTripped))
+	deserialized, err := DeserializeDeploymentV3(ctx, roundTripped, &testSecretsProvider{sm: sm})
+	require.NoError(t, err)
+	assert.Equal(t, 1, sm.batchDecryptCalls)
+	require.Len(t, deserialized.Resources, 2)
+	for i, res := range deserialized.Resources {
+		assert.True(t, snap.Resources[i].Inputs.DeepEquals(res.Inputs))
+		assert.True(t, snap.Resources[i].Outputs.DeepEquals(res.Outputs))
+	}
+}
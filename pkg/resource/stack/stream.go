@@ -0,0 +1,380 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// A deployment stream is a deployment written as newline delimited JSON (NDJSON), so that it can be written and read
+// one resource at a time instead of all at once. The first line records the version of the deployment:
+//
+//	{"version":3}
+//
+// Every following line is an object with a single property that holds one part of the deployment. Resources and
+// pending operations are written one per line, under the properties "resource" and "pending_operation", and every
+// other property of the deployment, such as its manifest, is written on a line of its own under its usual name:
+//
+//	{"manifest":{...}}
+//	{"resource":{...}}
+//	{"resource":{...}}
+//	{"pending_operation":{...}}
+//
+// Properties appear in the order they do in the deployment, so all of its resources are on consecutive lines.
+
+// streamedArrays maps the properties of a deployment that are streamed one element per line to the property each
+// element is written under.
+var streamedArrays = map[string]string{
+	"resources":          "resource",
+	"pending_operations": "pending_operation",
+}
+
+// deploymentProperty returns the property of a deployment that a line of a stream with the given property is part of,
+// and whether that deployment property is an array.
+func deploymentProperty(line string) (string, bool) {
+	for property, element := range streamedArrays {
+		if element == line {
+			return property, true
+		}
+	}
+	return line, false
+}
+
+// EncodeDeploymentStream reads the JSON of a deployment of the given version from dec and writes it to w as a
+// deployment stream. Only one resource is held in memory at a time. A null deployment is written as a stream that
+// holds only the version.
+func EncodeDeploymentStream(w io.Writer, version int, dec *json.Decoder) error {
+	bw := bufio.NewWriter(w)
+	enc := &streamEncoder{w: bw}
+	if err := enc.writeLine("version", json.RawMessage(fmt.Sprint(version))); err != nil {
+		return err
+	}
+
+	// A null deployment is written as just its version.
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return bw.Flush()
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected {, found %v", tok)
+	}
+	for dec.More() {
+		key, err := decodeKey(dec)
+		if err != nil {
+			return err
+		}
+
+		if element, ok := streamedArrays[key]; ok {
+			if err := enc.writeArray(dec, element); err != nil {
+				return fmt.Errorf("reading %s: %w", key, err)
+			}
+			continue
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("reading %s: %w", key, err)
+		}
+		if err := enc.writeLine(key, value); err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// EncodeUntypedDeploymentStream writes the given deployment to w as a deployment stream.
+func EncodeUntypedDeploymentStream(w io.Writer, deployment *apitype.UntypedDeployment) error {
+	dec := json.NewDecoder(bytes.NewReader(deployment.Deployment))
+	return EncodeDeploymentStream(w, deployment.Version, dec)
+}
+
+type streamEncoder struct {
+	w   *bufio.Writer
+	buf bytes.Buffer
+}
+
+// writeLine writes a single line of a deployment stream.
+func (e *streamEncoder) writeLine(key string, value json.RawMessage) error {
+	e.buf.Reset()
+	k, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	e.buf.WriteByte('{')
+	e.buf.Write(k)
+	e.buf.WriteByte(':')
+	// Values may be indented over several lines, but each must fit on exactly one line of the stream.
+	if err := json.Compact(&e.buf, value); err != nil {
+		return fmt.Errorf("reading %s: %w", key, err)
+	}
+	e.buf.WriteString("}\n")
+	_, err = e.w.Write(e.buf.Bytes())
+	return err
+}
+
+// writeArray writes each element of the JSON array read from dec as a line of its own.
+func (e *streamEncoder) writeArray(dec *json.Decoder, element string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected an array, found %v", tok)
+	}
+	for dec.More() {
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		if err := e.writeLine(element, value); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// expectDelim reads the next token from dec, which must be the given delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %v, found %v", want, tok)
+	}
+	return nil
+}
+
+// decodeKey reads the next object key from dec.
+func decodeKey(dec *json.Decoder) (string, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+	key, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("expected an object key, found %v", tok)
+	}
+	return key, nil
+}
+
+// DeploymentStreamReader reads a deployment stream.
+type DeploymentStreamReader struct {
+	// OnResource, if set, is called with each resource of the deployment as it's read. An error it returns stops the
+	// stream from being read.
+	OnResource func(res apitype.ResourceV3) error
+	// OnPendingOperation, if set, is called with each pending operation of the deployment as it's read, and returns
+	// whether to keep it. An error it returns stops the stream from being read.
+	OnPendingOperation func(op apitype.OperationV2) (bool, error)
+	// OnEnd, if set, is called once the whole stream has been read. An error it returns fails the read, so that
+	// nothing is done with a deployment found to be invalid once all of it has been seen.
+	OnEnd func() error
+
+	dec     *json.Decoder
+	version int
+}
+
+// NewDeploymentStreamReader returns a reader for the deployment stream read from r, reading the version of the
+// deployment from its first line.
+func NewDeploymentStreamReader(r io.Reader) (*DeploymentStreamReader, error) {
+	dec := json.NewDecoder(r)
+	var header struct {
+		Version *int `json:"version"`
+	}
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf("reading deployment stream header: %w", err)
+	}
+	if header.Version == nil {
+		return nil, errors.New("reading deployment stream header: the first line must record the deployment version")
+	}
+	return &DeploymentStreamReader{dec: dec, version: *header.Version}, nil
+}
+
+// Version returns the version of the deployment being read.
+func (r *DeploymentStreamReader) Version() int {
+	return r.version
+}
+
+// WriteTo writes the JSON of the deployment read from the stream to w, one line of the stream at a time.
+func (r *DeploymentStreamReader) WriteTo(w io.Writer) (int64, error) {
+	if (r.OnResource != nil || r.OnPendingOperation != nil) && r.version != apitype.DeploymentSchemaVersionCurrent {
+		return 0, fmt.Errorf("deployment streams of version %d can't be inspected; expected version %d",
+			r.version, apitype.DeploymentSchemaVersionCurrent)
+	}
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	if err := r.write(cw); err != nil {
+		return cw.n, err
+	}
+	if err := cw.w.Flush(); err != nil {
+		return cw.n, err
+	}
+	if r.OnEnd != nil {
+		if err := r.OnEnd(); err != nil {
+			return cw.n, err
+		}
+	}
+	return cw.n, nil
+}
+
+func (r *DeploymentStreamReader) write(w io.Writer) error {
+	seen := make(map[string]bool)
+	open := "" // the array property whose elements are being written, if any.
+	first := true
+
+	write := func(s string) error {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+
+	if err := write("{"); err != nil {
+		return err
+	}
+	for line := 2; ; line++ {
+		var entry map[string]json.RawMessage
+		if err := r.dec.Decode(&entry); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("reading line %d of deployment stream: %w", line, err)
+		}
+		if len(entry) != 1 {
+			keys := make([]string, 0, len(entry))
+			for k := range entry {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			return fmt.Errorf("line %d of deployment stream must have exactly one property, found %v", line, keys)
+		}
+
+		for key, value := range entry {
+			property, isArray := deploymentProperty(key)
+
+			keep, err := r.visit(key, value)
+			if err != nil {
+				return err
+			}
+
+			if property != open {
+				if open != "" {
+					if err := write("]"); err != nil {
+						return err
+					}
+					open = ""
+				}
+				if seen[property] {
+					return fmt.Errorf("line %d of deployment stream repeats %q", line, property)
+				}
+			}
+
+			if !keep {
+				continue
+			}
+
+			var sep string
+			switch {
+			case property == open:
+				sep = ","
+			default:
+				seen[property] = true
+				name, err := json.Marshal(property)
+				if err != nil {
+					return err
+				}
+				if !first {
+					sep = ","
+				}
+				sep += string(name) + ":"
+				if isArray {
+					sep += "["
+					open = property
+				}
+			}
+			first = false
+			if err := write(sep); err != nil {
+				return err
+			}
+			if _, err := w.Write(value); err != nil {
+				return err
+			}
+		}
+	}
+	if open != "" {
+		if err := write("]"); err != nil {
+			return err
+		}
+	}
+	return write("}")
+}
+
+// visit calls the reader's callbacks for the given line of the stream, and returns whether to keep it.
+func (r *DeploymentStreamReader) visit(key string, value json.RawMessage) (bool, error) {
+	switch {
+	case key == "resource" && r.OnResource != nil:
+		var res apitype.ResourceV3
+		if err := json.Unmarshal(value, &res); err != nil {
+			return false, fmt.Errorf("reading resource: %w", err)
+		}
+		return true, r.OnResource(res)
+	case key == "pending_operation" && r.OnPendingOperation != nil:
+		var op apitype.OperationV2
+		if err := json.Unmarshal(value, &op); err != nil {
+			return false, fmt.Errorf("reading pending operation: %w", err)
+		}
+		return r.OnPendingOperation(op)
+	default:
+		return true, nil
+	}
+}
+
+// ReadUntypedDeployment reads the whole deployment from the stream into memory.
+func (r *DeploymentStreamReader) ReadUntypedDeployment() (*apitype.UntypedDeployment, error) {
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return &apitype.UntypedDeployment{
+		Version:    r.version,
+		Deployment: buf.Bytes(),
+	}, nil
+}
+
+type countingWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
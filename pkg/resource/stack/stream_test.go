@@ -0,0 +1,150 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func streamTestDeployment(t *testing.T) *apitype.UntypedDeployment {
+	dep := apitype.DeploymentV3{
+		Manifest: apitype.ManifestV1{Version: "v1.0.0"},
+		Resources: []apitype.ResourceV3{
+			{URN: "urn:pulumi:stack::proj::pulumi:pulumi:Stack::proj-stack", Type: "pulumi:pulumi:Stack"},
+			{
+				URN:    "urn:pulumi:stack::proj::pkg:index:Thing::a",
+				Type:   "pkg:index:Thing",
+				Parent: "urn:pulumi:stack::proj::pulumi:pulumi:Stack::proj-stack",
+				Inputs: map[string]interface{}{"text": "line one\nline two"},
+			},
+		},
+		PendingOperations: []apitype.OperationV2{{
+			Resource: apitype.ResourceV3{URN: "urn:pulumi:stack::proj::pkg:index:Thing::b"},
+			Type:     apitype.OperationTypeCreating,
+		}},
+	}
+	// Indent the deployment, as every line of the stream must still hold a whole resource.
+	data, err := json.MarshalIndent(dep, "", "    ")
+	require.NoError(t, err)
+	return &apitype.UntypedDeployment{Version: 3, Deployment: data}
+}
+
+func TestDeploymentStream_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	deployment := streamTestDeployment(t)
+
+	var stream bytes.Buffer
+	require.NoError(t, EncodeUntypedDeploymentStream(&stream, deployment))
+	lines := strings.Split(strings.TrimSuffix(stream.String(), "\n"), "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, `{"version":3}`, lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], `{"manifest":`))
+	assert.True(t, strings.HasPrefix(lines[2], `{"resource":`))
+	assert.True(t, strings.HasPrefix(lines[3], `{"resource":`))
+	assert.True(t, strings.HasPrefix(lines[4], `{"pending_operation":`))
+
+	r, err := NewDeploymentStreamReader(&stream)
+	require.NoError(t, err)
+	assert.Equal(t, 3, r.Version())
+	actual, err := r.ReadUntypedDeployment()
+	require.NoError(t, err)
+
+	var expected, got apitype.DeploymentV3
+	require.NoError(t, json.Unmarshal(deployment.Deployment, &expected))
+	require.NoError(t, json.Unmarshal(actual.Deployment, &got))
+	assert.Equal(t, expected, got)
+}
+
+func TestDeploymentStream_callbacks(t *testing.T) {
+	t.Parallel()
+
+	var stream bytes.Buffer
+	require.NoError(t, EncodeUntypedDeploymentStream(&stream, streamTestDeployment(t)))
+
+	r, err := NewDeploymentStreamReader(&stream)
+	require.NoError(t, err)
+
+	var urns []resource.URN
+	ended := false
+	r.OnResource = func(res apitype.ResourceV3) error {
+		urns = append(urns, res.URN)
+		return nil
+	}
+	r.OnPendingOperation = func(op apitype.OperationV2) (bool, error) {
+		return false, nil
+	}
+	r.OnEnd = func() error {
+		ended = true
+		return nil
+	}
+
+	actual, err := r.ReadUntypedDeployment()
+	require.NoError(t, err)
+	assert.Len(t, urns, 2)
+	assert.True(t, ended)
+
+	var got apitype.DeploymentV3
+	require.NoError(t, json.Unmarshal(actual.Deployment, &got))
+	assert.Len(t, got.Resources, 2)
+	assert.Empty(t, got.PendingOperations)
+}
+
+func TestDeploymentStream_invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		stream string
+		err    string
+	}{
+		{
+			desc:   "no version",
+			stream: `{"manifest":{}}`,
+			err:    "the first line must record the deployment version",
+		},
+		{
+			desc:   "several properties",
+			stream: "{\"version\":3}\n{\"manifest\":{},\"resource\":{}}\n",
+			err:    "line 2 of deployment stream must have exactly one property, found [manifest resource]",
+		},
+		{
+			desc:   "resources apart",
+			stream: "{\"version\":3}\n{\"resource\":{}}\n{\"manifest\":{}}\n{\"resource\":{}}\n",
+			err:    `line 4 of deployment stream repeats "resources"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewDeploymentStreamReader(strings.NewReader(tt.stream))
+			if err == nil {
+				_, err = r.ReadUntypedDeployment()
+			}
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
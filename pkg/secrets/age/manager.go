@@ -0,0 +1,257 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package age implements a secrets manager that encrypts secrets for one or more age recipients, so that stacks can
+// use keypairs kept offline instead of a passphrase or a cloud key management service.
+package age
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Type is the type of secrets managed by this secrets provider
+const Type = "age"
+
+// Scheme is the URL scheme of the age secrets provider. Providers have the form "age://<recipient>[,<recipient>...]".
+const Scheme = "age://"
+
+// IdentityFileEnvVar is the environment variable listing the identity files to decrypt secrets with. Multiple files
+// are separated by the OS's path list separator.
+const IdentityFileEnvVar = "PULUMI_AGE_IDENTITY_FILE"
+
+type ageSecretsManagerState struct {
+	URL          string `json:"url"`
+	EncryptedKey []byte `json:"encryptedkey"`
+}
+
+// IsAgeSecretsProvider returns true if the secrets provider is an age secrets provider.
+func IsAgeSecretsProvider(secretsProvider string) bool {
+	return strings.HasPrefix(secretsProvider, Scheme)
+}
+
+// parseRecipients parses the recipients from an age secrets provider URL.
+func parseRecipients(url string) ([]age.Recipient, error) {
+	if !IsAgeSecretsProvider(url) {
+		return nil, fmt.Errorf("invalid age secrets provider %q: must start with %s", url, Scheme)
+	}
+
+	var recipients []age.Recipient
+	for _, s := range strings.Split(strings.TrimPrefix(url, Scheme), ",") {
+		if s == "" {
+			continue
+		}
+		r, err := age.ParseX25519Recipient(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid age recipient %q: %w", s, err)
+		}
+		recipients = append(recipients, r)
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("invalid age secrets provider %q: no recipients", url)
+	}
+	return recipients, nil
+}
+
+// loadIdentities reads the identities from the files listed in PULUMI_AGE_IDENTITY_FILE.
+func loadIdentities() ([]age.Identity, error) {
+	paths, ok := os.LookupEnv(IdentityFileEnvVar)
+	if !ok || paths == "" {
+		return nil, fmt.Errorf("%s must be set to decrypt secrets encrypted with age", IdentityFileEnvVar)
+	}
+
+	var identities []age.Identity
+	for _, path := range filepath.SplitList(paths) {
+		if path == "" {
+			continue
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading age identity file: %w", err)
+		}
+		ids, err := age.ParseIdentities(f)
+		closeErr := f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("parsing age identity file %s: %w", path, err)
+		}
+		if closeErr != nil {
+			return nil, fmt.Errorf("reading age identity file: %w", closeErr)
+		}
+		identities = append(identities, ids...)
+	}
+	return identities, nil
+}
+
+// encryptDataKey encrypts the data key for all of the recipients in the URL.
+func encryptDataKey(url string, dataKey []byte) ([]byte, error) {
+	recipients, err := parseRecipients(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients...)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting data key: %w", err)
+	}
+	if _, err := w.Write(dataKey); err != nil {
+		return nil, fmt.Errorf("encrypting data key: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("encrypting data key: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decryptDataKey decrypts the data key with the identities in PULUMI_AGE_IDENTITY_FILE.
+func decryptDataKey(encryptedDataKey []byte) ([]byte, error) {
+	identities, err := loadIdentities()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := age.Decrypt(bytes.NewReader(encryptedDataKey), identities...)
+	if err != nil {
+		var noMatch *age.NoIdentityMatchError
+		if errors.As(err, &noMatch) {
+			return nil, fmt.Errorf("none of the identities in %s can decrypt the stack's secrets", IdentityFileEnvVar)
+		}
+		return nil, fmt.Errorf("decrypting data key: %w", err)
+	}
+	return io.ReadAll(r)
+}
+
+// newAgeSecretsManager returns a secrets manager that encrypts secrets with the given data key, which is stored in
+// its state encrypted for the recipients in the URL.
+func newAgeSecretsManager(url string, encryptedDataKey, dataKey []byte) (*Manager, error) {
+	state, err := json.Marshal(ageSecretsManagerState{
+		URL:          url,
+		EncryptedKey: encryptedDataKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling state: %w", err)
+	}
+	return &Manager{
+		crypter: secrets.NewSymmetricCrypter(dataKey),
+		state:   state,
+	}, nil
+}
+
+// Manager is the secrets.Manager implementation for age
+type Manager struct {
+	state   json.RawMessage
+	crypter config.Crypter
+}
+
+func (m *Manager) Type() string                         { return Type }
+func (m *Manager) State() json.RawMessage               { return m.state }
+func (m *Manager) Encrypter() (config.Encrypter, error) { return m.crypter, nil }
+func (m *Manager) Decrypter() (config.Decrypter, error) { return m.crypter, nil }
+
+// Validate checks that the data key can still be decrypted with the identities in PULUMI_AGE_IDENTITY_FILE.
+func (m *Manager) Validate(ctx context.Context) error {
+	var s ageSecretsManagerState
+	if err := json.Unmarshal(m.state, &s); err != nil {
+		return fmt.Errorf("unmarshalling state: %w", err)
+	}
+	if _, err := decryptDataKey(s.EncryptedKey); err != nil {
+		return fmt.Errorf("secrets provider %s can't decrypt the stack's data key; "+
+			"check that PULUMI_AGE_IDENTITY_FILE contains an identity for one of its recipients: %w", s.URL, err)
+	}
+	return nil
+}
+
+func EditProjectStack(info *workspace.ProjectStack, state json.RawMessage) error {
+	info.EncryptionSalt = ""
+
+	var s ageSecretsManagerState
+	err := json.Unmarshal(state, &s)
+	if err != nil {
+		return fmt.Errorf("unmarshalling age state: %w", err)
+	}
+
+	info.SecretsProvider = s.URL
+	info.EncryptedKey = base64.StdEncoding.EncodeToString(s.EncryptedKey)
+	return nil
+}
+
+// NewAgeSecretsManagerFromState deserializes configuration from state and returns a secrets manager that decrypts its
+// data key with the identities in PULUMI_AGE_IDENTITY_FILE.
+func NewAgeSecretsManagerFromState(state json.RawMessage) (secrets.Manager, error) {
+	var s ageSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return nil, fmt.Errorf("unmarshalling state: %w", err)
+	}
+
+	dataKey, err := decryptDataKey(s.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	return newAgeSecretsManager(s.URL, s.EncryptedKey, dataKey)
+}
+
+// NewAgeSecretsManager returns a secrets manager for the given age secrets provider, using the data key in info if it
+// was encrypted for the same recipients, or generating a new one otherwise.
+func NewAgeSecretsManager(info *workspace.ProjectStack,
+	secretsProvider string, rotateSecretsProvider bool,
+) (secrets.Manager, error) {
+	// Only a passphrase provider has an encryption salt.
+	info.EncryptionSalt = ""
+
+	// If we're rotating then just clear the key so we create a fresh one below
+	if rotateSecretsProvider {
+		info.EncryptedKey = ""
+	}
+
+	// If there is no key or the recipients are changing, generate a new key for the new recipients. This doesn't
+	// need an identity, as the plaintext key is already at hand.
+	if info.EncryptedKey == "" || info.SecretsProvider != secretsProvider {
+		dataKey := make([]byte, 32)
+		if _, err := rand.Read(dataKey); err != nil {
+			return nil, err
+		}
+		encryptedDataKey, err := encryptDataKey(secretsProvider, dataKey)
+		if err != nil {
+			return nil, err
+		}
+		info.EncryptedKey = base64.StdEncoding.EncodeToString(encryptedDataKey)
+		info.SecretsProvider = secretsProvider
+		return newAgeSecretsManager(secretsProvider, encryptedDataKey, dataKey)
+	}
+
+	encryptedDataKey, err := base64.StdEncoding.DecodeString(info.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := decryptDataKey(encryptedDataKey)
+	if err != nil {
+		return nil, err
+	}
+	return newAgeSecretsManager(secretsProvider, encryptedDataKey, dataKey)
+}
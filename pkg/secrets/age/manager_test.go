@@ -0,0 +1,112 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package age
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func writeIdentityFile(t *testing.T, identity *age.X25519Identity) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keys.txt")
+	err := os.WriteFile(path, []byte("# created: test\n"+identity.String()+"\n"), 0o600)
+	require.NoError(t, err)
+	return path
+}
+
+//nolint:paralleltest // mutates environment variables
+func TestAgeSecretsManager(t *testing.T) {
+	alice, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	bob, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	eve, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	url := Scheme + alice.Recipient().String() + "," + bob.Recipient().String()
+
+	// Creating a new secrets manager doesn't need an identity.
+	t.Setenv(IdentityFileEnvVar, "")
+	info := &workspace.ProjectStack{EncryptionSalt: "salt"}
+	sm, err := NewAgeSecretsManager(info, url, false)
+	require.NoError(t, err)
+	assert.Equal(t, url, info.SecretsProvider)
+	assert.NotEmpty(t, info.EncryptedKey)
+	assert.Empty(t, info.EncryptionSalt)
+	assert.Equal(t, Type, sm.Type())
+
+	enc, err := sm.Encrypter()
+	require.NoError(t, err)
+	ciphertext, err := enc.EncryptValue(context.Background(), "hunter2")
+	require.NoError(t, err)
+
+	// Loading it from its state needs an identity.
+	_, err = NewAgeSecretsManagerFromState(sm.State())
+	assert.ErrorContains(t, err, IdentityFileEnvVar+" must be set")
+
+	t.Setenv(IdentityFileEnvVar, writeIdentityFile(t, eve))
+	_, err = NewAgeSecretsManagerFromState(sm.State())
+	assert.ErrorContains(t, err, "none of the identities")
+
+	// Any of the recipients can decrypt, and the identity files are searched in order.
+	for _, identity := range []*age.X25519Identity{alice, bob} {
+		t.Setenv(IdentityFileEnvVar, writeIdentityFile(t, eve)+string(os.PathListSeparator)+writeIdentityFile(t, identity))
+
+		loaded, err := NewAgeSecretsManagerFromState(sm.State())
+		require.NoError(t, err)
+		dec, err := loaded.Decrypter()
+		require.NoError(t, err)
+		plaintext, err := dec.DecryptValue(context.Background(), ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", plaintext)
+
+		// Loading it from the project stack reuses the existing key.
+		fromConfig, err := NewAgeSecretsManager(info, url, false)
+		require.NoError(t, err)
+		assert.Equal(t, sm.State(), fromConfig.State())
+	}
+
+	// Changing the recipients generates a new key.
+	newURL := Scheme + alice.Recipient().String()
+	oldKey := info.EncryptedKey
+	_, err = NewAgeSecretsManager(info, newURL, false)
+	require.NoError(t, err)
+	assert.NotEqual(t, oldKey, info.EncryptedKey)
+	assert.Equal(t, newURL, info.SecretsProvider)
+
+	// The project stack can be restored from the state.
+	restored := &workspace.ProjectStack{}
+	require.NoError(t, EditProjectStack(restored, sm.State()))
+	assert.Equal(t, url, restored.SecretsProvider)
+	assert.Equal(t, oldKey, restored.EncryptedKey)
+}
+
+func TestAgeSecretsManagerInvalidRecipients(t *testing.T) {
+	t.Parallel()
+
+	for _, url := range []string{"age://", "age://not-a-recipient", "awskms://alias/test"} {
+		_, err := NewAgeSecretsManager(&workspace.ProjectStack{}, url, false)
+		assert.Error(t, err, url)
+	}
+}
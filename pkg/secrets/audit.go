@@ -0,0 +1,208 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// DecryptEvent describes a single decryption of a secret, as reported to an AuditSink.
+type DecryptEvent struct {
+	// Time is the time at which the secret was decrypted.
+	Time time.Time `json:"time"`
+	// Key identifies the secret that was decrypted. It's a fingerprint of the secret's ciphertext, so that audit logs
+	// never contain secret material but can still be correlated with the secrets in a stack's configuration or state.
+	Key string `json:"key"`
+	// Stack is the name of the stack whose secret was decrypted.
+	Stack string `json:"stack,omitempty"`
+	// Caller identifies who decrypted the secret.
+	Caller string `json:"caller,omitempty"`
+	// SecretsProvider is the type of the secrets manager that decrypted the secret.
+	SecretsProvider string `json:"secretsProvider"`
+	// Error is set if the secret could not be decrypted.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink receives an event for every decryption performed by a secrets manager returned by
+// NewAuditingSecretsManager. If the sink returns an error the decryption fails, so that secrets are never decrypted
+// without leaving an audit trail.
+type AuditSink interface {
+	AuditDecrypt(ctx context.Context, events []DecryptEvent) error
+}
+
+// AuditSinkFunc adapts a function to an AuditSink, e.g. to forward events to an external audit system.
+type AuditSinkFunc func(ctx context.Context, events []DecryptEvent) error
+
+func (f AuditSinkFunc) AuditDecrypt(ctx context.Context, events []DecryptEvent) error {
+	return f(ctx, events)
+}
+
+// NewFileAuditSink returns an AuditSink that appends events to the file at path, one JSON object per line. The file
+// is created if it doesn't exist.
+func NewFileAuditSink(path string) AuditSink {
+	return &fileAuditSink{path: path}
+}
+
+type fileAuditSink struct {
+	m    sync.Mutex
+	path string
+}
+
+func (s *fileAuditSink) AuditDecrypt(ctx context.Context, events []DecryptEvent) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening secrets audit log: %w", err)
+	}
+	enc := json.NewEncoder(f)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			contract.IgnoreClose(f)
+			return fmt.Errorf("writing secrets audit log: %w", err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing secrets audit log: %w", err)
+	}
+	return nil
+}
+
+// NewAuditingSecretsManager returns a Manager that reports every decryption performed by manager to sink. Its type
+// and state are those of manager.
+func NewAuditingSecretsManager(manager Manager, sink AuditSink, stack, caller string) Manager {
+	return &auditingSecretsManager{manager: manager, sink: sink, stack: stack, caller: caller}
+}
+
+type auditingSecretsManager struct {
+	manager Manager
+	sink    AuditSink
+	stack   string
+	caller  string
+}
+
+func (m *auditingSecretsManager) Type() string                         { return m.manager.Type() }
+func (m *auditingSecretsManager) State() json.RawMessage               { return m.manager.State() }
+func (m *auditingSecretsManager) Encrypter() (config.Encrypter, error) { return m.manager.Encrypter() }
+
+func (m *auditingSecretsManager) Validate(ctx context.Context) error {
+	return Validate(ctx, m.manager)
+}
+
+func (m *auditingSecretsManager) Decrypter() (config.Decrypter, error) {
+	dec, err := m.manager.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	return &auditingDecrypter{manager: m, decrypter: dec}, nil
+}
+
+func (m *auditingSecretsManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	return BatchEncrypt(ctx, m.manager, plaintexts)
+}
+
+func (m *auditingSecretsManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	plaintexts, err := BatchDecrypt(ctx, m.manager, ciphertexts)
+	if auditErr := m.audit(ctx, ciphertexts, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return plaintexts, err
+}
+
+// audit reports the decryption of ciphertexts to the sink. err is the error, if any, returned by the decryption.
+func (m *auditingSecretsManager) audit(ctx context.Context, ciphertexts []string, err error) error {
+	if len(ciphertexts) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var message string
+	if err != nil {
+		message = err.Error()
+	}
+	events := make([]DecryptEvent, len(ciphertexts))
+	for i, ct := range ciphertexts {
+		events[i] = DecryptEvent{
+			Time:            now,
+			Key:             fingerprint(ct),
+			Stack:           m.stack,
+			Caller:          m.caller,
+			SecretsProvider: m.manager.Type(),
+			Error:           message,
+		}
+	}
+	if err := m.sink.AuditDecrypt(ctx, events); err != nil {
+		return fmt.Errorf("auditing secrets decryption: %w", err)
+	}
+	return nil
+}
+
+// fingerprint returns a short, stable identifier for a ciphertext.
+func fingerprint(ciphertext string) string {
+	sum := sha256.Sum256([]byte(ciphertext))
+	return hex.EncodeToString(sum[:8])
+}
+
+type auditingDecrypter struct {
+	manager   *auditingSecretsManager
+	decrypter config.Decrypter
+}
+
+func (d *auditingDecrypter) DecryptValue(ctx context.Context, ciphertext string) (string, error) {
+	plaintext, err := d.decrypter.DecryptValue(ctx, ciphertext)
+	if auditErr := d.manager.audit(ctx, []string{ciphertext}, err); auditErr != nil {
+		return "", auditErr
+	}
+	return plaintext, err
+}
+
+func (d *auditingDecrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (map[string]string, error) {
+	plaintexts, err := d.decrypter.BulkDecrypt(ctx, ciphertexts)
+	if auditErr := d.manager.audit(ctx, ciphertexts, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return plaintexts, err
+}
+
+// NewAuditingProvider returns a Provider whose secrets managers report every decryption to sink.
+func NewAuditingProvider(provider Provider, sink AuditSink, stack, caller string) Provider {
+	return &auditingProvider{provider: provider, sink: sink, stack: stack, caller: caller}
+}
+
+type auditingProvider struct {
+	provider Provider
+	sink     AuditSink
+	stack    string
+	caller   string
+}
+
+func (p *auditingProvider) OfType(ty string, state json.RawMessage) (Manager, error) {
+	sm, err := p.provider.OfType(ty, state)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuditingSecretsManager(sm, p.sink, p.stack, p.caller), nil
+}
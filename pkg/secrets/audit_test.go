@@ -0,0 +1,117 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+func newAuditedManager(sink AuditSink) (Manager, config.Encrypter) {
+	crypter := config.NewSymmetricCrypter(make([]byte, config.SymmetricCrypterKeyBytes))
+	sm := &MockSecretsManager{
+		TypeF:      func() string { return "mock" },
+		EncrypterF: func() (config.Encrypter, error) { return crypter, nil },
+		DecrypterF: func() (config.Decrypter, error) { return crypter, nil },
+		BatchDecryptF: func(ciphertexts []string) ([]string, error) {
+			return DefaultBatchDecrypt(context.Background(), &MockSecretsManager{
+				DecrypterF: func() (config.Decrypter, error) { return crypter, nil },
+			}, ciphertexts)
+		},
+	}
+	return NewAuditingSecretsManager(sm, sink, "org/project/dev", "alice"), crypter
+}
+
+func TestAuditingSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sm, enc := newAuditedManager(NewFileAuditSink(path))
+	assert.Equal(t, "mock", sm.Type())
+
+	ct1, err := enc.EncryptValue(ctx, "one")
+	require.NoError(t, err)
+	ct2, err := enc.EncryptValue(ctx, "two")
+	require.NoError(t, err)
+
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	pt, err := dec.DecryptValue(ctx, ct1)
+	require.NoError(t, err)
+	assert.Equal(t, "one", pt)
+
+	pts, err := BatchDecrypt(ctx, sm, []string{ct1, ct2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, pts)
+
+	_, err = dec.DecryptValue(ctx, "not a ciphertext")
+	assert.Error(t, err)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var events []DecryptEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e DecryptEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, events, 4)
+	assert.Equal(t, fingerprint(ct1), events[0].Key)
+	assert.Equal(t, fingerprint(ct1), events[1].Key)
+	assert.Equal(t, fingerprint(ct2), events[2].Key)
+	for _, e := range events {
+		assert.Equal(t, "org/project/dev", e.Stack)
+		assert.Equal(t, "alice", e.Caller)
+		assert.Equal(t, "mock", e.SecretsProvider)
+		assert.False(t, e.Time.IsZero())
+	}
+	assert.Empty(t, events[0].Error)
+	assert.NotEmpty(t, events[3].Error)
+}
+
+func TestAuditingSecretsManagerSinkError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sm, enc := newAuditedManager(AuditSinkFunc(func(ctx context.Context, events []DecryptEvent) error {
+		return errors.New("audit system unavailable")
+	}))
+
+	ct, err := enc.EncryptValue(ctx, "secret")
+	require.NoError(t, err)
+
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	_, err = dec.DecryptValue(ctx, ct)
+	assert.ErrorContains(t, err, "audit system unavailable")
+
+	_, err = BatchDecrypt(ctx, sm, []string{ct})
+	assert.ErrorContains(t, err, "audit system unavailable")
+}
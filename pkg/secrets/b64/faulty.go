@@ -0,0 +1,153 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package b64
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+// ErrInjected is the error returned by the calls that a FaultyManager is configured to fail.
+var ErrInjected = errors.New("b64: injected failure")
+
+// FaultOptions configures the faults injected by a FaultyManager. Faults are injected deterministically, based on the
+// number of calls made to the manager, so that tests can exercise specific retry and error paths.
+type FaultOptions struct {
+	// Latency is added to every call. The call fails early if its context is canceled.
+	Latency time.Duration
+	// FailFirst is the number of calls that fail before any call succeeds.
+	FailFirst int
+	// FailEvery, if non-zero, makes every FailEvery-th call after the first FailFirst calls fail.
+	FailEvery int
+	// Err is the error returned by failing calls. Defaults to ErrInjected.
+	Err error
+}
+
+// CallCounts counts the calls made to a FaultyManager, including the calls that failed.
+type CallCounts struct {
+	Encrypt      int
+	Decrypt      int
+	BulkDecrypt  int
+	BatchEncrypt int
+	BatchDecrypt int
+}
+
+// Total returns the total number of calls.
+func (c CallCounts) Total() int {
+	return c.Encrypt + c.Decrypt + c.BulkDecrypt + c.BatchEncrypt + c.BatchDecrypt
+}
+
+// FaultyManager is a base64 secrets manager that injects latency and failures into its calls and counts them. Its
+// type and state are those of the manager returned by NewBase64SecretsManager, so deployments serialized with it can
+// be deserialized with Base64SecretsProvider.
+type FaultyManager struct {
+	manager
+
+	opts FaultOptions
+
+	m      sync.Mutex
+	counts CallCounts
+}
+
+var _ secrets.Manager = (*FaultyManager)(nil)
+
+// NewFaultyBase64SecretsManager returns a base64 secrets manager that injects the faults configured by opts.
+func NewFaultyBase64SecretsManager(opts FaultOptions) *FaultyManager {
+	return &FaultyManager{opts: opts}
+}
+
+// Calls returns the number of calls made to the manager so far.
+func (m *FaultyManager) Calls() CallCounts {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.counts
+}
+
+// call records a call, counted by count, and returns the fault to inject into it, if any.
+func (m *FaultyManager) call(ctx context.Context, count func(c *CallCounts)) error {
+	m.m.Lock()
+	count(&m.counts)
+	n := m.counts.Total()
+	m.m.Unlock()
+
+	if m.opts.Latency > 0 {
+		timer := time.NewTimer(m.opts.Latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+
+	fail := n <= m.opts.FailFirst
+	if !fail && m.opts.FailEvery > 0 {
+		fail = (n-m.opts.FailFirst)%m.opts.FailEvery == 0
+	}
+	if !fail {
+		return nil
+	}
+	if m.opts.Err != nil {
+		return m.opts.Err
+	}
+	return ErrInjected
+}
+
+func (m *FaultyManager) Encrypter() (config.Encrypter, error) { return &faultyCrypter{m}, nil }
+func (m *FaultyManager) Decrypter() (config.Decrypter, error) { return &faultyCrypter{m}, nil }
+
+func (m *FaultyManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	if err := m.call(ctx, func(c *CallCounts) { c.BatchEncrypt++ }); err != nil {
+		return nil, err
+	}
+	return secrets.BatchEncrypt(ctx, &m.manager, plaintexts)
+}
+
+func (m *FaultyManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	if err := m.call(ctx, func(c *CallCounts) { c.BatchDecrypt++ }); err != nil {
+		return nil, err
+	}
+	return secrets.BatchDecrypt(ctx, &m.manager, ciphertexts)
+}
+
+type faultyCrypter struct {
+	m *FaultyManager
+}
+
+func (c *faultyCrypter) EncryptValue(ctx context.Context, plaintext string) (string, error) {
+	if err := c.m.call(ctx, func(c *CallCounts) { c.Encrypt++ }); err != nil {
+		return "", err
+	}
+	return config.Base64Crypter.EncryptValue(ctx, plaintext)
+}
+
+func (c *faultyCrypter) DecryptValue(ctx context.Context, ciphertext string) (string, error) {
+	if err := c.m.call(ctx, func(c *CallCounts) { c.Decrypt++ }); err != nil {
+		return "", err
+	}
+	return config.Base64Crypter.DecryptValue(ctx, ciphertext)
+}
+
+func (c *faultyCrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (map[string]string, error) {
+	if err := c.m.call(ctx, func(c *CallCounts) { c.BulkDecrypt++ }); err != nil {
+		return nil, err
+	}
+	return config.Base64Crypter.BulkDecrypt(ctx, ciphertexts)
+}
@@ -0,0 +1,87 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package b64
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultyManagerFailures(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := NewFaultyBase64SecretsManager(FaultOptions{FailFirst: 2, FailEvery: 3})
+	assert.Equal(t, Type, m.Type())
+
+	enc, err := m.Encrypter()
+	require.NoError(t, err)
+
+	// Calls 1 and 2 fail, then every third call after them fails.
+	var failed []int
+	for i := 1; i <= 8; i++ {
+		if _, err := enc.EncryptValue(ctx, "secret"); err != nil {
+			assert.ErrorIs(t, err, ErrInjected)
+			failed = append(failed, i)
+		}
+	}
+	assert.Equal(t, []int{1, 2, 5, 8}, failed)
+	assert.Equal(t, CallCounts{Encrypt: 8}, m.Calls())
+}
+
+func TestFaultyManagerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := NewFaultyBase64SecretsManager(FaultOptions{})
+
+	cts, err := m.BatchEncrypt(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	pts, err := m.BatchDecrypt(ctx, cts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, pts)
+
+	dec, err := m.Decrypter()
+	require.NoError(t, err)
+	pt, err := dec.DecryptValue(ctx, cts[0])
+	require.NoError(t, err)
+	assert.Equal(t, "a", pt)
+	bulk, err := dec.BulkDecrypt(ctx, cts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{cts[0]: "a", cts[1]: "b"}, bulk)
+
+	assert.Equal(t, CallCounts{Decrypt: 1, BulkDecrypt: 1, BatchEncrypt: 1, BatchDecrypt: 1}, m.Calls())
+}
+
+func TestFaultyManagerLatency(t *testing.T) {
+	t.Parallel()
+
+	custom := errors.New("boom")
+	m := NewFaultyBase64SecretsManager(FaultOptions{Latency: time.Hour, FailFirst: 1, Err: custom})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := m.BatchEncrypt(ctx, []string{"a"})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	m = NewFaultyBase64SecretsManager(FaultOptions{Latency: time.Millisecond, FailFirst: 1, Err: custom})
+	_, err = m.BatchEncrypt(context.Background(), []string{"a"})
+	assert.ErrorIs(t, err, custom)
+}
@@ -0,0 +1,245 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache implements an in-process cache of decrypted secrets, so that the same ciphertext isn't decrypted
+// repeatedly by the components of a single operation, e.g. when the engine, the snapshot manager and the config
+// loader each deserialize the same secrets.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+// DefaultMaxBytes is the size limit of the Default cache.
+const DefaultMaxBytes = 32 << 20
+
+// Default is the cache shared by the secrets managers of this process.
+var Default = New(DefaultMaxBytes)
+
+type entry struct {
+	key       string
+	plaintext string
+}
+
+// Cache is a size-limited cache of decrypted secrets keyed by ciphertext. When it's full, the least recently used
+// secrets are evicted. It's safe for concurrent use.
+type Cache struct {
+	m        sync.Mutex
+	maxBytes int
+	size     int
+	lru      *list.List
+	entries  map[string]*list.Element
+}
+
+// New returns a cache that holds at most maxBytes of ciphertexts and plaintexts.
+func New(maxBytes int) *Cache {
+	return &Cache{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+func cacheKey(scope, ciphertext string) string {
+	return scope + "\x00" + ciphertext
+}
+
+func entrySize(e *entry) int {
+	return len(e.key) + len(e.plaintext)
+}
+
+// Get returns the plaintext of the given ciphertext of the secrets manager identified by scope, if it's cached.
+func (c *Cache) Get(scope, ciphertext string) (string, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	elem, ok := c.entries[cacheKey(scope, ciphertext)]
+	if !ok {
+		return "", false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*entry).plaintext, true
+}
+
+// Put caches the plaintext of the given ciphertext of the secrets manager identified by scope.
+func (c *Cache) Put(scope, ciphertext, plaintext string) {
+	e := &entry{key: cacheKey(scope, ciphertext), plaintext: plaintext}
+	if entrySize(e) > c.maxBytes {
+		return
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if elem, ok := c.entries[e.key]; ok {
+		c.remove(elem)
+	}
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.size += entrySize(e)
+	for c.size > c.maxBytes {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Len returns the number of cached secrets.
+func (c *Cache) Len() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.lru.Len()
+}
+
+// Clear evicts every secret from the cache.
+func (c *Cache) Clear() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.entries, e.key)
+	c.size -= entrySize(e)
+}
+
+// managerScope identifies the secrets manager of the given type and state, so that the ciphertexts of different
+// secrets managers never share cache entries.
+func managerScope(ty string, state json.RawMessage) string {
+	h := sha256.New()
+	h.Write([]byte(ty))
+	h.Write([]byte{0})
+	h.Write(state)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type cachingSecretsManager struct {
+	manager secrets.Manager
+	cache   *Cache
+	scope   string
+}
+
+// NewCachingSecretsManager returns a secrets manager that looks up the plaintexts of the ciphertexts it decrypts
+// in cache before decrypting them with manager.
+func NewCachingSecretsManager(manager secrets.Manager, cache *Cache) secrets.Manager {
+	return &cachingSecretsManager{
+		manager: manager,
+		cache:   cache,
+		scope:   managerScope(manager.Type(), manager.State()),
+	}
+}
+
+func (m *cachingSecretsManager) Type() string           { return m.manager.Type() }
+func (m *cachingSecretsManager) State() json.RawMessage { return m.manager.State() }
+
+func (m *cachingSecretsManager) Encrypter() (config.Encrypter, error) {
+	return m.manager.Encrypter()
+}
+
+func (m *cachingSecretsManager) Decrypter() (config.Decrypter, error) {
+	dec, err := m.manager.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	return &cachingDecrypter{decrypter: dec, cache: m.cache, scope: m.scope}, nil
+}
+
+func (m *cachingSecretsManager) Validate(ctx context.Context) error {
+	return secrets.Validate(ctx, m.manager)
+}
+
+func (m *cachingSecretsManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	return secrets.BatchEncrypt(ctx, m.manager, plaintexts)
+}
+
+func (m *cachingSecretsManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	plaintexts := make([]string, len(ciphertexts))
+	var missing []int
+	for i, ciphertext := range ciphertexts {
+		if plaintext, ok := m.cache.Get(m.scope, ciphertext); ok {
+			plaintexts[i] = plaintext
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return plaintexts, nil
+	}
+
+	batch := make([]string, len(missing))
+	for j, i := range missing {
+		batch[j] = ciphertexts[i]
+	}
+	decrypted, err := secrets.BatchDecrypt(ctx, m.manager, batch)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range missing {
+		plaintexts[i] = decrypted[j]
+		m.cache.Put(m.scope, batch[j], decrypted[j])
+	}
+	return plaintexts, nil
+}
+
+type cachingDecrypter struct {
+	decrypter config.Decrypter
+	cache     *Cache
+	scope     string
+}
+
+func (d *cachingDecrypter) DecryptValue(ctx context.Context, ciphertext string) (string, error) {
+	if plaintext, ok := d.cache.Get(d.scope, ciphertext); ok {
+		return plaintext, nil
+	}
+	plaintext, err := d.decrypter.DecryptValue(ctx, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	d.cache.Put(d.scope, ciphertext, plaintext)
+	return plaintext, nil
+}
+
+func (d *cachingDecrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (map[string]string, error) {
+	decrypted := make(map[string]string, len(ciphertexts))
+	var missing []string
+	for _, ciphertext := range ciphertexts {
+		if plaintext, ok := d.cache.Get(d.scope, ciphertext); ok {
+			decrypted[ciphertext] = plaintext
+		} else {
+			missing = append(missing, ciphertext)
+		}
+	}
+	if len(missing) == 0 {
+		return decrypted, nil
+	}
+
+	plaintexts, err := d.decrypter.BulkDecrypt(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for ciphertext, plaintext := range plaintexts {
+		decrypted[ciphertext] = plaintext
+		d.cache.Put(d.scope, ciphertext, plaintext)
+	}
+	return decrypted, nil
+}
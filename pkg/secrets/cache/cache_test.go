@@ -0,0 +1,117 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
+)
+
+func TestCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	// Each entry takes the 4 bytes of its scope and separator, a 2 byte ciphertext and a 2 byte plaintext.
+	c := New(16)
+	c.Put("aaa", "c1", "p1")
+	c.Put("aaa", "c2", "p2")
+	assert.Equal(t, 2, c.Len())
+
+	// Reading c1 makes c2 the least recently used entry.
+	plaintext, ok := c.Get("aaa", "c1")
+	assert.True(t, ok)
+	assert.Equal(t, "p1", plaintext)
+	c.Put("aaa", "c3", "p3")
+	assert.Equal(t, 2, c.Len())
+	_, ok = c.Get("aaa", "c2")
+	assert.False(t, ok)
+	_, ok = c.Get("aaa", "c1")
+	assert.True(t, ok)
+
+	// Entries are scoped by secrets manager.
+	_, ok = c.Get("bbb", "c1")
+	assert.False(t, ok)
+
+	// Entries larger than the cache are never cached.
+	c.Put("aaa", "c4", "a much longer plaintext")
+	_, ok = c.Get("aaa", "c4")
+	assert.False(t, ok)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestCacheClear(t *testing.T) {
+	t.Parallel()
+
+	c := New(DefaultMaxBytes)
+	c.Put("scope", "ciphertext", "plaintext")
+
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+	_, ok := c.Get("scope", "ciphertext")
+	assert.False(t, ok)
+}
+
+func TestCachingSecretsManager(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	base := b64.NewFaultyBase64SecretsManager(b64.FaultOptions{})
+	c := New(DefaultMaxBytes)
+	sm := NewCachingSecretsManager(base, c)
+	// Managers share the entries of the cache.
+	other := NewCachingSecretsManager(base, c)
+
+	ciphertexts, err := secrets.BatchEncrypt(ctx, sm, []string{"a", "b"})
+	require.NoError(t, err)
+
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	plaintext, err := dec.DecryptValue(ctx, ciphertexts[0])
+	require.NoError(t, err)
+	assert.Equal(t, "a", plaintext)
+	assert.Equal(t, 1, base.Calls().Decrypt)
+
+	otherDec, err := other.Decrypter()
+	require.NoError(t, err)
+	plaintext, err = otherDec.DecryptValue(ctx, ciphertexts[0])
+	require.NoError(t, err)
+	assert.Equal(t, "a", plaintext)
+	assert.Equal(t, 1, base.Calls().Decrypt)
+
+	// Only the uncached ciphertext is decrypted.
+	plaintexts, err := secrets.BatchDecrypt(ctx, other, ciphertexts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, plaintexts)
+	assert.Equal(t, 1, base.Calls().BatchDecrypt)
+	decrypted, err := dec.BulkDecrypt(ctx, ciphertexts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{ciphertexts[0]: "a", ciphertexts[1]: "b"}, decrypted)
+	assert.Equal(t, 0, base.Calls().BulkDecrypt)
+}
+
+func TestCachingSecretsManagerScope(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEqual(t,
+		managerScope("passphrase", json.RawMessage(`{"salt":"a"}`)),
+		managerScope("passphrase", json.RawMessage(`{"salt":"b"}`)))
+	assert.NotEqual(t, managerScope("a", nil), managerScope("b", nil))
+}
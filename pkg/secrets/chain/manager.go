@@ -0,0 +1,183 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chain implements a composite secrets manager, which encrypts secrets with a primary secrets manager but can
+// decrypt them with any of an ordered list of secrets managers. This allows a stack to migrate to a new key without
+// breaking anyone whose secrets are still encrypted with the old one.
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Type is the type of secrets managed by this secrets provider
+const Type = "chain"
+
+type chainedSecretsManagerState struct {
+	Primary   apitype.SecretsProvidersV1   `json:"primary"`
+	Fallbacks []apitype.SecretsProvidersV1 `json:"fallbacks,omitempty"`
+}
+
+// Manager is a secrets.Manager that encrypts with its primary manager, and decrypts with the first of its managers
+// that succeeds.
+type Manager struct {
+	state     json.RawMessage
+	primary   secrets.Manager
+	fallbacks []secrets.Manager
+}
+
+var _ secrets.Manager = (*Manager)(nil)
+
+// NewChainedSecretsManager returns a secrets manager that encrypts secrets with primary, and decrypts them with
+// primary or, if that fails, with each of the fallbacks in order.
+func NewChainedSecretsManager(primary secrets.Manager, fallbacks ...secrets.Manager) (*Manager, error) {
+	s := chainedSecretsManagerState{
+		Primary: apitype.SecretsProvidersV1{Type: primary.Type(), State: primary.State()},
+	}
+	for _, fallback := range fallbacks {
+		s.Fallbacks = append(s.Fallbacks, apitype.SecretsProvidersV1{Type: fallback.Type(), State: fallback.State()})
+	}
+	state, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling state: %w", err)
+	}
+	return &Manager{state: state, primary: primary, fallbacks: fallbacks}, nil
+}
+
+// NewChainedSecretsManagerFromState deserializes configuration from state and returns a chained secrets manager whose
+// managers are constructed by the given provider.
+func NewChainedSecretsManagerFromState(state json.RawMessage, provider secrets.Provider) (secrets.Manager, error) {
+	var s chainedSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return nil, fmt.Errorf("unmarshalling state: %w", err)
+	}
+
+	primary, err := provider.OfType(s.Primary.Type, s.Primary.State)
+	if err != nil {
+		return nil, fmt.Errorf("constructing primary secrets manager: %w", err)
+	}
+	fallbacks := make([]secrets.Manager, 0, len(s.Fallbacks))
+	for i, f := range s.Fallbacks {
+		fallback, err := provider.OfType(f.Type, f.State)
+		if err != nil {
+			return nil, fmt.Errorf("constructing fallback secrets manager %d: %w", i, err)
+		}
+		fallbacks = append(fallbacks, fallback)
+	}
+	return &Manager{state: state, primary: primary, fallbacks: fallbacks}, nil
+}
+
+// EditProjectStack restores the configuration of the primary manager and the fallbacks into info, using edit to
+// restore the configuration of each manager from its state.
+func EditProjectStack(info *workspace.ProjectStack, state json.RawMessage,
+	edit func(info *workspace.ProjectStack, ty string, state json.RawMessage) error,
+) error {
+	var s chainedSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return fmt.Errorf("unmarshalling chain state: %w", err)
+	}
+
+	if err := edit(info, s.Primary.Type, s.Primary.State); err != nil {
+		return err
+	}
+	info.FallbackProviders = nil
+	for _, f := range s.Fallbacks {
+		var fallback workspace.ProjectStack
+		if err := edit(&fallback, f.Type, f.State); err != nil {
+			return err
+		}
+		info.FallbackProviders = append(info.FallbackProviders, workspace.FallbackSecretsProvider{
+			SecretsProvider: fallback.SecretsProvider,
+			EncryptedKey:    fallback.EncryptedKey,
+			EncryptionSalt:  fallback.EncryptionSalt,
+		})
+	}
+	return nil
+}
+
+// Primary returns the manager that encrypts secrets.
+func (m *Manager) Primary() secrets.Manager { return m.primary }
+
+// Fallbacks returns the managers that are only used to decrypt secrets.
+func (m *Manager) Fallbacks() []secrets.Manager { return m.fallbacks }
+
+func (m *Manager) Type() string           { return Type }
+func (m *Manager) State() json.RawMessage { return m.state }
+
+func (m *Manager) Encrypter() (config.Encrypter, error) {
+	return m.primary.Encrypter()
+}
+
+func (m *Manager) Decrypter() (config.Decrypter, error) {
+	decrypters := make([]config.Decrypter, 0, 1+len(m.fallbacks))
+	for _, sm := range append([]secrets.Manager{m.primary}, m.fallbacks...) {
+		dec, err := sm.Decrypter()
+		if err != nil {
+			return nil, err
+		}
+		decrypters = append(decrypters, dec)
+	}
+	return &chainedDecrypter{decrypters: decrypters}, nil
+}
+
+// Validate validates the primary manager. The fallbacks aren't validated, since they're only needed to decrypt
+// secrets that haven't been re-encrypted by the primary yet.
+func (m *Manager) Validate(ctx context.Context) error {
+	return secrets.Validate(ctx, m.primary)
+}
+
+func (m *Manager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	return secrets.BatchEncrypt(ctx, m.primary, plaintexts)
+}
+
+func (m *Manager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	// Most of the time every secret is encrypted by the primary manager, so try that in a single batch first.
+	if plaintexts, err := secrets.BatchDecrypt(ctx, m.primary, ciphertexts); err == nil {
+		return plaintexts, nil
+	}
+	return secrets.DefaultBatchDecrypt(ctx, m, ciphertexts)
+}
+
+// chainedDecrypter decrypts values with the first of its decrypters that succeeds.
+type chainedDecrypter struct {
+	decrypters []config.Decrypter
+}
+
+func (c *chainedDecrypter) DecryptValue(ctx context.Context, ciphertext string) (string, error) {
+	var errs []error
+	for _, dec := range c.decrypters {
+		plaintext, err := dec.DecryptValue(ctx, ciphertext)
+		if err == nil {
+			return plaintext, nil
+		}
+		errs = append(errs, err)
+	}
+	return "", errors.Join(errs...)
+}
+
+func (c *chainedDecrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (map[string]string, error) {
+	// Try the primary decrypter in bulk before falling back to decrypting each value through the chain.
+	if decrypted, err := c.decrypters[0].BulkDecrypt(ctx, ciphertexts); err == nil {
+		return decrypted, nil
+	}
+	return config.DefaultBulkDecrypt(ctx, c, ciphertexts)
+}
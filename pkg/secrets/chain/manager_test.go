@@ -0,0 +1,131 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/passphrase"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// testProvider returns one of a fixed set of managers, identified by their state.
+type testProvider map[string]secrets.Manager
+
+func (p testProvider) OfType(ty string, state json.RawMessage) (secrets.Manager, error) {
+	sm, ok := p[string(state)]
+	if !ok || sm.Type() != ty {
+		return nil, fmt.Errorf("unknown manager %s", state)
+	}
+	return sm, nil
+}
+
+func newPassphraseManager(t *testing.T, phrase string) secrets.Manager {
+	t.Helper()
+	_, sm, err := passphrase.NewPassphraseSecretsManager(phrase)
+	require.NoError(t, err)
+	return sm
+}
+
+func encrypt(t *testing.T, sm secrets.Manager, plaintext string) string {
+	t.Helper()
+	enc, err := sm.Encrypter()
+	require.NoError(t, err)
+	ciphertext, err := enc.EncryptValue(context.Background(), plaintext)
+	require.NoError(t, err)
+	return ciphertext
+}
+
+func TestChainedSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	oldKey := newPassphraseManager(t, "old")
+	newKey := newPassphraseManager(t, "new")
+	sm, err := NewChainedSecretsManager(newKey, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, Type, sm.Type())
+
+	// Secrets are encrypted with the primary manager only.
+	fromChain := encrypt(t, sm, "chain")
+	oldDec, err := oldKey.Decrypter()
+	require.NoError(t, err)
+	_, err = oldDec.DecryptValue(ctx, fromChain)
+	assert.Error(t, err)
+
+	// Secrets encrypted by any of the managers can be decrypted.
+	fromOld := encrypt(t, oldKey, "old")
+	fromNew := encrypt(t, newKey, "new")
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	for ciphertext, expected := range map[string]string{fromChain: "chain", fromOld: "old", fromNew: "new"} {
+		plaintext, err := dec.DecryptValue(ctx, ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, expected, plaintext)
+	}
+
+	decrypted, err := dec.BulkDecrypt(ctx, []string{fromOld, fromNew})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{fromOld: "old", fromNew: "new"}, decrypted)
+
+	plaintexts, err := sm.BatchDecrypt(ctx, []string{fromNew, fromOld, fromChain})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new", "old", "chain"}, plaintexts)
+
+	// Secrets encrypted by another key can't be decrypted.
+	_, err = dec.DecryptValue(ctx, encrypt(t, newPassphraseManager(t, "other"), "other"))
+	assert.Error(t, err)
+}
+
+func TestChainedSecretsManagerFromState(t *testing.T) {
+	t.Parallel()
+
+	oldKey := newPassphraseManager(t, "old")
+	newKey := newPassphraseManager(t, "new")
+	sm, err := NewChainedSecretsManager(newKey, oldKey)
+	require.NoError(t, err)
+
+	provider := testProvider{string(oldKey.State()): oldKey, string(newKey.State()): newKey}
+	restored, err := NewChainedSecretsManagerFromState(sm.State(), provider)
+	require.NoError(t, err)
+	assert.Equal(t, sm.State(), restored.State())
+
+	chained, ok := restored.(*Manager)
+	require.True(t, ok)
+	assert.Equal(t, newKey, chained.Primary())
+	assert.Equal(t, []secrets.Manager{oldKey}, chained.Fallbacks())
+
+	var info workspace.ProjectStack
+	err = EditProjectStack(&info, sm.State(), func(info *workspace.ProjectStack, ty string, state json.RawMessage) error {
+		info.SecretsProvider = ty
+		info.EncryptionSalt = string(state)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, passphrase.Type, info.SecretsProvider)
+	assert.Equal(t, string(newKey.State()), info.EncryptionSalt)
+	assert.Equal(t, []workspace.FallbackSecretsProvider{{
+		SecretsProvider: passphrase.Type,
+		EncryptionSalt:  string(oldKey.State()),
+	}}, info.FallbackProviders)
+}
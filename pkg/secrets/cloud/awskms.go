@@ -0,0 +1,169 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	netUrl "net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// awsKeyFamilies caches, for the lifetime of the process, the ARNs of the primary and replica keys of the AWS KMS
+// multi-region key that an awskms:// URL refers to. A nil entry means that the key isn't a multi-region key, or that
+// its replicas couldn't be resolved.
+var awsKeyFamilies struct {
+	sync.Mutex
+	m map[string][]string
+}
+
+func cachedAWSKeyFamily(url string) ([]string, bool) {
+	awsKeyFamilies.Lock()
+	defer awsKeyFamilies.Unlock()
+	family, ok := awsKeyFamilies.m[url]
+	return family, ok
+}
+
+func cacheAWSKeyFamily(url string, family []string) {
+	awsKeyFamilies.Lock()
+	defer awsKeyFamilies.Unlock()
+	if awsKeyFamilies.m == nil {
+		awsKeyFamilies.m = make(map[string][]string)
+	}
+	awsKeyFamilies.m[url] = family
+}
+
+// describeAWSKey returns the metadata of the AWS KMS key with the given ID, which may be an alias. It's a variable so
+// that tests can replace it.
+var describeAWSKey = func(ctx context.Context, cfg aws.Config, keyID string) (*types.KeyMetadata, error) {
+	out, err := kms.NewFromConfig(cfg).DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return nil, err
+	}
+	return out.KeyMetadata, nil
+}
+
+// loadAWSConfig loads the AWS configuration for an awskms:// URL, honoring its region and profile parameters.
+func loadAWSConfig(ctx context.Context, u *netUrl.URL) (aws.Config, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	if region := u.Query().Get("region"); region != "" {
+		opts = append(opts, awsconfig.WithRegion(region))
+	}
+	if profile := u.Query().Get("profile"); profile != "" {
+		opts = append(opts, awsconfig.WithSharedConfigProfile(profile))
+	}
+	return awsconfig.LoadDefaultConfig(ctx, opts...)
+}
+
+// awsKeyID returns the key ID, alias or ARN of an awskms:// URL.
+func awsKeyID(u *netUrl.URL) string {
+	return strings.TrimPrefix(u.Host+u.Path, "/")
+}
+
+// resolveAWSKeyFamily returns the ARNs of the primary and replica keys of the multi-region key that the awskms:// URL
+// refers to, resolving aliases. It returns nil if the key isn't a multi-region key. Failures to describe the key
+// aren't fatal, since the key can still be used in the URL's region.
+func resolveAWSKeyFamily(ctx context.Context, url string, u *netUrl.URL) []string {
+	if family, ok := cachedAWSKeyFamily(url); ok {
+		return family
+	}
+
+	var family []string
+	cfg, err := loadAWSConfig(ctx, u)
+	if err == nil {
+		var md *types.KeyMetadata
+		md, err = describeAWSKey(ctx, cfg, awsKeyID(u))
+		if err == nil && md.MultiRegionConfiguration != nil {
+			if primary := md.MultiRegionConfiguration.PrimaryKey; primary != nil && primary.Arn != nil {
+				family = append(family, *primary.Arn)
+			}
+			for _, replica := range md.MultiRegionConfiguration.ReplicaKeys {
+				if replica.Arn != nil {
+					family = append(family, *replica.Arn)
+				}
+			}
+		}
+	}
+	if err != nil {
+		logging.V(5).Infof("unable to resolve the multi-region key family of %s: %v", url, err)
+	}
+	cacheAWSKeyFamily(url, family)
+	return family
+}
+
+// callerAWSRegion returns the region that the caller is configured to use, ignoring the region of the URL.
+func callerAWSRegion(ctx context.Context, u *netUrl.URL) string {
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region
+	}
+	if region := os.Getenv("AWS_DEFAULT_REGION"); region != "" {
+		return region
+	}
+	withoutRegion := *u
+	q := u.Query()
+	q.Del("region")
+	withoutRegion.RawQuery = q.Encode()
+	cfg, err := loadAWSConfig(ctx, &withoutRegion)
+	if err != nil {
+		return ""
+	}
+	return cfg.Region
+}
+
+// arnRegion returns the region of an ARN, e.g. "us-east-1" for "arn:aws:kms:us-east-1:111122223333:key/mrk-1234".
+func arnRegion(arn string) string {
+	parts := strings.SplitN(arn, ":", 5)
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[3]
+}
+
+// closestAWSKeyURL returns a URL for the key in family that's in region, or u if there is none. Ciphertexts of a
+// multi-region key can be decrypted by any key of its family, so using the caller's regional replica avoids
+// cross-region requests without changing which secrets can be decrypted.
+func closestAWSKeyURL(u *netUrl.URL, family []string, region string) *netUrl.URL {
+	for _, arn := range family {
+		if arnRegion(arn) != region {
+			continue
+		}
+		replica := *u
+		replica.Host = ""
+		replica.Path = "/" + arn
+		q := u.Query()
+		q.Set("region", region)
+		replica.RawQuery = q.Encode()
+		return &replica
+	}
+	return u
+}
+
+// awsKeeperURL returns the URL to open a keeper for the awskms:// URL with, preferring the caller's regional replica
+// of multi-region keys.
+func awsKeeperURL(ctx context.Context, url string, u *netUrl.URL) *netUrl.URL {
+	region := callerAWSRegion(ctx, u)
+	if region == "" || region == u.Query().Get("region") || arnRegion(awsKeyID(u)) == region {
+		return u
+	}
+	return closestAWSKeyURL(u, resolveAWSKeyFamily(ctx, url, u), region)
+}
@@ -0,0 +1,124 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	netUrl "net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	primaryARN = "arn:aws:kms:us-east-1:111122223333:key/mrk-1234"
+	replicaARN = "arn:aws:kms:eu-west-1:111122223333:key/mrk-1234"
+)
+
+func TestClosestAWSKeyURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := netUrl.Parse("awskms://alias/stack?region=us-east-1&context_team=infra")
+	require.NoError(t, err)
+	family := []string{primaryARN, replicaARN}
+
+	closest := closestAWSKeyURL(u, family, "eu-west-1")
+	assert.Equal(t, "awskms:///"+replicaARN+"?context_team=infra&region=eu-west-1", closest.String())
+	assert.Equal(t, replicaARN, awsKeyID(closest))
+
+	// There's no replica in ap-south-1, so the URL is used as is.
+	assert.Equal(t, u, closestAWSKeyURL(u, family, "ap-south-1"))
+	assert.Equal(t, u, closestAWSKeyURL(u, nil, "eu-west-1"))
+
+	assert.Equal(t, "eu-west-1", arnRegion(replicaARN))
+	assert.Equal(t, "", arnRegion("alias/stack"))
+}
+
+//nolint:paralleltest // replaces describeAWSKey and sets environment variables
+func TestAWSKeeperURLUsesRegionalReplica(t *testing.T) {
+	t.Setenv("AWS_REGION", "eu-west-1")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	described := 0
+	old := describeAWSKey
+	defer func() { describeAWSKey = old }()
+	describeAWSKey = func(ctx context.Context, cfg aws.Config, keyID string) (*types.KeyMetadata, error) {
+		described++
+		switch keyID {
+		case "alias/multi-region":
+			assert.Equal(t, "us-east-1", cfg.Region)
+			return &types.KeyMetadata{
+				MultiRegion: aws.Bool(true),
+				MultiRegionConfiguration: &types.MultiRegionConfiguration{
+					PrimaryKey:  &types.MultiRegionKey{Arn: aws.String(primaryARN), Region: aws.String("us-east-1")},
+					ReplicaKeys: []types.MultiRegionKey{{Arn: aws.String(replicaARN), Region: aws.String("eu-west-1")}},
+				},
+			}, nil
+		case "alias/single-region":
+			return &types.KeyMetadata{MultiRegion: aws.Bool(false)}, nil
+		default:
+			return nil, errors.New("AccessDeniedException")
+		}
+	}
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		url      string
+		expected string
+	}{
+		{"awskms://alias/multi-region?region=us-east-1", "awskms:///" + replicaARN + "?region=eu-west-1"},
+		{"awskms://alias/single-region?region=us-east-1", "awskms://alias/single-region?region=us-east-1"},
+		{"awskms://alias/denied?region=us-east-1", "awskms://alias/denied?region=us-east-1"},
+		// Keys in the caller's region are used without being described.
+		{"awskms://alias/local?region=eu-west-1", "awskms://alias/local?region=eu-west-1"},
+	} {
+		u, err := netUrl.Parse(tc.url)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, awsKeeperURL(ctx, tc.url, u).String())
+		// The family is cached.
+		assert.Equal(t, tc.expected, awsKeeperURL(ctx, tc.url, u).String())
+	}
+	assert.Equal(t, 3, described)
+}
+
+//nolint:paralleltest // mutates the key family cache
+func TestAWSKeyFamilyState(t *testing.T) {
+	url := "awskms://alias/recorded?region=us-east-1"
+	state, err := json.Marshal(cloudSecretsManagerState{
+		URL:          url,
+		EncryptedKey: []byte("encrypted"),
+		KeyFamily:    []string{primaryARN, replicaARN},
+	})
+	require.NoError(t, err)
+
+	// Seed the data key cache so that the key management service isn't called.
+	cacheDataKey(url, []byte("encrypted"), make([]byte, 32))
+	sm, err := NewCloudSecretsManagerFromState(state)
+	require.NoError(t, err)
+
+	family, ok := cachedAWSKeyFamily(url)
+	assert.True(t, ok)
+	assert.Equal(t, []string{primaryARN, replicaARN}, family)
+
+	var s cloudSecretsManagerState
+	require.NoError(t, json.Unmarshal(sm.State(), &s))
+	assert.Equal(t, []string{primaryARN, replicaARN}, s.KeyFamily)
+}
@@ -16,8 +16,10 @@ package cloud
 
 import (
 	"context"
+	netUrl "net/url"
 	"testing"
 
+	azcloud "github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/keyvault/azkeys"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
@@ -96,3 +98,29 @@ func TestAzureKeyVaultExistingKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "plaintext", plaintext)
 }
+
+func TestKeyVaultURLOptions(t *testing.T) {
+	t.Parallel()
+
+	u, err := netUrl.Parse(
+		"azurekeyvault://pulumi.vault.usgovcloudapi.net/keys/k?algorithm=RSA-OAEP&cloud=USGovernment&clientid=abc")
+	require.NoError(t, err)
+	stripped, opts, set, err := azureKeyVaultURLOptions(u)
+	require.NoError(t, err)
+	assert.True(t, set)
+	assert.Equal(t, "azurekeyvault://pulumi.vault.usgovcloudapi.net/keys/k?algorithm=RSA-OAEP", stripped.String())
+	assert.Equal(t, azcloud.AzureGovernment, opts.Cloud)
+	assert.Equal(t, "abc", opts.ClientID)
+
+	u, err = netUrl.Parse("azurekeyvault://pulumi.vault.azure.net/keys/k")
+	require.NoError(t, err)
+	_, opts, set, err = azureKeyVaultURLOptions(u)
+	require.NoError(t, err)
+	assert.False(t, set)
+	assert.Equal(t, azcloud.AzurePublic, opts.Cloud)
+
+	u, err = netUrl.Parse("azurekeyvault://pulumi.vault.azure.net/keys/k?cloud=mars")
+	require.NoError(t, err)
+	_, _, _, err = azureKeyVaultURLOptions(u)
+	assert.ErrorContains(t, err, `unknown Azure cloud "mars"`)
+}
@@ -0,0 +1,117 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	netUrl "net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azcloud "github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/keyvault/azkeys"
+	gosecrets "gocloud.dev/secrets"
+	"gocloud.dev/secrets/azurekeyvault"
+)
+
+// azureClouds maps the values accepted by the cloud query parameter of azurekeyvault:// URLs to the Azure cloud
+// they refer to.
+var azureClouds = map[string]azcloud.Configuration{
+	"public":       azcloud.AzurePublic,
+	"usgovernment": azcloud.AzureGovernment,
+	"china":        azcloud.AzureChina,
+}
+
+// azureKeyVaultOptions are the credentials used to access an Azure Key Vault key.
+type azureKeyVaultOptions struct {
+	// Cloud is the Azure cloud that hosts the key vault.
+	Cloud azcloud.Configuration
+	// ClientID is the client ID of the user-assigned managed identity to authenticate as, if any.
+	ClientID string
+}
+
+// azureKeyVaultURLOptions extracts the pulumi-specific query parameters of an azurekeyvault:// URL, which
+// configure the credentials used to access the key:
+//
+//   - cloud: the Azure cloud that hosts the key vault; one of public (the default), usgovernment or china.
+//   - clientid: the client ID of a user-assigned managed identity to authenticate as.
+//
+// It returns the URL without these parameters, since they aren't understood by gocloud.dev, and whether any of
+// them was set.
+func azureKeyVaultURLOptions(u *netUrl.URL) (*netUrl.URL, azureKeyVaultOptions, bool, error) {
+	q := u.Query()
+	opts := azureKeyVaultOptions{
+		Cloud:    azcloud.AzurePublic,
+		ClientID: q.Get("clientid"),
+	}
+	name := q.Get("cloud")
+	if name != "" {
+		c, ok := azureClouds[strings.ToLower(name)]
+		if !ok {
+			return nil, azureKeyVaultOptions{}, false, fmt.Errorf(
+				"unknown Azure cloud %q; expected one of public, usgovernment or china", name)
+		}
+		opts.Cloud = c
+	}
+	set := q.Has("cloud") || q.Has("clientid")
+	q.Del("cloud")
+	q.Del("clientid")
+
+	stripped := *u
+	stripped.RawQuery = q.Encode()
+	return &stripped, opts, set, nil
+}
+
+// azureCredential returns the credential used to access a key vault: the given user-assigned managed identity if
+// there is one, and the default Azure credential chain otherwise.
+func azureCredential(opts azureKeyVaultOptions) (azcore.TokenCredential, error) {
+	clientOptions := azcore.ClientOptions{Cloud: opts.Cloud}
+	if opts.ClientID != "" {
+		return azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{
+			ClientOptions: clientOptions,
+			ID:            azidentity.ClientID(opts.ClientID),
+		})
+	}
+	return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		ClientOptions: clientOptions,
+	})
+}
+
+// openAzureKeeper opens the key referred to by an azurekeyvault:// URL, honoring its cloud and clientid
+// parameters.
+func openAzureKeeper(ctx context.Context, u *netUrl.URL) (*gosecrets.Keeper, error) {
+	stripped, opts, set, err := azureKeyVaultURLOptions(u)
+	if err != nil {
+		return nil, err
+	}
+	if !set {
+		return gosecrets.OpenKeeper(ctx, u.String())
+	}
+
+	opener := azurekeyvault.URLOpener{
+		ClientMaker: func(keyVaultURI string) (*azkeys.Client, error) {
+			cred, err := azureCredential(opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Azure credential: %w", err)
+			}
+			return azkeys.NewClient(keyVaultURI, cred, &azkeys.ClientOptions{
+				ClientOptions: azcore.ClientOptions{Cloud: opts.Cloud},
+			})
+		},
+	}
+	return opener.OpenKeeperURL(ctx, stripped)
+}
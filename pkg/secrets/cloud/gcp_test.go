@@ -17,11 +17,15 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"testing"
 
 	kms "cloud.google.com/go/kms/apiv1"
 	"cloud.google.com/go/kms/apiv1/kmspb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/authhelpers"
 )
 
 func createGCPKey(ctx context.Context, t *testing.T) string {
@@ -64,3 +68,22 @@ func TestGCPCloudManager(t *testing.T) {
 	url := "gcpkms://" + keyName
 	testURL(ctx, t, url)
 }
+
+func TestGCPTokenSourceOptions(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse("gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k" +
+		"?credentials=%2Ftmp%2Fwif.json&impersonate=deployer%40p.iam.gserviceaccount.com&delegates=a%40p,b%40p")
+	require.NoError(t, err)
+
+	stripped, opts := gcpTokenSourceOptions(u)
+	assert.Equal(t, "gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k", stripped.String())
+	assert.Equal(t, authhelpers.GoogleTokenSourceOptions{
+		CredentialsFile:           "/tmp/wif.json",
+		ImpersonateServiceAccount: "deployer@p.iam.gserviceaccount.com",
+		Delegates:                 []string{"a@p", "b@p"},
+	}, opts)
+
+	// The original URL is left untouched.
+	assert.Contains(t, u.String(), "impersonate=")
+}
@@ -0,0 +1,238 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	netUrl "net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+	gosecrets "gocloud.dev/secrets"
+	"gocloud.dev/secrets/hashivault"
+)
+
+const (
+	vaultAuthToken      = "token"
+	vaultAuthAppRole    = "approle"
+	vaultAuthKubernetes = "kubernetes"
+
+	// defaultKubernetesTokenPath is the path of the service account token in a Kubernetes pod.
+	defaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec
+)
+
+// vaultOptions are the settings used to connect and authenticate to a Vault server.
+type vaultOptions struct {
+	// Address is the address of the Vault server. It defaults to VAULT_SERVER_URL or VAULT_ADDR.
+	Address string
+	// Namespace is the Vault Enterprise namespace to use. It defaults to VAULT_NAMESPACE.
+	Namespace string
+	// Auth is the method used to authenticate: token (the default), approle or kubernetes.
+	Auth string
+	// Role is the role to log in with. For the approle method, it defaults to VAULT_ROLE_ID.
+	Role string
+	// AuthMount is the path the auth method is mounted at. It defaults to the name of the method.
+	AuthMount string
+}
+
+// hashiVaultURLOptions extracts the pulumi-specific query parameters of a hashivault:// URL, which configure how to
+// connect to Vault:
+//
+//   - address: the address of the Vault server.
+//   - namespace: the Vault Enterprise namespace of the key.
+//   - auth: the auth method to log in with; one of token (the default), approle or kubernetes.
+//   - role: the role to log in with. It is required by the kubernetes method.
+//   - authMount: the path the auth method is mounted at, if it isn't the name of the method.
+//
+// It returns the URL without these parameters, since they aren't understood by gocloud.dev, and whether any of
+// them was set.
+func hashiVaultURLOptions(u *netUrl.URL) (*netUrl.URL, vaultOptions, bool, error) {
+	q := u.Query()
+	opts := vaultOptions{
+		Address:   q.Get("address"),
+		Namespace: q.Get("namespace"),
+		Auth:      q.Get("auth"),
+		Role:      q.Get("role"),
+		AuthMount: q.Get("authMount"),
+	}
+	switch opts.Auth {
+	case "":
+		opts.Auth = vaultAuthToken
+	case vaultAuthToken, vaultAuthAppRole, vaultAuthKubernetes:
+	default:
+		return nil, vaultOptions{}, false, fmt.Errorf(
+			"unknown Vault auth method %q; expected one of token, approle or kubernetes", opts.Auth)
+	}
+	if opts.AuthMount == "" {
+		opts.AuthMount = opts.Auth
+	}
+	if opts.Auth == vaultAuthKubernetes && opts.Role == "" {
+		return nil, vaultOptions{}, false, errors.New(
+			"the role parameter is required for the kubernetes Vault auth method")
+	}
+
+	set := false
+	for _, param := range []string{"address", "namespace", "auth", "role", "authMount"} {
+		set = set || q.Has(param)
+		q.Del(param)
+	}
+
+	stripped := *u
+	stripped.RawQuery = q.Encode()
+	return &stripped, opts, set, nil
+}
+
+// vaultClients caches the Vault clients that logged in with an auth method, so that a process logs in once per set
+// of options rather than once per secrets manager.
+var vaultClients struct {
+	sync.Mutex
+	m map[vaultOptions]*api.Client
+}
+
+// vaultClient returns a client authenticated with the given options. The tokens of clients that log in are renewed
+// for as long as possible.
+func vaultClient(ctx context.Context, opts vaultOptions) (*api.Client, error) {
+	vaultClients.Lock()
+	defer vaultClients.Unlock()
+	if c, ok := vaultClients.m[opts]; ok {
+		return c, nil
+	}
+
+	cfg := api.DefaultConfig()
+	if cfg.Error != nil {
+		return nil, fmt.Errorf("configuring Vault client: %w", cfg.Error)
+	}
+	switch {
+	case opts.Address != "":
+		cfg.Address = opts.Address
+	case os.Getenv("VAULT_SERVER_URL") != "":
+		cfg.Address = os.Getenv("VAULT_SERVER_URL")
+	}
+	c, err := api.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating Vault client: %w", err)
+	}
+	if opts.Namespace != "" {
+		c.SetNamespace(opts.Namespace)
+	}
+
+	var login map[string]interface{}
+	switch opts.Auth {
+	case vaultAuthToken:
+		if token := os.Getenv("VAULT_SERVER_TOKEN"); token != "" {
+			c.SetToken(token)
+		}
+		if c.Token() == "" {
+			return nil, errors.New("VAULT_TOKEN must be set to use the token Vault auth method")
+		}
+		// The token is managed by the caller, so there's nothing to renew.
+		return c, nil
+	case vaultAuthAppRole:
+		roleID := opts.Role
+		if roleID == "" {
+			roleID = os.Getenv("VAULT_ROLE_ID")
+		}
+		secretID := os.Getenv("VAULT_SECRET_ID")
+		if roleID == "" || secretID == "" {
+			return nil, errors.New("VAULT_ROLE_ID and VAULT_SECRET_ID must be set to use the approle Vault auth method")
+		}
+		login = map[string]interface{}{"role_id": roleID, "secret_id": secretID}
+	case vaultAuthKubernetes:
+		tokenPath := os.Getenv("VAULT_K8S_TOKEN_PATH")
+		if tokenPath == "" {
+			tokenPath = defaultKubernetesTokenPath
+		}
+		jwt, err := os.ReadFile(tokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading Kubernetes service account token: %w", err)
+		}
+		login = map[string]interface{}{"role": opts.Role, "jwt": strings.TrimSpace(string(jwt))}
+	}
+
+	secret, err := c.Logical().WriteWithContext(ctx, path.Join("auth", opts.AuthMount, "login"), login)
+	if err != nil {
+		return nil, fmt.Errorf("logging in to Vault with %s: %w", opts.Auth, err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, fmt.Errorf("logging in to Vault with %s: no token returned", opts.Auth)
+	}
+	c.SetToken(secret.Auth.ClientToken)
+
+	if vaultClients.m == nil {
+		vaultClients.m = make(map[vaultOptions]*api.Client)
+	}
+	vaultClients.m[opts] = c
+	if secret.Auth.Renewable {
+		if err := renewVaultToken(c, secret, func() { forgetVaultClient(opts, c) }); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// renewVaultToken renews the client's token until it can no longer be renewed, at which point done is called.
+func renewVaultToken(c *api.Client, secret *api.Secret, done func()) error {
+	watcher, err := c.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+	if err != nil {
+		return fmt.Errorf("renewing Vault token: %w", err)
+	}
+	go watcher.Start()
+	go func() {
+		defer watcher.Stop()
+		for {
+			select {
+			case <-watcher.DoneCh():
+				done()
+				return
+			case <-watcher.RenewCh():
+			}
+		}
+	}()
+	return nil
+}
+
+// forgetVaultClient removes a client whose token has expired from the cache, so that the next secrets manager logs
+// in again.
+func forgetVaultClient(opts vaultOptions, c *api.Client) {
+	vaultClients.Lock()
+	defer vaultClients.Unlock()
+	if vaultClients.m[opts] == c {
+		delete(vaultClients.m, opts)
+	}
+}
+
+// openHashiVaultKeeper opens the Transit key referred to by a hashivault:// URL, honoring its address, namespace,
+// auth, role and authMount parameters.
+func openHashiVaultKeeper(ctx context.Context, u *netUrl.URL) (*gosecrets.Keeper, error) {
+	stripped, opts, set, err := hashiVaultURLOptions(u)
+	if err != nil {
+		return nil, err
+	}
+	if !set {
+		return gosecrets.OpenKeeper(ctx, u.String())
+	}
+
+	client, err := vaultClient(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	opener := hashivault.URLOpener{Client: client}
+	return opener.OpenKeeperURL(ctx, stripped)
+}
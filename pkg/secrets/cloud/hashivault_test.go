@@ -0,0 +1,183 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	netUrl "net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// fakeVault emulates the approle login and Transit encrypt/decrypt endpoints of a Vault server. The "ciphertext" is
+// the plaintext prefixed with the key name.
+type fakeVault struct {
+	lock       sync.Mutex
+	logins     int
+	namespaces []string
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var body map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.namespaces = append(f.namespaces, r.Header.Get("X-Vault-Namespace"))
+
+	respond := func(v map[string]interface{}) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	token := r.Header.Get("X-Vault-Token")
+	switch {
+	case r.URL.Path == "/v1/auth/approle/login":
+		if body["role_id"] != "role" || body["secret_id"] != "secret" {
+			http.Error(w, `{"errors":["invalid credentials"]}`, http.StatusBadRequest)
+			return
+		}
+		f.logins++
+		respond(map[string]interface{}{"auth": map[string]interface{}{"client_token": "approle-token"}})
+	case token != "root" && token != "approle-token":
+		http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+	case strings.HasPrefix(r.URL.Path, "/v1/transit/encrypt/"):
+		key := strings.TrimPrefix(r.URL.Path, "/v1/transit/encrypt/")
+		respond(map[string]interface{}{"data": map[string]interface{}{
+			"ciphertext": "vault:" + key + ":" + body["plaintext"],
+		}})
+	case strings.HasPrefix(r.URL.Path, "/v1/transit/decrypt/"):
+		key := strings.TrimPrefix(r.URL.Path, "/v1/transit/decrypt/")
+		plaintext, ok := strings.CutPrefix(body["ciphertext"], "vault:"+key+":")
+		if !ok {
+			http.Error(w, `{"errors":["cipher: message authentication failed"]}`, http.StatusBadRequest)
+			return
+		}
+		respond(map[string]interface{}{"data": map[string]interface{}{"plaintext": plaintext}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestHashiVaultURLOptions(t *testing.T) {
+	t.Parallel()
+
+	u, err := netUrl.Parse(
+		"hashivault://my-key?engine=team-transit&auth=kubernetes&role=deployer&authMount=k8s&namespace=ns1")
+	require.NoError(t, err)
+	stripped, opts, set, err := hashiVaultURLOptions(u)
+	require.NoError(t, err)
+	assert.True(t, set)
+	assert.Equal(t, "hashivault://my-key?engine=team-transit", stripped.String())
+	assert.Equal(t, vaultOptions{
+		Namespace: "ns1",
+		Auth:      vaultAuthKubernetes,
+		Role:      "deployer",
+		AuthMount: "k8s",
+	}, opts)
+
+	u, err = netUrl.Parse("hashivault://my-key")
+	require.NoError(t, err)
+	_, opts, set, err = hashiVaultURLOptions(u)
+	require.NoError(t, err)
+	assert.False(t, set)
+	assert.Equal(t, vaultOptions{Auth: vaultAuthToken, AuthMount: vaultAuthToken}, opts)
+
+	u, err = netUrl.Parse("hashivault://my-key?auth=ldap")
+	require.NoError(t, err)
+	_, _, _, err = hashiVaultURLOptions(u)
+	assert.ErrorContains(t, err, `unknown Vault auth method "ldap"`)
+
+	u, err = netUrl.Parse("hashivault://my-key?auth=kubernetes")
+	require.NoError(t, err)
+	_, _, _, err = hashiVaultURLOptions(u)
+	assert.ErrorContains(t, err, "role parameter is required")
+}
+
+//nolint:paralleltest // mutates environment variables
+func TestHashiVaultAppRole(t *testing.T) {
+	vault := &fakeVault{}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "")
+	t.Setenv("VAULT_SERVER_TOKEN", "")
+	t.Setenv("VAULT_ROLE_ID", "role")
+	t.Setenv("VAULT_SECRET_ID", "secret")
+
+	url := "hashivault://approle-key?auth=approle&namespace=team"
+	info := &workspace.ProjectStack{}
+	sm, err := NewCloudSecretsManager(info, url, false)
+	require.NoError(t, err)
+	assert.Equal(t, url, info.SecretsProvider)
+
+	enc, err := sm.Encrypter()
+	require.NoError(t, err)
+	ciphertext, err := enc.EncryptValue(context.Background(), "hunter2")
+	require.NoError(t, err)
+
+	// Unwrapping the data key again logs in with the cached client, in the same namespace.
+	dataKeys.Lock()
+	dataKeys.m = nil
+	dataKeys.Unlock()
+	sm, err = NewCloudSecretsManager(info, url, false)
+	require.NoError(t, err)
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	plaintext, err := dec.DecryptValue(context.Background(), ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", plaintext)
+
+	assert.Equal(t, 1, vault.logins)
+	assert.Equal(t, []string{"team", "team", "team"}, vault.namespaces)
+
+	// Bad credentials fail to log in.
+	t.Setenv("VAULT_SECRET_ID", "wrong")
+	_, err = NewCloudSecretsManager(&workspace.ProjectStack{},
+		"hashivault://approle-key?auth=approle&namespace=other", false)
+	assert.ErrorContains(t, err, "logging in to Vault with approle")
+}
+
+//nolint:paralleltest // mutates environment variables
+func TestHashiVaultToken(t *testing.T) {
+	vault := &fakeVault{}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	t.Setenv("VAULT_ADDR", "")
+	t.Setenv("VAULT_TOKEN", "root")
+	t.Setenv("VAULT_SERVER_TOKEN", "")
+
+	// The address can be given in the URL instead of the environment.
+	url := "hashivault://token-key?address=" + netUrl.QueryEscape(server.URL)
+	_, err := NewCloudSecretsManager(&workspace.ProjectStack{}, url, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, vault.logins)
+
+	t.Setenv("VAULT_TOKEN", "")
+	_, err = NewCloudSecretsManager(&workspace.ProjectStack{}, url+"&namespace=other", false)
+	assert.ErrorContains(t, err, "VAULT_TOKEN must be set")
+}
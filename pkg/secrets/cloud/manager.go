@@ -24,12 +24,13 @@ import (
 	netUrl "net/url"
 	"os"
 	"strings"
+	"sync"
 
 	gosecrets "gocloud.dev/secrets"
-	_ "gocloud.dev/secrets/awskms"        // support for awskms://
-	_ "gocloud.dev/secrets/azurekeyvault" // support for azurekeyvault://
-	"gocloud.dev/secrets/gcpkms"          // support for gcpkms://
-	_ "gocloud.dev/secrets/hashivault"    // support for hashivault://
+	"gocloud.dev/secrets/awskms"        // support for awskms://
+	"gocloud.dev/secrets/azurekeyvault" // support for azurekeyvault://
+	"gocloud.dev/secrets/gcpkms"        // support for gcpkms://
+	"gocloud.dev/secrets/hashivault"    // support for hashivault://
 	"google.golang.org/api/cloudkms/v1"
 
 	"github.com/pulumi/pulumi/pkg/v3/authhelpers"
@@ -44,6 +45,40 @@ const Type = "cloud"
 type cloudSecretsManagerState struct {
 	URL          string `json:"url"`
 	EncryptedKey []byte `json:"encryptedkey"`
+	// KeyFamily are the ARNs of the primary and replica keys of an AWS KMS multi-region key, if known. Recording them
+	// lets callers in other regions use their regional replica without having to look it up.
+	KeyFamily []string `json:"keyfamily,omitempty"`
+}
+
+// dataKeyRef identifies a data key wrapped by a key management service.
+type dataKeyRef struct {
+	url              string
+	encryptedDataKey string
+}
+
+// dataKeys caches the plaintext of the data keys wrapped or unwrapped by a key management service for the lifetime of
+// the process. A stack's secrets manager is constructed many times during a single command, and the cache ensures
+// that the key management service is called at most once per stack rather than each time; once the data key is
+// known, secrets are encrypted and decrypted locally.
+var dataKeys struct {
+	sync.Mutex
+	m map[dataKeyRef][]byte
+}
+
+func cachedDataKey(url string, encryptedDataKey []byte) ([]byte, bool) {
+	dataKeys.Lock()
+	defer dataKeys.Unlock()
+	key, ok := dataKeys.m[dataKeyRef{url, string(encryptedDataKey)}]
+	return key, ok
+}
+
+func cacheDataKey(url string, encryptedDataKey, plaintextDataKey []byte) {
+	dataKeys.Lock()
+	defer dataKeys.Unlock()
+	if dataKeys.m == nil {
+		dataKeys.m = make(map[dataKeyRef][]byte)
+	}
+	dataKeys.m[dataKeyRef{url, string(encryptedDataKey)}] = plaintextDataKey
 }
 
 // openKeeper opens the keeper, handling pulumi-specifc cases in the URL.
@@ -55,12 +90,14 @@ func openKeeper(ctx context.Context, url string) (*gosecrets.Keeper, error) {
 
 	switch u.Scheme {
 	case gcpkms.Scheme:
-		credentials, err := authhelpers.ResolveGoogleCredentials(ctx, cloudkms.CloudkmsScope)
+		var opts authhelpers.GoogleTokenSourceOptions
+		u, opts = gcpTokenSourceOptions(u)
+		tokenSource, err := authhelpers.ResolveGoogleTokenSource(ctx, cloudkms.CloudkmsScope, opts)
 		if err != nil {
 			return nil, fmt.Errorf("missing google credentials: %w", err)
 		}
 
-		kmsClient, _, err := gcpkms.Dial(ctx, credentials.TokenSource)
+		kmsClient, _, err := gcpkms.Dial(ctx, tokenSource)
 		if err != nil {
 			return nil, fmt.Errorf("failed to connect to gcpkms: %w", err)
 		}
@@ -69,11 +106,43 @@ func openKeeper(ctx context.Context, url string) (*gosecrets.Keeper, error) {
 		}
 
 		return opener.OpenKeeperURL(ctx, u)
+	case awskms.Scheme:
+		return gosecrets.OpenKeeper(ctx, awsKeeperURL(ctx, url, u).String())
+	case azurekeyvault.Scheme:
+		return openAzureKeeper(ctx, u)
+	case hashivault.Scheme:
+		return openHashiVaultKeeper(ctx, u)
 	default:
 		return gosecrets.OpenKeeper(ctx, url)
 	}
 }
 
+// gcpTokenSourceOptions extracts the pulumi-specific query parameters of a gcpkms:// URL, which configure the
+// credentials used to access the key:
+//
+//   - credentials: the path of a credentials file, e.g. a workload identity federation credential configuration.
+//   - impersonate: the email address of a service account to impersonate.
+//   - delegates: a comma-separated delegation chain of service accounts used for impersonation.
+//
+// It returns the URL without these parameters, since they aren't understood by gocloud.dev.
+func gcpTokenSourceOptions(u *netUrl.URL) (*netUrl.URL, authhelpers.GoogleTokenSourceOptions) {
+	q := u.Query()
+	opts := authhelpers.GoogleTokenSourceOptions{
+		CredentialsFile:           q.Get("credentials"),
+		ImpersonateServiceAccount: q.Get("impersonate"),
+	}
+	if delegates := q.Get("delegates"); delegates != "" {
+		opts.Delegates = strings.Split(delegates, ",")
+	}
+	q.Del("credentials")
+	q.Del("impersonate")
+	q.Del("delegates")
+
+	stripped := *u
+	stripped.RawQuery = q.Encode()
+	return &stripped, opts
+}
+
 // generateNewDataKey generates a new DataKey seeded by a fresh random 32-byte key and encrypted
 // using the target cloud key management service.
 func generateNewDataKey(url string) ([]byte, error) {
@@ -86,12 +155,25 @@ func generateNewDataKey(url string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return keeper.Encrypt(context.Background(), plaintextDataKey)
+	encryptedDataKey, err := keeper.Encrypt(context.Background(), plaintextDataKey)
+	if err != nil {
+		return nil, err
+	}
+	cacheDataKey(url, encryptedDataKey, plaintextDataKey)
+	// Record the family of multi-region keys in the stack's state.
+	if u, err := netUrl.Parse(url); err == nil && u.Scheme == awskms.Scheme {
+		resolveAWSKeyFamily(context.Background(), url, u)
+	}
+	return encryptedDataKey, nil
 }
 
-// newCloudSecretsManager returns a secrets manager that uses the target cloud key management
-// service to encrypt/decrypt a data key used for envelope encryption of secrets values.
-func newCloudSecretsManager(url string, encryptedDataKey []byte) (*Manager, error) {
+// unwrapDataKey decrypts the data key with the target cloud key management service, unless it has already been
+// decrypted by this process.
+func unwrapDataKey(url string, encryptedDataKey []byte) ([]byte, error) {
+	if plaintextDataKey, ok := cachedDataKey(url, encryptedDataKey); ok {
+		return plaintextDataKey, nil
+	}
+
 	keeper, err := openKeeper(context.Background(), url)
 	if err != nil {
 		return nil, err
@@ -100,14 +182,27 @@ func newCloudSecretsManager(url string, encryptedDataKey []byte) (*Manager, erro
 	if err != nil {
 		return nil, err
 	}
+	cacheDataKey(url, encryptedDataKey, plaintextDataKey)
+	return plaintextDataKey, nil
+}
+
+// newCloudSecretsManager returns a secrets manager that uses the target cloud key management
+// service to encrypt/decrypt a data key used for envelope encryption of secrets values.
+func newCloudSecretsManager(url string, encryptedDataKey []byte) (*Manager, error) {
+	plaintextDataKey, err := unwrapDataKey(url, encryptedDataKey)
+	if err != nil {
+		return nil, err
+	}
+	keyFamily, _ := cachedAWSKeyFamily(url)
 	state, err := json.Marshal(cloudSecretsManagerState{
 		URL:          url,
 		EncryptedKey: encryptedDataKey,
+		KeyFamily:    keyFamily,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("marshalling state: %w", err)
 	}
-	crypter := config.NewSymmetricCrypter(plaintextDataKey)
+	crypter := secrets.NewSymmetricCrypter(plaintextDataKey)
 	return &Manager{
 		crypter: crypter,
 		state:   state,
@@ -125,6 +220,20 @@ func (m *Manager) State() json.RawMessage               { return m.state }
 func (m *Manager) Encrypter() (config.Encrypter, error) { return m.crypter, nil }
 func (m *Manager) Decrypter() (config.Decrypter, error) { return m.crypter, nil }
 
+// Validate checks that the data key can be decrypted with the cloud key management service. It goes through the data
+// key cache, so the service is only called if this process hasn't unwrapped the data key yet.
+func (m *Manager) Validate(ctx context.Context) error {
+	var s cloudSecretsManagerState
+	if err := json.Unmarshal(m.state, &s); err != nil {
+		return fmt.Errorf("unmarshalling state: %w", err)
+	}
+	if _, err := unwrapDataKey(s.URL, s.EncryptedKey); err != nil {
+		return fmt.Errorf("secrets provider %s can't decrypt the stack's data key; "+
+			"check that the key exists and that your credentials are allowed to decrypt with it: %w", s.URL, err)
+	}
+	return nil
+}
+
 func EditProjectStack(info *workspace.ProjectStack, state json.RawMessage) error {
 	info.EncryptionSalt = ""
 
@@ -147,6 +256,11 @@ func NewCloudSecretsManagerFromState(state json.RawMessage) (secrets.Manager, er
 	if err := json.Unmarshal(state, &s); err != nil {
 		return nil, fmt.Errorf("unmarshalling state: %w", err)
 	}
+	if len(s.KeyFamily) > 0 {
+		if _, ok := cachedAWSKeyFamily(s.URL); !ok {
+			cacheAWSKeyFamily(s.URL, s.KeyFamily)
+		}
+	}
 
 	return newCloudSecretsManager(s.URL, s.EncryptedKey)
 }
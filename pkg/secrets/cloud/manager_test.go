@@ -124,3 +124,70 @@ func (k dummySecretsKeeper) Decrypt(ctx context.Context, ciphertext []byte) ([]b
 func (k dummySecretsKeeper) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
 	return plaintext, nil
 }
+
+type countingSecretsKeeper struct {
+	driver.Keeper
+
+	encryptCalls int
+	decryptCalls int
+}
+
+func (k *countingSecretsKeeper) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	k.decryptCalls++
+	return ciphertext, nil
+}
+
+func (k *countingSecretsKeeper) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	k.encryptCalls++
+	return plaintext, nil
+}
+
+type countingSecretsKeeperOpener struct {
+	keeper *countingSecretsKeeper
+}
+
+func (m *countingSecretsKeeperOpener) OpenKeeperURL(ctx context.Context, u *url.URL) (*secrets.Keeper, error) {
+	return secrets.NewKeeper(m.keeper), nil
+}
+
+//nolint:paralleltest // modifies the data key cache
+func TestDataKeyCache(t *testing.T) {
+	keeper := &countingSecretsKeeper{}
+	secrets.DefaultURLMux().RegisterKeeper("counting", &countingSecretsKeeperOpener{keeper: keeper})
+
+	// Creating a stack wraps a new data key, and caches its plaintext.
+	info := &workspace.ProjectStack{}
+	sm, err := NewCloudSecretsManager(info, "counting://key", false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, keeper.encryptCalls)
+	assert.Equal(t, 0, keeper.decryptCalls)
+
+	// Loading the stack again, or deserializing a deployment, doesn't need the key management service.
+	_, err = NewCloudSecretsManager(info, "counting://key", false)
+	require.NoError(t, err)
+	_, err = NewCloudSecretsManagerFromState(sm.State())
+	require.NoError(t, err)
+	assert.Equal(t, 1, keeper.encryptCalls)
+	assert.Equal(t, 0, keeper.decryptCalls)
+
+	// A data key that isn't cached is unwrapped once, and then cached.
+	dataKeys.Lock()
+	dataKeys.m = nil
+	dataKeys.Unlock()
+	for i := 0; i < 3; i++ {
+		_, err = NewCloudSecretsManager(info, "counting://key", false)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, keeper.encryptCalls)
+	assert.Equal(t, 1, keeper.decryptCalls)
+
+	// Validating the manager uses the cached data key too.
+	require.NoError(t, sm.(*Manager).Validate(context.Background()))
+	assert.Equal(t, 1, keeper.decryptCalls)
+
+	// Rotating wraps a new data key.
+	_, err = NewCloudSecretsManager(info, "counting://key", true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, keeper.encryptCalls)
+	assert.Equal(t, 1, keeper.decryptCalls)
+}
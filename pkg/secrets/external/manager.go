@@ -0,0 +1,286 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package external implements support for out-of-tree secrets providers, which are discovered by the scheme of their
+// URL and invoked through a small exec protocol, much like git or docker credential helpers.
+//
+// A secrets provider "<scheme>://..." is handled by an executable named "pulumi-secrets-<scheme>" on the PATH. The
+// helper is run once per operation with the operation name as its only argument:
+//
+//	pulumi-secrets-<scheme> encrypt
+//	pulumi-secrets-<scheme> decrypt
+//
+// and a JSON request on its standard input:
+//
+//	{"version": 1, "url": "<scheme>://...", "plaintext": "<base64>"}
+//	{"version": 1, "url": "<scheme>://...", "ciphertext": "<base64>"}
+//
+// It must write the matching JSON response to its standard output and exit with status 0:
+//
+//	{"ciphertext": "<base64>"}
+//	{"plaintext": "<base64>"}
+//
+// A non-zero exit status signals failure, and anything the helper wrote to its standard error is reported to the user.
+// Helpers only ever encrypt and decrypt the data key of a stack; secret values are encrypted locally with that key.
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Type is the type of secrets managed by this secrets provider
+const Type = "external"
+
+// HelperPrefix is the prefix of the name of the executable that implements the secrets provider for a scheme.
+const HelperPrefix = "pulumi-secrets-"
+
+// ProtocolVersion is the version of the exec protocol spoken with helpers.
+const ProtocolVersion = 1
+
+// builtinSchemes are the schemes handled by the built-in secrets providers, which helpers can't override.
+var builtinSchemes = map[string]bool{
+	"default":       true,
+	"passphrase":    true,
+	"awskms":        true,
+	"azurekeyvault": true,
+	"gcpkms":        true,
+	"hashivault":    true,
+	"base64key":     true,
+	"age":           true,
+}
+
+type externalSecretsManagerState struct {
+	URL          string `json:"url"`
+	EncryptedKey []byte `json:"encryptedkey"`
+}
+
+// request is the message written to the standard input of a helper.
+type request struct {
+	Version    int    `json:"version"`
+	URL        string `json:"url"`
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+// response is the message read from the standard output of a helper.
+type response struct {
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+// scheme returns the scheme of the secrets provider URL, or "" if it doesn't have one.
+func scheme(secretsProvider string) string {
+	i := strings.Index(secretsProvider, "://")
+	if i <= 0 {
+		return ""
+	}
+	return secretsProvider[:i]
+}
+
+// findHelper returns the path of the helper for the scheme of the secrets provider URL.
+func findHelper(secretsProvider string) (string, error) {
+	s := scheme(secretsProvider)
+	if s == "" || builtinSchemes[s] {
+		return "", fmt.Errorf("%q is not an external secrets provider", secretsProvider)
+	}
+	path, err := exec.LookPath(HelperPrefix + s)
+	if err != nil {
+		return "", fmt.Errorf("no helper found for secrets provider scheme %q: %w", s, err)
+	}
+	return path, nil
+}
+
+// IsExternalSecretsProvider returns true if the secrets provider isn't a built-in one and a helper for its scheme is
+// installed.
+func IsExternalSecretsProvider(secretsProvider string) bool {
+	_, err := findHelper(secretsProvider)
+	return err == nil
+}
+
+// invoke runs the helper for the request's URL with the given operation.
+func invoke(ctx context.Context, op string, req request) (response, error) {
+	path, err := findHelper(req.URL)
+	if err != nil {
+		return response{}, err
+	}
+
+	req.Version = ProtocolVersion
+	input, err := json.Marshal(req)
+	if err != nil {
+		return response{}, fmt.Errorf("marshalling %s request: %w", op, err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, op)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return response{}, fmt.Errorf("%s %s failed: %s", HelperPrefix+scheme(req.URL), op, msg)
+		}
+		return response{}, fmt.Errorf("%s %s failed: %w", HelperPrefix+scheme(req.URL), op, err)
+	}
+
+	var resp response
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return response{}, fmt.Errorf("%s %s returned an invalid response: %w", HelperPrefix+scheme(req.URL), op, err)
+	}
+	return resp, nil
+}
+
+// encryptDataKey encrypts the data key with the helper for the URL.
+func encryptDataKey(ctx context.Context, url string, dataKey []byte) ([]byte, error) {
+	resp, err := invoke(ctx, "encrypt", request{URL: url, Plaintext: dataKey})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Ciphertext) == 0 {
+		return nil, errors.New("encrypting data key: the helper returned no ciphertext")
+	}
+	return resp.Ciphertext, nil
+}
+
+// decryptDataKey decrypts the data key with the helper for the URL.
+func decryptDataKey(ctx context.Context, url string, encryptedDataKey []byte) ([]byte, error) {
+	resp, err := invoke(ctx, "decrypt", request{URL: url, Ciphertext: encryptedDataKey})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Plaintext) == 0 {
+		return nil, errors.New("decrypting data key: the helper returned no plaintext")
+	}
+	return resp.Plaintext, nil
+}
+
+// newExternalSecretsManager returns a secrets manager that encrypts secrets with the given data key, which is stored
+// in its state encrypted by the helper for the URL.
+func newExternalSecretsManager(url string, encryptedDataKey, dataKey []byte) (*Manager, error) {
+	state, err := json.Marshal(externalSecretsManagerState{
+		URL:          url,
+		EncryptedKey: encryptedDataKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling state: %w", err)
+	}
+	return &Manager{
+		crypter: secrets.NewSymmetricCrypter(dataKey),
+		state:   state,
+	}, nil
+}
+
+// Manager is the secrets.Manager implementation for external secrets providers
+type Manager struct {
+	state   json.RawMessage
+	crypter config.Crypter
+}
+
+func (m *Manager) Type() string                         { return Type }
+func (m *Manager) State() json.RawMessage               { return m.state }
+func (m *Manager) Encrypter() (config.Encrypter, error) { return m.crypter, nil }
+func (m *Manager) Decrypter() (config.Decrypter, error) { return m.crypter, nil }
+
+// Validate checks that the helper can still decrypt the data key.
+func (m *Manager) Validate(ctx context.Context) error {
+	var s externalSecretsManagerState
+	if err := json.Unmarshal(m.state, &s); err != nil {
+		return fmt.Errorf("unmarshalling state: %w", err)
+	}
+	if _, err := decryptDataKey(ctx, s.URL, s.EncryptedKey); err != nil {
+		return fmt.Errorf("secrets provider %s can't decrypt the stack's data key: %w", s.URL, err)
+	}
+	return nil
+}
+
+func EditProjectStack(info *workspace.ProjectStack, state json.RawMessage) error {
+	info.EncryptionSalt = ""
+
+	var s externalSecretsManagerState
+	err := json.Unmarshal(state, &s)
+	if err != nil {
+		return fmt.Errorf("unmarshalling external state: %w", err)
+	}
+
+	info.SecretsProvider = s.URL
+	info.EncryptedKey = base64.StdEncoding.EncodeToString(s.EncryptedKey)
+	return nil
+}
+
+// NewExternalSecretsManagerFromState deserializes configuration from state and returns a secrets manager that
+// decrypts its data key with the helper for its URL.
+func NewExternalSecretsManagerFromState(state json.RawMessage) (secrets.Manager, error) {
+	var s externalSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return nil, fmt.Errorf("unmarshalling state: %w", err)
+	}
+
+	dataKey, err := decryptDataKey(context.Background(), s.URL, s.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	return newExternalSecretsManager(s.URL, s.EncryptedKey, dataKey)
+}
+
+// NewExternalSecretsManager returns a secrets manager for the given external secrets provider, using the data key in
+// info if it was encrypted with the same provider, or generating a new one otherwise.
+func NewExternalSecretsManager(info *workspace.ProjectStack,
+	secretsProvider string, rotateSecretsProvider bool,
+) (secrets.Manager, error) {
+	// Only a passphrase provider has an encryption salt.
+	info.EncryptionSalt = ""
+
+	// If we're rotating then just clear the key so we create a fresh one below
+	if rotateSecretsProvider {
+		info.EncryptedKey = ""
+	}
+
+	ctx := context.Background()
+
+	// If there is no key or the secrets provider is changing, generate a new key for the new provider.
+	if info.EncryptedKey == "" || info.SecretsProvider != secretsProvider {
+		dataKey := make([]byte, 32)
+		if _, err := rand.Read(dataKey); err != nil {
+			return nil, err
+		}
+		encryptedDataKey, err := encryptDataKey(ctx, secretsProvider, dataKey)
+		if err != nil {
+			return nil, err
+		}
+		info.EncryptedKey = base64.StdEncoding.EncodeToString(encryptedDataKey)
+		info.SecretsProvider = secretsProvider
+		return newExternalSecretsManager(secretsProvider, encryptedDataKey, dataKey)
+	}
+
+	encryptedDataKey, err := base64.StdEncoding.DecodeString(info.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := decryptDataKey(ctx, secretsProvider, encryptedDataKey)
+	if err != nil {
+		return nil, err
+	}
+	return newExternalSecretsManager(secretsProvider, encryptedDataKey, dataKey)
+}
@@ -0,0 +1,116 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// installHelper installs a helper for the "test" scheme on the PATH. The helper "encrypts" by echoing the plaintext
+// back as the ciphertext, and fails for URLs that contain "broken".
+func installHelper(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("helper is a shell script")
+	}
+
+	dir := t.TempDir()
+	script := `#!/bin/sh
+input=$(cat)
+case "$input" in
+*broken*) echo "the key is broken" >&2; exit 1 ;;
+esac
+case "$1" in
+encrypt) echo "$input" | sed -e 's/"plaintext"/"ciphertext"/' ;;
+decrypt) echo "$input" | sed -e 's/"ciphertext"/"plaintext"/' ;;
+*) exit 2 ;;
+esac
+`
+	err := os.WriteFile(filepath.Join(dir, HelperPrefix+"test"), []byte(script), 0o700) //nolint:gosec
+	require.NoError(t, err)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+//nolint:paralleltest // modifies PATH
+func TestIsExternalSecretsProvider(t *testing.T) {
+	installHelper(t)
+
+	assert.True(t, IsExternalSecretsProvider("test://key"))
+	assert.False(t, IsExternalSecretsProvider("missing://key"))
+	assert.False(t, IsExternalSecretsProvider("passphrase"))
+	assert.False(t, IsExternalSecretsProvider("awskms://alias/key"))
+}
+
+//nolint:paralleltest // modifies PATH
+func TestExternalSecretsManager(t *testing.T) {
+	installHelper(t)
+
+	url := "test://my-key"
+	info := &workspace.ProjectStack{EncryptionSalt: "salt"}
+	sm, err := NewExternalSecretsManager(info, url, false)
+	require.NoError(t, err)
+	assert.Equal(t, url, info.SecretsProvider)
+	assert.NotEmpty(t, info.EncryptedKey)
+	assert.Empty(t, info.EncryptionSalt)
+	assert.Equal(t, Type, sm.Type())
+
+	enc, err := sm.Encrypter()
+	require.NoError(t, err)
+	ciphertext, err := enc.EncryptValue(context.Background(), "hunter2")
+	require.NoError(t, err)
+
+	// Reopening the stack decrypts the same data key.
+	sm2, err := NewExternalSecretsManager(info, url, false)
+	require.NoError(t, err)
+	dec, err := sm2.Decrypter()
+	require.NoError(t, err)
+	plaintext, err := dec.DecryptValue(context.Background(), ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", plaintext)
+
+	// So does restoring the manager from its state.
+	sm3, err := NewExternalSecretsManagerFromState(sm.State())
+	require.NoError(t, err)
+	dec, err = sm3.Decrypter()
+	require.NoError(t, err)
+	plaintext, err = dec.DecryptValue(context.Background(), ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", plaintext)
+
+	restored := &workspace.ProjectStack{}
+	require.NoError(t, EditProjectStack(restored, sm.State()))
+	assert.Equal(t, info.SecretsProvider, restored.SecretsProvider)
+	assert.Equal(t, info.EncryptedKey, restored.EncryptedKey)
+}
+
+//nolint:paralleltest // modifies PATH
+func TestExternalSecretsManagerErrors(t *testing.T) {
+	installHelper(t)
+
+	_, err := NewExternalSecretsManager(&workspace.ProjectStack{}, "test://broken", false)
+	assert.ErrorContains(t, err, "pulumi-secrets-test encrypt failed: the key is broken")
+
+	_, err = NewExternalSecretsManager(&workspace.ProjectStack{}, "missing://key", false)
+	assert.ErrorContains(t, err, `no helper found for secrets provider scheme "missing"`)
+}
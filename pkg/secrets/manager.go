@@ -17,8 +17,11 @@ package secrets
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 )
 
@@ -39,6 +42,108 @@ type Manager interface {
 	Decrypter() (config.Decrypter, error)
 }
 
+// BatchCrypter is implemented by Managers that can encrypt or decrypt many values at once more efficiently than one
+// at a time, e.g. because they're backed by a remote key management service.
+type BatchCrypter interface {
+	// BatchEncrypt encrypts a list of plaintexts, returning their ciphertexts in the same order. Managers backed by a
+	// remote key management service should encrypt the values in as few requests as possible.
+	BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error)
+	// BatchDecrypt decrypts a list of ciphertexts, returning their plaintexts in the same order. Managers backed by a
+	// remote key management service should decrypt the values in as few requests as possible.
+	BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error)
+}
+
+// BatchEncrypt encrypts the plaintexts with m, returning their ciphertexts in the same order. If m doesn't implement
+// BatchCrypter, each plaintext is encrypted with its Encrypter.
+func BatchEncrypt(ctx context.Context, m Manager, plaintexts []string) ([]string, error) {
+	if b, ok := m.(BatchCrypter); ok {
+		return b.BatchEncrypt(ctx, plaintexts)
+	}
+	return DefaultBatchEncrypt(ctx, m, plaintexts)
+}
+
+// BatchDecrypt decrypts the ciphertexts with m, returning their plaintexts in the same order. If m doesn't implement
+// BatchCrypter, the ciphertexts are decrypted with the BulkDecrypt method of its Decrypter.
+func BatchDecrypt(ctx context.Context, m Manager, ciphertexts []string) ([]string, error) {
+	if b, ok := m.(BatchCrypter); ok {
+		return b.BatchDecrypt(ctx, ciphertexts)
+	}
+	return DefaultBatchDecrypt(ctx, m, ciphertexts)
+}
+
+// Validator is implemented by Managers that can check up front that they'll be able to encrypt and decrypt secrets,
+// e.g. that their key is accessible and that the caller has permission to use it. Validating a manager before a
+// deployment begins reports misconfigurations before anything has been changed, rather than on the first secret.
+type Validator interface {
+	// Validate returns an actionable error if the manager won't be able to encrypt or decrypt secrets.
+	Validate(ctx context.Context) error
+}
+
+// Validate validates m if it implements Validator.
+func Validate(ctx context.Context, m Manager) error {
+	if v, ok := m.(Validator); ok {
+		return v.Validate(ctx)
+	}
+	return nil
+}
+
+// DefaultBatchEncrypt encrypts each plaintext individually with the manager's Encrypter. It's what BatchEncrypt falls
+// back to for managers that don't implement BatchCrypter.
+func DefaultBatchEncrypt(ctx context.Context, m Manager, plaintexts []string) ([]string, error) {
+	if len(plaintexts) == 0 {
+		return nil, nil
+	}
+
+	enc, err := m.Encrypter()
+	if err != nil {
+		return nil, err
+	}
+	ciphertexts := make([]string, len(plaintexts))
+	for i, pt := range plaintexts {
+		ct, err := enc.EncryptValue(ctx, pt)
+		if err != nil {
+			return nil, err
+		}
+		ciphertexts[i] = ct
+	}
+	return ciphertexts, nil
+}
+
+// DefaultBatchDecrypt decrypts the ciphertexts with the BulkDecrypt method of the manager's Decrypter. It's what
+// BatchDecrypt falls back to for managers that don't implement BatchCrypter.
+func DefaultBatchDecrypt(ctx context.Context, m Manager, ciphertexts []string) ([]string, error) {
+	if len(ciphertexts) == 0 {
+		return nil, nil
+	}
+
+	dec, err := m.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	decrypted, err := dec.BulkDecrypt(ctx, ciphertexts)
+	if err != nil {
+		return nil, err
+	}
+	plaintexts := make([]string, len(ciphertexts))
+	for i, ct := range ciphertexts {
+		pt, ok := decrypted[ct]
+		if !ok {
+			return nil, fmt.Errorf("decrypting secrets: no plaintext returned for ciphertext %d", i)
+		}
+		plaintexts[i] = pt
+	}
+	return plaintexts, nil
+}
+
+// NewSymmetricCrypter returns a crypter that encrypts values with AES-256-GCM using key. This should be used by
+// implementers of Manager that encrypt values locally, so that PULUMI_DETERMINISTIC_SECRETS is respected.
+func NewSymmetricCrypter(key []byte) config.Crypter {
+	if env.DeterministicSecrets.Value() {
+		return config.NewDeterministicSymmetricCrypter(key)
+	}
+	return config.NewSymmetricCrypter(key)
+}
+
 // AreCompatible returns true if the two Managers are of the same type and have the same state.
 func AreCompatible(a, b Manager) bool {
 	if a == nil || b == nil {
@@ -0,0 +1,71 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+// unbatchedManager hides the BatchCrypter methods of the manager it wraps, like a Manager implemented before
+// BatchCrypter was added.
+type unbatchedManager struct {
+	Manager
+}
+
+func TestBatchEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	crypter := config.NewSymmetricCrypter(make([]byte, config.SymmetricCrypterKeyBytes))
+	mock := &MockSecretsManager{
+		EncrypterF: func() (config.Encrypter, error) { return crypter, nil },
+		DecrypterF: func() (config.Decrypter, error) { return crypter, nil },
+		BatchEncryptF: func(plaintexts []string) ([]string, error) {
+			return nil, errors.New("batch encrypt")
+		},
+		BatchDecryptF: func(ciphertexts []string) ([]string, error) {
+			return nil, errors.New("batch decrypt")
+		},
+	}
+
+	t.Run("BatchCrypter", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := BatchEncrypt(ctx, mock, []string{"one"})
+		assert.ErrorContains(t, err, "batch encrypt")
+		_, err = BatchDecrypt(ctx, mock, []string{"one"})
+		assert.ErrorContains(t, err, "batch decrypt")
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		t.Parallel()
+
+		sm := unbatchedManager{Manager: mock}
+		ciphertexts, err := BatchEncrypt(ctx, sm, []string{"one", "two"})
+		require.NoError(t, err)
+		require.Len(t, ciphertexts, 2)
+
+		plaintexts, err := BatchDecrypt(ctx, sm, ciphertexts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one", "two"}, plaintexts)
+	})
+}
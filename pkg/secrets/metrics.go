@@ -0,0 +1,244 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+// The operations of a secrets manager that are measured by NewInstrumentedSecretsManager.
+const (
+	OpEncrypt      = "encrypt"
+	OpDecrypt      = "decrypt"
+	OpBulkDecrypt  = "bulkDecrypt"
+	OpBatchEncrypt = "batchEncrypt"
+	OpBatchDecrypt = "batchDecrypt"
+)
+
+// LatencyBuckets are the upper bounds of the buckets of the latency histograms of OperationMetrics. Calls that take
+// longer than the last bound are counted in an extra bucket.
+var LatencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// OperationMetrics are the metrics of one operation of one type of secrets manager.
+type OperationMetrics struct {
+	// Calls is the number of calls to the operation.
+	Calls int
+	// Failures is the number of calls that failed.
+	Failures int
+	// Values is the number of values that were encrypted or decrypted.
+	Values int
+	// TotalLatency is the total time spent in the operation.
+	TotalLatency time.Duration
+	// MaxLatency is the duration of the slowest call.
+	MaxLatency time.Duration
+	// Latency is a histogram of the durations of the calls, bucketed by LatencyBuckets.
+	Latency []int
+}
+
+// Metrics collects the OperationMetrics of the secrets managers returned by NewInstrumentedSecretsManager. It's safe
+// for concurrent use.
+type Metrics struct {
+	m   sync.Mutex
+	ops map[string]map[string]*OperationMetrics
+}
+
+// DefaultMetrics are the metrics of the secrets managers of this process.
+var DefaultMetrics = &Metrics{}
+
+func (m *Metrics) record(ty, op string, values int, latency time.Duration, err error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if m.ops == nil {
+		m.ops = map[string]map[string]*OperationMetrics{}
+	}
+	if m.ops[ty] == nil {
+		m.ops[ty] = map[string]*OperationMetrics{}
+	}
+	om := m.ops[ty][op]
+	if om == nil {
+		om = &OperationMetrics{Latency: make([]int, len(LatencyBuckets)+1)}
+		m.ops[ty][op] = om
+	}
+
+	om.Calls++
+	if err != nil {
+		om.Failures++
+	} else {
+		om.Values += values
+	}
+	om.TotalLatency += latency
+	if latency > om.MaxLatency {
+		om.MaxLatency = latency
+	}
+	bucket := sort.Search(len(LatencyBuckets), func(i int) bool { return latency <= LatencyBuckets[i] })
+	om.Latency[bucket]++
+}
+
+// Get returns the metrics of the given operation of the given type of secrets manager.
+func (m *Metrics) Get(ty, op string) (OperationMetrics, bool) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	om, ok := m.ops[ty][op]
+	if !ok {
+		return OperationMetrics{}, false
+	}
+	result := *om
+	result.Latency = append([]int(nil), om.Latency...)
+	return result, true
+}
+
+// Report records the metrics as tags of span, e.g. the root span of a traced command, named
+// secrets.<type>.<operation>.<metric>.
+func (m *Metrics) Report(span opentracing.Span) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	for ty, ops := range m.ops {
+		for op, om := range ops {
+			prefix := fmt.Sprintf("secrets.%s.%s.", ty, op)
+			span.SetTag(prefix+"calls", om.Calls)
+			span.SetTag(prefix+"failures", om.Failures)
+			span.SetTag(prefix+"values", om.Values)
+			span.SetTag(prefix+"latency.total", om.TotalLatency.String())
+			span.SetTag(prefix+"latency.max", om.MaxLatency.String())
+			for i, count := range om.Latency {
+				bucket := "inf"
+				if i < len(LatencyBuckets) {
+					bucket = LatencyBuckets[i].String()
+				}
+				span.SetTag(prefix+"latency.le."+bucket, count)
+			}
+		}
+	}
+}
+
+type instrumentedSecretsManager struct {
+	manager Manager
+	metrics *Metrics
+}
+
+// NewInstrumentedSecretsManager returns a secrets manager that records the number, latency and failures of the
+// calls to manager in metrics, and traces each of them in a span.
+func NewInstrumentedSecretsManager(manager Manager, metrics *Metrics) Manager {
+	return &instrumentedSecretsManager{manager: manager, metrics: metrics}
+}
+
+func (m *instrumentedSecretsManager) Type() string           { return m.manager.Type() }
+func (m *instrumentedSecretsManager) State() json.RawMessage { return m.manager.State() }
+
+func (m *instrumentedSecretsManager) Validate(ctx context.Context) error {
+	return Validate(ctx, m.manager)
+}
+
+func (m *instrumentedSecretsManager) Encrypter() (config.Encrypter, error) {
+	enc, err := m.manager.Encrypter()
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedCrypter{encrypter: enc, manager: m}, nil
+}
+
+func (m *instrumentedSecretsManager) Decrypter() (config.Decrypter, error) {
+	dec, err := m.manager.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedCrypter{decrypter: dec, manager: m}, nil
+}
+
+func (m *instrumentedSecretsManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	return m.measure(ctx, OpBatchEncrypt, len(plaintexts), func(ctx context.Context) ([]string, error) {
+		return BatchEncrypt(ctx, m.manager, plaintexts)
+	})
+}
+
+func (m *instrumentedSecretsManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	return m.measure(ctx, OpBatchDecrypt, len(ciphertexts), func(ctx context.Context) ([]string, error) {
+		return BatchDecrypt(ctx, m.manager, ciphertexts)
+	})
+}
+
+// measure calls f in a span named after the operation, and records its latency and outcome.
+func (m *instrumentedSecretsManager) measure(ctx context.Context, op string, values int,
+	f func(ctx context.Context) ([]string, error),
+) ([]string, error) {
+	ty := m.manager.Type()
+	span, ctx := opentracing.StartSpanFromContext(ctx, "secrets."+op,
+		opentracing.Tag{Key: "secrets.provider", Value: ty},
+		opentracing.Tag{Key: "secrets.values", Value: values})
+	defer span.Finish()
+
+	start := time.Now()
+	result, err := f(ctx)
+	m.metrics.record(ty, op, values, time.Since(start), err)
+	if err != nil {
+		span.SetTag("error", true)
+	}
+	return result, err
+}
+
+type instrumentedCrypter struct {
+	encrypter config.Encrypter
+	decrypter config.Decrypter
+	manager   *instrumentedSecretsManager
+}
+
+func (c *instrumentedCrypter) EncryptValue(ctx context.Context, plaintext string) (string, error) {
+	result, err := c.manager.measure(ctx, OpEncrypt, 1, func(ctx context.Context) ([]string, error) {
+		ciphertext, err := c.encrypter.EncryptValue(ctx, plaintext)
+		return []string{ciphertext}, err
+	})
+	if err != nil {
+		return "", err
+	}
+	return result[0], nil
+}
+
+func (c *instrumentedCrypter) DecryptValue(ctx context.Context, ciphertext string) (string, error) {
+	result, err := c.manager.measure(ctx, OpDecrypt, 1, func(ctx context.Context) ([]string, error) {
+		plaintext, err := c.decrypter.DecryptValue(ctx, ciphertext)
+		return []string{plaintext}, err
+	})
+	if err != nil {
+		return "", err
+	}
+	return result[0], nil
+}
+
+func (c *instrumentedCrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (map[string]string, error) {
+	var decrypted map[string]string
+	_, err := c.manager.measure(ctx, OpBulkDecrypt, len(ciphertexts), func(ctx context.Context) ([]string, error) {
+		var err error
+		decrypted, err = c.decrypter.BulkDecrypt(ctx, ciphertexts)
+		return nil, err
+	})
+	return decrypted, err
+}
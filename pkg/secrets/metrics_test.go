@@ -0,0 +1,93 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+func TestInstrumentedSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	crypter := config.NewSymmetricCrypter(make([]byte, config.SymmetricCrypterKeyBytes))
+	metrics := &Metrics{}
+	sm := NewInstrumentedSecretsManager(&MockSecretsManager{
+		TypeF:      func() string { return "mock" },
+		EncrypterF: func() (config.Encrypter, error) { return crypter, nil },
+		DecrypterF: func() (config.Decrypter, error) { return crypter, nil },
+		BatchDecryptF: func(ciphertexts []string) ([]string, error) {
+			return nil, errors.New("throttled")
+		},
+	}, metrics)
+
+	enc, err := sm.Encrypter()
+	require.NoError(t, err)
+	ct1, err := enc.EncryptValue(ctx, "one")
+	require.NoError(t, err)
+	ct2, err := enc.EncryptValue(ctx, "two")
+	require.NoError(t, err)
+
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	plaintext, err := dec.DecryptValue(ctx, ct1)
+	require.NoError(t, err)
+	assert.Equal(t, "one", plaintext)
+	_, err = dec.BulkDecrypt(ctx, []string{ct1, ct2})
+	require.NoError(t, err)
+	_, err = BatchDecrypt(ctx, sm, []string{ct1, ct2})
+	assert.ErrorContains(t, err, "throttled")
+
+	om, ok := metrics.Get("mock", OpEncrypt)
+	require.True(t, ok)
+	assert.Equal(t, 2, om.Calls)
+	assert.Equal(t, 2, om.Values)
+	assert.Equal(t, 0, om.Failures)
+	assert.Len(t, om.Latency, len(LatencyBuckets)+1)
+	// Encryption is much faster than the first bucket.
+	assert.Equal(t, 2, om.Latency[0])
+
+	om, ok = metrics.Get("mock", OpBulkDecrypt)
+	require.True(t, ok)
+	assert.Equal(t, 1, om.Calls)
+	assert.Equal(t, 2, om.Values)
+
+	om, ok = metrics.Get("mock", OpBatchDecrypt)
+	require.True(t, ok)
+	assert.Equal(t, 1, om.Calls)
+	assert.Equal(t, 1, om.Failures)
+	assert.Equal(t, 0, om.Values)
+
+	_, ok = metrics.Get("mock", OpBatchEncrypt)
+	assert.False(t, ok)
+
+	tracer := mocktracer.New()
+	span := tracer.StartSpan("root")
+	metrics.Report(span)
+	span.Finish()
+	tags := tracer.FinishedSpans()[0].Tags()
+	assert.Equal(t, 2, tags["secrets.mock.encrypt.calls"])
+	assert.Equal(t, 1, tags["secrets.mock.batchDecrypt.failures"])
+	assert.Equal(t, 2, tags["secrets.mock.encrypt.latency.le.10ms"])
+	assert.Equal(t, 0, tags["secrets.mock.encrypt.latency.le.inf"])
+}
@@ -23,10 +23,12 @@ import (
 )
 
 type MockSecretsManager struct {
-	TypeF      func() string
-	StateF     func() json.RawMessage
-	EncrypterF func() (config.Encrypter, error)
-	DecrypterF func() (config.Decrypter, error)
+	TypeF         func() string
+	StateF        func() json.RawMessage
+	EncrypterF    func() (config.Encrypter, error)
+	DecrypterF    func() (config.Decrypter, error)
+	BatchEncryptF func(plaintexts []string) ([]string, error)
+	BatchDecryptF func(ciphertexts []string) ([]string, error)
 }
 
 var _ Manager = &MockSecretsManager{}
@@ -63,6 +65,22 @@ func (msm *MockSecretsManager) Decrypter() (config.Decrypter, error) {
 	panic("not implemented")
 }
 
+func (msm *MockSecretsManager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	if msm.BatchEncryptF != nil {
+		return msm.BatchEncryptF(plaintexts)
+	}
+
+	return DefaultBatchEncrypt(ctx, msm, plaintexts)
+}
+
+func (msm *MockSecretsManager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	if msm.BatchDecryptF != nil {
+		return msm.BatchDecryptF(ciphertexts)
+	}
+
+	return DefaultBatchDecrypt(ctx, msm, ciphertexts)
+}
+
 type MockEncrypter struct {
 	EncryptValueF func() string
 }
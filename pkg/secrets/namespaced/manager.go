@@ -0,0 +1,329 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package namespaced implements a composite secrets manager, which encrypts the config values of some config
+// namespaces with secrets managers of their own, and everything else with a default secrets manager. This allows
+// organizations to split the custody of a stack's keys, e.g. so that only the holders of a KMS key can decrypt the
+// stack's aws:* configuration.
+package namespaced
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Type is the type of secrets managed by this secrets provider
+const Type = "namespaced"
+
+// prefix marks a ciphertext that was encrypted by the secrets manager of a namespace. It's followed by the namespace
+// and a colon, so that each value records which of the secrets managers it must be decrypted with.
+const prefix = "namespaced:"
+
+type namespaceState struct {
+	Namespace string                     `json:"namespace"`
+	Provider  apitype.SecretsProvidersV1 `json:"provider"`
+}
+
+type namespacedSecretsManagerState struct {
+	Default    apitype.SecretsProvidersV1 `json:"default"`
+	Namespaces []namespaceState           `json:"namespaces,omitempty"`
+}
+
+// Manager is a secrets.Manager that encrypts config values with the secrets manager of their namespace, if there is
+// one, and everything else with its default manager.
+type Manager struct {
+	state      json.RawMessage
+	def        secrets.Manager
+	namespaces map[string]secrets.Manager
+}
+
+var _ secrets.Manager = (*Manager)(nil)
+
+// NewNamespacedSecretsManager returns a secrets manager that encrypts secrets with def, except for the config values
+// of the given namespaces, which are encrypted with their own managers.
+func NewNamespacedSecretsManager(def secrets.Manager, namespaces map[string]secrets.Manager) (*Manager, error) {
+	s := namespacedSecretsManagerState{
+		Default: apitype.SecretsProvidersV1{Type: def.Type(), State: def.State()},
+	}
+	for _, ns := range sortedNamespaces(namespaces) {
+		sm := namespaces[ns]
+		s.Namespaces = append(s.Namespaces, namespaceState{
+			Namespace: ns,
+			Provider:  apitype.SecretsProvidersV1{Type: sm.Type(), State: sm.State()},
+		})
+	}
+	state, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling state: %w", err)
+	}
+	return &Manager{state: state, def: def, namespaces: namespaces}, nil
+}
+
+// NewNamespacedSecretsManagerFromState deserializes configuration from state and returns a namespaced secrets manager
+// whose managers are constructed by the given provider.
+func NewNamespacedSecretsManagerFromState(state json.RawMessage, provider secrets.Provider) (secrets.Manager, error) {
+	var s namespacedSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return nil, fmt.Errorf("unmarshalling state: %w", err)
+	}
+
+	def, err := provider.OfType(s.Default.Type, s.Default.State)
+	if err != nil {
+		return nil, fmt.Errorf("constructing default secrets manager: %w", err)
+	}
+	namespaces := make(map[string]secrets.Manager, len(s.Namespaces))
+	for _, ns := range s.Namespaces {
+		sm, err := provider.OfType(ns.Provider.Type, ns.Provider.State)
+		if err != nil {
+			return nil, fmt.Errorf("constructing secrets manager for namespace %q: %w", ns.Namespace, err)
+		}
+		namespaces[ns.Namespace] = sm
+	}
+	return &Manager{state: state, def: def, namespaces: namespaces}, nil
+}
+
+// EditProjectStack restores the configuration of the default manager and of the managers of each namespace into
+// info, using edit to restore the configuration of each manager from its state.
+func EditProjectStack(info *workspace.ProjectStack, state json.RawMessage,
+	edit func(info *workspace.ProjectStack, ty string, state json.RawMessage) error,
+) error {
+	var s namespacedSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return fmt.Errorf("unmarshalling namespaced state: %w", err)
+	}
+
+	if err := edit(info, s.Default.Type, s.Default.State); err != nil {
+		return err
+	}
+	info.NamespaceProviders = nil
+	for _, ns := range s.Namespaces {
+		var p workspace.ProjectStack
+		if err := edit(&p, ns.Provider.Type, ns.Provider.State); err != nil {
+			return err
+		}
+		info.NamespaceProviders = append(info.NamespaceProviders, workspace.ConfigSecretsProvider{
+			Namespace:       ns.Namespace,
+			SecretsProvider: p.SecretsProvider,
+			EncryptedKey:    p.EncryptedKey,
+			EncryptionSalt:  p.EncryptionSalt,
+		})
+	}
+	return nil
+}
+
+// Default returns the manager that encrypts everything but the config values of the namespaces.
+func (m *Manager) Default() secrets.Manager { return m.def }
+
+// Namespace returns the manager that encrypts the config values of the given namespace, if there is one.
+func (m *Manager) Namespace(namespace string) (secrets.Manager, bool) {
+	sm, ok := m.namespaces[namespace]
+	return sm, ok
+}
+
+func (m *Manager) Type() string           { return Type }
+func (m *Manager) State() json.RawMessage { return m.state }
+
+// Encrypter returns the encrypter of the default manager. Use EncrypterFor to encrypt config values.
+func (m *Manager) Encrypter() (config.Encrypter, error) {
+	return m.def.Encrypter()
+}
+
+// EncrypterFor returns the encrypter for the config values of the given namespace.
+func (m *Manager) EncrypterFor(namespace string) (config.Encrypter, error) {
+	sm, ok := m.namespaces[namespace]
+	if !ok {
+		return m.def.Encrypter()
+	}
+	enc, err := sm.Encrypter()
+	if err != nil {
+		return nil, err
+	}
+	return NewEncrypter(namespace, enc), nil
+}
+
+func (m *Manager) Decrypter() (config.Decrypter, error) {
+	def, err := m.def.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	decrypters := make(map[string]config.Decrypter, len(m.namespaces))
+	for ns, sm := range m.namespaces {
+		dec, err := sm.Decrypter()
+		if err != nil {
+			return nil, err
+		}
+		decrypters[ns] = dec
+	}
+	return &namespacedDecrypter{def: def, namespaces: decrypters}, nil
+}
+
+// Validate validates the default manager and the manager of each namespace.
+func (m *Manager) Validate(ctx context.Context) error {
+	if err := secrets.Validate(ctx, m.def); err != nil {
+		return err
+	}
+	for _, ns := range sortedNamespaces(m.namespaces) {
+		if err := secrets.Validate(ctx, m.namespaces[ns]); err != nil {
+			return fmt.Errorf("namespace %q: %w", ns, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) BatchEncrypt(ctx context.Context, plaintexts []string) ([]string, error) {
+	return secrets.BatchEncrypt(ctx, m.def, plaintexts)
+}
+
+// BatchDecrypt decrypts the ciphertexts of each manager in a single batch.
+func (m *Manager) BatchDecrypt(ctx context.Context, ciphertexts []string) ([]string, error) {
+	// Group the indices of the ciphertexts by the namespace that encrypted them; "" is the default manager.
+	groups := map[string][]int{}
+	stripped := make([]string, len(ciphertexts))
+	for i, ciphertext := range ciphertexts {
+		ns, rest, _ := splitCiphertext(ciphertext)
+		groups[ns] = append(groups[ns], i)
+		stripped[i] = rest
+	}
+
+	plaintexts := make([]string, len(ciphertexts))
+	for ns, indices := range groups {
+		sm := m.def
+		if ns != "" {
+			var ok bool
+			if sm, ok = m.namespaces[ns]; !ok {
+				return nil, fmt.Errorf("no secrets manager for namespace %q", ns)
+			}
+		}
+		batch := make([]string, len(indices))
+		for j, i := range indices {
+			batch[j] = stripped[i]
+		}
+		decrypted, err := secrets.BatchDecrypt(ctx, sm, batch)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range indices {
+			plaintexts[i] = decrypted[j]
+		}
+	}
+	return plaintexts, nil
+}
+
+// NewEncrypter returns an encrypter that records in each of the ciphertexts of enc that it was encrypted by the
+// secrets manager of the given namespace.
+func NewEncrypter(namespace string, enc config.Encrypter) config.Encrypter {
+	return &namespaceEncrypter{namespace: namespace, enc: enc}
+}
+
+type namespaceEncrypter struct {
+	namespace string
+	enc       config.Encrypter
+}
+
+func (e *namespaceEncrypter) EncryptValue(ctx context.Context, plaintext string) (string, error) {
+	ciphertext, err := e.enc.EncryptValue(ctx, plaintext)
+	if err != nil {
+		return "", err
+	}
+	return prefix + e.namespace + ":" + ciphertext, nil
+}
+
+// Namespace returns the namespace whose secrets manager encrypted ciphertext, if it wasn't the default manager.
+func Namespace(ciphertext string) (string, bool) {
+	ns, _, ok := splitCiphertext(ciphertext)
+	return ns, ok
+}
+
+// splitCiphertext splits a ciphertext into the namespace whose secrets manager encrypted it and the ciphertext of
+// that manager. Ciphertexts of the default manager have an empty namespace.
+func splitCiphertext(ciphertext string) (string, string, bool) {
+	if !strings.HasPrefix(ciphertext, prefix) {
+		return "", ciphertext, false
+	}
+	ns, rest, ok := strings.Cut(ciphertext[len(prefix):], ":")
+	if !ok || ns == "" {
+		return "", ciphertext, false
+	}
+	return ns, rest, true
+}
+
+// namespacedDecrypter decrypts values with the decrypter of the namespace that encrypted them.
+type namespacedDecrypter struct {
+	def        config.Decrypter
+	namespaces map[string]config.Decrypter
+}
+
+func (d *namespacedDecrypter) decrypter(namespace string) (config.Decrypter, error) {
+	if namespace == "" {
+		return d.def, nil
+	}
+	dec, ok := d.namespaces[namespace]
+	if !ok {
+		return nil, fmt.Errorf("no secrets manager for namespace %q", namespace)
+	}
+	return dec, nil
+}
+
+func (d *namespacedDecrypter) DecryptValue(ctx context.Context, ciphertext string) (string, error) {
+	ns, rest, _ := splitCiphertext(ciphertext)
+	dec, err := d.decrypter(ns)
+	if err != nil {
+		return "", err
+	}
+	return dec.DecryptValue(ctx, rest)
+}
+
+func (d *namespacedDecrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (map[string]string, error) {
+	// Group the ciphertexts by namespace so that each decrypter decrypts its values in bulk.
+	groups := map[string][]string{}
+	originals := map[string]string{}
+	for _, ciphertext := range ciphertexts {
+		ns, rest, _ := splitCiphertext(ciphertext)
+		groups[ns] = append(groups[ns], rest)
+		originals[ns+":"+rest] = ciphertext
+	}
+
+	decrypted := make(map[string]string, len(ciphertexts))
+	for ns, batch := range groups {
+		dec, err := d.decrypter(ns)
+		if err != nil {
+			return nil, err
+		}
+		plaintexts, err := dec.BulkDecrypt(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		for rest, plaintext := range plaintexts {
+			decrypted[originals[ns+":"+rest]] = plaintext
+		}
+	}
+	return decrypted, nil
+}
+
+func sortedNamespaces(namespaces map[string]secrets.Manager) []string {
+	names := make([]string, 0, len(namespaces))
+	for ns := range namespaces {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	return names
+}
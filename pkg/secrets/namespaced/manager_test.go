@@ -0,0 +1,131 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaced
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/pkg/v3/secrets/passphrase"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// testProvider returns one of a fixed set of managers, identified by their state.
+type testProvider map[string]secrets.Manager
+
+func (p testProvider) OfType(ty string, state json.RawMessage) (secrets.Manager, error) {
+	sm, ok := p[string(state)]
+	if !ok || sm.Type() != ty {
+		return nil, fmt.Errorf("unknown manager %s", state)
+	}
+	return sm, nil
+}
+
+func newPassphraseManager(t *testing.T, phrase string) secrets.Manager {
+	t.Helper()
+	_, sm, err := passphrase.NewPassphraseSecretsManager(phrase)
+	require.NoError(t, err)
+	return sm
+}
+
+func TestNamespacedSecretsManager(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	def := newPassphraseManager(t, "default")
+	aws := newPassphraseManager(t, "aws")
+	sm, err := NewNamespacedSecretsManager(def, map[string]secrets.Manager{"aws": aws})
+	require.NoError(t, err)
+
+	awsEnc, err := sm.EncrypterFor("aws")
+	require.NoError(t, err)
+	awsCiphertext, err := awsEnc.EncryptValue(ctx, "aws-secret")
+	require.NoError(t, err)
+	ns, ok := Namespace(awsCiphertext)
+	assert.True(t, ok)
+	assert.Equal(t, "aws", ns)
+
+	defEnc, err := sm.EncrypterFor("gcp")
+	require.NoError(t, err)
+	defCiphertext, err := defEnc.EncryptValue(ctx, "other-secret")
+	require.NoError(t, err)
+	_, ok = Namespace(defCiphertext)
+	assert.False(t, ok)
+
+	// Only the manager of the namespace can decrypt its values.
+	awsDec, err := aws.Decrypter()
+	require.NoError(t, err)
+	_, err = awsDec.DecryptValue(ctx, strings.TrimPrefix(awsCiphertext, "namespaced:aws:"))
+	require.NoError(t, err)
+	defDec, err := def.Decrypter()
+	require.NoError(t, err)
+	_, err = defDec.DecryptValue(ctx, strings.TrimPrefix(awsCiphertext, "namespaced:aws:"))
+	assert.Error(t, err)
+
+	dec, err := sm.Decrypter()
+	require.NoError(t, err)
+	plaintext, err := dec.DecryptValue(ctx, awsCiphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "aws-secret", plaintext)
+	plaintext, err = dec.DecryptValue(ctx, defCiphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "other-secret", plaintext)
+
+	decrypted, err := dec.BulkDecrypt(ctx, []string{awsCiphertext, defCiphertext})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{awsCiphertext: "aws-secret", defCiphertext: "other-secret"}, decrypted)
+
+	plaintexts, err := sm.BatchDecrypt(ctx, []string{defCiphertext, awsCiphertext})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other-secret", "aws-secret"}, plaintexts)
+
+	_, err = dec.DecryptValue(ctx, "namespaced:azure:abc")
+	assert.ErrorContains(t, err, `no secrets manager for namespace "azure"`)
+}
+
+func TestNamespacedSecretsManagerState(t *testing.T) {
+	t.Parallel()
+
+	def := newPassphraseManager(t, "default")
+	aws := newPassphraseManager(t, "aws")
+	sm, err := NewNamespacedSecretsManager(def, map[string]secrets.Manager{"aws": aws})
+	require.NoError(t, err)
+
+	provider := testProvider{string(def.State()): def, string(aws.State()): aws}
+	restored, err := NewNamespacedSecretsManagerFromState(sm.State(), provider)
+	require.NoError(t, err)
+	assert.Equal(t, sm.State(), restored.State())
+	restoredAWS, ok := restored.(*Manager).Namespace("aws")
+	require.True(t, ok)
+	assert.Equal(t, aws.State(), restoredAWS.State())
+
+	var ps workspace.ProjectStack
+	err = EditProjectStack(&ps, sm.State(), func(info *workspace.ProjectStack, ty string, state json.RawMessage) error {
+		return passphrase.EditProjectStack(info, state)
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, ps.EncryptionSalt)
+	require.Len(t, ps.NamespaceProviders, 1)
+	assert.Equal(t, "aws", ps.NamespaceProviders[0].Namespace)
+	assert.NotEmpty(t, ps.NamespaceProviders[0].EncryptionSalt)
+	assert.NotEqual(t, ps.EncryptionSalt, ps.NamespaceProviders[0].EncryptionSalt)
+}
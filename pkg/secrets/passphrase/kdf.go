@@ -0,0 +1,133 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+// KDF describes the key derivation function used to derive the encryption key of a stack from its passphrase. KDFs
+// are written as an algorithm followed by its parameters, e.g. `pbkdf2,i=1000000`, `scrypt,n=32768,r=8,p=1` or
+// `argon2id,t=3,m=65536,p=4`.
+type KDF struct {
+	// Algorithm is one of "pbkdf2", "scrypt" or "argon2id".
+	Algorithm string
+	// Iterations is the number of iterations of pbkdf2 (i), or the number of passes of argon2id (t).
+	Iterations int
+	// Cost is the CPU/memory cost of scrypt (n), or the memory in KiB used by argon2id (m).
+	Cost int
+	// BlockSize is the block size of scrypt (r).
+	BlockSize int
+	// Parallelism is the parallelization of scrypt, or the number of threads used by argon2id (p).
+	Parallelism int
+}
+
+// DefaultKDF is the KDF of stacks that don't configure one: 1,000,000 iterations of PBKDF2 using SHA256. Stacks that
+// use it keep the `v1` state format, so that they can be read by older versions of the CLI.
+var DefaultKDF = KDF{Algorithm: "pbkdf2", Iterations: 1000000}
+
+// kdfDefaults are the parameters of each algorithm that are used when a KDF leaves them out.
+var kdfDefaults = map[string]KDF{
+	"pbkdf2":   DefaultKDF,
+	"scrypt":   {Algorithm: "scrypt", Cost: 32768, BlockSize: 8, Parallelism: 1},
+	"argon2id": {Algorithm: "argon2id", Iterations: 3, Cost: 64 * 1024, Parallelism: 4},
+}
+
+// ParseKDF parses a KDF written as an algorithm followed by its parameters. Parameters that are left out take the
+// algorithm's default values.
+func ParseKDF(s string) (KDF, error) {
+	parts := strings.Split(s, ",")
+	kdf, ok := kdfDefaults[parts[0]]
+	if !ok {
+		return KDF{}, fmt.Errorf("unknown key derivation function %q, expected pbkdf2, scrypt or argon2id", parts[0])
+	}
+
+	for _, p := range parts[1:] {
+		name, value, ok := strings.Cut(p, "=")
+		if !ok {
+			return KDF{}, fmt.Errorf("malformed %s parameter %q", kdf.Algorithm, p)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return KDF{}, fmt.Errorf("%s parameter %q must be a positive integer", kdf.Algorithm, name)
+		}
+
+		var field *int
+		switch {
+		case name == "i" && kdf.Algorithm == "pbkdf2", name == "t" && kdf.Algorithm == "argon2id":
+			field = &kdf.Iterations
+		case name == "n" && kdf.Algorithm == "scrypt", name == "m" && kdf.Algorithm == "argon2id":
+			field = &kdf.Cost
+		case name == "r" && kdf.Algorithm == "scrypt":
+			field = &kdf.BlockSize
+		case name == "p" && kdf.Algorithm != "pbkdf2":
+			field = &kdf.Parallelism
+		default:
+			return KDF{}, fmt.Errorf("unknown %s parameter %q", kdf.Algorithm, name)
+		}
+		*field = n
+	}
+
+	switch {
+	case kdf.Algorithm == "scrypt" && (kdf.Cost < 2 || kdf.Cost&(kdf.Cost-1) != 0):
+		return KDF{}, fmt.Errorf("scrypt parameter \"n\" must be a power of two greater than one")
+	case kdf.Algorithm == "argon2id" && kdf.Parallelism > 255:
+		return KDF{}, fmt.Errorf("argon2id parameter \"p\" must be at most 255")
+	}
+	return kdf, nil
+}
+
+// String returns the KDF in the form accepted by ParseKDF.
+func (k KDF) String() string {
+	switch k.Algorithm {
+	case "pbkdf2":
+		return fmt.Sprintf("pbkdf2,i=%d", k.Iterations)
+	case "scrypt":
+		return fmt.Sprintf("scrypt,n=%d,r=%d,p=%d", k.Cost, k.BlockSize, k.Parallelism)
+	default:
+		return fmt.Sprintf("%s,t=%d,m=%d,p=%d", k.Algorithm, k.Iterations, k.Cost, k.Parallelism)
+	}
+}
+
+// crypter derives a key from phrase and salt and returns a crypter using it.
+func (k KDF) crypter(phrase string, salt []byte) (config.Crypter, error) {
+	var key []byte
+	switch k.Algorithm {
+	case "pbkdf2":
+		key = pbkdf2.Key([]byte(phrase), salt, k.Iterations, config.SymmetricCrypterKeyBytes, sha256.New)
+	case "scrypt":
+		var err error
+		key, err = scrypt.Key([]byte(phrase), salt, k.Cost, k.BlockSize, k.Parallelism, config.SymmetricCrypterKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("deriving key: %w", err)
+		}
+	case "argon2id":
+		key = argon2.IDKey([]byte(phrase), salt, uint32(k.Iterations), uint32(k.Cost), uint8(k.Parallelism),
+			config.SymmetricCrypterKeyBytes)
+	default:
+		return nil, fmt.Errorf("unknown key derivation function %q", k.Algorithm)
+	}
+	return secrets.NewSymmetricCrypter(key), nil
+}
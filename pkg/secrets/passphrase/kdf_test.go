@@ -0,0 +1,106 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passphrase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKDF(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected KDF
+		err      string
+	}{
+		{input: "pbkdf2", expected: DefaultKDF},
+		{input: "pbkdf2,i=10", expected: KDF{Algorithm: "pbkdf2", Iterations: 10}},
+		{input: "scrypt", expected: KDF{Algorithm: "scrypt", Cost: 32768, BlockSize: 8, Parallelism: 1}},
+		{input: "scrypt,n=1024,p=2", expected: KDF{Algorithm: "scrypt", Cost: 1024, BlockSize: 8, Parallelism: 2}},
+		{input: "argon2id,t=1,m=1024", expected: KDF{Algorithm: "argon2id", Iterations: 1, Cost: 1024, Parallelism: 4}},
+		{input: "bcrypt", err: "unknown key derivation function"},
+		{input: "scrypt,n=1000", err: "must be a power of two"},
+		{input: "scrypt,i=10", err: "unknown scrypt parameter"},
+		{input: "argon2id,t=0", err: "must be a positive integer"},
+		{input: "argon2id,t", err: "malformed argon2id parameter"},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.input, func(t *testing.T) {
+			t.Parallel()
+
+			kdf, err := ParseKDF(c.input)
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, kdf)
+
+			roundTripped, err := ParseKDF(kdf.String())
+			require.NoError(t, err)
+			assert.Equal(t, kdf, roundTripped)
+		})
+	}
+}
+
+//nolint:paralleltest // clears the secrets manager cache
+func TestPassphraseManagerKDFs(t *testing.T) {
+	resetEnv := resetPassphraseTestEnvVars()
+	defer resetEnv()
+
+	ctx := context.Background()
+	for _, s := range []string{"pbkdf2,i=1000", "scrypt,n=1024,r=8,p=1", "argon2id,t=1,m=1024,p=1"} {
+		kdf, err := ParseKDF(s)
+		require.NoError(t, err)
+
+		state, sm, err := NewPassphraseSecretsManagerWithKDF("password", kdf)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(state, "v2:"+s+":"), state)
+
+		enc, err := sm.Encrypter()
+		require.NoError(t, err)
+		ct, err := enc.EncryptValue(ctx, "secret")
+		require.NoError(t, err)
+
+		// Construct a new manager from the state, as another process would.
+		clearCachedSecretsManagers()
+		sm, err = GetPassphraseSecretsManager("password", state)
+		require.NoError(t, err)
+		dec, err := sm.Decrypter()
+		require.NoError(t, err)
+		pt, err := dec.DecryptValue(ctx, ct)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", pt)
+
+		clearCachedSecretsManagers()
+		_, err = GetPassphraseSecretsManager("wrong", state)
+		assert.ErrorIs(t, err, ErrIncorrectPassphrase)
+	}
+}
+
+func TestPassphraseManagerDefaultKDFUsesV1State(t *testing.T) {
+	t.Parallel()
+
+	state, _, err := NewPassphraseSecretsManager("password")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(state, "v1:"), state)
+}
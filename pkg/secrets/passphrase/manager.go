@@ -41,28 +41,49 @@ const Type = "passphrase"
 var ErrIncorrectPassphrase = errors.New("incorrect passphrase")
 
 // given a passphrase and an encryption state, construct a Crypter from it. Our encryption
-// state value is a version tag followed by version specific state information. Presently, we only have one version
-// we support (`v1`) which is AES-256-GCM using a key derived from a passphrase using 1,000,000 iterations of PDKDF2
-// using SHA256.
+// state value is a version tag followed by version specific state information. We support two versions, both of
+// which use AES-256-GCM:
+//
+//   - `v1:<salt>:<message>` uses a key derived from a passphrase using 1,000,000 iterations of PDKDF2 using SHA256.
+//   - `v2:<kdf>:<salt>:<message>` uses a key derived from a passphrase using the given KDF (see ParseKDF).
 func symmetricCrypterFromPhraseAndState(phrase string, state string) (config.Crypter, error) {
-	splits := strings.SplitN(state, ":", 3)
-	if len(splits) != 3 {
-		return nil, errors.New("malformed state value")
+	version, _, _ := strings.Cut(state, ":")
+	var kdf KDF
+	var saltIndex int
+	switch version {
+	case "v1":
+		kdf, saltIndex = DefaultKDF, 1
+	case "v2":
+		splits := strings.SplitN(state, ":", 3)
+		if len(splits) != 3 {
+			return nil, errors.New("malformed state value")
+		}
+		var err error
+		if kdf, err = ParseKDF(splits[1]); err != nil {
+			return nil, fmt.Errorf("malformed state value: %w", err)
+		}
+		saltIndex = 2
+	default:
+		return nil, errors.New("unknown state version")
 	}
 
-	if splits[0] != "v1" {
-		return nil, errors.New("unknown state version")
+	splits := strings.SplitN(state, ":", saltIndex+2)
+	if len(splits) != saltIndex+2 {
+		return nil, errors.New("malformed state value")
 	}
 
-	salt, err := base64.StdEncoding.DecodeString(splits[1])
+	salt, err := base64.StdEncoding.DecodeString(splits[saltIndex])
 	if err != nil {
 		return nil, err
 	}
 
-	decrypter := config.NewSymmetricCrypterFromPassphrase(phrase, salt)
+	decrypter, err := kdf.crypter(phrase, salt)
+	if err != nil {
+		return nil, err
+	}
 	// symmetricCrypter does not use ctx, safe to pass context.Background()
 	ignoredCtx := context.Background()
-	decrypted, err := decrypter.DecryptValue(ignoredCtx, state[indexN(state, ":", 2)+1:])
+	decrypted, err := decrypter.DecryptValue(ignoredCtx, state[indexN(state, ":", saltIndex+1)+1:])
 	if err != nil || decrypted != "pulumi" {
 		return nil, ErrIncorrectPassphrase
 	}
@@ -115,6 +136,15 @@ func (sm *localSecretsManager) Encrypter() (config.Encrypter, error) {
 	return sm.crypter, nil
 }
 
+// Validate returns an error if the manager is locked because the passphrase was incorrect.
+func (sm *localSecretsManager) Validate(ctx context.Context) error {
+	if _, locked := sm.crypter.(*errorCrypter); locked {
+		return errors.New("incorrect passphrase, please set PULUMI_CONFIG_PASSPHRASE to the " +
+			"correct passphrase or set PULUMI_CONFIG_PASSPHRASE_FILE to a file containing the passphrase")
+	}
+	return nil
+}
+
 func EditProjectStack(info *workspace.ProjectStack, state json.RawMessage) error {
 	info.EncryptedKey = ""
 	info.SecretsProvider = ""
@@ -159,13 +189,25 @@ func setCachedSecretsManager(state string, sm secrets.Manager) {
 }
 
 func NewPassphraseSecretsManager(phrase string) (string, secrets.Manager, error) {
+	return NewPassphraseSecretsManagerWithKDF(phrase, DefaultKDF)
+}
+
+// NewPassphraseSecretsManagerWithKDF returns a new passphrase-based secrets manager whose key is derived from phrase
+// using kdf, along with its state.
+func NewPassphraseSecretsManagerWithKDF(phrase string, kdf KDF) (string, secrets.Manager, error) {
 	// Produce a new salt.
 	salt := make([]byte, 8)
+	if kdf != DefaultKDF {
+		salt = make([]byte, 16)
+	}
 	_, err := cryptorand.Read(salt)
 	contract.AssertNoErrorf(err, "could not read from system random")
 
 	// Encrypt a message and store it with the salt so we can test if the password is correct later.
-	crypter := config.NewSymmetricCrypterFromPassphrase(phrase, salt)
+	crypter, err := kdf.crypter(phrase, salt)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// symmetricCrypter does not use ctx, safe to use context.Background()
 	ignoredCtx := context.Background()
@@ -174,6 +216,9 @@ func NewPassphraseSecretsManager(phrase string) (string, secrets.Manager, error)
 
 	// Encode the salt as the passphrase secrets manager state.
 	state := fmt.Sprintf("v1:%s:%s", base64.StdEncoding.EncodeToString(salt), msg)
+	if kdf != DefaultKDF {
+		state = fmt.Sprintf("v2:%s:%s:%s", kdf, base64.StdEncoding.EncodeToString(salt), msg)
+	}
 	jsonState, err := json.Marshal(localSecretsManagerState{
 		Salt: state,
 	})
@@ -217,8 +262,9 @@ func GetPassphraseSecretsManager(phrase string, state string) (secrets.Manager,
 
 // newPromptingPassphraseSecretsManagerFromState returns a new passphrase-based secrets manager, from the
 // given state. Will use the passphrase found in PULUMI_CONFIG_PASSPHRASE, the file specified by
-// PULUMI_CONFIG_PASSPHRASE_FILE, or otherwise will prompt for the passphrase if interactive.
-func newPromptingPassphraseSecretsManagerFromState(state string) (secrets.Manager, error) {
+// PULUMI_CONFIG_PASSPHRASE_FILE, the stack's passphrase file if phraseFile is not empty, or otherwise will prompt for
+// the passphrase if interactive.
+func newPromptingPassphraseSecretsManagerFromState(state string, phraseFile string) (secrets.Manager, error) {
 	// Check the cache first, if we have already seen this state before, return a cached value.
 	if cached, ok := getCachedSecretsManager(state); ok {
 		return cached, nil
@@ -228,7 +274,7 @@ func newPromptingPassphraseSecretsManagerFromState(state string) (secrets.Manage
 	const prompt = "Enter your passphrase to unlock config/secrets\n" +
 		"    (set PULUMI_CONFIG_PASSPHRASE or PULUMI_CONFIG_PASSPHRASE_FILE to remember)"
 	for {
-		phrase, interactive, phraseErr := readPassphrase(prompt, true /*useEnv*/)
+		phrase, interactive, phraseErr := readPassphrase(prompt, true /*useEnv*/, phraseFile)
 		if phraseErr != nil {
 			return nil, phraseErr
 		}
@@ -255,7 +301,7 @@ func NewPromptingPassphraseSecretsManagerFromState(state json.RawMessage) (secre
 		return nil, fmt.Errorf("unmarshalling state: %w", err)
 	}
 
-	sm, err := newPromptingPassphraseSecretsManagerFromState(s.Salt)
+	sm, err := newPromptingPassphraseSecretsManagerFromState(s.Salt, "" /*phraseFile*/)
 	switch {
 	case err == ErrIncorrectPassphrase:
 		return newLockedPasspharseSecretsManager(state), nil
@@ -280,11 +326,11 @@ func NewPromptingPassphraseSecretsManager(info *workspace.ProjectStack,
 
 	// If we have a salt, we can just use it.
 	if info.EncryptionSalt != "" {
-		return newPromptingPassphraseSecretsManagerFromState(info.EncryptionSalt)
+		return newPromptingPassphraseSecretsManagerFromState(info.EncryptionSalt, info.PassphraseFile)
 	}
 
 	// Otherwise, prompt the user for a new passphrase.
-	state, sm, err := promptForNewPassphrase(rotateSecretsProvider)
+	state, sm, err := promptForNewPassphrase(rotateSecretsProvider, info.PassphraseFile)
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +342,17 @@ func NewPromptingPassphraseSecretsManager(info *workspace.ProjectStack,
 	return sm, nil
 }
 
-// promptForNewPassphrase prompts for a new passphrase, and returns the state and the secrets manager.
-func promptForNewPassphrase(rotate bool) (string, secrets.Manager, error) {
+// promptForNewPassphrase prompts for a new passphrase, and returns the state and the secrets manager. The key is
+// derived from the passphrase using the KDF set by PULUMI_CONFIG_PASSPHRASE_KDF, if any.
+func promptForNewPassphrase(rotate bool, phraseFile string) (string, secrets.Manager, error) {
+	kdf := DefaultKDF
+	if s, ok := os.LookupEnv("PULUMI_CONFIG_PASSPHRASE_KDF"); ok && s != "" {
+		var err error
+		if kdf, err = ParseKDF(s); err != nil {
+			return "", nil, fmt.Errorf("invalid PULUMI_CONFIG_PASSPHRASE_KDF: %w", err)
+		}
+	}
+
 	var phrase string
 
 	// Get a the passphrase from the user, ensuring that they match.
@@ -314,7 +369,7 @@ func promptForNewPassphrase(rotate bool) (string, secrets.Manager, error) {
 			}
 		}
 		// Here, the stack does not have an EncryptionSalt, so we will get a passphrase and create one
-		first, _, err := readPassphrase(firstMessage, !rotate)
+		first, _, err := readPassphrase(firstMessage, !rotate, phraseFile)
 		if err != nil {
 			return "", nil, err
 		}
@@ -322,7 +377,7 @@ func promptForNewPassphrase(rotate bool) (string, secrets.Manager, error) {
 		if rotate {
 			secondMessage = "Re-enter your new passphrase to confirm"
 		}
-		second, _, err := readPassphrase(secondMessage, !rotate)
+		second, _, err := readPassphrase(secondMessage, !rotate, phraseFile)
 		if err != nil {
 			return "", nil, err
 		}
@@ -335,7 +390,7 @@ func promptForNewPassphrase(rotate bool) (string, secrets.Manager, error) {
 		cmdutil.Diag().Errorf(diag.Message("", "passphrases do not match"))
 	}
 
-	state, sm, err := NewPassphraseSecretsManager(phrase)
+	state, sm, err := NewPassphraseSecretsManagerWithKDF(phrase, kdf)
 	if err != nil {
 		return "", nil, err
 	}
@@ -343,7 +398,9 @@ func promptForNewPassphrase(rotate bool) (string, secrets.Manager, error) {
 	return state, sm, err
 }
 
-func readPassphrase(prompt string, useEnv bool) (phrase string, interactive bool, err error) {
+// readPassphrase reads a passphrase from the environment, the stack's passphrase file at phraseFile if not empty, or
+// otherwise the console.
+func readPassphrase(prompt string, useEnv bool, phraseFile string) (phrase string, interactive bool, err error) {
 	if useEnv {
 		if phrase, ok := os.LookupEnv("PULUMI_CONFIG_PASSPHRASE"); ok {
 			return phrase, false, nil
@@ -359,6 +416,13 @@ func readPassphrase(prompt string, useEnv bool) (phrase string, interactive bool
 			}
 			return strings.TrimSpace(string(phraseDetails)), false, nil
 		}
+		if phraseFile != "" {
+			phraseDetails, err := os.ReadFile(phraseFile)
+			if err != nil {
+				return "", false, fmt.Errorf("unable to read the stack's passphrase file: %w", err)
+			}
+			return strings.TrimSpace(string(phraseDetails)), false, nil
+		}
 		if !isInteractive() {
 			return "", false, errors.New("passphrase must be set with PULUMI_CONFIG_PASSPHRASE or " +
 				"PULUMI_CONFIG_PASSPHRASE_FILE environment variables")
@@ -1,12 +1,17 @@
 package passphrase
 
 import (
+	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 const (
@@ -45,6 +50,7 @@ func TestPassphraseManagerIncorrectPassphraseReturnsErrorCrypter(t *testing.T) {
 		state:   state,
 		crypter: &errorCrypter{},
 	})
+	assert.ErrorContains(t, manager.(secrets.Validator).Validate(context.Background()), "incorrect passphrase")
 }
 
 //nolint:paralleltest // mutates environment variables
@@ -129,3 +135,29 @@ func TestPassphraseManagerEmptyPassfileReturnsError(t *testing.T) {
 	assert.ErrorContains(t, err, "passphrase must be set with "+
 		"PULUMI_CONFIG_PASSPHRASE or PULUMI_CONFIG_PASSPHRASE_FILE environment variables")
 }
+
+//nolint:paralleltest // mutates environment variables
+func TestPassphraseManagerStackPassphraseFile(t *testing.T) {
+	resetEnv := resetPassphraseTestEnvVars()
+	defer resetEnv()
+
+	os.Unsetenv("PULUMI_CONFIG_PASSPHRASE")
+	os.Unsetenv("PULUMI_CONFIG_PASSPHRASE_FILE")
+
+	path := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(path, []byte("password\n"), 0o600))
+
+	info := &workspace.ProjectStack{
+		EncryptionSalt: "v1:fozI5u6B030=:v1:F+6ZduKKd8G0/V7L:PGMFeIzwobWRKmEAzUdaQHqC5mMRIQ==",
+		PassphraseFile: path,
+	}
+	sm, err := NewPromptingPassphraseSecretsManager(info, false /*rotateSecretsProvider*/)
+	require.NoError(t, err)
+	assert.NotNil(t, sm)
+
+	// The environment takes precedence over the stack's passphrase file.
+	clearCachedSecretsManagers()
+	os.Setenv("PULUMI_CONFIG_PASSPHRASE", "password123")
+	_, err = NewPromptingPassphraseSecretsManager(info, false /*rotateSecretsProvider*/)
+	assert.ErrorIs(t, err, ErrIncorrectPassphrase)
+}
@@ -122,6 +122,20 @@ func (sm *serviceSecretsManager) Encrypter() (config.Encrypter, error) {
 	return sm.crypter, nil
 }
 
+// Validate checks that the service will encrypt and decrypt secrets for the stack, e.g. that the access token in use
+// isn't read-only, by round-tripping a probe value.
+func (sm *serviceSecretsManager) Validate(ctx context.Context) error {
+	ciphertext, err := sm.crypter.EncryptValue(ctx, "pulumi")
+	if err != nil {
+		return fmt.Errorf("the Pulumi Cloud can't encrypt secrets for this stack; "+
+			"check that your access token is allowed to update it: %w", err)
+	}
+	if _, err := sm.crypter.DecryptValue(ctx, ciphertext); err != nil {
+		return fmt.Errorf("the Pulumi Cloud can't decrypt secrets for this stack: %w", err)
+	}
+	return nil
+}
+
 func NewServiceSecretsManager(
 	client *client.Client, id client.StackIdentifier, info *workspace.ProjectStack,
 ) (secrets.Manager, error) {
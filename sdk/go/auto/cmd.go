@@ -17,15 +17,53 @@ package auto
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"sort"
+	"time"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 )
 
 const unknownErrorCode = -2
 
+// defaultCancelGracePeriod is how long a cancelled command is given to exit after being interrupted before it is
+// killed.
+const defaultCancelGracePeriod = 30 * time.Second
+
+// cancelledError is returned when a command fails after its context was cancelled. It unwraps to the context's
+// error, so callers can test for it with errors.Is(err, context.Canceled).
+type cancelledError struct {
+	ctxErr error
+	err    error
+	// killed is true if the command did not exit within its grace period and had to be killed.
+	killed bool
+}
+
+func (e *cancelledError) Error() string {
+	if e.killed {
+		return fmt.Sprintf("%v: command did not exit within the cancellation grace period and was killed: %v",
+			e.ctxErr, e.err)
+	}
+	return fmt.Sprintf("%v: %v", e.ctxErr, e.err)
+}
+
+func (e *cancelledError) Unwrap() error {
+	return e.ctxErr
+}
+
+// isCancelTimeout returns true if err indicates that a cancelled command had to be killed.
+func isCancelTimeout(err error) bool {
+	var cancelled *cancelledError
+	return errors.As(err, &cancelled) && cancelled.killed
+}
+
+// runPulumiCommandSync runs a pulumi command to completion. If ctx is cancelled while the command is running, the
+// command is interrupted so that the CLI can cancel the current operation gracefully. If it has not exited after
+// gracePeriod, it is killed.
 func runPulumiCommandSync(
 	ctx context.Context,
 	workdir string,
@@ -33,14 +71,15 @@ func runPulumiCommandSync(
 	additionalOutput []io.Writer,
 	additionalErrorOutput []io.Writer,
 	additionalEnv []string,
+	gracePeriod time.Duration,
 	args ...string,
 ) (string, string, int, error) {
 	// all commands should be run in non-interactive mode.
 	// this causes commands to fail rather than prompting for input (and thus hanging indefinitely)
 	args = withNonInteractiveArg(args)
-	cmd := exec.CommandContext(ctx, "pulumi", args...)
+	cmd := exec.Command("pulumi", args...)
 	cmd.Dir = workdir
-	cmd.Env = append(os.Environ(), additionalEnv...)
+	cmd.Env = append(append(os.Environ(), additionalEnv...), operationEnv(ctx)...)
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -51,16 +90,92 @@ func runPulumiCommandSync(
 	cmd.Stdin = stdin
 
 	code := unknownErrorCode
-	err := cmd.Run()
+	if err := ctx.Err(); err != nil {
+		return "", "", code, err
+	}
+	if err := cmd.Start(); err != nil {
+		return "", "", code, err
+	}
+
+	exited, killed := make(chan struct{}), make(chan struct{})
+	go func() {
+		select {
+		case <-exited:
+			return
+		case <-ctx.Done():
+		}
+
+		// The CLI treats the first interrupt as a request to cancel the current operation, which lets the engine
+		// finish any in-flight resource operations and write out the checkpoint. Interrupts are not supported on
+		// Windows, so fall back to killing the process there.
+		if err := cmd.Process.Signal(os.Interrupt); err == nil {
+			timer := time.NewTimer(gracePeriod)
+			defer timer.Stop()
+			select {
+			case <-exited:
+				return
+			case <-timer.C:
+			}
+		}
+
+		close(killed)
+		//nolint:errcheck
+		cmd.Process.Kill()
+	}()
+
+	err := cmd.Wait()
+	close(exited)
 	if exitError, ok := err.(*exec.ExitError); ok {
 		code = exitError.ExitCode()
 	} else if err == nil {
 		// If there was no error then the exit code was 0
 		code = 0
 	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil && err != nil {
+		cancelled := &cancelledError{ctxErr: ctxErr, err: err}
+		select {
+		case <-killed:
+			cancelled.killed = true
+		default:
+		}
+		err = cancelled
+	}
 	return stdout.String(), stderr.String(), code, err
 }
 
+type operationEnvKey struct{}
+
+// withOperationEnv returns a context that adds env to the environment of every pulumi command run with it, overriding
+// the environment of the workspace. Using the context rather than passing env explicitly means that the commands an
+// operation runs on its own behalf, such as fetching the stack's outputs after an update, see the same environment.
+func withOperationEnv(ctx context.Context, env map[string]string) context.Context {
+	if len(env) == 0 {
+		return ctx
+	}
+	merged := make(map[string]string)
+	if outer, ok := ctx.Value(operationEnvKey{}).(map[string]string); ok {
+		for k, v := range outer {
+			merged[k] = v
+		}
+	}
+	for k, v := range env {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, operationEnvKey{}, merged)
+}
+
+// operationEnv returns the environment added to ctx by withOperationEnv, as sorted KEY=value pairs.
+func operationEnv(ctx context.Context) []string {
+	env, _ := ctx.Value(operationEnvKey{}).(map[string]string)
+	out := make([]string, 0, len(env))
+	for k, v := range env {
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
+
 func withNonInteractiveArg(args []string) []string {
 	out := slice.Prealloc[string](len(args))
 	seen := false
@@ -0,0 +1,102 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePulumi puts a shell script named pulumi on the PATH.
+func fakePulumi(t *testing.T, script string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake pulumi scripts are not supported on Windows")
+	}
+	dir := t.TempDir()
+	//nolint:gosec // the script needs to be executable
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pulumi"), []byte("#!/bin/sh\n"+script), 0o700))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+//nolint:paralleltest // modifies PATH
+func TestRunPulumiCommandSyncInterruptsOnCancel(t *testing.T) {
+	fakePulumi(t, `trap 'echo cancelled; exit 3' INT
+echo started
+while true; do sleep 0.1; done
+`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(500*time.Millisecond, cancel)
+
+	stdout, _, code, err := runPulumiCommandSync(ctx, t.TempDir(), nil, nil, nil, nil, time.Minute, "up")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, isCancelTimeout(err))
+	assert.Equal(t, 3, code)
+	assert.Equal(t, "started\ncancelled\n", stdout)
+}
+
+//nolint:paralleltest // modifies PATH
+func TestRunPulumiCommandSyncKillsAfterGracePeriod(t *testing.T) {
+	fakePulumi(t, `trap '' INT
+echo started
+exec sleep 60
+`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(500*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, _, _, err := runPulumiCommandSync(ctx, t.TempDir(), nil, nil, nil, nil, 500*time.Millisecond, "up")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, isCancelTimeout(err))
+	assert.Less(t, time.Since(start), 30*time.Second)
+}
+
+//nolint:paralleltest // modifies PATH
+func TestRunPulumiCommandSyncCancelledBeforeStart(t *testing.T) {
+	fakePulumi(t, "echo started\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stdout, _, _, err := runPulumiCommandSync(ctx, t.TempDir(), nil, nil, nil, nil, time.Minute, "up")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, stdout)
+}
+
+//nolint:paralleltest // modifies PATH
+func TestRunPulumiCommandSyncOperationEnv(t *testing.T) {
+	fakePulumi(t, `echo "$TENANT $TOKEN $REGION"
+`)
+
+	ctx := withOperationEnv(context.Background(), map[string]string{"TENANT": "a", "TOKEN": "secret-a"})
+	ctx = withOperationEnv(ctx, map[string]string{"TOKEN": "secret-b"})
+
+	env := []string{"TOKEN=workspace", "REGION=us-west-2"}
+	stdout, _, _, err := runPulumiCommandSync(ctx, t.TempDir(), nil, nil, nil, env, time.Minute, "up")
+	require.NoError(t, err)
+	assert.Equal(t, "a secret-b us-west-2\n", stdout)
+
+	stdout, _, _, err = runPulumiCommandSync(context.Background(), t.TempDir(), nil, nil, nil, env, time.Minute, "up")
+	require.NoError(t, err)
+	assert.Equal(t, " workspace us-west-2\n", stdout)
+}
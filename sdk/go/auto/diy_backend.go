@@ -0,0 +1,162 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DIYBackend describes a DIY backend, which stores state in a local directory or a cloud storage bucket rather than
+// in Pulumi Cloud. Use WithDIYBackend to configure a LocalWorkspace to use it.
+// https://www.pulumi.com/docs/concepts/state/#using-a-diy-backend
+type DIYBackend struct {
+	// URL is the URL of the backend, e.g. "file:///var/state", "s3://my-bucket", "azblob://my-container", or
+	// "gs://my-bucket".
+	URL string
+	// Passphrase is the passphrase used by the passphrase secrets provider to encrypt the stacks' secrets.
+	Passphrase string
+}
+
+// diyBackendSchemes are the URL schemes supported by DIY backends.
+var diyBackendSchemes = []string{"file://", "s3://", "azblob://", "gs://"}
+
+type diyBackendOptions struct {
+	passphrase string
+	skipCheck  bool
+}
+
+// DIYBackendOption configures NewDIYBackend.
+type DIYBackendOption func(*diyBackendOptions)
+
+// DIYPassphrase sets the passphrase used to encrypt the backend's secrets. If none is set, a random passphrase is
+// generated.
+func DIYPassphrase(passphrase string) DIYBackendOption {
+	return func(opts *diyBackendOptions) {
+		opts.passphrase = passphrase
+	}
+}
+
+// SkipDIYBackendCheck skips checking that the backend can be logged into when it is created.
+func SkipDIYBackendCheck() DIYBackendOption {
+	return func(opts *diyBackendOptions) {
+		opts.skipCheck = true
+	}
+}
+
+// NewDIYBackend prepares a DIY backend at the given URL, which may also be a local directory path. Local directories
+// are created if they don't exist. Unless SkipDIYBackendCheck is given, the Pulumi CLI then logs into the backend
+// with a temporary PULUMI_HOME, which fails if the bucket doesn't exist or the credentials for it are missing. This
+// doesn't change the backend that the CLI is logged into.
+//
+// Creating a backend in a fresh directory for each test gives every test its own isolated state:
+//
+//	backend, err := auto.NewDIYBackend(ctx, t.TempDir())
+//	...
+//	stack, err := auto.UpsertStackInlineSource(ctx, "dev", "project", program, auto.WithDIYBackend(backend))
+func NewDIYBackend(ctx context.Context, url string, opts ...DIYBackendOption) (DIYBackend, error) {
+	var options diyBackendOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	url, err := diyBackendURL(url)
+	if err != nil {
+		return DIYBackend{}, err
+	}
+	if strings.HasPrefix(url, "file://") {
+		dir := filepath.FromSlash(strings.TrimPrefix(url, "file://"))
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return DIYBackend{}, fmt.Errorf("creating backend directory: %w", err)
+		}
+	}
+
+	passphrase := options.passphrase
+	if passphrase == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return DIYBackend{}, fmt.Errorf("generating passphrase: %w", err)
+		}
+		passphrase = hex.EncodeToString(b)
+	}
+
+	backend := DIYBackend{URL: url, Passphrase: passphrase}
+	if !options.skipCheck {
+		if err := backend.check(ctx); err != nil {
+			return DIYBackend{}, err
+		}
+	}
+	return backend, nil
+}
+
+// diyBackendURL validates a DIY backend URL, turning local paths into file:// URLs.
+func diyBackendURL(url string) (string, error) {
+	for _, scheme := range diyBackendSchemes {
+		if strings.HasPrefix(url, scheme) {
+			return url, nil
+		}
+	}
+	if strings.Contains(url, "://") {
+		return "", fmt.Errorf("unsupported DIY backend URL %q; expected a local path or one of: %s",
+			url, strings.Join(diyBackendSchemes, ", "))
+	}
+	if url == "" {
+		return "", errors.New("a DIY backend URL is required")
+	}
+	abs, err := filepath.Abs(url)
+	if err != nil {
+		return "", fmt.Errorf("resolving backend directory: %w", err)
+	}
+	return "file://" + filepath.ToSlash(abs), nil
+}
+
+// check logs into the backend with a temporary PULUMI_HOME.
+func (b DIYBackend) check(ctx context.Context) error {
+	home, err := os.MkdirTemp("", "automation-diy-backend-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(home)
+
+	env := []string{fmt.Sprintf("%s=%s", pulumiHomeEnv, home)}
+	stdout, stderr, code, err := runPulumiCommandSync(
+		ctx, home, nil, nil, nil, env, defaultCancelGracePeriod, "login", b.URL)
+	if err != nil {
+		return newAutoError(fmt.Errorf("failed to log into backend %s: %w", b.URL, err), stdout, stderr, code)
+	}
+	return nil
+}
+
+// EnvVars returns the environment variables that configure the Pulumi CLI to use the backend.
+func (b DIYBackend) EnvVars() map[string]string {
+	return map[string]string{
+		"PULUMI_BACKEND_URL":       b.URL,
+		"PULUMI_CONFIG_PASSPHRASE": b.Passphrase,
+	}
+}
+
+// WithDIYBackend configures the workspace to use the given DIY backend, regardless of which backend the Pulumi CLI
+// is logged into. Its environment variables take precedence over those set with EnvVars.
+func WithDIYBackend(backend DIYBackend) LocalWorkspaceOption {
+	return localWorkspaceOption(func(lo *localWorkspaceOptions) {
+		lo.DIYBackend = &backend
+	})
+}
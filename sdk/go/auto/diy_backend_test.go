@@ -0,0 +1,86 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDIYBackendURL(t *testing.T) {
+	t.Parallel()
+
+	for _, url := range []string{"file:///tmp/state", "s3://bucket/prefix", "azblob://container", "gs://bucket"} {
+		actual, err := diyBackendURL(url)
+		require.NoError(t, err)
+		assert.Equal(t, url, actual)
+	}
+
+	dir := t.TempDir()
+	actual, err := diyBackendURL(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.ToSlash(dir), actual)
+
+	_, err = diyBackendURL("https://api.pulumi.com")
+	assert.ErrorContains(t, err, `unsupported DIY backend URL "https://api.pulumi.com"`)
+	_, err = diyBackendURL("")
+	assert.ErrorContains(t, err, "a DIY backend URL is required")
+}
+
+//nolint:paralleltest // modifies PATH
+func TestNewDIYBackend(t *testing.T) {
+	log := filepath.Join(t.TempDir(), "commands.log")
+	fakePulumi(t, `echo "$PULUMI_HOME $@" >> `+log+`
+case "$2" in
+s3://missing) echo "error: unable to open bucket" >&2; exit 1 ;;
+esac
+`)
+	ctx := context.Background()
+
+	dir := filepath.Join(t.TempDir(), "state")
+	backend, err := NewDIYBackend(ctx, dir)
+	require.NoError(t, err)
+	assert.DirExists(t, dir)
+	assert.Equal(t, "file://"+filepath.ToSlash(dir), backend.URL)
+	assert.Len(t, backend.Passphrase, 64)
+	assert.Equal(t, map[string]string{
+		"PULUMI_BACKEND_URL":       backend.URL,
+		"PULUMI_CONFIG_PASSPHRASE": backend.Passphrase,
+	}, backend.EnvVars())
+
+	b, err := os.ReadFile(log)
+	require.NoError(t, err)
+	fields := strings.Fields(string(b))
+	require.Len(t, fields, 4)
+	assert.NotEqual(t, os.Getenv("PULUMI_HOME"), fields[0], "login should use a temporary PULUMI_HOME")
+	assert.NoDirExists(t, fields[0])
+	assert.Equal(t, []string{"login", backend.URL, "--non-interactive"}, fields[1:])
+
+	other, err := NewDIYBackend(ctx, "s3://bucket", DIYPassphrase("hunter2"))
+	require.NoError(t, err)
+	assert.Equal(t, DIYBackend{URL: "s3://bucket", Passphrase: "hunter2"}, other)
+
+	_, err = NewDIYBackend(ctx, "s3://missing")
+	assert.ErrorContains(t, err, "failed to log into backend s3://missing")
+
+	_, err = NewDIYBackend(ctx, "s3://missing", SkipDIYBackendCheck())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,189 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/debug"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optrefresh"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/constant"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// DriftReport describes the resources of a stack that have changed outside of Pulumi.
+type DriftReport struct {
+	StdOut string
+	StdErr string
+	// Resources lists the resources that have drifted, sorted by URN.
+	Resources []DriftedResource
+	// ChangeSummary counts the resources by the operation a refresh would perform on them.
+	ChangeSummary map[apitype.OpType]int
+}
+
+// HasDrift returns true if any resources have drifted.
+func (r DriftReport) HasDrift() bool {
+	return len(r.Resources) > 0
+}
+
+// DriftedResource is a resource whose actual state differs from the state recorded in the stack.
+type DriftedResource struct {
+	// URN is the URN of the resource.
+	URN string
+	// Type is the type token of the resource.
+	Type string
+	// Op is the operation a refresh would perform: apitype.OpUpdate if the resource has changed, or
+	// apitype.OpDelete if it no longer exists.
+	Op apitype.OpType
+	// Diff maps the paths of the outputs that have changed to the kind of change. It is empty for deleted
+	// resources.
+	Diff map[string]apitype.PropertyDiff
+	// Old is the state recorded in the stack.
+	Old map[string]interface{}
+	// New is the actual state of the resource. It is nil for deleted resources.
+	New map[string]interface{}
+}
+
+// DetectDrift reads the current state of the stack's resources from their providers and reports any that differ from
+// the state recorded in the stack. Unlike Refresh, it does not change the stack's state, so it is suitable for
+// scheduled drift detection. Only the Target, Exclude, Parallel, UserAgent, Color, DebugLogging, ProgressStreams,
+// ErrorProgressStreams, and EventStreams options apply.
+func (s *Stack) DetectDrift(ctx context.Context, opts ...optrefresh.Option) (DriftReport, error) {
+	var res DriftReport
+
+	refreshOpts := &optrefresh.Options{}
+	for _, o := range opts {
+		o.ApplyOption(refreshOpts)
+	}
+	ctx = withOperationEnv(ctx, refreshOpts.Env)
+
+	var args []string
+	args = debug.AddArgs(&refreshOpts.DebugLogOpts, args)
+	args = append(args, "refresh", "--preview-only")
+	args, err := addTargetArgs(refreshOpts.Target, refreshOpts.Exclude, nil, args)
+	if err != nil {
+		return res, err
+	}
+	if refreshOpts.Parallel > 0 {
+		args = append(args, fmt.Sprintf("--parallel=%d", refreshOpts.Parallel))
+	}
+	if refreshOpts.UserAgent != "" {
+		args = append(args, "--exec-agent="+refreshOpts.UserAgent)
+	}
+	if refreshOpts.Color != "" {
+		args = append(args, "--color="+refreshOpts.Color)
+	}
+	execKind := constant.ExecKindAutoLocal
+	if s.Workspace().Program() != nil {
+		execKind = constant.ExecKindAutoInline
+	}
+	args = append(args, "--exec-kind="+execKind)
+
+	var drift driftCollector
+	eventChannel := make(chan events.EngineEvent)
+	eventsDone := make(chan bool)
+	go func() {
+		for event := range eventChannel {
+			drift.collect(event)
+		}
+		close(eventsDone)
+	}()
+
+	eventChannels := []chan<- events.EngineEvent{eventChannel}
+	eventChannels = append(eventChannels, refreshOpts.EventStreams...)
+
+	t, err := tailLogs(ctx, "refresh", eventChannels)
+	if err != nil {
+		return res, fmt.Errorf("failed to tail logs: %w", err)
+	}
+	defer t.Close()
+	args = append(args, "--event-log", t.Filename)
+
+	stdout, stderr, code, err := s.runPulumiCmdSync(
+		ctx,
+		refreshOpts.ProgressStreams,      /* additionalOutputs */
+		refreshOpts.ErrorProgressStreams, /* additionalErrorOutputs */
+		args...,
+	)
+
+	// Close the file watcher wait for all events to send
+	t.Close()
+	<-eventsDone
+
+	if err != nil {
+		return res, newAutoError(fmt.Errorf("failed to detect drift: %w", err), stdout, stderr, code)
+	}
+
+	res = drift.report()
+	res.StdOut, res.StdErr = stdout, stderr
+	return res, nil
+}
+
+// driftCollector builds a DriftReport from the events of a refresh preview.
+type driftCollector struct {
+	resources map[string]DriftedResource
+	summary   map[apitype.OpType]int
+}
+
+func (c *driftCollector) collect(event events.EngineEvent) {
+	switch {
+	case event.ResOutputsEvent != nil:
+		m := event.ResOutputsEvent.Metadata
+		if m.Op != apitype.OpUpdate && m.Op != apitype.OpDelete {
+			return
+		}
+		r := DriftedResource{URN: m.URN, Type: m.Type, Op: m.Op}
+		if m.Old != nil {
+			r.Old = m.Old.Outputs
+		}
+		if m.Op == apitype.OpUpdate && m.New != nil {
+			r.New = m.New.Outputs
+			r.Diff = outputsDiff(r.Old, r.New)
+		}
+		if c.resources == nil {
+			c.resources = make(map[string]DriftedResource)
+		}
+		c.resources[r.URN] = r
+	case event.SummaryEvent != nil:
+		c.summary = event.SummaryEvent.ResourceChanges
+	}
+}
+
+func (c *driftCollector) report() DriftReport {
+	report := DriftReport{ChangeSummary: c.summary}
+	for _, r := range c.resources {
+		report.Resources = append(report.Resources, r)
+	}
+	sort.Slice(report.Resources, func(i, j int) bool {
+		return report.Resources[i].URN < report.Resources[j].URN
+	})
+	return report
+}
+
+// outputsDiff returns the detailed diff between two sets of outputs.
+func outputsDiff(oldOutputs, newOutputs map[string]interface{}) map[string]apitype.PropertyDiff {
+	diff := resource.NewPropertyMapFromMap(oldOutputs).Diff(resource.NewPropertyMapFromMap(newOutputs))
+	detailed := plugin.NewDetailedDiffFromObjectDiff(diff, false /*inputDiff*/)
+	out := make(map[string]apitype.PropertyDiff, len(detailed))
+	for path, d := range detailed {
+		out[path] = apitype.PropertyDiff{Kind: apitype.DiffKind(d.Kind.String())}
+	}
+	return out
+}
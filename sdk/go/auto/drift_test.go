@@ -0,0 +1,79 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+func TestDriftCollector(t *testing.T) {
+	t.Parallel()
+
+	resOutputs := func(op apitype.OpType, urn string, oldOutputs, newOutputs map[string]interface{}) events.EngineEvent {
+		m := apitype.StepEventMetadata{Op: op, URN: urn, Type: "aws:s3/bucket:Bucket"}
+		if oldOutputs != nil {
+			m.Old = &apitype.StepEventStateMetadata{Outputs: oldOutputs}
+		}
+		if newOutputs != nil {
+			m.New = &apitype.StepEventStateMetadata{Outputs: newOutputs}
+		}
+		return events.EngineEvent{EngineEvent: apitype.EngineEvent{
+			ResOutputsEvent: &apitype.ResOutputsEvent{Metadata: m, Planning: true},
+		}}
+	}
+
+	var c driftCollector
+	c.collect(resOutputs(apitype.OpSame, "urn:b", map[string]interface{}{"acl": "private"}, nil))
+	c.collect(resOutputs(apitype.OpUpdate, "urn:c",
+		map[string]interface{}{"acl": "private", "tags": map[string]interface{}{"env": "dev"}},
+		map[string]interface{}{"acl": "public-read", "tags": map[string]interface{}{"env": "dev", "team": "x"}}))
+	c.collect(resOutputs(apitype.OpDelete, "urn:a", map[string]interface{}{"acl": "private"}, nil))
+	c.collect(events.EngineEvent{EngineEvent: apitype.EngineEvent{
+		SummaryEvent: &apitype.SummaryEvent{ResourceChanges: map[apitype.OpType]int{
+			apitype.OpSame: 1, apitype.OpUpdate: 1, apitype.OpDelete: 1,
+		}},
+	}})
+
+	report := c.report()
+	assert.True(t, report.HasDrift())
+	assert.Equal(t, map[apitype.OpType]int{apitype.OpSame: 1, apitype.OpUpdate: 1, apitype.OpDelete: 1},
+		report.ChangeSummary)
+	assert.Equal(t, []DriftedResource{
+		{
+			URN:  "urn:a",
+			Type: "aws:s3/bucket:Bucket",
+			Op:   apitype.OpDelete,
+			Old:  map[string]interface{}{"acl": "private"},
+		},
+		{
+			URN:  "urn:c",
+			Type: "aws:s3/bucket:Bucket",
+			Op:   apitype.OpUpdate,
+			Diff: map[string]apitype.PropertyDiff{
+				"acl":       {Kind: apitype.DiffUpdate},
+				"tags.team": {Kind: apitype.DiffAdd},
+			},
+			Old: map[string]interface{}{"acl": "private", "tags": map[string]interface{}{"env": "dev"}},
+			New: map[string]interface{}{"acl": "public-read", "tags": map[string]interface{}{"env": "dev", "team": "x"}},
+		},
+	}, report.Resources)
+
+	assert.False(t, (&driftCollector{}).report().HasDrift())
+}
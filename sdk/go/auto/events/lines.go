@@ -0,0 +1,95 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+)
+
+// Line is a single line of output from an operation, tagged with the resource and step it concerns, if any.
+type Line struct {
+	Header
+	// Severity is one of "info", "info#err", "warning", or "error".
+	Severity string
+	// URN is the resource the line concerns, if any.
+	URN string
+	// Step is the operation being performed on the resource, if known.
+	Step apitype.OpType
+	// Text is the uncolored text of the line, without a trailing newline.
+	Text string
+}
+
+// LineConverter converts engine events to lines of output. It tracks the current step for each resource so that
+// diagnostics can be tagged with the step that produced them.
+type LineConverter struct {
+	steps map[string]apitype.OpType
+}
+
+// NewLineConverter creates a converter for the events of a single operation.
+func NewLineConverter() *LineConverter {
+	return &LineConverter{steps: map[string]apitype.OpType{}}
+}
+
+// Convert returns the lines of output for an event. Events that produce no output, such as the summary event, yield
+// no lines.
+func (c *LineConverter) Convert(e EngineEvent) []Line {
+	h := header(e)
+	switch {
+	case e.Error != nil:
+		return splitLines(h, "error", "", "", e.Error.Error())
+	case e.StdoutEvent != nil:
+		return splitLines(h, "info", "", "", e.StdoutEvent.Message)
+	case e.DiagnosticEvent != nil:
+		d := e.DiagnosticEvent
+		return splitLines(h, d.Severity, d.URN, c.steps[d.URN], d.Prefix+d.Message)
+	case e.PolicyEvent != nil:
+		p := e.PolicyEvent
+		severity := "warning"
+		if p.EnforcementLevel == "mandatory" {
+			severity = "error"
+		}
+		text := fmt.Sprintf("[%s@v%s] %s: %s", p.PolicyPackName, p.PolicyPackVersion, p.PolicyName, p.Message)
+		return splitLines(h, severity, p.ResourceURN, c.steps[p.ResourceURN], text)
+	case e.ResourcePreEvent != nil:
+		m := e.ResourcePreEvent.Metadata
+		c.steps[m.URN] = m.Op
+		return splitLines(h, "info", m.URN, m.Op, fmt.Sprintf("%s %s", m.Op, m.URN))
+	case e.ResOutputsEvent != nil:
+		m := e.ResOutputsEvent.Metadata
+		return splitLines(h, "info", m.URN, m.Op, fmt.Sprintf("%s %s complete", m.Op, m.URN))
+	case e.ResOpFailedEvent != nil:
+		m := e.ResOpFailedEvent.Metadata
+		return splitLines(h, "error", m.URN, m.Op, fmt.Sprintf("%s %s failed", m.Op, m.URN))
+	default:
+		return nil
+	}
+}
+
+func splitLines(h Header, severity, urn string, step apitype.OpType, text string) []Line {
+	text = strings.TrimRight(colors.Never.Colorize(text), "\n")
+	if text == "" {
+		return nil
+	}
+	parts := strings.Split(text, "\n")
+	lines := make([]Line, len(parts))
+	for i, part := range parts {
+		lines[i] = Line{Header: h, Severity: severity, URN: urn, Step: step, Text: strings.TrimRight(part, "\r")}
+	}
+	return lines
+}
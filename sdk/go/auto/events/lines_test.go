@@ -0,0 +1,87 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+func TestLineConverter(t *testing.T) {
+	t.Parallel()
+
+	const urn = "urn:pulumi:dev::proj::random:index/randomPet:RandomPet::pet"
+	c := NewLineConverter()
+
+	lines := c.Convert(EngineEvent{EngineEvent: apitype.EngineEvent{
+		Sequence:         1,
+		Timestamp:        100,
+		ResourcePreEvent: &apitype.ResourcePreEvent{Metadata: apitype.StepEventMetadata{Op: apitype.OpCreate, URN: urn}},
+	}})
+	assert.Equal(t, []Line{{
+		Header:   Header{Sequence: 1, Timestamp: time.Unix(100, 0)},
+		Severity: "info",
+		URN:      urn,
+		Step:     apitype.OpCreate,
+		Text:     "create " + urn,
+	}}, lines)
+
+	// Diagnostics are tagged with the step of the resource they concern and split into lines without colors.
+	lines = c.Convert(EngineEvent{EngineEvent: apitype.EngineEvent{
+		Sequence: 2,
+		DiagnosticEvent: &apitype.DiagnosticEvent{
+			URN:      urn,
+			Message:  "<{%fg 3%}>first<{%reset%}>\nsecond\n",
+			Severity: "warning",
+		},
+	}})
+	if assert.Len(t, lines, 2) {
+		assert.Equal(t, "first", lines[0].Text)
+		assert.Equal(t, "second", lines[1].Text)
+		for _, l := range lines {
+			assert.Equal(t, "warning", l.Severity)
+			assert.Equal(t, urn, l.URN)
+			assert.Equal(t, apitype.OpCreate, l.Step)
+			assert.Equal(t, 2, l.Sequence)
+		}
+	}
+
+	lines = c.Convert(EngineEvent{EngineEvent: apitype.EngineEvent{
+		PolicyEvent: &apitype.PolicyEvent{
+			ResourceURN:       urn,
+			Message:           "no pets",
+			PolicyName:        "no-pets",
+			PolicyPackName:    "pack",
+			PolicyPackVersion: "1.0.0",
+			EnforcementLevel:  "mandatory",
+		},
+	}})
+	if assert.Len(t, lines, 1) {
+		assert.Equal(t, "error", lines[0].Severity)
+		assert.Equal(t, "[pack@v1.0.0] no-pets: no pets", lines[0].Text)
+	}
+
+	lines = c.Convert(EngineEvent{Error: errors.New("bad event")})
+	if assert.Len(t, lines, 1) {
+		assert.Equal(t, Line{Header: Header{Timestamp: time.Unix(0, 0)}, Severity: "error", Text: "bad event"}, lines[0])
+	}
+
+	assert.Empty(t, c.Convert(EngineEvent{EngineEvent: apitype.EngineEvent{SummaryEvent: &apitype.SummaryEvent{}}}))
+}
@@ -0,0 +1,302 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// Header holds the fields common to all engine events.
+type Header struct {
+	// Sequence is the event's position in the total ordering of the operation's events.
+	Sequence int
+	// Timestamp is the time at which the event was emitted, to the second.
+	Timestamp time.Time
+}
+
+// ResourcePre is emitted before a step is applied to a resource.
+type ResourcePre struct {
+	Header
+	apitype.ResourcePreEvent
+}
+
+// ResOutputs is emitted after a step has been applied to a resource and its outputs are known.
+type ResOutputs struct {
+	Header
+	apitype.ResOutputsEvent
+}
+
+// ResOpFailed is emitted when a step fails.
+type ResOpFailed struct {
+	Header
+	apitype.ResOpFailedEvent
+}
+
+// Diagnostic is emitted for each diagnostic message, such as a warning or an error.
+type Diagnostic struct {
+	Header
+	apitype.DiagnosticEvent
+}
+
+// PolicyViolation is emitted when a resource violates a policy.
+type PolicyViolation struct {
+	Header
+	apitype.PolicyEvent
+}
+
+// Summary is emitted once the operation has finished.
+type Summary struct {
+	Header
+	apitype.SummaryEvent
+}
+
+// Predicate reports whether a watcher's handlers should receive an event.
+type Predicate func(EngineEvent) bool
+
+// ForURN matches events that concern the resource with the given URN.
+func ForURN(urn string) Predicate {
+	return func(e EngineEvent) bool {
+		return eventURN(e) == urn
+	}
+}
+
+// ForType matches step events for resources of the given type.
+func ForType(typ string) Predicate {
+	return func(e EngineEvent) bool {
+		m := stepMetadata(e)
+		return m != nil && m.Type == typ
+	}
+}
+
+// ForSeverity matches diagnostic events with one of the given severities, such as "warning" or "error".
+func ForSeverity(severities ...string) Predicate {
+	return func(e EngineEvent) bool {
+		if e.DiagnosticEvent == nil {
+			return false
+		}
+		for _, s := range severities {
+			if e.DiagnosticEvent.Severity == s {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ExcludePlanning matches all events except the step events emitted while planning a preview.
+func ExcludePlanning() Predicate {
+	return func(e EngineEvent) bool {
+		switch {
+		case e.ResourcePreEvent != nil:
+			return !e.ResourcePreEvent.Planning
+		case e.ResOutputsEvent != nil:
+			return !e.ResOutputsEvent.Planning
+		default:
+			return true
+		}
+	}
+}
+
+type watcherHandler struct {
+	where    []Predicate
+	dispatch func(EngineEvent)
+}
+
+type watcherState struct {
+	m        sync.Mutex
+	handlers []watcherHandler
+	ch       chan EngineEvent
+	done     chan struct{}
+}
+
+// Watcher dispatches engine events to handlers for specific event types. Pass the channel returned by Channel to an
+// operation's EventStreams option, register handlers with the On methods, and call Wait after the operation returns
+// to ensure that all events have been handled.
+//
+// Handlers are invoked sequentially, in registration order, from a single goroutine.
+type Watcher struct {
+	state *watcherState
+	where []Predicate
+}
+
+// NewWatcher creates a watcher with no handlers.
+func NewWatcher() *Watcher {
+	return &Watcher{state: &watcherState{}}
+}
+
+// Where returns a view of the watcher whose handlers only receive events that match all of the given predicates,
+// in addition to any predicates of w itself. Handlers registered on the view are dispatched by w.
+func (w *Watcher) Where(predicates ...Predicate) *Watcher {
+	where := append(append([]Predicate(nil), w.where...), predicates...)
+	return &Watcher{state: w.state, where: where}
+}
+
+func (w *Watcher) on(dispatch func(EngineEvent)) *Watcher {
+	w.state.m.Lock()
+	defer w.state.m.Unlock()
+	w.state.handlers = append(w.state.handlers, watcherHandler{where: w.where, dispatch: dispatch})
+	return w
+}
+
+// OnEvent registers a handler for all events, including errors reading the event stream.
+func (w *Watcher) OnEvent(fn func(EngineEvent)) *Watcher {
+	return w.on(fn)
+}
+
+// OnError registers a handler for errors reading the event stream.
+func (w *Watcher) OnError(fn func(error)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.Error != nil {
+			fn(e.Error)
+		}
+	})
+}
+
+// OnResourcePre registers a handler for ResourcePre events.
+func (w *Watcher) OnResourcePre(fn func(ResourcePre)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.ResourcePreEvent != nil {
+			fn(ResourcePre{header(e), *e.ResourcePreEvent})
+		}
+	})
+}
+
+// OnResOutputs registers a handler for ResOutputs events.
+func (w *Watcher) OnResOutputs(fn func(ResOutputs)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.ResOutputsEvent != nil {
+			fn(ResOutputs{header(e), *e.ResOutputsEvent})
+		}
+	})
+}
+
+// OnResOpFailed registers a handler for ResOpFailed events.
+func (w *Watcher) OnResOpFailed(fn func(ResOpFailed)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.ResOpFailedEvent != nil {
+			fn(ResOpFailed{header(e), *e.ResOpFailedEvent})
+		}
+	})
+}
+
+// OnDiagnostic registers a handler for Diagnostic events.
+func (w *Watcher) OnDiagnostic(fn func(Diagnostic)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.DiagnosticEvent != nil {
+			fn(Diagnostic{header(e), *e.DiagnosticEvent})
+		}
+	})
+}
+
+// OnPolicyViolation registers a handler for PolicyViolation events.
+func (w *Watcher) OnPolicyViolation(fn func(PolicyViolation)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.PolicyEvent != nil {
+			fn(PolicyViolation{header(e), *e.PolicyEvent})
+		}
+	})
+}
+
+// OnSummary registers a handler for the Summary event.
+func (w *Watcher) OnSummary(fn func(Summary)) *Watcher {
+	return w.on(func(e EngineEvent) {
+		if e.SummaryEvent != nil {
+			fn(Summary{header(e), *e.SummaryEvent})
+		}
+	})
+}
+
+// Channel returns the channel on which the watcher receives events, starting the watcher if necessary. The channel
+// is closed by the operation it is passed to, after which the watcher stops.
+func (w *Watcher) Channel() chan<- EngineEvent {
+	s := w.state
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.ch == nil {
+		s.ch, s.done = make(chan EngineEvent), make(chan struct{})
+		go s.run()
+	}
+	return s.ch
+}
+
+// Wait blocks until the watcher's channel has been closed and all received events have been handled. Wait returns
+// immediately if the watcher was never started.
+func (w *Watcher) Wait() {
+	s := w.state
+	s.m.Lock()
+	done := s.done
+	s.m.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+func (s *watcherState) run() {
+	defer close(s.done)
+	for e := range s.ch {
+		s.m.Lock()
+		handlers := s.handlers
+		s.m.Unlock()
+
+		for _, h := range handlers {
+			if matches(e, h.where) {
+				h.dispatch(e)
+			}
+		}
+	}
+}
+
+func matches(e EngineEvent, where []Predicate) bool {
+	for _, p := range where {
+		if !p(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func header(e EngineEvent) Header {
+	return Header{Sequence: e.Sequence, Timestamp: time.Unix(int64(e.Timestamp), 0)}
+}
+
+func stepMetadata(e EngineEvent) *apitype.StepEventMetadata {
+	switch {
+	case e.ResourcePreEvent != nil:
+		return &e.ResourcePreEvent.Metadata
+	case e.ResOutputsEvent != nil:
+		return &e.ResOutputsEvent.Metadata
+	case e.ResOpFailedEvent != nil:
+		return &e.ResOpFailedEvent.Metadata
+	default:
+		return nil
+	}
+}
+
+func eventURN(e EngineEvent) string {
+	if m := stepMetadata(e); m != nil {
+		return m.URN
+	}
+	switch {
+	case e.DiagnosticEvent != nil:
+		return e.DiagnosticEvent.URN
+	case e.PolicyEvent != nil:
+		return e.PolicyEvent.ResourceURN
+	default:
+		return ""
+	}
+}
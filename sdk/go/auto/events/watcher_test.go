@@ -0,0 +1,108 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+func TestWatcher(t *testing.T) {
+	t.Parallel()
+
+	const urnA = "urn:pulumi:stack::project::test:index:Res::a"
+	const urnB = "urn:pulumi:stack::project::test:index:Other::b"
+
+	var pres []ResourcePre
+	var outputs []string
+	var warnings []string
+	var forA []int
+	var violations []PolicyViolation
+	var summary *Summary
+	var errs []error
+
+	w := NewWatcher().
+		OnResourcePre(func(e ResourcePre) { pres = append(pres, e) }).
+		OnPolicyViolation(func(e PolicyViolation) { violations = append(violations, e) }).
+		OnSummary(func(e Summary) { summary = &e }).
+		OnError(func(err error) { errs = append(errs, err) })
+	w.Where(ForType("test:index:Res"), ExcludePlanning()).
+		OnResOutputs(func(e ResOutputs) { outputs = append(outputs, e.Metadata.URN) })
+	w.Where(ForSeverity("warning")).
+		OnDiagnostic(func(e Diagnostic) { warnings = append(warnings, e.Message) })
+	w.Where(ForURN(urnA)).
+		OnEvent(func(e EngineEvent) { forA = append(forA, e.Sequence) })
+
+	stream := []EngineEvent{
+		{EngineEvent: apitype.EngineEvent{Sequence: 1, Timestamp: 100, ResourcePreEvent: &apitype.ResourcePreEvent{
+			Metadata: apitype.StepEventMetadata{URN: urnA, Type: "test:index:Res", Op: apitype.OpCreate},
+		}}},
+		{EngineEvent: apitype.EngineEvent{Sequence: 2, ResOutputsEvent: &apitype.ResOutputsEvent{
+			Metadata: apitype.StepEventMetadata{URN: urnA, Type: "test:index:Res"},
+		}}},
+		{EngineEvent: apitype.EngineEvent{Sequence: 3, ResOutputsEvent: &apitype.ResOutputsEvent{
+			Metadata: apitype.StepEventMetadata{URN: urnB, Type: "test:index:Other"},
+		}}},
+		{EngineEvent: apitype.EngineEvent{Sequence: 4, ResOutputsEvent: &apitype.ResOutputsEvent{
+			Metadata: apitype.StepEventMetadata{URN: urnA, Type: "test:index:Res"},
+			Planning: true,
+		}}},
+		{EngineEvent: apitype.EngineEvent{Sequence: 5, DiagnosticEvent: &apitype.DiagnosticEvent{
+			URN: urnA, Message: "careful", Severity: "warning",
+		}}},
+		{EngineEvent: apitype.EngineEvent{Sequence: 6, DiagnosticEvent: &apitype.DiagnosticEvent{
+			Message: "fyi", Severity: "info",
+		}}},
+		{EngineEvent: apitype.EngineEvent{Sequence: 7, PolicyEvent: &apitype.PolicyEvent{
+			ResourceURN: urnB, PolicyName: "no-public-buckets", EnforcementLevel: "mandatory",
+		}}},
+		{Error: errors.New("bad line")},
+		{EngineEvent: apitype.EngineEvent{Sequence: 8, SummaryEvent: &apitype.SummaryEvent{DurationSeconds: 3}}},
+	}
+
+	ch := w.Channel()
+	assert.Equal(t, ch, w.Channel())
+	for _, e := range stream {
+		ch <- e
+	}
+	close(ch)
+	w.Wait()
+
+	assert.Len(t, pres, 1)
+	assert.Equal(t, 1, pres[0].Sequence)
+	assert.Equal(t, time.Unix(100, 0), pres[0].Timestamp)
+	assert.Equal(t, apitype.OpCreate, pres[0].Metadata.Op)
+	assert.Equal(t, []string{urnA}, outputs)
+	assert.Equal(t, []string{"careful"}, warnings)
+	assert.Equal(t, []int{1, 2, 4, 5}, forA)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "no-public-buckets", violations[0].PolicyName)
+	if assert.NotNil(t, summary) {
+		assert.Equal(t, 3, summary.DurationSeconds)
+	}
+	assert.Equal(t, []error{errors.New("bad line")}, errs)
+}
+
+func TestWatcherWaitWithoutChannel(t *testing.T) {
+	t.Parallel()
+
+	// Wait must not block if the watcher was never passed to an operation.
+	NewWatcher().Wait()
+}
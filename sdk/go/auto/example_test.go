@@ -24,6 +24,7 @@ import (
 	"os/exec"
 	"path/filepath"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optdestroy"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optpreview"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optrefresh"
@@ -989,6 +990,29 @@ func ExampleStack_Up_streamingProgress() {
 	stack.Up(ctx, optup.ProgressStreams(progressStreams...))
 }
 
+func ExampleStack_Up_watchingEvents() {
+	ctx := context.Background()
+	stackName := FullyQualifiedStackName("org", "project", "stack")
+	// create a new stack to update
+	stack, _ := NewStackLocalSource(ctx, stackName, filepath.Join(".", "program"))
+	// events.Watcher dispatches the structured event stream to typed handlers
+	watcher := events.NewWatcher().
+		OnResOutputs(func(e events.ResOutputs) {
+			fmt.Printf("%s %s\n", e.Metadata.Op, e.Metadata.URN)
+		}).
+		OnPolicyViolation(func(e events.PolicyViolation) {
+			fmt.Printf("policy %s violated by %s\n", e.PolicyName, e.ResourceURN)
+		})
+	// handlers registered on a filtered view only see matching events
+	watcher.Where(events.ForSeverity("warning", "error")).
+		OnDiagnostic(func(e events.Diagnostic) {
+			fmt.Println(e.Message)
+		})
+	stack.Up(ctx, optup.EventStreams(watcher.Channel()))
+	// wait for all events to be handled
+	watcher.Wait()
+}
+
 func ExampleStack_Preview() {
 	ctx := context.Background()
 	stackName := FullyQualifiedStackName("org", "project", "stack")
@@ -0,0 +1,148 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/opthistory"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// Keys of the UpdateSummary.Environment values that describe who or what initiated an update.
+const (
+	envExecKind       = "exec.kind"
+	envExecAgent      = "exec.agent"
+	envGitAuthor      = "git.author"
+	envGitAuthorEmail = "git.author.email"
+	envCISystem       = "ci.system"
+	envCIBuildURL     = "ci.build.url"
+)
+
+// UpdateInitiator describes who or what initiated an update, as recorded in the update's environment. Fields are
+// empty if the information wasn't available when the update ran.
+type UpdateInitiator struct {
+	// ExecKind is how the update was run: "cli", "auto.local", or "auto.inline".
+	ExecKind string
+	// ExecAgent is the user agent of the tool that ran the update, if it was run by automation.
+	ExecAgent string
+	// GitAuthor is the author of the commit the update was run from.
+	GitAuthor string
+	// GitAuthorEmail is the email address of the author of the commit the update was run from.
+	GitAuthorEmail string
+	// CISystem is the CI system that ran the update.
+	CISystem string
+	// CIBuildURL is the URL of the CI build that ran the update.
+	CIBuildURL string
+}
+
+// UpdateKind returns the kind of the update, e.g. apitype.UpdateUpdate for `pulumi up`.
+func (u UpdateSummary) UpdateKind() apitype.UpdateKind {
+	return apitype.UpdateKind(u.Kind)
+}
+
+// UpdateResult returns the result of the update.
+func (u UpdateSummary) UpdateResult() apitype.UpdateResult {
+	return apitype.UpdateResult(u.Result)
+}
+
+// Started returns the time the update started.
+func (u UpdateSummary) Started() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, u.StartTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing start time of update %d: %w", u.Version, err)
+	}
+	return t, nil
+}
+
+// Ended returns the time the update ended. The boolean is false if the update hasn't ended.
+func (u UpdateSummary) Ended() (time.Time, bool, error) {
+	if u.EndTime == nil {
+		return time.Time{}, false, nil
+	}
+	t, err := time.Parse(time.RFC3339, *u.EndTime)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("parsing end time of update %d: %w", u.Version, err)
+	}
+	return t, true, nil
+}
+
+// Changes returns the number of resources affected by each kind of operation. It is nil if the update hasn't
+// ended.
+func (u UpdateSummary) Changes() map[apitype.OpType]int {
+	if u.ResourceChanges == nil {
+		return nil
+	}
+	changes := make(map[apitype.OpType]int, len(*u.ResourceChanges))
+	for op, n := range *u.ResourceChanges {
+		changes[apitype.OpType(op)] = n
+	}
+	return changes
+}
+
+// Initiator returns who or what initiated the update.
+func (u UpdateSummary) Initiator() UpdateInitiator {
+	return UpdateInitiator{
+		ExecKind:       u.Environment[envExecKind],
+		ExecAgent:      u.Environment[envExecAgent],
+		GitAuthor:      u.Environment[envGitAuthor],
+		GitAuthorEmail: u.Environment[envGitAuthorEmail],
+		CISystem:       u.Environment[envCISystem],
+		CIBuildURL:     u.Environment[envCIBuildURL],
+	}
+}
+
+// GetUpdate returns the summary of the update with the given version.
+func (s *Stack) GetUpdate(ctx context.Context, version int, opts ...opthistory.Option) (UpdateSummary, error) {
+	if version < 1 {
+		return UpdateSummary{}, fmt.Errorf("invalid update version %d", version)
+	}
+
+	latest, err := s.History(ctx, 1 /*pageSize*/, 1 /*page*/, opts...)
+	if err != nil {
+		return UpdateSummary{}, err
+	}
+	if len(latest) == 0 || version > latest[0].Version {
+		return UpdateSummary{}, fmt.Errorf("update %d not found", version)
+	}
+
+	// History is ordered from newest to oldest and versions are normally consecutive, so the update can be fetched
+	// directly by its position.
+	page, err := s.History(ctx, 1 /*pageSize*/, latest[0].Version-version+1, opts...)
+	if err != nil {
+		return UpdateSummary{}, err
+	}
+	if len(page) == 1 && page[0].Version == version {
+		return page[0], nil
+	}
+
+	// Otherwise look through the whole history.
+	history, err := s.History(ctx, 0 /*pageSize*/, 0 /*page*/, opts...)
+	if err != nil {
+		return UpdateSummary{}, err
+	}
+	return findUpdate(history, version)
+}
+
+func findUpdate(history []UpdateSummary, version int) (UpdateSummary, error) {
+	for _, u := range history {
+		if u.Version == version {
+			return u, nil
+		}
+	}
+	return UpdateSummary{}, fmt.Errorf("update %d not found", version)
+}
@@ -0,0 +1,111 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+func TestUpdateSummaryAccessors(t *testing.T) {
+	t.Parallel()
+
+	end := "2024-01-10T12:05:30.000Z"
+	u := UpdateSummary{
+		Version:   7,
+		Kind:      "update",
+		StartTime: "2024-01-10T12:00:00.000Z",
+		Result:    "succeeded",
+		Environment: map[string]string{
+			"exec.kind":  "auto.inline",
+			"exec.agent": "my-controller",
+			"git.author": "Jane Doe",
+			"ci.system":  "GitHub",
+		},
+		EndTime:         &end,
+		ResourceChanges: &map[string]int{"create": 2, "same": 5},
+	}
+
+	assert.Equal(t, apitype.UpdateUpdate, u.UpdateKind())
+	assert.Equal(t, apitype.SucceededResult, u.UpdateResult())
+
+	started, err := u.Started()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC), started)
+
+	ended, ok, err := u.Ended()
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Minute+30*time.Second, ended.Sub(started))
+
+	assert.Equal(t, map[apitype.OpType]int{apitype.OpCreate: 2, apitype.OpSame: 5}, u.Changes())
+	assert.Equal(t, UpdateInitiator{
+		ExecKind:  "auto.inline",
+		ExecAgent: "my-controller",
+		GitAuthor: "Jane Doe",
+		CISystem:  "GitHub",
+	}, u.Initiator())
+
+	inProgress := UpdateSummary{Version: 8, StartTime: "not a time"}
+	_, ok, err = inProgress.Ended()
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, inProgress.Changes())
+	_, err = inProgress.Started()
+	assert.ErrorContains(t, err, "parsing start time of update 8")
+}
+
+//nolint:paralleltest // modifies PATH
+func TestGetUpdate(t *testing.T) {
+	// History with a gap in the versions, so that looking an update up by position doesn't always work.
+	fakePulumi(t, `page=""
+while [ $# -gt 0 ]; do
+	case "$1" in
+	--page) page=$2; shift ;;
+	esac
+	shift
+done
+case "$page" in
+1) echo '[{"version":4,"kind":"update"}]' ;;
+2) echo '[{"version":3,"kind":"refresh"}]' ;;
+3) echo '[{"version":1,"kind":"update"}]' ;;
+*) echo '[{"version":4,"kind":"update"},{"version":3,"kind":"refresh"},{"version":1,"kind":"update"}]' ;;
+esac
+`)
+
+	s := Stack{workspace: &LocalWorkspace{workDir: t.TempDir()}, stackName: "dev"}
+	ctx := context.Background()
+
+	u, err := s.GetUpdate(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", u.Kind)
+
+	u, err = s.GetUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, u.Version)
+
+	_, err = s.GetUpdate(ctx, 2)
+	assert.ErrorContains(t, err, "update 2 not found")
+	_, err = s.GetUpdate(ctx, 5)
+	assert.ErrorContains(t, err, "update 5 not found")
+	_, err = s.GetUpdate(ctx, 0)
+	assert.ErrorContains(t, err, "invalid update version 0")
+}
@@ -0,0 +1,156 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+)
+
+// lineStream sends the output of an operation to line receivers. Lines are produced from the engine events of the
+// operation, which are received on the channel returned by events, and from the CLI's own errors, which are written
+// to the stream as its stderr.
+type lineStream struct {
+	receivers []chan<- events.Line
+	converter *events.LineConverter
+	ch        chan events.EngineEvent
+	done      chan struct{}
+
+	m       sync.Mutex
+	started bool
+	partial []byte
+}
+
+// newLineStream creates a stream for the given receivers, or returns nil if there are none.
+func newLineStream(receivers []chan<- events.Line) *lineStream {
+	if len(receivers) == 0 {
+		return nil
+	}
+	return &lineStream{
+		receivers: receivers,
+		converter: events.NewLineConverter(),
+		ch:        make(chan events.EngineEvent),
+		done:      make(chan struct{}),
+	}
+}
+
+// eventStreams appends the stream's event channel to eventStreams. The result never aliases eventStreams.
+func (ls *lineStream) eventStreams(eventStreams []chan<- events.EngineEvent) []chan<- events.EngineEvent {
+	result := append([]chan<- events.EngineEvent(nil), eventStreams...)
+	if ls != nil {
+		result = append(result, ls.ch)
+	}
+	return result
+}
+
+// errorStreams appends the stream to errorStreams. The result never aliases errorStreams.
+func (ls *lineStream) errorStreams(errorStreams []io.Writer) []io.Writer {
+	result := append([]io.Writer(nil), errorStreams...)
+	if ls != nil {
+		result = append(result, ls)
+	}
+	return result
+}
+
+// start begins consuming engine events. It must be called once the event log is being watched, as the watcher closes
+// the event channel when it's done.
+func (ls *lineStream) start() {
+	if ls == nil {
+		return
+	}
+	ls.m.Lock()
+	ls.started = true
+	ls.m.Unlock()
+	go func() {
+		defer close(ls.done)
+		for e := range ls.ch {
+			lines := ls.converter.Convert(e)
+			ls.m.Lock()
+			ls.send(lines...)
+			ls.m.Unlock()
+		}
+	}()
+}
+
+// Write splits the CLI's stderr into lines.
+func (ls *lineStream) Write(p []byte) (int, error) {
+	ls.m.Lock()
+	defer ls.m.Unlock()
+
+	ls.partial = append(ls.partial, p...)
+	for {
+		i := bytes.IndexByte(ls.partial, '\n')
+		if i < 0 {
+			break
+		}
+		ls.sendStderr(string(ls.partial[:i]))
+		ls.partial = ls.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// Close waits for all engine events to be consumed, flushes any remaining stderr, and closes the receivers.
+func (ls *lineStream) Close() {
+	if ls == nil {
+		return
+	}
+	ls.m.Lock()
+	started := ls.started
+	ls.m.Unlock()
+	if started {
+		<-ls.done
+	}
+
+	ls.m.Lock()
+	defer ls.m.Unlock()
+	if len(ls.partial) > 0 {
+		ls.sendStderr(string(ls.partial))
+		ls.partial = nil
+	}
+	for _, r := range ls.receivers {
+		close(r)
+	}
+	ls.receivers = nil
+}
+
+func (ls *lineStream) sendStderr(text string) {
+	text = strings.TrimRight(colors.Never.Colorize(text), "\r")
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	severity := "error"
+	if strings.HasPrefix(text, "warning: ") {
+		severity = "warning"
+	}
+	ls.send(events.Line{
+		Header:   events.Header{Timestamp: time.Now().Truncate(time.Second)},
+		Severity: severity,
+		Text:     text,
+	})
+}
+
+func (ls *lineStream) send(lines ...events.Line) {
+	for _, l := range lines {
+		for _, r := range ls.receivers {
+			r <- l
+		}
+	}
+}
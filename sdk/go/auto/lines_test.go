@@ -0,0 +1,79 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+func TestLineStream(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newLineStream(nil))
+
+	ch := make(chan events.Line)
+	var got []events.Line
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		for l := range ch {
+			got = append(got, l)
+		}
+	}()
+
+	ls := newLineStream([]chan<- events.Line{ch})
+	eventStreams := ls.eventStreams(nil)
+	require.Len(t, eventStreams, 1)
+	errorStreams := ls.errorStreams(nil)
+	require.Len(t, errorStreams, 1)
+	ls.start()
+
+	eventStreams[0] <- events.EngineEvent{EngineEvent: apitype.EngineEvent{
+		DiagnosticEvent: &apitype.DiagnosticEvent{Message: "hello\n", Severity: "info"},
+	}}
+	close(eventStreams[0])
+
+	_, err := fmt.Fprint(errorStreams[0], "warning: careful\nerror: fail")
+	require.NoError(t, err)
+	ls.Close()
+	<-received
+
+	require.Len(t, got, 3)
+	assert.Equal(t, "info", got[0].Severity)
+	assert.Equal(t, "hello", got[0].Text)
+	assert.Equal(t, "warning", got[1].Severity)
+	assert.Equal(t, "warning: careful", got[1].Text)
+	// Trailing output without a newline is flushed on close.
+	assert.Equal(t, "error", got[2].Severity)
+	assert.Equal(t, "error: fail", got[2].Text)
+}
+
+func TestLineStreamNotStarted(t *testing.T) {
+	t.Parallel()
+
+	// Closing a stream whose events were never watched doesn't block, and still closes the receivers.
+	ch := make(chan events.Line, 1)
+	ls := newLineStream([]chan<- events.Line{ch})
+	ls.Close()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
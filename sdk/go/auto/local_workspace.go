@@ -23,8 +23,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/blang/semver"
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optremove"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
@@ -56,6 +59,13 @@ type LocalWorkspace struct {
 	remoteEnvVars                 map[string]EnvVarValue
 	preRunCommands                []string
 	remoteSkipInstallDependencies bool
+	cancelGracePeriod             time.Duration
+
+	// ensureLock serializes calls to EnsurePlugins and guards the fields below, which record what it has already
+	// installed.
+	ensureLock          sync.Mutex
+	ensuredDependencies bool
+	ensuredPlugins      map[string]bool
 }
 
 var settingsExtensions = []string{".yaml", ".yml", ".json"}
@@ -604,11 +614,7 @@ func (l *LocalWorkspace) ListStacks(ctx context.Context) ([]StackSummary, error)
 
 // InstallPlugin acquires the plugin matching the specified name and version.
 func (l *LocalWorkspace) InstallPlugin(ctx context.Context, name string, version string) error {
-	stdout, stderr, errCode, err := l.runPulumiCmdSync(ctx, "plugin", "install", "resource", name, version)
-	if err != nil {
-		return newAutoError(fmt.Errorf("failed to install plugin: %w", err), stdout, stderr, errCode)
-	}
-	return nil
+	return l.installPlugin(ctx, name, version, "")
 }
 
 // InstallPluginFromServer acquires the plugin matching the specified name and version from a third party server.
@@ -618,8 +624,20 @@ func (l *LocalWorkspace) InstallPluginFromServer(
 	version string,
 	server string,
 ) error {
-	stdout, stderr, errCode, err := l.runPulumiCmdSync(
-		ctx, "plugin", "install", "resource", name, version, "--server", server)
+	return l.installPlugin(ctx, name, version, server)
+}
+
+// installPlugin installs a resource plugin. If version is empty the latest version is installed, and if server is
+// empty the plugin is downloaded from its default location.
+func (l *LocalWorkspace) installPlugin(ctx context.Context, name, version, server string) error {
+	args := []string{"plugin", "install", "resource", name}
+	if version != "" {
+		args = append(args, version)
+	}
+	if server != "" {
+		args = append(args, "--server", server)
+	}
+	stdout, stderr, errCode, err := l.runPulumiCmdSync(ctx, args...)
 	if err != nil {
 		return newAutoError(fmt.Errorf("failed to install plugin: %w", err), stdout, stderr, errCode)
 	}
@@ -649,6 +667,102 @@ func (l *LocalWorkspace) ListPlugins(ctx context.Context) ([]workspace.PluginInf
 	return plugins, nil
 }
 
+// EnsurePlugins installs the language dependencies and plugins required by the program, along with any plugins in
+// the given manifest, so that they don't have to be installed by the first operation. This is useful in ephemeral
+// environments such as CI runners, where nothing is installed ahead of time.
+//
+// Dependencies and plugins required by the program are found with `pulumi install`, which inspects the program in the
+// workspace's WorkDir. Inline programs can't be inspected, so their plugins must be listed in the manifest. Plugins
+// that are already installed are skipped, and the remaining installs run concurrently. Results are cached by the
+// workspace, so calling EnsurePlugins again only installs what is still missing.
+func (l *LocalWorkspace) EnsurePlugins(ctx context.Context, manifest ...PluginRequirement) error {
+	l.ensureLock.Lock()
+	defer l.ensureLock.Unlock()
+
+	installed, err := l.ListPlugins(ctx)
+	if err != nil {
+		return err
+	}
+	have := make(map[string]bool)
+	for _, p := range installed {
+		if p.Kind != workspace.ResourcePlugin {
+			continue
+		}
+		have[pluginKey(p.Name, "")] = true
+		if p.Version != nil {
+			have[pluginKey(p.Name, p.Version.String())] = true
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var result error
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		result = multierror.Append(result, err)
+	}
+
+	installDependencies := l.program == nil && !l.remote && !l.ensuredDependencies
+	var dependenciesErr error
+	if installDependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stdout, stderr, errCode, err := l.runPulumiCmdSync(ctx, "install")
+			if err != nil {
+				dependenciesErr = newAutoError(
+					fmt.Errorf("failed to install dependencies: %w", err), stdout, stderr, errCode)
+				fail(dependenciesErr)
+			}
+		}()
+	}
+
+	if l.ensuredPlugins == nil {
+		l.ensuredPlugins = make(map[string]bool)
+	}
+	pending := make(map[string]PluginRequirement)
+	for _, p := range manifest {
+		key := pluginKey(p.Name, p.Version)
+		if have[key] || l.ensuredPlugins[key] {
+			continue
+		}
+		pending[key] = p
+	}
+	installedPlugins := make([]string, 0, len(pending))
+	for key, p := range pending {
+		key, p := key, p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.installPlugin(ctx, p.Name, p.Version, p.Server); err != nil {
+				fail(fmt.Errorf("plugin %s: %w", key, err))
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			installedPlugins = append(installedPlugins, key)
+		}()
+	}
+	wg.Wait()
+
+	if installDependencies && dependenciesErr == nil {
+		l.ensuredDependencies = true
+	}
+	for _, key := range installedPlugins {
+		l.ensuredPlugins[key] = true
+	}
+	return result
+}
+
+// pluginKey identifies a plugin requirement. An empty version matches any version of the plugin.
+func pluginKey(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + "@" + strings.TrimPrefix(version, "v")
+}
+
 // Program returns the program `pulumi.RunFunc` to be used for Preview/Update if any.
 // If none is specified, the stack will refer to ProjectSettings for this information.
 func (l *LocalWorkspace) Program() pulumi.RunFunc {
@@ -799,6 +913,7 @@ func (l *LocalWorkspace) runPulumiInputCmdSync(
 		nil, /* additionalOutputs */
 		nil, /* additionalErrorOutputs */
 		env,
+		l.getCancelGracePeriod(),
 		args...,
 	)
 }
@@ -810,6 +925,13 @@ func (l *LocalWorkspace) runPulumiCmdSync(
 	return l.runPulumiInputCmdSync(ctx, nil, args...)
 }
 
+func (l *LocalWorkspace) getCancelGracePeriod() time.Duration {
+	if l.cancelGracePeriod > 0 {
+		return l.cancelGracePeriod
+	}
+	return defaultCancelGracePeriod
+}
+
 // supportsPulumiCmdFlag runs a command with `--help` to see if the specified flag is found within the resulting
 // output, in which case we assume the flag is supported.
 func (l *LocalWorkspace) supportsPulumiCmdFlag(ctx context.Context, flag string, args ...string) (bool, error) {
@@ -819,7 +941,8 @@ func (l *LocalWorkspace) supportsPulumiCmdFlag(ctx context.Context, flag string,
 	}
 
 	// Run the command with `--help`, and then we'll look for the flag in the output.
-	stdout, _, _, err := runPulumiCommandSync(ctx, l.WorkDir(), nil, nil, nil, env, append(args, "--help")...)
+	stdout, _, _, err := runPulumiCommandSync(
+		ctx, l.WorkDir(), nil, nil, nil, env, l.getCancelGracePeriod(), append(args, "--help")...)
 	if err != nil {
 		return false, err
 	}
@@ -877,6 +1000,7 @@ func NewLocalWorkspace(ctx context.Context, opts ...LocalWorkspaceOption) (Works
 		remoteEnvVars:                 lwOpts.RemoteEnvVars,
 		remoteSkipInstallDependencies: lwOpts.RemoteSkipInstallDependencies,
 		repo:                          lwOpts.Repo,
+		cancelGracePeriod:             lwOpts.CancelGracePeriod,
 	}
 
 	// optOut indicates we should skip the version check.
@@ -938,6 +1062,11 @@ func NewLocalWorkspace(ctx context.Context, opts ...LocalWorkspaceOption) (Works
 			return nil, fmt.Errorf("failed to set environment values: %w", err)
 		}
 	}
+	if lwOpts.DIYBackend != nil {
+		if err := setEnvVars(l, lwOpts.DIYBackend.EnvVars()); err != nil {
+			return nil, fmt.Errorf("failed to set environment values: %w", err)
+		}
+	}
 
 	return l, nil
 }
@@ -978,6 +1107,10 @@ type localWorkspaceOptions struct {
 	PreRunCommands []string
 	// RemoteSkipInstallDependencies sets whether to skip the default dependency installation step
 	RemoteSkipInstallDependencies bool
+	// CancelGracePeriod is how long a command is given to exit after its context is cancelled.
+	CancelGracePeriod time.Duration
+	// DIYBackend is the DIY backend to use, if any.
+	DIYBackend *DIYBackend
 }
 
 // LocalWorkspaceOption is used to customize and configure a LocalWorkspace at initialization time.
@@ -1099,6 +1232,17 @@ func EnvVars(envvars map[string]string) LocalWorkspaceOption {
 	})
 }
 
+// CancelGracePeriod sets how long a command is given to finish after its context is cancelled. Cancelling the
+// context passed to an operation such as Stack.Up interrupts the Pulumi CLI, which cancels the operation gracefully:
+// in-flight resource operations are allowed to complete and the stack's state is saved. If the CLI has not exited
+// once the grace period has elapsed it is killed, and any update it left in progress is cancelled. Defaults to 30
+// seconds.
+func CancelGracePeriod(d time.Duration) LocalWorkspaceOption {
+	return localWorkspaceOption(func(lo *localWorkspaceOptions) {
+		lo.CancelGracePeriod = d
+	})
+}
+
 // remoteEnvVars is a map of environment values scoped to the workspace.
 // These values will be passed to the remote Pulumi operation.
 func remoteEnvVars(envvars map[string]EnvVarValue) LocalWorkspaceOption {
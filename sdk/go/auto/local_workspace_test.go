@@ -3012,3 +3012,62 @@ func collectEvents(eventChannel <-chan events.EngineEvent, events *[]events.Engi
 	})()
 	return &wg
 }
+
+//nolint:paralleltest // modifies PATH
+func TestEnsurePlugins(t *testing.T) {
+	log := filepath.Join(t.TempDir(), "commands.log")
+	fakePulumi(t, `case "$1 $2" in
+"plugin ls") echo '[{"name":"aws","kind":"resource","version":"6.0.0"}]' ;;
+*) echo "$@" >> `+log+` ;;
+esac
+`)
+
+	ws := &LocalWorkspace{workDir: t.TempDir()}
+	manifest := []PluginRequirement{
+		{Name: "aws", Version: "v6.0.0"},
+		{Name: "random", Version: "4.15.0"},
+		{Name: "acme", Version: "1.0.0", Server: "https://example.com/plugins"},
+	}
+	require.NoError(t, ws.EnsurePlugins(context.Background(), manifest...))
+
+	b, err := os.ReadFile(log)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"install --non-interactive",
+		"plugin install resource random 4.15.0 --non-interactive",
+		"plugin install resource acme 1.0.0 --server https://example.com/plugins --non-interactive",
+	}, strings.Split(strings.TrimSpace(string(b)), "\n"))
+
+	// A second call should not install anything again.
+	require.NoError(t, os.Remove(log))
+	require.NoError(t, ws.EnsurePlugins(context.Background(), manifest...))
+	_, err = os.Stat(log)
+	assert.True(t, os.IsNotExist(err))
+}
+
+//nolint:paralleltest // modifies PATH
+func TestEnsurePluginsInlineProgram(t *testing.T) {
+	log := filepath.Join(t.TempDir(), "commands.log")
+	fakePulumi(t, `case "$1 $2" in
+"plugin ls") echo '[]' ;;
+"plugin install") echo "$@" >> `+log+`; [ "$4" != "broken" ] ;;
+*) echo "$@" >> `+log+` ;;
+esac
+`)
+
+	ws := &LocalWorkspace{
+		workDir: t.TempDir(),
+		program: func(ctx *pulumi.Context) error { return nil },
+	}
+	err := ws.EnsurePlugins(context.Background(),
+		PluginRequirement{Name: "random"},
+		PluginRequirement{Name: "broken", Version: "1.0.0"})
+	assert.ErrorContains(t, err, "plugin broken@1.0.0")
+
+	b, err := os.ReadFile(log)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"plugin install resource random --non-interactive",
+		"plugin install resource broken 1.0.0 --non-interactive",
+	}, strings.Split(strings.TrimSpace(string(b)), "\n"))
+}
@@ -45,6 +45,14 @@ func Target(urns []string) Option {
 	})
 }
 
+// Exclude specifies a list of resource URNs to exclude from the destroy. Excluded resources are left as they are, and
+// URNs may contain wildcards (*, **).
+func Exclude(urns []string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Exclude = urns
+	})
+}
+
 // TargetDependents allows updating of dependent targets discovered but not specified in the Target list
 func TargetDependents() Option {
 	return optionFunc(func(opts *Options) {
@@ -73,6 +81,15 @@ func EventStreams(channels ...chan<- events.EngineEvent) Option {
 	})
 }
 
+// LineStreams allows specifying one or more channels to receive the output of the operation line by line, with each
+// line tagged with its severity and the resource and step it concerns. The channels are closed once the operation
+// completes.
+func LineStreams(channels ...chan<- events.Line) Option {
+	return optionFunc(func(opts *Options) {
+		opts.LineStreams = channels
+	})
+}
+
 // DebugLogging provides options for verbose logging to standard error, and enabling plugin logs.
 func DebugLogging(debugOpts debug.LoggingOptions) Option {
 	return optionFunc(func(opts *Options) {
@@ -94,6 +111,15 @@ func ShowSecrets(show bool) Option {
 	})
 }
 
+// Env specifies environment variables for the operation, such as credentials or PULUMI_* settings, overriding those
+// of the workspace. They are passed to the Pulumi CLI and the plugins it runs, but not to inline programs, which run
+// in the calling process. This lets a single workspace serve operations that need different environments.
+func Env(envvars map[string]string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Env = envvars
+	})
+}
+
 // Option is a parameter to be applied to a Stack.Destroy() operation
 type Option interface {
 	ApplyOption(*Options)
@@ -110,6 +136,8 @@ type Options struct {
 	Message string
 	// Specify an exclusive list of resource URNs to update
 	Target []string
+	// Specify a list of resource URNs to exclude from the destroy
+	Exclude []string
 	// Allows updating of dependent targets discovered but not specified in the Target list
 	TargetDependents bool
 	// ProgressStreams allows specifying one or more io.Writers to redirect incremental destroy stdout
@@ -118,6 +146,10 @@ type Options struct {
 	ErrorProgressStreams []io.Writer
 	// EventStreams allows specifying one or more channels to receive the Pulumi event stream
 	EventStreams []chan<- events.EngineEvent
+	// LineStreams allows specifying one or more channels to receive the output of the operation line by line
+	LineStreams []chan<- events.Line
+	// Env specifies environment variables for the operation, overriding those of the workspace
+	Env map[string]string
 	// DebugLogOpts specifies additional settings for debug logging
 	DebugLogOpts debug.LoggingOptions
 	// UserAgent specifies the agent responsible for the update, stored in backends as "environment.exec.agent"
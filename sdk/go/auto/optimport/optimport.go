@@ -0,0 +1,146 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package optimport contains functional options to be used with resource imports
+// github.com/sdk/v2/go/x/auto Stack.ImportResources(...optimport.Option)
+package optimport
+
+import (
+	"io"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/debug"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+)
+
+// Message (optional) to associate with the import operation
+func Message(message string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Message = message
+	})
+}
+
+// Parallel is the number of resource operations to run in parallel at once during the import
+// (1 for no parallelism). Defaults to unbounded. (default 2147483647)
+func Parallel(n int) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Parallel = n
+	})
+}
+
+// Protect sets whether imported resources are protected from deletion. Defaults to true.
+func Protect(protect bool) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Protect = &protect
+	})
+}
+
+// GenerateCode sets whether to generate resource declaration code for the imported resources. Defaults to true.
+func GenerateCode(generate bool) Option {
+	return optionFunc(func(opts *Options) {
+		opts.GenerateCode = &generate
+	})
+}
+
+// NameTable maps the variable names used in the generated code to the URNs of existing resources, such as the
+// parents and providers of the imported resources.
+func NameTable(names map[string]string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.NameTable = names
+	})
+}
+
+// ProgressStreams allows specifying one or more io.Writers to redirect incremental import stdout
+func ProgressStreams(writers ...io.Writer) Option {
+	return optionFunc(func(opts *Options) {
+		opts.ProgressStreams = writers
+	})
+}
+
+// ErrorProgressStreams allows specifying one or more io.Writers to redirect incremental import stderr
+func ErrorProgressStreams(writers ...io.Writer) Option {
+	return optionFunc(func(opts *Options) {
+		opts.ErrorProgressStreams = writers
+	})
+}
+
+// DebugLogging provides options for verbose logging to standard error, and enabling plugin logs.
+func DebugLogging(debugOpts debug.LoggingOptions) Option {
+	return optionFunc(func(opts *Options) {
+		opts.DebugLogOpts = debugOpts
+	})
+}
+
+// EventStreams allows specifying one or more channels to receive the Pulumi event stream
+func EventStreams(channels ...chan<- events.EngineEvent) Option {
+	return optionFunc(func(opts *Options) {
+		opts.EventStreams = channels
+	})
+}
+
+// UserAgent specifies the agent responsible for the import, stored in backends as "environment.exec.agent"
+func UserAgent(agent string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.UserAgent = agent
+	})
+}
+
+// Env specifies environment variables for the operation, such as credentials or PULUMI_* settings, overriding those
+// of the workspace. They are passed to the Pulumi CLI and the plugins it runs, but not to inline programs, which run
+// in the calling process. This lets a single workspace serve operations that need different environments.
+func Env(envvars map[string]string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Env = envvars
+	})
+}
+
+// Option is a parameter to be applied to a Stack.ImportResources() operation
+type Option interface {
+	ApplyOption(*Options)
+}
+
+// ---------------------------------- implementation details ----------------------------------
+
+// Options is an implementation detail
+type Options struct {
+	// Message (optional) to associate with the import operation
+	Message string
+	// Parallel is the number of resource operations to run in parallel at once
+	// (1 for no parallelism). Defaults to unbounded. (default 2147483647)
+	Parallel int
+	// Protect imported resources from deletion. Defaults to true.
+	Protect *bool
+	// Generate resource declaration code for the imported resources. Defaults to true.
+	GenerateCode *bool
+	// NameTable maps variable names in the generated code to the URNs of existing resources
+	NameTable map[string]string
+	// DebugLogOpts specifies additional settings for debug logging
+	DebugLogOpts debug.LoggingOptions
+	// ProgressStreams allows specifying one or more io.Writers to redirect incremental import stdout
+	ProgressStreams []io.Writer
+	// ErrorProgressStreams allows specifying one or more io.Writers to redirect incremental import stderr
+	ErrorProgressStreams []io.Writer
+	// EventStreams allows specifying one or more channels to receive the Pulumi event stream
+	EventStreams []chan<- events.EngineEvent
+	// Env specifies environment variables for the operation, overriding those of the workspace
+	Env map[string]string
+	// UserAgent specifies the agent responsible for the import, stored in backends as "environment.exec.agent"
+	UserAgent string
+}
+
+type optionFunc func(*Options)
+
+// ApplyOption is an implementation detail
+func (o optionFunc) ApplyOption(opts *Options) {
+	o(opts)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/debug"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/policy"
 )
 
 // Parallel is the number of resource operations to run in parallel at once during the update
@@ -66,6 +67,14 @@ func Target(urns []string) Option {
 	})
 }
 
+// Exclude specifies a list of resource URNs to exclude from the update. Excluded resources are left as they are, and
+// URNs may contain wildcards (*, **).
+func Exclude(urns []string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Exclude = urns
+	})
+}
+
 // TargetDependents allows updating of dependent targets discovered but not specified in the Target list
 func TargetDependents() Option {
 	return optionFunc(func(opts *Options) {
@@ -101,6 +110,15 @@ func EventStreams(channels ...chan<- events.EngineEvent) Option {
 	})
 }
 
+// LineStreams allows specifying one or more channels to receive the output of the operation line by line, with each
+// line tagged with its severity and the resource and step it concerns. The channels are closed once the operation
+// completes.
+func LineStreams(channels ...chan<- events.Line) Option {
+	return optionFunc(func(opts *Options) {
+		opts.LineStreams = channels
+	})
+}
+
 // UserAgent specifies the agent responsible for the update, stored in backends as "environment.exec.agent"
 func UserAgent(agent string) Option {
 	return optionFunc(func(opts *Options) {
@@ -115,6 +133,24 @@ func Plan(path string) Option {
 	})
 }
 
+// Env specifies environment variables for the operation, such as credentials or PULUMI_* settings, overriding those
+// of the workspace. They are passed to the Pulumi CLI and the plugins it runs, but not to inline programs, which run
+// in the calling process. This lets a single workspace serve operations that need different environments.
+func Env(envvars map[string]string) Option {
+	return optionFunc(func(opts *Options) {
+		opts.Env = envvars
+	})
+}
+
+// PolicyPacks specifies local policy packs to run as part of the preview, along with their configuration. Policy packs
+// required by the stack's organization in Pulumi Cloud are installed and enforced automatically. Violations from all
+// packs are returned in the result's PolicyViolations.
+func PolicyPacks(packs []policy.PackSpec) Option {
+	return optionFunc(func(opts *Options) {
+		opts.PolicyPackSpecs = packs
+	})
+}
+
 // Option is a parameter to be applied to a Stack.Preview() operation
 type Option interface {
 	ApplyOption(*Options)
@@ -137,6 +173,8 @@ type Options struct {
 	Replace []string
 	// Specify an exclusive list of resource URNs to update
 	Target []string
+	// Specify a list of resource URNs to exclude from the update
+	Exclude []string
 	// Allows updating of dependent targets discovered but not specified in the Target list
 	TargetDependents bool
 	// DebugLogOpts specifies additional settings for debug logging
@@ -147,6 +185,10 @@ type Options struct {
 	ErrorProgressStreams []io.Writer
 	// EventStreams allows specifying one or more channels to receive the Pulumi event stream
 	EventStreams []chan<- events.EngineEvent
+	// LineStreams allows specifying one or more channels to receive the output of the operation line by line
+	LineStreams []chan<- events.Line
+	// Env specifies environment variables for the operation, overriding those of the workspace
+	Env map[string]string
 	// UserAgent specifies the agent responsible for the update, stored in backends as "environment.exec.agent"
 	UserAgent string
 	// Colorize output. Choices are: always, never, raw, auto (default "auto")
@@ -157,6 +199,8 @@ type Options struct {
 	PolicyPacks []string
 	// Path to JSON file containing the config for the policy pack of the corresponding "--policy-pack" flag
 	PolicyPackConfigs []string
+	// Policy packs to run as part of this preview, in addition to PolicyPacks
+	PolicyPackSpecs []policy.PackSpec
 }
 
 type optionFunc func(*Options)
@@ -0,0 +1,280 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optdestroy"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optup"
+)
+
+// FailurePolicy controls how an Orchestrator reacts when an operation on one of its stacks fails.
+type FailurePolicy int
+
+const (
+	// SkipDependents skips the stacks that depend on a failed stack, but carries on with the others. This is the
+	// default.
+	SkipDependents FailurePolicy = iota
+	// FailFast cancels the operations that are in progress and skips all remaining stacks as soon as one fails.
+	FailFast
+)
+
+// StackStatus is the outcome of an orchestrated operation on a single stack.
+type StackStatus string
+
+const (
+	// StackSucceeded means the operation on the stack succeeded.
+	StackSucceeded StackStatus = "succeeded"
+	// StackFailed means the operation on the stack failed.
+	StackFailed StackStatus = "failed"
+	// StackSkipped means the stack was not operated on, because a stack it depends on did not succeed or because
+	// the orchestration was cancelled.
+	StackSkipped StackStatus = "skipped"
+)
+
+// StackRunResult is the outcome of an orchestrated operation on a single stack.
+type StackRunResult struct {
+	// Stack is the name of the stack.
+	Stack string
+	// Status is the outcome of the operation.
+	Status StackStatus
+	// Err is the error the operation failed with, if any.
+	Err error
+	// Up is the result of Orchestrator.Up, if the stack was updated.
+	Up *UpResult
+	// Destroy is the result of Orchestrator.Destroy, if the stack was destroyed.
+	Destroy *DestroyResult
+}
+
+// StackEngineEvent is an engine event emitted by one of the stacks of an Orchestrator.
+type StackEngineEvent struct {
+	events.EngineEvent
+	// Stack is the name of the stack that emitted the event.
+	Stack string
+}
+
+// Orchestrator runs operations across a set of stacks that depend on each other, typically because the programs of
+// some stacks read the outputs of others through stack references. Up updates each stack only after all the stacks
+// it depends on have been updated, and Destroy destroys each stack only after all the stacks that depend on it have
+// been destroyed. Independent stacks are operated on concurrently.
+type Orchestrator struct {
+	parallel     int
+	policy       FailurePolicy
+	eventStreams []chan<- StackEngineEvent
+
+	stacks  map[string]*orchestratedStack
+	ordered []*orchestratedStack
+}
+
+type orchestratedStack struct {
+	stack      Stack
+	dependsOn  []*orchestratedStack
+	dependents []*orchestratedStack
+}
+
+// OrchestratorOption is used to configure an Orchestrator.
+type OrchestratorOption func(*Orchestrator)
+
+// Parallelism limits the number of stacks that are operated on at the same time. Zero, the default, means no limit.
+func Parallelism(n int) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.parallel = n
+	}
+}
+
+// OnFailure sets how the orchestrator reacts when an operation on one of its stacks fails.
+func OnFailure(policy FailurePolicy) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.policy = policy
+	}
+}
+
+// OrchestratorEventStreams specifies channels that receive the engine events of every stack, tagged with the name of
+// the stack that emitted them. The channels are closed once the operation on all stacks has finished.
+func OrchestratorEventStreams(channels ...chan<- StackEngineEvent) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.eventStreams = channels
+	}
+}
+
+// NewOrchestrator creates an Orchestrator with no stacks.
+func NewOrchestrator(opts ...OrchestratorOption) *Orchestrator {
+	o := &Orchestrator{stacks: make(map[string]*orchestratedStack)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Add adds a stack to the orchestrator. dependsOn names the stacks that this stack depends on, which must already
+// have been added. Adding stacks in dependency order means the stacks always form an acyclic graph.
+func (o *Orchestrator) Add(stack Stack, dependsOn ...string) error {
+	name := stack.Name()
+	if _, has := o.stacks[name]; has {
+		return fmt.Errorf("stack %q has already been added", name)
+	}
+
+	node := &orchestratedStack{stack: stack}
+	for _, dep := range dependsOn {
+		depNode, has := o.stacks[dep]
+		if !has {
+			return fmt.Errorf("stack %q depends on %q, which has not been added", name, dep)
+		}
+		node.dependsOn = append(node.dependsOn, depNode)
+		depNode.dependents = append(depNode.dependents, node)
+	}
+
+	o.stacks[name] = node
+	o.ordered = append(o.ordered, node)
+	return nil
+}
+
+// Up updates every stack, each after the stacks it depends on. The options are passed to every Stack.Up, except for
+// event streams, which should be set on the orchestrator with OrchestratorEventStreams instead. The results are
+// keyed by stack name, and the returned error combines the errors of all the stacks that failed.
+func (o *Orchestrator) Up(ctx context.Context, opts ...optup.Option) (map[string]*StackRunResult, error) {
+	return o.run(ctx, false, func(ctx context.Context, s Stack, ch chan<- events.EngineEvent, res *StackRunResult) error {
+		// Copy the options so that the stacks running concurrently don't append to the same backing array.
+		up, err := s.Up(ctx, append(append([]optup.Option(nil), opts...), optup.EventStreams(ch))...)
+		res.Up = &up
+		return err
+	})
+}
+
+// Destroy destroys every stack, each after the stacks that depend on it. The options are passed to every
+// Stack.Destroy, except for event streams, which should be set on the orchestrator with OrchestratorEventStreams
+// instead. The results are keyed by stack name, and the returned error combines the errors of all the stacks that
+// failed.
+func (o *Orchestrator) Destroy(ctx context.Context, opts ...optdestroy.Option) (map[string]*StackRunResult, error) {
+	return o.run(ctx, true, func(ctx context.Context, s Stack, ch chan<- events.EngineEvent, res *StackRunResult) error {
+		// Copy the options so that the stacks running concurrently don't append to the same backing array.
+		destroy, err := s.Destroy(ctx, append(append([]optdestroy.Option(nil), opts...), optdestroy.EventStreams(ch))...)
+		res.Destroy = &destroy
+		return err
+	})
+}
+
+// stackOperation runs an operation on a single stack, sending its engine events to ch.
+type stackOperation func(ctx context.Context, s Stack, ch chan<- events.EngineEvent, res *StackRunResult) error
+
+func (o *Orchestrator) run(
+	ctx context.Context, reverse bool, op stackOperation,
+) (map[string]*StackRunResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var sem chan struct{}
+	if o.parallel > 0 {
+		sem = make(chan struct{}, o.parallel)
+	}
+
+	results := make(map[string]*StackRunResult, len(o.ordered))
+	done := make(map[*orchestratedStack]chan struct{}, len(o.ordered))
+	for _, node := range o.ordered {
+		results[node.stack.Name()] = &StackRunResult{Stack: node.stack.Name()}
+		done[node] = make(chan struct{})
+	}
+
+	var eventsWG, stacksWG sync.WaitGroup
+	for _, node := range o.ordered {
+		node := node
+		res := results[node.stack.Name()]
+
+		prereqs := node.dependsOn
+		if reverse {
+			prereqs = node.dependents
+		}
+
+		stacksWG.Add(1)
+		go func() {
+			defer stacksWG.Done()
+			defer close(done[node])
+
+			// Wait for the stacks that must be operated on first. Their results are written before their done
+			// channels are closed, so they are safe to read once the channels are closed.
+			for _, prereq := range prereqs {
+				<-done[prereq]
+				if results[prereq.stack.Name()].Status != StackSucceeded {
+					res.Status = StackSkipped
+					return
+				}
+			}
+
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+				}
+			}
+			if ctx.Err() != nil {
+				res.Status = StackSkipped
+				return
+			}
+
+			// Stacks close their event channels when they finish tailing the event log, but operations that fail
+			// early never start tailing it. The channel is unbuffered, so once the operation has returned there
+			// can be no more events to forward.
+			ch, opDone := make(chan events.EngineEvent), make(chan struct{})
+			eventsWG.Add(1)
+			go func() {
+				defer eventsWG.Done()
+				for {
+					select {
+					case e, ok := <-ch:
+						if !ok {
+							return
+						}
+						for _, stream := range o.eventStreams {
+							stream <- StackEngineEvent{EngineEvent: e, Stack: res.Stack}
+						}
+					case <-opDone:
+						return
+					}
+				}
+			}()
+
+			err := op(ctx, node.stack, ch, res)
+			close(opDone)
+			if err != nil {
+				res.Status, res.Err = StackFailed, err
+				if o.policy == FailFast {
+					cancel()
+				}
+				return
+			}
+			res.Status = StackSucceeded
+		}()
+	}
+	stacksWG.Wait()
+	eventsWG.Wait()
+	for _, stream := range o.eventStreams {
+		close(stream)
+	}
+
+	var result error
+	for _, node := range o.ordered {
+		if res := results[node.stack.Name()]; res.Err != nil {
+			result = multierror.Append(result, fmt.Errorf("stack %s: %w", res.Stack, res.Err))
+		}
+	}
+	return results, result
+}
@@ -0,0 +1,199 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// newTestOrchestrator creates an orchestrator for the stack graph:
+//
+//	network -> database -> app
+//	network -> cache    -> app
+//	monitoring
+func newTestOrchestrator(t *testing.T, opts ...OrchestratorOption) *Orchestrator {
+	o := NewOrchestrator(opts...)
+	require.NoError(t, o.Add(Stack{stackName: "network"}))
+	require.NoError(t, o.Add(Stack{stackName: "database"}, "network"))
+	require.NoError(t, o.Add(Stack{stackName: "cache"}, "network"))
+	require.NoError(t, o.Add(Stack{stackName: "app"}, "database", "cache"))
+	require.NoError(t, o.Add(Stack{stackName: "monitoring"}))
+	return o
+}
+
+// recordOrder returns an operation that records the order in which stacks are operated on, failing those in fail.
+func recordOrder(order *[]string, fail ...string) stackOperation {
+	var mu sync.Mutex
+	return func(ctx context.Context, s Stack, ch chan<- events.EngineEvent, res *StackRunResult) error {
+		mu.Lock()
+		*order = append(*order, s.Name())
+		mu.Unlock()
+		for _, f := range fail {
+			if f == s.Name() {
+				return errors.New("boom")
+			}
+		}
+		return nil
+	}
+}
+
+func indexOf(order []string, name string) int {
+	for i, n := range order {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestOrchestratorAdd(t *testing.T) {
+	t.Parallel()
+
+	o := NewOrchestrator()
+	require.NoError(t, o.Add(Stack{stackName: "a"}))
+	assert.ErrorContains(t, o.Add(Stack{stackName: "a"}), `stack "a" has already been added`)
+	assert.ErrorContains(t, o.Add(Stack{stackName: "b"}, "c"), `stack "b" depends on "c", which has not been added`)
+}
+
+func TestOrchestratorOrder(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOrchestrator(t)
+
+	var up []string
+	results, err := o.run(context.Background(), false, recordOrder(&up))
+	require.NoError(t, err)
+	require.Len(t, up, 5)
+	assert.Less(t, indexOf(up, "network"), indexOf(up, "database"))
+	assert.Less(t, indexOf(up, "network"), indexOf(up, "cache"))
+	assert.Less(t, indexOf(up, "database"), indexOf(up, "app"))
+	assert.Less(t, indexOf(up, "cache"), indexOf(up, "app"))
+	for _, res := range results {
+		assert.Equal(t, StackSucceeded, res.Status)
+	}
+
+	var destroy []string
+	_, err = o.run(context.Background(), true, recordOrder(&destroy))
+	require.NoError(t, err)
+	require.Len(t, destroy, 5)
+	assert.Less(t, indexOf(destroy, "app"), indexOf(destroy, "database"))
+	assert.Less(t, indexOf(destroy, "app"), indexOf(destroy, "cache"))
+	assert.Less(t, indexOf(destroy, "database"), indexOf(destroy, "network"))
+	assert.Less(t, indexOf(destroy, "cache"), indexOf(destroy, "network"))
+}
+
+func TestOrchestratorSkipDependents(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOrchestrator(t)
+
+	var order []string
+	results, err := o.run(context.Background(), false, recordOrder(&order, "database"))
+	assert.ErrorContains(t, err, "stack database: boom")
+
+	assert.Equal(t, StackSucceeded, results["network"].Status)
+	assert.Equal(t, StackFailed, results["database"].Status)
+	assert.Equal(t, StackSucceeded, results["cache"].Status)
+	assert.Equal(t, StackSkipped, results["app"].Status)
+	assert.Equal(t, StackSucceeded, results["monitoring"].Status)
+	assert.NotContains(t, order, "app")
+}
+
+func TestOrchestratorFailFast(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOrchestrator(t, OnFailure(FailFast), Parallelism(1))
+
+	var order []string
+	results, err := o.run(context.Background(), false, recordOrder(&order, "network"))
+	assert.ErrorContains(t, err, "stack network: boom")
+
+	// Only one stack runs at a time, so monitoring either ran before network or was skipped when network failed.
+	assert.Equal(t, StackFailed, results["network"].Status)
+	for _, name := range []string{"database", "cache", "app"} {
+		assert.Equal(t, StackSkipped, results[name].Status)
+	}
+	if indexOf(order, "monitoring") == -1 {
+		assert.Equal(t, StackSkipped, results["monitoring"].Status)
+	}
+}
+
+func TestOrchestratorParallelism(t *testing.T) {
+	t.Parallel()
+
+	o := NewOrchestrator(Parallelism(2))
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		require.NoError(t, o.Add(Stack{stackName: name}))
+	}
+
+	var running, peak int32
+	_, err := o.run(context.Background(), false,
+		func(ctx context.Context, s Stack, ch chan<- events.EngineEvent, res *StackRunResult) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+}
+
+func TestOrchestratorEventStreams(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan StackEngineEvent)
+	o := newTestOrchestrator(t, OrchestratorEventStreams(ch))
+
+	received := make(map[string]int)
+	done := make(chan struct{})
+	go func() {
+		for e := range ch {
+			received[e.Stack] += e.Sequence
+		}
+		close(done)
+	}()
+
+	_, err := o.run(context.Background(), false,
+		func(ctx context.Context, s Stack, ch chan<- events.EngineEvent, res *StackRunResult) error {
+			if s.Name() == "monitoring" {
+				// Operations that fail early never send any events or close their channel.
+				return errors.New("early failure")
+			}
+			for i := 1; i <= 2; i++ {
+				ch <- events.EngineEvent{EngineEvent: apitype.EngineEvent{Sequence: i}}
+			}
+			close(ch)
+			return nil
+		})
+	assert.ErrorContains(t, err, "early failure")
+	<-done
+
+	assert.Equal(t, map[string]int{"network": 3, "database": 3, "cache": 3, "app": 3}, received)
+}
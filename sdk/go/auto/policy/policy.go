@@ -0,0 +1,75 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy contains the options shared by the operations that can run policy packs.
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// PackSpec describes a local policy pack to run during an operation. Published policy packs can't be given here; those
+// that are enabled for the stack's organization in Pulumi Cloud are installed and enforced by the CLI automatically.
+type PackSpec struct {
+	// Path is the path to the policy pack's directory. Its dependencies are installed by the Pulumi CLI before it
+	// runs.
+	Path string
+	// Config is the configuration for the policy pack, keyed by policy name, e.g.
+	// {"s3-no-public-read": {"enforcementLevel": "mandatory"}}.
+	Config map[string]interface{}
+	// ConfigPath is the path to a JSON file containing the configuration for the policy pack. It is ignored if
+	// Config is set.
+	ConfigPath string
+}
+
+// AddArgs appends the --policy-pack and --policy-pack-config arguments for the given policy packs to args. The CLI
+// matches configurations to policy packs by position, so if any pack has a configuration, an empty one is written to
+// dir for each pack that doesn't. Configurations given inline are written to dir too.
+func AddArgs(packs []PackSpec, dir string, args []string) ([]string, error) {
+	hasConfig := false
+	for _, pack := range packs {
+		if pack.Path == "" {
+			return nil, errors.New("policy pack path must not be empty")
+		}
+		args = append(args, "--policy-pack="+pack.Path)
+		hasConfig = hasConfig || pack.Config != nil || pack.ConfigPath != ""
+	}
+	if !hasConfig {
+		return args, nil
+	}
+
+	for i, pack := range packs {
+		path := pack.ConfigPath
+		if pack.Config != nil || path == "" {
+			config := pack.Config
+			if config == nil {
+				config = map[string]interface{}{}
+			}
+			b, err := json.Marshal(config)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling config for policy pack %s: %w", pack.Path, err)
+			}
+			path = filepath.Join(dir, fmt.Sprintf("policy-pack-config-%d.json", i))
+			if err := os.WriteFile(path, b, 0o600); err != nil {
+				return nil, fmt.Errorf("writing config for policy pack %s: %w", pack.Path, err)
+			}
+		}
+		args = append(args, "--policy-pack-config="+path)
+	}
+	return args, nil
+}
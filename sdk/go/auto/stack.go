@@ -108,6 +108,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/nxadm/tail"
@@ -117,9 +118,11 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optdestroy"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/opthistory"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optimport"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optpreview"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optrefresh"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optup"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/policy"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/constant"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -221,6 +224,7 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 	for _, o := range opts {
 		o.ApplyOption(preOpts)
 	}
+	ctx = withOperationEnv(ctx, preOpts.Env)
 
 	bufferSizeHint := len(preOpts.Replace) + len(preOpts.Target) +
 		len(preOpts.PolicyPacks) + len(preOpts.PolicyPackConfigs)
@@ -236,18 +240,16 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 	if preOpts.Diff {
 		sharedArgs = append(sharedArgs, "--diff")
 	}
-	for _, rURN := range preOpts.Replace {
-		sharedArgs = append(sharedArgs, "--replace="+rURN)
-	}
-	for _, tURN := range preOpts.Target {
-		sharedArgs = append(sharedArgs, "--target="+tURN)
-	}
-	for _, pack := range preOpts.PolicyPacks {
-		sharedArgs = append(sharedArgs, "--policy-pack="+pack)
+	sharedArgs, err := addTargetArgs(preOpts.Target, preOpts.Exclude, preOpts.Replace, sharedArgs)
+	if err != nil {
+		return res, err
 	}
-	for _, packConfig := range preOpts.PolicyPackConfigs {
-		sharedArgs = append(sharedArgs, "--policy-pack-config="+packConfig)
+	sharedArgs, cleanup, err := addPolicyPackArgs(
+		preOpts.PolicyPacks, preOpts.PolicyPackConfigs, preOpts.PolicyPackSpecs, sharedArgs)
+	if err != nil {
+		return res, err
 	}
+	defer cleanup()
 	if preOpts.TargetDependents {
 		sharedArgs = append(sharedArgs, "--target-dependents")
 	}
@@ -282,6 +284,7 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 	args = append(args, sharedArgs...)
 
 	var summaryEvents []apitype.SummaryEvent
+	var policyEvents []apitype.PolicyEvent
 	eventChannel := make(chan events.EngineEvent)
 	eventsDone := make(chan bool)
 	go func() {
@@ -294,33 +297,45 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 			if event.SummaryEvent != nil {
 				summaryEvents = append(summaryEvents, *event.SummaryEvent)
 			}
+			if event.PolicyEvent != nil {
+				policyEvents = append(policyEvents, *event.PolicyEvent)
+			}
 		}
 	}()
 
+	lines := newLineStream(preOpts.LineStreams)
+	defer lines.Close()
+
 	eventChannels := []chan<- events.EngineEvent{eventChannel}
 	eventChannels = append(eventChannels, preOpts.EventStreams...)
+	eventChannels = lines.eventStreams(eventChannels)
 
-	t, err := tailLogs("preview", eventChannels)
+	t, err := tailLogs(ctx, "preview", eventChannels)
 	if err != nil {
 		return res, fmt.Errorf("failed to tail logs: %w", err)
 	}
 	defer t.Close()
+	lines.start()
 	args = append(args, "--event-log", t.Filename)
 
 	stdout, stderr, code, err := s.runPulumiCmdSync(
 		ctx,
-		preOpts.ProgressStreams,      /* additionalOutput */
-		preOpts.ErrorProgressStreams, /* additionalErrorOutput */
+		preOpts.ProgressStreams, /* additionalOutput */
+		lines.errorStreams(preOpts.ErrorProgressStreams), /* additionalErrorOutput */
 		args...,
 	)
-	if err != nil {
-		return res, newAutoError(fmt.Errorf("failed to run preview: %w", err), stdout, stderr, code)
-	}
 
 	// Close the file watcher wait for all events to send
 	t.Close()
 	<-eventsDone
 
+	res.StdOut = stdout
+	res.StdErr = stderr
+	res.PolicyViolations = policyEvents
+	if err != nil {
+		return res, newAutoError(fmt.Errorf("failed to run preview: %w", err), stdout, stderr, code)
+	}
+
 	if len(summaryEvents) == 0 {
 		return res, newAutoError(errors.New("failed to get preview summary"), stdout, stderr, code)
 	}
@@ -328,8 +343,6 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 		return res, newAutoError(errors.New("got multiple preview summaries"), stdout, stderr, code)
 	}
 
-	res.StdOut = stdout
-	res.StdErr = stderr
 	res.ChangeSummary = summaryEvents[0].ResourceChanges
 
 	return res, nil
@@ -344,6 +357,7 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 	for _, o := range opts {
 		o.ApplyOption(upOpts)
 	}
+	ctx = withOperationEnv(ctx, upOpts.Env)
 
 	bufferSizeHint := len(upOpts.Replace) + len(upOpts.Target) + len(upOpts.PolicyPacks) + len(upOpts.PolicyPackConfigs)
 	sharedArgs := slice.Prealloc[string](bufferSizeHint)
@@ -358,18 +372,16 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 	if upOpts.Diff {
 		sharedArgs = append(sharedArgs, "--diff")
 	}
-	for _, rURN := range upOpts.Replace {
-		sharedArgs = append(sharedArgs, "--replace="+rURN)
-	}
-	for _, tURN := range upOpts.Target {
-		sharedArgs = append(sharedArgs, "--target="+tURN)
-	}
-	for _, pack := range upOpts.PolicyPacks {
-		sharedArgs = append(sharedArgs, "--policy-pack="+pack)
+	sharedArgs, err := addTargetArgs(upOpts.Target, upOpts.Exclude, upOpts.Replace, sharedArgs)
+	if err != nil {
+		return res, err
 	}
-	for _, packConfig := range upOpts.PolicyPackConfigs {
-		sharedArgs = append(sharedArgs, "--policy-pack-config="+packConfig)
+	sharedArgs, cleanup, err := addPolicyPackArgs(
+		upOpts.PolicyPacks, upOpts.PolicyPackConfigs, upOpts.PolicyPackSpecs, sharedArgs)
+	if err != nil {
+		return res, err
 	}
+	defer cleanup()
 	if upOpts.TargetDependents {
 		sharedArgs = append(sharedArgs, "--target-dependents")
 	}
@@ -401,19 +413,51 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 	}
 	args = append(args, "--exec-kind="+kind)
 
-	if len(upOpts.EventStreams) > 0 {
-		eventChannels := upOpts.EventStreams
-		t, err := tailLogs("up", eventChannels)
-		if err != nil {
-			return res, fmt.Errorf("failed to tail logs: %w", err)
+	var policyEvents []apitype.PolicyEvent
+	eventChannel := make(chan events.EngineEvent)
+	eventsDone := make(chan bool)
+	go func() {
+		for {
+			event, ok := <-eventChannel
+			if !ok {
+				close(eventsDone)
+				return
+			}
+			if event.PolicyEvent != nil {
+				policyEvents = append(policyEvents, *event.PolicyEvent)
+			}
 		}
-		defer t.Close()
-		args = append(args, "--event-log", t.Filename)
+	}()
+
+	lines := newLineStream(upOpts.LineStreams)
+	defer lines.Close()
+
+	eventChannels := []chan<- events.EngineEvent{eventChannel}
+	eventChannels = append(eventChannels, upOpts.EventStreams...)
+	eventChannels = lines.eventStreams(eventChannels)
+
+	t, err := tailLogs(ctx, "up", eventChannels)
+	if err != nil {
+		return res, fmt.Errorf("failed to tail logs: %w", err)
 	}
+	defer t.Close()
+	lines.start()
+	args = append(args, "--event-log", t.Filename)
 
 	args = append(args, sharedArgs...)
-	stdout, stderr, code, err := s.runPulumiCmdSync(ctx, upOpts.ProgressStreams, upOpts.ErrorProgressStreams, args...)
+	stdout, stderr, code, err := s.runPulumiCmdSync(
+		ctx, upOpts.ProgressStreams, lines.errorStreams(upOpts.ErrorProgressStreams), args...)
+
+	// Close the file watcher wait for all events to send
+	t.Close()
+	<-eventsDone
+
 	if err != nil {
+		res = UpResult{
+			StdOut:           stdout,
+			StdErr:           stderr,
+			PolicyViolations: policyEvents,
+		}
 		return res, newAutoError(fmt.Errorf("failed to run update: %w", err), stdout, stderr, code)
 	}
 
@@ -437,11 +481,230 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 	}
 
 	res = UpResult{
-		Outputs: outs,
-		StdOut:  stdout,
-		StdErr:  stderr,
+		Outputs:          outs,
+		StdOut:           stdout,
+		StdErr:           stderr,
+		PolicyViolations: policyEvents,
+	}
+
+	if len(history) > 0 {
+		res.Summary = history[0]
+	}
+
+	return res, nil
+}
+
+// PreviewWithPlan previews an update to the stack and saves the resulting update plan to path, so that the plan can
+// be reviewed and later applied with UpWithPlan. The saved plan is returned along with the preview result.
+// https://www.pulumi.com/docs/concepts/update-plans/
+func (s *Stack) PreviewWithPlan(
+	ctx context.Context, path string, opts ...optpreview.Option,
+) (PreviewResult, apitype.DeploymentPlanV1, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return PreviewResult{}, apitype.DeploymentPlanV1{}, fmt.Errorf("resolving plan path: %w", err)
+	}
+
+	res, err := s.Preview(ctx, append(opts, optpreview.Plan(path))...)
+	if err != nil {
+		return res, apitype.DeploymentPlanV1{}, err
+	}
+
+	plan, err := ReadPlan(path)
+	if err != nil {
+		return res, apitype.DeploymentPlanV1{}, err
+	}
+	return res, plan, nil
+}
+
+// UpWithPlan updates the stack, constrained by the update plan previously saved to path by PreviewWithPlan. The
+// update fails if it would perform operations that are not part of the plan.
+// https://www.pulumi.com/docs/concepts/update-plans/
+func (s *Stack) UpWithPlan(ctx context.Context, path string, opts ...optup.Option) (UpResult, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return UpResult{}, fmt.Errorf("resolving plan path: %w", err)
+	}
+
+	// Check the plan up front, so that a missing or corrupt plan is reported before the update starts.
+	if _, err := ReadPlan(path); err != nil {
+		return UpResult{}, err
+	}
+
+	return s.Up(ctx, append(opts, optup.Plan(path))...)
+}
+
+// ReadPlan reads an update plan saved by PreviewWithPlan.
+func ReadPlan(path string) (apitype.DeploymentPlanV1, error) {
+	var plan apitype.DeploymentPlanV1
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return plan, fmt.Errorf("reading plan: %w", err)
+	}
+	if err := json.Unmarshal(b, &plan); err != nil {
+		return plan, fmt.Errorf("parsing plan %s: %w", path, err)
+	}
+	return plan, nil
+}
+
+// ImportSpec describes a resource to import with Stack.ImportResources.
+// https://www.pulumi.com/docs/cli/commands/pulumi_import/
+type ImportSpec struct {
+	// Type is the type token of the resource.
+	Type string `json:"type"`
+	// Name is the name of the resource in the stack.
+	Name string `json:"name"`
+	// ID is the provider ID of the resource to import.
+	ID string `json:"id,omitempty"`
+	// Parent is the variable name of the resource's parent, as given in the NameTable option.
+	Parent string `json:"parent,omitempty"`
+	// Provider is the variable name of the resource's provider, as given in the NameTable option.
+	Provider string `json:"provider,omitempty"`
+	// Version is the version of the provider plugin to use.
+	Version string `json:"version,omitempty"`
+	// PluginDownloadURL is the URL from which to download the provider plugin.
+	PluginDownloadURL string `json:"pluginDownloadUrl,omitempty"`
+	// Properties restricts the imported inputs to the given property names.
+	Properties []string `json:"properties,omitempty"`
+	// Component is true if the resource is a component resource, which is created rather than imported.
+	Component bool `json:"component,omitempty"`
+	// Remote is true if the component resource is a multi-language component.
+	Remote bool `json:"remote,omitempty"`
+	// LogicalName is the name to use in the generated code, if it differs from Name.
+	LogicalName string `json:"logicalName,omitempty"`
+}
+
+// ImportedResource is the outcome of importing a single resource.
+type ImportedResource struct {
+	// URN is the URN of the resource.
+	URN string
+	// Type is the type token of the resource.
+	Type string
+	// ID is the provider ID of the resource. It is empty for component resources.
+	ID string
+	// Op is the operation performed on the resource, usually "import".
+	Op apitype.OpType
+	// Failed is true if the resource could not be imported.
+	Failed bool
+}
+
+// ImportResult contains information about a Stack.ImportResources operation,
+// including the generated code and the outcome for each resource.
+type ImportResult struct {
+	StdOut        string
+	StdErr        string
+	GeneratedCode string
+	Resources     []ImportedResource
+	Summary       UpdateSummary
+}
+
+// ImportResources imports existing resources into the stack and generates the code that declares them. Results are
+// returned for each resource, including those that failed to import.
+// https://www.pulumi.com/docs/cli/commands/pulumi_import/
+func (s *Stack) ImportResources(
+	ctx context.Context, resources []ImportSpec, opts ...optimport.Option,
+) (ImportResult, error) {
+	var res ImportResult
+
+	importOpts := &optimport.Options{}
+	for _, o := range opts {
+		o.ApplyOption(importOpts)
+	}
+	ctx = withOperationEnv(ctx, importOpts.Env)
+
+	tempDir, err := os.MkdirTemp("", "automation-import-")
+	if err != nil {
+		return res, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	spec, err := json.Marshal(struct {
+		NameTable map[string]string `json:"nameTable,omitempty"`
+		Resources []ImportSpec      `json:"resources"`
+	}{importOpts.NameTable, resources})
+	if err != nil {
+		return res, fmt.Errorf("failed to marshal import file: %w", err)
+	}
+	specPath, outPath := filepath.Join(tempDir, "import.json"), filepath.Join(tempDir, "generated.txt")
+	if err := os.WriteFile(specPath, spec, 0o600); err != nil {
+		return res, fmt.Errorf("failed to write import file: %w", err)
+	}
+
+	args := []string{"import", "--yes", "--skip-preview", "--file=" + specPath}
+	args = debug.AddArgs(&importOpts.DebugLogOpts, args)
+	if importOpts.Message != "" {
+		args = append(args, fmt.Sprintf("--message=%q", importOpts.Message))
+	}
+	if importOpts.Parallel > 0 {
+		args = append(args, fmt.Sprintf("--parallel=%d", importOpts.Parallel))
+	}
+	if importOpts.Protect != nil {
+		args = append(args, fmt.Sprintf("--protect=%t", *importOpts.Protect))
+	}
+	if importOpts.GenerateCode == nil || *importOpts.GenerateCode {
+		args = append(args, "--out="+outPath)
+	} else {
+		args = append(args, "--generate-code=false")
+	}
+	if importOpts.UserAgent != "" {
+		args = append(args, "--exec-agent="+importOpts.UserAgent)
 	}
+	args = append(args, "--exec-kind="+constant.ExecKindAutoLocal)
+	args = append(args, s.remoteArgs()...)
+
+	eventChannel := make(chan events.EngineEvent)
+	eventsDone := make(chan bool)
+	go func() {
+		for event := range eventChannel {
+			switch {
+			case event.ResOutputsEvent != nil && !event.ResOutputsEvent.Planning:
+				res.Resources = append(res.Resources, importedResource(event.ResOutputsEvent.Metadata, false))
+			case event.ResOpFailedEvent != nil:
+				res.Resources = append(res.Resources, importedResource(event.ResOpFailedEvent.Metadata, true))
+			}
+		}
+		close(eventsDone)
+	}()
+
+	eventChannels := []chan<- events.EngineEvent{eventChannel}
+	eventChannels = append(eventChannels, importOpts.EventStreams...)
 
+	t, err := tailLogs(ctx, "import", eventChannels)
+	if err != nil {
+		return res, fmt.Errorf("failed to tail logs: %w", err)
+	}
+	defer t.Close()
+	args = append(args, "--event-log", t.Filename)
+
+	stdout, stderr, code, err := s.runPulumiCmdSync(
+		ctx,
+		importOpts.ProgressStreams,      /* additionalOutput */
+		importOpts.ErrorProgressStreams, /* additionalErrorOutput */
+		args...,
+	)
+
+	// Close the file watcher wait for all events to send
+	t.Close()
+	<-eventsDone
+
+	res.StdOut, res.StdErr = stdout, stderr
+	if err != nil {
+		return res, newAutoError(fmt.Errorf("failed to run import: %w", err), stdout, stderr, code)
+	}
+
+	if importOpts.GenerateCode == nil || *importOpts.GenerateCode {
+		generated, err := os.ReadFile(outPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return res, fmt.Errorf("failed to read generated code: %w", err)
+		}
+		res.GeneratedCode = string(generated)
+	}
+
+	history, err := s.History(ctx, 1 /*pageSize*/, 1 /*page*/)
+	if err != nil {
+		return res, err
+	}
 	if len(history) > 0 {
 		res.Summary = history[0]
 	}
@@ -449,6 +712,14 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 	return res, nil
 }
 
+func importedResource(m apitype.StepEventMetadata, failed bool) ImportedResource {
+	r := ImportedResource{URN: m.URN, Type: m.Type, Op: m.Op, Failed: failed}
+	if m.New != nil {
+		r.ID = m.New.ID
+	}
+	return r
+}
+
 // Refresh compares the current stack’s resource state with the state known to exist in the actual
 // cloud provider. Any such changes are adopted into the current stack.
 func (s *Stack) Refresh(ctx context.Context, opts ...optrefresh.Option) (RefreshResult, error) {
@@ -458,6 +729,7 @@ func (s *Stack) Refresh(ctx context.Context, opts ...optrefresh.Option) (Refresh
 	for _, o := range opts {
 		o.ApplyOption(refreshOpts)
 	}
+	ctx = withOperationEnv(ctx, refreshOpts.Env)
 
 	args := slice.Prealloc[string](len(refreshOpts.Target))
 
@@ -469,8 +741,9 @@ func (s *Stack) Refresh(ctx context.Context, opts ...optrefresh.Option) (Refresh
 	if refreshOpts.ExpectNoChanges {
 		args = append(args, "--expect-no-changes")
 	}
-	for _, tURN := range refreshOpts.Target {
-		args = append(args, "--target="+tURN)
+	args, err := addTargetArgs(refreshOpts.Target, refreshOpts.Exclude, nil, args)
+	if err != nil {
+		return res, err
 	}
 	if refreshOpts.Parallel > 0 {
 		args = append(args, fmt.Sprintf("--parallel=%d", refreshOpts.Parallel))
@@ -487,13 +760,16 @@ func (s *Stack) Refresh(ctx context.Context, opts ...optrefresh.Option) (Refresh
 	}
 	args = append(args, "--exec-kind="+execKind)
 
-	if len(refreshOpts.EventStreams) > 0 {
-		eventChannels := refreshOpts.EventStreams
-		t, err := tailLogs("refresh", eventChannels)
+	lines := newLineStream(refreshOpts.LineStreams)
+	defer lines.Close()
+
+	if eventChannels := lines.eventStreams(refreshOpts.EventStreams); len(eventChannels) > 0 {
+		t, err := tailLogs(ctx, "refresh", eventChannels)
 		if err != nil {
 			return res, fmt.Errorf("failed to tail logs: %w", err)
 		}
 		defer t.Close()
+		lines.start()
 		args = append(args, "--event-log", t.Filename)
 	}
 
@@ -502,8 +778,8 @@ func (s *Stack) Refresh(ctx context.Context, opts ...optrefresh.Option) (Refresh
 
 	stdout, stderr, code, err := s.runPulumiCmdSync(
 		ctx,
-		refreshOpts.ProgressStreams,      /* additionalOutputs */
-		refreshOpts.ErrorProgressStreams, /* additionalErrorOutputs */
+		refreshOpts.ProgressStreams, /* additionalOutputs */
+		lines.errorStreams(refreshOpts.ErrorProgressStreams), /* additionalErrorOutputs */
 		args...,
 	)
 	if err != nil {
@@ -546,6 +822,7 @@ func (s *Stack) Destroy(ctx context.Context, opts ...optdestroy.Option) (Destroy
 	for _, o := range opts {
 		o.ApplyOption(destroyOpts)
 	}
+	ctx = withOperationEnv(ctx, destroyOpts.Env)
 
 	args := slice.Prealloc[string](len(destroyOpts.Target))
 
@@ -554,8 +831,9 @@ func (s *Stack) Destroy(ctx context.Context, opts ...optdestroy.Option) (Destroy
 	if destroyOpts.Message != "" {
 		args = append(args, fmt.Sprintf("--message=%q", destroyOpts.Message))
 	}
-	for _, tURN := range destroyOpts.Target {
-		args = append(args, "--target="+tURN)
+	args, err := addTargetArgs(destroyOpts.Target, destroyOpts.Exclude, nil, args)
+	if err != nil {
+		return res, err
 	}
 	if destroyOpts.TargetDependents {
 		args = append(args, "--target-dependents")
@@ -575,13 +853,16 @@ func (s *Stack) Destroy(ctx context.Context, opts ...optdestroy.Option) (Destroy
 	}
 	args = append(args, "--exec-kind="+execKind)
 
-	if len(destroyOpts.EventStreams) > 0 {
-		eventChannels := destroyOpts.EventStreams
-		t, err := tailLogs("destroy", eventChannels)
+	lines := newLineStream(destroyOpts.LineStreams)
+	defer lines.Close()
+
+	if eventChannels := lines.eventStreams(destroyOpts.EventStreams); len(eventChannels) > 0 {
+		t, err := tailLogs(ctx, "destroy", eventChannels)
 		if err != nil {
 			return res, fmt.Errorf("failed to tail logs: %w", err)
 		}
 		defer t.Close()
+		lines.start()
 		args = append(args, "--event-log", t.Filename)
 	}
 
@@ -590,8 +871,8 @@ func (s *Stack) Destroy(ctx context.Context, opts ...optdestroy.Option) (Destroy
 
 	stdout, stderr, code, err := s.runPulumiCmdSync(
 		ctx,
-		destroyOpts.ProgressStreams,      /* additionalOutputs */
-		destroyOpts.ErrorProgressStreams, /* additionalErrorOutputs */
+		destroyOpts.ProgressStreams, /* additionalOutputs */
+		lines.errorStreams(destroyOpts.ErrorProgressStreams), /* additionalErrorOutputs */
 		args...,
 	)
 	if err != nil {
@@ -815,6 +1096,30 @@ func (s *Stack) Export(ctx context.Context) (apitype.UntypedDeployment, error) {
 	return s.Workspace().ExportStack(ctx, s.Name())
 }
 
+// ExportWithSecretsProvider exports the deployment state of the stack with its secrets re-encrypted for the given
+// secrets provider, e.g. "passphrase" or "awskms://alias/ExampleAlias". The stack itself, including its secrets
+// provider, is left unchanged. The result can be imported with Stack.Import into a stack in another backend or using
+// another secrets provider, provided that the secrets provider is accessible there.
+func (s *Stack) ExportWithSecretsProvider(
+	ctx context.Context, secretsProvider string,
+) (apitype.UntypedDeployment, error) {
+	var state apitype.UntypedDeployment
+
+	stdout, stderr, errCode, err := s.runPulumiCmdSync(ctx, nil /* additionalOutputs */, nil, /* additionalErrorOutputs */
+		"stack", "export", "--secrets-provider", secretsProvider)
+	if err != nil {
+		return state, newAutoError(fmt.Errorf("could not export stack: %w", err), stdout, stderr, errCode)
+	}
+
+	if err := json.Unmarshal([]byte(stdout), &state); err != nil {
+		return state, newAutoError(
+			fmt.Errorf("failed to export stack, unable to unmarshall stack state: %w", err), stdout, stderr, errCode,
+		)
+	}
+
+	return state, nil
+}
+
 // Import imports the specified deployment state into the stack.
 // This can be combined with Stack.Export to edit a stack's state (such as recovery from failed deployments).
 func (s *Stack) Import(ctx context.Context, state apitype.UntypedDeployment) error {
@@ -849,6 +1154,9 @@ type UpResult struct {
 	StdErr  string
 	Outputs OutputMap
 	Summary UpdateSummary
+	// PolicyViolations are the violations reported by the policy packs run as part of the update, including those
+	// enforced by the organization. It is also populated when the update fails due to a mandatory violation.
+	PolicyViolations []apitype.PolicyEvent
 }
 
 // GetPermalink returns the permalink URL in the Pulumi Console for the update operation.
@@ -918,6 +1226,9 @@ type PreviewResult struct {
 	StdOut        string
 	StdErr        string
 	ChangeSummary map[apitype.OpType]int
+	// PolicyViolations are the violations reported by the policy packs run as part of the preview, including those
+	// enforced by the organization. It is also populated when the preview fails due to a mandatory violation.
+	PolicyViolations []apitype.PolicyEvent
 }
 
 // GetPermalink returns the permalink URL in the Pulumi Console for the preview operation.
@@ -988,6 +1299,11 @@ func (s *Stack) runPulumiCmdSync(
 	args = append(args, additionalArgs...)
 	args = append(args, "--stack", s.Name())
 
+	gracePeriod := defaultCancelGracePeriod
+	if lws, isLocalWorkspace := s.Workspace().(*LocalWorkspace); isLocalWorkspace {
+		gracePeriod = lws.getCancelGracePeriod()
+	}
+
 	stdout, stderr, errCode, err := runPulumiCommandSync(
 		ctx,
 		s.Workspace().WorkDir(),
@@ -995,9 +1311,17 @@ func (s *Stack) runPulumiCmdSync(
 		additionalOutput,
 		additionalErrorOutput,
 		env,
+		gracePeriod,
 		args...,
 	)
 	if err != nil {
+		if isCancelTimeout(err) && len(args) > 0 && isUpdateCommand(args[0]) {
+			// The CLI was killed before it could finish cancelling the update, so the update may still be marked as
+			// in progress. Cancel it so that the stack isn't left locked. This is best effort: it fails if no
+			// update is in progress, and isn't supported by local backends.
+			//nolint:errcheck
+			s.Cancel(context.Background())
+		}
 		return stdout, stderr, errCode, err
 	}
 	err = s.Workspace().PostCommandCallback(ctx, s.Name())
@@ -1007,6 +1331,15 @@ func (s *Stack) runPulumiCmdSync(
 	return stdout, stderr, errCode, nil
 }
 
+// isUpdateCommand returns true if the given pulumi command performs an update that holds a lock on the stack.
+func isUpdateCommand(command string) bool {
+	switch command {
+	case "up", "preview", "refresh", "destroy", "import":
+		return true
+	}
+	return false
+}
+
 func (s *Stack) isRemote() bool {
 	var remote bool
 	if lws, isLocalWorkspace := s.Workspace().(*LocalWorkspace); isLocalWorkspace {
@@ -1256,7 +1589,10 @@ type fileWatcher struct {
 	done      chan bool
 }
 
-func watchFile(path string, receivers []chan<- events.EngineEvent) (*fileWatcher, error) {
+// watchFile tails the event log at path and forwards its events to receivers. If ctx has been cancelled by the time
+// the watcher is closed and the log did not end with a cancel event, one is sent so that receivers always see a
+// terminal event.
+func watchFile(ctx context.Context, path string, receivers []chan<- events.EngineEvent) (*fileWatcher, error) {
 	t, err := tail.TailFile(path, tail.Config{
 		Follow: true,
 		Poll:   runtime.GOOS == "windows", // on Windows poll for file changes instead of using the default inotify
@@ -1267,6 +1603,8 @@ func watchFile(path string, receivers []chan<- events.EngineEvent) (*fileWatcher
 	}
 	done := make(chan bool)
 	go func(tailedLog *tail.Tail) {
+		var sequence int
+		terminated := false
 		for line := range tailedLog.Lines {
 			if line.Err != nil {
 				for _, r := range receivers {
@@ -1282,6 +1620,17 @@ func watchFile(path string, receivers []chan<- events.EngineEvent) (*fileWatcher
 				}
 				continue
 			}
+			sequence, terminated = e.Sequence, e.CancelEvent != nil
+			for _, r := range receivers {
+				r <- events.EngineEvent{EngineEvent: e}
+			}
+		}
+		if !terminated && ctx.Err() != nil {
+			e := apitype.EngineEvent{
+				Sequence:    sequence + 1,
+				Timestamp:   int(time.Now().Unix()),
+				CancelEvent: &apitype.CancelEvent{},
+			}
 			for _, r := range receivers {
 				r <- events.EngineEvent{EngineEvent: e}
 			}
@@ -1299,14 +1648,74 @@ func watchFile(path string, receivers []chan<- events.EngineEvent) (*fileWatcher
 	}, nil
 }
 
-func tailLogs(command string, receivers []chan<- events.EngineEvent) (*fileWatcher, error) {
+// addTargetArgs validates the given target, exclude, and replace URNs and appends the corresponding arguments to args.
+// Each URN must either be a valid URN or a glob containing '*'.
+func addTargetArgs(targets, excludes, replaces []string, args []string) ([]string, error) {
+	for _, flag := range []struct {
+		name string
+		urns []string
+	}{
+		{"target", targets},
+		{"exclude", excludes},
+		{"replace", replaces},
+	} {
+		for _, urn := range flag.urns {
+			if !strings.ContainsRune(urn, '*') && !resource.URN(urn).IsValid() {
+				return nil, fmt.Errorf("invalid %s URN %q", flag.name, urn)
+			}
+			args = append(args, "--"+flag.name+"="+urn)
+		}
+	}
+	return args, nil
+}
+
+// addPolicyPackArgs appends the arguments for the given policy packs to args. Packs given by path and config path
+// are run before packs given as specs. Any configuration files that need to be written go to a temporary directory,
+// which is removed by the returned cleanup function.
+func addPolicyPackArgs(
+	packs, configs []string, specs []policy.PackSpec, args []string,
+) ([]string, func(), error) {
+	if len(specs) == 0 {
+		for _, pack := range packs {
+			args = append(args, "--policy-pack="+pack)
+		}
+		for _, packConfig := range configs {
+			args = append(args, "--policy-pack-config="+packConfig)
+		}
+		return args, func() {}, nil
+	}
+
+	all := slice.Prealloc[policy.PackSpec](len(packs) + len(specs))
+	for i, pack := range packs {
+		spec := policy.PackSpec{Path: pack}
+		if i < len(configs) {
+			spec.ConfigPath = configs[i]
+		}
+		all = append(all, spec)
+	}
+	all = append(all, specs...)
+
+	dir, err := os.MkdirTemp("", "automation-policy-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create policy pack config directory: %w", err)
+	}
+	cleanup := func() { contract.IgnoreError(os.RemoveAll(dir)) }
+	args, err = policy.AddArgs(all, dir, args)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return args, cleanup, nil
+}
+
+func tailLogs(ctx context.Context, command string, receivers []chan<- events.EngineEvent) (*fileWatcher, error) {
 	logDir, err := os.MkdirTemp("", fmt.Sprintf("automation-logs-%s-", command))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create logdir: %w", err)
 	}
 	logFile := filepath.Join(logDir, "eventlog.txt")
 
-	t, err := watchFile(logFile, receivers)
+	t, err := watchFile(ctx, logFile, receivers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to watch file: %w", err)
 	}
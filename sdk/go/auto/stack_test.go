@@ -17,10 +17,15 @@ package auto
 import (
 	"context"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optpreview"
 	"github.com/pulumi/pulumi/sdk/v3/go/auto/optup"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/policy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -134,3 +139,202 @@ func TestUpdatePlans(t *testing.T) {
 	assert.Equal(t, "destroy", dRes.Summary.Kind)
 	assert.Equal(t, "succeeded", dRes.Summary.Result)
 }
+
+func TestPreviewAndUpWithPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sName := randomStackName()
+	stackName := FullyQualifiedStackName(pulumiOrg, pName, sName)
+
+	opts := []LocalWorkspaceOption{
+		SecretsProvider("passphrase"),
+		EnvVars(map[string]string{
+			"PULUMI_CONFIG_PASSPHRASE": "password",
+		}),
+	}
+
+	// initialize
+	s, err := NewStackInlineSource(ctx, stackName, pName, func(ctx *pulumi.Context) error {
+		ctx.Export("exp_static", pulumi.String("foo"))
+		return nil
+	}, opts...)
+	require.NoError(t, err, "failed to initialize stack, err: %v", err)
+
+	defer func() {
+		// -- pulumi stack rm --
+		err = s.Workspace().RemoveStack(ctx, s.Name())
+		assert.Nil(t, err, "failed to remove stack. Resources have leaked.")
+	}()
+
+	planPath := filepath.Join(t.TempDir(), "plan.json")
+
+	// -- pulumi preview --save-plan --
+	_, plan, err := s.PreviewWithPlan(ctx, planPath)
+	require.NoError(t, err, "preview failed, err: %v", err)
+	assert.NotEmpty(t, plan.ResourcePlans)
+
+	// -- pulumi up --plan --
+	upResult, err := s.UpWithPlan(ctx, planPath)
+	require.NoError(t, err, "up failed, err: %v", err)
+	assert.Equal(t, "update", upResult.Summary.Kind)
+	assert.Equal(t, "succeeded", upResult.Summary.Result)
+
+	// -- pulumi destroy --
+	dRes, err := s.Destroy(ctx)
+	require.NoError(t, err, "destroy failed, err: %v", err)
+	assert.Equal(t, "succeeded", dRes.Summary.Result)
+}
+
+func TestUpWithPlanInvalidPlan(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	var s Stack
+	_, err := s.UpWithPlan(context.Background(), filepath.Join(dir, "missing.json"))
+	assert.ErrorContains(t, err, "reading plan")
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	require.NoError(t, os.WriteFile(corrupt, []byte("{"), 0o600))
+	_, err = s.UpWithPlan(context.Background(), corrupt)
+	assert.ErrorContains(t, err, "parsing plan "+corrupt)
+}
+
+func TestImportedResource(t *testing.T) {
+	t.Parallel()
+
+	r := importedResource(apitype.StepEventMetadata{
+		Op:   apitype.OpImport,
+		URN:  "urn:pulumi:dev::proj::random:index/randomPet:RandomPet::pet",
+		Type: "random:index/randomPet:RandomPet",
+		New:  &apitype.StepEventStateMetadata{ID: "pet-1234"},
+	}, false)
+	assert.Equal(t, ImportedResource{
+		URN:  "urn:pulumi:dev::proj::random:index/randomPet:RandomPet::pet",
+		Type: "random:index/randomPet:RandomPet",
+		ID:   "pet-1234",
+		Op:   apitype.OpImport,
+	}, r)
+
+	r = importedResource(apitype.StepEventMetadata{
+		Op:   apitype.OpImport,
+		URN:  "urn:pulumi:dev::proj::random:index/randomPet:RandomPet::missing",
+		Type: "random:index/randomPet:RandomPet",
+	}, true)
+	assert.True(t, r.Failed)
+	assert.Empty(t, r.ID)
+}
+
+func TestTailLogsSendsCancelEventWhenCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan events.EngineEvent)
+	tailer, err := tailLogs(ctx, "test", []chan<- events.EngineEvent{ch})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tailer.Filename,
+		[]byte(`{"sequence":1,"timestamp":1,"stdoutEvent":{"message":"hello","color":"never"}}`+"\n"), 0o600))
+
+	first := <-ch
+	assert.NotNil(t, first.StdoutEvent)
+
+	cancel()
+	var received []events.EngineEvent
+	done := make(chan struct{})
+	go func() {
+		for e := range ch {
+			received = append(received, e)
+		}
+		close(done)
+	}()
+	tailer.Close()
+	<-done
+
+	require.Len(t, received, 1)
+	assert.NotNil(t, received[0].CancelEvent)
+	assert.Equal(t, 2, received[0].Sequence)
+}
+
+func TestAddPolicyPackArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy", func(t *testing.T) {
+		t.Parallel()
+
+		args, cleanup, err := addPolicyPackArgs([]string{"a", "b"}, []string{"a.json"}, nil, nil)
+		require.NoError(t, err)
+		defer cleanup()
+		assert.Equal(t, []string{"--policy-pack=a", "--policy-pack=b", "--policy-pack-config=a.json"}, args)
+	})
+
+	t.Run("specs", func(t *testing.T) {
+		t.Parallel()
+
+		args, cleanup, err := addPolicyPackArgs([]string{"a"}, []string{"a.json"}, []policy.PackSpec{
+			{Path: "b"},
+			{Path: "c", Config: map[string]interface{}{"all": "mandatory"}},
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, args, 6)
+		assert.Equal(t, []string{"--policy-pack=a", "--policy-pack=b", "--policy-pack=c", "--policy-pack-config=a.json"},
+			args[:4])
+
+		// Packs without a config get an empty one so the configs line up with the packs.
+		b, err := os.ReadFile(strings.TrimPrefix(args[4], "--policy-pack-config="))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(b))
+		c, err := os.ReadFile(strings.TrimPrefix(args[5], "--policy-pack-config="))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"all": "mandatory"}`, string(c))
+
+		cleanup()
+		_, err = os.Stat(filepath.Dir(strings.TrimPrefix(args[5], "--policy-pack-config=")))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("no configs", func(t *testing.T) {
+		t.Parallel()
+
+		args, cleanup, err := addPolicyPackArgs(nil, nil, []policy.PackSpec{{Path: "a"}, {Path: "b"}}, nil)
+		require.NoError(t, err)
+		defer cleanup()
+		assert.Equal(t, []string{"--policy-pack=a", "--policy-pack=b"}, args)
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := addPolicyPackArgs(nil, nil, []policy.PackSpec{{Config: map[string]interface{}{}}}, nil)
+		assert.EqualError(t, err, "policy pack path must not be empty")
+	})
+}
+
+func TestAddTargetArgs(t *testing.T) {
+	t.Parallel()
+
+	const urn = "urn:pulumi:dev::proj::random:index/randomPet:RandomPet::pet"
+
+	args, err := addTargetArgs([]string{urn}, []string{"**pet*"}, []string{urn}, []string{"up"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"up", "--target=" + urn, "--exclude=**pet*", "--replace=" + urn}, args)
+
+	_, err = addTargetArgs(nil, []string{"pet"}, nil, nil)
+	assert.EqualError(t, err, `invalid exclude URN "pet"`)
+}
+
+//nolint:paralleltest // modifies PATH
+func TestExportWithSecretsProvider(t *testing.T) {
+	fakePulumi(t, `[ "$*" = "stack export --secrets-provider awskms://alias/test --stack dev --non-interactive" ] || {
+	echo "unexpected args: $*" >&2
+	exit 1
+}
+echo '{"version":3,"deployment":{"secrets_providers":{"type":"cloud"}}}'
+`)
+
+	s := Stack{workspace: &LocalWorkspace{workDir: t.TempDir()}, stackName: "dev"}
+	state, err := s.ExportWithSecretsProvider(context.Background(), "awskms://alias/test")
+	require.NoError(t, err)
+	assert.Equal(t, 3, state.Version)
+	assert.JSONEq(t, `{"secrets_providers":{"type":"cloud"}}`, string(state.Deployment))
+}
@@ -0,0 +1,85 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// defaultWatchInterval is how often WatchOutputs polls for changes by default.
+const defaultWatchInterval = 30 * time.Second
+
+type watchOptions struct {
+	interval time.Duration
+}
+
+// WatchOption configures Stack.WatchOutputs.
+type WatchOption func(*watchOptions)
+
+// WatchInterval sets how often WatchOutputs polls the backend for the stack's outputs. Defaults to 30 seconds. The
+// interval must be positive.
+func WatchInterval(d time.Duration) WatchOption {
+	return func(opts *watchOptions) {
+		opts.interval = d
+	}
+}
+
+// WatchOutputs calls fn with the stack's outputs, and then again each time they change, until ctx is cancelled. The
+// outputs are polled from the backend, so changes made by any client, such as another process running `pulumi up`,
+// are observed. WatchOutputs blocks until ctx is cancelled, in which case it returns ctx.Err(), or until the outputs
+// can't be fetched, in which case it returns the error. It returns an error without fetching the outputs if the
+// WatchInterval option isn't positive.
+func (s *Stack) WatchOutputs(ctx context.Context, fn func(OutputMap), opts ...WatchOption) error {
+	return watchOutputs(ctx, s.Outputs, fn, opts...)
+}
+
+func watchOutputs(
+	ctx context.Context, fetch func(context.Context) (OutputMap, error), fn func(OutputMap), opts ...WatchOption,
+) error {
+	options := watchOptions{interval: defaultWatchInterval}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.interval <= 0 {
+		return fmt.Errorf("watch interval must be positive, got %v", options.interval)
+	}
+
+	ticker := time.NewTicker(options.interval)
+	defer ticker.Stop()
+
+	var last OutputMap
+	for first := true; ; first = false {
+		outputs, err := fetch(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return err
+		}
+		if first || !reflect.DeepEqual(outputs, last) {
+			last = outputs
+			fn(outputs)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
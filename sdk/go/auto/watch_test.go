@@ -0,0 +1,85 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchOutputs(t *testing.T) {
+	t.Parallel()
+
+	polls := []OutputMap{
+		{"url": {Value: "a"}},
+		{"url": {Value: "a"}},
+		{"url": {Value: "b"}},
+		{"url": {Value: "b"}, "token": {Value: "t", Secret: true}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var n int
+	fetch := func(ctx context.Context) (OutputMap, error) {
+		if n == len(polls) {
+			cancel()
+			return polls[n-1], nil
+		}
+		n++
+		return polls[n-1], nil
+	}
+
+	var seen []OutputMap
+	err := watchOutputs(ctx, fetch, func(outs OutputMap) { seen = append(seen, outs) },
+		WatchInterval(time.Millisecond))
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []OutputMap{polls[0], polls[2], polls[3]}, seen)
+}
+
+func TestWatchOutputsError(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	calls := 0
+	err := watchOutputs(context.Background(), func(ctx context.Context) (OutputMap, error) {
+		calls++
+		if calls > 1 {
+			return nil, boom
+		}
+		return OutputMap{}, nil
+	}, func(OutputMap) {}, WatchInterval(time.Millisecond))
+	require.ErrorIs(t, err, boom)
+	assert.Equal(t, 2, calls)
+}
+
+func TestWatchOutputsInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		calls := 0
+		err := watchOutputs(context.Background(), func(ctx context.Context) (OutputMap, error) {
+			calls++
+			return OutputMap{}, nil
+		}, func(OutputMap) {}, WatchInterval(interval))
+		assert.ErrorContains(t, err, "watch interval must be positive")
+		assert.Equal(t, 0, calls)
+	}
+}
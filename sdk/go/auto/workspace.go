@@ -135,6 +135,9 @@ type Workspace interface {
 	RemovePlugin(context.Context, string, string) error
 	// ListPlugins lists all installed plugins.
 	ListPlugins(context.Context) ([]workspace.PluginInfo, error)
+	// EnsurePlugins installs the language dependencies and plugins required by the program, along with any
+	// plugins in the given manifest, so that they don't have to be installed by the first operation.
+	EnsurePlugins(context.Context, ...PluginRequirement) error
 	// Program returns the program `pulumi.RunFunc` to be used for Preview/Update if any.
 	// If none is specified, the stack will refer to ProjectSettings for this information.
 	Program() pulumi.RunFunc
@@ -177,6 +180,17 @@ type StackSummary struct {
 	URL              string `json:"url,omitempty"`
 }
 
+// PluginRequirement describes a resource plugin required by a program.
+type PluginRequirement struct {
+	// Name is the name of the plugin, e.g. "aws".
+	Name string
+	// Version is the version of the plugin. If empty, any installed version satisfies the requirement, and the
+	// latest version is installed if none is.
+	Version string
+	// Server is the URL from which to download the plugin, if it isn't hosted by Pulumi.
+	Server string
+}
+
 // WhoAmIResult contains detailed information about the currently logged-in Pulumi identity.
 type WhoAmIResult struct {
 	User          string   `json:"user"`
@@ -149,6 +149,8 @@ type DeploymentV3 struct {
 	Resources []ResourceV3 `json:"resources,omitempty" yaml:"resources,omitempty"`
 	// PendingOperations are all operations that were known by the engine to be currently executing.
 	PendingOperations []OperationV2 `json:"pending_operations,omitempty" yaml:"pending_operations,omitempty"`
+	// SecretsRotations records every rotation of the secrets of this stack, oldest first.
+	SecretsRotations []SecretsRotationV1 `json:"secrets_rotations,omitempty" yaml:"secrets_rotations,omitempty"`
 }
 
 type SecretsProvidersV1 struct {
@@ -156,6 +158,21 @@ type SecretsProvidersV1 struct {
 	State json.RawMessage `json:"state,omitempty"`
 }
 
+// SecretsRotationV1 records a rotation of the secrets of a stack, in which every secret in its configuration and state
+// was decrypted and re-encrypted with a new key.
+type SecretsRotationV1 struct {
+	// Time is the time at which the rotation happened.
+	Time time.Time `json:"time" yaml:"time"`
+	// PreviousSecretsProvider is the secrets provider the secrets were encrypted with before the rotation.
+	PreviousSecretsProvider string `json:"previousSecretsProvider,omitempty" yaml:"previousSecretsProvider,omitempty"`
+	// SecretsProvider is the secrets provider the secrets were re-encrypted with.
+	SecretsProvider string `json:"secretsProvider" yaml:"secretsProvider"`
+	// ConfigSecrets is the number of secret configuration values that were re-encrypted.
+	ConfigSecrets int `json:"configSecrets" yaml:"configSecrets"`
+	// StateSecrets is the number of secret values in the state that were re-encrypted.
+	StateSecrets int `json:"stateSecrets" yaml:"stateSecrets"`
+}
+
 // OperationType is the type of an operation initiated by the engine. Its value indicates the type of operation
 // that the engine initiated.
 type OperationType string
@@ -335,6 +352,12 @@ type ResourceV3 struct {
 	Modified *time.Time `json:"modified,omitempty" yaml:"modified,omitempty"`
 	// SourcePosition tracks the source location of this resource's registration
 	SourcePosition string `json:"sourcePosition,omitempty" yaml:"sourcePosition,omitempty"`
+	// RetryPolicy is a configuration block that can be used to control retries of failed CRUD operations.
+	RetryPolicy *resource.RetryPolicy `json:"retryPolicy,omitempty" yaml:"retryPolicy,omitempty"`
+	// Frozen is true when the resource is frozen; the engine refuses to update, replace or delete frozen resources.
+	Frozen bool `json:"frozen,omitempty" yaml:"frozen,omitempty"`
+	// OutputMetadata holds optional documentation and type information for the resource's outputs.
+	OutputMetadata resource.OutputMetadataMap `json:"outputMetadata,omitempty" yaml:"outputMetadata,omitempty"`
 }
 
 // ManifestV1 captures meta-information about this checkpoint file, such as versions of binaries, etc.
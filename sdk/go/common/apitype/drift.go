@@ -0,0 +1,83 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apitype
+
+// DriftReportVersion is the version of the drift report schema written by this version of the CLI. Fields are only
+// ever added to a version; any other change to the schema comes with a new version.
+const DriftReportVersion = 1
+
+// DriftReport describes how the resources of a stack have drifted from the state recorded for them, as found by
+// refreshing the stack without updating its state.
+type DriftReport struct {
+	// Version is the version of the schema the report follows.
+	Version int `json:"version"`
+	// Project is the name of the project the stack belongs to.
+	Project string `json:"project"`
+	// Stack is the name of the stack.
+	Stack string `json:"stack"`
+	// Summary counts the resources that were refreshed and those that drifted.
+	Summary DriftSummary `json:"summary"`
+	// Resources are the resources that drifted, in the order they were refreshed.
+	Resources []DriftedResource `json:"resources"`
+}
+
+// DriftSummary counts the resources covered by a drift report.
+type DriftSummary struct {
+	// Refreshed is the number of resources that were refreshed.
+	Refreshed int `json:"refreshed"`
+	// Drifted is the number of resources whose properties changed.
+	Drifted int `json:"drifted"`
+	// Deleted is the number of resources that no longer exist.
+	Deleted int `json:"deleted"`
+}
+
+// DriftedResource describes how a single resource has drifted.
+type DriftedResource struct {
+	// URN is the URN of the resource.
+	URN string `json:"urn"`
+	// Type is the type of the resource.
+	Type string `json:"type"`
+	// ID is the provider ID of the resource.
+	ID string `json:"id,omitempty"`
+	// Deleted is true if the resource was deleted out of band, and so no longer exists.
+	Deleted bool `json:"deleted"`
+	// Properties are the properties of the resource whose values changed. They're empty if the resource was deleted.
+	Properties []PropertyDrift `json:"properties,omitempty"`
+}
+
+// PropertyDriftKind is the way a property of a resource has drifted.
+type PropertyDriftKind string
+
+const (
+	// PropertyAdded is a property that is now set but wasn't recorded.
+	PropertyAdded PropertyDriftKind = "add"
+	// PropertyDeleted is a property that was recorded but is no longer set.
+	PropertyDeleted PropertyDriftKind = "delete"
+	// PropertyUpdated is a property whose value changed.
+	PropertyUpdated PropertyDriftKind = "update"
+)
+
+// PropertyDrift describes how a single property of a resource has drifted.
+type PropertyDrift struct {
+	// Path is the path of the property within the outputs of the resource, such as `tags.env` or
+	// `ingress[0].fromPort`.
+	Path string `json:"path"`
+	// Kind is the way the property drifted.
+	Kind PropertyDriftKind `json:"kind"`
+	// Old is the value recorded for the property, if any. Secret values are replaced by "[secret]".
+	Old interface{} `json:"old,omitempty"`
+	// New is the current value of the property, if any. Secret values are replaced by "[secret]".
+	New interface{} `json:"new,omitempty"`
+}
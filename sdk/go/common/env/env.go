@@ -46,6 +46,10 @@ var Dev = env.Bool("DEV", "Enable features for hacking on pulumi itself.")
 var SkipCheckpoints = env.Bool("SKIP_CHECKPOINTS", "Experimental flag to skip saving state "+
 	"checkpoints and only save the final deployment. See #10668.", env.Needs(Experimental))
 
+var CheckpointBatchWindow = env.Int("CHECKPOINT_BATCH_WINDOW", "How long, in milliseconds, a checkpoint write "+
+	"during an update may be delayed so that it can be coalesced with later ones. Defaults to 0, which writes a "+
+	"checkpoint after every step.")
+
 var DebugCommands = env.Bool("DEBUG_COMMANDS", "List commands helpful for debugging pulumi itself.")
 
 var EnableLegacyDiff = env.Bool("ENABLE_LEGACY_DIFF", "")
@@ -78,6 +82,9 @@ The variable should be set to the log file to which gRPC debug traces will be se
 var GitSSHPassphrase = env.String("GITSSH_PASSPHRASE",
 	"The passphrase to use with Git operations that use SSH.", env.Secret)
 
+var ReadOnly = env.Bool("READ_ONLY",
+	"Put the backend in read-only mode, in which any operation that would write to the state of a stack fails.")
+
 var ErrorOnDependencyCycles = env.Bool("ERROR_ON_DEPENDENCY_CYCLES",
 	"Whether or not to error when dependency cycles are detected.")
 
@@ -92,13 +99,85 @@ var (
 	SelfManagedGzip = env.Bool("SELF_MANAGED_STATE_GZIP",
 		"Enables gzip compression when writing state files.")
 
+	SelfManagedCompression = env.String("SELF_MANAGED_STATE_COMPRESSION",
+		"The compression to use when writing state files: none, gzip or zstd. Takes precedence over "+
+			"PULUMI_SELF_MANAGED_STATE_GZIP. State files in any format can always be read.")
+
 	SelfManagedRetainCheckpoints = env.Bool("RETAIN_CHECKPOINTS",
 		"If set every checkpoint will be duplicated to a timestamped file.")
 
 	SelfManagedDisableCheckpointBackups = env.Bool("DISABLE_CHECKPOINT_BACKUPS",
 		"If set checkpoint backups will not be written the to the backup folder.")
+
+	SelfManagedHistoryLimit = env.Int("SELF_MANAGED_STATE_HISTORY_LIMIT",
+		"The number of updates kept in each stack's history. Defaults to 1000, a negative value keeps every update.")
+
+	SelfManagedLockLease = env.Int("SELF_MANAGED_STATE_LOCK_LEASE",
+		"How long, in seconds, a stack lock stays valid without being renewed by its holder. Defaults to 300.")
+
+	SelfManagedJournal = env.Bool("SELF_MANAGED_STATE_JOURNAL",
+		"If set checkpoint writes during an update are appended to a journal instead of rewriting the whole state file. "+
+			"CLIs that predate the journal ignore it, so only set this if every CLI using the state supports it. "+
+			"For S3, Azure Blob and GCS buckets this also sends an update's first save as a journal entry "+
+			"instead of uploading the whole state file. The journal isn't used when the state is encrypted.")
+
+	SelfManagedJournalCompactionInterval = env.Int("SELF_MANAGED_STATE_JOURNAL_COMPACTION_INTERVAL",
+		"The number of journal entries written before the state file is rewritten in full. Defaults to 64.")
+
+	SelfManagedRestoreCorrupted = env.Bool("SELF_MANAGED_STATE_RESTORE_CORRUPTED",
+		"If set a stack whose state file is found to be corrupted is restored from its most recent valid backup.")
+
+	SelfManagedListPageSize = env.Int("SELF_MANAGED_STATE_LIST_PAGE_SIZE",
+		"The number of objects listed at a time when listing the stacks in a bucket. Defaults to 1000.")
+
+	SelfManagedEncryptState = env.Bool("SELF_MANAGED_STATE_ENCRYPT",
+		"If set the whole state file is encrypted with the stack's secrets manager before it's written.")
+
+	SelfManagedWebhookSecret = env.String("SELF_MANAGED_STATE_WEBHOOK_SECRET",
+		"The secret that the payloads sent to the webhooks of a DIY backend are signed with.", env.Secret)
 )
 
+// Environment variables that affect how requests to the Pulumi Cloud API are retried.
+var (
+	APIRetryMaxAttempts = env.Int("API_RETRY_MAX_ATTEMPTS",
+		"The number of times a retryable request to the Pulumi Cloud API is attempted before giving up. Defaults to 4.")
+
+	APIRetryDelay = env.Int("API_RETRY_DELAY",
+		"How long, in milliseconds, to wait before retrying a failed Pulumi Cloud API request. Defaults to 1000.")
+
+	APIRetryMaxDelay = env.Int("API_RETRY_MAX_DELAY",
+		"The longest, in milliseconds, to wait between retries of a Pulumi Cloud API request. Defaults to 30000.")
+
+	APIRetryBackoff = env.String("API_RETRY_BACKOFF",
+		"The factor the delay between retries of a Pulumi Cloud API request grows by after each try. Defaults to 2.")
+
+	APIRetryableStatusCodes = env.String("API_RETRYABLE_STATUS_CODES",
+		"A comma separated list of the response status codes that Pulumi Cloud API requests are retried on. "+
+			"Defaults to 429 and every 5xx code.")
+
+	APICircuitBreakerThreshold = env.Int("API_CIRCUIT_BREAKER_THRESHOLD",
+		"The number of consecutive Pulumi Cloud API requests that can fail, after retries, before further requests "+
+			"fail immediately. The circuit breaker is disabled unless this is set to a positive number.")
+
+	APICircuitBreakerCooldown = env.Int("API_CIRCUIT_BREAKER_COOLDOWN",
+		"How long, in seconds, requests to the Pulumi Cloud API fail immediately once the circuit breaker trips. "+
+			"Defaults to 30.")
+)
+
+// SecretsAuditLog is the path of a file that every decryption of a stack's secrets is logged to.
+var SecretsAuditLog = env.String("SECRETS_AUDIT_LOG",
+	"If set, every decryption of a stack's secrets is logged to this file as a line of JSON.")
+
+// DeterministicSecrets makes locally encrypted secrets deterministic.
+var DeterministicSecrets = env.Bool("DETERMINISTIC_SECRETS",
+	"Encrypt equal secret values to equal ciphertexts, so that unchanged secrets don't show up in diffs of "+
+		"configuration and state. This reveals which secrets have equal values.")
+
+// SecretScan scans the plaintext outputs of resources for values that look like secrets before they're saved.
+var SecretScan = env.String("SECRET_SCAN",
+	"Scan the plaintext outputs of resources for values that look like secrets before saving them in a stack's "+
+		"state. Set to `warn` to warn about them, or to `error` to encrypt them and then fail the update.")
+
 // Environment variables which affect Pulumi AI integrations
 var (
 	AIServiceEndpoint = env.String("AI_SERVICE_ENDPOINT", "Endpoint for Pulumi AI service")
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	cryptorand "crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
@@ -115,7 +116,21 @@ func (p panicCrypter) BulkDecrypt(ctx context.Context, ciphertexts []string) (ma
 // the value itself as a pair of base64 values separated by a colon and a version tag `v1` is prepended.
 func NewSymmetricCrypter(key []byte) Crypter {
 	contract.Requiref(len(key) == SymmetricCrypterKeyBytes, "key", "AES-256-GCM needs a 32 byte key")
-	return &symmetricCrypter{key}
+	return &symmetricCrypter{key: key}
+}
+
+// NewDeterministicSymmetricCrypter creates a crypter that encrypts values like NewSymmetricCrypter, except that the
+// nonce is synthesized from the key and the value, in the manner of AES-SIV, rather than generated randomly. Equal
+// values are thus encrypted to equal ciphertexts, which keeps unchanged secrets from showing up as changes in diffs of
+// configuration and state, at the cost of revealing which secrets have equal values. Its ciphertexts can be decrypted
+// by any symmetric crypter with the same key.
+func NewDeterministicSymmetricCrypter(key []byte) Crypter {
+	contract.Requiref(len(key) == SymmetricCrypterKeyBytes, "key", "AES-256-GCM needs a 32 byte key")
+
+	// Derive a separate key for synthesizing nonces, so that the encryption key is never used for anything else.
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("pulumi deterministic nonce"))
+	return &symmetricCrypter{key: key, nonceKey: mac.Sum(nil)}
 }
 
 // NewSymmetricCrypterFromPassphrase uses a passphrase and salt to generate a key, and then returns a crypter using it.
@@ -131,10 +146,20 @@ const SymmetricCrypterKeyBytes = 32
 
 type symmetricCrypter struct {
 	key []byte
+	// nonceKey, if set, is the key used to synthesize the nonce of each value instead of generating it randomly.
+	nonceKey []byte
 }
 
 func (s symmetricCrypter) EncryptValue(ctx context.Context, value string) (string, error) {
-	secret, nonce := encryptAES256GCGM(value, s.key)
+	var secret, nonce []byte
+	if s.nonceKey != nil {
+		mac := hmac.New(sha256.New, s.nonceKey)
+		mac.Write([]byte(value))
+		nonce = mac.Sum(nil)[:12]
+		secret = sealAES256GCM(value, s.key, nonce)
+	} else {
+		secret, nonce = encryptAES256GCGM(value, s.key)
+	}
 	return fmt.Sprintf("v1:%s:%s",
 		base64.StdEncoding.EncodeToString(nonce), base64.StdEncoding.EncodeToString(secret)), nil
 }
@@ -176,15 +201,20 @@ func encryptAES256GCGM(plaintext string, key []byte) ([]byte, []byte) {
 	_, err := cryptorand.Read(nonce)
 	contract.Assertf(err == nil, "could not read from system random source")
 
+	return sealAES256GCM(plaintext, key, nonce), nonce
+}
+
+// sealAES256GCM returns the ciphertext of plaintext using the given nonce
+func sealAES256GCM(plaintext string, key []byte, nonce []byte) []byte {
+	contract.Requiref(len(key) == SymmetricCrypterKeyBytes, "key", "AES-256-GCM needs a 32 byte key")
+
 	block, err := aes.NewCipher(key)
 	contract.AssertNoErrorf(err, "error creating AES cipher")
 
 	aesgcm, err := cipher.NewGCM(block)
 	contract.AssertNoErrorf(err, "error creating AES-GCM cipher")
 
-	msg := aesgcm.Seal(nil, nonce, []byte(plaintext), nil)
-
-	return msg, nonce
+	return aesgcm.Seal(nil, nonce, []byte(plaintext), nil)
 }
 
 func decryptAES256GCM(ciphertext []byte, key []byte, nonce []byte) (string, error) {
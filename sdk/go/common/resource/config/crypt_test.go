@@ -0,0 +1,58 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeterministicSymmetricCrypter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := make([]byte, SymmetricCrypterKeyBytes)
+	crypter := NewDeterministicSymmetricCrypter(key)
+
+	a1, err := crypter.EncryptValue(ctx, "a")
+	require.NoError(t, err)
+	a2, err := crypter.EncryptValue(ctx, "a")
+	require.NoError(t, err)
+	b, err := crypter.EncryptValue(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, a1, a2)
+	assert.NotEqual(t, a1, b)
+
+	// A different key synthesizes different nonces.
+	other := make([]byte, SymmetricCrypterKeyBytes)
+	other[0] = 1
+	a3, err := NewDeterministicSymmetricCrypter(other).EncryptValue(ctx, "a")
+	require.NoError(t, err)
+	assert.NotEqual(t, a1, a3)
+
+	// The ciphertexts can be decrypted by a non-deterministic crypter with the same key, and vice versa.
+	pt, err := NewSymmetricCrypter(key).DecryptValue(ctx, a1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", pt)
+
+	ct, err := NewSymmetricCrypter(key).EncryptValue(ctx, "c")
+	require.NoError(t, err)
+	pt, err = crypter.DecryptValue(ctx, ct)
+	require.NoError(t, err)
+	assert.Equal(t, "c", pt)
+}
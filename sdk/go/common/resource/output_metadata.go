@@ -0,0 +1,25 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+// OutputMetadata carries documentation and type information for an output property, typically a stack output.
+type OutputMetadata struct {
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Schema is a JSON schema document describing the output's type.
+	Schema string `json:"schema,omitempty" yaml:"schema,omitempty"`
+}
+
+// OutputMetadataMap maps output property names to their metadata.
+type OutputMetadataMap map[PropertyKey]OutputMetadata
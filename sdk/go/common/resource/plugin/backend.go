@@ -0,0 +1,72 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// BackendObject describes an object stored by a backend plugin.
+type BackendObject struct {
+	// Key is the slash-separated key of the object.
+	Key string
+	// Size is the size of the object's content, in bytes.
+	Size int64
+	// ModTime is the time the object was last modified.
+	ModTime time.Time
+	// ContentType is the MIME type of the object's content, if known.
+	ContentType string
+	// IsDir is true if this is a "directory" of objects returned by a List with a delimiter.
+	IsDir bool
+}
+
+type BackendListRequest struct {
+	// Prefix restricts the listing to objects whose keys start with this prefix.
+	Prefix string
+	// Delimiter, if set, groups keys that contain the delimiter after the prefix into directories.
+	Delimiter string
+	// PageSize is the maximum number of objects to return, or 0 to let the plugin choose.
+	PageSize int
+	// PageToken is the token returned by the previous call to List, if any.
+	PageToken string
+}
+
+type BackendListResponse struct {
+	Objects       []BackendObject
+	NextPageToken string
+}
+
+// Backend is a plugin that stores the state of self-managed stacks as opaque objects. Implementations must return an
+// error with a codes.NotFound gRPC status when asked for an object that doesn't exist.
+type Backend interface {
+	io.Closer
+
+	// Configure passes the URL that was logged into to the plugin.
+	Configure(ctx context.Context, url string) error
+	// Attributes returns the attributes of a single object.
+	Attributes(ctx context.Context, key string) (*BackendObject, error)
+	// List returns a page of the objects whose keys start with a prefix, ordered by key.
+	List(ctx context.Context, req *BackendListRequest) (*BackendListResponse, error)
+	// Read returns the content of an object, and its content type if known.
+	Read(ctx context.Context, key string) ([]byte, string, error)
+	// Write creates an object, or replaces an existing one.
+	Write(ctx context.Context, key string, content []byte, contentType string) error
+	// Copy copies an object to another key.
+	Copy(ctx context.Context, dstKey, srcKey string) error
+	// Delete deletes an object.
+	Delete(ctx context.Context, key string) error
+}
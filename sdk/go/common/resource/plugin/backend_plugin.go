@@ -0,0 +1,238 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// backendPlugin reflects a backend plugin, loaded dynamically from another process over gRPC.
+type backendPlugin struct {
+	name      string
+	plug      *plugin                 // the actual plugin process wrapper.
+	clientRaw pulumirpc.BackendClient // the raw backend client; usually unsafe to use directly.
+}
+
+// NewBackend loads the backend plugin with the given name, which is the scheme of the URLs it handles.
+func NewBackend(ctx *Context, name string, version *semver.Version) (Backend, error) {
+	prefix := fmt.Sprintf("%v (backend)", name)
+
+	var projectPlugins []workspace.ProjectPlugin
+	if ctx.Host != nil {
+		projectPlugins = ctx.Host.GetProjectPlugins()
+	}
+
+	// Load the plugin's path by using the standard workspace logic.
+	path, err := workspace.GetPluginPath(ctx.Diag, workspace.BackendPlugin, name, version, projectPlugins)
+	if err != nil {
+		return nil, err
+	}
+
+	contract.Assertf(path != "", "unexpected empty path for plugin %s", name)
+
+	plug, err := newPlugin(ctx, ctx.Pwd, path, prefix,
+		workspace.BackendPlugin, []string{}, os.Environ(), backendPluginDialOptions(ctx, name, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	contract.Assertf(plug != nil, "unexpected nil backend plugin for %s", name)
+
+	return &backendPlugin{
+		name:      name,
+		plug:      plug,
+		clientRaw: pulumirpc.NewBackendClient(plug.Conn),
+	}, nil
+}
+
+func backendPluginDialOptions(ctx *Context, name string, path string) []grpc.DialOption {
+	dialOpts := append(
+		rpcutil.OpenTracingInterceptorDialOptions(otgrpc.SpanDecorator(decorateProviderSpans)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		rpcutil.GrpcChannelOptions(),
+	)
+
+	if ctx.DialOptions != nil {
+		metadata := map[string]interface{}{
+			"mode": "client",
+			"kind": "backend",
+		}
+		if name != "" {
+			metadata["name"] = name
+		}
+		if path != "" {
+			metadata["path"] = path
+		}
+		dialOpts = append(dialOpts, ctx.DialOptions(metadata)...)
+	}
+
+	return dialOpts
+}
+
+// label returns a base label for tracing functions.
+func (b *backendPlugin) label() string {
+	return fmt.Sprintf("Backend[%s, %p]", b.name, b)
+}
+
+// logError logs an error returned by the plugin. The error itself is returned unchanged so that callers can inspect
+// its gRPC status.
+func (b *backendPlugin) logError(label string, err error) error {
+	rpcError := rpcerror.Convert(err)
+	logging.V(8).Infof("%s backend received rpc error `%s`: `%s`", label, rpcError.Code(), rpcError.Message())
+	return err
+}
+
+func (b *backendPlugin) Close() error {
+	if b.plug == nil {
+		return nil
+	}
+	return b.plug.Close()
+}
+
+func (b *backendPlugin) Configure(ctx context.Context, url string) error {
+	label := b.label() + ".Configure"
+	logging.V(7).Infof("%s executing", label)
+
+	if _, err := b.clientRaw.Configure(ctx, &pulumirpc.BackendConfigureRequest{Url: url}); err != nil {
+		return b.logError(label, err)
+	}
+	return nil
+}
+
+func (b *backendPlugin) Attributes(ctx context.Context, key string) (*BackendObject, error) {
+	label := fmt.Sprintf("%s.Attributes(%s)", b.label(), key)
+	logging.V(7).Infof("%s executing", label)
+
+	resp, err := b.clientRaw.Attributes(ctx, &pulumirpc.BackendAttributesRequest{Key: key})
+	if err != nil {
+		return nil, b.logError(label, err)
+	}
+	obj := unmarshalBackendObject(resp)
+	return &obj, nil
+}
+
+func (b *backendPlugin) List(ctx context.Context, req *BackendListRequest) (*BackendListResponse, error) {
+	label := fmt.Sprintf("%s.List(%s)", b.label(), req.Prefix)
+	logging.V(7).Infof("%s executing", label)
+
+	resp, err := b.clientRaw.List(ctx, &pulumirpc.BackendListRequest{
+		Prefix:    req.Prefix,
+		Delimiter: req.Delimiter,
+		PageSize:  int32(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, b.logError(label, err)
+	}
+
+	objects := make([]BackendObject, len(resp.Objects))
+	for i, obj := range resp.Objects {
+		objects[i] = unmarshalBackendObject(obj)
+	}
+	return &BackendListResponse{
+		Objects:       objects,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (b *backendPlugin) Read(ctx context.Context, key string) ([]byte, string, error) {
+	label := fmt.Sprintf("%s.Read(%s)", b.label(), key)
+	logging.V(7).Infof("%s executing", label)
+
+	resp, err := b.clientRaw.Read(ctx, &pulumirpc.BackendReadRequest{Key: key})
+	if err != nil {
+		return nil, "", b.logError(label, err)
+	}
+	return resp.Content, resp.ContentType, nil
+}
+
+func (b *backendPlugin) Write(ctx context.Context, key string, content []byte, contentType string) error {
+	label := fmt.Sprintf("%s.Write(%s)", b.label(), key)
+	logging.V(7).Infof("%s executing", label)
+
+	_, err := b.clientRaw.Write(ctx, &pulumirpc.BackendWriteRequest{
+		Key:         key,
+		Content:     content,
+		ContentType: contentType,
+	})
+	if err != nil {
+		return b.logError(label, err)
+	}
+	return nil
+}
+
+func (b *backendPlugin) Copy(ctx context.Context, dstKey, srcKey string) error {
+	label := fmt.Sprintf("%s.Copy(%s, %s)", b.label(), dstKey, srcKey)
+	logging.V(7).Infof("%s executing", label)
+
+	_, err := b.clientRaw.Copy(ctx, &pulumirpc.BackendCopyRequest{DstKey: dstKey, SrcKey: srcKey})
+	if err != nil {
+		return b.logError(label, err)
+	}
+	return nil
+}
+
+func (b *backendPlugin) Delete(ctx context.Context, key string) error {
+	label := fmt.Sprintf("%s.Delete(%s)", b.label(), key)
+	logging.V(7).Infof("%s executing", label)
+
+	if _, err := b.clientRaw.Delete(ctx, &pulumirpc.BackendDeleteRequest{Key: key}); err != nil {
+		return b.logError(label, err)
+	}
+	return nil
+}
+
+func unmarshalBackendObject(obj *pulumirpc.BackendObject) BackendObject {
+	var modTime time.Time
+	if obj.ModTime != 0 {
+		modTime = time.UnixMilli(obj.ModTime)
+	}
+	return BackendObject{
+		Key:         obj.Key,
+		Size:        obj.Size,
+		ModTime:     modTime,
+		ContentType: obj.ContentType,
+		IsDir:       obj.IsDir,
+	}
+}
+
+func marshalBackendObject(obj BackendObject) *pulumirpc.BackendObject {
+	var modTime int64
+	if !obj.ModTime.IsZero() {
+		modTime = obj.ModTime.UnixMilli()
+	}
+	return &pulumirpc.BackendObject{
+		Key:         obj.Key,
+		Size:        obj.Size,
+		ModTime:     modTime,
+		ContentType: obj.ContentType,
+		IsDir:       obj.IsDir,
+	}
+}
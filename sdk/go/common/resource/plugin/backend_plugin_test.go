@@ -0,0 +1,166 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+type testBackend struct {
+	url     string
+	objects map[string][]byte
+}
+
+func (b *testBackend) Close() error {
+	return nil
+}
+
+func (b *testBackend) Configure(ctx context.Context, url string) error {
+	b.url = url
+	return nil
+}
+
+func (b *testBackend) Attributes(ctx context.Context, key string) (*BackendObject, error) {
+	content, ok := b.objects[key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", key)
+	}
+	return &BackendObject{
+		Key:         key,
+		Size:        int64(len(content)),
+		ModTime:     time.UnixMilli(1700000000000),
+		ContentType: "application/json",
+	}, nil
+}
+
+func (b *testBackend) List(ctx context.Context, req *BackendListRequest) (*BackendListResponse, error) {
+	if req.PageToken != "" {
+		return &BackendListResponse{Objects: []BackendObject{{Key: req.Prefix + "b"}}}, nil
+	}
+	return &BackendListResponse{
+		Objects:       []BackendObject{{Key: req.Prefix + "a", Size: 1}, {Key: req.Prefix + "dir/", IsDir: true}},
+		NextPageToken: "next",
+	}, nil
+}
+
+func (b *testBackend) Read(ctx context.Context, key string) ([]byte, string, error) {
+	content, ok := b.objects[key]
+	if !ok {
+		return nil, "", status.Errorf(codes.NotFound, "%s not found", key)
+	}
+	return content, "application/json", nil
+}
+
+func (b *testBackend) Write(ctx context.Context, key string, content []byte, contentType string) error {
+	b.objects[key] = content
+	return nil
+}
+
+func (b *testBackend) Copy(ctx context.Context, dstKey, srcKey string) error {
+	content, ok := b.objects[srcKey]
+	if !ok {
+		return status.Errorf(codes.NotFound, "%s not found", srcKey)
+	}
+	b.objects[dstKey] = content
+	return nil
+}
+
+func (b *testBackend) Delete(ctx context.Context, key string) error {
+	delete(b.objects, key)
+	return nil
+}
+
+// TestBackendPlugin checks that requests to a backend plugin make it to the backend it serves and back.
+func TestBackendPlugin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := &testBackend{objects: map[string][]byte{}}
+
+	cancel := make(chan bool)
+	handle, err := rpcutil.ServeWithOptions(rpcutil.ServeOptions{
+		Cancel: cancel,
+		Init: func(srv *grpc.Server) error {
+			pulumirpc.RegisterBackendServer(srv, NewBackendServer(server))
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		close(cancel)
+		assert.NoError(t, <-handle.Done)
+	})
+
+	conn, err := grpc.Dial(
+		fmt.Sprintf("127.0.0.1:%d", handle.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		rpcutil.GrpcChannelOptions(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	client := &backendPlugin{name: "test", clientRaw: pulumirpc.NewBackendClient(conn)}
+
+	require.NoError(t, client.Configure(ctx, "test://bucket/path"))
+	assert.Equal(t, "test://bucket/path", server.url)
+
+	require.NoError(t, client.Write(ctx, "a", []byte("hello"), "text/plain"))
+	content, contentType, err := client.Read(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+	assert.Equal(t, "application/json", contentType)
+
+	obj, err := client.Attributes(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, BackendObject{
+		Key:         "a",
+		Size:        5,
+		ModTime:     time.UnixMilli(1700000000000),
+		ContentType: "application/json",
+	}, *obj)
+
+	require.NoError(t, client.Copy(ctx, "b", "a"))
+	assert.Equal(t, []byte("hello"), server.objects["b"])
+	require.NoError(t, client.Delete(ctx, "a"))
+	assert.NotContains(t, server.objects, "a")
+
+	_, _, err = client.Read(ctx, "a")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	list, err := client.List(ctx, &BackendListRequest{Prefix: "p/", Delimiter: "/"})
+	require.NoError(t, err)
+	assert.Equal(t, &BackendListResponse{
+		Objects:       []BackendObject{{Key: "p/a", Size: 1}, {Key: "p/dir/", IsDir: true}},
+		NextPageToken: "next",
+	}, list)
+
+	list, err = client.List(ctx, &BackendListRequest{Prefix: "p/", PageToken: list.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []BackendObject{{Key: "p/b"}}, list.Objects)
+	assert.Empty(t, list.NextPageToken)
+}
@@ -0,0 +1,116 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+type backendServer struct {
+	pulumirpc.UnsafeBackendServer // opt out of forward compat
+
+	backend Backend
+}
+
+// NewBackendServer returns a gRPC server that serves the given backend, for use by backend plugins written in Go.
+func NewBackendServer(backend Backend) pulumirpc.BackendServer {
+	return &backendServer{backend: backend}
+}
+
+func (b *backendServer) Configure(ctx context.Context,
+	req *pulumirpc.BackendConfigureRequest,
+) (*pbempty.Empty, error) {
+	if err := b.backend.Configure(ctx, req.Url); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (b *backendServer) Attributes(ctx context.Context,
+	req *pulumirpc.BackendAttributesRequest,
+) (*pulumirpc.BackendObject, error) {
+	obj, err := b.backend.Attributes(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return marshalBackendObject(*obj), nil
+}
+
+func (b *backendServer) List(ctx context.Context,
+	req *pulumirpc.BackendListRequest,
+) (*pulumirpc.BackendListResponse, error) {
+	resp, err := b.backend.List(ctx, &BackendListRequest{
+		Prefix:    req.Prefix,
+		Delimiter: req.Delimiter,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*pulumirpc.BackendObject, len(resp.Objects))
+	for i, obj := range resp.Objects {
+		objects[i] = marshalBackendObject(obj)
+	}
+	return &pulumirpc.BackendListResponse{
+		Objects:       objects,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (b *backendServer) Read(ctx context.Context,
+	req *pulumirpc.BackendReadRequest,
+) (*pulumirpc.BackendReadResponse, error) {
+	content, contentType, err := b.backend.Read(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.BackendReadResponse{
+		Content:     content,
+		ContentType: contentType,
+	}, nil
+}
+
+func (b *backendServer) Write(ctx context.Context,
+	req *pulumirpc.BackendWriteRequest,
+) (*pbempty.Empty, error) {
+	if err := b.backend.Write(ctx, req.Key, req.Content, req.ContentType); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (b *backendServer) Copy(ctx context.Context,
+	req *pulumirpc.BackendCopyRequest,
+) (*pbempty.Empty, error) {
+	if err := b.backend.Copy(ctx, req.DstKey, req.SrcKey); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (b *backendServer) Delete(ctx context.Context,
+	req *pulumirpc.BackendDeleteRequest,
+) (*pbempty.Empty, error) {
+	if err := b.backend.Delete(ctx, req.Key); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
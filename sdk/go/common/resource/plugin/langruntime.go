@@ -113,4 +113,5 @@ type RunInfo struct {
 	QueryMode         bool                  // true if we're only doing a query.
 	Parallel          int                   // the degree of parallelism for resource operations (<=1 for serial).
 	Organization      string                // the organization name housing the program being run (might be empty).
+	StackTags         map[string]string     // the tags of the stack being evaluated (might be empty).
 }
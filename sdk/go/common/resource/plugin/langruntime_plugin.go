@@ -239,6 +239,7 @@ func (h *langhost) Run(info RunInfo) (string, bool, error) {
 		QueryMode:         info.QueryMode,
 		Parallel:          int32(info.Parallel),
 		Organization:      info.Organization,
+		StackTags:         info.StackTags,
 		Info: &pulumirpc.ProgramInfo{
 			RootDirectory:    h.root,
 			ProgramDirectory: info.Pwd,
@@ -110,6 +110,10 @@ type Provider interface {
 	// error) if it doesn't have any mappings for the given key.
 	// If a provider implements this method GetMapping will be called using the results from this method.
 	GetMappings(key string) ([]string, error)
+
+	// Discover enumerates the resources that the provider is able to import and that match the given request.
+	// Providers that do not support discovery return an error.
+	Discover(req DiscoverRequest) (DiscoverResult, error)
 }
 
 type GrpcProvider interface {
@@ -417,6 +421,27 @@ type ConstructResult struct {
 	OutputDependencies map[resource.PropertyKey][]resource.URN
 }
 
+// DiscoverRequest selects the resources for a provider to discover.
+type DiscoverRequest struct {
+	Types   []tokens.Type     // the types of resources to discover, or all types the provider supports if empty.
+	Filters map[string]string // provider-specific filters that the discovered resources must match.
+}
+
+// DiscoveredResource is a resource that a provider is able to import.
+type DiscoveredResource struct {
+	Type tokens.Type // the type token of the resource.
+	Name string      // a suggested name for the resource.
+	ID   resource.ID // the ID to import the resource with.
+}
+
+// DiscoverResult is the result of a call to Discover.
+type DiscoverResult struct {
+	Resources []DiscoveredResource // the discovered resources.
+	// the types whose resources were all enumerated, so that a resource of one of these types that wasn't discovered
+	// doesn't exist. Types that the provider could only partly enumerate, or not at all, are left out.
+	Types []tokens.Type
+}
+
 // CallInfo contains all of the information required to register resources as part of a call to Construct.
 type CallInfo struct {
 	Project        string                // the project name housing the program being run.
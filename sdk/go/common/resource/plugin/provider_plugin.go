@@ -23,6 +23,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
@@ -256,6 +257,33 @@ func (p *provider) requestContext() context.Context {
 	return p.ctx.Request()
 }
 
+// operationTimeoutGrace is how long past a resource's custom timeout a provider is given to report that its Create,
+// Update or Delete timed out before the engine cancels the call.
+var operationTimeoutGrace = 30 * time.Second
+
+// operationContext returns the request context for a Create, Update or Delete call with the given custom timeout, in
+// seconds. If the timeout is positive, the context is cancelled once it and the grace period have elapsed, which
+// cancels the in-flight RPC.
+func (p *provider) operationContext(timeout float64) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(p.requestContext())
+	}
+	return context.WithTimeout(p.requestContext(), time.Duration(timeout*float64(time.Second))+operationTimeoutGrace)
+}
+
+// operationTimeout returns an OperationTimeoutError if the given Create, Update or Delete call failed because its
+// context's deadline was exceeded, and nil otherwise.
+func operationTimeout(ctx context.Context, urn resource.URN, operation string, timeout float64) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return &OperationTimeoutError{
+		URN:       urn,
+		Operation: operation,
+		Timeout:   time.Duration(timeout * float64(time.Second)),
+	}
+}
+
 // isDiffCheckConfigLogicallyUnimplemented returns true when an rpcerror.Error should be treated as if it was an error
 // due to a rpc being unimplemented. Due to past mistakes, different providers returned "Unimplemented" in a variaity of
 // different ways that don't always result in an Uimplemented error code.
@@ -977,13 +1005,19 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout
 	var liveObject *_struct.Struct
 	var resourceError error
 	resourceStatus := resource.StatusOK
-	resp, err := client.Create(p.requestContext(), &pulumirpc.CreateRequest{
+	ctx, cancel := p.operationContext(timeout)
+	defer cancel()
+	resp, err := client.Create(ctx, &pulumirpc.CreateRequest{
 		Urn:        string(urn),
 		Properties: mprops,
 		Timeout:    timeout,
 		Preview:    preview,
 	})
 	if err != nil {
+		if timeoutErr := operationTimeout(ctx, urn, "create", timeout); timeoutErr != nil {
+			logging.V(7).Infof("%s failed: %v", label, timeoutErr)
+			return "", nil, resource.StatusUnknown, timeoutErr
+		}
 		resourceStatus, id, liveObject, _, resourceError = parseError(err)
 		logging.V(7).Infof("%s failed: %v", label, resourceError)
 
@@ -1230,7 +1264,9 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 	var liveObject *_struct.Struct
 	var resourceError error
 	resourceStatus := resource.StatusOK
-	resp, err := client.Update(p.requestContext(), &pulumirpc.UpdateRequest{
+	ctx, cancel := p.operationContext(timeout)
+	defer cancel()
+	resp, err := client.Update(ctx, &pulumirpc.UpdateRequest{
 		Id:            string(id),
 		Urn:           string(urn),
 		Olds:          mOldOutputs,
@@ -1241,6 +1277,10 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		OldInputs:     mOldInputs,
 	})
 	if err != nil {
+		if timeoutErr := operationTimeout(ctx, urn, "update", timeout); timeoutErr != nil {
+			logging.V(7).Infof("%s failed: %v", label, timeoutErr)
+			return nil, resource.StatusUnknown, timeoutErr
+		}
 		resourceStatus, _, liveObject, _, resourceError = parseError(err)
 		logging.V(7).Infof("%s failed: %v", label, resourceError)
 
@@ -1319,13 +1359,19 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, oldInputs, oldOutput
 	// We should only be calling {Create,Update,Delete} if the provider is fully configured.
 	contract.Assertf(pcfg.known, "Delete cannot be called if the configuration is unknown")
 
-	if _, err := client.Delete(p.requestContext(), &pulumirpc.DeleteRequest{
+	ctx, cancel := p.operationContext(timeout)
+	defer cancel()
+	if _, err := client.Delete(ctx, &pulumirpc.DeleteRequest{
 		Id:         string(id),
 		Urn:        string(urn),
 		Properties: moutputs,
 		Timeout:    timeout,
 		OldInputs:  minputs,
 	}); err != nil {
+		if timeoutErr := operationTimeout(ctx, urn, "delete", timeout); timeoutErr != nil {
+			logging.V(7).Infof("%s failed: %v", label, timeoutErr)
+			return resource.StatusUnknown, timeoutErr
+		}
 		resourceStatus, rpcErr := resourceStateAndError(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcErr)
 		return resourceStatus, rpcErr
@@ -1887,6 +1933,25 @@ func (ie *InitError) Error() string {
 	return err.Error()
 }
 
+// OperationTimeoutError is returned when a provider doesn't finish creating, updating or deleting a resource within the
+// resource's custom timeout. The call to the provider is cancelled, but the operation may already have taken effect,
+// so the resource's state is unknown until it's refreshed.
+type OperationTimeoutError struct {
+	// URN is the URN of the resource.
+	URN resource.URN
+	// Operation is the operation that timed out: "create", "update" or "delete".
+	Operation string
+	// Timeout is the resource's custom timeout for the operation.
+	Timeout time.Duration
+}
+
+var _ error = (*OperationTimeoutError)(nil)
+
+func (e *OperationTimeoutError) Error() string {
+	return fmt.Sprintf("%s of %s timed out after %v and was cancelled; it may still have taken effect, "+
+		"run `pulumi refresh` to reconcile the resource's state", e.Operation, e.URN, e.Timeout)
+}
+
 func decorateSpanWithType(span opentracing.Span, urn string) {
 	if urn := resource.URN(urn); urn.IsValid() {
 		span.SetTag("pulumi-decorator", urn.Type())
@@ -1966,3 +2031,37 @@ func (p *provider) GetMappings(key string) ([]string, error) {
 	}
 	return resp.Providers, nil
 }
+
+func (p *provider) Discover(req DiscoverRequest) (DiscoverResult, error) {
+	label := p.label() + ".Discover"
+	logging.V(7).Infof("%s executing: types=%v, filters=%v", label, req.Types, req.Filters)
+
+	types := make([]string, len(req.Types))
+	for i, t := range req.Types {
+		types[i] = string(t)
+	}
+	resp, err := p.clientRaw.Discover(p.requestContext(), &pulumirpc.DiscoverRequest{
+		Types:   types,
+		Filters: req.Filters,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented", label)
+			return DiscoverResult{}, fmt.Errorf("the %v provider does not support resource discovery", p.pkg)
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError)
+		return DiscoverResult{}, rpcError
+	}
+
+	resources := make([]DiscoveredResource, len(resp.GetResources()))
+	for i, r := range resp.GetResources() {
+		resources[i] = DiscoveredResource{Type: tokens.Type(r.GetType()), Name: r.GetName(), ID: resource.ID(r.GetId())}
+	}
+	listed := make([]tokens.Type, len(resp.GetTypes()))
+	for i, t := range resp.GetTypes() {
+		listed[i] = tokens.Type(t)
+	}
+	logging.V(7).Infof("%s success: #resources=%d, types=%v", label, len(resources), listed)
+	return DiscoverResult{Resources: resources, Types: listed}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/stretchr/testify/assert"
@@ -588,6 +589,37 @@ func TestProvider_ConfigureDeleteRace(t *testing.T) {
 	}, s.StructValue.Fields["value"].GetKind())
 }
 
+//nolint:paralleltest // modifies operationTimeoutGrace
+func TestProvider_CreateTimeout(t *testing.T) {
+	grace := operationTimeoutGrace
+	operationTimeoutGrace = 0
+	defer func() { operationTimeoutGrace = grace }()
+
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{}, nil
+		},
+		CreateF: func(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+			// Hang until the call is cancelled, as a provider that ignores its timeout would.
+			<-ctx.Done()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		},
+	}
+
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	urn := resource.NewURN("org/proj/dev", "foo", "", "bar:baz", "qux")
+	_, _, rst, err := p.Create(urn, resource.PropertyMap{}, 0.01, false /* preview */)
+	assert.Equal(t, resource.StatusUnknown, rst)
+
+	var timeoutErr *OperationTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, urn, timeoutErr.URN)
+	assert.Equal(t, "create", timeoutErr.Operation)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+}
+
 // newTestContext builds a *Context for use in tests.
 func newTestContext(t testing.TB) *Context {
 	t.Helper()
@@ -611,6 +643,7 @@ type stubClient struct {
 	ConstructF  func(*pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error)
 	ConfigureF  func(*pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error)
 	DeleteF     func(*pulumirpc.DeleteRequest) error
+	CreateF     func(context.Context, *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error)
 }
 
 func (c *stubClient) DiffConfig(
@@ -646,6 +679,17 @@ func (c *stubClient) Configure(
 	return c.ResourceProviderClient.Configure(ctx, req, opts...)
 }
 
+func (c *stubClient) Create(
+	ctx context.Context,
+	req *pulumirpc.CreateRequest,
+	opts ...grpc.CallOption,
+) (*pulumirpc.CreateResponse, error) {
+	if f := c.CreateF; f != nil {
+		return f(ctx, req)
+	}
+	return c.ResourceProviderClient.Create(ctx, req, opts...)
+}
+
 func (c *stubClient) Delete(
 	ctx context.Context,
 	req *pulumirpc.DeleteRequest,
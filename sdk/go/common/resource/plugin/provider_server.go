@@ -664,3 +664,26 @@ func (p *providerServer) GetMappings(ctx context.Context,
 	}
 	return &pulumirpc.GetMappingsResponse{Providers: providers}, nil
 }
+
+func (p *providerServer) Discover(ctx context.Context,
+	req *pulumirpc.DiscoverRequest,
+) (*pulumirpc.DiscoverResponse, error) {
+	types := make([]tokens.Type, len(req.GetTypes()))
+	for i, t := range req.GetTypes() {
+		types[i] = tokens.Type(t)
+	}
+	discovered, err := p.provider.Discover(DiscoverRequest{Types: types, Filters: req.GetFilters()})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*pulumirpc.DiscoveredResource, len(discovered.Resources))
+	for i, r := range discovered.Resources {
+		resources[i] = &pulumirpc.DiscoveredResource{Type: string(r.Type), Name: r.Name, Id: string(r.ID)}
+	}
+	listed := make([]string, len(discovered.Types))
+	for i, t := range discovered.Types {
+		listed[i] = string(t)
+	}
+	return &pulumirpc.DiscoverResponse{Resources: resources, Types: listed}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,15 @@ type stubProvider struct {
 	) (ReadResult, resource.Status, error)
 
 	ConfigureFunc func(resource.PropertyMap) error
+
+	DiscoverFunc func(DiscoverRequest) (DiscoverResult, error)
+}
+
+func (p *stubProvider) Discover(req DiscoverRequest) (DiscoverResult, error) {
+	if p.DiscoverFunc != nil {
+		return p.DiscoverFunc(req)
+	}
+	return p.Provider.Discover(req)
 }
 
 func (p *stubProvider) Configure(inputs resource.PropertyMap) error {
@@ -72,6 +82,37 @@ func (p *stubProvider) Read(
 	return p.Provider.Read(urn, id, inputs, state)
 }
 
+// Validate that Discover passes the requested types and filters to the provider and returns what it discovers,
+// along with the types it enumerated.
+func TestProviderServer_Discover(t *testing.T) {
+	t.Parallel()
+
+	provider := stubProvider{
+		DiscoverFunc: func(req DiscoverRequest) (DiscoverResult, error) {
+			assert.Equal(t, DiscoverRequest{
+				Types:   []tokens.Type{"aws:s3/bucket:Bucket"},
+				Filters: map[string]string{"tag:env": "dev"},
+			}, req)
+			return DiscoverResult{
+				Resources: []DiscoveredResource{{Type: "aws:s3/bucket:Bucket", Name: "logs", ID: "logs-1234"}},
+				Types:     []tokens.Type{"aws:s3/bucket:Bucket"},
+			}, nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+
+	resp, err := srv.Discover(context.Background(), &pulumirpc.DiscoverRequest{
+		Types:   []string{"aws:s3/bucket:Bucket"},
+		Filters: map[string]string{"tag:env": "dev"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Resources, 1)
+	assert.Equal(t, "aws:s3/bucket:Bucket", resp.Resources[0].Type)
+	assert.Equal(t, "logs", resp.Resources[0].Name)
+	assert.Equal(t, "logs-1234", resp.Resources[0].Id)
+	assert.Equal(t, []string{"aws:s3/bucket:Bucket"}, resp.Types)
+}
+
 // When importing random passwords, the secret passed as "ID" should not leak in plain text into the final ID.
 func TestProviderServer_Read_respects_ID(t *testing.T) {
 	t.Parallel()
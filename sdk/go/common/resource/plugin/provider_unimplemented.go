@@ -105,3 +105,7 @@ func (p *UnimplementedProvider) GetMapping(key, provider string) ([]byte, string
 func (p *UnimplementedProvider) GetMappings(key string) ([]string, error) {
 	return nil, status.Error(codes.Unimplemented, "GetMappings is not yet implemented")
 }
+
+func (p *UnimplementedProvider) Discover(req DiscoverRequest) (DiscoverResult, error) {
+	return DiscoverResult{}, status.Error(codes.Unimplemented, "Discover is not yet implemented")
+}
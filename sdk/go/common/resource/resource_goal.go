@@ -43,7 +43,9 @@ type Goal struct {
 	// if set, the providers Delete method will not be called for this resource
 	// if specified resource is being deleted as well.
 	DeletedWith    URN
-	SourcePosition string // If set, the source location of the resource registration
+	SourcePosition string      // If set, the source location of the resource registration
+	RetryPolicy    RetryPolicy // an optional policy for retrying failed CRUD operations.
+	Frozen         bool        // true to freeze this resource, so that it can't be updated, replaced or deleted.
 }
 
 // NewGoal allocates a new resource goal state.
@@ -52,6 +54,7 @@ func NewGoal(t tokens.Type, name string, custom bool, props PropertyMap,
 	propertyDependencies map[PropertyKey][]URN, deleteBeforeReplace *bool, ignoreChanges []string,
 	additionalSecretOutputs []PropertyKey, aliases []Alias, id ID, customTimeouts *CustomTimeouts,
 	replaceOnChanges []string, retainOnDelete bool, deletedWith URN, sourcePosition string,
+	retryPolicy *RetryPolicy,
 ) *Goal {
 	g := &Goal{
 		Type:                    t,
@@ -79,5 +82,9 @@ func NewGoal(t tokens.Type, name string, custom bool, props PropertyMap,
 		g.CustomTimeouts = *customTimeouts
 	}
 
+	if retryPolicy != nil {
+		g.RetryPolicy = *retryPolicy
+	}
+
 	return g
 }
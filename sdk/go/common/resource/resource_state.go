@@ -36,6 +36,7 @@ type State struct {
 	Outputs                 PropertyMap           // the resource's complete output state (as returned by the resource provider).
 	Parent                  URN                   // an optional parent URN that this resource belongs to.
 	Protect                 bool                  // true to "protect" this resource (protected resources cannot be deleted).
+	Frozen                  bool                  // true to freeze this resource, so that it can't be changed or deleted.
 	External                bool                  // true if this resource is "external" to Pulumi and we don't control the lifecycle.
 	Dependencies            []URN                 // the resource's dependencies.
 	InitErrors              []string              // the set of errors encountered in the process of initializing resource.
@@ -51,6 +52,11 @@ type State struct {
 	Created                 *time.Time            // If set, the time when the state was initially added to the state file. (i.e. Create, Import)
 	Modified                *time.Time            // If set, the time when the state was last modified in the state file.
 	SourcePosition          string                // If set, the source location of the resource registration
+	RetryPolicy             RetryPolicy           // A config block that configures retries of failed CRUD operations.
+
+	// OutputMetadata holds optional documentation and type information for outputs, keyed by output name.
+	// It is set by RegisterResourceOutputs, typically for stack outputs.
+	OutputMetadata OutputMetadataMap
 }
 
 func (s *State) GetAliasURNs() []URN {
@@ -72,7 +78,7 @@ func NewState(t tokens.Type, urn URN, custom bool, del bool, id ID,
 	propertyDependencies map[PropertyKey][]URN, pendingReplacement bool,
 	additionalSecretOutputs []PropertyKey, aliases []URN, timeouts *CustomTimeouts,
 	importID ID, retainOnDelete bool, deletedWith URN, created *time.Time, modified *time.Time,
-	sourcePosition string,
+	sourcePosition string, retryPolicy *RetryPolicy,
 ) *State {
 	contract.Assertf(t != "", "type was empty")
 	contract.Assertf(custom || id == "", "is custom or had empty ID")
@@ -108,5 +114,9 @@ func NewState(t tokens.Type, urn URN, custom bool, del bool, id ID,
 		s.CustomTimeouts = *timeouts
 	}
 
+	if retryPolicy != nil {
+		s.RetryPolicy = *retryPolicy
+	}
+
 	return s
 }
@@ -0,0 +1,30 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+// RetryPolicy configures how the engine retries a resource's CRUD operations when the provider reports an error.
+// Delays are expressed in seconds.
+type RetryPolicy struct {
+	MaxRetries   int     `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
+	InitialDelay float64 `json:"initialDelay,omitempty" yaml:"initialDelay,omitempty"`
+	MaxDelay     float64 `json:"maxDelay,omitempty" yaml:"maxDelay,omitempty"`
+	// RetryOn holds regular expressions matching the error messages to retry. If it is empty, every failure is
+	// retried.
+	RetryOn []string `json:"retryOn,omitempty" yaml:"retryOn,omitempty"`
+}
+
+func (p *RetryPolicy) IsNotEmpty() bool {
+	return p.MaxRetries != 0 || p.InitialDelay != 0 || p.MaxDelay != 0 || len(p.RetryOn) != 0
+}
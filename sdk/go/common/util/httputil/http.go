@@ -17,6 +17,7 @@ package httputil
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -32,6 +33,9 @@ type RetryOpts struct {
 	MaxDelay *time.Duration
 
 	MaxRetryCount *int
+
+	// RetryableStatusCodes are the response status codes that are retried. Leave nil to retry any 5xx response.
+	RetryableStatusCodes []int
 }
 
 // DoWithRetry calls client.Do, and in the case of an error, retries the operation again after a slight delay.
@@ -61,13 +65,30 @@ func doWithRetry(req *http.Request, client *http.Client, opts RetryOpts) (*http.
 		maxRetryCount = *opts.MaxRetryCount
 	}
 
+	retryable := func(code int) bool {
+		if opts.RetryableStatusCodes == nil {
+			return inRange(code, 500, 599)
+		}
+		for _, c := range opts.RetryableStatusCodes {
+			if c == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	maxDelay := retry.DefaultMaxDelay
+	if opts.MaxDelay != nil {
+		maxDelay = *opts.MaxDelay
+	}
+
 	acceptor := retry.Acceptor{
 		// If the opts field is nil, retry.Until will provide defaults.
 		Delay:    opts.Delay,
 		Backoff:  opts.Backoff,
 		MaxDelay: opts.MaxDelay,
 
-		Accept: func(try int, _ time.Duration) (bool, interface{}, error) {
+		Accept: func(try int, nextRetryTime time.Duration) (bool, interface{}, error) {
 			if try > 0 && req.GetBody != nil {
 				// Reset request body, if present, for retries.
 				rc, bodyErr := req.GetBody()
@@ -78,7 +99,7 @@ func doWithRetry(req *http.Request, client *http.Client, opts RetryOpts) (*http.
 			}
 
 			res, resErr := client.Do(req)
-			if resErr == nil && !inRange(res.StatusCode, 500, 599) {
+			if resErr == nil && !retryable(res.StatusCode) {
 				return true, res, nil
 			}
 			if try >= (maxRetryCount - 1) {
@@ -88,6 +109,16 @@ func doWithRetry(req *http.Request, client *http.Client, opts RetryOpts) (*http.
 			// Close the response body, if present, since our caller can't.
 			if resErr == nil {
 				contract.IgnoreError(res.Body.Close())
+
+				// If the server asked us to wait longer than we were going to, wait out the difference before
+				// the next try.
+				if wait := retryAfter(res, maxDelay) - nextRetryTime; wait > 0 {
+					select {
+					case <-time.After(wait):
+					case <-req.Context().Done():
+						return true, nil, req.Context().Err()
+					}
+				}
 			}
 			return false, nil, nil
 		},
@@ -100,6 +131,27 @@ func doWithRetry(req *http.Request, client *http.Client, opts RetryOpts) (*http.
 	return res.(*http.Response), nil
 }
 
+// retryAfter returns how long the response's Retry-After header asks clients to wait before retrying, capped at
+// maxDelay. It returns zero if the header is missing or malformed.
+func retryAfter(res *http.Response, maxDelay time.Duration) time.Duration {
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(header); err == nil {
+		wait = time.Until(at)
+	}
+
+	if wait > maxDelay {
+		return maxDelay
+	}
+	return wait
+}
+
 // GetWithRetry issues a GET request with the given client, and in the case of an error, retries the operation again
 // after a slight delay.
 func GetWithRetry(url string, client *http.Client) (*http.Response, error) {
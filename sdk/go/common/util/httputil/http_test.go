@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/http2"
 
@@ -95,3 +96,42 @@ func TestRetryPostHTTP2(t *testing.T) {
 	assert.Equal(t, 2, tries)
 	assert.Equal(t, 200, res.StatusCode)
 }
+
+// Test that DoWithRetryOpts only retries the given status codes, and waits for as long as Retry-After asks.
+func TestRetryStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		tries++
+		switch tries {
+		case 1:
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	req, err := http.NewRequest("GET", server.URL, nil)
+	assert.NoError(t, err)
+
+	delay := time.Millisecond
+	start := time.Now()
+	res, err := DoWithRetryOpts(req, server.Client(), RetryOpts{
+		Delay:                &delay,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests},
+	})
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	// The 429 is retried after the second it asked for, but the 500 isn't retried at all.
+	assert.Equal(t, 2, tries)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
@@ -80,15 +80,8 @@ func DeleteAccount(key string) error {
 }
 
 func DeleteAllAccounts() error {
-	credsFile, err := getCredsFilePath()
-	if err != nil {
-		return err
-	}
-
-	if err = os.Remove(credsFile); err != nil && !os.IsNotExist(err) {
-		return err
-	}
-	return nil
+	// Storing no credentials removes the credentials file and any tokens held in a credential store.
+	return StoreCredentials(Credentials{})
 }
 
 // StoreAccount saves the given account underneath the given key.
@@ -124,6 +117,8 @@ type Account struct {
 	Insecure bool `json:"insecure,omitempty"`
 	// Information about the token used to authenticate.
 	TokenInformation *TokenInformation `json:"tokenInformation,omitempty"`
+	// Whether the backend was logged in to in read-only mode, in which nothing may write to the state of its stacks.
+	ReadOnly bool `json:"readOnly,omitempty"`
 }
 
 // Information about the token that was used to authenticate the current user. One (or none) of Team or Organization
@@ -140,6 +135,8 @@ type Credentials struct {
 	Current      string             `json:"current,omitempty"`      // the currently selected key.
 	AccessTokens map[string]string  `json:"accessTokens,omitempty"` // a map of arbitrary key strings to tokens.
 	Accounts     map[string]Account `json:"accounts,omitempty"`     // a map of arbitrary keys to account info.
+	// the credential store the access tokens are kept in, or empty if they're kept in the credentials file.
+	Store string `json:"credentialsStore,omitempty"`
 }
 
 // getCredsFilePath returns the path to the Pulumi credentials file on disk, regardless of
@@ -203,13 +200,31 @@ func GetCloudInsecure(cloudURL string) bool {
 	return insecure
 }
 
-// GetStoredCredentials returns any credentials stored on the local machine.
-func GetStoredCredentials() (Credentials, error) {
-	credsFile, err := getCredsFilePath()
-	if err != nil {
-		return Credentials{}, err
+// GetCloudReadOnly returns true if the given backend was logged in to in read-only mode.
+func GetCloudReadOnly(cloudURL string) bool {
+	readOnly := false
+	creds, err := GetStoredCredentials()
+	// If this errors just assume readOnly == false
+	if err == nil {
+		if account, has := creds.Accounts[cloudURL]; has {
+			readOnly = account.ReadOnly
+		}
 	}
+	return readOnly
+}
 
+// credentialStoreName returns the name of the credential store selected by the given name, which is empty for the
+// credentials file.
+func credentialStoreName(name string) string {
+	if name == "file" {
+		return ""
+	}
+	return name
+}
+
+// readCredentialsFile reads the given credentials file. The access tokens of credentials kept in a credential store
+// are left empty.
+func readCredentialsFile(credsFile string) (Credentials, error) {
 	c, err := lockedfile.Read(credsFile)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -223,6 +238,59 @@ func GetStoredCredentials() (Credentials, error) {
 		return Credentials{}, fmt.Errorf("failed to read Pulumi credentials file. Please re-run "+
 			"`pulumi login` to reset your credentials file: %w", err)
 	}
+	return creds, nil
+}
+
+// loadStoredTokens fills in the access tokens of credentials kept in a credential store.
+func loadStoredTokens(creds *Credentials) error {
+	store, err := NewCredentialStore(creds.Store)
+	if err != nil || store == nil {
+		return err
+	}
+	for key := range creds.AccessTokens {
+		token, err := store.Get(key)
+		if err != nil {
+			return fmt.Errorf("reading the access token for %s from the %s credential store: %w", key, store.Name(), err)
+		}
+		if token == "" {
+			logging.V(5).Infof("no access token for %s in the %s credential store", key, store.Name())
+		}
+		creds.AccessTokens[key] = token
+		if account, ok := creds.Accounts[key]; ok {
+			account.AccessToken = token
+			creds.Accounts[key] = account
+		}
+	}
+	return nil
+}
+
+// GetStoredCredentials returns any credentials stored on the local machine.
+//
+// If PulumiCredentialsStoreEnvVar selects a different credential store to the one the credentials are kept in, they
+// are moved into it.
+func GetStoredCredentials() (Credentials, error) {
+	credsFile, err := getCredsFilePath()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	creds, err := readCredentialsFile(credsFile)
+	if err != nil {
+		return Credentials{}, err
+	}
+	if err := loadStoredTokens(&creds); err != nil {
+		return Credentials{}, err
+	}
+
+	if selected := os.Getenv(PulumiCredentialsStoreEnvVar); selected != "" {
+		if name := credentialStoreName(selected); name != creds.Store && len(creds.AccessTokens) != 0 {
+			creds.Store = name
+			if err := StoreCredentials(creds); err != nil {
+				return Credentials{}, fmt.Errorf("moving credentials to the %s credential store: %w", selected, err)
+			}
+			logging.V(5).Infof("moved credentials to the %s credential store", selected)
+		}
+	}
 
 	secrets := slice.Prealloc[string](len(creds.AccessTokens))
 	for _, v := range creds.AccessTokens {
@@ -236,26 +304,80 @@ func GetStoredCredentials() (Credentials, error) {
 
 // StoreCredentials updates the stored credentials on the machine, replacing the existing set.  If the credentials
 // are empty, the auth file will be deleted rather than just serializing an empty map.
+//
+// The access tokens are kept in the credential store selected by PulumiCredentialsStoreEnvVar, or if that isn't set,
+// the one the credentials name or were last kept in. Only the keys of tokens kept in a credential store are written to
+// the credentials file.
 func StoreCredentials(creds Credentials) error {
 	credsFile, err := getCredsFilePath()
 	if err != nil {
 		return err
 	}
 
+	// Tokens that the previous credential store holds but no longer should are erased from it once the credentials
+	// file no longer refers to them.
+	prev, err := readCredentialsFile(credsFile)
+	if err != nil {
+		logging.V(5).Infof("error reading previous credentials, not erasing tokens from its credential store: %v", err)
+		prev = Credentials{}
+	}
+
+	name := creds.Store
+	if selected := os.Getenv(PulumiCredentialsStoreEnvVar); selected != "" {
+		name = credentialStoreName(selected)
+	} else if name == "" {
+		name = prev.Store
+	}
+	store, err := NewCredentialStore(name)
+	if err != nil {
+		return err
+	}
+
 	if len(creds.AccessTokens) == 0 {
 		err = os.Remove(credsFile)
 		if err != nil && !os.IsNotExist(err) {
 			return err
 		}
-		return nil
-	}
+	} else {
+		onDisk := creds
+		onDisk.Store = name
+		if store != nil {
+			onDisk.AccessTokens = make(map[string]string, len(creds.AccessTokens))
+			for key, token := range creds.AccessTokens {
+				if err := store.Store(key, token); err != nil {
+					return fmt.Errorf("storing the access token for %s in the %s credential store: %w", key, name, err)
+				}
+				onDisk.AccessTokens[key] = ""
+			}
+			onDisk.Accounts = make(map[string]Account, len(creds.Accounts))
+			for key, account := range creds.Accounts {
+				account.AccessToken = ""
+				onDisk.Accounts[key] = account
+			}
+		}
 
-	raw, err := json.MarshalIndent(creds, "", "    ")
-	if err != nil {
-		return fmt.Errorf("marshalling credentials object: %w", err)
+		raw, err := json.MarshalIndent(onDisk, "", "    ")
+		if err != nil {
+			return fmt.Errorf("marshalling credentials object: %w", err)
+		}
+		if err := lockedfile.Write(credsFile, bytes.NewReader(raw), 0o600); err != nil {
+			return err
+		}
 	}
 
-	return lockedfile.Write(credsFile, bytes.NewReader(raw), 0o600)
+	prevStore, err := NewCredentialStore(prev.Store)
+	if err != nil || prevStore == nil {
+		return nil
+	}
+	for key := range prev.AccessTokens {
+		if _, kept := creds.AccessTokens[key]; kept && prev.Store == name {
+			continue
+		}
+		if err := prevStore.Erase(key); err != nil {
+			return fmt.Errorf("erasing the access token for %s from the %s credential store: %w", key, prev.Store, err)
+		}
+	}
+	return nil
 }
 
 type BackendConfig struct {
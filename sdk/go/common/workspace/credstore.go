@@ -0,0 +1,224 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// PulumiCredentialsStoreEnvVar selects the store the access tokens used to log in to backends are kept in, instead
+// of in plaintext in the credentials file. It takes one of:
+//
+//   - "file", to keep them in the credentials file;
+//   - "keychain", to keep them in the macOS keychain;
+//   - "libsecret", to keep them in the Secret Service, like GNOME Keyring or KWallet, using libsecret's secret-tool;
+//   - any other name, to keep them with the external credential helper named pulumi-credential-<name>.
+//
+// The store is recorded in the credentials file, so it only needs to be set once. Tokens held by another store are
+// moved into the selected one the next time credentials are read.
+//
+//nolint:gosec
+const PulumiCredentialsStoreEnvVar = "PULUMI_CREDENTIALS_STORE"
+
+// credentialStoreService is the service name tokens are stored under in stores that hold credentials for several
+// programs.
+const credentialStoreService = "pulumi"
+
+// CredentialStore is a store for the access tokens used to log in to backends, keyed by backend URL.
+type CredentialStore interface {
+	// Name returns the name the store is selected by.
+	Name() string
+	// Get returns the token stored under the given key, or the empty string if there is none.
+	Get(key string) (string, error)
+	// Store stores the token under the given key, replacing any that is already stored there.
+	Store(key, token string) error
+	// Erase removes the token stored under the given key, if there is one.
+	Erase(key string) error
+}
+
+// NewCredentialStore returns the credential store with the given name, as described by PulumiCredentialsStoreEnvVar.
+// It returns nil for the credentials file itself, which holds tokens in plaintext.
+func NewCredentialStore(name string) (CredentialStore, error) {
+	switch name {
+	case "", "file":
+		return nil, nil
+	case "keychain":
+		if runtime.GOOS != "darwin" {
+			return nil, fmt.Errorf("the keychain credential store is only available on macOS")
+		}
+		return keychainCredentialStore{}, nil
+	case "libsecret":
+		return libsecretCredentialStore{}, nil
+	}
+	if strings.ContainsAny(name, `/\`) {
+		return nil, fmt.Errorf("invalid credential helper name %q", name)
+	}
+	return helperCredentialStore{name: name}, nil
+}
+
+// runCredentialCommand runs a credential store command with the given input, returning its standard output.
+func runCredentialCommand(input []byte, name string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = bytes.NewReader(input), &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return stdout.Bytes(), fmt.Errorf("%s %s: %w: %s", name, args[0], err, msg)
+		}
+		return stdout.Bytes(), fmt.Errorf("%s %s: %w", name, args[0], err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// exitCode returns the exit code of the command that failed with the given error, or -1 if it didn't exit.
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+// keychainCredentialStore keeps tokens as generic passwords in the macOS keychain, using the security command.
+type keychainCredentialStore struct{}
+
+// keychainItemNotFound is the exit code of the security command when there is no matching keychain item.
+const keychainItemNotFound = 44
+
+func (keychainCredentialStore) Name() string {
+	return "keychain"
+}
+
+func (keychainCredentialStore) Get(key string) (string, error) {
+	out, err := runCredentialCommand(nil,
+		"security", "find-generic-password", "-s", credentialStoreService, "-a", key, "-w")
+	if err != nil {
+		if exitCode(err) == keychainItemNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func (keychainCredentialStore) Store(key, token string) error {
+	// The token is passed through security's interactive mode rather than its arguments, which other processes can
+	// see.
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+	}
+	input := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+		quote(credentialStoreService), quote(key), quote(token))
+	_, err := runCredentialCommand([]byte(input), "security", "-i")
+	return err
+}
+
+func (keychainCredentialStore) Erase(key string) error {
+	_, err := runCredentialCommand(nil, "security", "delete-generic-password", "-s", credentialStoreService, "-a", key)
+	if err != nil && exitCode(err) != keychainItemNotFound {
+		return err
+	}
+	return nil
+}
+
+// libsecretCredentialStore keeps tokens in the Secret Service, using the secret-tool command that ships with libsecret.
+type libsecretCredentialStore struct{}
+
+func (libsecretCredentialStore) Name() string {
+	return "libsecret"
+}
+
+func (libsecretCredentialStore) Get(key string) (string, error) {
+	out, err := runCredentialCommand(nil, "secret-tool", "lookup", "service", credentialStoreService, "account", key)
+	if err != nil {
+		// secret-tool fails without output when there is no matching secret.
+		if exitCode(err) == 1 && len(out) == 0 {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func (libsecretCredentialStore) Store(key, token string) error {
+	// secret-tool reads the secret from its standard input.
+	_, err := runCredentialCommand([]byte(token), "secret-tool", "store", "--label", "Pulumi access token for "+key,
+		"service", credentialStoreService, "account", key)
+	return err
+}
+
+func (libsecretCredentialStore) Erase(key string) error {
+	_, err := runCredentialCommand(nil, "secret-tool", "clear", "service", credentialStoreService, "account", key)
+	if err != nil && exitCode(err) != 1 {
+		return err
+	}
+	return nil
+}
+
+// helperCredentialStore keeps tokens with an external credential helper: an executable named
+// pulumi-credential-<name> on the PATH. The helper is run with one of the commands get, store or erase, and is sent a
+// JSON object holding the key, and for store the token, on its standard input. For get, it writes a JSON object
+// holding the token, if there is one, to its standard output. A helper reports failure with a non-zero exit code.
+type helperCredentialStore struct {
+	name string
+}
+
+// credentialHelperMessage is the JSON object exchanged with credential helpers.
+type credentialHelperMessage struct {
+	Key   string `json:"key"`
+	Token string `json:"token,omitempty"`
+}
+
+func (s helperCredentialStore) Name() string {
+	return s.name
+}
+
+func (s helperCredentialStore) run(command string, msg credentialHelperMessage) ([]byte, error) {
+	input, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return runCredentialCommand(input, "pulumi-credential-"+s.name, command)
+}
+
+func (s helperCredentialStore) Get(key string) (string, error) {
+	out, err := s.run("get", credentialHelperMessage{Key: key})
+	if err != nil {
+		return "", err
+	}
+	var msg credentialHelperMessage
+	if len(bytes.TrimSpace(out)) != 0 {
+		if err := json.Unmarshal(out, &msg); err != nil {
+			return "", fmt.Errorf("reading the output of pulumi-credential-%s get: %w", s.name, err)
+		}
+	}
+	return msg.Token, nil
+}
+
+func (s helperCredentialStore) Store(key, token string) error {
+	_, err := s.run("store", credentialHelperMessage{Key: key, Token: token})
+	return err
+}
+
+func (s helperCredentialStore) Erase(key string) error {
+	_, err := s.run("erase", credentialHelperMessage{Key: key})
+	return err
+}
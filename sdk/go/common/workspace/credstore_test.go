@@ -0,0 +1,138 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCredentialHelper is a credential helper that keeps each token in a file named after its key, in a tokens
+// directory next to the helper.
+const testCredentialHelper = `#!/bin/sh
+dir="$(dirname "$0")/tokens"
+mkdir -p "$dir"
+input=$(cat)
+key=$(printf '%s' "$input" | sed -n 's/.*"key":"\([^"]*\)".*/\1/p' | tr '/:' '__')
+case "$1" in
+get) if [ -f "$dir/$key" ]; then printf '{"token":"%s"}' "$(cat "$dir/$key")"; fi ;;
+store) printf '%s' "$input" | sed -n 's/.*"token":"\([^"]*\)".*/\1/p' > "$dir/$key" ;;
+erase) rm -f "$dir/$key" ;;
+*) exit 1 ;;
+esac
+`
+
+// installTestCredentialHelper installs testCredentialHelper as the credential helper named "test", and points the
+// credentials file at a temporary directory. It returns the directory the helper keeps tokens in.
+func installTestCredentialHelper(t *testing.T) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test credential helper is a shell script")
+	}
+
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "pulumi-credential-test"), []byte(testCredentialHelper), 0o700))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv(PulumiCredentialsPathEnvVar, t.TempDir())
+	return filepath.Join(bin, "tokens")
+}
+
+func TestCredentialHelper(t *testing.T) {
+	installTestCredentialHelper(t)
+
+	store, err := NewCredentialStore("test")
+	require.NoError(t, err)
+
+	token, err := store.Get("https://api.pulumi.com")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	require.NoError(t, store.Store("https://api.pulumi.com", "pul-123"))
+	token, err = store.Get("https://api.pulumi.com")
+	require.NoError(t, err)
+	assert.Equal(t, "pul-123", token)
+
+	require.NoError(t, store.Erase("https://api.pulumi.com"))
+	token, err = store.Get("https://api.pulumi.com")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+}
+
+func TestNewCredentialStore(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewCredentialStore("file")
+	require.NoError(t, err)
+	assert.Nil(t, store)
+
+	store, err = NewCredentialStore("libsecret")
+	require.NoError(t, err)
+	assert.Equal(t, "libsecret", store.Name())
+
+	_, err = NewCredentialStore("../evil")
+	assert.ErrorContains(t, err, "invalid credential helper name")
+}
+
+func TestStoreCredentials_migration(t *testing.T) {
+	tokens := installTestCredentialHelper(t)
+	credsFile, err := getCredsFilePath()
+	require.NoError(t, err)
+
+	// Credentials start out in plaintext in the credentials file.
+	require.NoError(t, StoreAccount("https://api.pulumi.com", Account{AccessToken: "pul-123", Username: "user"}, true))
+	raw, err := os.ReadFile(credsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "pul-123")
+
+	// Selecting a credential store moves the tokens into it the next time they're read.
+	t.Setenv(PulumiCredentialsStoreEnvVar, "test")
+	account, err := GetAccount("https://api.pulumi.com")
+	require.NoError(t, err)
+	assert.Equal(t, "pul-123", account.AccessToken)
+	assert.Equal(t, "user", account.Username)
+
+	raw, err = os.ReadFile(credsFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "pul-123")
+	assert.Contains(t, string(raw), `"credentialsStore": "test"`)
+	assert.FileExists(t, filepath.Join(tokens, "https___api.pulumi.com"))
+
+	// The store is recorded in the credentials file, so it keeps being used without being selected.
+	t.Setenv(PulumiCredentialsStoreEnvVar, "")
+	require.NoError(t, StoreAccount("https://other.example.com", Account{AccessToken: "pul-456"}, false))
+	creds, err := GetStoredCredentials()
+	require.NoError(t, err)
+	assert.Equal(t, "test", creds.Store)
+	assert.Equal(t, "pul-456", creds.AccessTokens["https://other.example.com"])
+	assert.FileExists(t, filepath.Join(tokens, "https___other.example.com"))
+
+	// Logging out erases the token from the store.
+	require.NoError(t, DeleteAccount("https://other.example.com"))
+	assert.NoFileExists(t, filepath.Join(tokens, "https___other.example.com"))
+
+	// Selecting the credentials file moves the tokens back into it.
+	t.Setenv(PulumiCredentialsStoreEnvVar, "file")
+	creds, err = GetStoredCredentials()
+	require.NoError(t, err)
+	assert.Equal(t, "pul-123", creds.Accounts["https://api.pulumi.com"].AccessToken)
+	raw, err = os.ReadFile(credsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "pul-123")
+	assert.NoFileExists(t, filepath.Join(tokens, "https___api.pulumi.com"))
+}
@@ -40,6 +40,8 @@ const (
 	GitDir = ".git"
 	// HistoryDir is the name of the directory that holds historical information for projects.
 	HistoryDir = "history"
+	// JournalDir is the name of the directory that holds checkpoint journals for stacks.
+	JournalDir = "journals"
 	// PluginDir is the name of the directory containing plugins.
 	PluginDir = "plugins"
 	// PolicyDir is the name of the directory that holds policy packs.
@@ -365,6 +365,9 @@ func newGithubSource(url *url.URL, name string, kind PluginKind) (*githubSource,
 			// should go away and be replaced with a registry lookup.
 			repository = "pulumi-yaml"
 		}
+	} else if kind == BackendPlugin {
+		// Likewise backend plugins, e.g. github.com/acme/pulumi-backend-postgres.
+		repository = "pulumi-backend-" + name
 	}
 	if len(parts) == 2 {
 		repository = parts[1]
@@ -1504,12 +1507,14 @@ const (
 	ResourcePlugin PluginKind = "resource"
 	// ConverterPlugin is a plugin that can be used to convert from other ecosystems to Pulumi.
 	ConverterPlugin PluginKind = "converter"
+	// BackendPlugin is a plugin that can be used to store the state of self-managed stacks.
+	BackendPlugin PluginKind = "backend"
 )
 
 // IsPluginKind returns true if k is a valid plugin kind, and false otherwise.
 func IsPluginKind(k string) bool {
 	switch PluginKind(k) {
-	case AnalyzerPlugin, LanguagePlugin, ResourcePlugin, ConverterPlugin:
+	case AnalyzerPlugin, LanguagePlugin, ResourcePlugin, ConverterPlugin, BackendPlugin:
 		return true
 	default:
 		return false
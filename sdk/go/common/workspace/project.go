@@ -821,6 +821,15 @@ type ProjectStack struct {
 	// EncryptionSalt is this stack's base64 encoded encryption salt.  Only used for
 	// passphrase-based secrets providers.
 	EncryptionSalt string `json:"encryptionsalt,omitempty" yaml:"encryptionsalt,omitempty"`
+	// PassphraseFile is the path of a file containing this stack's passphrase. Only used for passphrase-based
+	// secrets providers, when neither PULUMI_CONFIG_PASSPHRASE nor PULUMI_CONFIG_PASSPHRASE_FILE are set.
+	PassphraseFile string `json:"passphrasefile,omitempty" yaml:"passphrasefile,omitempty"`
+	// FallbackProviders are previous secrets providers of this stack. They are never used to encrypt secrets,
+	// only to decrypt secrets that the current secrets provider can't, e.g. while a key migration is in progress.
+	FallbackProviders []FallbackSecretsProvider `json:"fallbackproviders,omitempty" yaml:"fallbackproviders,omitempty"`
+	// NamespaceProviders are secrets providers for the config values of specific namespaces, which are then
+	// encrypted with them instead of the stack's secrets provider.
+	NamespaceProviders []ConfigSecretsProvider `json:"namespaceproviders,omitempty" yaml:"namespaceproviders,omitempty"`
 	// Config is an optional config bag.
 	Config config.Map `json:"config,omitempty" yaml:"config,omitempty"`
 	// Environment is an optional environment definition or list of environments.
@@ -830,6 +839,38 @@ type ProjectStack struct {
 	raw []byte
 }
 
+// FallbackSecretsProvider is the configuration of a previous secrets provider of a stack.
+type FallbackSecretsProvider struct {
+	// SecretsProvider is the secrets provider, or empty for the passphrase provider.
+	SecretsProvider string `json:"secretsprovider,omitempty" yaml:"secretsprovider,omitempty"`
+	// EncryptedKey is the KMS-encrypted ciphertext for the data key of a cloud-based secrets provider.
+	EncryptedKey string `json:"encryptedkey,omitempty" yaml:"encryptedkey,omitempty"`
+	// EncryptionSalt is the base64 encoded encryption salt of a passphrase-based secrets provider.
+	EncryptionSalt string `json:"encryptionsalt,omitempty" yaml:"encryptionsalt,omitempty"`
+}
+
+// ConfigSecretsProvider is the configuration of the secrets provider for the config values of a namespace.
+type ConfigSecretsProvider struct {
+	// Namespace is the config namespace whose values are encrypted with this secrets provider, e.g. "aws".
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// SecretsProvider is the secrets provider, or empty for the passphrase provider.
+	SecretsProvider string `json:"secretsprovider,omitempty" yaml:"secretsprovider,omitempty"`
+	// EncryptedKey is the KMS-encrypted ciphertext for the data key of a cloud-based secrets provider.
+	EncryptedKey string `json:"encryptedkey,omitempty" yaml:"encryptedkey,omitempty"`
+	// EncryptionSalt is the base64 encoded encryption salt of a passphrase-based secrets provider.
+	EncryptionSalt string `json:"encryptionsalt,omitempty" yaml:"encryptionsalt,omitempty"`
+}
+
+// ConfigSecretsProvider returns the secrets provider for the config values of the given namespace, if there is one.
+func (ps *ProjectStack) ConfigSecretsProvider(namespace string) (ConfigSecretsProvider, bool) {
+	for _, p := range ps.NamespaceProviders {
+		if p.Namespace == namespace {
+			return p, true
+		}
+	}
+	return ConfigSecretsProvider{}, false
+}
+
 func (ps ProjectStack) EnvironmentBytes() []byte {
 	return ps.Environment.Definition()
 }
@@ -0,0 +1,121 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+// trackProvenance is true if outputs record the outputs they were derived from. Those references keep every
+// ancestor of a live output reachable, so they are only recorded when provenance tracking is enabled.
+var trackProvenance atomic.Bool
+
+func init() {
+	trackProvenance.Store(cmdutil.IsTruthy(os.Getenv("PULUMI_TRACK_OUTPUT_PROVENANCE")))
+}
+
+// SetProvenanceTracking enables or disables recording of the outputs that new outputs are derived from.
+func SetProvenanceTracking(enabled bool) {
+	trackProvenance.Store(enabled)
+}
+
+// outputOrigin records the resource property that produces an output.
+type outputOrigin struct {
+	resource Resource
+	property string
+}
+
+// Provenance describes where the value of an output came from.
+type Provenance struct {
+	// Resource is the resource whose property produces the output, or nil if the output is not a resource property.
+	// This is a pulumi.Resource, but we can't use that type here because it would create a circular dependency.
+	Resource Resource
+	// Property is the name of the resource property that produces the output, e.g. "arn", "id", or "urn".
+	Property string
+	// ResolvedAt is the time at which the output was resolved or rejected, or the zero time if it is still pending.
+	ResolvedAt time.Time
+	// Sources are the provenances of the outputs that this output was derived from,
+	// e.g. with ApplyT or All, in the order in which they were encountered.
+	Sources []Provenance
+}
+
+// Provenance returns the provenance of the output's value: the resource property that produced it, if any,
+// and the outputs it was derived from. This is intended for debugging tools that need to answer
+// "where did this value come from" in programs with many applies. Sources are only reported for outputs
+// created while provenance tracking is enabled.
+func (o *OutputState) Provenance() Provenance {
+	return o.provenance(map[*OutputState]Provenance{})
+}
+
+// provenance returns the provenance of the output's value. seen holds the provenances that have already been
+// computed, so that outputs that are shared by several derived outputs are only visited once.
+func (o *OutputState) provenance(seen map[*OutputState]Provenance) Provenance {
+	if o == nil {
+		return Provenance{}
+	}
+	if p, ok := seen[o]; ok {
+		return p
+	}
+
+	o.cond.L.Lock()
+	origin, sources, resolvedAt := o.origin, o.sources, o.resolvedAt
+	o.cond.L.Unlock()
+
+	var p Provenance
+	if origin != nil {
+		p.Resource, p.Property = origin.resource, origin.property
+	}
+	p.ResolvedAt = resolvedAt
+	if len(sources) > 0 {
+		p.Sources = make([]Provenance, len(sources))
+		for i, source := range sources {
+			p.Sources[i] = source.provenance(seen)
+		}
+	}
+	seen[o] = p
+	return p
+}
+
+// setSources records that this output was derived from the given outputs if provenance tracking is enabled.
+func (o *OutputState) setSources(sources []*OutputState) {
+	if len(sources) == 0 || !trackProvenance.Load() {
+		return
+	}
+	o.cond.L.Lock()
+	defer o.cond.L.Unlock()
+	o.sources = sources
+}
+
+// addSource records that this output was derived from the given output.
+func (o *OutputState) addSource(source *OutputState) {
+	if o == nil || source == nil || !trackProvenance.Load() {
+		return
+	}
+	o.cond.L.Lock()
+	defer o.cond.L.Unlock()
+	o.sources = append(o.sources, source)
+}
+
+// SetOutputOrigin records that the given output is produced by the named property of the given resource.
+func SetOutputOrigin(o OutputOrState, res Resource, property string) {
+	state := o.getState()
+	state.cond.L.Lock()
+	defer state.cond.L.Unlock()
+	state.origin = &outputOrigin{resource: res, property: property}
+}
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"runtime"
 	"sync"
+	"time"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -130,6 +131,11 @@ type OutputState struct {
 	// This is a []pulumi.Resource, but we can't use that type here because
 	// it would create a circular dependency.
 	deps []Resource
+
+	// The provenance of this output's value. See Provenance.
+	origin     *outputOrigin  // the resource property that produces this output, if any.
+	sources    []*OutputState // the outputs this output was derived from, if provenance tracking is enabled.
+	resolvedAt time.Time      // the time at which this output was fulfilled.
 }
 
 func getOutputState(v reflect.Value) (*OutputState, bool) {
@@ -207,6 +213,7 @@ func (o *OutputState) fulfillValue(value reflect.Value, known, secret bool, deps
 		}
 	}
 
+	o.resolvedAt = time.Now()
 	if err != nil {
 		o.state, o.err, o.known, o.secret = OutputRejected, err, true, secret
 	} else {
@@ -606,6 +613,7 @@ func (o *OutputState) applyTWithApplier(ctx context.Context, ap *applier) Output
 	}
 
 	result := NewOutput(o.join, resultType, o.dependencies()...)
+	result.getState().setSources([]*OutputState{o})
 	go func() {
 		v, known, secret, deps, err := o.getState().await(ctx)
 		if err != nil || !known {
@@ -628,6 +636,7 @@ func (o *OutputState) applyTWithApplier(ctx context.Context, ap *applier) Output
 		fulfilledDeps = append(fulfilledDeps, deps...)
 		if resultOutput, ok := out.Interface().(Output); ok {
 			fulfilledDeps = append(fulfilledDeps, resultOutput.getState().dependencies()...)
+			result.getState().addSource(resultOutput.getState())
 		}
 		// Fulfill the result.
 		result.getState().fulfillValue(out, true, secret, fulfilledDeps, nil)
@@ -691,13 +700,15 @@ func ToSecretWithContext(ctx context.Context, input interface{}) Output {
 	return o
 }
 
-func gatherJoins(v interface{}) workGroups {
+// gatherJoins returns the wait groups of the outputs contained in v, along with the outputs themselves.
+func gatherJoins(v interface{}) (workGroups, []*OutputState) {
 	if v == nil {
-		return nil
+		return nil, nil
 	}
 
 	joinSet := make(map[*WorkGroup]struct{})
-	gatherJoinSet(reflect.ValueOf(v), joinSet)
+	var outputs []*OutputState
+	gatherJoinSet(reflect.ValueOf(v), joinSet, &outputs)
 
 	var joins workGroups
 	if len(joinSet) > 0 {
@@ -707,18 +718,21 @@ func gatherJoins(v interface{}) workGroups {
 		}
 	}
 
-	return joins
+	return joins, outputs
 }
 
 var resourceType = reflect.TypeOf((*Resource)(nil)).Elem()
 
-func gatherJoinSet(v reflect.Value, joins map[*WorkGroup]struct{}) {
+func gatherJoinSet(v reflect.Value, joins map[*WorkGroup]struct{}, outputs *[]*OutputState) {
 	for {
 		// Check for an Output that we can pull dependencies off of.
 		if v.Type().Implements(outputType) && v.CanInterface() {
 			output := v.Convert(outputType).Interface().(Output)
-			if join := output.getState().join; join != nil {
-				joins[join] = struct{}{}
+			if state := output.getState(); state != nil {
+				*outputs = append(*outputs, state)
+				if state.join != nil {
+					joins[state.join] = struct{}{}
+				}
 			}
 			return
 		}
@@ -737,18 +751,18 @@ func gatherJoinSet(v reflect.Value, joins map[*WorkGroup]struct{}) {
 		case reflect.Struct:
 			numFields := v.Type().NumField()
 			for i := 0; i < numFields; i++ {
-				gatherJoinSet(v.Field(i), joins)
+				gatherJoinSet(v.Field(i), joins, outputs)
 			}
 		case reflect.Array, reflect.Slice:
 			l := v.Len()
 			for i := 0; i < l; i++ {
-				gatherJoinSet(v.Index(i), joins)
+				gatherJoinSet(v.Index(i), joins, outputs)
 			}
 		case reflect.Map:
 			iter := v.MapRange()
 			for iter.Next() {
-				gatherJoinSet(iter.Key(), joins)
-				gatherJoinSet(iter.Value(), joins)
+				gatherJoinSet(iter.Key(), joins, outputs)
+				gatherJoinSet(iter.Value(), joins, outputs)
 			}
 		}
 		return
@@ -1001,7 +1015,7 @@ func awaitInputs(ctx context.Context, v, resolved reflect.Value) (bool, bool, []
 func toOutputTWithContext(ctx context.Context, join *WorkGroup, outputType reflect.Type, v interface{}, result reflect.Value, forceSecretVal *bool) Output {
 	// forceSecretVal enables ensuring the value is marked secret before the secret field of the
 	// output could be observed (read: raced) by any user of the returned Output prior to awaiting.
-	joins := gatherJoins(v)
+	joins, sources := gatherJoins(v)
 
 	done := joins.done
 	if join == nil {
@@ -1021,6 +1035,7 @@ func toOutputTWithContext(ctx context.Context, join *WorkGroup, outputType refle
 	joins.add()
 
 	output := NewOutput(join, outputType)
+	output.getState().setSources(sources)
 	if forceSecretVal != nil {
 		output.getState().secret = *forceSecretVal
 	}
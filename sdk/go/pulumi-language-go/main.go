@@ -837,6 +837,14 @@ func (host *goLanguageHost) constructEnv(req *pulumirpc.RunRequest) ([]string, e
 	if err != nil {
 		return nil, err
 	}
+	stackTags, err := host.constructStackTags(req)
+	if err != nil {
+		return nil, err
+	}
+	runtimeOptions, err := host.constructRuntimeOptions(req)
+	if err != nil {
+		return nil, err
+	}
 
 	env := os.Environ()
 	maybeAppendEnv := func(k, v string) {
@@ -850,6 +858,8 @@ func (host *goLanguageHost) constructEnv(req *pulumirpc.RunRequest) ([]string, e
 	maybeAppendEnv(pulumi.EnvStack, req.GetStack())
 	maybeAppendEnv(pulumi.EnvConfig, config)
 	maybeAppendEnv(pulumi.EnvConfigSecretKeys, configSecretKeys)
+	maybeAppendEnv(pulumi.EnvStackTags, stackTags)
+	maybeAppendEnv(pulumi.EnvRuntimeOptions, runtimeOptions)
 	maybeAppendEnv(pulumi.EnvDryRun, strconv.FormatBool(req.GetDryRun()))
 	maybeAppendEnv(pulumi.EnvParallel, strconv.Itoa(int(req.GetParallel())))
 	maybeAppendEnv(pulumi.EnvMonitor, req.GetMonitorAddress())
@@ -889,6 +899,36 @@ func (host *goLanguageHost) constructConfigSecretKeys(req *pulumirpc.RunRequest)
 	return string(configSecretKeysJSON), nil
 }
 
+// constructStackTags JSON-serializes the stack tags given as part of a RunRequest.
+func (host *goLanguageHost) constructStackTags(req *pulumirpc.RunRequest) (string, error) {
+	stackTags := req.GetStackTags()
+	if len(stackTags) == 0 {
+		return "", nil
+	}
+
+	stackTagsJSON, err := json.Marshal(stackTags)
+	if err != nil {
+		return "", err
+	}
+
+	return string(stackTagsJSON), nil
+}
+
+// constructRuntimeOptions JSON-serializes the project's runtime options given as part of a RunRequest.
+func (host *goLanguageHost) constructRuntimeOptions(req *pulumirpc.RunRequest) (string, error) {
+	opts := req.GetInfo().GetOptions()
+	if len(opts.GetFields()) == 0 {
+		return "", nil
+	}
+
+	optsJSON, err := json.Marshal(opts.AsMap())
+	if err != nil {
+		return "", err
+	}
+
+	return string(optsJSON), nil
+}
+
 func (host *goLanguageHost) GetPluginInfo(ctx context.Context, req *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
 	return &pulumirpc.PluginInfo{
 		Version: version.Version,
@@ -20,6 +20,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestParseRunParams(t *testing.T) {
@@ -456,3 +458,28 @@ func testPluginsAndDependencies(t *testing.T, progDir string) {
 		}, gotDeps)
 	})
 }
+
+func TestConstructEnvStackMetadata(t *testing.T) {
+	t.Parallel()
+
+	opts, err := structpb.NewStruct(map[string]interface{}{"buildTarget": "bin/app"})
+	require.NoError(t, err)
+
+	host := &goLanguageHost{}
+	env, err := host.constructEnv(&pulumirpc.RunRequest{
+		Organization: "myorg",
+		StackTags:    map[string]string{"env": "prod"},
+		Info:         &pulumirpc.ProgramInfo{Options: opts},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, env, "PULUMI_ORGANIZATION=myorg")
+	assert.Contains(t, env, `PULUMI_STACK_TAGS={"env":"prod"}`)
+	assert.Contains(t, env, `PULUMI_RUNTIME_OPTIONS={"buildTarget":"bin/app"}`)
+
+	env, err = host.constructEnv(&pulumirpc.RunRequest{})
+	require.NoError(t, err)
+	for _, v := range env {
+		assert.False(t, strings.HasPrefix(v, "PULUMI_STACK_TAGS="))
+		assert.False(t, strings.HasPrefix(v, "PULUMI_RUNTIME_OPTIONS="))
+	}
+}
@@ -15,6 +15,7 @@
 package pulumi
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -94,6 +95,10 @@ type Archive interface {
 	Path() string
 	// URI returns a URI, for remote network-based archives.
 	URI() string
+	// ContentHash returns the SHA256 hash of the archive's contents, as computed by the engine when the archive
+	// is used as a resource input. The hash changes only if the archive's contents change,
+	// so it may be used to trigger updates to other resources.
+	ContentHash() StringOutput
 
 	isArchive()
 }
@@ -137,6 +142,22 @@ func (a *archive) Path() string { return a.path }
 // URI returns the archive's URL, if this is a remote archive, or an empty string otherwise.
 func (a *archive) URI() string { return a.uri }
 
+// ContentHash returns the SHA256 hash of the archive's contents. Reading the contents happens asynchronously;
+// the output is rejected if they cannot be read.
+func (a *archive) ContentHash() StringOutput {
+	return ToOutput(Archive(a)).ApplyT(func(a Archive) (string, error) {
+		v, _, err := marshalInput(a, anyType, true)
+		if err != nil {
+			return "", err
+		}
+		archive := v.ArchiveValue()
+		if err := archive.EnsureHash(); err != nil {
+			return "", fmt.Errorf("computing archive hash: %w", err)
+		}
+		return archive.Hash, nil
+	}).(StringOutput)
+}
+
 func (a *archive) isArchive() {}
 
 func (a *archive) isAssetOrArchive() {}
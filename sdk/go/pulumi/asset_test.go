@@ -0,0 +1,63 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestArchiveContentHash(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.js"), []byte("exports.handler = () => 1;"), 0o600))
+
+	hash := func(t *testing.T, a Archive) string {
+		v, known, secret, deps, err := await(a.ContentHash())
+		require.NoError(t, err)
+		assert.True(t, known)
+		assert.False(t, secret)
+		assert.Empty(t, deps)
+		return v.(string)
+	}
+
+	// The hash matches the one the engine computes.
+	expected, err := resource.NewPathArchive(dir)
+	require.NoError(t, err)
+	first := hash(t, NewFileArchive(dir))
+	assert.Equal(t, expected.Hash, first)
+
+	// The hash depends on the contents rather than the path.
+	other := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(other, "index.js"), []byte("exports.handler = () => 1;"), 0o600))
+	assert.Equal(t, first, hash(t, NewFileArchive(other)))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.js"), []byte("exports.handler = () => 2;"), 0o600))
+	assert.NotEqual(t, first, hash(t, NewFileArchive(dir)))
+
+	// Archives of assets are hashed too.
+	inMemory := NewAssetArchive(map[string]interface{}{"index.js": NewStringAsset("exports.handler = () => 1;")})
+	assert.Len(t, hash(t, inMemory), 64)
+
+	_, _, _, _, err = await(NewFileArchive(filepath.Join(dir, "missing")).ContentHash())
+	assert.ErrorContains(t, err, "computing archive hash")
+}
@@ -58,6 +58,11 @@ func (c *Config) GetBool(key string) bool {
 	return GetBool(c.ctx, c.fullKey(key))
 }
 
+// GetFloat32 loads an optional float32 configuration value by its key, or returns 0 if it doesn't exist.
+func (c *Config) GetFloat32(key string) float32 {
+	return GetFloat32(c.ctx, c.fullKey(key))
+}
+
 // GetFloat64 loads an optional float64 configuration value by its key, or returns 0 if it doesn't exist.
 func (c *Config) GetFloat64(key string) float64 {
 	return GetFloat64(c.ctx, c.fullKey(key))
@@ -68,6 +73,16 @@ func (c *Config) GetInt(key string) int {
 	return GetInt(c.ctx, c.fullKey(key))
 }
 
+// GetInt64 loads an optional int64 configuration value by its key, or returns 0 if it doesn't exist.
+func (c *Config) GetInt64(key string) int64 {
+	return GetInt64(c.ctx, c.fullKey(key))
+}
+
+// GetUint64 loads an optional uint64 configuration value by its key, or returns 0 if it doesn't exist.
+func (c *Config) GetUint64(key string) uint64 {
+	return GetUint64(c.ctx, c.fullKey(key))
+}
+
 // Require loads a configuration value by its key, or panics if it doesn't exist.
 func (c *Config) Require(key string) string {
 	return Require(c.ctx, c.fullKey(key))
@@ -84,6 +99,11 @@ func (c *Config) RequireBool(key string) bool {
 	return RequireBool(c.ctx, c.fullKey(key))
 }
 
+// RequireFloat32 loads a float32 configuration value by its key, or panics if it doesn't exist.
+func (c *Config) RequireFloat32(key string) float32 {
+	return RequireFloat32(c.ctx, c.fullKey(key))
+}
+
 // RequireFloat64 loads a float64 configuration value by its key, or panics if it doesn't exist.
 func (c *Config) RequireFloat64(key string) float64 {
 	return RequireFloat64(c.ctx, c.fullKey(key))
@@ -94,6 +114,16 @@ func (c *Config) RequireInt(key string) int {
 	return RequireInt(c.ctx, c.fullKey(key))
 }
 
+// RequireInt64 loads a int64 configuration value by its key, or panics if it doesn't exist.
+func (c *Config) RequireInt64(key string) int64 {
+	return RequireInt64(c.ctx, c.fullKey(key))
+}
+
+// RequireUint64 loads a uint64 configuration value by its key, or panics if it doesn't exist.
+func (c *Config) RequireUint64(key string) uint64 {
+	return RequireUint64(c.ctx, c.fullKey(key))
+}
+
 // Try loads a configuration value by its key, returning a non-nil error if it doesn't exist.
 func (c *Config) Try(key string) (string, error) {
 	return Try(c.ctx, c.fullKey(key))
@@ -110,6 +140,11 @@ func (c *Config) TryBool(key string) (bool, error) {
 	return TryBool(c.ctx, c.fullKey(key))
 }
 
+// TryFloat32 loads an optional float32 configuration value by its key, or returns an error if it doesn't exist.
+func (c *Config) TryFloat32(key string) (float32, error) {
+	return TryFloat32(c.ctx, c.fullKey(key))
+}
+
 // TryFloat64 loads an optional float64 configuration value by its key, or returns an error if it doesn't exist.
 func (c *Config) TryFloat64(key string) (float64, error) {
 	return TryFloat64(c.ctx, c.fullKey(key))
@@ -120,6 +155,16 @@ func (c *Config) TryInt(key string) (int, error) {
 	return TryInt(c.ctx, c.fullKey(key))
 }
 
+// TryInt64 loads an optional int64 configuration value by its key, or returns an error if it doesn't exist.
+func (c *Config) TryInt64(key string) (int64, error) {
+	return TryInt64(c.ctx, c.fullKey(key))
+}
+
+// TryUint64 loads an optional uint64 configuration value by its key, or returns an error if it doesn't exist.
+func (c *Config) TryUint64(key string) (uint64, error) {
+	return TryUint64(c.ctx, c.fullKey(key))
+}
+
 // GetSecret loads an optional configuration value by its key
 // or "" if it doesn't exist, and returns it wrapped in a secret Output.
 func (c *Config) GetSecret(key string) pulumi.StringOutput {
@@ -138,6 +183,12 @@ func (c *Config) GetSecretBool(key string) pulumi.BoolOutput {
 	return GetSecretBool(c.ctx, c.fullKey(key))
 }
 
+// GetSecretFloat32 loads an optional float32 configuration value by its key
+// or 0 if it doesn't exist, and returns it wrapped in a secret Output.
+func (c *Config) GetSecretFloat32(key string) pulumi.Float32Output {
+	return GetSecretFloat32(c.ctx, c.fullKey(key))
+}
+
 // GetSecretFloat64 loads an optional float64 configuration value by its key
 // or 0 if it doesn't exist, and returns it wrapped in a secret Output.
 func (c *Config) GetSecretFloat64(key string) pulumi.Float64Output {
@@ -150,6 +201,18 @@ func (c *Config) GetSecretInt(key string) pulumi.IntOutput {
 	return GetSecretInt(c.ctx, c.fullKey(key))
 }
 
+// GetSecretInt64 loads an optional int64 configuration value by its key
+// or 0 if it doesn't exist, and returns it wrapped in a secret Output.
+func (c *Config) GetSecretInt64(key string) pulumi.Int64Output {
+	return GetSecretInt64(c.ctx, c.fullKey(key))
+}
+
+// GetSecretUint64 loads an optional uint64 configuration value by its key
+// or 0 if it doesn't exist, and returns it wrapped in a secret Output.
+func (c *Config) GetSecretUint64(key string) pulumi.Uint64Output {
+	return GetSecretUint64(c.ctx, c.fullKey(key))
+}
+
 // RequireSecret loads a configuration value by its key
 // and returns it wrapped in a secret output, or panics if it doesn't exist.
 func (c *Config) RequireSecret(key string) pulumi.StringOutput {
@@ -168,6 +231,12 @@ func (c *Config) RequireSecretBool(key string) pulumi.BoolOutput {
 	return RequireSecretBool(c.ctx, c.fullKey(key))
 }
 
+// RequireSecretFloat32 loads a float32 configuration value by its key
+// and returns is wrapped in a secret Output, or panics if it doesn't exist.
+func (c *Config) RequireSecretFloat32(key string) pulumi.Float32Output {
+	return RequireSecretFloat32(c.ctx, c.fullKey(key))
+}
+
 // RequireSecretFloat64 loads a float64 configuration value by its key
 // and returns is wrapped in a secret Output, or panics if it doesn't exist.
 func (c *Config) RequireSecretFloat64(key string) pulumi.Float64Output {
@@ -180,6 +249,18 @@ func (c *Config) RequireSecretInt(key string) pulumi.IntOutput {
 	return RequireSecretInt(c.ctx, c.fullKey(key))
 }
 
+// RequireSecretInt64 loads a int64 configuration value by its key
+// and returns is wrapped in a secret Output, or panics if it doesn't exist.
+func (c *Config) RequireSecretInt64(key string) pulumi.Int64Output {
+	return RequireSecretInt64(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretUint64 loads a uint64 configuration value by its key
+// and returns is wrapped in a secret Output, or panics if it doesn't exist.
+func (c *Config) RequireSecretUint64(key string) pulumi.Uint64Output {
+	return RequireSecretUint64(c.ctx, c.fullKey(key))
+}
+
 // TrySecret loads a configuration value by its key, returning a non-nil error if it doesn't exist.
 func (c *Config) TrySecret(key string) (pulumi.StringOutput, error) {
 	return TrySecret(c.ctx, c.fullKey(key))
@@ -196,6 +277,12 @@ func (c *Config) TrySecretBool(key string) (pulumi.BoolOutput, error) {
 	return TrySecretBool(c.ctx, c.fullKey(key))
 }
 
+// TrySecretFloat32 loads an optional float32 configuration value by its key into a secret Output,
+// or returns an error if it doesn't exist.
+func (c *Config) TrySecretFloat32(key string) (pulumi.Float32Output, error) {
+	return TrySecretFloat32(c.ctx, c.fullKey(key))
+}
+
 // TrySecretFloat64 loads an optional float64 configuration value by its key into a secret Output,
 // or returns an error if it doesn't exist.
 func (c *Config) TrySecretFloat64(key string) (pulumi.Float64Output, error) {
@@ -207,3 +294,15 @@ func (c *Config) TrySecretFloat64(key string) (pulumi.Float64Output, error) {
 func (c *Config) TrySecretInt(key string) (pulumi.IntOutput, error) {
 	return TrySecretInt(c.ctx, c.fullKey(key))
 }
+
+// TrySecretInt64 loads an optional int64 configuration value by its key into a secret Output,
+// or returns an error if it doesn't exist.
+func (c *Config) TrySecretInt64(key string) (pulumi.Int64Output, error) {
+	return TrySecretInt64(c.ctx, c.fullKey(key))
+}
+
+// TrySecretUint64 loads an optional uint64 configuration value by its key into a secret Output,
+// or returns an error if it doesn't exist.
+func (c *Config) TrySecretUint64(key string) (pulumi.Uint64Output, error) {
+	return TrySecretUint64(c.ctx, c.fullKey(key))
+}
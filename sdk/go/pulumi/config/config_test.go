@@ -18,11 +18,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
@@ -326,3 +329,157 @@ func TestSecretConfig(t *testing.T) {
 		}
 	}
 }
+
+type testDatabase struct {
+	Host     string              `json:"host"`
+	Password pulumi.StringOutput `json:"password" config:"secret"`
+	Port     pulumi.IntOutput    `json:"port" config:"secret"`
+}
+
+type testSecretsStruct struct {
+	Name      string                  `json:"name"`
+	Primary   testDatabase            `json:"primary"`
+	Replicas  []*testDatabase         `json:"replicas"`
+	ByRegion  map[string]testDatabase `json:"byRegion"`
+	Untouched map[string]string       `json:"untouched"`
+}
+
+func TestObjectWithSecrets(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := pulumi.NewContext(context.Background(), pulumi.RunInfo{
+		Config: map[string]string{
+			"testpkg:obj": `{
+				"name": "app",
+				"primary": {"host": "db", "password": "hunter2", "port": 5432},
+				"replicas": [{"host": "replica", "password": "hunter3"}],
+				"byRegion": {"west": {"host": "west", "password": "hunter4"}},
+				"untouched": {"a": "b"}
+			}`,
+			"testpkg:badsecret": `{"primary": {"password": 42}}`,
+			"testpkg:plain":     `{"password": "hunter2"}`,
+		},
+	})
+	assert.NoError(t, err)
+
+	cfg := New(ctx, "testpkg")
+
+	assertSecret := func(t *testing.T, expected interface{}, out pulumi.Output) {
+		v, known, secret, _, err := internal.AwaitOutput(context.Background(), out)
+		assert.NoError(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, expected, v)
+	}
+
+	var obj testSecretsStruct
+	assert.NoError(t, cfg.TryObjectWithSecrets("obj", &obj))
+	assert.Equal(t, "app", obj.Name)
+	assert.Equal(t, "db", obj.Primary.Host)
+	assertSecret(t, "hunter2", obj.Primary.Password)
+	assertSecret(t, 5432, obj.Primary.Port)
+	assert.Len(t, obj.Replicas, 1)
+	assert.Equal(t, "replica", obj.Replicas[0].Host)
+	assertSecret(t, "hunter3", obj.Replicas[0].Password)
+	assert.Nil(t, obj.Replicas[0].Port.OutputState)
+	assert.Equal(t, "west", obj.ByRegion["west"].Host)
+	assertSecret(t, "hunter4", obj.ByRegion["west"].Password)
+	assert.Equal(t, map[string]string{"a": "b"}, obj.Untouched)
+
+	var required testSecretsStruct
+	cfg.RequireObjectWithSecrets("obj", &required)
+	assertSecret(t, "hunter2", required.Primary.Password)
+
+	var missing testSecretsStruct
+	assert.NoError(t, cfg.GetObjectWithSecrets("missing", &missing))
+	assert.ErrorIs(t, cfg.TryObjectWithSecrets("missing", &missing), ErrMissingVar)
+
+	var bad testSecretsStruct
+	err = cfg.TryObjectWithSecrets("badsecret", &bad)
+	assert.ErrorContains(t, err, "primary.password: json: cannot unmarshal number")
+
+	var plain struct {
+		Password string `json:"password" config:"secret"`
+	}
+	err = cfg.TryObjectWithSecrets("plain", &plain)
+	assert.ErrorContains(t, err, "secret field Password must have an Output type, not string")
+}
+
+//nolint:paralleltest // sets environment variables
+func TestOpenEnvironment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/preview/environments/myorg/dev/open":
+			fmt.Fprint(w, `{"id": "session"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/preview/environments/myorg/dev/open/session":
+			fmt.Fprint(w, `{"properties": {
+				"region": {"value": "us-west-2"},
+				"replicas": {"value": 3},
+				"aws": {"value": {"login": {"value": {
+					"accessKeyId": {"value": "AKIA"},
+					"secretAccessKey": {"value": "shh", "secret": true}
+				}}}}
+			}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	t.Setenv("PULUMI_CREDENTIALS_PATH", t.TempDir())
+	t.Setenv("PULUMI_BACKEND_URL", srv.URL)
+	t.Setenv("PULUMI_ACCESS_TOKEN", "test-token")
+
+	ctx, err := pulumi.NewContext(context.Background(), pulumi.RunInfo{Organization: "myorg"})
+	assert.NoError(t, err)
+
+	env, err := OpenEnvironment(ctx, "dev")
+	assert.NoError(t, err)
+
+	await := func(out pulumi.Output) (interface{}, bool) {
+		v, known, secret, _, err := internal.AwaitOutput(context.Background(), out)
+		assert.NoError(t, err)
+		assert.True(t, known)
+		return v, secret
+	}
+
+	region, err := env.GetString("region")
+	assert.NoError(t, err)
+	v, secret := await(region)
+	assert.Equal(t, "us-west-2", v)
+	assert.False(t, secret)
+
+	replicas, err := env.Get("replicas")
+	assert.NoError(t, err)
+	v, secret = await(replicas)
+	assert.Equal(t, 3.0, v)
+	assert.False(t, secret)
+
+	key, err := env.GetString("aws.login.secretAccessKey")
+	assert.NoError(t, err)
+	v, secret = await(key)
+	assert.Equal(t, "shh", v)
+	assert.True(t, secret)
+
+	login, err := env.Get("aws.login")
+	assert.NoError(t, err)
+	v, secret = await(login)
+	assert.Equal(t, map[string]interface{}{"accessKeyId": "AKIA", "secretAccessKey": "shh"}, v)
+	assert.True(t, secret)
+
+	_, err = env.Get("aws.missing")
+	assert.EqualError(t, err, "environment myorg/dev has no value at aws.missing")
+	_, err = env.GetString("replicas")
+	assert.EqualError(t, err, "environment myorg/dev: replicas is not a string")
+
+	_, err = OpenEnvironment(ctx, "otherorg/dev")
+	assert.ErrorContains(t, err, "opening environment otherorg/dev")
+
+	t.Setenv("PULUMI_BACKEND_URL", "file://~")
+	_, err = OpenEnvironment(ctx, "dev")
+	assert.EqualError(t, err, "opening environments requires a Pulumi Cloud login")
+}
@@ -0,0 +1,196 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/pulumi/esc"
+	esc_client "github.com/pulumi/esc/cmd/esc/cli/client"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/version"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The environment variable that holds the access token used to open environments.
+//
+//nolint:gosec
+const accessTokenEnvVar = "PULUMI_ACCESS_TOKEN"
+
+// The duration for which environments are opened. Short-lived credentials produced by an environment
+// remain valid for at most this long.
+const environmentOpenDuration = 2 * time.Hour
+
+// Environment is an opened Pulumi ESC environment.
+type Environment struct {
+	name       string
+	properties map[string]esc.Value
+}
+
+// OpenEnvironment opens the named Pulumi ESC environment, evaluating its values and generating any
+// short-lived credentials that it defines. The name may be qualified with an organization as "org/env";
+// otherwise the organization of the current stack is used.
+//
+// The environment is opened with the credentials of the current Pulumi Cloud login, or with the access token
+// held by PULUMI_ACCESS_TOKEN for the backend held by PULUMI_BACKEND_URL, if set.
+func OpenEnvironment(ctx *pulumi.Context, name string) (*Environment, error) {
+	orgName, envName, ok := strings.Cut(name, "/")
+	if !ok {
+		orgName, envName = ctx.Organization(), name
+	}
+	if orgName == "" || envName == "" {
+		return nil, fmt.Errorf("invalid environment name %q", name)
+	}
+
+	backendURL, err := workspace.GetCurrentCloudURL(nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting the current backend: %w", err)
+	}
+	if !strings.HasPrefix(backendURL, "https://") && !strings.HasPrefix(backendURL, "http://") {
+		return nil, errors.New("opening environments requires a Pulumi Cloud login")
+	}
+
+	token := os.Getenv(accessTokenEnvVar)
+	if token == "" {
+		account, err := workspace.GetAccount(backendURL)
+		if err != nil {
+			return nil, fmt.Errorf("getting the credentials for %v: %w", backendURL, err)
+		}
+		token = account.AccessToken
+	}
+	if token == "" {
+		return nil, fmt.Errorf("no access token for %v; run `pulumi login` or set %v", backendURL, accessTokenEnvVar)
+	}
+
+	userAgent := fmt.Sprintf("pulumi-sdk-go/1 (%s; %s)", version.Version, runtime.GOOS)
+	client := esc_client.New(userAgent, backendURL, token, workspace.GetCloudInsecure(backendURL))
+
+	id, diags, err := client.OpenEnvironment(ctx.Context(), orgName, envName, environmentOpenDuration)
+	if err != nil {
+		return nil, fmt.Errorf("opening environment %v/%v: %w", orgName, envName, err)
+	}
+	if len(diags) != 0 {
+		var sb strings.Builder
+		for _, d := range diags {
+			fmt.Fprintf(&sb, "\n\t%v", d.Summary)
+		}
+		return nil, fmt.Errorf("opening environment %v/%v:%v", orgName, envName, sb.String())
+	}
+
+	env, err := client.GetOpenEnvironment(ctx.Context(), orgName, envName, id)
+	if err != nil {
+		return nil, fmt.Errorf("reading environment %v/%v: %w", orgName, envName, err)
+	}
+	return &Environment{name: orgName + "/" + envName, properties: env.Properties}, nil
+}
+
+// Get returns the value at the given property path within the environment, e.g. "aws.login.accessKeyId"
+// or "hosts[0]", as an Output. Objects are returned as maps, arrays as slices, and numbers as float64s.
+// The Output is secret if the value is or contains a secret.
+func (e *Environment) Get(path string) (pulumi.Output, error) {
+	v, err := e.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	plain, secret := environmentValue(v)
+	if secret {
+		return pulumi.ToSecret(plain), nil
+	}
+	return pulumi.ToOutput(plain), nil
+}
+
+// GetString returns the string at the given property path within the environment. See Get.
+func (e *Environment) GetString(path string) (pulumi.StringOutput, error) {
+	v, err := e.lookup(path)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+	plain, secret := environmentValue(v)
+	s, ok := plain.(string)
+	if !ok {
+		return pulumi.StringOutput{}, fmt.Errorf("environment %v: %v is not a string", e.name, path)
+	}
+	if secret {
+		return pulumi.ToSecret(pulumi.String(s)).(pulumi.StringOutput), nil
+	}
+	return pulumi.String(s).ToStringOutput(), nil
+}
+
+// lookup finds the value at the given property path.
+func (e *Environment) lookup(path string) (esc.Value, error) {
+	p, err := resource.ParsePropertyPath(path)
+	if err != nil {
+		return esc.Value{}, fmt.Errorf("environment %v: %w", e.name, err)
+	}
+
+	v := esc.NewValue(e.properties)
+	for _, key := range p {
+		var found bool
+		switch key := key.(type) {
+		case string:
+			if m, ok := v.Value.(map[string]esc.Value); ok {
+				v, found = m[key]
+			}
+		case int:
+			if a, ok := v.Value.([]esc.Value); ok && key >= 0 && key < len(a) {
+				v, found = a[key], true
+			}
+		}
+		if !found {
+			return esc.Value{}, fmt.Errorf("environment %v has no value at %v", e.name, path)
+		}
+	}
+	return v, nil
+}
+
+// environmentValue converts an environment value into a plain Go value,
+// reporting whether it is or contains a secret.
+func environmentValue(v esc.Value) (interface{}, bool) {
+	secret := v.Secret
+	switch pv := v.Value.(type) {
+	case []esc.Value:
+		a := make([]interface{}, len(pv))
+		for i, e := range pv {
+			var s bool
+			a[i], s = environmentValue(e)
+			secret = secret || s
+		}
+		return a, secret
+	case map[string]esc.Value:
+		m := make(map[string]interface{}, len(pv))
+		for k, e := range pv {
+			var s bool
+			m[k], s = environmentValue(e)
+			secret = secret || s
+		}
+		return m, secret
+	case json.Number:
+		f, err := pv.Float64()
+		if err != nil {
+			return pv.String(), secret
+		}
+		return f, secret
+	default:
+		return pv, secret
+	}
+}
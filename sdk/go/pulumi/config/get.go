@@ -76,6 +76,18 @@ func GetBool(ctx *pulumi.Context, key string) bool {
 	return getBool(ctx, key, "GetSecretBool", "GetBool")
 }
 
+func getFloat32(ctx *pulumi.Context, key, use, insteadOf string) float32 {
+	if v, ok := get(ctx, key, use, insteadOf); ok {
+		return cast.ToFloat32(v)
+	}
+	return 0
+}
+
+// GetFloat32 loads an optional configuration value by its key, as a float32, or returns 0 if it doesn't exist.
+func GetFloat32(ctx *pulumi.Context, key string) float32 {
+	return getFloat32(ctx, key, "GetSecretFloat32", "GetFloat32")
+}
+
 func getFloat64(ctx *pulumi.Context, key, use, insteadOf string) float64 {
 	if v, ok := get(ctx, key, use, insteadOf); ok {
 		return cast.ToFloat64(v)
@@ -100,6 +112,30 @@ func GetInt(ctx *pulumi.Context, key string) int {
 	return getInt(ctx, key, "GetSecretInt", "GetInt")
 }
 
+func getInt64(ctx *pulumi.Context, key, use, insteadOf string) int64 {
+	if v, ok := get(ctx, key, use, insteadOf); ok {
+		return cast.ToInt64(v)
+	}
+	return 0
+}
+
+// GetInt64 loads an optional configuration value by its key, as a int64, or returns 0 if it doesn't exist.
+func GetInt64(ctx *pulumi.Context, key string) int64 {
+	return getInt64(ctx, key, "GetSecretInt64", "GetInt64")
+}
+
+func getUint64(ctx *pulumi.Context, key, use, insteadOf string) uint64 {
+	if v, ok := get(ctx, key, use, insteadOf); ok {
+		return cast.ToUint64(v)
+	}
+	return 0
+}
+
+// GetUint64 loads an optional configuration value by its key, as a uint64, or returns 0 if it doesn't exist.
+func GetUint64(ctx *pulumi.Context, key string) uint64 {
+	return getUint64(ctx, key, "GetSecretUint64", "GetUint64")
+}
+
 // GetSecret loads an optional configuration value by its key, or "" if it does not exist, into a secret Output.
 func GetSecret(ctx *pulumi.Context, key string) pulumi.StringOutput {
 	v, _ := get(ctx, key, "", "")
@@ -121,6 +157,12 @@ func GetSecretBool(ctx *pulumi.Context, key string) pulumi.BoolOutput {
 	return pulumi.ToSecret(getBool(ctx, key, "", "")).(pulumi.BoolOutput)
 }
 
+// GetSecretFloat32 loads an optional float32 configuration value by its key,
+// or false if it does not exist, into a secret Output.
+func GetSecretFloat32(ctx *pulumi.Context, key string) pulumi.Float32Output {
+	return pulumi.ToSecret(getFloat32(ctx, key, "", "")).(pulumi.Float32Output)
+}
+
 // GetSecretFloat64 loads an optional float64 configuration value by its key,
 // or false if it does not exist, into a secret Output.
 func GetSecretFloat64(ctx *pulumi.Context, key string) pulumi.Float64Output {
@@ -132,3 +174,15 @@ func GetSecretFloat64(ctx *pulumi.Context, key string) pulumi.Float64Output {
 func GetSecretInt(ctx *pulumi.Context, key string) pulumi.IntOutput {
 	return pulumi.ToSecret(getInt(ctx, key, "", "")).(pulumi.IntOutput)
 }
+
+// GetSecretInt64 loads an optional int64 configuration value by its key,
+// or false if it does not exist, into a secret Output.
+func GetSecretInt64(ctx *pulumi.Context, key string) pulumi.Int64Output {
+	return pulumi.ToSecret(getInt64(ctx, key, "", "")).(pulumi.Int64Output)
+}
+
+// GetSecretUint64 loads an optional uint64 configuration value by its key,
+// or false if it does not exist, into a secret Output.
+func GetSecretUint64(ctx *pulumi.Context, key string) pulumi.Uint64Output {
+	return pulumi.ToSecret(getUint64(ctx, key, "", "")).(pulumi.Uint64Output)
+}
@@ -77,6 +77,20 @@ func RequireBool(ctx *pulumi.Context, key string) bool {
 	return requireBool(ctx, key, false, "RequireSecretBool", "RequireBool")
 }
 
+func requireFloat32(ctx *pulumi.Context, key string, secret bool, use, insteadOf string) float32 {
+	v := require(ctx, key, secret, use, insteadOf)
+	o, err := cast.ToFloat32E(v)
+	if err != nil {
+		failf("unable to parse required configuration variable '%s'; %s", key, err)
+	}
+	return o
+}
+
+// RequireFloat32 loads an optional configuration value by its key, as a float32, or panics if it doesn't exist.
+func RequireFloat32(ctx *pulumi.Context, key string) float32 {
+	return requireFloat32(ctx, key, false, "RequireSecretFloat32", "RequireFloat32")
+}
+
 func requireFloat64(ctx *pulumi.Context, key string, secret bool, use, insteadOf string) float64 {
 	v := require(ctx, key, secret, use, insteadOf)
 	o, err := cast.ToFloat64E(v)
@@ -105,6 +119,34 @@ func RequireInt(ctx *pulumi.Context, key string) int {
 	return requireInt(ctx, key, false, "RequireSecretInt", "RequireInt")
 }
 
+func requireInt64(ctx *pulumi.Context, key string, secret bool, use, insteadOf string) int64 {
+	v := require(ctx, key, secret, use, insteadOf)
+	o, err := cast.ToInt64E(v)
+	if err != nil {
+		failf("unable to parse required configuration variable '%s'; %s", key, err)
+	}
+	return o
+}
+
+// RequireInt64 loads an optional configuration value by its key, as a int64, or panics if it doesn't exist.
+func RequireInt64(ctx *pulumi.Context, key string) int64 {
+	return requireInt64(ctx, key, false, "RequireSecretInt64", "RequireInt64")
+}
+
+func requireUint64(ctx *pulumi.Context, key string, secret bool, use, insteadOf string) uint64 {
+	v := require(ctx, key, secret, use, insteadOf)
+	o, err := cast.ToUint64E(v)
+	if err != nil {
+		failf("unable to parse required configuration variable '%s'; %s", key, err)
+	}
+	return o
+}
+
+// RequireUint64 loads an optional configuration value by its key, as a uint64, or panics if it doesn't exist.
+func RequireUint64(ctx *pulumi.Context, key string) uint64 {
+	return requireUint64(ctx, key, false, "RequireSecretUint64", "RequireUint64")
+}
+
 // RequireSecret loads a configuration value by its key returning it wrapped in a secret Output,
 // or panics if it doesn't exist.
 func RequireSecret(ctx *pulumi.Context, key string) pulumi.StringOutput {
@@ -124,6 +166,12 @@ func RequireSecretBool(ctx *pulumi.Context, key string) pulumi.BoolOutput {
 	return pulumi.ToSecret(requireBool(ctx, key, true, "", "")).(pulumi.BoolOutput)
 }
 
+// RequireSecretFloat32 loads an optional configuration value by its key,
+// as a float32 wrapped in a secret Output, or panics if it doesn't exist.
+func RequireSecretFloat32(ctx *pulumi.Context, key string) pulumi.Float32Output {
+	return pulumi.ToSecret(requireFloat32(ctx, key, true, "", "")).(pulumi.Float32Output)
+}
+
 // RequireSecretFloat64 loads an optional configuration value by its key,
 // as a float64 wrapped in a secret Output, or panics if it doesn't exist.
 func RequireSecretFloat64(ctx *pulumi.Context, key string) pulumi.Float64Output {
@@ -135,3 +183,15 @@ func RequireSecretFloat64(ctx *pulumi.Context, key string) pulumi.Float64Output
 func RequireSecretInt(ctx *pulumi.Context, key string) pulumi.IntOutput {
 	return pulumi.ToSecret(requireInt(ctx, key, true, "", "")).(pulumi.IntOutput)
 }
+
+// RequireSecretInt64 loads an optional configuration value by its key,
+// as a int64 wrapped in a secret Output, or panics if it doesn't exist.
+func RequireSecretInt64(ctx *pulumi.Context, key string) pulumi.Int64Output {
+	return pulumi.ToSecret(requireInt64(ctx, key, true, "", "")).(pulumi.Int64Output)
+}
+
+// RequireSecretUint64 loads an optional configuration value by its key,
+// as a uint64 wrapped in a secret Output, or panics if it doesn't exist.
+func RequireSecretUint64(ctx *pulumi.Context, key string) pulumi.Uint64Output {
+	return pulumi.ToSecret(requireUint64(ctx, key, true, "", "")).(pulumi.Uint64Output)
+}
@@ -0,0 +1,258 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The tag that marks a field of a configuration object as secret, e.g.
+//
+//	type Database struct {
+//		Host     string              `json:"host"`
+//		Password pulumi.StringOutput `json:"password" config:"secret"`
+//	}
+const secretTag = "secret"
+
+var outputType = reflect.TypeOf((*pulumi.Output)(nil)).Elem()
+
+func getObjectWithSecrets(ctx *pulumi.Context, key string, output interface{}) error {
+	if v, ok := get(ctx, key, "", ""); ok {
+		return unmarshalWithSecrets(key, v, output)
+	}
+	return nil
+}
+
+// GetObjectWithSecrets attempts to load an optional configuration value by its key into the specified output variable.
+// Fields of the output tagged with `config:"secret"` must have an Output type such as pulumi.StringOutput,
+// and are loaded as secret Outputs; all other fields are loaded as plaintext,
+// so that only the tagged fields of an object are treated as secret rather than the entire object.
+func GetObjectWithSecrets(ctx *pulumi.Context, key string, output interface{}) error {
+	return getObjectWithSecrets(ctx, key, output)
+}
+
+// RequireObjectWithSecrets loads a required configuration value by its key into the output variable,
+// or panics if unable to do so. Fields tagged with `config:"secret"` are loaded as secret Outputs;
+// see GetObjectWithSecrets.
+func RequireObjectWithSecrets(ctx *pulumi.Context, key string, output interface{}) {
+	v := require(ctx, key, true, "", "")
+	if err := unmarshalWithSecrets(key, v, output); err != nil {
+		failf("unable to unmarshall required configuration variable '%s'; %s", key, err)
+	}
+}
+
+// TryObjectWithSecrets loads a configuration value by its key into the output variable,
+// returning a non-nil error if it doesn't exist. Fields tagged with `config:"secret"` are loaded as secret Outputs;
+// see GetObjectWithSecrets.
+func TryObjectWithSecrets(ctx *pulumi.Context, key string, output interface{}) error {
+	v, err := try(ctx, key, "", "")
+	if err != nil {
+		return err
+	}
+	return unmarshalWithSecrets(key, v, output)
+}
+
+// GetObjectWithSecrets loads an optional configuration value into the specified output by its key,
+// loading the fields tagged with `config:"secret"` as secret Outputs.
+func (c *Config) GetObjectWithSecrets(key string, output interface{}) error {
+	return GetObjectWithSecrets(c.ctx, c.fullKey(key), output)
+}
+
+// RequireObjectWithSecrets loads a required configuration value into the specified output by its key,
+// loading the fields tagged with `config:"secret"` as secret Outputs, or panics if unable to do so.
+func (c *Config) RequireObjectWithSecrets(key string, output interface{}) {
+	RequireObjectWithSecrets(c.ctx, c.fullKey(key), output)
+}
+
+// TryObjectWithSecrets loads a configuration value into the specified output by its key,
+// loading the fields tagged with `config:"secret"` as secret Outputs,
+// or returns an error if it doesn't exist.
+func (c *Config) TryObjectWithSecrets(key string, output interface{}) error {
+	return TryObjectWithSecrets(c.ctx, c.fullKey(key), output)
+}
+
+func unmarshalWithSecrets(key, v string, output interface{}) error {
+	rv := reflect.ValueOf(output)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("output for configuration variable '%s' must be a non-nil pointer", key)
+	}
+	return decodeWithSecrets(json.RawMessage(v), rv.Elem(), "")
+}
+
+// decodeWithSecrets decodes raw into v, loading fields tagged as secret as secret Outputs.
+// Values that contain no secret fields are decoded with encoding/json.
+func decodeWithSecrets(raw json.RawMessage, v reflect.Value, path string) error {
+	if !hasSecretFields(v.Type(), map[reflect.Type]bool{}) {
+		return json.Unmarshal(raw, v.Addr().Interface())
+	}
+	if string(raw) == "null" {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeWithSecrets(raw, v.Elem(), path)
+	case reflect.Slice:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return fmt.Errorf("%s: %w", pathOrRoot(path), err)
+		}
+		s := reflect.MakeSlice(v.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := decodeWithSecrets(elem, s.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	case reflect.Map:
+		var elems map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return fmt.Errorf("%s: %w", pathOrRoot(path), err)
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(elems))
+		for k, elem := range elems {
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeWithSecrets(elem, e, joinPath(path, k)); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), e)
+		}
+		v.Set(m)
+		return nil
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return fmt.Errorf("%s: %w", pathOrRoot(path), err)
+		}
+		return decodeStructWithSecrets(fields, v, path)
+	default:
+		return json.Unmarshal(raw, v.Addr().Interface())
+	}
+}
+
+func decodeStructWithSecrets(fields map[string]json.RawMessage, v reflect.Value, path string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+		raw, ok := lookupField(fields, name)
+		if !ok {
+			continue
+		}
+		fieldPath := joinPath(path, name)
+
+		if field.Tag.Get("config") != secretTag {
+			if err := decodeWithSecrets(raw, v.Field(i), fieldPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !field.Type.Implements(outputType) {
+			return fmt.Errorf("%s: secret field %s must have an Output type, not %v", fieldPath, field.Name, field.Type)
+		}
+		elem := reflect.New(reflect.Zero(field.Type).Interface().(pulumi.Output).ElementType())
+		if err := json.Unmarshal(raw, elem.Interface()); err != nil {
+			return fmt.Errorf("%s: %w", fieldPath, err)
+		}
+		secret := reflect.ValueOf(pulumi.ToSecret(elem.Elem().Interface()))
+		if !secret.Type().AssignableTo(field.Type) {
+			return fmt.Errorf("%s: cannot load a secret of type %v into a field of type %v",
+				fieldPath, secret.Type(), field.Type)
+		}
+		v.Field(i).Set(secret)
+	}
+	return nil
+}
+
+// hasSecretFields returns true if values of type t may contain fields tagged as secret.
+func hasSecretFields(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		return hasSecretFields(t.Elem(), seen)
+	case reflect.Struct:
+		if t.Implements(outputType) {
+			return false
+		}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Tag.Get("config") == secretTag || hasSecretFields(field.Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jsonFieldName returns the name of the JSON property that holds the given field,
+// and whether the field is ignored by encoding/json.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, false
+	}
+	return field.Name, false
+}
+
+// lookupField finds the named property, preferring an exact match but falling back
+// to a case-insensitive one like encoding/json.
+func lookupField(fields map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	if raw, ok := fields[name]; ok {
+		return raw, true
+	}
+	for k, raw := range fields {
+		if strings.EqualFold(k, name) {
+			return raw, true
+		}
+	}
+	return nil, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path
+}
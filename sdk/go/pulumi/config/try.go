@@ -84,6 +84,20 @@ func TryBool(ctx *pulumi.Context, key string) (bool, error) {
 	return tryBool(ctx, key, "TrySecretBool", "TryBool")
 }
 
+func tryFloat32(ctx *pulumi.Context, key, use, insteadOf string) (float32, error) {
+	v, err := try(ctx, key, use, insteadOf)
+	if err != nil {
+		return 0, err
+	}
+	return cast.ToFloat32E(v)
+}
+
+// TryFloat32 loads an optional configuration value by its key, as a float32,
+// or returns an error if it doesn't exist or can't be parsed.
+func TryFloat32(ctx *pulumi.Context, key string) (float32, error) {
+	return tryFloat32(ctx, key, "TrySecretFloat32", "TryFloat32")
+}
+
 func tryFloat64(ctx *pulumi.Context, key, use, insteadOf string) (float64, error) {
 	v, err := try(ctx, key, use, insteadOf)
 	if err != nil {
@@ -112,6 +126,34 @@ func TryInt(ctx *pulumi.Context, key string) (int, error) {
 	return tryInt(ctx, key, "TrySecretInt", "TryInt")
 }
 
+func tryInt64(ctx *pulumi.Context, key, use, insteadOf string) (int64, error) {
+	v, err := try(ctx, key, use, insteadOf)
+	if err != nil {
+		return 0, err
+	}
+	return cast.ToInt64E(v)
+}
+
+// TryInt64 loads an optional configuration value by its key, as a int64,
+// or returns an error if it doesn't exist or can't be parsed.
+func TryInt64(ctx *pulumi.Context, key string) (int64, error) {
+	return tryInt64(ctx, key, "TrySecretInt64", "TryInt64")
+}
+
+func tryUint64(ctx *pulumi.Context, key, use, insteadOf string) (uint64, error) {
+	v, err := try(ctx, key, use, insteadOf)
+	if err != nil {
+		return 0, err
+	}
+	return cast.ToUint64E(v)
+}
+
+// TryUint64 loads an optional configuration value by its key, as a uint64,
+// or returns an error if it doesn't exist or can't be parsed.
+func TryUint64(ctx *pulumi.Context, key string) (uint64, error) {
+	return tryUint64(ctx, key, "TrySecretUint64", "TryUint64")
+}
+
 // TrySecret loads a configuration value by its key, returning a non-nil error if it doesn't exist.
 func TrySecret(ctx *pulumi.Context, key string) (pulumi.StringOutput, error) {
 	v, err := try(ctx, key, "", "")
@@ -144,6 +186,17 @@ func TrySecretBool(ctx *pulumi.Context, key string) (pulumi.BoolOutput, error) {
 	return pulumi.ToSecret(pulumi.Bool(v)).(pulumi.BoolOutput), nil
 }
 
+// TrySecretFloat32 loads an optional configuration value by its key, as a float32,
+// or returns an error if it doesn't exist.
+func TrySecretFloat32(ctx *pulumi.Context, key string) (pulumi.Float32Output, error) {
+	v, err := tryFloat32(ctx, key, "", "")
+	if err != nil {
+		var empty pulumi.Float32Output
+		return empty, err
+	}
+	return pulumi.ToSecret(pulumi.Float32(v)).(pulumi.Float32Output), nil
+}
+
 // TrySecretFloat64 loads an optional configuration value by its key, as a float64,
 // or returns an error if it doesn't exist.
 func TrySecretFloat64(ctx *pulumi.Context, key string) (pulumi.Float64Output, error) {
@@ -165,3 +218,25 @@ func TrySecretInt(ctx *pulumi.Context, key string) (pulumi.IntOutput, error) {
 	}
 	return pulumi.ToSecret(pulumi.Int(v)).(pulumi.IntOutput), nil
 }
+
+// TrySecretInt64 loads an optional configuration value by its key, as a int64,
+// or returns an error if it doesn't exist.
+func TrySecretInt64(ctx *pulumi.Context, key string) (pulumi.Int64Output, error) {
+	v, err := tryInt64(ctx, key, "", "")
+	if err != nil {
+		var empty pulumi.Int64Output
+		return empty, err
+	}
+	return pulumi.ToSecret(pulumi.Int64(v)).(pulumi.Int64Output), nil
+}
+
+// TrySecretUint64 loads an optional configuration value by its key, as a uint64,
+// or returns an error if it doesn't exist.
+func TrySecretUint64(ctx *pulumi.Context, key string) (pulumi.Uint64Output, error) {
+	v, err := tryUint64(ctx, key, "", "")
+	if err != nil {
+		var empty pulumi.Uint64Output
+		return empty, err
+	}
+	return pulumi.ToSecret(pulumi.Uint64(v)).(pulumi.Uint64Output), nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/url"
 	"os"
 	"path"
@@ -29,6 +30,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	multierror "github.com/hashicorp/go-multierror"
@@ -55,6 +57,7 @@ type Context struct {
 	info        RunInfo
 	stack       Resource
 	exports     map[string]Input
+	exportMeta  map[string]ExportMeta
 	monitor     pulumirpc.ResourceMonitorClient
 	monitorConn *grpc.ClientConn
 	engine      pulumirpc.EngineClient
@@ -68,9 +71,14 @@ type Context struct {
 	rpcsDone            *sync.Cond // an event signaling completion of RPCs.
 	rpcsLock            sync.Mutex // a lock protecting the RPC count and event.
 	rpcError            error      // the first error (if any) encountered during an RPC.
+	validationErrors    []error    // the args validation failures (if any), protected by rpcsLock.
 
 	join workGroup // the waitgroup for non-RPC async work associated with this context
 
+	now        func() time.Time // the clock used for timestamps.
+	random     *rand.Rand       // the random source used for autonaming.
+	randomLock sync.Mutex       // a lock protecting the random source.
+
 	Log Log // the logging interface for the Pulumi log stream.
 }
 
@@ -84,6 +92,7 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 			info.MonitorAddr,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
 			rpcutil.GrpcChannelOptions(),
+			grpc.WithChainUnaryInterceptor(info.rpcInterceptors...),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("connecting to resource monitor over RPC: %w", err)
@@ -102,6 +111,7 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 			info.EngineAddr,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
 			rpcutil.GrpcChannelOptions(),
+			grpc.WithChainUnaryInterceptor(info.rpcInterceptors...),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("connecting to engine over RPC: %w", err)
@@ -110,9 +120,10 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 		engine = pulumirpc.NewEngineClient(engineConn)
 	}
 
+	var mocks *mockMonitor
 	if info.Mocks != nil {
-		monitor = &mockMonitor{project: info.Project, stack: info.Stack, mocks: info.Mocks}
-		engine = &mockEngine{}
+		mocks = &mockMonitor{project: info.Project, stack: info.Stack, mocks: info.Mocks}
+		monitor, engine = mocks, &mockEngine{}
 	}
 
 	if wrap := info.wrapResourceMonitorClient; wrap != nil {
@@ -162,6 +173,17 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 		keepOutputValues:    keepOutputValues,
 		supportsDeletedWith: supportsDeletedWith,
 		supportsAliasSpecs:  supportsAliasSpecs,
+		now:                 time.Now,
+		random:              rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec // not for security
+	}
+	if mocks != nil {
+		if info.clock != nil {
+			context.now = info.clock
+		}
+		if info.randomSeed != nil {
+			context.random = rand.New(rand.NewSource(*info.randomSeed)) //nolint:gosec // not for security
+		}
+		mocks.autoname = context.Autoname
 	}
 	context.rpcsDone = sync.NewCond(&context.rpcsLock)
 	context.Log = &logState{
@@ -210,6 +232,9 @@ func (ctx *Context) wait() error {
 	// Mark the RPCs flag so that no more RPCs are permitted.
 	ctx.rpcs = noMoreRPCs
 
+	if err := ctx.validationError(); err != nil {
+		return err
+	}
 	if ctx.rpcError != nil {
 		return fmt.Errorf("waiting for RPCs: %w", ctx.rpcError)
 	}
@@ -229,6 +254,25 @@ func (ctx *Context) Organization() string {
 // Project returns the current project name.
 func (ctx *Context) Project() string { return ctx.info.Project }
 
+// StackTags returns the tags of the stack being deployed into.
+// Stacks in backends that don't support tags have none.
+func (ctx *Context) StackTags() map[string]string {
+	tags := make(map[string]string, len(ctx.info.StackTags))
+	for k, v := range ctx.info.StackTags {
+		tags[k] = v
+	}
+	return tags
+}
+
+// ProjectRuntimeOptions returns the options given to the runtime in the project file, e.g. "binary" or "buildTarget".
+func (ctx *Context) ProjectRuntimeOptions() map[string]interface{} {
+	opts := make(map[string]interface{}, len(ctx.info.RuntimeOptions))
+	for k, v := range ctx.info.RuntimeOptions {
+		opts[k] = v
+	}
+	return opts
+}
+
 // Stack returns the current stack name being deployed into.
 func (ctx *Context) Stack() string { return ctx.info.Stack }
 
@@ -244,6 +288,20 @@ func (ctx *Context) RunningWithMocks() bool {
 	return isMockMonitor
 }
 
+// Now returns the current time. When running with mocks, the clock may be replaced using WithClock.
+func (ctx *Context) Now() time.Time {
+	return ctx.now()
+}
+
+// Autoname returns name followed by a hyphen and seven random lowercase hexadecimal digits, matching the names that
+// providers generate for resources. When running with mocks, it names the resources passed to the mocks, and the
+// random source may be seeded using WithRandomSeed.
+func (ctx *Context) Autoname(name string) string {
+	ctx.randomLock.Lock()
+	defer ctx.randomLock.Unlock()
+	return fmt.Sprintf("%s-%07x", name, ctx.random.Int63n(1<<28))
+}
+
 // GetConfig returns the config value, as a string, and a bool indicating whether it exists or not.
 func (ctx *Context) GetConfig(key string) (string, bool) {
 	v, ok := ctx.info.Config[key]
@@ -654,6 +712,12 @@ func (ctx *Context) ReadResource(
 	res := ctx.makeResourceState(t, name, resource, providers, provider,
 		options.Version, options.PluginDownloadURL, aliasURNs, transformations)
 
+	var drift MapOutput
+	if options.DriftReport != nil {
+		drift = ctx.newOutput(reflect.TypeOf(MapOutput{}), resource).(MapOutput)
+		*options.DriftReport = drift
+	}
+
 	// Get the source position for the resource registration. Note that this assumes that there is an intermediate
 	// between the this function and user code.
 	sourcePosition := ctx.getSourcePosition(2)
@@ -667,6 +731,9 @@ func (ctx *Context) ReadResource(
 		var err error
 		defer func() {
 			res.resolve(ctx, err, inputs, urn, resID, state, nil)
+			if drift.OutputState != nil {
+				resolveDriftReport(drift, err, inputs, state, resource)
+			}
 			ctx.endRPC(err)
 		}()
 
@@ -708,6 +775,82 @@ func (ctx *Context) ReadResource(
 	return nil
 }
 
+// resolveDriftReport resolves the output requested with the DriftReport option
+// by comparing the properties supplied to ReadResource with the state that was read.
+func resolveDriftReport(drift MapOutput, err error, inputs *resourceInputs, state *structpb.Struct, res Resource) {
+	if err == nil && inputs == nil {
+		// The read was skipped because the ID was unknown.
+		internal.ResolveOutput(drift, nil, false, false, []internal.Resource{res})
+		return
+	}
+	if err == nil {
+		var report map[string]interface{}
+		var known, secret bool
+		report, known, secret, err = computeDriftReport(inputs.rpcProps, state)
+		if err == nil {
+			internal.ResolveOutput(drift, report, known, secret, []internal.Resource{res})
+			return
+		}
+	}
+	internal.RejectOutput(drift, err)
+}
+
+// computeDriftReport compares the properties supplied to a read with the state that was read.
+func computeDriftReport(supplied, actual *structpb.Struct) (map[string]interface{}, bool, bool, error) {
+	opts := plugin.MarshalOptions{KeepUnknowns: true, KeepSecrets: true}
+	expectedProps, err := plugin.UnmarshalProperties(supplied, opts)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("unmarshaling supplied properties: %w", err)
+	}
+	actualProps, err := plugin.UnmarshalProperties(actual, opts)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("unmarshaling read properties: %w", err)
+	}
+
+	report := map[string]interface{}{}
+	secret := false
+	for k, expected := range expectedProps {
+		if expected.IsNull() {
+			continue
+		}
+		got := actualProps[k]
+		if got.ContainsUnknowns() || expected.ContainsUnknowns() {
+			return nil, false, false, nil
+		}
+		if expected.DeepEquals(got) {
+			continue
+		}
+		secret = secret || expected.ContainsSecrets() || got.ContainsSecrets()
+		report[string(k)] = map[string]interface{}{
+			"expected": unwrapSecrets(expected).Mappable(),
+			"actual":   unwrapSecrets(got).Mappable(),
+		}
+	}
+	return report, true, secret, nil
+}
+
+// unwrapSecrets replaces secret values with their plaintext so that they can be converted to plain Go values.
+func unwrapSecrets(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsSecret():
+		return unwrapSecrets(v.SecretValue().Element)
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = unwrapSecrets(e)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		obj := make(resource.PropertyMap, len(v.ObjectValue()))
+		for k, e := range v.ObjectValue() {
+			obj[k] = unwrapSecrets(e)
+		}
+		return resource.NewObjectProperty(obj)
+	default:
+		return v
+	}
+}
+
 // RegisterResource creates and registers a new resource object. t is the fully qualified type token and name is
 // the "name" part to use in creating a stable and globally unique URN for the object. props contains the goal state
 // for the resource object and opts contains optional settings that govern the way the resource is created.
@@ -809,6 +952,10 @@ func (ctx *Context) registerResource(
 
 	options := merge(opts...)
 
+	if options.DriftReport != nil {
+		return errors.New("the DriftReport option is only supported by ReadResource")
+	}
+
 	if parent := options.Parent; parent != nil && internal.GetOutputState(parent.URN()) == nil {
 		// Guard against uninitialized parent resources to prevent
 		// panics from invalid state further down the line.
@@ -846,6 +993,10 @@ func (ctx *Context) registerResource(
 		return err
 	}
 
+	// Give self-validating args a chance to reject the resource. Failures are reported when the program completes
+	// so that they may be aggregated across resources.
+	validationErr := ctx.validateArgs(t, name, props)
+
 	// Collapse aliases to URNs.
 	var aliasURNs []URNOutput
 	if options.Aliases != nil {
@@ -882,6 +1033,8 @@ func (ctx *Context) registerResource(
 	// frames between this function and user code.
 	sourcePosition := ctx.getSourcePosition(3)
 
+	start := ctx.now()
+
 	// Kick off the resource registration.  If we are actually performing a deployment, the resulting properties
 	// will be resolved asynchronously as the RPC operation completes.  If we're just planning, values won't resolve.
 	go func() {
@@ -896,6 +1049,11 @@ func (ctx *Context) registerResource(
 			ctx.endRPC(err)
 		}()
 
+		if validationErr != nil {
+			err = validationErr
+			return
+		}
+
 		// Prepare the inputs for an impending operation.
 		inputs, err = ctx.prepareResourceInputs(resource, props, t, options, resState, remote, custom)
 		if err != nil {
@@ -943,6 +1101,7 @@ func (ctx *Context) registerResource(
 				DeleteBeforeReplace:     inputs.deleteBeforeReplace,
 				ImportId:                inputs.importID,
 				CustomTimeouts:          inputs.customTimeouts,
+				RetryPolicy:             inputs.retryPolicy,
 				IgnoreChanges:           inputs.ignoreChanges,
 				AliasURNs:               aliasURNs,
 				Aliases:                 aliases,
@@ -956,6 +1115,7 @@ func (ctx *Context) registerResource(
 				RetainOnDelete:          inputs.retainOnDelete,
 				DeletedWith:             inputs.deletedWith,
 				SourcePosition:          sourcePosition,
+				Frozen:                  inputs.frozen,
 			})
 			if err != nil {
 				logging.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -967,6 +1127,12 @@ func (ctx *Context) registerResource(
 		if resp != nil {
 			urn, resID = resp.Urn, resp.Id
 			state = resp.Object
+			if err == nil {
+				elapsed := ctx.now().Sub(start)
+				for _, hook := range ctx.info.resourceRegisteredHooks {
+					hook(URN(urn), elapsed)
+				}
+			}
 			for key, propertyDependencies := range resp.GetPropertyDependencies() {
 				var resources []Resource
 				for _, urn := range propertyDependencies.GetUrns() {
@@ -1198,6 +1364,7 @@ func (ctx *Context) makeResourceState(t, name string, resourceV Resource, provid
 			}
 
 			output := ctx.newOutput(field.Type, resourceV)
+			internal.SetOutputOrigin(output, resourceV, tag)
 			fieldV.Set(reflect.ValueOf(output))
 
 			if tag == "" && field.Type != mapOutputType {
@@ -1217,6 +1384,7 @@ func (ctx *Context) makeResourceState(t, name string, resourceV Resource, provid
 	if crs != nil {
 		rs = &crs.ResourceState
 		crs.id = IDOutput{ctx.newOutputState(idType, resourceV)}
+		internal.SetOutputOrigin(crs.id, resourceV, "id")
 		state.outputs["id"] = crs.id
 	}
 
@@ -1241,6 +1409,7 @@ func (ctx *Context) makeResourceState(t, name string, resourceV Resource, provid
 		state.pluginDownloadURL = pluginDownloadURL
 		rs.pluginDownloadURL = pluginDownloadURL
 		rs.urn = URNOutput{ctx.newOutputState(urnType, resourceV)}
+		internal.SetOutputOrigin(rs.urn, resourceV, "urn")
 		state.outputs["urn"] = rs.urn
 		state.name = name
 		rs.name = name
@@ -1347,6 +1516,7 @@ type resourceInputs struct {
 	deleteBeforeReplace     bool
 	importID                string
 	customTimeouts          *pulumirpc.RegisterResourceRequest_CustomTimeouts
+	retryPolicy             *pulumirpc.RegisterResourceRequest_RetryPolicy
 	ignoreChanges           []string
 	aliases                 []*pulumirpc.Alias
 	additionalSecretOutputs []string
@@ -1355,6 +1525,7 @@ type resourceInputs struct {
 	replaceOnChanges        []string
 	retainOnDelete          bool
 	deletedWith             string
+	frozen                  bool
 }
 
 func (ctx *Context) resolveAliasParent(alias Alias, spec *pulumirpc.Alias_Spec) error {
@@ -1541,6 +1712,13 @@ func (ctx *Context) prepareResourceInputs(res Resource, props Input, t string, o
 		return nil, fmt.Errorf("resolving options: %w", err)
 	}
 
+	// Wait for any resources this one must be registered after.
+	for _, r := range opts.Sequential {
+		if _, _, _, err := r.URN().awaitURN(ctx.ctx); err != nil {
+			return nil, fmt.Errorf("waiting for preceding resource: %w", err)
+		}
+	}
+
 	// Serialize all properties, first by awaiting them, and then marshaling them to the requisite gRPC values.
 	resolvedProps, propertyDeps, rpcDeps, err := marshalInputs(props)
 	if err != nil {
@@ -1612,6 +1790,7 @@ func (ctx *Context) prepareResourceInputs(res Resource, props Input, t string, o
 		deleteBeforeReplace:     resOpts.deleteBeforeReplace,
 		importID:                string(resOpts.importID),
 		customTimeouts:          getTimeouts(opts.CustomTimeouts),
+		retryPolicy:             getRetryPolicy(opts.RetryPolicy),
 		ignoreChanges:           resOpts.ignoreChanges,
 		aliases:                 aliases,
 		additionalSecretOutputs: resOpts.additionalSecretOutputs,
@@ -1620,6 +1799,7 @@ func (ctx *Context) prepareResourceInputs(res Resource, props Input, t string, o
 		replaceOnChanges:        resOpts.replaceOnChanges,
 		retainOnDelete:          opts.RetainOnDelete,
 		deletedWith:             string(deletedWithURN),
+		frozen:                  opts.Frozen,
 	}, nil
 }
 
@@ -1633,6 +1813,18 @@ func getTimeouts(custom *CustomTimeouts) *pulumirpc.RegisterResourceRequest_Cust
 	return &timeouts
 }
 
+func getRetryPolicy(policy *RetryPolicy) *pulumirpc.RegisterResourceRequest_RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &pulumirpc.RegisterResourceRequest_RetryPolicy{
+		MaxRetries:   int32(policy.MaxRetries),
+		InitialDelay: policy.InitialDelay,
+		MaxDelay:     policy.MaxDelay,
+		RetryOn:      policy.RetryOn,
+	}
+}
+
 // Helper struct for the return type of `getOpts`.
 type resourceOpts struct {
 	parentURN               URN
@@ -1770,6 +1962,24 @@ func (ctx *Context) endRPC(err error) {
 
 // RegisterResourceOutputs completes the resource registration, attaching an optional set of computed outputs.
 func (ctx *Context) RegisterResourceOutputs(resource Resource, outs Map) error {
+	return ctx.registerResourceOutputs(resource, outs, nil /* metadata */)
+}
+
+// RegisterResourceOutputsStruct completes the resource registration like RegisterResourceOutputs, but accepts the
+// outputs as any map with string keys, or as a struct (or pointer to a struct) whose fields are tagged with
+// `pulumi:"..."`. Untagged fields are ignored, so a component may pass itself to register its output fields.
+func (ctx *Context) RegisterResourceOutputsStruct(resource Resource, outs interface{}) error {
+	return ctx.registerResourceOutputs(resource, outs, nil /* metadata */)
+}
+
+func (ctx *Context) registerResourceOutputs(res Resource, outs interface{},
+	metadata map[string]*pulumirpc.RegisterResourceOutputsRequest_OutputMetadata,
+) error {
+	outs, outsType, err := resourceOutputsValue(outs)
+	if err != nil {
+		return err
+	}
+
 	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
 	if err := ctx.beginRPC(); err != nil {
 		return err
@@ -1783,18 +1993,22 @@ func (ctx *Context) RegisterResourceOutputs(resource Resource, outs Map) error {
 			ctx.endRPC(err)
 		}()
 
-		urn, _, _, err := resource.URN().awaitURN(context.TODO())
+		urn, _, _, err := res.URN().awaitURN(context.TODO())
 		if err != nil {
 			return
 		}
 
-		outsResolved, _, err := marshalInput(outs, anyType, true)
+		outsResolved, _, err := marshalInput(outs, outsType, true)
 		if err != nil {
 			return
 		}
+		var outsMap resource.PropertyMap
+		if outsResolved.IsObject() {
+			outsMap = outsResolved.ObjectValue()
+		}
 
 		outsMarshalled, err := plugin.MarshalProperties(
-			outsResolved.ObjectValue(),
+			outsMap,
 			ctx.withKeepOrRejectUnknowns(plugin.MarshalOptions{
 				KeepSecrets:   true,
 				KeepResources: ctx.keepResources,
@@ -1806,8 +2020,9 @@ func (ctx *Context) RegisterResourceOutputs(resource Resource, outs Map) error {
 		// Register the outputs
 		logging.V(9).Infof("RegisterResourceOutputs(%s): RPC call being made", urn)
 		_, err = ctx.monitor.RegisterResourceOutputs(ctx.ctx, &pulumirpc.RegisterResourceOutputsRequest{
-			Urn:     string(urn),
-			Outputs: outsMarshalled,
+			Urn:            string(urn),
+			Outputs:        outsMarshalled,
+			OutputMetadata: metadata,
 		})
 
 		logging.V(9).Infof("RegisterResourceOutputs(%s): %v", urn, err)
@@ -1816,9 +2031,106 @@ func (ctx *Context) RegisterResourceOutputs(resource Resource, outs Map) error {
 	return nil
 }
 
+// resourceOutputsValue validates the outputs passed to RegisterResourceOutputsStruct,
+// returning the value to marshal and the type to marshal it as.
+// Structs that are not inputs are converted to a map keyed by their `pulumi` tags.
+func resourceOutputsValue(outs interface{}) (interface{}, reflect.Type, error) {
+	if outs == nil {
+		return nil, anyType, nil
+	}
+
+	if input, ok := outs.(Input); ok {
+		switch t := input.ElementType(); {
+		case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String:
+			return outs, anyType, nil
+		case t.Kind() == reflect.Struct:
+			return outs, t, nil
+		}
+		return nil, nil, fmt.Errorf("resource outputs must be a map or a struct, got %T", outs)
+	}
+
+	v := reflect.ValueOf(outs)
+	if v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct {
+		// Dereference pointers to structs (e.g. a component passing itself)
+		// so that they are not marshaled as resource references.
+		if v.IsNil() {
+			return nil, anyType, nil
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		return outs, anyType, nil
+	case v.Kind() == reflect.Struct:
+		m := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			tag := strings.Split(t.Field(i).Tag.Get("pulumi"), ",")[0]
+			if tag == "" || !t.Field(i).IsExported() {
+				continue
+			}
+
+			fv := v.Field(i)
+			// Skip outputs that were never assigned.
+			if fv.Type().Implements(outputType) && fv.IsZero() {
+				continue
+			}
+			m[tag] = fv.Interface()
+		}
+		return m, anyType, nil
+	default:
+		return nil, nil, fmt.Errorf("resource outputs must be a map or a struct, got %T", outs)
+	}
+}
+
 // Export registers a key and value pair with the current context's stack.
 func (ctx *Context) Export(name string, value Input) {
 	ctx.exports[name] = value
+	delete(ctx.exportMeta, name)
+}
+
+// ExportMeta holds documentation and type information for a stack output.
+type ExportMeta struct {
+	// Description is a human-readable description of the output.
+	Description string
+	// Sensitive marks the output as a secret.
+	Sensitive bool
+	// Schema is an optional JSON schema document describing the output's type.
+	Schema string
+}
+
+// ExportWithMetadata registers a key and value pair with the current context's stack,
+// along with documentation and type information that is recorded with the stack's state.
+func (ctx *Context) ExportWithMetadata(name string, value Input, meta ExportMeta) {
+	if meta.Sensitive {
+		value = ToSecret(value)
+	}
+	ctx.exports[name] = value
+
+	if meta.Description == "" && meta.Schema == "" {
+		delete(ctx.exportMeta, name)
+		return
+	}
+	if ctx.exportMeta == nil {
+		ctx.exportMeta = make(map[string]ExportMeta)
+	}
+	ctx.exportMeta[name] = meta
+}
+
+// exportMetadata returns the metadata for the stack's outputs in the form expected by RegisterResourceOutputs.
+func (ctx *Context) exportMetadata() map[string]*pulumirpc.RegisterResourceOutputsRequest_OutputMetadata {
+	if len(ctx.exportMeta) == 0 {
+		return nil
+	}
+	metadata := make(map[string]*pulumirpc.RegisterResourceOutputsRequest_OutputMetadata, len(ctx.exportMeta))
+	for name, meta := range ctx.exportMeta {
+		metadata[name] = &pulumirpc.RegisterResourceOutputsRequest_OutputMetadata{
+			Description: meta.Description,
+			Schema:      meta.Schema,
+		}
+	}
+	return metadata
 }
 
 // RegisterStackTransformation adds a transformation to all future resources constructed in this Pulumi stack.
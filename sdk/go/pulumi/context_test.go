@@ -18,14 +18,17 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
@@ -603,3 +606,308 @@ func TestSourcePosition(t *testing.T) {
 	}, WithMocks("project", "stack", mocks))
 	assert.NoError(t, err)
 }
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	var retryPolicy *pulumirpc.RegisterResourceRequest_RetryPolicy
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			require.NotNil(t, args.RegisterRPC)
+			retryPolicy = args.RegisterRPC.RetryPolicy
+			return "myID", resource.PropertyMap{}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		return ctx.RegisterResource("test:resource:type", "reg", &testResource2Inputs{}, &res,
+			Retry(&RetryPolicy{MaxRetries: 3, InitialDelay: "2s", MaxDelay: "1m", RetryOn: []string{"throttl"}}))
+	}, WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+
+	require.NotNil(t, retryPolicy)
+	assert.Equal(t, int32(3), retryPolicy.MaxRetries)
+	assert.Equal(t, "2s", retryPolicy.InitialDelay)
+	assert.Equal(t, "1m", retryPolicy.MaxDelay)
+	assert.Equal(t, []string{"throttl"}, retryPolicy.RetryOn)
+}
+
+func TestFrozen(t *testing.T) {
+	t.Parallel()
+
+	var frozen bool
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			require.NotNil(t, args.RegisterRPC)
+			frozen = args.RegisterRPC.Frozen
+			return "myID", resource.PropertyMap{}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		return ctx.RegisterResource("test:resource:type", "reg", &testResource2Inputs{}, &res, Frozen(true))
+	}, WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+	assert.True(t, frozen)
+}
+
+type testOutputsComp struct {
+	ResourceState
+
+	Name   StringOutput `pulumi:"name"`
+	Count  IntOutput    `pulumi:"count"`
+	Nested MapOutput    `pulumi:"nested"`
+
+	internal string
+}
+
+func TestRegisterResourceOutputsStruct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		give func(comp *testOutputsComp) interface{}
+	}{
+		{
+			desc: "Map",
+			give: func(comp *testOutputsComp) interface{} {
+				return Map{"name": comp.Name, "count": comp.Count, "nested": comp.Nested}
+			},
+		},
+		{
+			desc: "component pointer",
+			give: func(comp *testOutputsComp) interface{} { return comp },
+		},
+		{
+			desc: "anonymous struct",
+			give: func(comp *testOutputsComp) interface{} {
+				return struct {
+					Name   StringOutput `pulumi:"name"`
+					Count  IntOutput    `pulumi:"count"`
+					Nested MapOutput    `pulumi:"nested"`
+				}{comp.Name, comp.Count, comp.Nested}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu   sync.Mutex
+				outs = map[string]*structpb.Struct{}
+			)
+			wrap := func(cl pulumirpc.ResourceMonitorClient) pulumirpc.ResourceMonitorClient {
+				m := newInterceptingResourceMonitor(cl)
+				m.afterRegisterResourceOutputs = func(req *pulumirpc.RegisterResourceOutputsRequest, err error) {
+					mu.Lock()
+					defer mu.Unlock()
+					outs[req.GetUrn()] = req.GetOutputs()
+				}
+				return m
+			}
+
+			var compURN URN
+			err := RunErr(func(ctx *Context) error {
+				var comp testOutputsComp
+				require.NoError(t, ctx.RegisterComponentResource("test:index:Comp", "comp", &comp))
+				comp.Name = String("a").ToStringOutput()
+				comp.Count = Int(1).ToIntOutput()
+				comp.Nested = ToSecret(Map{"key": String("value")}).(MapOutput)
+				comp.internal = "ignored"
+
+				urn, _, _, err := comp.URN().awaitURN(context.Background())
+				require.NoError(t, err)
+				compURN = urn
+
+				if outs, ok := tt.give(&comp).(Map); ok {
+					return ctx.RegisterResourceOutputs(&comp, outs)
+				}
+				return ctx.RegisterResourceOutputsStruct(&comp, tt.give(&comp))
+			}, WithMocks("project", "stack", &testMonitor{}), WrapResourceMonitorClient(wrap))
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			got, err := plugin.UnmarshalProperties(outs[string(compURN)], plugin.MarshalOptions{KeepSecrets: true})
+			require.NoError(t, err)
+			assert.Equal(t, resource.PropertyMap{
+				"name":  resource.NewStringProperty("a"),
+				"count": resource.NewNumberProperty(1),
+				"nested": resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+					"key": resource.NewStringProperty("value"),
+				})),
+			}, got)
+		})
+	}
+}
+
+func TestRegisterResourceOutputsInvalid(t *testing.T) {
+	t.Parallel()
+
+	err := RunErr(func(ctx *Context) error {
+		var comp testComp
+		require.NoError(t, ctx.RegisterComponentResource("test:index:Comp", "comp", &comp))
+		return ctx.RegisterResourceOutputsStruct(&comp, []string{"a"})
+	}, WithMocks("project", "stack", &testMonitor{}))
+	assert.ErrorContains(t, err, "resource outputs must be a map or a struct")
+}
+
+func TestSequential(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		created []string
+	)
+	release := make(chan struct{})
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			if args.Name == "first" {
+				// Hold the first resource until the second has had a chance to register.
+				<-release
+			}
+			// The ordering must not be recorded as a dependency.
+			assert.Empty(t, args.RegisterRPC.GetDependencies())
+			mu.Lock()
+			defer mu.Unlock()
+			created = append(created, args.Name)
+			return args.Name + "_id", resource.PropertyMap{}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var first, second testResource2
+		if err := ctx.RegisterResource("test:resource:type", "first", &testResource2Inputs{}, &first); err != nil {
+			return err
+		}
+		if err := ctx.RegisterResource("test:resource:type", "second", &testResource2Inputs{}, &second,
+			Sequential(&first)); err != nil {
+			return err
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"first", "second"}, created)
+}
+
+func TestExportWithMetadata(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		reqs []*pulumirpc.RegisterResourceOutputsRequest
+	)
+	wrap := func(cl pulumirpc.ResourceMonitorClient) pulumirpc.ResourceMonitorClient {
+		m := newInterceptingResourceMonitor(cl)
+		m.afterRegisterResourceOutputs = func(req *pulumirpc.RegisterResourceOutputsRequest, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reqs = append(reqs, req)
+		}
+		return m
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		ctx.ExportWithMetadata("endpoint", String("https://example.com"), ExportMeta{
+			Description: "The public endpoint.",
+			Schema:      `{"type":"string"}`,
+		})
+		ctx.ExportWithMetadata("password", String("hunter2"), ExportMeta{Sensitive: true})
+		ctx.Export("plain", String("value"))
+		return nil
+	}, WithMocks("project", "stack", &testMonitor{}), WrapResourceMonitorClient(wrap))
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, reqs, 1)
+
+	metadata := reqs[0].GetOutputMetadata()
+	require.Len(t, metadata, 1, "only outputs with a description or schema carry metadata")
+	assert.Equal(t, "The public endpoint.", metadata["endpoint"].GetDescription())
+	assert.Equal(t, `{"type":"string"}`, metadata["endpoint"].GetSchema())
+
+	outs, err := plugin.UnmarshalProperties(reqs[0].GetOutputs(), plugin.MarshalOptions{KeepSecrets: true})
+	require.NoError(t, err)
+	assert.True(t, outs["password"].IsSecret())
+	assert.False(t, outs["endpoint"].IsSecret())
+}
+
+//nolint:paralleltest // sets environment variables
+func TestStackMetadata(t *testing.T) {
+	t.Setenv(EnvOrganization, "myorg")
+	t.Setenv(EnvStackTags, `{"env":"prod"}`)
+	t.Setenv(EnvRuntimeOptions, `{"buildTarget":"bin/app"}`)
+
+	info := getEnvInfo()
+	ctx, err := NewContext(context.Background(), info)
+	require.NoError(t, err)
+
+	assert.Equal(t, "myorg", ctx.Organization())
+	assert.Equal(t, map[string]string{"env": "prod"}, ctx.StackTags())
+	assert.Equal(t, map[string]interface{}{"buildTarget": "bin/app"}, ctx.ProjectRuntimeOptions())
+
+	// The returned maps are copies.
+	ctx.StackTags()["env"] = "dev"
+	ctx.ProjectRuntimeOptions()["buildTarget"] = "other"
+	assert.Equal(t, map[string]string{"env": "prod"}, ctx.StackTags())
+	assert.Equal(t, map[string]interface{}{"buildTarget": "bin/app"}, ctx.ProjectRuntimeOptions())
+}
+
+func TestDeterministicMocks(t *testing.T) {
+	t.Parallel()
+
+	fixed := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	run := func() (time.Time, []string) {
+		var now time.Time
+		var names []string
+		mocks := &testMonitor{
+			NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+				return args.Autoname, resource.PropertyMap{}, nil
+			},
+		}
+		err := RunErr(func(ctx *Context) error {
+			now = ctx.Now()
+			names = append(names, ctx.Autoname("bucket"), ctx.Autoname("bucket"))
+
+			// Resources are registered with autonamed IDs.
+			var res testResource2
+			if err := ctx.RegisterResource("test:resource:type", "res", &testResource2Inputs{}, &res); err != nil {
+				return err
+			}
+			id, _, _, _, err := await(res.ID())
+			if err != nil {
+				return err
+			}
+			names = append(names, string(id.(ID)))
+			return nil
+		}, WithMocks("project", "stack", mocks),
+			WithClock(func() time.Time { return fixed }), WithRandomSeed(42))
+		require.NoError(t, err)
+		return now, names
+	}
+
+	now1, names1 := run()
+	now2, names2 := run()
+	assert.Equal(t, fixed, now1)
+	assert.Equal(t, fixed, now2)
+	assert.Equal(t, names1, names2)
+	assert.NotEqual(t, names1[0], names1[1])
+	assert.Regexp(t, "^bucket-[0-9a-f]{7}$", names1[0])
+	assert.Regexp(t, "^res-[0-9a-f]{7}$", names1[2])
+
+	// Without mocks, the clock is ignored.
+	var info RunInfo
+	WithClock(func() time.Time { return fixed })(&info)
+	ctx, err := NewContext(context.Background(), info)
+	require.NoError(t, err)
+	assert.NotEqual(t, fixed, ctx.Now())
+}
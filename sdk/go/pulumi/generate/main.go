@@ -71,7 +71,7 @@ func (b builtin) DefineInputMethods() bool {
 
 func (b builtin) ImplementsPtrType() bool {
 	switch b.Type {
-	case "bool", "float64", "ID", "int", "string", "URN":
+	case "bool", "float32", "float64", "ID", "int", "int64", "string", "uint64", "URN":
 		return true
 	}
 	return false
@@ -181,12 +181,24 @@ var builtins = makeBuiltins([]*builtin{
 	{Name: "Asset", Type: "Asset", inputType: "*asset", implements: []string{AssetOrArchiveType}, Example: "NewFileAsset(\"foo.txt\")"},
 	{Name: AssetOrArchiveType, Type: AssetOrArchiveType, Example: "NewFileArchive(\"foo.zip\")"},
 	{Name: "Bool", Type: "bool", Example: "Bool(true)", GenerateConfig: true, DefaultConfig: "false", elemExample: "true", RegisterInput: true, defaultValue: "Bool(false)"},
+	{
+		Name: "Float32", Type: "float32", Example: "Float32(999.9)", GenerateConfig: true, DefaultConfig: "0",
+		elemExample: "999.9", RegisterInput: true, defaultValue: "Float32(0)",
+	},
 	{Name: "Float64", Type: "float64", Example: "Float64(999.9)", GenerateConfig: true, DefaultConfig: "0", elemExample: "999.9", RegisterInput: true, defaultValue: "Float64(0)"},
 	{Name: "ID", Type: "ID", inputType: "ID", implements: []string{"String"}, Example: "ID(\"foo\")", RegisterInput: true, defaultValue: "ID(\"\")"},
 	{Name: "Input", Type: "interface{}", Example: "String(\"any\")"},
 	{Name: "Int", Type: "int", Example: "Int(42)", GenerateConfig: true, DefaultConfig: "0", elemExample: "42", RegisterInput: true, defaultValue: "Int(0)"},
+	{
+		Name: "Int64", Type: "int64", Example: "Int64(42)", GenerateConfig: true, DefaultConfig: "0",
+		elemExample: "42", RegisterInput: true, defaultValue: "Int64(0)",
+	},
 	{Name: "String", Type: "string", Example: "String(\"foo\")", elemExample: "\"foo\"", RegisterInput: true, defaultValue: "String(\"\")"},
 	{Name: "URN", Type: "URN", inputType: "URN", implements: []string{"String"}, Example: "URN(\"foo\")", RegisterInput: true, defaultValue: "URN(\"\")"},
+	{
+		Name: "Uint64", Type: "uint64", Example: "Uint64(42)", GenerateConfig: true, DefaultConfig: "0",
+		elemExample: "42", RegisterInput: true, defaultValue: "Uint64(0)",
+	},
 })
 
 func unexported(s string) string {
@@ -40,6 +40,9 @@ type MockCallArgs struct {
 	Args resource.PropertyMap
 	// Provider is the identifier of the provider instance being used to make the call.
 	Provider string
+	// Self is the URN of the resource whose method is being called. It is empty for invokes and for calls to
+	// functions that are not methods.
+	Self string
 }
 
 // MockResourceArgs is a used to construct a newResource Mock
@@ -57,6 +60,11 @@ type MockResourceArgs struct {
 	ID string
 	// Custom specifies whether or not the resource is Custom (i.e. managed by a resource provider).
 	Custom bool
+	// URN is the URN that the mock monitor will assign to the resource.
+	URN string
+	// Autoname is a name for a custom resource in the style of the names providers generate, which mocks can use as
+	// the resource's ID or name property. It is reproducible across runs if the program is run with WithRandomSeed.
+	Autoname string
 	// Full register RPC call, if available.
 	RegisterRPC *pulumirpc.RegisterResourceRequest
 	// Full read RPC call, if available
@@ -68,6 +76,8 @@ type mockMonitor struct {
 	stack     string
 	mocks     MockResourceMonitor
 	resources sync.Map // map[string]resource.PropertyMap
+
+	autoname func(name string) string // generates names for custom resources.
 }
 
 func (m *mockMonitor) newURN(parent, typ, name string) string {
@@ -154,7 +164,43 @@ func (m *mockMonitor) StreamInvoke(ctx context.Context, in *pulumirpc.ResourceIn
 func (m *mockMonitor) Call(ctx context.Context, in *pulumirpc.CallRequest,
 	opts ...grpc.CallOption,
 ) (*pulumirpc.CallResponse, error) {
-	panic("not implemented")
+	args, err := plugin.UnmarshalProperties(in.GetArgs(), plugin.MarshalOptions{
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var self string
+	if v, ok := args["__self__"]; ok {
+		if v.IsResourceReference() {
+			self = string(v.ResourceReferenceValue().URN)
+		}
+		delete(args, "__self__")
+	}
+
+	resultV, err := m.mocks.Call(MockCallArgs{
+		Token:    in.GetTok(),
+		Args:     args,
+		Provider: in.GetProvider(),
+		Self:     self,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.MarshalProperties(resultV, plugin.MarshalOptions{
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.CallResponse{
+		Return: result,
+	}, nil
 }
 
 func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResourceRequest,
@@ -168,6 +214,8 @@ func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResour
 		return nil, err
 	}
 
+	urn := m.newURN(in.GetParent(), in.GetType(), in.GetName())
+
 	id, state, err := m.mocks.NewResource(MockResourceArgs{
 		TypeToken: in.GetType(),
 		Name:      in.GetName(),
@@ -175,14 +223,13 @@ func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResour
 		Provider:  in.GetProvider(),
 		ID:        in.GetId(),
 		Custom:    false,
+		URN:       urn,
 		ReadRPC:   in,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	urn := m.newURN(in.GetParent(), in.GetType(), in.GetName())
-
 	m.resources.Store(urn, resource.PropertyMap{
 		resource.PropertyKey("urn"):   resource.NewStringProperty(urn),
 		resource.PropertyKey("id"):    resource.NewStringProperty(id),
@@ -220,6 +267,13 @@ func (m *mockMonitor) RegisterResource(ctx context.Context, in *pulumirpc.Regist
 		return nil, err
 	}
 
+	urn := m.newURN(in.GetParent(), in.GetType(), in.GetName())
+
+	var autoname string
+	if in.GetCustom() && m.autoname != nil {
+		autoname = m.autoname(in.GetName())
+	}
+
 	id, state, err := m.mocks.NewResource(MockResourceArgs{
 		TypeToken:   in.GetType(),
 		Name:        in.GetName(),
@@ -227,14 +281,14 @@ func (m *mockMonitor) RegisterResource(ctx context.Context, in *pulumirpc.Regist
 		Provider:    in.GetProvider(),
 		ID:          in.GetImportId(),
 		Custom:      in.GetCustom(),
+		URN:         urn,
+		Autoname:    autoname,
 		RegisterRPC: in,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	urn := m.newURN(in.GetParent(), in.GetType(), in.GetName())
-
 	m.resources.Store(urn, resource.PropertyMap{
 		resource.PropertyKey("urn"):   resource.NewStringProperty(urn),
 		resource.PropertyKey("id"):    resource.NewStringProperty(id),
@@ -0,0 +1,396 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/mapper"
+)
+
+// MockResourceFunc handles a mocked resource registration, returning the resource's ID and output state.
+type MockResourceFunc func(args MockResourceArgs) (string, resource.PropertyMap, error)
+
+// MockCallFunc handles a mocked function call, returning the function's outputs.
+type MockCallFunc func(args MockCallArgs) (resource.PropertyMap, error)
+
+// MockEventKind identifies the kind of call recorded by Mocks.
+type MockEventKind string
+
+const (
+	// MockEventResource records a resource registration or read.
+	MockEventResource MockEventKind = "resource"
+	// MockEventCall records a function call.
+	MockEventCall MockEventKind = "call"
+)
+
+// MockEvent is a single call recorded by Mocks.
+type MockEvent struct {
+	// Kind is the kind of call.
+	Kind MockEventKind
+	// Token is the resource type token or function token.
+	Token string
+	// Name is the logical name of the resource. It is empty for function calls.
+	Name string
+	// URN is the URN of the resource. It is empty for function calls.
+	URN string
+	// Inputs are the resource inputs or function arguments.
+	Inputs resource.PropertyMap
+}
+
+// Mocks is a MockResourceMonitor that dispatches to handlers registered per type token, function token, or URN,
+// and records every call it receives. Resources without a handler echo their inputs back as their state, and
+// functions without a handler fail.
+type Mocks struct {
+	m         sync.Mutex
+	resources map[string]MockResourceFunc
+	urns      map[string]MockResourceFunc
+	calls     map[string]MockCallFunc
+	stackRefs map[string]resource.PropertyMap
+	fakes     *schemaFakes
+	events    []MockEvent
+}
+
+var _ MockResourceMonitor = (*Mocks)(nil)
+
+// NewMocks creates an empty set of mocks.
+func NewMocks() *Mocks {
+	return &Mocks{
+		resources: map[string]MockResourceFunc{},
+		urns:      map[string]MockResourceFunc{},
+		calls:     map[string]MockCallFunc{},
+		stackRefs: map[string]resource.PropertyMap{},
+	}
+}
+
+// OnNewResource registers a handler for all resources of the given type.
+func (m *Mocks) OnNewResource(typeToken string, fn MockResourceFunc) *Mocks {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.resources[typeToken] = fn
+	return m
+}
+
+// OnNewResourceURN registers a handler for the resource with the given URN. URN handlers take precedence over
+// type handlers.
+func (m *Mocks) OnNewResourceURN(urn URN, fn MockResourceFunc) *Mocks {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.urns[string(urn)] = fn
+	return m
+}
+
+// OnCall registers a handler for the given function. Handlers for methods of remote components are registered using
+// the method's token, and receive the URN of the component in MockCallArgs.Self.
+func (m *Mocks) OnCall(token string, fn MockCallFunc) *Mocks {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.calls[token] = fn
+	return m
+}
+
+// OnStackReference registers the outputs of the stack with the given fully qualified name, as read by
+// NewStackReference. Secret outputs may be given using resource.MakeSecret.
+func (m *Mocks) OnStackReference(name string, outputs resource.PropertyMap) *Mocks {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.stackRefs[name] = outputs
+	return m
+}
+
+// LoadSchema registers fakes for the resources and functions in the given package schema. Faked resources echo
+// their inputs and fill in any required output properties that are missing with zero values; faked functions
+// return zero values for their required outputs. Explicitly registered handlers take precedence over fakes.
+func (m *Mocks) LoadSchema(schema []byte) error {
+	var spec schemaFakeSpec
+	if err := json.Unmarshal(schema, &spec); err != nil {
+		return fmt.Errorf("parsing schema: %w", err)
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.fakes == nil {
+		m.fakes = &schemaFakes{
+			resources: map[string]schemaFakeObject{},
+			functions: map[string]schemaFakeObject{},
+			types:     map[string]schemaFakeObject{},
+		}
+	}
+	for tok, r := range spec.Resources {
+		m.fakes.resources[tok] = r
+	}
+	for tok, f := range spec.Functions {
+		m.fakes.functions[tok] = f.Outputs
+	}
+	for tok, t := range spec.Types {
+		m.fakes.types[tok] = t
+	}
+	return nil
+}
+
+// Events returns the calls recorded so far, in the order they were received.
+func (m *Mocks) Events() []MockEvent {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return append([]MockEvent(nil), m.events...)
+}
+
+// VerifyOrder checks that calls matching each of the given keys were received in the given order. A key matches a
+// call if it is equal to the call's token or URN. Other calls may be interleaved with the matched calls.
+func (m *Mocks) VerifyOrder(keys ...string) error {
+	events := m.Events()
+
+	next := 0
+	for _, e := range events {
+		if next < len(keys) && (keys[next] == e.Token || keys[next] == e.URN) {
+			next++
+		}
+	}
+	if next == len(keys) {
+		return nil
+	}
+
+	seen := make([]string, len(events))
+	for i, e := range events {
+		if e.URN != "" {
+			seen[i] = e.URN
+		} else {
+			seen[i] = e.Token
+		}
+	}
+	return fmt.Errorf("expected a call to %q after %q; calls received: %v",
+		keys[next], strings.Join(keys[:next], ", "), strings.Join(seen, ", "))
+}
+
+// NewResource implements MockResourceMonitor.
+func (m *Mocks) NewResource(args MockResourceArgs) (string, resource.PropertyMap, error) {
+	m.m.Lock()
+	m.events = append(m.events, MockEvent{
+		Kind:   MockEventResource,
+		Token:  args.TypeToken,
+		Name:   args.Name,
+		URN:    args.URN,
+		Inputs: args.Inputs,
+	})
+	fn, ok := m.urns[args.URN]
+	if !ok {
+		fn, ok = m.resources[args.TypeToken]
+	}
+	stackRef, isStackRef := m.stackRefs[args.ID]
+	fakes := m.fakes
+	m.m.Unlock()
+
+	if ok {
+		return fn(args)
+	}
+
+	if args.TypeToken == stackReferenceType {
+		if !isStackRef {
+			return "", nil, fmt.Errorf("no mock registered for stack reference %q", args.ID)
+		}
+		return args.ID, stackReferenceState(args.ID, stackRef), nil
+	}
+
+	state := args.Inputs.Copy()
+	if fakes != nil {
+		if r, ok := fakes.resources[args.TypeToken]; ok {
+			fakes.fill(state, r, map[string]bool{})
+		}
+	}
+	id := args.ID
+	if id == "" {
+		id = args.Name + "_id"
+	}
+	return id, state, nil
+}
+
+// Call implements MockResourceMonitor.
+func (m *Mocks) Call(args MockCallArgs) (resource.PropertyMap, error) {
+	m.m.Lock()
+	m.events = append(m.events, MockEvent{
+		Kind:   MockEventCall,
+		Token:  args.Token,
+		Inputs: args.Args,
+	})
+	fn, ok := m.calls[args.Token]
+	fakes := m.fakes
+	m.m.Unlock()
+
+	if ok {
+		return fn(args)
+	}
+	if fakes != nil {
+		if f, ok := fakes.functions[args.Token]; ok {
+			outputs := resource.PropertyMap{}
+			fakes.fill(outputs, f, map[string]bool{})
+			return outputs, nil
+		}
+	}
+	return nil, fmt.Errorf("no mock registered for function %q", args.Token)
+}
+
+const stackReferenceType = "pulumi:pulumi:StackReference"
+
+// stackReferenceState returns the state of a stack reference to the named stack with the given outputs.
+func stackReferenceState(name string, outputs resource.PropertyMap) resource.PropertyMap {
+	var secretNames []resource.PropertyValue
+	for _, k := range outputs.StableKeys() {
+		if outputs[k].IsSecret() {
+			secretNames = append(secretNames, resource.NewStringProperty(string(k)))
+		}
+	}
+	return resource.PropertyMap{
+		"name":              resource.NewStringProperty(name),
+		"outputs":           resource.NewObjectProperty(outputs),
+		"secretOutputNames": resource.NewArrayProperty(secretNames),
+	}
+}
+
+// TypedMockResource adapts a handler that works with structs to a MockResourceFunc. The resource's inputs are
+// decoded into I and the returned O is encoded as the resource's state. Fields are matched using `pulumi` tags.
+func TypedMockResource[I, O any](fn func(args MockResourceArgs, inputs I) (string, O, error)) MockResourceFunc {
+	return func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+		var inputs I
+		if err := decodeMockProperties(args.Inputs, &inputs); err != nil {
+			return "", nil, fmt.Errorf("decoding inputs of %s: %w", args.URN, err)
+		}
+		id, outputs, err := fn(args, inputs)
+		if err != nil {
+			return "", nil, err
+		}
+		state, err := encodeMockProperties(outputs)
+		if err != nil {
+			return "", nil, fmt.Errorf("encoding state of %s: %w", args.URN, err)
+		}
+		return id, state, nil
+	}
+}
+
+// TypedMockCall adapts a handler that works with structs to a MockCallFunc. The function's arguments are decoded
+// into I and the returned O is encoded as the function's outputs. Fields are matched using `pulumi` tags.
+func TypedMockCall[I, O any](fn func(args MockCallArgs, inputs I) (O, error)) MockCallFunc {
+	return func(args MockCallArgs) (resource.PropertyMap, error) {
+		var inputs I
+		if err := decodeMockProperties(args.Args, &inputs); err != nil {
+			return nil, fmt.Errorf("decoding arguments of %s: %w", args.Token, err)
+		}
+		outputs, err := fn(args, inputs)
+		if err != nil {
+			return nil, err
+		}
+		result, err := encodeMockProperties(outputs)
+		if err != nil {
+			return nil, fmt.Errorf("encoding outputs of %s: %w", args.Token, err)
+		}
+		return result, nil
+	}
+}
+
+func decodeMockProperties(props resource.PropertyMap, target interface{}) error {
+	obj := unwrapSecrets(resource.NewObjectProperty(props)).ObjectValue().Mappable()
+	if err := mapper.MapI(obj, target); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeMockProperties(source interface{}) (resource.PropertyMap, error) {
+	obj, err := mapper.New(nil).Encode(source)
+	if err != nil {
+		return nil, err
+	}
+	return resource.NewPropertyMapFromMap(obj), nil
+}
+
+// schemaFakeSpec is the subset of a package schema needed to fake its resources and functions.
+type schemaFakeSpec struct {
+	Resources map[string]schemaFakeObject `json:"resources"`
+	Functions map[string]struct {
+		Outputs schemaFakeObject `json:"outputs"`
+	} `json:"functions"`
+	Types map[string]schemaFakeObject `json:"types"`
+}
+
+type schemaFakeObject struct {
+	Type       string                    `json:"type"`
+	Properties map[string]schemaFakeType `json:"properties"`
+	Required   []string                  `json:"required"`
+}
+
+type schemaFakeType struct {
+	Type string `json:"type"`
+	Ref  string `json:"$ref"`
+}
+
+type schemaFakes struct {
+	resources map[string]schemaFakeObject
+	functions map[string]schemaFakeObject
+	types     map[string]schemaFakeObject
+}
+
+// fill sets each required property of obj that is missing from props to its zero value. visiting guards against
+// recursive object types.
+func (f *schemaFakes) fill(props resource.PropertyMap, obj schemaFakeObject, visiting map[string]bool) {
+	for _, name := range obj.Required {
+		key := resource.PropertyKey(name)
+		if _, has := props[key]; has {
+			continue
+		}
+		if v, ok := f.zero(obj.Properties[name], visiting); ok {
+			props[key] = v
+		}
+	}
+}
+
+func (f *schemaFakes) zero(t schemaFakeType, visiting map[string]bool) (resource.PropertyValue, bool) {
+	if t.Ref != "" {
+		tok, ok := strings.CutPrefix(t.Ref, "#/types/")
+		if !ok || visiting[tok] {
+			return resource.PropertyValue{}, false
+		}
+		obj, ok := f.types[tok]
+		if !ok {
+			return resource.PropertyValue{}, false
+		}
+		if obj.Type != "" && obj.Type != "object" {
+			return f.zero(schemaFakeType{Type: obj.Type}, visiting)
+		}
+		visiting[tok] = true
+		defer delete(visiting, tok)
+
+		props := resource.PropertyMap{}
+		f.fill(props, obj, visiting)
+		return resource.NewObjectProperty(props), true
+	}
+
+	switch t.Type {
+	case "string":
+		return resource.NewStringProperty(""), true
+	case "integer", "number":
+		return resource.NewNumberProperty(0), true
+	case "boolean":
+		return resource.NewBoolProperty(false), true
+	case "array":
+		return resource.NewArrayProperty([]resource.PropertyValue{}), true
+	case "object":
+		return resource.NewObjectProperty(resource.PropertyMap{}), true
+	default:
+		return resource.PropertyValue{}, false
+	}
+}
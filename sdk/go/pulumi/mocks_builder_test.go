@@ -0,0 +1,193 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestMocks(t *testing.T) {
+	t.Parallel()
+
+	urnB := URN("urn:pulumi:stack::project::test:resource:type::resB")
+
+	mocks := NewMocks().
+		OnNewResource("test:resource:type", TypedMockResource(
+			func(args MockResourceArgs, inputs testResource2Args) (string, testResource2Args, error) {
+				inputs.Foo += "-type"
+				return args.Name + "-id", inputs, nil
+			})).
+		OnNewResourceURN(urnB, func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			return "b", resource.PropertyMap{"foo": resource.NewStringProperty("by-urn")}, nil
+		}).
+		OnCall("test:index:func", TypedMockCall(func(args MockCallArgs, inputs invokeArgs) (invokeResult, error) {
+			return invokeResult{Foo: inputs.Bar, Baz: inputs.Bang}, nil
+		}))
+
+	var fooA, fooB string
+	var result invokeResult
+	err := RunErr(func(ctx *Context) error {
+		var resA, resB testResource2
+		err := ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{Foo: String("a")}, &resA)
+		require.NoError(t, err)
+		err = ctx.RegisterResource("test:resource:type", "resB", &testResource2Inputs{Foo: String("b")}, &resB,
+			DependsOn([]Resource{&resA}))
+		require.NoError(t, err)
+
+		v, _, _, _, err := await(resA.Foo)
+		require.NoError(t, err)
+		fooA = v.(string)
+		v, _, _, _, err = await(resB.Foo)
+		require.NoError(t, err)
+		fooB = v.(string)
+
+		return ctx.Invoke("test:index:func", &invokeArgs{Bang: "gnab", Bar: "rab"}, &result)
+	}, WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+
+	assert.Equal(t, "a-type", fooA)
+	assert.Equal(t, "by-urn", fooB)
+	assert.Equal(t, invokeResult{Foo: "rab", Baz: "gnab"}, result)
+
+	events := mocks.Events()
+	require.Len(t, events, 3)
+	assert.Equal(t, MockEventResource, events[0].Kind)
+	assert.Equal(t, "resA", events[0].Name)
+	assert.Equal(t, MockEventCall, events[2].Kind)
+
+	assert.NoError(t, mocks.VerifyOrder("test:resource:type", string(urnB), "test:index:func"))
+	assert.EqualError(t, mocks.VerifyOrder("test:index:func", "test:resource:type"),
+		`expected a call to "test:resource:type" after "test:index:func"; calls received: `+
+			"urn:pulumi:stack::project::test:resource:type::resA, "+string(urnB)+", test:index:func")
+
+	_, err = mocks.Call(MockCallArgs{Token: "test:index:missing"})
+	assert.EqualError(t, err, `no mock registered for function "test:index:missing"`)
+}
+
+func TestMocksLoadSchema(t *testing.T) {
+	t.Parallel()
+
+	mocks := NewMocks()
+	err := mocks.LoadSchema([]byte(`{
+		"resources": {
+			"test:index:Bucket": {
+				"properties": {
+					"name": {"type": "string"},
+					"arn": {"type": "string"},
+					"tags": {"type": "object"},
+					"config": {"$ref": "#/types/test:index:Config"},
+					"optional": {"type": "string"}
+				},
+				"required": ["name", "arn", "tags", "config"]
+			}
+		},
+		"functions": {
+			"test:index:getBucket": {
+				"outputs": {
+					"properties": {"size": {"type": "integer"}, "kind": {"$ref": "#/types/test:index:Kind"}},
+					"required": ["size", "kind"]
+				}
+			}
+		},
+		"types": {
+			"test:index:Config": {
+				"type": "object",
+				"properties": {"enabled": {"type": "boolean"}, "next": {"$ref": "#/types/test:index:Config"}},
+				"required": ["enabled", "next"]
+			},
+			"test:index:Kind": {"type": "string", "enum": [{"value": "a"}]}
+		}
+	}`))
+	require.NoError(t, err)
+
+	id, state, err := mocks.NewResource(MockResourceArgs{
+		TypeToken: "test:index:Bucket",
+		Name:      "bucket",
+		Inputs:    resource.PropertyMap{"name": resource.NewStringProperty("my-bucket")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bucket_id", id)
+	assert.Equal(t, resource.PropertyMap{
+		"name": resource.NewStringProperty("my-bucket"),
+		"arn":  resource.NewStringProperty(""),
+		"tags": resource.NewObjectProperty(resource.PropertyMap{}),
+		"config": resource.NewObjectProperty(resource.PropertyMap{
+			"enabled": resource.NewBoolProperty(false),
+		}),
+	}, state)
+
+	outputs, err := mocks.Call(MockCallArgs{Token: "test:index:getBucket"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{
+		"size": resource.NewNumberProperty(0),
+		"kind": resource.NewStringProperty(""),
+	}, outputs)
+}
+
+func TestMocksCallAndStackReference(t *testing.T) {
+	t.Parallel()
+
+	mocks := NewMocks().
+		OnCall("test:index:Res/greet", func(args MockCallArgs) (resource.PropertyMap, error) {
+			return resource.PropertyMap{
+				"greeting": resource.NewStringProperty("hello " + args.Args["name"].StringValue()),
+				"self":     resource.NewStringProperty(args.Self),
+			}, nil
+		}).
+		OnStackReference("org/network/prod", resource.PropertyMap{
+			"vpcId":    resource.NewStringProperty("vpc-123"),
+			"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		})
+
+	var urn URN
+	var result map[string]interface{}
+	var vpcID, password interface{}
+	var passwordSecret bool
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		err := ctx.RegisterResource("test:index:Res", "res", &testResource2Inputs{}, &res)
+		require.NoError(t, err)
+		urn, _, _, err = res.URN().awaitURN(ctx.ctx)
+		require.NoError(t, err)
+
+		out, err := ctx.Call("test:index:Res/greet", Map{"name": String("world")}, MapOutput{}, &res)
+		require.NoError(t, err)
+		v, _, _, _, err := await(out)
+		require.NoError(t, err)
+		result = v.(map[string]interface{})
+
+		ref, err := NewStackReference(ctx, "org/network/prod", nil)
+		require.NoError(t, err)
+		vpcID, _, _, _, err = await(ref.GetOutput(String("vpcId")))
+		require.NoError(t, err)
+		password, _, passwordSecret, _, err = await(ref.GetOutput(String("password")))
+		require.NoError(t, err)
+
+		_, err = NewStackReference(ctx, "org/network/missing", nil)
+		require.NoError(t, err)
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.ErrorContains(t, err, `no mock registered for stack reference "org/network/missing"`)
+
+	assert.Equal(t, map[string]interface{}{"greeting": "hello world", "self": string(urn)}, result)
+	assert.Equal(t, "vpc-123", vpcID)
+	assert.Equal(t, "hunter2", password)
+	assert.True(t, passwordSecret)
+}
@@ -3,6 +3,10 @@ package pulumi
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
 )
 
 func Printf(format string, args ...interface{}) IntOutput {
@@ -22,3 +26,162 @@ func Sprintf(format string, args ...interface{}) StringOutput {
 		return fmt.Sprintf(format, args...)
 	}).(StringOutput)
 }
+
+// Format is like Sprintf, but validates the format string against its arguments.
+// Arguments may be a mix of plain values and Inputs; the result depends on every Input argument
+// and is secret if any of them is secret.
+//
+// Unlike Sprintf, which embeds errors such as "%!d(MISSING)" in its result,
+// the returned output is rejected if the format string contains an unknown verb,
+// if a '*' width or precision is not given an int, or if there are too few or too many arguments.
+func Format(format string, args ...interface{}) StringOutput {
+	return All(args...).ApplyT(func(args []interface{}) (string, error) {
+		if err := checkFormat(format, args); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(format, args...), nil
+	}).(StringOutput)
+}
+
+// Template renders a text/template with the given data, which may contain Inputs anywhere within
+// its maps, slices, and structs. The Inputs are resolved before the template is executed,
+// and the result depends on each of them and is secret if any of them is secret.
+//
+// The returned output is rejected if the template cannot be parsed or executed.
+func Template(text string, data interface{}) StringOutput {
+	tmpl, parseErr := template.New("pulumi").Option("missingkey=error").Parse(text)
+	return All(data).ApplyT(func(args []interface{}) (string, error) {
+		if parseErr != nil {
+			return "", parseErr
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, args[0]); err != nil {
+			return "", err
+		}
+		return sb.String(), nil
+	}).(StringOutput)
+}
+
+// formatVerbs are the verbs understood by the fmt package.
+const formatVerbs = "vTtbcdoOqxXUeEfFgGsp"
+
+// checkFormat checks that format is a valid fmt format string for args.
+func checkFormat(format string, args []interface{}) error {
+	argNum, reordered := 0, false
+
+	// takeArg consumes the next argument, returning an error if there is none.
+	takeArg := func(what string, offset int) (interface{}, error) {
+		if argNum >= len(args) {
+			return nil, fmt.Errorf("format %q: missing argument for %s at offset %d", format, what, offset)
+		}
+		arg := args[argNum]
+		argNum++
+		return arg, nil
+	}
+
+	// parseIndex parses an explicit argument index such as "[2]" at the start of s.
+	parseIndex := func(s string, offset int) (int, error) {
+		if len(s) == 0 || s[0] != '[' {
+			return 0, nil
+		}
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return 0, fmt.Errorf("format %q: unterminated argument index at offset %d", format, offset)
+		}
+		n, err := strconv.Atoi(s[1:end])
+		if err != nil || n < 1 || n > len(args) {
+			return 0, fmt.Errorf("format %q: bad argument index %s at offset %d", format, s[:end+1], offset)
+		}
+		argNum, reordered = n-1, true
+		return end + 1, nil
+	}
+
+	// parseStar consumes a '*' width or precision, which must be given an int.
+	parseStar := func(what string, offset int) error {
+		arg, err := takeArg(what, offset)
+		if err != nil {
+			return err
+		}
+		if _, ok := arg.(int); !ok {
+			return fmt.Errorf("format %q: %s at offset %d must be an int, got %T", format, what, offset, arg)
+		}
+		return nil
+	}
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			i++
+			continue
+		}
+		start := i
+		i++
+
+		// Flags.
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			i++
+		}
+
+		// Width.
+		n, err := parseIndex(format[i:], i)
+		if err != nil {
+			return err
+		}
+		i += n
+		if i < len(format) && format[i] == '*' {
+			if err := parseStar("width", i); err != nil {
+				return err
+			}
+			i++
+		} else {
+			for i < len(format) && '0' <= format[i] && format[i] <= '9' {
+				i++
+			}
+		}
+
+		// Precision.
+		if i < len(format) && format[i] == '.' {
+			i++
+			n, err := parseIndex(format[i:], i)
+			if err != nil {
+				return err
+			}
+			i += n
+			if i < len(format) && format[i] == '*' {
+				if err := parseStar("precision", i); err != nil {
+					return err
+				}
+				i++
+			} else {
+				for i < len(format) && '0' <= format[i] && format[i] <= '9' {
+					i++
+				}
+			}
+		}
+
+		// Verb.
+		n, err = parseIndex(format[i:], i)
+		if err != nil {
+			return err
+		}
+		i += n
+		if i >= len(format) {
+			return fmt.Errorf("format %q: missing verb at end of string", format)
+		}
+		verb, size := utf8.DecodeRuneInString(format[i:])
+		i += size
+		if verb == '%' {
+			continue
+		}
+		if !strings.ContainsRune(formatVerbs, verb) {
+			return fmt.Errorf("format %q: unknown verb %s", format, format[start:i])
+		}
+		if _, err := takeArg("verb "+format[start:i], start); err != nil {
+			return err
+		}
+	}
+
+	if !reordered && argNum < len(args) {
+		return fmt.Errorf("format %q: %d extra argument(s)", format, len(args)-argNum)
+	}
+	return nil
+}
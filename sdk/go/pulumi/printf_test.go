@@ -49,3 +49,80 @@ func TestSprintfOutputs(t *testing.T) {
 
 	testPrintf(t, ToOutput("foo"), ToOutput(42), ToOutput(true))
 }
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	v, known, secret, deps, err := await(Format("arn:aws:s3:::%s/%*d%%", ToSecret(String("bucket")), 4, Int(7)))
+	assert.NoError(t, err)
+	assert.True(t, known)
+	assert.True(t, secret)
+	assert.Nil(t, deps)
+	assert.Equal(t, "arn:aws:s3:::bucket/   7%", v)
+
+	v, _, _, _, err = await(Format("%[2]s-%[1]s", "a", String("b")))
+	assert.NoError(t, err)
+	assert.Equal(t, "b-a", v)
+}
+
+func TestFormatDeps(t *testing.T) {
+	t.Parallel()
+
+	err := RunErr(func(ctx *Context) error {
+		res := newTestRes(t, ctx, "res")
+		out := outputDependingOnResource(res, true)
+
+		_, _, _, deps, err := await(Format("%v/%s", out, "key"))
+		assert.NoError(t, err)
+		assert.Equal(t, []Resource{res}, deps)
+		return nil
+	}, WithMocks("project", "stack", &testMonitor{}))
+	assert.NoError(t, err)
+}
+
+func TestFormatInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format string
+		args   []interface{}
+		want   string
+	}{
+		{"%s/%s", []interface{}{String("a")}, "missing argument for verb %s at offset 3"},
+		{"%s", []interface{}{String("a"), "b"}, "1 extra argument(s)"},
+		{"%y", []interface{}{String("a")}, "unknown verb %y"},
+		{"%*d", []interface{}{String("a"), 1}, "width at offset 1 must be an int, got string"},
+		{"%.*f", []interface{}{"a", 1.0}, "precision at offset 2 must be an int, got string"},
+		{"%[3]s", []interface{}{"a"}, "bad argument index [3]"},
+		{"abc%", nil, "missing verb at end of string"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, _, err := await(Format(tt.format, tt.args...))
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+
+	out := Template("{{.name}}: {{range .ports}}{{.}} {{end}}", map[string]interface{}{
+		"name":  String("web"),
+		"ports": []interface{}{80, ToSecret(Int(443))},
+	})
+	v, known, secret, _, err := await(out)
+	assert.NoError(t, err)
+	assert.True(t, known)
+	assert.True(t, secret)
+	assert.Equal(t, "web: 80 443 ", v)
+
+	_, _, _, _, err = await(Template("{{.name", map[string]interface{}{}))
+	assert.ErrorContains(t, err, "unclosed action")
+
+	_, _, _, _, err = await(Template("{{.missing}}", map[string]interface{}{"name": "web"}))
+	assert.ErrorContains(t, err, `map has no entry for key "missing"`)
+}
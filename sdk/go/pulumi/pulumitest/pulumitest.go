@@ -0,0 +1,254 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pulumitest runs Pulumi programs and components in-process against fake resource providers and records the
+// resulting resource graph, so that unit tests can assert on names, parents, aliases, and properties without a
+// running engine.
+package pulumitest
+
+import (
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Resource is a resource registered or read by a program under test.
+type Resource struct {
+	// URN is the resource's URN.
+	URN pulumi.URN
+	// Type is the resource's type token.
+	Type string
+	// Name is the resource's logical name.
+	Name string
+	// ID is the resource's ID. It is empty for component resources.
+	ID string
+	// Parent is the URN of the resource's parent.
+	Parent pulumi.URN
+	// Custom is true for custom resources and false for component resources.
+	Custom bool
+	// Read is true if the resource was read rather than registered.
+	Read bool
+	// Provider is the reference to the provider that manages the resource, if any.
+	Provider string
+	// Protect is true if the resource is protected.
+	Protect bool
+	// Dependencies are the URNs of the resource's explicit and implicit dependencies.
+	Dependencies []pulumi.URN
+	// Aliases are the resource's aliases.
+	Aliases []Alias
+	// Inputs are the inputs the resource was registered with.
+	Inputs resource.PropertyMap
+	// State is the state returned by the fake provider.
+	State resource.PropertyMap
+}
+
+// Alias is an alias of a recorded resource. Either URN is set, or the alias is described by its other fields,
+// where empty fields take their value from the resource itself.
+type Alias struct {
+	URN      pulumi.URN `json:"urn,omitempty"`
+	Name     string     `json:"name,omitempty"`
+	Type     string     `json:"type,omitempty"`
+	Stack    string     `json:"stack,omitempty"`
+	Project  string     `json:"project,omitempty"`
+	Parent   pulumi.URN `json:"parent,omitempty"`
+	NoParent bool       `json:"noParent,omitempty"`
+}
+
+// Graph is the set of resources recorded by Run, in registration order.
+type Graph struct {
+	m         sync.Mutex
+	resources []*Resource
+}
+
+// Resources returns all recorded resources in the order they were registered.
+func (g *Graph) Resources() []*Resource {
+	g.m.Lock()
+	defer g.m.Unlock()
+	return append([]*Resource(nil), g.resources...)
+}
+
+// Get returns the resource with the given URN, or nil if there is none.
+func (g *Graph) Get(urn pulumi.URN) *Resource {
+	for _, r := range g.Resources() {
+		if r.URN == urn {
+			return r
+		}
+	}
+	return nil
+}
+
+// Find returns the resource with the given type and name, or nil if there is none.
+func (g *Graph) Find(typ, name string) *Resource {
+	for _, r := range g.Resources() {
+		if r.Type == typ && r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// OfType returns all resources with the given type.
+func (g *Graph) OfType(typ string) []*Resource {
+	var result []*Resource
+	for _, r := range g.Resources() {
+		if r.Type == typ {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// Children returns the resources whose parent is the given URN.
+func (g *Graph) Children(parent pulumi.URN) []*Resource {
+	var result []*Resource
+	for _, r := range g.Resources() {
+		if r.Parent == parent {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func (g *Graph) add(r *Resource) {
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.resources = append(g.resources, r)
+}
+
+type options struct {
+	project string
+	stack   string
+	mocks   pulumi.MockResourceMonitor
+	run     []pulumi.RunOption
+}
+
+// Option configures Run.
+type Option func(*options)
+
+// WithProject sets the project name used to construct URNs. The default is "project".
+func WithProject(project string) Option {
+	return func(o *options) {
+		o.project = project
+	}
+}
+
+// WithStack sets the stack name used to construct URNs. The default is "stack".
+func WithStack(stack string) Option {
+	return func(o *options) {
+		o.stack = stack
+	}
+}
+
+// WithMocks sets the fakes used to resolve resources and function calls. The default is pulumi.NewMocks(), which
+// echoes resource inputs back as their state.
+func WithMocks(mocks pulumi.MockResourceMonitor) Option {
+	return func(o *options) {
+		o.mocks = mocks
+	}
+}
+
+// WithRunOptions passes additional options to pulumi.RunErr.
+func WithRunOptions(opts ...pulumi.RunOption) Option {
+	return func(o *options) {
+		o.run = append(o.run, opts...)
+	}
+}
+
+// Run runs the given program in-process and returns the resources it registered. Run returns once all of the
+// program's outputs have resolved. The graph is returned even if the program fails.
+func Run(program pulumi.RunFunc, opts ...Option) (*Graph, error) {
+	o := options{project: "project", stack: "stack"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.mocks == nil {
+		o.mocks = pulumi.NewMocks()
+	}
+
+	rec := &recorder{mocks: o.mocks, graph: &Graph{}}
+	runOpts := append([]pulumi.RunOption{pulumi.WithMocks(o.project, o.stack, rec)}, o.run...)
+	err := pulumi.RunErr(program, runOpts...)
+	return rec.graph, err
+}
+
+// recorder wraps a set of mocks and records each resource they resolve.
+type recorder struct {
+	mocks pulumi.MockResourceMonitor
+	graph *Graph
+}
+
+func (r *recorder) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return r.mocks.Call(args)
+}
+
+func (r *recorder) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	id, state, err := r.mocks.NewResource(args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	res := &Resource{
+		URN:      pulumi.URN(args.URN),
+		Type:     args.TypeToken,
+		Name:     args.Name,
+		Custom:   args.Custom,
+		Provider: args.Provider,
+		Inputs:   args.Inputs,
+		State:    state,
+	}
+	if args.Custom {
+		res.ID = id
+	}
+
+	switch {
+	case args.RegisterRPC != nil:
+		req := args.RegisterRPC
+		res.Parent = pulumi.URN(req.GetParent())
+		res.Protect = req.GetProtect()
+		for _, dep := range req.GetDependencies() {
+			res.Dependencies = append(res.Dependencies, pulumi.URN(dep))
+		}
+		for _, urn := range req.GetAliasURNs() {
+			res.Aliases = append(res.Aliases, Alias{URN: pulumi.URN(urn)})
+		}
+		for _, alias := range req.GetAliases() {
+			if urn := alias.GetUrn(); urn != "" {
+				res.Aliases = append(res.Aliases, Alias{URN: pulumi.URN(urn)})
+				continue
+			}
+			spec := alias.GetSpec()
+			res.Aliases = append(res.Aliases, Alias{
+				Name:     spec.GetName(),
+				Type:     spec.GetType(),
+				Stack:    spec.GetStack(),
+				Project:  spec.GetProject(),
+				Parent:   pulumi.URN(spec.GetParentUrn()),
+				NoParent: spec.GetNoParent(),
+			})
+		}
+	case args.ReadRPC != nil:
+		req := args.ReadRPC
+		res.Read = true
+		res.Custom = true
+		res.ID = id
+		res.Parent = pulumi.URN(req.GetParent())
+		for _, dep := range req.GetDependencies() {
+			res.Dependencies = append(res.Dependencies, pulumi.URN(dep))
+		}
+	}
+
+	r.graph.add(res)
+	return id, state, nil
+}
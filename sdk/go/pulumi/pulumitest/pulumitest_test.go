@@ -0,0 +1,119 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumitest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type bucket struct {
+	pulumi.CustomResourceState
+
+	Name pulumi.StringOutput `pulumi:"name"`
+	Arn  pulumi.StringOutput `pulumi:"arn"`
+}
+
+type bucketArgs struct {
+	Name pulumi.StringInput
+}
+
+func (bucketArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*struct {
+		Name string `pulumi:"name"`
+	})(nil)).Elem()
+}
+
+type site struct {
+	pulumi.ResourceState
+
+	Bucket *bucket
+}
+
+func newSite(ctx *pulumi.Context, name string, opts ...pulumi.ResourceOption) (*site, error) {
+	s := &site{}
+	if err := ctx.RegisterComponentResource("test:index:Site", name, s, opts...); err != nil {
+		return nil, err
+	}
+
+	s.Bucket = &bucket{}
+	err := ctx.RegisterResource("test:index:Bucket", name+"-bucket", &bucketArgs{Name: pulumi.String(name)}, s.Bucket,
+		pulumi.Parent(s), pulumi.Aliases([]pulumi.Alias{{Name: pulumi.String("old-bucket")}}))
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	mocks := pulumi.NewMocks().OnNewResource("test:index:Bucket",
+		func(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+			state := args.Inputs.Copy()
+			state["arn"] = resource.NewStringProperty("arn:" + args.Name)
+			return "bucket-id", state, nil
+		})
+
+	graph, err := Run(func(ctx *pulumi.Context) error {
+		_, err := newSite(ctx, "site")
+		return err
+	}, WithMocks(mocks))
+	require.NoError(t, err)
+
+	require.Len(t, graph.Resources(), 2)
+
+	s := graph.Find("test:index:Site", "site")
+	require.NotNil(t, s)
+	assert.False(t, s.Custom)
+	assert.Equal(t, pulumi.URN("urn:pulumi:stack::project::test:index:Site::site"), s.URN)
+
+	children := graph.Children(s.URN)
+	require.Len(t, children, 1)
+	b := children[0]
+	assert.Same(t, b, graph.Get(b.URN))
+	assert.Equal(t, "site-bucket", b.Name)
+	assert.True(t, b.Custom)
+	assert.Equal(t, "bucket-id", b.ID)
+	assert.Equal(t, pulumi.URN("urn:pulumi:stack::project::test:index:Site$test:index:Bucket::site-bucket"), b.URN)
+	assert.Equal(t, []Alias{{Name: "old-bucket"}}, b.Aliases)
+	assert.Equal(t, resource.NewStringProperty("site"), b.Inputs["name"])
+	assert.Equal(t, resource.NewStringProperty("arn:site-bucket"), b.State["arn"])
+	assert.Len(t, graph.OfType("test:index:Bucket"), 1)
+}
+
+func TestRunError(t *testing.T) {
+	t.Parallel()
+
+	mocks := pulumi.NewMocks().OnNewResource("test:index:Bucket",
+		func(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+			return "", nil, assert.AnError
+		})
+
+	graph, err := Run(func(ctx *pulumi.Context) error {
+		_, err := newSite(ctx, "site")
+		return err
+	}, WithMocks(mocks), WithProject("proj"), WithStack("dev"))
+	assert.ErrorContains(t, err, assert.AnError.Error())
+
+	require.Len(t, graph.Resources(), 1)
+	assert.Equal(t, pulumi.URN("urn:pulumi:dev::proj::test:index:Site::site"), graph.Resources()[0].URN)
+}
@@ -0,0 +1,154 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumitest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"pgregory.net/rapid"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
+)
+
+// Generated is an input produced by one of the generators in this package, along with the state that its output is
+// expected to resolve to. Value is only meaningful if Known is true.
+type Generated[T any] struct {
+	Input  pulumix.Input[T]
+	Value  T
+	Known  bool
+	Secret bool
+}
+
+// Output converts the generated input to an output.
+func (g Generated[T]) Output() pulumix.Output[T] {
+	return g.Input.ToOutput(context.Background())
+}
+
+// String describes the expected state of the generated input, for use in failure messages.
+func (g Generated[T]) String() string {
+	switch {
+	case !g.Known && g.Secret:
+		return "secret(unknown)"
+	case !g.Known:
+		return "unknown"
+	case g.Secret:
+		return fmt.Sprintf("secret(%v)", g.Value)
+	default:
+		return fmt.Sprintf("%v", g.Value)
+	}
+}
+
+// OutputGenerator generates outputs that resolve to values drawn from values. Each generated output is
+// independently known or unknown and secret or not.
+func OutputGenerator[T any](values *rapid.Generator[T]) *rapid.Generator[Generated[T]] {
+	return rapid.Custom(func(t *rapid.T) Generated[T] {
+		known := rapid.Bool().Draw(t, "known")
+		secret := rapid.Bool().Draw(t, "secret")
+
+		var value T
+		if known {
+			value = values.Draw(t, "value")
+		}
+
+		state := internal.NewOutputState(nil /* joinGroup */, reflect.TypeOf((*T)(nil)).Elem())
+		internal.ResolveOutput(state, value, known, secret, nil /* deps */)
+		return Generated[T]{
+			Input:  pulumix.Output[T]{OutputState: state},
+			Value:  value,
+			Known:  known,
+			Secret: secret,
+		}
+	})
+}
+
+// ArrayGenerator generates array inputs whose elements are drawn from elems. The array is known only if all of its
+// elements are known, and secret if any of its elements are secret.
+func ArrayGenerator[T any](elems *rapid.Generator[Generated[T]]) *rapid.Generator[Generated[[]T]] {
+	return rapid.Custom(func(t *rapid.T) Generated[[]T] {
+		items := rapid.SliceOfN(elems, 0, 4).Draw(t, "elements")
+
+		g := Generated[[]T]{Value: make([]T, len(items)), Known: true}
+		input := make(pulumix.Array[T], len(items))
+		for i, item := range items {
+			input[i] = item.Input
+			g.Value[i] = item.Value
+			g.Known = g.Known && item.Known
+			g.Secret = g.Secret || item.Secret
+		}
+		g.Input = input
+		return g
+	})
+}
+
+// MapGenerator generates map inputs whose elements are drawn from elems. The map is known only if all of its
+// elements are known, and secret if any of its elements are secret.
+func MapGenerator[T any](elems *rapid.Generator[Generated[T]]) *rapid.Generator[Generated[map[string]T]] {
+	return rapid.Custom(func(t *rapid.T) Generated[map[string]T] {
+		items := rapid.MapOfN(rapid.StringMatching("[a-z]{1,4}"), elems, 0, 4).Draw(t, "elements")
+
+		g := Generated[map[string]T]{Value: make(map[string]T, len(items)), Known: true}
+		input := make(pulumix.Map[T], len(items))
+		for k, item := range items {
+			input[k] = item.Input
+			g.Value[k] = item.Value
+			g.Known = g.Known && item.Known
+			g.Secret = g.Secret || item.Secret
+		}
+		g.Input = input
+		return g
+	})
+}
+
+// AnyGenerator generates inputs holding booleans, numbers, strings, and arrays and maps of the same, nested up to
+// maxDepth levels deep. Unknowns and secrets may appear at any level.
+func AnyGenerator(maxDepth int) *rapid.Generator[Generated[any]] {
+	scalars := rapid.OneOf(
+		rapid.Map(rapid.Bool(), func(v bool) any { return v }),
+		rapid.Map(rapid.Float64(), func(v float64) any { return v }),
+		rapid.Map(rapid.String(), func(v string) any { return v }),
+	)
+	if maxDepth <= 1 {
+		return OutputGenerator(scalars)
+	}
+
+	elems := AnyGenerator(maxDepth - 1)
+	return rapid.OneOf(
+		OutputGenerator(scalars),
+		rapid.Map(ArrayGenerator(elems), asAny[[]any]),
+		rapid.Map(MapGenerator(elems), asAny[map[string]any]),
+	)
+}
+
+func asAny[T any](g Generated[T]) Generated[any] {
+	return Generated[any]{
+		Input:  g.Output().AsAny(),
+		Value:  g.Value,
+		Known:  g.Known,
+		Secret: g.Secret,
+	}
+}
+
+// AwaitOutput waits for the given output to resolve and returns its value and whether it is known and secret.
+func AwaitOutput[T any](o pulumix.Output[T]) (value T, known, secret bool, err error) {
+	v, known, secret, _, err := internal.AwaitOutput(context.Background(), o)
+	if err != nil || !known {
+		return value, known, secret, err
+	}
+	value, _ = v.(T)
+	return value, known, secret, nil
+}
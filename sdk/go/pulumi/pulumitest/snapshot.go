@@ -0,0 +1,177 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumitest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+// snapshotResource is the serialized form of a Resource in a snapshot. Provider state is deliberately omitted, as
+// it is produced by fakes rather than by the code under test.
+type snapshotResource struct {
+	URN          string                 `json:"urn"`
+	Custom       bool                   `json:"custom"`
+	Read         bool                   `json:"read,omitempty"`
+	Parent       string                 `json:"parent,omitempty"`
+	Provider     string                 `json:"provider,omitempty"`
+	Protect      bool                   `json:"protect,omitempty"`
+	Dependencies []string               `json:"dependencies,omitempty"`
+	Aliases      []Alias                `json:"aliases,omitempty"`
+	Inputs       map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// Snapshot serializes the URNs, inputs, and options of the graph's resources to canonical JSON. Resources are sorted
+// by URN, so the snapshot does not depend on the order in which independent resources were registered.
+func (g *Graph) Snapshot() ([]byte, error) {
+	resources := g.Resources()
+	snap := make([]snapshotResource, len(resources))
+	for i, r := range resources {
+		deps := make([]string, len(r.Dependencies))
+		for j, dep := range r.Dependencies {
+			deps[j] = string(dep)
+		}
+		sort.Strings(deps)
+
+		var inputs map[string]interface{}
+		if len(r.Inputs) > 0 {
+			inputs = snapshotValue(resource.NewObjectProperty(r.Inputs)).(map[string]interface{})
+		}
+
+		snap[i] = snapshotResource{
+			URN:          string(r.URN),
+			Custom:       r.Custom,
+			Read:         r.Read,
+			Parent:       string(r.Parent),
+			Provider:     r.Provider,
+			Protect:      r.Protect,
+			Dependencies: deps,
+			Aliases:      r.Aliases,
+			Inputs:       inputs,
+		}
+	}
+	sort.Slice(snap, func(i, j int) bool { return snap[i].URN < snap[j].URN })
+
+	b, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// snapshotValue converts a property value to a JSON value, encoding secrets, assets, archives, and resource
+// references using the same signatures as the engine's checkpoint format.
+func snapshotValue(v resource.PropertyValue) interface{} {
+	switch {
+	case v.IsNull():
+		return nil
+	case v.IsBool(), v.IsNumber(), v.IsString():
+		return v.V
+	case v.IsArray():
+		arr := make([]interface{}, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = snapshotValue(e)
+		}
+		return arr
+	case v.IsObject():
+		obj := make(map[string]interface{}, len(v.ObjectValue()))
+		for k, e := range v.ObjectValue() {
+			obj[string(k)] = snapshotValue(e)
+		}
+		return obj
+	case v.IsAsset():
+		return v.AssetValue().Serialize()
+	case v.IsArchive():
+		return v.ArchiveValue().Serialize()
+	case v.IsSecret():
+		return map[string]interface{}{
+			resource.SigKey: resource.SecretSig,
+			"value":         snapshotValue(v.SecretValue().Element),
+		}
+	case v.IsResourceReference():
+		ref := v.ResourceReferenceValue()
+		obj := map[string]interface{}{
+			resource.SigKey: resource.ResourceReferenceSig,
+			"urn":           string(ref.URN),
+		}
+		if id, hasID := ref.IDString(); hasID {
+			obj["id"] = id
+		}
+		if ref.PackageVersion != "" {
+			obj["packageVersion"] = ref.PackageVersion
+		}
+		return obj
+	case v.IsOutput():
+		out := v.OutputValue()
+		if !out.Known {
+			return plugin.UnknownStringValue
+		}
+		if out.Secret {
+			return snapshotValue(resource.MakeSecret(out.Element))
+		}
+		return snapshotValue(out.Element)
+	default:
+		return plugin.UnknownStringValue
+	}
+}
+
+// AssertSnapshot compares the graph's snapshot with the golden file at path and fails the test if they differ.
+// If the PULUMI_ACCEPT environment variable is truthy, the golden file is written instead.
+func AssertSnapshot(t testing.TB, g *Graph, path string) bool {
+	t.Helper()
+
+	actual, err := g.Snapshot()
+	if err != nil {
+		t.Errorf("serializing resource graph: %v", err)
+		return false
+	}
+
+	if cmdutil.IsTruthy(os.Getenv("PULUMI_ACCEPT")) {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Errorf("creating snapshot directory: %v", err)
+			return false
+		}
+		if err := os.WriteFile(path, actual, 0o600); err != nil {
+			t.Errorf("writing snapshot: %v", err)
+			return false
+		}
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("snapshot %s does not exist; run with PULUMI_ACCEPT=true to create it", path)
+		} else {
+			t.Errorf("reading snapshot: %v", err)
+		}
+		return false
+	}
+
+	return assert.Equal(t, string(expected), string(actual),
+		fmt.Sprintf("snapshot %s is out of date; run with PULUMI_ACCEPT=true to update it", path))
+}
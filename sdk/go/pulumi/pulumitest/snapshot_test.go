@@ -0,0 +1,76 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumitest
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Note: to regenerate the baselines for these tests, run `go test` with `PULUMI_ACCEPT=true`.
+
+type recordingTB struct {
+	testing.TB
+
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertSnapshot(t *testing.T) {
+	t.Parallel()
+
+	graph, err := Run(func(ctx *pulumi.Context) error {
+		_, err := newSite(ctx, "site")
+		if err != nil {
+			return err
+		}
+		_, err = newSite(ctx, "other", pulumi.Protect(true))
+		return err
+	})
+	require.NoError(t, err)
+
+	AssertSnapshot(t, graph, filepath.Join("testdata", "site.json"))
+}
+
+func TestAssertSnapshotMismatch(t *testing.T) {
+	t.Parallel()
+
+	graph, err := Run(func(ctx *pulumi.Context) error {
+		_, err := newSite(ctx, "renamed")
+		return err
+	})
+	require.NoError(t, err)
+
+	tb := &recordingTB{TB: t}
+	assert.False(t, AssertSnapshot(tb, graph, filepath.Join("testdata", "site.json")))
+	assert.NotEmpty(t, tb.errors)
+
+	tb = &recordingTB{TB: t}
+	assert.False(t, AssertSnapshot(tb, graph, filepath.Join("testdata", "missing.json")))
+	assert.Equal(t, []string{
+		"snapshot testdata/missing.json does not exist; run with PULUMI_ACCEPT=true to create it",
+	}, tb.errors)
+}
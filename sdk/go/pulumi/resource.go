@@ -299,6 +299,32 @@ type CustomTimeouts struct {
 	Delete string
 }
 
+// RetryPolicy specifies how the engine retries a resource's
+// create, update, and delete operations when the provider fails them,
+// for example because of API throttling or eventual consistency.
+// Use it with the [Retry] option when creating new resources.
+//
+// Failed operations are retried up to MaxRetries times.
+// The delay between attempts starts at InitialDelay (1s if unset)
+// and doubles after each attempt, up to MaxDelay if set.
+// Delays are specified as duration strings, as with [CustomTimeouts].
+//
+// If RetryOn is set, only failures whose error messages match
+// one of its regular expressions, or that the provider reports
+// as transient, are retried.
+//
+// Operations that leave the resource partially initialized
+// are not retried.
+//
+// A RetryPolicy set on a provider resource applies to
+// the resources it manages that don't set their own.
+type RetryPolicy struct {
+	MaxRetries   int
+	InitialDelay string
+	MaxDelay     string
+	RetryOn      []string
+}
+
 // ResourceOptions is a snapshot of one or more [ResourceOption]s.
 //
 // You cannot pass a ResourceOptions struct to a resource constructor.
@@ -365,6 +391,15 @@ type ResourceOptions struct {
 	// replacements.
 	ReplaceOnChanges []string
 
+	// RetryPolicy, if set, configures retries of the resource's
+	// CRUD operations when they fail.
+	RetryPolicy *RetryPolicy
+
+	// Sequential lists resources that must finish being created or updated
+	// before this resource is registered.
+	// Unlike DependsOn, these are not recorded as dependencies.
+	Sequential []Resource
+
 	// Transformations is a list of functions that transform
 	// the resource's properties during construction.
 	Transformations []ResourceTransformation
@@ -389,6 +424,14 @@ type ResourceOptions struct {
 	// DeletedWith holds a container resource that, if deleted,
 	// also deletes this resource.
 	DeletedWith Resource
+
+	// DriftReport, if set, receives a report of the properties of a resource read with ReadResource
+	// that differ from the properties supplied to the read.
+	DriftReport *MapOutput
+
+	// Frozen specifies that the engine must refuse to update, replace,
+	// or delete the resource until it is unfrozen.
+	Frozen bool
 }
 
 // NewResourceOptions builds a preview of the effect of the provided options.
@@ -417,12 +460,16 @@ type resourceOptions struct {
 	Provider                ProviderResource
 	Providers               map[string]ProviderResource
 	ReplaceOnChanges        []string
+	RetryPolicy             *RetryPolicy
+	Sequential              []Resource
 	Transformations         []ResourceTransformation
 	URN                     string
 	Version                 string
 	PluginDownloadURL       string
 	RetainOnDelete          bool
 	DeletedWith             Resource
+	DriftReport             *MapOutput
+	Frozen                  bool
 }
 
 func resourceOptionsSnapshot(ro *resourceOptions) *ResourceOptions {
@@ -472,12 +519,16 @@ func resourceOptionsSnapshot(ro *resourceOptions) *ResourceOptions {
 		Provider:                ro.Provider,
 		Providers:               providers,
 		ReplaceOnChanges:        ro.ReplaceOnChanges,
+		RetryPolicy:             ro.RetryPolicy,
+		Sequential:              ro.Sequential,
 		Transformations:         ro.Transformations,
 		URN:                     ro.URN,
 		Version:                 ro.Version,
 		PluginDownloadURL:       ro.PluginDownloadURL,
 		RetainOnDelete:          ro.RetainOnDelete,
 		DeletedWith:             ro.DeletedWith,
+		DriftReport:             ro.DriftReport,
+		Frozen:                  ro.Frozen,
 	}
 }
 
@@ -674,14 +725,20 @@ func (ra *resourceArrayInputDependencySet) addURNs(ctx context.Context, urns urn
 	out := ra.input.ToResourceArrayOutput()
 
 	value, known, _ /* secret */, _ /* deps */, err := internal.AwaitOutput(ctx, out)
-	if err != nil || !known {
+	if err != nil {
 		return err
 	}
 
-	resources, ok := value.([]Resource)
-	if !ok {
-		return fmt.Errorf("ResourceArrayInput resolved to a value of unexpected type %v, expected []Resource",
-			reflect.TypeOf(value))
+	// If the set of resources is unknown (e.g. it is computed from unknown values during a preview),
+	// we can still depend on everything the output itself depends on.
+	var resources []Resource
+	if known {
+		var ok bool
+		resources, ok = value.([]Resource)
+		if !ok {
+			return fmt.Errorf("ResourceArrayInput resolved to a value of unexpected type %v, expected []Resource",
+				reflect.TypeOf(value))
+		}
 	}
 
 	// For some reason, deps returned above are incorrect; instead:
@@ -823,6 +880,26 @@ func ReplaceOnChanges(o []string) ResourceOption {
 	})
 }
 
+// Retry is an optional configuration block used to retry failed CRUD operations.
+func Retry(o *RetryPolicy) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.RetryPolicy = o
+	})
+}
+
+// Sequential delays the registration of this resource until the given resources
+// have finished being created or updated.
+// Use this to order operations against providers whose control planes
+// do not tolerate concurrent changes.
+//
+// Unlike DependsOn, the given resources are not recorded as dependencies,
+// so they do not affect the order of deletions or replacements.
+func Sequential(resources ...Resource) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.Sequential = append(ro.Sequential, resources...)
+	})
+}
+
 // Timeouts is an optional configuration block used for CRUD operations
 func Timeouts(o *CustomTimeouts) ResourceOption {
 	return resourceOption(func(ro *resourceOptions) {
@@ -881,6 +958,14 @@ func RetainOnDelete(b bool) ResourceOption {
 	})
 }
 
+// Frozen, when set to true, ensures that this resource cannot be updated, replaced or deleted. Unlike Protect, setting
+// it back to false does not unfreeze the resource; that takes `pulumi state unfreeze`.
+func Frozen(o bool) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.Frozen = o
+	})
+}
+
 // If set, the providers Delete method will not be called for this resource
 // if specified resource is being deleted as well.
 func DeletedWith(r Resource) ResourceOption {
@@ -888,3 +973,17 @@ func DeletedWith(r Resource) ResourceOption {
 		ro.DeletedWith = r
 	})
 }
+
+// DriftReport requests a report of how a resource read with ReadResource has drifted
+// from the properties supplied to the read. Once the read completes, the output
+// holds a map from the name of each differing property to a map with
+// "expected" (the supplied value) and "actual" (the value read from the provider) keys.
+// Properties that were not supplied to the read are not compared.
+//
+// The report is secret if any of the compared values are secret.
+// This option is only supported by ReadResource.
+func DriftReport(report *MapOutput) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.DriftReport = report
+	})
+}
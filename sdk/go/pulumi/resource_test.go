@@ -23,6 +23,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	grpc "google.golang.org/grpc"
@@ -729,6 +730,23 @@ func TestDependsOnInputs(t *testing.T) {
 				ApplyT(func(int) []Resource { return []Resource{dep1, dep2} }).(ResourceArrayInput)
 			checkDeps("r4", out4, dep1, dep2, dep4)
 
+			// Resources created inside an apply.
+			var inner Resource
+			out5 := String("inner").ToStringOutput().
+				ApplyT(func(name string) []Resource {
+					inner = newTestRes(t, ctx, name)
+					return []Resource{inner}
+				}).(ResourceArrayOutput)
+			r5 := newTestRes(t, ctx, "r5", DependsOnInputs(out5))
+			urn(t, ctx, r5) // wait for registration, which happens after the apply has run
+			assertHasDeps(t, ctx, depTracker, r5, inner)
+
+			// Unknown sets of resources still depend on the output's dependencies.
+			dep6 := newTestRes(t, ctx, "dep6")
+			out6 := outputDependingOnResource(dep6, false).
+				ApplyT(func(int) []Resource { return []Resource{dep1} }).(ResourceArrayInput)
+			checkDeps("r6", out6, dep6)
+
 			return nil
 		}, WithMocks("project", "stack", &testMonitor{}), WrapResourceMonitorClient(depTracker.Wrap))
 		assert.NoError(t, err)
@@ -941,6 +959,7 @@ func TestNewResourceOptions(t *testing.T) {
 	// Declared up here so that it may be shared to test
 	// referential equality.
 	sampleResourceInput := NewResourceInput(&testRes{foo: "foo"})
+	var sampleDriftReport MapOutput
 
 	tests := []struct {
 		desc string
@@ -1098,6 +1117,23 @@ func TestNewResourceOptions(t *testing.T) {
 				ReplaceOnChanges: []string{"foo", "bar"},
 			},
 		},
+		{
+			desc: "Retry",
+			give: Retry(&RetryPolicy{MaxRetries: 3, InitialDelay: "5s"}),
+			want: ResourceOptions{
+				RetryPolicy: &RetryPolicy{MaxRetries: 3, InitialDelay: "5s"},
+			},
+		},
+		{
+			desc: "Sequential",
+			give: Sequential(&testRes{foo: "foo"}, &testRes{foo: "bar"}),
+			want: ResourceOptions{
+				Sequential: []Resource{
+					&testRes{foo: "foo"},
+					&testRes{foo: "bar"},
+				},
+			},
+		},
 		{
 			desc: "Timeouts",
 			give: Timeouts(&CustomTimeouts{Create: "10s"}),
@@ -1130,6 +1166,16 @@ func TestNewResourceOptions(t *testing.T) {
 			give: DeletedWith(&testRes{foo: "a"}),
 			want: ResourceOptions{DeletedWith: &testRes{foo: "a"}},
 		},
+		{
+			desc: "DriftReport",
+			give: DriftReport(&sampleDriftReport),
+			want: ResourceOptions{DriftReport: &sampleDriftReport},
+		},
+		{
+			desc: "Frozen",
+			give: Frozen(true),
+			want: ResourceOptions{Frozen: true},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1298,6 +1344,8 @@ type interceptingResourceMonitor struct {
 	pulumirpc.ResourceMonitorClient
 
 	afterRegisterResource func(req *pulumirpc.RegisterResourceRequest, resp *pulumirpc.RegisterResourceResponse, err error)
+
+	afterRegisterResourceOutputs func(req *pulumirpc.RegisterResourceOutputsRequest, err error)
 }
 
 func newInterceptingResourceMonitor(inner pulumirpc.ResourceMonitorClient) *interceptingResourceMonitor {
@@ -1318,6 +1366,18 @@ func (i *interceptingResourceMonitor) RegisterResource(
 	return resp, err
 }
 
+func (i *interceptingResourceMonitor) RegisterResourceOutputs(
+	ctx context.Context,
+	in *pulumirpc.RegisterResourceOutputsRequest,
+	opts ...grpc.CallOption,
+) (*empty.Empty, error) {
+	resp, err := i.ResourceMonitorClient.RegisterResourceOutputs(ctx, in, opts...)
+	if i.afterRegisterResourceOutputs != nil {
+		i.afterRegisterResourceOutputs(in, err)
+	}
+	return resp, err
+}
+
 func TestRehydratedComponentConsideredRemote(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,7 @@ package pulumi
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strings"
 	"sync"
@@ -379,9 +380,21 @@ func marshalInputImpl(v interface{},
 		case reflect.Bool:
 			return resource.NewBoolProperty(rv.Bool()), deps, nil
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			return resource.NewNumberProperty(float64(rv.Int())), deps, nil
+			// Int64 values are checked for precision loss. Other integers keep their historical lossy conversion.
+			f, ok := intToNumber(rv.Int())
+			if !ok && rv.Type() == int64InputType {
+				return resource.PropertyValue{}, nil,
+					fmt.Errorf("%v value %d cannot be represented exactly as a number", destType, rv.Int())
+			}
+			return resource.NewNumberProperty(f), deps, nil
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			return resource.NewNumberProperty(float64(rv.Uint())), deps, nil
+			// Uint64 values are checked for precision loss. Other integers keep their historical lossy conversion.
+			f, ok := uintToNumber(rv.Uint())
+			if !ok && rv.Type() == uint64InputType {
+				return resource.PropertyValue{}, nil,
+					fmt.Errorf("%v value %d cannot be represented exactly as a number", destType, rv.Uint())
+			}
+			return resource.NewNumberProperty(f), deps, nil
 		case reflect.Float32, reflect.Float64:
 			return resource.NewNumberProperty(rv.Float()), deps, nil
 		case reflect.Ptr, reflect.Interface:
@@ -916,3 +929,22 @@ func init() {
 	resourcePackages = versionedMap{versions: make(map[string][]Versioned)}
 	resourceModules = versionedMap{versions: make(map[string][]Versioned)}
 }
+
+var (
+	int64InputType  = reflect.TypeOf(Int64(0))
+	uint64InputType = reflect.TypeOf(Uint64(0))
+)
+
+// intToNumber converts an integer to a float64 property number, reporting false if the conversion would lose
+// precision.
+func intToNumber(i int64) (float64, bool) {
+	f := float64(i)
+	return f, f < math.MaxInt64 && int64(f) == i
+}
+
+// uintToNumber converts an unsigned integer to a float64 property number, reporting false if the conversion would
+// lose precision.
+func uintToNumber(u uint64) (float64, bool) {
+	f := float64(u)
+	return f, f < math.MaxUint64 && uint64(f) == u
+}
@@ -18,6 +18,7 @@ package pulumi
 import (
 	"context"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 
@@ -539,6 +540,53 @@ func TestUnmarshalInternalMapValue(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestMarshal64BitNumbers(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(context.Background(), RunInfo{})
+	assert.NoError(t, err)
+
+	v, _, err := marshalInput(Int64(1<<53), int64Type, true)
+	require.NoError(t, err)
+	var i int64
+	_, err = unmarshalOutput(ctx, v, reflect.ValueOf(&i).Elem())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<53), i)
+
+	v, _, err = marshalInput(Uint64(1<<63), uint64Type, true)
+	require.NoError(t, err)
+	var u uint64
+	_, err = unmarshalOutput(ctx, v, reflect.ValueOf(&u).Elem())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1<<63), u)
+
+	v, _, err = marshalInput(Float32(1.5), float32Type, true)
+	require.NoError(t, err)
+	var f float32
+	_, err = unmarshalOutput(ctx, v, reflect.ValueOf(&f).Elem())
+	require.NoError(t, err)
+	assert.Equal(t, float32(1.5), f)
+
+	_, _, err = marshalInput(Int64(1<<53+1), int64Type, true)
+	assert.EqualError(t, err, "int64 value 9007199254740993 cannot be represented exactly as a number")
+
+	_, _, err = marshalInput(Uint64(math.MaxUint64), uint64Type, true)
+	assert.EqualError(t, err, "uint64 value 18446744073709551615 cannot be represented exactly as a number")
+
+	// Other integer types keep their lossy conversions.
+	v, _, err = marshalInput(Int(1<<53+1), intType, true)
+	require.NoError(t, err)
+	assert.Equal(t, float64(1<<53), v.NumberValue())
+
+	v, _, err = marshalInput(int64(1<<53+1), anyType, true)
+	require.NoError(t, err)
+	assert.Equal(t, float64(1<<53), v.NumberValue())
+
+	_, err = unmarshalOutput(ctx, resource.NewNumberProperty(1.5), reflect.ValueOf(&i).Elem())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), i)
+}
+
 // TestMarshalRoundtripNestedSecret ensures that marshaling a complex structure to and from
 // its on-the-wire gRPC format succeeds including a nested secret property.
 func TestMarshalRoundtripNestedSecret(t *testing.T) {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	multierror "github.com/hashicorp/go-multierror"
 
@@ -124,7 +125,7 @@ func RunWithContext(ctx *Context, body RunFunc) error {
 	}
 
 	// Register all the outputs to the stack object.
-	if err = ctx.RegisterResourceOutputs(ctx.stack, Map(ctx.exports)); err != nil {
+	if err = ctx.registerResourceOutputs(ctx.stack, Map(ctx.exports), ctx.exportMetadata()); err != nil {
 		result = multierror.Append(result, err)
 	}
 
@@ -152,6 +153,8 @@ type RunInfo struct {
 	MonitorAddr       string
 	EngineAddr        string
 	Organization      string
+	StackTags         map[string]string
+	RuntimeOptions    map[string]interface{}
 	Mocks             MockResourceMonitor
 
 	getPlugins bool
@@ -159,6 +162,61 @@ type RunInfo struct {
 
 	// If non-nil, wraps the resource monitor client used by Context.
 	wrapResourceMonitorClient func(pulumirpc.ResourceMonitorClient) pulumirpc.ResourceMonitorClient
+
+	// Interceptors applied to the unary RPCs made to the resource monitor and engine.
+	rpcInterceptors []grpc.UnaryClientInterceptor
+
+	// Callbacks invoked after each resource registration completes.
+	resourceRegisteredHooks []func(URN, time.Duration)
+
+	// The clock and random seed used by Context when running with mocks.
+	clock      func() time.Time
+	randomSeed *int64
+}
+
+// WithRPCInterceptor adds a gRPC interceptor to the connections to the resource monitor and the engine.
+// Interceptors observe every unary RPC the program makes, such as RegisterResource and Invoke,
+// and may be used to trace, log, or modify requests and responses.
+// Interceptors run in the order in which they are added.
+//
+// Interceptors are not used with mocks or when the context uses a pre-existing engine connection.
+func WithRPCInterceptor(interceptor grpc.UnaryClientInterceptor) RunOption {
+	return func(info *RunInfo) {
+		info.rpcInterceptors = append(info.rpcInterceptors, interceptor)
+	}
+}
+
+// OnResourceRegistered adds a callback that is invoked after each resource registration completes
+// with the URN of the resource and the time elapsed between the call to RegisterResource
+// (or a resource constructor) and the engine's response.
+// The elapsed time includes the time spent waiting for the resource's inputs and dependencies to resolve,
+// so it may be used to identify registrations that are serialized behind other resources.
+//
+// Callbacks are invoked concurrently from the goroutines performing the registrations
+// and must be safe for concurrent use.
+func OnResourceRegistered(callback func(urn URN, elapsed time.Duration)) RunOption {
+	return func(info *RunInfo) {
+		info.resourceRegisteredHooks = append(info.resourceRegisteredHooks, callback)
+	}
+}
+
+// WithClock sets the clock used by Context.Now, so that unit tests can produce reproducible timestamps.
+//
+// The clock is only used with mocks; real deployments always use the system clock.
+func WithClock(now func() time.Time) RunOption {
+	return func(info *RunInfo) {
+		info.clock = now
+	}
+}
+
+// WithRandomSeed seeds the random source used by Context.Autoname, so that unit tests can produce reproducible
+// names.
+//
+// The seed is only used with mocks; real deployments always use an unpredictable seed.
+func WithRandomSeed(seed int64) RunOption {
+	return func(info *RunInfo) {
+		info.randomSeed = &seed
+	}
 }
 
 // getEnvInfo reads various program information from the process environment.
@@ -178,12 +236,24 @@ func getEnvInfo() RunInfo {
 		_ = json.Unmarshal([]byte(keys), &configSecretKeys)
 	}
 
+	var stackTags map[string]string
+	if tags := os.Getenv(EnvStackTags); tags != "" {
+		_ = json.Unmarshal([]byte(tags), &stackTags)
+	}
+
+	var runtimeOptions map[string]interface{}
+	if opts := os.Getenv(EnvRuntimeOptions); opts != "" {
+		_ = json.Unmarshal([]byte(opts), &runtimeOptions)
+	}
+
 	return RunInfo{
 		Organization:     os.Getenv(EnvOrganization),
 		Project:          os.Getenv(EnvProject),
 		Stack:            os.Getenv(EnvStack),
 		Config:           config,
 		ConfigSecretKeys: configSecretKeys,
+		StackTags:        stackTags,
+		RuntimeOptions:   runtimeOptions,
 		Parallel:         parallel,
 		DryRun:           dryRun,
 		MonitorAddr:      os.Getenv(EnvMonitor),
@@ -204,6 +274,10 @@ const (
 	// EnvConfigSecretKeys is the envvar used to read the current Pulumi configuration keys that are secrets.
 	//nolint:gosec
 	EnvConfigSecretKeys = "PULUMI_CONFIG_SECRET_KEYS"
+	// EnvStackTags is the envvar used to read the tags of the current Pulumi stack.
+	EnvStackTags = "PULUMI_STACK_TAGS"
+	// EnvRuntimeOptions is the envvar used to read the runtime options of the current Pulumi project.
+	EnvRuntimeOptions = "PULUMI_RUNTIME_OPTIONS"
 	// EnvParallel is the envvar used to read the current Pulumi degree of parallelism.
 	EnvParallel = "PULUMI_PARALLEL"
 	// EnvDryRun is the envvar used to read the current Pulumi dry-run setting.
@@ -3,16 +3,21 @@ package pulumi
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/internal"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 // WithDryRun is an internal, test-only option
@@ -286,6 +291,63 @@ func TestReadResource(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestReadResourceDriftReport(t *testing.T) {
+	t.Parallel()
+
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			return args.ID, resource.PropertyMap{
+				"foo": resource.NewStringProperty("qux"),
+				"bar": resource.NewStringProperty("rab"),
+				"baz": resource.NewStringProperty("zab"),
+			}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		var drift MapOutput
+		err := ctx.ReadResource("test:resource:type", "resA", ID("someID"), &testResource2Inputs{
+			Foo: String("oof"),
+			Bar: String("rab"),
+		}, &res, DriftReport(&drift))
+		require.NoError(t, err)
+
+		report, known, secret, deps, err := await(drift)
+		require.NoError(t, err)
+		assert.True(t, known)
+		assert.False(t, secret)
+		assert.Equal(t, []Resource{&res}, deps)
+		assert.Equal(t, map[string]interface{}{
+			"foo": map[string]interface{}{"expected": "oof", "actual": "qux"},
+		}, report)
+
+		// Secret inputs make the report secret.
+		var res2 testResource2
+		var drift2 MapOutput
+		err = ctx.ReadResource("test:resource:type", "resB", ID("someID"), Map{
+			"foo": ToSecret(String("oof")),
+		}, &res2, DriftReport(&drift2))
+		require.NoError(t, err)
+
+		report, known, secret, _, err = await(drift2)
+		require.NoError(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, map[string]interface{}{
+			"foo": map[string]interface{}{"expected": "oof", "actual": "qux"},
+		}, report)
+
+		// The option is rejected by RegisterResource.
+		var res3 testResource2
+		err = ctx.RegisterResource("test:resource:type", "resC", &testResource2Inputs{}, &res3, DriftReport(&drift))
+		assert.ErrorContains(t, err, "only supported by ReadResource")
+
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+}
+
 func TestInvoke(t *testing.T) {
 	t.Parallel()
 
@@ -1032,3 +1094,82 @@ func TestResourceInput(t *testing.T) {
 	}, WithMocks("project", "stack", mocks))
 	assert.NoError(t, err)
 }
+
+type supportsFeatureMonitor struct {
+	pulumirpc.UnimplementedResourceMonitorServer
+}
+
+func (supportsFeatureMonitor) SupportsFeature(
+	context.Context, *pulumirpc.SupportsFeatureRequest,
+) (*pulumirpc.SupportsFeatureResponse, error) {
+	return &pulumirpc.SupportsFeatureResponse{HasSupport: true}, nil
+}
+
+func TestWithRPCInterceptor(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	pulumirpc.RegisterResourceMonitorServer(srv, supportsFeatureMonitor{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	var mu sync.Mutex
+	var methods []string
+	interceptor := func(
+		ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		mu.Lock()
+		methods = append(methods, method)
+		mu.Unlock()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	info := RunInfo{Project: "project", Stack: "stack", MonitorAddr: lis.Addr().String()}
+	WithRPCInterceptor(interceptor)(&info)
+
+	ctx, err := NewContext(context.Background(), info)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	assert.True(t, ctx.keepResources)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Contains(t, methods, "/pulumirpc.ResourceMonitor/SupportsFeature")
+}
+
+func TestOnResourceRegistered(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	registered := map[URN]time.Duration{}
+	hook := func(urn URN, elapsed time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		registered[urn] = elapsed
+	}
+
+	var urnA, urnB URN
+	err := RunErr(func(ctx *Context) error {
+		var resA, resB testResource2
+		err := ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{}, &resA)
+		require.NoError(t, err)
+		err = ctx.RegisterResource("test:resource:type", "resB", &testResource2Inputs{}, &resB, DependsOn([]Resource{&resA}))
+		require.NoError(t, err)
+
+		urnA, _, _, err = resA.URN().awaitURN(ctx.ctx)
+		require.NoError(t, err)
+		urnB, _, _, err = resB.URN().awaitURN(ctx.ctx)
+		require.NoError(t, err)
+		return nil
+	}, WithMocks("project", "stack", &testMonitor{}), OnResourceRegistered(hook))
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, registered, 3) // the stack, resA, and resB
+	assert.Contains(t, registered, urnA)
+	assert.Contains(t, registered, urnB)
+}
@@ -90,6 +90,46 @@ func (s *StackReference) GetOutputDetails(name string) (*StackReferenceOutputDet
 	return &d, nil
 }
 
+// StackReferenceOutputMetadata holds the documentation and type information that the referenced stack recorded for
+// one of its outputs with [Context.ExportWithMetadata].
+type StackReferenceOutputMetadata struct {
+	// Description describes the output.
+	Description string
+	// Schema is a JSON schema document describing the output's type.
+	Schema string
+}
+
+// GetOutputMetadata retrieves the metadata that the referenced stack recorded for the output with the given name.
+// It returns nil if the stack recorded no metadata for the output, or if the stack's outputs aren't known yet.
+func (s *StackReference) GetOutputMetadata(name string) (*StackReferenceOutputMetadata, error) {
+	raw, known, _, _, err := awaitWithContext(s.ctx.Context(), s.rawOutputs)
+	if err != nil || !known {
+		return nil, err
+	}
+	stack, ok := raw.(resource.PropertyMap)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert %T to object", raw)
+	}
+
+	metadata := stack["outputMetadata"]
+	if !metadata.IsObject() {
+		return nil, nil
+	}
+	v, ok := metadata.ObjectValue()[resource.PropertyKey(name)]
+	if !ok || !v.IsObject() {
+		return nil, nil
+	}
+
+	var md StackReferenceOutputMetadata
+	if d := v.ObjectValue()["description"]; d.IsString() {
+		md.Description = d.StringValue()
+	}
+	if schema := v.ObjectValue()["schema"]; schema.IsString() {
+		md.Schema = schema.StringValue()
+	}
+	return &md, nil
+}
+
 // GetStringOutput returns a stack output keyed by the given name as an StringOutput
 func (s *StackReference) GetStringOutput(name StringInput) StringOutput {
 	return All(name, s.GetOutput(name)).ApplyT(func(args []interface{}) (string, error) {
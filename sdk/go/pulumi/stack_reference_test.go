@@ -256,3 +256,43 @@ func TestStackReference_GetOutputDetails(t *testing.T) {
 		})
 	}
 }
+
+func TestStackReference_GetOutputMetadata(t *testing.T) {
+	t.Parallel()
+
+	mocks := testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			return args.Name + "_id", resource.NewPropertyMapFromMap(map[string]interface{}{
+				"outputs": map[string]interface{}{
+					"bucket": "mybucket-1234",
+					"region": "us-west-2",
+				},
+				"outputMetadata": map[string]interface{}{
+					"bucket": map[string]interface{}{
+						"description": "The name of the bucket.",
+						"schema":      `{"type":"string"}`,
+					},
+				},
+			}), nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		ref, err := NewStackReference(ctx, "ref", nil /* args */)
+		require.NoError(t, err)
+
+		md, err := ref.GetOutputMetadata("bucket")
+		require.NoError(t, err)
+		assert.Equal(t, &StackReferenceOutputMetadata{
+			Description: "The name of the bucket.",
+			Schema:      `{"type":"string"}`,
+		}, md)
+
+		md, err = ref.GetOutputMetadata("region")
+		require.NoError(t, err)
+		assert.Nil(t, md)
+
+		return nil
+	}, WithMocks("proj", "stack", &mocks))
+	require.NoError(t, err)
+}
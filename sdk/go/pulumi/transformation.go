@@ -1,5 +1,10 @@
 package pulumi
 
+import (
+	"reflect"
+	"strings"
+)
+
 // ResourceTransformationArgs is the argument bag passed to a resource transformation.
 type ResourceTransformationArgs struct {
 	// The resource instance that is being transformed.
@@ -31,3 +36,84 @@ type ResourceTransformationResult struct {
 // of the original call to the `Resource` constructor.  If the transformation returns nil,
 // this indicates that the resource will not be transformed.
 type ResourceTransformation func(*ResourceTransformationArgs) *ResourceTransformationResult
+
+// TaggableArgs is implemented by the arguments of resources whose provider schema declares a tags property. The
+// generated SDKs of such providers implement it, and AutoTag uses it to find the field to add tags to.
+type TaggableArgs interface {
+	// TagsField returns the name of the StringMapInput field that holds the resource's tags or labels.
+	TagsField() string
+}
+
+var stringMapInputType = reflect.TypeOf((*StringMapInput)(nil)).Elem()
+
+// DefaultTags returns the tags that AutoTag is typically used with: the names of the project and stack being
+// deployed, and the stack's version control tags, such as the git repository it is deployed from, if any.
+func DefaultTags(ctx *Context) map[string]StringInput {
+	tags := map[string]StringInput{
+		"pulumi:project": String(ctx.Project()),
+		"pulumi:stack":   String(ctx.Stack()),
+	}
+	for k, v := range ctx.StackTags() {
+		if strings.HasPrefix(k, "vcs:") {
+			tags[k] = String(v)
+		}
+	}
+	return tags
+}
+
+// AutoTag returns a stack transformation that adds the given tags to every resource that accepts them. A resource
+// accepts tags if its provider's schema declares a tags property for it, in which case its arguments implement
+// TaggableArgs. Tags already set on the resource take precedence over the ones given here.
+//
+// For example, to tag every resource in a stack with the project and stack names and the stack's git repository:
+//
+//	ctx.RegisterStackTransformation(pulumi.AutoTag(pulumi.DefaultTags(ctx)))
+func AutoTag(tags map[string]StringInput) ResourceTransformation {
+	return func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+		taggable, ok := args.Props.(TaggableArgs)
+		if !ok {
+			return nil
+		}
+		props := reflect.ValueOf(args.Props)
+		if props.Kind() != reflect.Ptr || props.IsNil() || props.Elem().Kind() != reflect.Struct {
+			return nil
+		}
+		field := props.Elem().FieldByName(taggable.TagsField())
+		if !field.IsValid() || field.Type() != stringMapInputType {
+			return nil
+		}
+
+		var existing StringMapInput
+		if !field.IsNil() {
+			existing = field.Interface().(StringMapInput)
+		}
+
+		// Work on a copy so that the caller's arguments are left untouched.
+		copied := reflect.New(props.Elem().Type())
+		copied.Elem().Set(props.Elem())
+		copied.Elem().FieldByName(taggable.TagsField()).Set(reflect.ValueOf(mergeTags(tags, existing)))
+
+		return &ResourceTransformationResult{
+			Props: copied.Interface().(Input),
+			Opts:  args.Opts,
+		}
+	}
+}
+
+// mergeTags combines the default tags with the tags set on a resource,
+// giving precedence to the latter.
+func mergeTags(defaults map[string]StringInput, existing StringMapInput) StringMapInput {
+	if existing == nil {
+		return StringMap(defaults)
+	}
+
+	return All(StringMap(defaults), existing).ApplyT(func(vs []interface{}) map[string]string {
+		merged := make(map[string]string)
+		for _, v := range vs {
+			for k, tag := range v.(map[string]string) {
+				merged[k] = tag
+			}
+		}
+		return merged
+	}).(StringMapOutput)
+}
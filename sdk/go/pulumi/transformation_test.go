@@ -0,0 +1,127 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type autoTagTestArgs struct {
+	Name   StringInput
+	Tags   StringMapInput
+	Labels StringMapInput
+}
+
+func (autoTagTestArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*autoTagTestArgs)(nil)).Elem()
+}
+
+// TagsField reports Labels rather than Tags to check that AutoTag follows the schema rather than field names.
+func (autoTagTestArgs) TagsField() string {
+	return "Labels"
+}
+
+type untaggableTestArgs struct {
+	Tags StringMapInput
+}
+
+func (untaggableTestArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*untaggableTestArgs)(nil)).Elem()
+}
+
+func TestAutoTag(t *testing.T) {
+	t.Parallel()
+
+	transform := AutoTag(map[string]StringInput{
+		"stack": String("dev"),
+		"owner": String("platform"),
+	})
+
+	t.Run("untaggable", func(t *testing.T) {
+		t.Parallel()
+
+		res := transform(&ResourceTransformationArgs{Props: Map{"tags": StringMap{}}})
+		assert.Nil(t, res)
+
+		res = transform(&ResourceTransformationArgs{Props: &untaggableTestArgs{}})
+		assert.Nil(t, res, "only resources with a schema tags property are tagged")
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		t.Parallel()
+
+		args := &autoTagTestArgs{Name: String("a")}
+		opts := []ResourceOption{Protect(true)}
+		res := transform(&ResourceTransformationArgs{Props: args, Opts: opts})
+		require.NotNil(t, res)
+		assert.Equal(t, opts, res.Opts)
+		assert.Nil(t, args.Labels, "original args must not be modified")
+
+		newArgs := res.Props.(*autoTagTestArgs)
+		assert.Equal(t, String("a"), newArgs.Name)
+		assert.Nil(t, newArgs.Tags, "only the schema's tags property is tagged")
+
+		v, known, _, _, err := await(newArgs.Labels.ToStringMapOutput())
+		require.NoError(t, err)
+		assert.True(t, known)
+		assert.Equal(t, map[string]string{"stack": "dev", "owner": "platform"}, v)
+	})
+
+	t.Run("existing tags", func(t *testing.T) {
+		t.Parallel()
+
+		args := &autoTagTestArgs{Labels: ToSecret(StringMap{"owner": String("me")}).(StringMapOutput)}
+		res := transform(&ResourceTransformationArgs{Props: args})
+		require.NotNil(t, res)
+
+		newArgs := res.Props.(*autoTagTestArgs)
+		v, known, secret, _, err := await(newArgs.Labels.ToStringMapOutput())
+		require.NoError(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, map[string]string{"stack": "dev", "owner": "me"}, v)
+	})
+}
+
+func TestDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(context.Background(), RunInfo{
+		Project: "proj",
+		Stack:   "dev",
+		StackTags: map[string]string{
+			"vcs:owner":      "pulumi",
+			"vcs:repo":       "pulumi",
+			"pulumi:runtime": "go",
+		},
+	})
+	require.NoError(t, err)
+
+	tags := DefaultTags(ctx)
+	v, known, _, _, err := await(StringMap(tags).ToStringMapOutput())
+	require.NoError(t, err)
+	assert.True(t, known)
+	assert.Equal(t, map[string]string{
+		"pulumi:project": "proj",
+		"pulumi:stack":   "dev",
+		"vcs:owner":      "pulumi",
+		"vcs:repo":       "pulumi",
+	}, v)
+}
@@ -52,6 +52,16 @@ func RegisterInputType(interfaceType reflect.Type, input Input) {
 // OutputState holds the internal details of an Output and implements the Apply and ApplyWithContext methods.
 type OutputState = internal.OutputState
 
+// Provenance describes where the value of an Output came from. See OutputState.Provenance.
+type Provenance = internal.Provenance
+
+// SetProvenanceTracking enables or disables recording of the outputs that new outputs are derived from, which
+// Provenance reports as Sources. Tracking is off by default because it keeps every ancestor of a live output in
+// memory. It can also be enabled by setting PULUMI_TRACK_OUTPUT_PROVENANCE.
+func SetProvenanceTracking(enabled bool) {
+	internal.SetProvenanceTracking(enabled)
+}
+
 func newAnyOutput(wg *workGroup) (Output, func(interface{}), func(error)) {
 	out := internal.NewOutputState(wg, anyType)
 
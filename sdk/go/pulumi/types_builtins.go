@@ -2298,3947 +2298,5942 @@ func ToBoolArrayArrayOutput(in []BoolArrayOutput) BoolArrayArrayOutput {
 	return a.ToBoolArrayArrayOutput()
 }
 
-var float64Type = reflect.TypeOf((*float64)(nil)).Elem()
+var float32Type = reflect.TypeOf((*float32)(nil)).Elem()
 
-// Float64Input is an input type that accepts Float64 and Float64Output values.
-type Float64Input interface {
+// Float32Input is an input type that accepts Float32 and Float32Output values.
+type Float32Input interface {
 	Input
 
-	ToFloat64Output() Float64Output
-	ToFloat64OutputWithContext(ctx context.Context) Float64Output
+	ToFloat32Output() Float32Output
+	ToFloat32OutputWithContext(ctx context.Context) Float32Output
 
-	ToFloat64PtrOutput() Float64PtrOutput
-	ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput
+	ToFloat32PtrOutput() Float32PtrOutput
+	ToFloat32PtrOutputWithContext(ctx context.Context) Float32PtrOutput
 }
 
-// Float64 is an input type for float64 values.
-type Float64 float64
+// Float32 is an input type for float32 values.
+type Float32 float32
 
-// ElementType returns the element type of this Input (float64).
-func (Float64) ElementType() reflect.Type {
-	return float64Type
+// ElementType returns the element type of this Input (float32).
+func (Float32) ElementType() reflect.Type {
+	return float32Type
 }
 
-func (in Float64) ToOutput(ctx context.Context) pulumix.Output[float64] {
-	return pulumix.Output[float64]{
+func (in Float32) ToOutput(ctx context.Context) pulumix.Output[float32] {
+	return pulumix.Output[float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64) ToFloat64Output() Float64Output {
-	return ToOutput(in).(Float64Output)
+func (in Float32) ToFloat32Output() Float32Output {
+	return ToOutput(in).(Float32Output)
 }
 
-func (in Float64) ToFloat64OutputWithContext(ctx context.Context) Float64Output {
-	return ToOutputWithContext(ctx, in).(Float64Output)
+func (in Float32) ToFloat32OutputWithContext(ctx context.Context) Float32Output {
+	return ToOutputWithContext(ctx, in).(Float32Output)
 }
 
-func (in Float64) ToFloat64PtrOutput() Float64PtrOutput {
-	return in.ToFloat64PtrOutputWithContext(context.Background())
+func (in Float32) ToFloat32PtrOutput() Float32PtrOutput {
+	return in.ToFloat32PtrOutputWithContext(context.Background())
 }
 
-func (in Float64) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
-	return in.ToFloat64OutputWithContext(ctx).ToFloat64PtrOutputWithContext(ctx)
+func (in Float32) ToFloat32PtrOutputWithContext(ctx context.Context) Float32PtrOutput {
+	return in.ToFloat32OutputWithContext(ctx).ToFloat32PtrOutputWithContext(ctx)
 }
 
-// Float64Output is an Output that returns float64 values.
-type Float64Output struct{ *OutputState }
+// Float32Output is an Output that returns float32 values.
+type Float32Output struct{ *OutputState }
 
-func (Float64Output) MarshalJSON() ([]byte, error) {
+func (Float32Output) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64Output) ToOutput(ctx context.Context) pulumix.Output[float64] {
-	return pulumix.Output[float64]{
+func (o Float32Output) ToOutput(ctx context.Context) pulumix.Output[float32] {
+	return pulumix.Output[float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (float64).
-func (Float64Output) ElementType() reflect.Type {
-	return float64Type
+// ElementType returns the element type of this Output (float32).
+func (Float32Output) ElementType() reflect.Type {
+	return float32Type
 }
 
-func (o Float64Output) ToFloat64Output() Float64Output {
+func (o Float32Output) ToFloat32Output() Float32Output {
 	return o
 }
 
-func (o Float64Output) ToFloat64OutputWithContext(ctx context.Context) Float64Output {
+func (o Float32Output) ToFloat32OutputWithContext(ctx context.Context) Float32Output {
 	return o
 }
 
-func (o Float64Output) ToFloat64PtrOutput() Float64PtrOutput {
-	return o.ToFloat64PtrOutputWithContext(context.Background())
+func (o Float32Output) ToFloat32PtrOutput() Float32PtrOutput {
+	return o.ToFloat32PtrOutputWithContext(context.Background())
 }
 
-func (o Float64Output) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v float64) *float64 {
+func (o Float32Output) ToFloat32PtrOutputWithContext(ctx context.Context) Float32PtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v float32) *float32 {
 		return &v
-	}).(Float64PtrOutput)
+	}).(Float32PtrOutput)
 }
 
-var float64PtrType = reflect.TypeOf((**float64)(nil)).Elem()
+var float32PtrType = reflect.TypeOf((**float32)(nil)).Elem()
 
-// Float64PtrInput is an input type that accepts Float64Ptr and Float64PtrOutput values.
-type Float64PtrInput interface {
+// Float32PtrInput is an input type that accepts Float32Ptr and Float32PtrOutput values.
+type Float32PtrInput interface {
 	Input
 
-	ToFloat64PtrOutput() Float64PtrOutput
-	ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput
+	ToFloat32PtrOutput() Float32PtrOutput
+	ToFloat32PtrOutputWithContext(ctx context.Context) Float32PtrOutput
 }
 
-type float64Ptr float64
+type float32Ptr float32
 
-// Float64Ptr is an input type for *float64 values.
-func Float64Ptr(v float64) Float64PtrInput {
-	return (*float64Ptr)(&v)
+// Float32Ptr is an input type for *float32 values.
+func Float32Ptr(v float32) Float32PtrInput {
+	return (*float32Ptr)(&v)
 }
-func Float64PtrFromPtr(v *float64) Float64PtrInput {
+func Float32PtrFromPtr(v *float32) Float32PtrInput {
 	if v == nil {
 		return nil
 	}
-	return (*float64Ptr)(v)
+	return (*float32Ptr)(v)
 }
 
-// ElementType returns the element type of this Input (*float64).
-func (*float64Ptr) ElementType() reflect.Type {
-	return float64PtrType
+// ElementType returns the element type of this Input (*float32).
+func (*float32Ptr) ElementType() reflect.Type {
+	return float32PtrType
 }
 
-func (in *float64Ptr) ToOutput(ctx context.Context) pulumix.Output[*float64] {
-	return pulumix.Output[*float64]{
+func (in *float32Ptr) ToOutput(ctx context.Context) pulumix.Output[*float32] {
+	return pulumix.Output[*float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in *float64Ptr) ToFloat64PtrOutput() Float64PtrOutput {
-	return ToOutput(in).(Float64PtrOutput)
+func (in *float32Ptr) ToFloat32PtrOutput() Float32PtrOutput {
+	return ToOutput(in).(Float32PtrOutput)
 }
 
-func (in *float64Ptr) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
-	return ToOutputWithContext(ctx, in).(Float64PtrOutput)
+func (in *float32Ptr) ToFloat32PtrOutputWithContext(ctx context.Context) Float32PtrOutput {
+	return ToOutputWithContext(ctx, in).(Float32PtrOutput)
 }
 
-// Float64PtrOutput is an Output that returns *float64 values.
-type Float64PtrOutput struct{ *OutputState }
+// Float32PtrOutput is an Output that returns *float32 values.
+type Float32PtrOutput struct{ *OutputState }
 
-func (Float64PtrOutput) MarshalJSON() ([]byte, error) {
+func (Float32PtrOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64PtrOutput) ToOutput(ctx context.Context) pulumix.Output[*float64] {
-	return pulumix.Output[*float64]{
+func (o Float32PtrOutput) ToOutput(ctx context.Context) pulumix.Output[*float32] {
+	return pulumix.Output[*float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (*float64).
-func (Float64PtrOutput) ElementType() reflect.Type {
-	return float64PtrType
+// ElementType returns the element type of this Output (*float32).
+func (Float32PtrOutput) ElementType() reflect.Type {
+	return float32PtrType
 }
 
-func (o Float64PtrOutput) ToFloat64PtrOutput() Float64PtrOutput {
+func (o Float32PtrOutput) ToFloat32PtrOutput() Float32PtrOutput {
 	return o
 }
 
-func (o Float64PtrOutput) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
+func (o Float32PtrOutput) ToFloat32PtrOutputWithContext(ctx context.Context) Float32PtrOutput {
 	return o
 }
 
 // Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
-func (o Float64PtrOutput) Elem() Float64Output {
-	return o.ApplyT(func(v *float64) float64 {
-		var ret float64
+func (o Float32PtrOutput) Elem() Float32Output {
+	return o.ApplyT(func(v *float32) float32 {
+		var ret float32
 		if v != nil {
 			ret = *v
 		}
 		return ret
-	}).(Float64Output)
+	}).(Float32Output)
 }
 
-var float64ArrayType = reflect.TypeOf((*[]float64)(nil)).Elem()
+var float32ArrayType = reflect.TypeOf((*[]float32)(nil)).Elem()
 
-// Float64ArrayInput is an input type that accepts Float64Array and Float64ArrayOutput values.
-type Float64ArrayInput interface {
+// Float32ArrayInput is an input type that accepts Float32Array and Float32ArrayOutput values.
+type Float32ArrayInput interface {
 	Input
 
-	ToFloat64ArrayOutput() Float64ArrayOutput
-	ToFloat64ArrayOutputWithContext(ctx context.Context) Float64ArrayOutput
+	ToFloat32ArrayOutput() Float32ArrayOutput
+	ToFloat32ArrayOutputWithContext(ctx context.Context) Float32ArrayOutput
 }
 
-// Float64Array is an input type for []Float64Input values.
-type Float64Array []Float64Input
+// Float32Array is an input type for []Float32Input values.
+type Float32Array []Float32Input
 
-// ElementType returns the element type of this Input ([]float64).
-func (Float64Array) ElementType() reflect.Type {
-	return float64ArrayType
+// ElementType returns the element type of this Input ([]float32).
+func (Float32Array) ElementType() reflect.Type {
+	return float32ArrayType
 }
 
-func (in Float64Array) ToOutput(ctx context.Context) pulumix.Output[[]float64] {
-	return pulumix.Output[[]float64]{
+func (in Float32Array) ToOutput(ctx context.Context) pulumix.Output[[]float32] {
+	return pulumix.Output[[]float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64Array) ToFloat64ArrayOutput() Float64ArrayOutput {
-	return ToOutput(in).(Float64ArrayOutput)
+func (in Float32Array) ToFloat32ArrayOutput() Float32ArrayOutput {
+	return ToOutput(in).(Float32ArrayOutput)
 }
 
-func (in Float64Array) ToFloat64ArrayOutputWithContext(ctx context.Context) Float64ArrayOutput {
-	return ToOutputWithContext(ctx, in).(Float64ArrayOutput)
+func (in Float32Array) ToFloat32ArrayOutputWithContext(ctx context.Context) Float32ArrayOutput {
+	return ToOutputWithContext(ctx, in).(Float32ArrayOutput)
 }
 
-// Float64ArrayOutput is an Output that returns []float64 values.
-type Float64ArrayOutput struct{ *OutputState }
+// Float32ArrayOutput is an Output that returns []float32 values.
+type Float32ArrayOutput struct{ *OutputState }
 
-func (Float64ArrayOutput) MarshalJSON() ([]byte, error) {
+func (Float32ArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]float64] {
-	return pulumix.Output[[]float64]{
+func (o Float32ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]float32] {
+	return pulumix.Output[[]float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]float64).
-func (Float64ArrayOutput) ElementType() reflect.Type {
-	return float64ArrayType
+// ElementType returns the element type of this Output ([]float32).
+func (Float32ArrayOutput) ElementType() reflect.Type {
+	return float32ArrayType
 }
 
-func (o Float64ArrayOutput) ToFloat64ArrayOutput() Float64ArrayOutput {
+func (o Float32ArrayOutput) ToFloat32ArrayOutput() Float32ArrayOutput {
 	return o
 }
 
-func (o Float64ArrayOutput) ToFloat64ArrayOutputWithContext(ctx context.Context) Float64ArrayOutput {
+func (o Float32ArrayOutput) ToFloat32ArrayOutputWithContext(ctx context.Context) Float32ArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o Float64ArrayOutput) Index(i IntInput) Float64Output {
-	return All(o, i).ApplyT(func(vs []interface{}) float64 {
-		arr := vs[0].([]float64)
+func (o Float32ArrayOutput) Index(i IntInput) Float32Output {
+	return All(o, i).ApplyT(func(vs []interface{}) float32 {
+		arr := vs[0].([]float32)
 		idx := vs[1].(int)
-		var ret float64
+		var ret float32
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(Float64Output)
+	}).(Float32Output)
 }
 
-func ToFloat64Array(in []float64) Float64Array {
-	a := make(Float64Array, len(in))
+func ToFloat32Array(in []float32) Float32Array {
+	a := make(Float32Array, len(in))
 	for i, v := range in {
-		a[i] = Float64(v)
+		a[i] = Float32(v)
 	}
 	return a
 }
 
-func ToFloat64ArrayOutput(in []Float64Output) Float64ArrayOutput {
-	a := make(Float64Array, len(in))
+func ToFloat32ArrayOutput(in []Float32Output) Float32ArrayOutput {
+	a := make(Float32Array, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToFloat64ArrayOutput()
+	return a.ToFloat32ArrayOutput()
 }
 
-var float64MapType = reflect.TypeOf((*map[string]float64)(nil)).Elem()
+var float32MapType = reflect.TypeOf((*map[string]float32)(nil)).Elem()
 
-// Float64MapInput is an input type that accepts Float64Map and Float64MapOutput values.
-type Float64MapInput interface {
+// Float32MapInput is an input type that accepts Float32Map and Float32MapOutput values.
+type Float32MapInput interface {
 	Input
 
-	ToFloat64MapOutput() Float64MapOutput
-	ToFloat64MapOutputWithContext(ctx context.Context) Float64MapOutput
+	ToFloat32MapOutput() Float32MapOutput
+	ToFloat32MapOutputWithContext(ctx context.Context) Float32MapOutput
 }
 
-// Float64Map is an input type for map[string]Float64Input values.
-type Float64Map map[string]Float64Input
+// Float32Map is an input type for map[string]Float32Input values.
+type Float32Map map[string]Float32Input
 
-// ElementType returns the element type of this Input (map[string]float64).
-func (Float64Map) ElementType() reflect.Type {
-	return float64MapType
+// ElementType returns the element type of this Input (map[string]float32).
+func (Float32Map) ElementType() reflect.Type {
+	return float32MapType
 }
 
-func (in Float64Map) ToOutput(ctx context.Context) pulumix.Output[map[string]float64] {
-	return pulumix.Output[map[string]float64]{
+func (in Float32Map) ToOutput(ctx context.Context) pulumix.Output[map[string]float32] {
+	return pulumix.Output[map[string]float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64Map) ToFloat64MapOutput() Float64MapOutput {
-	return ToOutput(in).(Float64MapOutput)
+func (in Float32Map) ToFloat32MapOutput() Float32MapOutput {
+	return ToOutput(in).(Float32MapOutput)
 }
 
-func (in Float64Map) ToFloat64MapOutputWithContext(ctx context.Context) Float64MapOutput {
-	return ToOutputWithContext(ctx, in).(Float64MapOutput)
+func (in Float32Map) ToFloat32MapOutputWithContext(ctx context.Context) Float32MapOutput {
+	return ToOutputWithContext(ctx, in).(Float32MapOutput)
 }
 
-// Float64MapOutput is an Output that returns map[string]float64 values.
-type Float64MapOutput struct{ *OutputState }
+// Float32MapOutput is an Output that returns map[string]float32 values.
+type Float32MapOutput struct{ *OutputState }
 
-func (Float64MapOutput) MarshalJSON() ([]byte, error) {
+func (Float32MapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]float64] {
-	return pulumix.Output[map[string]float64]{
+func (o Float32MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]float32] {
+	return pulumix.Output[map[string]float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]float64).
-func (Float64MapOutput) ElementType() reflect.Type {
-	return float64MapType
+// ElementType returns the element type of this Output (map[string]float32).
+func (Float32MapOutput) ElementType() reflect.Type {
+	return float32MapType
 }
 
-func (o Float64MapOutput) ToFloat64MapOutput() Float64MapOutput {
+func (o Float32MapOutput) ToFloat32MapOutput() Float32MapOutput {
 	return o
 }
 
-func (o Float64MapOutput) ToFloat64MapOutputWithContext(ctx context.Context) Float64MapOutput {
+func (o Float32MapOutput) ToFloat32MapOutputWithContext(ctx context.Context) Float32MapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o Float64MapOutput) MapIndex(k StringInput) Float64Output {
-	return All(o, k).ApplyT(func(vs []interface{}) float64 {
-		return vs[0].(map[string]float64)[vs[1].(string)]
-	}).(Float64Output)
+func (o Float32MapOutput) MapIndex(k StringInput) Float32Output {
+	return All(o, k).ApplyT(func(vs []interface{}) float32 {
+		return vs[0].(map[string]float32)[vs[1].(string)]
+	}).(Float32Output)
 }
 
-func ToFloat64Map(in map[string]float64) Float64Map {
-	m := make(Float64Map)
+func ToFloat32Map(in map[string]float32) Float32Map {
+	m := make(Float32Map)
 	for k, v := range in {
-		m[k] = Float64(v)
+		m[k] = Float32(v)
 	}
 	return m
 }
 
-func ToFloat64MapOutput(in map[string]Float64Output) Float64MapOutput {
-	m := make(Float64Map)
+func ToFloat32MapOutput(in map[string]Float32Output) Float32MapOutput {
+	m := make(Float32Map)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToFloat64MapOutput()
+	return m.ToFloat32MapOutput()
 }
 
-var float64ArrayMapType = reflect.TypeOf((*map[string][]float64)(nil)).Elem()
+var float32ArrayMapType = reflect.TypeOf((*map[string][]float32)(nil)).Elem()
 
-// Float64ArrayMapInput is an input type that accepts Float64ArrayMap and Float64ArrayMapOutput values.
-type Float64ArrayMapInput interface {
+// Float32ArrayMapInput is an input type that accepts Float32ArrayMap and Float32ArrayMapOutput values.
+type Float32ArrayMapInput interface {
 	Input
 
-	ToFloat64ArrayMapOutput() Float64ArrayMapOutput
-	ToFloat64ArrayMapOutputWithContext(ctx context.Context) Float64ArrayMapOutput
+	ToFloat32ArrayMapOutput() Float32ArrayMapOutput
+	ToFloat32ArrayMapOutputWithContext(ctx context.Context) Float32ArrayMapOutput
 }
 
-// Float64ArrayMap is an input type for map[string]Float64ArrayInput values.
-type Float64ArrayMap map[string]Float64ArrayInput
+// Float32ArrayMap is an input type for map[string]Float32ArrayInput values.
+type Float32ArrayMap map[string]Float32ArrayInput
 
-// ElementType returns the element type of this Input (map[string][]float64).
-func (Float64ArrayMap) ElementType() reflect.Type {
-	return float64ArrayMapType
+// ElementType returns the element type of this Input (map[string][]float32).
+func (Float32ArrayMap) ElementType() reflect.Type {
+	return float32ArrayMapType
 }
 
-func (in Float64ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]float64] {
-	return pulumix.Output[map[string][]float64]{
+func (in Float32ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]float32] {
+	return pulumix.Output[map[string][]float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64ArrayMap) ToFloat64ArrayMapOutput() Float64ArrayMapOutput {
-	return ToOutput(in).(Float64ArrayMapOutput)
+func (in Float32ArrayMap) ToFloat32ArrayMapOutput() Float32ArrayMapOutput {
+	return ToOutput(in).(Float32ArrayMapOutput)
 }
 
-func (in Float64ArrayMap) ToFloat64ArrayMapOutputWithContext(ctx context.Context) Float64ArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(Float64ArrayMapOutput)
+func (in Float32ArrayMap) ToFloat32ArrayMapOutputWithContext(ctx context.Context) Float32ArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(Float32ArrayMapOutput)
 }
 
-// Float64ArrayMapOutput is an Output that returns map[string][]float64 values.
-type Float64ArrayMapOutput struct{ *OutputState }
+// Float32ArrayMapOutput is an Output that returns map[string][]float32 values.
+type Float32ArrayMapOutput struct{ *OutputState }
 
-func (Float64ArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (Float32ArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]float64] {
-	return pulumix.Output[map[string][]float64]{
+func (o Float32ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]float32] {
+	return pulumix.Output[map[string][]float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][]float64).
-func (Float64ArrayMapOutput) ElementType() reflect.Type {
-	return float64ArrayMapType
+// ElementType returns the element type of this Output (map[string][]float32).
+func (Float32ArrayMapOutput) ElementType() reflect.Type {
+	return float32ArrayMapType
 }
 
-func (o Float64ArrayMapOutput) ToFloat64ArrayMapOutput() Float64ArrayMapOutput {
+func (o Float32ArrayMapOutput) ToFloat32ArrayMapOutput() Float32ArrayMapOutput {
 	return o
 }
 
-func (o Float64ArrayMapOutput) ToFloat64ArrayMapOutputWithContext(ctx context.Context) Float64ArrayMapOutput {
+func (o Float32ArrayMapOutput) ToFloat32ArrayMapOutputWithContext(ctx context.Context) Float32ArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o Float64ArrayMapOutput) MapIndex(k StringInput) Float64ArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) []float64 {
-		return vs[0].(map[string][]float64)[vs[1].(string)]
-	}).(Float64ArrayOutput)
+func (o Float32ArrayMapOutput) MapIndex(k StringInput) Float32ArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []float32 {
+		return vs[0].(map[string][]float32)[vs[1].(string)]
+	}).(Float32ArrayOutput)
 }
 
-func ToFloat64ArrayMap(in map[string][]float64) Float64ArrayMap {
-	m := make(Float64ArrayMap)
+func ToFloat32ArrayMap(in map[string][]float32) Float32ArrayMap {
+	m := make(Float32ArrayMap)
 	for k, v := range in {
-		m[k] = ToFloat64Array(v)
+		m[k] = ToFloat32Array(v)
 	}
 	return m
 }
 
-func ToFloat64ArrayMapOutput(in map[string]Float64ArrayOutput) Float64ArrayMapOutput {
-	m := make(Float64ArrayMap)
+func ToFloat32ArrayMapOutput(in map[string]Float32ArrayOutput) Float32ArrayMapOutput {
+	m := make(Float32ArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToFloat64ArrayMapOutput()
+	return m.ToFloat32ArrayMapOutput()
 }
 
-var float64MapArrayType = reflect.TypeOf((*[]map[string]float64)(nil)).Elem()
+var float32MapArrayType = reflect.TypeOf((*[]map[string]float32)(nil)).Elem()
 
-// Float64MapArrayInput is an input type that accepts Float64MapArray and Float64MapArrayOutput values.
-type Float64MapArrayInput interface {
+// Float32MapArrayInput is an input type that accepts Float32MapArray and Float32MapArrayOutput values.
+type Float32MapArrayInput interface {
 	Input
 
-	ToFloat64MapArrayOutput() Float64MapArrayOutput
-	ToFloat64MapArrayOutputWithContext(ctx context.Context) Float64MapArrayOutput
+	ToFloat32MapArrayOutput() Float32MapArrayOutput
+	ToFloat32MapArrayOutputWithContext(ctx context.Context) Float32MapArrayOutput
 }
 
-// Float64MapArray is an input type for []Float64MapInput values.
-type Float64MapArray []Float64MapInput
+// Float32MapArray is an input type for []Float32MapInput values.
+type Float32MapArray []Float32MapInput
 
-// ElementType returns the element type of this Input ([]map[string]float64).
-func (Float64MapArray) ElementType() reflect.Type {
-	return float64MapArrayType
+// ElementType returns the element type of this Input ([]map[string]float32).
+func (Float32MapArray) ElementType() reflect.Type {
+	return float32MapArrayType
 }
 
-func (in Float64MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]float64] {
-	return pulumix.Output[[]map[string]float64]{
+func (in Float32MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]float32] {
+	return pulumix.Output[[]map[string]float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64MapArray) ToFloat64MapArrayOutput() Float64MapArrayOutput {
-	return ToOutput(in).(Float64MapArrayOutput)
+func (in Float32MapArray) ToFloat32MapArrayOutput() Float32MapArrayOutput {
+	return ToOutput(in).(Float32MapArrayOutput)
 }
 
-func (in Float64MapArray) ToFloat64MapArrayOutputWithContext(ctx context.Context) Float64MapArrayOutput {
-	return ToOutputWithContext(ctx, in).(Float64MapArrayOutput)
+func (in Float32MapArray) ToFloat32MapArrayOutputWithContext(ctx context.Context) Float32MapArrayOutput {
+	return ToOutputWithContext(ctx, in).(Float32MapArrayOutput)
 }
 
-// Float64MapArrayOutput is an Output that returns []map[string]float64 values.
-type Float64MapArrayOutput struct{ *OutputState }
+// Float32MapArrayOutput is an Output that returns []map[string]float32 values.
+type Float32MapArrayOutput struct{ *OutputState }
 
-func (Float64MapArrayOutput) MarshalJSON() ([]byte, error) {
+func (Float32MapArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]float64] {
-	return pulumix.Output[[]map[string]float64]{
+func (o Float32MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]float32] {
+	return pulumix.Output[[]map[string]float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]map[string]float64).
-func (Float64MapArrayOutput) ElementType() reflect.Type {
-	return float64MapArrayType
+// ElementType returns the element type of this Output ([]map[string]float32).
+func (Float32MapArrayOutput) ElementType() reflect.Type {
+	return float32MapArrayType
 }
 
-func (o Float64MapArrayOutput) ToFloat64MapArrayOutput() Float64MapArrayOutput {
+func (o Float32MapArrayOutput) ToFloat32MapArrayOutput() Float32MapArrayOutput {
 	return o
 }
 
-func (o Float64MapArrayOutput) ToFloat64MapArrayOutputWithContext(ctx context.Context) Float64MapArrayOutput {
+func (o Float32MapArrayOutput) ToFloat32MapArrayOutputWithContext(ctx context.Context) Float32MapArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o Float64MapArrayOutput) Index(i IntInput) Float64MapOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) map[string]float64 {
-		arr := vs[0].([]map[string]float64)
+func (o Float32MapArrayOutput) Index(i IntInput) Float32MapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]float32 {
+		arr := vs[0].([]map[string]float32)
 		idx := vs[1].(int)
-		var ret map[string]float64
+		var ret map[string]float32
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(Float64MapOutput)
+	}).(Float32MapOutput)
 }
 
-func ToFloat64MapArray(in []map[string]float64) Float64MapArray {
-	a := make(Float64MapArray, len(in))
+func ToFloat32MapArray(in []map[string]float32) Float32MapArray {
+	a := make(Float32MapArray, len(in))
 	for i, v := range in {
-		a[i] = ToFloat64Map(v)
+		a[i] = ToFloat32Map(v)
 	}
 	return a
 }
 
-func ToFloat64MapArrayOutput(in []Float64MapOutput) Float64MapArrayOutput {
-	a := make(Float64MapArray, len(in))
+func ToFloat32MapArrayOutput(in []Float32MapOutput) Float32MapArrayOutput {
+	a := make(Float32MapArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToFloat64MapArrayOutput()
+	return a.ToFloat32MapArrayOutput()
 }
 
-var float64MapMapType = reflect.TypeOf((*map[string]map[string]float64)(nil)).Elem()
+var float32MapMapType = reflect.TypeOf((*map[string]map[string]float32)(nil)).Elem()
 
-// Float64MapMapInput is an input type that accepts Float64MapMap and Float64MapMapOutput values.
-type Float64MapMapInput interface {
+// Float32MapMapInput is an input type that accepts Float32MapMap and Float32MapMapOutput values.
+type Float32MapMapInput interface {
 	Input
 
-	ToFloat64MapMapOutput() Float64MapMapOutput
-	ToFloat64MapMapOutputWithContext(ctx context.Context) Float64MapMapOutput
+	ToFloat32MapMapOutput() Float32MapMapOutput
+	ToFloat32MapMapOutputWithContext(ctx context.Context) Float32MapMapOutput
 }
 
-// Float64MapMap is an input type for map[string]Float64MapInput values.
-type Float64MapMap map[string]Float64MapInput
+// Float32MapMap is an input type for map[string]Float32MapInput values.
+type Float32MapMap map[string]Float32MapInput
 
-// ElementType returns the element type of this Input (map[string]map[string]float64).
-func (Float64MapMap) ElementType() reflect.Type {
-	return float64MapMapType
+// ElementType returns the element type of this Input (map[string]map[string]float32).
+func (Float32MapMap) ElementType() reflect.Type {
+	return float32MapMapType
 }
 
-func (in Float64MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]float64] {
-	return pulumix.Output[map[string]map[string]float64]{
+func (in Float32MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]float32] {
+	return pulumix.Output[map[string]map[string]float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64MapMap) ToFloat64MapMapOutput() Float64MapMapOutput {
-	return ToOutput(in).(Float64MapMapOutput)
+func (in Float32MapMap) ToFloat32MapMapOutput() Float32MapMapOutput {
+	return ToOutput(in).(Float32MapMapOutput)
 }
 
-func (in Float64MapMap) ToFloat64MapMapOutputWithContext(ctx context.Context) Float64MapMapOutput {
-	return ToOutputWithContext(ctx, in).(Float64MapMapOutput)
+func (in Float32MapMap) ToFloat32MapMapOutputWithContext(ctx context.Context) Float32MapMapOutput {
+	return ToOutputWithContext(ctx, in).(Float32MapMapOutput)
 }
 
-// Float64MapMapOutput is an Output that returns map[string]map[string]float64 values.
-type Float64MapMapOutput struct{ *OutputState }
+// Float32MapMapOutput is an Output that returns map[string]map[string]float32 values.
+type Float32MapMapOutput struct{ *OutputState }
 
-func (Float64MapMapOutput) MarshalJSON() ([]byte, error) {
+func (Float32MapMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]float64] {
-	return pulumix.Output[map[string]map[string]float64]{
+func (o Float32MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]float32] {
+	return pulumix.Output[map[string]map[string]float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]map[string]float64).
-func (Float64MapMapOutput) ElementType() reflect.Type {
-	return float64MapMapType
+// ElementType returns the element type of this Output (map[string]map[string]float32).
+func (Float32MapMapOutput) ElementType() reflect.Type {
+	return float32MapMapType
 }
 
-func (o Float64MapMapOutput) ToFloat64MapMapOutput() Float64MapMapOutput {
+func (o Float32MapMapOutput) ToFloat32MapMapOutput() Float32MapMapOutput {
 	return o
 }
 
-func (o Float64MapMapOutput) ToFloat64MapMapOutputWithContext(ctx context.Context) Float64MapMapOutput {
+func (o Float32MapMapOutput) ToFloat32MapMapOutputWithContext(ctx context.Context) Float32MapMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o Float64MapMapOutput) MapIndex(k StringInput) Float64MapOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) map[string]float64 {
-		return vs[0].(map[string]map[string]float64)[vs[1].(string)]
-	}).(Float64MapOutput)
+func (o Float32MapMapOutput) MapIndex(k StringInput) Float32MapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]float32 {
+		return vs[0].(map[string]map[string]float32)[vs[1].(string)]
+	}).(Float32MapOutput)
 }
 
-func ToFloat64MapMap(in map[string]map[string]float64) Float64MapMap {
-	m := make(Float64MapMap)
+func ToFloat32MapMap(in map[string]map[string]float32) Float32MapMap {
+	m := make(Float32MapMap)
 	for k, v := range in {
-		m[k] = ToFloat64Map(v)
+		m[k] = ToFloat32Map(v)
 	}
 	return m
 }
 
-func ToFloat64MapMapOutput(in map[string]Float64MapOutput) Float64MapMapOutput {
-	m := make(Float64MapMap)
+func ToFloat32MapMapOutput(in map[string]Float32MapOutput) Float32MapMapOutput {
+	m := make(Float32MapMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToFloat64MapMapOutput()
+	return m.ToFloat32MapMapOutput()
 }
 
-var float64ArrayArrayType = reflect.TypeOf((*[][]float64)(nil)).Elem()
+var float32ArrayArrayType = reflect.TypeOf((*[][]float32)(nil)).Elem()
 
-// Float64ArrayArrayInput is an input type that accepts Float64ArrayArray and Float64ArrayArrayOutput values.
-type Float64ArrayArrayInput interface {
+// Float32ArrayArrayInput is an input type that accepts Float32ArrayArray and Float32ArrayArrayOutput values.
+type Float32ArrayArrayInput interface {
 	Input
 
-	ToFloat64ArrayArrayOutput() Float64ArrayArrayOutput
-	ToFloat64ArrayArrayOutputWithContext(ctx context.Context) Float64ArrayArrayOutput
+	ToFloat32ArrayArrayOutput() Float32ArrayArrayOutput
+	ToFloat32ArrayArrayOutputWithContext(ctx context.Context) Float32ArrayArrayOutput
 }
 
-// Float64ArrayArray is an input type for []Float64ArrayInput values.
-type Float64ArrayArray []Float64ArrayInput
+// Float32ArrayArray is an input type for []Float32ArrayInput values.
+type Float32ArrayArray []Float32ArrayInput
 
-// ElementType returns the element type of this Input ([][]float64).
-func (Float64ArrayArray) ElementType() reflect.Type {
-	return float64ArrayArrayType
+// ElementType returns the element type of this Input ([][]float32).
+func (Float32ArrayArray) ElementType() reflect.Type {
+	return float32ArrayArrayType
 }
 
-func (in Float64ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]float64] {
-	return pulumix.Output[[][]float64]{
+func (in Float32ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]float32] {
+	return pulumix.Output[[][]float32]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Float64ArrayArray) ToFloat64ArrayArrayOutput() Float64ArrayArrayOutput {
-	return ToOutput(in).(Float64ArrayArrayOutput)
+func (in Float32ArrayArray) ToFloat32ArrayArrayOutput() Float32ArrayArrayOutput {
+	return ToOutput(in).(Float32ArrayArrayOutput)
 }
 
-func (in Float64ArrayArray) ToFloat64ArrayArrayOutputWithContext(ctx context.Context) Float64ArrayArrayOutput {
-	return ToOutputWithContext(ctx, in).(Float64ArrayArrayOutput)
+func (in Float32ArrayArray) ToFloat32ArrayArrayOutputWithContext(ctx context.Context) Float32ArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(Float32ArrayArrayOutput)
 }
 
-// Float64ArrayArrayOutput is an Output that returns [][]float64 values.
-type Float64ArrayArrayOutput struct{ *OutputState }
+// Float32ArrayArrayOutput is an Output that returns [][]float32 values.
+type Float32ArrayArrayOutput struct{ *OutputState }
 
-func (Float64ArrayArrayOutput) MarshalJSON() ([]byte, error) {
+func (Float32ArrayArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o Float64ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]float64] {
-	return pulumix.Output[[][]float64]{
+func (o Float32ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]float32] {
+	return pulumix.Output[[][]float32]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([][]float64).
-func (Float64ArrayArrayOutput) ElementType() reflect.Type {
-	return float64ArrayArrayType
+// ElementType returns the element type of this Output ([][]float32).
+func (Float32ArrayArrayOutput) ElementType() reflect.Type {
+	return float32ArrayArrayType
 }
 
-func (o Float64ArrayArrayOutput) ToFloat64ArrayArrayOutput() Float64ArrayArrayOutput {
+func (o Float32ArrayArrayOutput) ToFloat32ArrayArrayOutput() Float32ArrayArrayOutput {
 	return o
 }
 
-func (o Float64ArrayArrayOutput) ToFloat64ArrayArrayOutputWithContext(ctx context.Context) Float64ArrayArrayOutput {
+func (o Float32ArrayArrayOutput) ToFloat32ArrayArrayOutputWithContext(ctx context.Context) Float32ArrayArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o Float64ArrayArrayOutput) Index(i IntInput) Float64ArrayOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) []float64 {
-		arr := vs[0].([][]float64)
+func (o Float32ArrayArrayOutput) Index(i IntInput) Float32ArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []float32 {
+		arr := vs[0].([][]float32)
 		idx := vs[1].(int)
-		var ret []float64
+		var ret []float32
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(Float64ArrayOutput)
+	}).(Float32ArrayOutput)
 }
 
-func ToFloat64ArrayArray(in [][]float64) Float64ArrayArray {
-	a := make(Float64ArrayArray, len(in))
+func ToFloat32ArrayArray(in [][]float32) Float32ArrayArray {
+	a := make(Float32ArrayArray, len(in))
 	for i, v := range in {
-		a[i] = ToFloat64Array(v)
+		a[i] = ToFloat32Array(v)
 	}
 	return a
 }
 
-func ToFloat64ArrayArrayOutput(in []Float64ArrayOutput) Float64ArrayArrayOutput {
-	a := make(Float64ArrayArray, len(in))
+func ToFloat32ArrayArrayOutput(in []Float32ArrayOutput) Float32ArrayArrayOutput {
+	a := make(Float32ArrayArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToFloat64ArrayArrayOutput()
+	return a.ToFloat32ArrayArrayOutput()
 }
 
-var idType = reflect.TypeOf((*ID)(nil)).Elem()
+var float64Type = reflect.TypeOf((*float64)(nil)).Elem()
 
-// IDInput is an input type that accepts ID and IDOutput values.
-type IDInput interface {
+// Float64Input is an input type that accepts Float64 and Float64Output values.
+type Float64Input interface {
 	Input
 
-	ToIDOutput() IDOutput
-	ToIDOutputWithContext(ctx context.Context) IDOutput
+	ToFloat64Output() Float64Output
+	ToFloat64OutputWithContext(ctx context.Context) Float64Output
 
-	ToIDPtrOutput() IDPtrOutput
-	ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput
+	ToFloat64PtrOutput() Float64PtrOutput
+	ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput
 }
 
-// ElementType returns the element type of this Input (ID).
-func (ID) ElementType() reflect.Type {
-	return idType
+// Float64 is an input type for float64 values.
+type Float64 float64
+
+// ElementType returns the element type of this Input (float64).
+func (Float64) ElementType() reflect.Type {
+	return float64Type
 }
 
-func (in ID) ToOutput(ctx context.Context) pulumix.Output[ID] {
-	return pulumix.Output[ID]{
+func (in Float64) ToOutput(ctx context.Context) pulumix.Output[float64] {
+	return pulumix.Output[float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in ID) ToIDOutput() IDOutput {
-	return ToOutput(in).(IDOutput)
-}
-
-func (in ID) ToIDOutputWithContext(ctx context.Context) IDOutput {
-	return ToOutputWithContext(ctx, in).(IDOutput)
-}
-
-func (in ID) ToStringOutput() StringOutput {
-	return in.ToStringOutputWithContext(context.Background())
+func (in Float64) ToFloat64Output() Float64Output {
+	return ToOutput(in).(Float64Output)
 }
 
-func (in ID) ToStringOutputWithContext(ctx context.Context) StringOutput {
-	return in.ToIDOutputWithContext(ctx).ToStringOutputWithContext(ctx)
+func (in Float64) ToFloat64OutputWithContext(ctx context.Context) Float64Output {
+	return ToOutputWithContext(ctx, in).(Float64Output)
 }
 
-func (in ID) ToIDPtrOutput() IDPtrOutput {
-	return in.ToIDPtrOutputWithContext(context.Background())
+func (in Float64) ToFloat64PtrOutput() Float64PtrOutput {
+	return in.ToFloat64PtrOutputWithContext(context.Background())
 }
 
-func (in ID) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
-	return in.ToIDOutputWithContext(ctx).ToIDPtrOutputWithContext(ctx)
+func (in Float64) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
+	return in.ToFloat64OutputWithContext(ctx).ToFloat64PtrOutputWithContext(ctx)
 }
 
-// IDOutput is an Output that returns ID values.
-type IDOutput struct{ *OutputState }
+// Float64Output is an Output that returns float64 values.
+type Float64Output struct{ *OutputState }
 
-func (IDOutput) MarshalJSON() ([]byte, error) {
+func (Float64Output) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDOutput) ToOutput(ctx context.Context) pulumix.Output[ID] {
-	return pulumix.Output[ID]{
+func (o Float64Output) ToOutput(ctx context.Context) pulumix.Output[float64] {
+	return pulumix.Output[float64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (ID).
-func (IDOutput) ElementType() reflect.Type {
-	return idType
+// ElementType returns the element type of this Output (float64).
+func (Float64Output) ElementType() reflect.Type {
+	return float64Type
 }
 
-func (o IDOutput) ToIDOutput() IDOutput {
+func (o Float64Output) ToFloat64Output() Float64Output {
 	return o
 }
 
-func (o IDOutput) ToIDOutputWithContext(ctx context.Context) IDOutput {
+func (o Float64Output) ToFloat64OutputWithContext(ctx context.Context) Float64Output {
 	return o
 }
 
-func (o IDOutput) ToStringOutput() StringOutput {
-	return o.ToStringOutputWithContext(context.Background())
-}
-
-func (o IDOutput) ToStringOutputWithContext(ctx context.Context) StringOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ID) string {
-		return (string)(v)
-	}).(StringOutput)
-}
-
-func (o IDOutput) ToIDPtrOutput() IDPtrOutput {
-	return o.ToIDPtrOutputWithContext(context.Background())
+func (o Float64Output) ToFloat64PtrOutput() Float64PtrOutput {
+	return o.ToFloat64PtrOutputWithContext(context.Background())
 }
 
-func (o IDOutput) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ID) *ID {
+func (o Float64Output) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v float64) *float64 {
 		return &v
-	}).(IDPtrOutput)
+	}).(Float64PtrOutput)
 }
 
-var iDPtrType = reflect.TypeOf((**ID)(nil)).Elem()
+var float64PtrType = reflect.TypeOf((**float64)(nil)).Elem()
 
-// IDPtrInput is an input type that accepts IDPtr and IDPtrOutput values.
-type IDPtrInput interface {
+// Float64PtrInput is an input type that accepts Float64Ptr and Float64PtrOutput values.
+type Float64PtrInput interface {
 	Input
 
-	ToIDPtrOutput() IDPtrOutput
-	ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput
+	ToFloat64PtrOutput() Float64PtrOutput
+	ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput
 }
 
-type idPtr ID
+type float64Ptr float64
 
-// IDPtr is an input type for *ID values.
-func IDPtr(v ID) IDPtrInput {
-	return (*idPtr)(&v)
+// Float64Ptr is an input type for *float64 values.
+func Float64Ptr(v float64) Float64PtrInput {
+	return (*float64Ptr)(&v)
 }
-func IDPtrFromPtr(v *ID) IDPtrInput {
+func Float64PtrFromPtr(v *float64) Float64PtrInput {
 	if v == nil {
 		return nil
 	}
-	return (*idPtr)(v)
+	return (*float64Ptr)(v)
 }
 
-// ElementType returns the element type of this Input (*ID).
-func (*idPtr) ElementType() reflect.Type {
-	return iDPtrType
+// ElementType returns the element type of this Input (*float64).
+func (*float64Ptr) ElementType() reflect.Type {
+	return float64PtrType
 }
 
-func (in *idPtr) ToOutput(ctx context.Context) pulumix.Output[*ID] {
-	return pulumix.Output[*ID]{
+func (in *float64Ptr) ToOutput(ctx context.Context) pulumix.Output[*float64] {
+	return pulumix.Output[*float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in *idPtr) ToIDPtrOutput() IDPtrOutput {
-	return ToOutput(in).(IDPtrOutput)
+func (in *float64Ptr) ToFloat64PtrOutput() Float64PtrOutput {
+	return ToOutput(in).(Float64PtrOutput)
 }
 
-func (in *idPtr) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
-	return ToOutputWithContext(ctx, in).(IDPtrOutput)
+func (in *float64Ptr) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
+	return ToOutputWithContext(ctx, in).(Float64PtrOutput)
 }
 
-// IDPtrOutput is an Output that returns *ID values.
-type IDPtrOutput struct{ *OutputState }
+// Float64PtrOutput is an Output that returns *float64 values.
+type Float64PtrOutput struct{ *OutputState }
 
-func (IDPtrOutput) MarshalJSON() ([]byte, error) {
+func (Float64PtrOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ID] {
-	return pulumix.Output[*ID]{
+func (o Float64PtrOutput) ToOutput(ctx context.Context) pulumix.Output[*float64] {
+	return pulumix.Output[*float64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (*ID).
-func (IDPtrOutput) ElementType() reflect.Type {
-	return iDPtrType
+// ElementType returns the element type of this Output (*float64).
+func (Float64PtrOutput) ElementType() reflect.Type {
+	return float64PtrType
 }
 
-func (o IDPtrOutput) ToIDPtrOutput() IDPtrOutput {
+func (o Float64PtrOutput) ToFloat64PtrOutput() Float64PtrOutput {
 	return o
 }
 
-func (o IDPtrOutput) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
+func (o Float64PtrOutput) ToFloat64PtrOutputWithContext(ctx context.Context) Float64PtrOutput {
 	return o
 }
 
 // Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
-func (o IDPtrOutput) Elem() IDOutput {
-	return o.ApplyT(func(v *ID) ID {
-		var ret ID
+func (o Float64PtrOutput) Elem() Float64Output {
+	return o.ApplyT(func(v *float64) float64 {
+		var ret float64
 		if v != nil {
 			ret = *v
 		}
 		return ret
-	}).(IDOutput)
+	}).(Float64Output)
 }
 
-var iDArrayType = reflect.TypeOf((*[]ID)(nil)).Elem()
+var float64ArrayType = reflect.TypeOf((*[]float64)(nil)).Elem()
 
-// IDArrayInput is an input type that accepts IDArray and IDArrayOutput values.
-type IDArrayInput interface {
+// Float64ArrayInput is an input type that accepts Float64Array and Float64ArrayOutput values.
+type Float64ArrayInput interface {
 	Input
 
-	ToIDArrayOutput() IDArrayOutput
-	ToIDArrayOutputWithContext(ctx context.Context) IDArrayOutput
+	ToFloat64ArrayOutput() Float64ArrayOutput
+	ToFloat64ArrayOutputWithContext(ctx context.Context) Float64ArrayOutput
 }
 
-// IDArray is an input type for []IDInput values.
-type IDArray []IDInput
+// Float64Array is an input type for []Float64Input values.
+type Float64Array []Float64Input
 
-// ElementType returns the element type of this Input ([]ID).
-func (IDArray) ElementType() reflect.Type {
-	return iDArrayType
+// ElementType returns the element type of this Input ([]float64).
+func (Float64Array) ElementType() reflect.Type {
+	return float64ArrayType
 }
 
-func (in IDArray) ToOutput(ctx context.Context) pulumix.Output[[]ID] {
-	return pulumix.Output[[]ID]{
+func (in Float64Array) ToOutput(ctx context.Context) pulumix.Output[[]float64] {
+	return pulumix.Output[[]float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IDArray) ToIDArrayOutput() IDArrayOutput {
-	return ToOutput(in).(IDArrayOutput)
+func (in Float64Array) ToFloat64ArrayOutput() Float64ArrayOutput {
+	return ToOutput(in).(Float64ArrayOutput)
 }
 
-func (in IDArray) ToIDArrayOutputWithContext(ctx context.Context) IDArrayOutput {
-	return ToOutputWithContext(ctx, in).(IDArrayOutput)
+func (in Float64Array) ToFloat64ArrayOutputWithContext(ctx context.Context) Float64ArrayOutput {
+	return ToOutputWithContext(ctx, in).(Float64ArrayOutput)
 }
 
-// IDArrayOutput is an Output that returns []ID values.
-type IDArrayOutput struct{ *OutputState }
+// Float64ArrayOutput is an Output that returns []float64 values.
+type Float64ArrayOutput struct{ *OutputState }
 
-func (IDArrayOutput) MarshalJSON() ([]byte, error) {
+func (Float64ArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]ID] {
-	return pulumix.Output[[]ID]{
+func (o Float64ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]float64] {
+	return pulumix.Output[[]float64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]ID).
-func (IDArrayOutput) ElementType() reflect.Type {
-	return iDArrayType
+// ElementType returns the element type of this Output ([]float64).
+func (Float64ArrayOutput) ElementType() reflect.Type {
+	return float64ArrayType
 }
 
-func (o IDArrayOutput) ToIDArrayOutput() IDArrayOutput {
+func (o Float64ArrayOutput) ToFloat64ArrayOutput() Float64ArrayOutput {
 	return o
 }
 
-func (o IDArrayOutput) ToIDArrayOutputWithContext(ctx context.Context) IDArrayOutput {
+func (o Float64ArrayOutput) ToFloat64ArrayOutputWithContext(ctx context.Context) Float64ArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o IDArrayOutput) Index(i IntInput) IDOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) ID {
-		arr := vs[0].([]ID)
+func (o Float64ArrayOutput) Index(i IntInput) Float64Output {
+	return All(o, i).ApplyT(func(vs []interface{}) float64 {
+		arr := vs[0].([]float64)
 		idx := vs[1].(int)
-		var ret ID
+		var ret float64
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(IDOutput)
+	}).(Float64Output)
 }
 
-func ToIDArray(in []ID) IDArray {
-	a := make(IDArray, len(in))
+func ToFloat64Array(in []float64) Float64Array {
+	a := make(Float64Array, len(in))
 	for i, v := range in {
-		a[i] = (v)
+		a[i] = Float64(v)
 	}
 	return a
 }
 
-func ToIDArrayOutput(in []IDOutput) IDArrayOutput {
-	a := make(IDArray, len(in))
+func ToFloat64ArrayOutput(in []Float64Output) Float64ArrayOutput {
+	a := make(Float64Array, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToIDArrayOutput()
+	return a.ToFloat64ArrayOutput()
 }
 
-var iDMapType = reflect.TypeOf((*map[string]ID)(nil)).Elem()
+var float64MapType = reflect.TypeOf((*map[string]float64)(nil)).Elem()
 
-// IDMapInput is an input type that accepts IDMap and IDMapOutput values.
-type IDMapInput interface {
+// Float64MapInput is an input type that accepts Float64Map and Float64MapOutput values.
+type Float64MapInput interface {
 	Input
 
-	ToIDMapOutput() IDMapOutput
-	ToIDMapOutputWithContext(ctx context.Context) IDMapOutput
+	ToFloat64MapOutput() Float64MapOutput
+	ToFloat64MapOutputWithContext(ctx context.Context) Float64MapOutput
 }
 
-// IDMap is an input type for map[string]IDInput values.
-type IDMap map[string]IDInput
+// Float64Map is an input type for map[string]Float64Input values.
+type Float64Map map[string]Float64Input
 
-// ElementType returns the element type of this Input (map[string]ID).
-func (IDMap) ElementType() reflect.Type {
-	return iDMapType
+// ElementType returns the element type of this Input (map[string]float64).
+func (Float64Map) ElementType() reflect.Type {
+	return float64MapType
 }
 
-func (in IDMap) ToOutput(ctx context.Context) pulumix.Output[map[string]ID] {
-	return pulumix.Output[map[string]ID]{
+func (in Float64Map) ToOutput(ctx context.Context) pulumix.Output[map[string]float64] {
+	return pulumix.Output[map[string]float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IDMap) ToIDMapOutput() IDMapOutput {
-	return ToOutput(in).(IDMapOutput)
+func (in Float64Map) ToFloat64MapOutput() Float64MapOutput {
+	return ToOutput(in).(Float64MapOutput)
 }
 
-func (in IDMap) ToIDMapOutputWithContext(ctx context.Context) IDMapOutput {
-	return ToOutputWithContext(ctx, in).(IDMapOutput)
+func (in Float64Map) ToFloat64MapOutputWithContext(ctx context.Context) Float64MapOutput {
+	return ToOutputWithContext(ctx, in).(Float64MapOutput)
 }
 
-// IDMapOutput is an Output that returns map[string]ID values.
-type IDMapOutput struct{ *OutputState }
+// Float64MapOutput is an Output that returns map[string]float64 values.
+type Float64MapOutput struct{ *OutputState }
 
-func (IDMapOutput) MarshalJSON() ([]byte, error) {
+func (Float64MapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]ID] {
-	return pulumix.Output[map[string]ID]{
+func (o Float64MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]float64] {
+	return pulumix.Output[map[string]float64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]ID).
-func (IDMapOutput) ElementType() reflect.Type {
-	return iDMapType
+// ElementType returns the element type of this Output (map[string]float64).
+func (Float64MapOutput) ElementType() reflect.Type {
+	return float64MapType
 }
 
-func (o IDMapOutput) ToIDMapOutput() IDMapOutput {
+func (o Float64MapOutput) ToFloat64MapOutput() Float64MapOutput {
 	return o
 }
 
-func (o IDMapOutput) ToIDMapOutputWithContext(ctx context.Context) IDMapOutput {
+func (o Float64MapOutput) ToFloat64MapOutputWithContext(ctx context.Context) Float64MapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o IDMapOutput) MapIndex(k StringInput) IDOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) ID {
-		return vs[0].(map[string]ID)[vs[1].(string)]
-	}).(IDOutput)
+func (o Float64MapOutput) MapIndex(k StringInput) Float64Output {
+	return All(o, k).ApplyT(func(vs []interface{}) float64 {
+		return vs[0].(map[string]float64)[vs[1].(string)]
+	}).(Float64Output)
 }
 
-func ToIDMap(in map[string]ID) IDMap {
-	m := make(IDMap)
+func ToFloat64Map(in map[string]float64) Float64Map {
+	m := make(Float64Map)
 	for k, v := range in {
-		m[k] = (v)
+		m[k] = Float64(v)
 	}
 	return m
 }
 
-func ToIDMapOutput(in map[string]IDOutput) IDMapOutput {
-	m := make(IDMap)
+func ToFloat64MapOutput(in map[string]Float64Output) Float64MapOutput {
+	m := make(Float64Map)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToIDMapOutput()
+	return m.ToFloat64MapOutput()
 }
 
-var iDArrayMapType = reflect.TypeOf((*map[string][]ID)(nil)).Elem()
+var float64ArrayMapType = reflect.TypeOf((*map[string][]float64)(nil)).Elem()
 
-// IDArrayMapInput is an input type that accepts IDArrayMap and IDArrayMapOutput values.
-type IDArrayMapInput interface {
+// Float64ArrayMapInput is an input type that accepts Float64ArrayMap and Float64ArrayMapOutput values.
+type Float64ArrayMapInput interface {
 	Input
 
-	ToIDArrayMapOutput() IDArrayMapOutput
-	ToIDArrayMapOutputWithContext(ctx context.Context) IDArrayMapOutput
+	ToFloat64ArrayMapOutput() Float64ArrayMapOutput
+	ToFloat64ArrayMapOutputWithContext(ctx context.Context) Float64ArrayMapOutput
 }
 
-// IDArrayMap is an input type for map[string]IDArrayInput values.
-type IDArrayMap map[string]IDArrayInput
+// Float64ArrayMap is an input type for map[string]Float64ArrayInput values.
+type Float64ArrayMap map[string]Float64ArrayInput
 
-// ElementType returns the element type of this Input (map[string][]ID).
-func (IDArrayMap) ElementType() reflect.Type {
-	return iDArrayMapType
+// ElementType returns the element type of this Input (map[string][]float64).
+func (Float64ArrayMap) ElementType() reflect.Type {
+	return float64ArrayMapType
 }
 
-func (in IDArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]ID] {
-	return pulumix.Output[map[string][]ID]{
+func (in Float64ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]float64] {
+	return pulumix.Output[map[string][]float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IDArrayMap) ToIDArrayMapOutput() IDArrayMapOutput {
-	return ToOutput(in).(IDArrayMapOutput)
+func (in Float64ArrayMap) ToFloat64ArrayMapOutput() Float64ArrayMapOutput {
+	return ToOutput(in).(Float64ArrayMapOutput)
 }
 
-func (in IDArrayMap) ToIDArrayMapOutputWithContext(ctx context.Context) IDArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(IDArrayMapOutput)
+func (in Float64ArrayMap) ToFloat64ArrayMapOutputWithContext(ctx context.Context) Float64ArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(Float64ArrayMapOutput)
 }
 
-// IDArrayMapOutput is an Output that returns map[string][]ID values.
-type IDArrayMapOutput struct{ *OutputState }
+// Float64ArrayMapOutput is an Output that returns map[string][]float64 values.
+type Float64ArrayMapOutput struct{ *OutputState }
 
-func (IDArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (Float64ArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]ID] {
-	return pulumix.Output[map[string][]ID]{
+func (o Float64ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]float64] {
+	return pulumix.Output[map[string][]float64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][]ID).
-func (IDArrayMapOutput) ElementType() reflect.Type {
-	return iDArrayMapType
+// ElementType returns the element type of this Output (map[string][]float64).
+func (Float64ArrayMapOutput) ElementType() reflect.Type {
+	return float64ArrayMapType
 }
 
-func (o IDArrayMapOutput) ToIDArrayMapOutput() IDArrayMapOutput {
+func (o Float64ArrayMapOutput) ToFloat64ArrayMapOutput() Float64ArrayMapOutput {
 	return o
 }
 
-func (o IDArrayMapOutput) ToIDArrayMapOutputWithContext(ctx context.Context) IDArrayMapOutput {
+func (o Float64ArrayMapOutput) ToFloat64ArrayMapOutputWithContext(ctx context.Context) Float64ArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o IDArrayMapOutput) MapIndex(k StringInput) IDArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) []ID {
-		return vs[0].(map[string][]ID)[vs[1].(string)]
-	}).(IDArrayOutput)
+func (o Float64ArrayMapOutput) MapIndex(k StringInput) Float64ArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []float64 {
+		return vs[0].(map[string][]float64)[vs[1].(string)]
+	}).(Float64ArrayOutput)
 }
 
-func ToIDArrayMap(in map[string][]ID) IDArrayMap {
-	m := make(IDArrayMap)
+func ToFloat64ArrayMap(in map[string][]float64) Float64ArrayMap {
+	m := make(Float64ArrayMap)
 	for k, v := range in {
-		m[k] = ToIDArray(v)
+		m[k] = ToFloat64Array(v)
 	}
 	return m
 }
 
-func ToIDArrayMapOutput(in map[string]IDArrayOutput) IDArrayMapOutput {
-	m := make(IDArrayMap)
+func ToFloat64ArrayMapOutput(in map[string]Float64ArrayOutput) Float64ArrayMapOutput {
+	m := make(Float64ArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToIDArrayMapOutput()
+	return m.ToFloat64ArrayMapOutput()
 }
 
-var iDMapArrayType = reflect.TypeOf((*[]map[string]ID)(nil)).Elem()
+var float64MapArrayType = reflect.TypeOf((*[]map[string]float64)(nil)).Elem()
 
-// IDMapArrayInput is an input type that accepts IDMapArray and IDMapArrayOutput values.
-type IDMapArrayInput interface {
+// Float64MapArrayInput is an input type that accepts Float64MapArray and Float64MapArrayOutput values.
+type Float64MapArrayInput interface {
 	Input
 
-	ToIDMapArrayOutput() IDMapArrayOutput
-	ToIDMapArrayOutputWithContext(ctx context.Context) IDMapArrayOutput
+	ToFloat64MapArrayOutput() Float64MapArrayOutput
+	ToFloat64MapArrayOutputWithContext(ctx context.Context) Float64MapArrayOutput
 }
 
-// IDMapArray is an input type for []IDMapInput values.
-type IDMapArray []IDMapInput
+// Float64MapArray is an input type for []Float64MapInput values.
+type Float64MapArray []Float64MapInput
 
-// ElementType returns the element type of this Input ([]map[string]ID).
-func (IDMapArray) ElementType() reflect.Type {
-	return iDMapArrayType
+// ElementType returns the element type of this Input ([]map[string]float64).
+func (Float64MapArray) ElementType() reflect.Type {
+	return float64MapArrayType
 }
 
-func (in IDMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]ID] {
-	return pulumix.Output[[]map[string]ID]{
+func (in Float64MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]float64] {
+	return pulumix.Output[[]map[string]float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IDMapArray) ToIDMapArrayOutput() IDMapArrayOutput {
-	return ToOutput(in).(IDMapArrayOutput)
+func (in Float64MapArray) ToFloat64MapArrayOutput() Float64MapArrayOutput {
+	return ToOutput(in).(Float64MapArrayOutput)
 }
 
-func (in IDMapArray) ToIDMapArrayOutputWithContext(ctx context.Context) IDMapArrayOutput {
-	return ToOutputWithContext(ctx, in).(IDMapArrayOutput)
+func (in Float64MapArray) ToFloat64MapArrayOutputWithContext(ctx context.Context) Float64MapArrayOutput {
+	return ToOutputWithContext(ctx, in).(Float64MapArrayOutput)
 }
 
-// IDMapArrayOutput is an Output that returns []map[string]ID values.
-type IDMapArrayOutput struct{ *OutputState }
+// Float64MapArrayOutput is an Output that returns []map[string]float64 values.
+type Float64MapArrayOutput struct{ *OutputState }
 
-func (IDMapArrayOutput) MarshalJSON() ([]byte, error) {
+func (Float64MapArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]ID] {
-	return pulumix.Output[[]map[string]ID]{
+func (o Float64MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]float64] {
+	return pulumix.Output[[]map[string]float64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]map[string]ID).
-func (IDMapArrayOutput) ElementType() reflect.Type {
-	return iDMapArrayType
+// ElementType returns the element type of this Output ([]map[string]float64).
+func (Float64MapArrayOutput) ElementType() reflect.Type {
+	return float64MapArrayType
 }
 
-func (o IDMapArrayOutput) ToIDMapArrayOutput() IDMapArrayOutput {
+func (o Float64MapArrayOutput) ToFloat64MapArrayOutput() Float64MapArrayOutput {
 	return o
 }
 
-func (o IDMapArrayOutput) ToIDMapArrayOutputWithContext(ctx context.Context) IDMapArrayOutput {
+func (o Float64MapArrayOutput) ToFloat64MapArrayOutputWithContext(ctx context.Context) Float64MapArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o IDMapArrayOutput) Index(i IntInput) IDMapOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) map[string]ID {
-		arr := vs[0].([]map[string]ID)
+func (o Float64MapArrayOutput) Index(i IntInput) Float64MapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]float64 {
+		arr := vs[0].([]map[string]float64)
 		idx := vs[1].(int)
-		var ret map[string]ID
+		var ret map[string]float64
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(IDMapOutput)
+	}).(Float64MapOutput)
 }
 
-func ToIDMapArray(in []map[string]ID) IDMapArray {
-	a := make(IDMapArray, len(in))
+func ToFloat64MapArray(in []map[string]float64) Float64MapArray {
+	a := make(Float64MapArray, len(in))
 	for i, v := range in {
-		a[i] = ToIDMap(v)
+		a[i] = ToFloat64Map(v)
 	}
 	return a
 }
 
-func ToIDMapArrayOutput(in []IDMapOutput) IDMapArrayOutput {
-	a := make(IDMapArray, len(in))
+func ToFloat64MapArrayOutput(in []Float64MapOutput) Float64MapArrayOutput {
+	a := make(Float64MapArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToIDMapArrayOutput()
+	return a.ToFloat64MapArrayOutput()
 }
 
-var iDMapMapType = reflect.TypeOf((*map[string]map[string]ID)(nil)).Elem()
+var float64MapMapType = reflect.TypeOf((*map[string]map[string]float64)(nil)).Elem()
 
-// IDMapMapInput is an input type that accepts IDMapMap and IDMapMapOutput values.
-type IDMapMapInput interface {
+// Float64MapMapInput is an input type that accepts Float64MapMap and Float64MapMapOutput values.
+type Float64MapMapInput interface {
 	Input
 
-	ToIDMapMapOutput() IDMapMapOutput
-	ToIDMapMapOutputWithContext(ctx context.Context) IDMapMapOutput
+	ToFloat64MapMapOutput() Float64MapMapOutput
+	ToFloat64MapMapOutputWithContext(ctx context.Context) Float64MapMapOutput
 }
 
-// IDMapMap is an input type for map[string]IDMapInput values.
-type IDMapMap map[string]IDMapInput
+// Float64MapMap is an input type for map[string]Float64MapInput values.
+type Float64MapMap map[string]Float64MapInput
 
-// ElementType returns the element type of this Input (map[string]map[string]ID).
-func (IDMapMap) ElementType() reflect.Type {
-	return iDMapMapType
+// ElementType returns the element type of this Input (map[string]map[string]float64).
+func (Float64MapMap) ElementType() reflect.Type {
+	return float64MapMapType
 }
 
-func (in IDMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]ID] {
-	return pulumix.Output[map[string]map[string]ID]{
+func (in Float64MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]float64] {
+	return pulumix.Output[map[string]map[string]float64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IDMapMap) ToIDMapMapOutput() IDMapMapOutput {
-	return ToOutput(in).(IDMapMapOutput)
-}
+func (in Float64MapMap) ToFloat64MapMapOutput() Float64MapMapOutput {
+	return ToOutput(in).(Float64MapMapOutput)
+}
+
+func (in Float64MapMap) ToFloat64MapMapOutputWithContext(ctx context.Context) Float64MapMapOutput {
+	return ToOutputWithContext(ctx, in).(Float64MapMapOutput)
+}
+
+// Float64MapMapOutput is an Output that returns map[string]map[string]float64 values.
+type Float64MapMapOutput struct{ *OutputState }
+
+func (Float64MapMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o Float64MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]float64] {
+	return pulumix.Output[map[string]map[string]float64]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]map[string]float64).
+func (Float64MapMapOutput) ElementType() reflect.Type {
+	return float64MapMapType
+}
+
+func (o Float64MapMapOutput) ToFloat64MapMapOutput() Float64MapMapOutput {
+	return o
+}
+
+func (o Float64MapMapOutput) ToFloat64MapMapOutputWithContext(ctx context.Context) Float64MapMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o Float64MapMapOutput) MapIndex(k StringInput) Float64MapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]float64 {
+		return vs[0].(map[string]map[string]float64)[vs[1].(string)]
+	}).(Float64MapOutput)
+}
+
+func ToFloat64MapMap(in map[string]map[string]float64) Float64MapMap {
+	m := make(Float64MapMap)
+	for k, v := range in {
+		m[k] = ToFloat64Map(v)
+	}
+	return m
+}
+
+func ToFloat64MapMapOutput(in map[string]Float64MapOutput) Float64MapMapOutput {
+	m := make(Float64MapMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToFloat64MapMapOutput()
+}
+
+var float64ArrayArrayType = reflect.TypeOf((*[][]float64)(nil)).Elem()
+
+// Float64ArrayArrayInput is an input type that accepts Float64ArrayArray and Float64ArrayArrayOutput values.
+type Float64ArrayArrayInput interface {
+	Input
+
+	ToFloat64ArrayArrayOutput() Float64ArrayArrayOutput
+	ToFloat64ArrayArrayOutputWithContext(ctx context.Context) Float64ArrayArrayOutput
+}
+
+// Float64ArrayArray is an input type for []Float64ArrayInput values.
+type Float64ArrayArray []Float64ArrayInput
+
+// ElementType returns the element type of this Input ([][]float64).
+func (Float64ArrayArray) ElementType() reflect.Type {
+	return float64ArrayArrayType
+}
+
+func (in Float64ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]float64] {
+	return pulumix.Output[[][]float64]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in Float64ArrayArray) ToFloat64ArrayArrayOutput() Float64ArrayArrayOutput {
+	return ToOutput(in).(Float64ArrayArrayOutput)
+}
+
+func (in Float64ArrayArray) ToFloat64ArrayArrayOutputWithContext(ctx context.Context) Float64ArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(Float64ArrayArrayOutput)
+}
+
+// Float64ArrayArrayOutput is an Output that returns [][]float64 values.
+type Float64ArrayArrayOutput struct{ *OutputState }
+
+func (Float64ArrayArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o Float64ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]float64] {
+	return pulumix.Output[[][]float64]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([][]float64).
+func (Float64ArrayArrayOutput) ElementType() reflect.Type {
+	return float64ArrayArrayType
+}
+
+func (o Float64ArrayArrayOutput) ToFloat64ArrayArrayOutput() Float64ArrayArrayOutput {
+	return o
+}
+
+func (o Float64ArrayArrayOutput) ToFloat64ArrayArrayOutputWithContext(ctx context.Context) Float64ArrayArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o Float64ArrayArrayOutput) Index(i IntInput) Float64ArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []float64 {
+		arr := vs[0].([][]float64)
+		idx := vs[1].(int)
+		var ret []float64
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(Float64ArrayOutput)
+}
+
+func ToFloat64ArrayArray(in [][]float64) Float64ArrayArray {
+	a := make(Float64ArrayArray, len(in))
+	for i, v := range in {
+		a[i] = ToFloat64Array(v)
+	}
+	return a
+}
+
+func ToFloat64ArrayArrayOutput(in []Float64ArrayOutput) Float64ArrayArrayOutput {
+	a := make(Float64ArrayArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToFloat64ArrayArrayOutput()
+}
+
+var idType = reflect.TypeOf((*ID)(nil)).Elem()
+
+// IDInput is an input type that accepts ID and IDOutput values.
+type IDInput interface {
+	Input
+
+	ToIDOutput() IDOutput
+	ToIDOutputWithContext(ctx context.Context) IDOutput
+
+	ToIDPtrOutput() IDPtrOutput
+	ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput
+}
+
+// ElementType returns the element type of this Input (ID).
+func (ID) ElementType() reflect.Type {
+	return idType
+}
+
+func (in ID) ToOutput(ctx context.Context) pulumix.Output[ID] {
+	return pulumix.Output[ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in ID) ToIDOutput() IDOutput {
+	return ToOutput(in).(IDOutput)
+}
+
+func (in ID) ToIDOutputWithContext(ctx context.Context) IDOutput {
+	return ToOutputWithContext(ctx, in).(IDOutput)
+}
+
+func (in ID) ToStringOutput() StringOutput {
+	return in.ToStringOutputWithContext(context.Background())
+}
+
+func (in ID) ToStringOutputWithContext(ctx context.Context) StringOutput {
+	return in.ToIDOutputWithContext(ctx).ToStringOutputWithContext(ctx)
+}
+
+func (in ID) ToIDPtrOutput() IDPtrOutput {
+	return in.ToIDPtrOutputWithContext(context.Background())
+}
+
+func (in ID) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
+	return in.ToIDOutputWithContext(ctx).ToIDPtrOutputWithContext(ctx)
+}
+
+// IDOutput is an Output that returns ID values.
+type IDOutput struct{ *OutputState }
+
+func (IDOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDOutput) ToOutput(ctx context.Context) pulumix.Output[ID] {
+	return pulumix.Output[ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (ID).
+func (IDOutput) ElementType() reflect.Type {
+	return idType
+}
+
+func (o IDOutput) ToIDOutput() IDOutput {
+	return o
+}
+
+func (o IDOutput) ToIDOutputWithContext(ctx context.Context) IDOutput {
+	return o
+}
+
+func (o IDOutput) ToStringOutput() StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o IDOutput) ToStringOutputWithContext(ctx context.Context) StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ID) string {
+		return (string)(v)
+	}).(StringOutput)
+}
+
+func (o IDOutput) ToIDPtrOutput() IDPtrOutput {
+	return o.ToIDPtrOutputWithContext(context.Background())
+}
+
+func (o IDOutput) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ID) *ID {
+		return &v
+	}).(IDPtrOutput)
+}
+
+var iDPtrType = reflect.TypeOf((**ID)(nil)).Elem()
+
+// IDPtrInput is an input type that accepts IDPtr and IDPtrOutput values.
+type IDPtrInput interface {
+	Input
+
+	ToIDPtrOutput() IDPtrOutput
+	ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput
+}
+
+type idPtr ID
+
+// IDPtr is an input type for *ID values.
+func IDPtr(v ID) IDPtrInput {
+	return (*idPtr)(&v)
+}
+func IDPtrFromPtr(v *ID) IDPtrInput {
+	if v == nil {
+		return nil
+	}
+	return (*idPtr)(v)
+}
+
+// ElementType returns the element type of this Input (*ID).
+func (*idPtr) ElementType() reflect.Type {
+	return iDPtrType
+}
+
+func (in *idPtr) ToOutput(ctx context.Context) pulumix.Output[*ID] {
+	return pulumix.Output[*ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in *idPtr) ToIDPtrOutput() IDPtrOutput {
+	return ToOutput(in).(IDPtrOutput)
+}
+
+func (in *idPtr) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
+	return ToOutputWithContext(ctx, in).(IDPtrOutput)
+}
+
+// IDPtrOutput is an Output that returns *ID values.
+type IDPtrOutput struct{ *OutputState }
+
+func (IDPtrOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ID] {
+	return pulumix.Output[*ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (*ID).
+func (IDPtrOutput) ElementType() reflect.Type {
+	return iDPtrType
+}
+
+func (o IDPtrOutput) ToIDPtrOutput() IDPtrOutput {
+	return o
+}
+
+func (o IDPtrOutput) ToIDPtrOutputWithContext(ctx context.Context) IDPtrOutput {
+	return o
+}
+
+// Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
+func (o IDPtrOutput) Elem() IDOutput {
+	return o.ApplyT(func(v *ID) ID {
+		var ret ID
+		if v != nil {
+			ret = *v
+		}
+		return ret
+	}).(IDOutput)
+}
+
+var iDArrayType = reflect.TypeOf((*[]ID)(nil)).Elem()
+
+// IDArrayInput is an input type that accepts IDArray and IDArrayOutput values.
+type IDArrayInput interface {
+	Input
+
+	ToIDArrayOutput() IDArrayOutput
+	ToIDArrayOutputWithContext(ctx context.Context) IDArrayOutput
+}
+
+// IDArray is an input type for []IDInput values.
+type IDArray []IDInput
+
+// ElementType returns the element type of this Input ([]ID).
+func (IDArray) ElementType() reflect.Type {
+	return iDArrayType
+}
+
+func (in IDArray) ToOutput(ctx context.Context) pulumix.Output[[]ID] {
+	return pulumix.Output[[]ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IDArray) ToIDArrayOutput() IDArrayOutput {
+	return ToOutput(in).(IDArrayOutput)
+}
+
+func (in IDArray) ToIDArrayOutputWithContext(ctx context.Context) IDArrayOutput {
+	return ToOutputWithContext(ctx, in).(IDArrayOutput)
+}
+
+// IDArrayOutput is an Output that returns []ID values.
+type IDArrayOutput struct{ *OutputState }
+
+func (IDArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]ID] {
+	return pulumix.Output[[]ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([]ID).
+func (IDArrayOutput) ElementType() reflect.Type {
+	return iDArrayType
+}
+
+func (o IDArrayOutput) ToIDArrayOutput() IDArrayOutput {
+	return o
+}
+
+func (o IDArrayOutput) ToIDArrayOutputWithContext(ctx context.Context) IDArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o IDArrayOutput) Index(i IntInput) IDOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) ID {
+		arr := vs[0].([]ID)
+		idx := vs[1].(int)
+		var ret ID
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(IDOutput)
+}
+
+func ToIDArray(in []ID) IDArray {
+	a := make(IDArray, len(in))
+	for i, v := range in {
+		a[i] = (v)
+	}
+	return a
+}
+
+func ToIDArrayOutput(in []IDOutput) IDArrayOutput {
+	a := make(IDArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToIDArrayOutput()
+}
+
+var iDMapType = reflect.TypeOf((*map[string]ID)(nil)).Elem()
+
+// IDMapInput is an input type that accepts IDMap and IDMapOutput values.
+type IDMapInput interface {
+	Input
+
+	ToIDMapOutput() IDMapOutput
+	ToIDMapOutputWithContext(ctx context.Context) IDMapOutput
+}
+
+// IDMap is an input type for map[string]IDInput values.
+type IDMap map[string]IDInput
+
+// ElementType returns the element type of this Input (map[string]ID).
+func (IDMap) ElementType() reflect.Type {
+	return iDMapType
+}
+
+func (in IDMap) ToOutput(ctx context.Context) pulumix.Output[map[string]ID] {
+	return pulumix.Output[map[string]ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IDMap) ToIDMapOutput() IDMapOutput {
+	return ToOutput(in).(IDMapOutput)
+}
+
+func (in IDMap) ToIDMapOutputWithContext(ctx context.Context) IDMapOutput {
+	return ToOutputWithContext(ctx, in).(IDMapOutput)
+}
+
+// IDMapOutput is an Output that returns map[string]ID values.
+type IDMapOutput struct{ *OutputState }
+
+func (IDMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]ID] {
+	return pulumix.Output[map[string]ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]ID).
+func (IDMapOutput) ElementType() reflect.Type {
+	return iDMapType
+}
+
+func (o IDMapOutput) ToIDMapOutput() IDMapOutput {
+	return o
+}
+
+func (o IDMapOutput) ToIDMapOutputWithContext(ctx context.Context) IDMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o IDMapOutput) MapIndex(k StringInput) IDOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) ID {
+		return vs[0].(map[string]ID)[vs[1].(string)]
+	}).(IDOutput)
+}
+
+func ToIDMap(in map[string]ID) IDMap {
+	m := make(IDMap)
+	for k, v := range in {
+		m[k] = (v)
+	}
+	return m
+}
+
+func ToIDMapOutput(in map[string]IDOutput) IDMapOutput {
+	m := make(IDMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToIDMapOutput()
+}
+
+var iDArrayMapType = reflect.TypeOf((*map[string][]ID)(nil)).Elem()
+
+// IDArrayMapInput is an input type that accepts IDArrayMap and IDArrayMapOutput values.
+type IDArrayMapInput interface {
+	Input
+
+	ToIDArrayMapOutput() IDArrayMapOutput
+	ToIDArrayMapOutputWithContext(ctx context.Context) IDArrayMapOutput
+}
+
+// IDArrayMap is an input type for map[string]IDArrayInput values.
+type IDArrayMap map[string]IDArrayInput
+
+// ElementType returns the element type of this Input (map[string][]ID).
+func (IDArrayMap) ElementType() reflect.Type {
+	return iDArrayMapType
+}
+
+func (in IDArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]ID] {
+	return pulumix.Output[map[string][]ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IDArrayMap) ToIDArrayMapOutput() IDArrayMapOutput {
+	return ToOutput(in).(IDArrayMapOutput)
+}
+
+func (in IDArrayMap) ToIDArrayMapOutputWithContext(ctx context.Context) IDArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(IDArrayMapOutput)
+}
+
+// IDArrayMapOutput is an Output that returns map[string][]ID values.
+type IDArrayMapOutput struct{ *OutputState }
+
+func (IDArrayMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]ID] {
+	return pulumix.Output[map[string][]ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string][]ID).
+func (IDArrayMapOutput) ElementType() reflect.Type {
+	return iDArrayMapType
+}
+
+func (o IDArrayMapOutput) ToIDArrayMapOutput() IDArrayMapOutput {
+	return o
+}
+
+func (o IDArrayMapOutput) ToIDArrayMapOutputWithContext(ctx context.Context) IDArrayMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o IDArrayMapOutput) MapIndex(k StringInput) IDArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []ID {
+		return vs[0].(map[string][]ID)[vs[1].(string)]
+	}).(IDArrayOutput)
+}
+
+func ToIDArrayMap(in map[string][]ID) IDArrayMap {
+	m := make(IDArrayMap)
+	for k, v := range in {
+		m[k] = ToIDArray(v)
+	}
+	return m
+}
+
+func ToIDArrayMapOutput(in map[string]IDArrayOutput) IDArrayMapOutput {
+	m := make(IDArrayMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToIDArrayMapOutput()
+}
+
+var iDMapArrayType = reflect.TypeOf((*[]map[string]ID)(nil)).Elem()
+
+// IDMapArrayInput is an input type that accepts IDMapArray and IDMapArrayOutput values.
+type IDMapArrayInput interface {
+	Input
+
+	ToIDMapArrayOutput() IDMapArrayOutput
+	ToIDMapArrayOutputWithContext(ctx context.Context) IDMapArrayOutput
+}
+
+// IDMapArray is an input type for []IDMapInput values.
+type IDMapArray []IDMapInput
+
+// ElementType returns the element type of this Input ([]map[string]ID).
+func (IDMapArray) ElementType() reflect.Type {
+	return iDMapArrayType
+}
+
+func (in IDMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]ID] {
+	return pulumix.Output[[]map[string]ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IDMapArray) ToIDMapArrayOutput() IDMapArrayOutput {
+	return ToOutput(in).(IDMapArrayOutput)
+}
+
+func (in IDMapArray) ToIDMapArrayOutputWithContext(ctx context.Context) IDMapArrayOutput {
+	return ToOutputWithContext(ctx, in).(IDMapArrayOutput)
+}
+
+// IDMapArrayOutput is an Output that returns []map[string]ID values.
+type IDMapArrayOutput struct{ *OutputState }
+
+func (IDMapArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]ID] {
+	return pulumix.Output[[]map[string]ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([]map[string]ID).
+func (IDMapArrayOutput) ElementType() reflect.Type {
+	return iDMapArrayType
+}
+
+func (o IDMapArrayOutput) ToIDMapArrayOutput() IDMapArrayOutput {
+	return o
+}
+
+func (o IDMapArrayOutput) ToIDMapArrayOutputWithContext(ctx context.Context) IDMapArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o IDMapArrayOutput) Index(i IntInput) IDMapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]ID {
+		arr := vs[0].([]map[string]ID)
+		idx := vs[1].(int)
+		var ret map[string]ID
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(IDMapOutput)
+}
+
+func ToIDMapArray(in []map[string]ID) IDMapArray {
+	a := make(IDMapArray, len(in))
+	for i, v := range in {
+		a[i] = ToIDMap(v)
+	}
+	return a
+}
+
+func ToIDMapArrayOutput(in []IDMapOutput) IDMapArrayOutput {
+	a := make(IDMapArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToIDMapArrayOutput()
+}
+
+var iDMapMapType = reflect.TypeOf((*map[string]map[string]ID)(nil)).Elem()
+
+// IDMapMapInput is an input type that accepts IDMapMap and IDMapMapOutput values.
+type IDMapMapInput interface {
+	Input
+
+	ToIDMapMapOutput() IDMapMapOutput
+	ToIDMapMapOutputWithContext(ctx context.Context) IDMapMapOutput
+}
+
+// IDMapMap is an input type for map[string]IDMapInput values.
+type IDMapMap map[string]IDMapInput
+
+// ElementType returns the element type of this Input (map[string]map[string]ID).
+func (IDMapMap) ElementType() reflect.Type {
+	return iDMapMapType
+}
+
+func (in IDMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]ID] {
+	return pulumix.Output[map[string]map[string]ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IDMapMap) ToIDMapMapOutput() IDMapMapOutput {
+	return ToOutput(in).(IDMapMapOutput)
+}
+
+func (in IDMapMap) ToIDMapMapOutputWithContext(ctx context.Context) IDMapMapOutput {
+	return ToOutputWithContext(ctx, in).(IDMapMapOutput)
+}
+
+// IDMapMapOutput is an Output that returns map[string]map[string]ID values.
+type IDMapMapOutput struct{ *OutputState }
+
+func (IDMapMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]ID] {
+	return pulumix.Output[map[string]map[string]ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]map[string]ID).
+func (IDMapMapOutput) ElementType() reflect.Type {
+	return iDMapMapType
+}
+
+func (o IDMapMapOutput) ToIDMapMapOutput() IDMapMapOutput {
+	return o
+}
+
+func (o IDMapMapOutput) ToIDMapMapOutputWithContext(ctx context.Context) IDMapMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o IDMapMapOutput) MapIndex(k StringInput) IDMapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]ID {
+		return vs[0].(map[string]map[string]ID)[vs[1].(string)]
+	}).(IDMapOutput)
+}
+
+func ToIDMapMap(in map[string]map[string]ID) IDMapMap {
+	m := make(IDMapMap)
+	for k, v := range in {
+		m[k] = ToIDMap(v)
+	}
+	return m
+}
+
+func ToIDMapMapOutput(in map[string]IDMapOutput) IDMapMapOutput {
+	m := make(IDMapMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToIDMapMapOutput()
+}
+
+var iDArrayArrayType = reflect.TypeOf((*[][]ID)(nil)).Elem()
+
+// IDArrayArrayInput is an input type that accepts IDArrayArray and IDArrayArrayOutput values.
+type IDArrayArrayInput interface {
+	Input
+
+	ToIDArrayArrayOutput() IDArrayArrayOutput
+	ToIDArrayArrayOutputWithContext(ctx context.Context) IDArrayArrayOutput
+}
+
+// IDArrayArray is an input type for []IDArrayInput values.
+type IDArrayArray []IDArrayInput
+
+// ElementType returns the element type of this Input ([][]ID).
+func (IDArrayArray) ElementType() reflect.Type {
+	return iDArrayArrayType
+}
+
+func (in IDArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]ID] {
+	return pulumix.Output[[][]ID]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IDArrayArray) ToIDArrayArrayOutput() IDArrayArrayOutput {
+	return ToOutput(in).(IDArrayArrayOutput)
+}
+
+func (in IDArrayArray) ToIDArrayArrayOutputWithContext(ctx context.Context) IDArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(IDArrayArrayOutput)
+}
+
+// IDArrayArrayOutput is an Output that returns [][]ID values.
+type IDArrayArrayOutput struct{ *OutputState }
+
+func (IDArrayArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IDArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]ID] {
+	return pulumix.Output[[][]ID]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([][]ID).
+func (IDArrayArrayOutput) ElementType() reflect.Type {
+	return iDArrayArrayType
+}
+
+func (o IDArrayArrayOutput) ToIDArrayArrayOutput() IDArrayArrayOutput {
+	return o
+}
+
+func (o IDArrayArrayOutput) ToIDArrayArrayOutputWithContext(ctx context.Context) IDArrayArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o IDArrayArrayOutput) Index(i IntInput) IDArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []ID {
+		arr := vs[0].([][]ID)
+		idx := vs[1].(int)
+		var ret []ID
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(IDArrayOutput)
+}
+
+func ToIDArrayArray(in [][]ID) IDArrayArray {
+	a := make(IDArrayArray, len(in))
+	for i, v := range in {
+		a[i] = ToIDArray(v)
+	}
+	return a
+}
+
+func ToIDArrayArrayOutput(in []IDArrayOutput) IDArrayArrayOutput {
+	a := make(IDArrayArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToIDArrayArrayOutput()
+}
+
+var arrayType = reflect.TypeOf((*[]interface{})(nil)).Elem()
+
+// ArrayInput is an input type that accepts Array and ArrayOutput values.
+type ArrayInput interface {
+	Input
+
+	ToArrayOutput() ArrayOutput
+	ToArrayOutputWithContext(ctx context.Context) ArrayOutput
+}
+
+// Array is an input type for []Input values.
+type Array []Input
+
+// ElementType returns the element type of this Input ([]interface{}).
+func (Array) ElementType() reflect.Type {
+	return arrayType
+}
+
+func (in Array) ToOutput(ctx context.Context) pulumix.Output[[]interface{}] {
+	return pulumix.Output[[]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in Array) ToArrayOutput() ArrayOutput {
+	return ToOutput(in).(ArrayOutput)
+}
+
+func (in Array) ToArrayOutputWithContext(ctx context.Context) ArrayOutput {
+	return ToOutputWithContext(ctx, in).(ArrayOutput)
+}
+
+// ArrayOutput is an Output that returns []interface{} values.
+type ArrayOutput struct{ *OutputState }
+
+func (ArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]interface{}] {
+	return pulumix.Output[[]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([]interface{}).
+func (ArrayOutput) ElementType() reflect.Type {
+	return arrayType
+}
+
+func (o ArrayOutput) ToArrayOutput() ArrayOutput {
+	return o
+}
+
+func (o ArrayOutput) ToArrayOutputWithContext(ctx context.Context) ArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o ArrayOutput) Index(i IntInput) Output {
+	return All(o, i).ApplyT(func(vs []interface{}) interface{} {
+		arr := vs[0].([]interface{})
+		idx := vs[1].(int)
+		var ret interface{}
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(Output)
+}
+
+func ToArray(in []interface{}) Array {
+	a := make(Array, len(in))
+	for i, v := range in {
+		a[i] = ToOutput(v)
+	}
+	return a
+}
+
+func ToArrayOutput(in []Output) ArrayOutput {
+	a := make(Array, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToArrayOutput()
+}
+
+var mapType = reflect.TypeOf((*map[string]interface{})(nil)).Elem()
+
+// MapInput is an input type that accepts Map and MapOutput values.
+type MapInput interface {
+	Input
+
+	ToMapOutput() MapOutput
+	ToMapOutputWithContext(ctx context.Context) MapOutput
+}
+
+// Map is an input type for map[string]Input values.
+type Map map[string]Input
+
+// ElementType returns the element type of this Input (map[string]interface{}).
+func (Map) ElementType() reflect.Type {
+	return mapType
+}
+
+func (in Map) ToOutput(ctx context.Context) pulumix.Output[map[string]interface{}] {
+	return pulumix.Output[map[string]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in Map) ToMapOutput() MapOutput {
+	return ToOutput(in).(MapOutput)
+}
+
+func (in Map) ToMapOutputWithContext(ctx context.Context) MapOutput {
+	return ToOutputWithContext(ctx, in).(MapOutput)
+}
+
+// MapOutput is an Output that returns map[string]interface{} values.
+type MapOutput struct{ *OutputState }
+
+func (MapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]interface{}] {
+	return pulumix.Output[map[string]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]interface{}).
+func (MapOutput) ElementType() reflect.Type {
+	return mapType
+}
+
+func (o MapOutput) ToMapOutput() MapOutput {
+	return o
+}
+
+func (o MapOutput) ToMapOutputWithContext(ctx context.Context) MapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o MapOutput) MapIndex(k StringInput) Output {
+	return All(o, k).ApplyT(func(vs []interface{}) interface{} {
+		return vs[0].(map[string]interface{})[vs[1].(string)]
+	}).(Output)
+}
+
+func ToMap(in map[string]interface{}) Map {
+	m := make(Map)
+	for k, v := range in {
+		m[k] = ToOutput(v)
+	}
+	return m
+}
+
+func ToMapOutput(in map[string]Output) MapOutput {
+	m := make(Map)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToMapOutput()
+}
+
+var arrayMapType = reflect.TypeOf((*map[string][]interface{})(nil)).Elem()
+
+// ArrayMapInput is an input type that accepts ArrayMap and ArrayMapOutput values.
+type ArrayMapInput interface {
+	Input
+
+	ToArrayMapOutput() ArrayMapOutput
+	ToArrayMapOutputWithContext(ctx context.Context) ArrayMapOutput
+}
+
+// ArrayMap is an input type for map[string]ArrayInput values.
+type ArrayMap map[string]ArrayInput
+
+// ElementType returns the element type of this Input (map[string][]interface{}).
+func (ArrayMap) ElementType() reflect.Type {
+	return arrayMapType
+}
+
+func (in ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]interface{}] {
+	return pulumix.Output[map[string][]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in ArrayMap) ToArrayMapOutput() ArrayMapOutput {
+	return ToOutput(in).(ArrayMapOutput)
+}
+
+func (in ArrayMap) ToArrayMapOutputWithContext(ctx context.Context) ArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(ArrayMapOutput)
+}
+
+// ArrayMapOutput is an Output that returns map[string][]interface{} values.
+type ArrayMapOutput struct{ *OutputState }
+
+func (ArrayMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]interface{}] {
+	return pulumix.Output[map[string][]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string][]interface{}).
+func (ArrayMapOutput) ElementType() reflect.Type {
+	return arrayMapType
+}
+
+func (o ArrayMapOutput) ToArrayMapOutput() ArrayMapOutput {
+	return o
+}
+
+func (o ArrayMapOutput) ToArrayMapOutputWithContext(ctx context.Context) ArrayMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o ArrayMapOutput) MapIndex(k StringInput) ArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []interface{} {
+		return vs[0].(map[string][]interface{})[vs[1].(string)]
+	}).(ArrayOutput)
+}
+
+func ToArrayMap(in map[string][]interface{}) ArrayMap {
+	m := make(ArrayMap)
+	for k, v := range in {
+		m[k] = ToArray(v)
+	}
+	return m
+}
+
+func ToArrayMapOutput(in map[string]ArrayOutput) ArrayMapOutput {
+	m := make(ArrayMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToArrayMapOutput()
+}
+
+var mapArrayType = reflect.TypeOf((*[]map[string]interface{})(nil)).Elem()
+
+// MapArrayInput is an input type that accepts MapArray and MapArrayOutput values.
+type MapArrayInput interface {
+	Input
+
+	ToMapArrayOutput() MapArrayOutput
+	ToMapArrayOutputWithContext(ctx context.Context) MapArrayOutput
+}
+
+// MapArray is an input type for []MapInput values.
+type MapArray []MapInput
+
+// ElementType returns the element type of this Input ([]map[string]interface{}).
+func (MapArray) ElementType() reflect.Type {
+	return mapArrayType
+}
+
+func (in MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]interface{}] {
+	return pulumix.Output[[]map[string]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in MapArray) ToMapArrayOutput() MapArrayOutput {
+	return ToOutput(in).(MapArrayOutput)
+}
+
+func (in MapArray) ToMapArrayOutputWithContext(ctx context.Context) MapArrayOutput {
+	return ToOutputWithContext(ctx, in).(MapArrayOutput)
+}
+
+// MapArrayOutput is an Output that returns []map[string]interface{} values.
+type MapArrayOutput struct{ *OutputState }
+
+func (MapArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]interface{}] {
+	return pulumix.Output[[]map[string]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([]map[string]interface{}).
+func (MapArrayOutput) ElementType() reflect.Type {
+	return mapArrayType
+}
+
+func (o MapArrayOutput) ToMapArrayOutput() MapArrayOutput {
+	return o
+}
+
+func (o MapArrayOutput) ToMapArrayOutputWithContext(ctx context.Context) MapArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o MapArrayOutput) Index(i IntInput) MapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]interface{} {
+		arr := vs[0].([]map[string]interface{})
+		idx := vs[1].(int)
+		var ret map[string]interface{}
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(MapOutput)
+}
+
+func ToMapArray(in []map[string]interface{}) MapArray {
+	a := make(MapArray, len(in))
+	for i, v := range in {
+		a[i] = ToMap(v)
+	}
+	return a
+}
+
+func ToMapArrayOutput(in []MapOutput) MapArrayOutput {
+	a := make(MapArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToMapArrayOutput()
+}
+
+var mapMapType = reflect.TypeOf((*map[string]map[string]interface{})(nil)).Elem()
+
+// MapMapInput is an input type that accepts MapMap and MapMapOutput values.
+type MapMapInput interface {
+	Input
+
+	ToMapMapOutput() MapMapOutput
+	ToMapMapOutputWithContext(ctx context.Context) MapMapOutput
+}
+
+// MapMap is an input type for map[string]MapInput values.
+type MapMap map[string]MapInput
+
+// ElementType returns the element type of this Input (map[string]map[string]interface{}).
+func (MapMap) ElementType() reflect.Type {
+	return mapMapType
+}
+
+func (in MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]interface{}] {
+	return pulumix.Output[map[string]map[string]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in MapMap) ToMapMapOutput() MapMapOutput {
+	return ToOutput(in).(MapMapOutput)
+}
+
+func (in MapMap) ToMapMapOutputWithContext(ctx context.Context) MapMapOutput {
+	return ToOutputWithContext(ctx, in).(MapMapOutput)
+}
+
+// MapMapOutput is an Output that returns map[string]map[string]interface{} values.
+type MapMapOutput struct{ *OutputState }
+
+func (MapMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]interface{}] {
+	return pulumix.Output[map[string]map[string]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]map[string]interface{}).
+func (MapMapOutput) ElementType() reflect.Type {
+	return mapMapType
+}
+
+func (o MapMapOutput) ToMapMapOutput() MapMapOutput {
+	return o
+}
+
+func (o MapMapOutput) ToMapMapOutputWithContext(ctx context.Context) MapMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o MapMapOutput) MapIndex(k StringInput) MapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]interface{} {
+		return vs[0].(map[string]map[string]interface{})[vs[1].(string)]
+	}).(MapOutput)
+}
+
+func ToMapMap(in map[string]map[string]interface{}) MapMap {
+	m := make(MapMap)
+	for k, v := range in {
+		m[k] = ToMap(v)
+	}
+	return m
+}
+
+func ToMapMapOutput(in map[string]MapOutput) MapMapOutput {
+	m := make(MapMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToMapMapOutput()
+}
+
+var arrayArrayType = reflect.TypeOf((*[][]interface{})(nil)).Elem()
+
+// ArrayArrayInput is an input type that accepts ArrayArray and ArrayArrayOutput values.
+type ArrayArrayInput interface {
+	Input
+
+	ToArrayArrayOutput() ArrayArrayOutput
+	ToArrayArrayOutputWithContext(ctx context.Context) ArrayArrayOutput
+}
+
+// ArrayArray is an input type for []ArrayInput values.
+type ArrayArray []ArrayInput
+
+// ElementType returns the element type of this Input ([][]interface{}).
+func (ArrayArray) ElementType() reflect.Type {
+	return arrayArrayType
+}
+
+func (in ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]interface{}] {
+	return pulumix.Output[[][]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in ArrayArray) ToArrayArrayOutput() ArrayArrayOutput {
+	return ToOutput(in).(ArrayArrayOutput)
+}
+
+func (in ArrayArray) ToArrayArrayOutputWithContext(ctx context.Context) ArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(ArrayArrayOutput)
+}
+
+// ArrayArrayOutput is an Output that returns [][]interface{} values.
+type ArrayArrayOutput struct{ *OutputState }
+
+func (ArrayArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]interface{}] {
+	return pulumix.Output[[][]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([][]interface{}).
+func (ArrayArrayOutput) ElementType() reflect.Type {
+	return arrayArrayType
+}
+
+func (o ArrayArrayOutput) ToArrayArrayOutput() ArrayArrayOutput {
+	return o
+}
+
+func (o ArrayArrayOutput) ToArrayArrayOutputWithContext(ctx context.Context) ArrayArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o ArrayArrayOutput) Index(i IntInput) ArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []interface{} {
+		arr := vs[0].([][]interface{})
+		idx := vs[1].(int)
+		var ret []interface{}
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(ArrayOutput)
+}
+
+func ToArrayArray(in [][]interface{}) ArrayArray {
+	a := make(ArrayArray, len(in))
+	for i, v := range in {
+		a[i] = ToArray(v)
+	}
+	return a
+}
+
+func ToArrayArrayOutput(in []ArrayOutput) ArrayArrayOutput {
+	a := make(ArrayArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToArrayArrayOutput()
+}
+
+var arrayArrayMapType = reflect.TypeOf((*map[string][][]interface{})(nil)).Elem()
+
+// ArrayArrayMapInput is an input type that accepts ArrayArrayMap and ArrayArrayMapOutput values.
+type ArrayArrayMapInput interface {
+	Input
+
+	ToArrayArrayMapOutput() ArrayArrayMapOutput
+	ToArrayArrayMapOutputWithContext(ctx context.Context) ArrayArrayMapOutput
+}
+
+// ArrayArrayMap is an input type for map[string]ArrayArrayInput values.
+type ArrayArrayMap map[string]ArrayArrayInput
+
+// ElementType returns the element type of this Input (map[string][][]interface{}).
+func (ArrayArrayMap) ElementType() reflect.Type {
+	return arrayArrayMapType
+}
+
+func (in ArrayArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][][]interface{}] {
+	return pulumix.Output[map[string][][]interface{}]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in ArrayArrayMap) ToArrayArrayMapOutput() ArrayArrayMapOutput {
+	return ToOutput(in).(ArrayArrayMapOutput)
+}
+
+func (in ArrayArrayMap) ToArrayArrayMapOutputWithContext(ctx context.Context) ArrayArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(ArrayArrayMapOutput)
+}
+
+// ArrayArrayMapOutput is an Output that returns map[string][][]interface{} values.
+type ArrayArrayMapOutput struct{ *OutputState }
+
+func (ArrayArrayMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o ArrayArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][][]interface{}] {
+	return pulumix.Output[map[string][][]interface{}]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string][][]interface{}).
+func (ArrayArrayMapOutput) ElementType() reflect.Type {
+	return arrayArrayMapType
+}
+
+func (o ArrayArrayMapOutput) ToArrayArrayMapOutput() ArrayArrayMapOutput {
+	return o
+}
+
+func (o ArrayArrayMapOutput) ToArrayArrayMapOutputWithContext(ctx context.Context) ArrayArrayMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o ArrayArrayMapOutput) MapIndex(k StringInput) ArrayArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) [][]interface{} {
+		return vs[0].(map[string][][]interface{})[vs[1].(string)]
+	}).(ArrayArrayOutput)
+}
+
+func ToArrayArrayMap(in map[string][][]interface{}) ArrayArrayMap {
+	m := make(ArrayArrayMap)
+	for k, v := range in {
+		m[k] = ToArrayArray(v)
+	}
+	return m
+}
+
+func ToArrayArrayMapOutput(in map[string]ArrayArrayOutput) ArrayArrayMapOutput {
+	m := make(ArrayArrayMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToArrayArrayMapOutput()
+}
+
+var intType = reflect.TypeOf((*int)(nil)).Elem()
+
+// IntInput is an input type that accepts Int and IntOutput values.
+type IntInput interface {
+	Input
+
+	ToIntOutput() IntOutput
+	ToIntOutputWithContext(ctx context.Context) IntOutput
+
+	ToIntPtrOutput() IntPtrOutput
+	ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput
+}
+
+// Int is an input type for int values.
+type Int int
+
+// ElementType returns the element type of this Input (int).
+func (Int) ElementType() reflect.Type {
+	return intType
+}
+
+func (in Int) ToOutput(ctx context.Context) pulumix.Output[int] {
+	return pulumix.Output[int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in Int) ToIntOutput() IntOutput {
+	return ToOutput(in).(IntOutput)
+}
+
+func (in Int) ToIntOutputWithContext(ctx context.Context) IntOutput {
+	return ToOutputWithContext(ctx, in).(IntOutput)
+}
+
+func (in Int) ToIntPtrOutput() IntPtrOutput {
+	return in.ToIntPtrOutputWithContext(context.Background())
+}
+
+func (in Int) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
+	return in.ToIntOutputWithContext(ctx).ToIntPtrOutputWithContext(ctx)
+}
+
+// IntOutput is an Output that returns int values.
+type IntOutput struct{ *OutputState }
+
+func (IntOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IntOutput) ToOutput(ctx context.Context) pulumix.Output[int] {
+	return pulumix.Output[int]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (int).
+func (IntOutput) ElementType() reflect.Type {
+	return intType
+}
+
+func (o IntOutput) ToIntOutput() IntOutput {
+	return o
+}
+
+func (o IntOutput) ToIntOutputWithContext(ctx context.Context) IntOutput {
+	return o
+}
+
+func (o IntOutput) ToIntPtrOutput() IntPtrOutput {
+	return o.ToIntPtrOutputWithContext(context.Background())
+}
+
+func (o IntOutput) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v int) *int {
+		return &v
+	}).(IntPtrOutput)
+}
+
+var intPtrType = reflect.TypeOf((**int)(nil)).Elem()
+
+// IntPtrInput is an input type that accepts IntPtr and IntPtrOutput values.
+type IntPtrInput interface {
+	Input
+
+	ToIntPtrOutput() IntPtrOutput
+	ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput
+}
+
+type intPtr int
+
+// IntPtr is an input type for *int values.
+func IntPtr(v int) IntPtrInput {
+	return (*intPtr)(&v)
+}
+func IntPtrFromPtr(v *int) IntPtrInput {
+	if v == nil {
+		return nil
+	}
+	return (*intPtr)(v)
+}
+
+// ElementType returns the element type of this Input (*int).
+func (*intPtr) ElementType() reflect.Type {
+	return intPtrType
+}
+
+func (in *intPtr) ToOutput(ctx context.Context) pulumix.Output[*int] {
+	return pulumix.Output[*int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in *intPtr) ToIntPtrOutput() IntPtrOutput {
+	return ToOutput(in).(IntPtrOutput)
+}
+
+func (in *intPtr) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
+	return ToOutputWithContext(ctx, in).(IntPtrOutput)
+}
+
+// IntPtrOutput is an Output that returns *int values.
+type IntPtrOutput struct{ *OutputState }
+
+func (IntPtrOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IntPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*int] {
+	return pulumix.Output[*int]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (*int).
+func (IntPtrOutput) ElementType() reflect.Type {
+	return intPtrType
+}
+
+func (o IntPtrOutput) ToIntPtrOutput() IntPtrOutput {
+	return o
+}
+
+func (o IntPtrOutput) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
+	return o
+}
+
+// Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
+func (o IntPtrOutput) Elem() IntOutput {
+	return o.ApplyT(func(v *int) int {
+		var ret int
+		if v != nil {
+			ret = *v
+		}
+		return ret
+	}).(IntOutput)
+}
+
+var intArrayType = reflect.TypeOf((*[]int)(nil)).Elem()
+
+// IntArrayInput is an input type that accepts IntArray and IntArrayOutput values.
+type IntArrayInput interface {
+	Input
+
+	ToIntArrayOutput() IntArrayOutput
+	ToIntArrayOutputWithContext(ctx context.Context) IntArrayOutput
+}
+
+// IntArray is an input type for []IntInput values.
+type IntArray []IntInput
+
+// ElementType returns the element type of this Input ([]int).
+func (IntArray) ElementType() reflect.Type {
+	return intArrayType
+}
+
+func (in IntArray) ToOutput(ctx context.Context) pulumix.Output[[]int] {
+	return pulumix.Output[[]int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IntArray) ToIntArrayOutput() IntArrayOutput {
+	return ToOutput(in).(IntArrayOutput)
+}
+
+func (in IntArray) ToIntArrayOutputWithContext(ctx context.Context) IntArrayOutput {
+	return ToOutputWithContext(ctx, in).(IntArrayOutput)
+}
+
+// IntArrayOutput is an Output that returns []int values.
+type IntArrayOutput struct{ *OutputState }
+
+func (IntArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IntArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]int] {
+	return pulumix.Output[[]int]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([]int).
+func (IntArrayOutput) ElementType() reflect.Type {
+	return intArrayType
+}
+
+func (o IntArrayOutput) ToIntArrayOutput() IntArrayOutput {
+	return o
+}
+
+func (o IntArrayOutput) ToIntArrayOutputWithContext(ctx context.Context) IntArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o IntArrayOutput) Index(i IntInput) IntOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) int {
+		arr := vs[0].([]int)
+		idx := vs[1].(int)
+		var ret int
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(IntOutput)
+}
+
+func ToIntArray(in []int) IntArray {
+	a := make(IntArray, len(in))
+	for i, v := range in {
+		a[i] = Int(v)
+	}
+	return a
+}
+
+func ToIntArrayOutput(in []IntOutput) IntArrayOutput {
+	a := make(IntArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToIntArrayOutput()
+}
+
+var intMapType = reflect.TypeOf((*map[string]int)(nil)).Elem()
+
+// IntMapInput is an input type that accepts IntMap and IntMapOutput values.
+type IntMapInput interface {
+	Input
+
+	ToIntMapOutput() IntMapOutput
+	ToIntMapOutputWithContext(ctx context.Context) IntMapOutput
+}
+
+// IntMap is an input type for map[string]IntInput values.
+type IntMap map[string]IntInput
+
+// ElementType returns the element type of this Input (map[string]int).
+func (IntMap) ElementType() reflect.Type {
+	return intMapType
+}
+
+func (in IntMap) ToOutput(ctx context.Context) pulumix.Output[map[string]int] {
+	return pulumix.Output[map[string]int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IntMap) ToIntMapOutput() IntMapOutput {
+	return ToOutput(in).(IntMapOutput)
+}
+
+func (in IntMap) ToIntMapOutputWithContext(ctx context.Context) IntMapOutput {
+	return ToOutputWithContext(ctx, in).(IntMapOutput)
+}
+
+// IntMapOutput is an Output that returns map[string]int values.
+type IntMapOutput struct{ *OutputState }
+
+func (IntMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IntMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]int] {
+	return pulumix.Output[map[string]int]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]int).
+func (IntMapOutput) ElementType() reflect.Type {
+	return intMapType
+}
+
+func (o IntMapOutput) ToIntMapOutput() IntMapOutput {
+	return o
+}
+
+func (o IntMapOutput) ToIntMapOutputWithContext(ctx context.Context) IntMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o IntMapOutput) MapIndex(k StringInput) IntOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) int {
+		return vs[0].(map[string]int)[vs[1].(string)]
+	}).(IntOutput)
+}
+
+func ToIntMap(in map[string]int) IntMap {
+	m := make(IntMap)
+	for k, v := range in {
+		m[k] = Int(v)
+	}
+	return m
+}
 
-func (in IDMapMap) ToIDMapMapOutputWithContext(ctx context.Context) IDMapMapOutput {
-	return ToOutputWithContext(ctx, in).(IDMapMapOutput)
+func ToIntMapOutput(in map[string]IntOutput) IntMapOutput {
+	m := make(IntMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToIntMapOutput()
 }
 
-// IDMapMapOutput is an Output that returns map[string]map[string]ID values.
-type IDMapMapOutput struct{ *OutputState }
+var intArrayMapType = reflect.TypeOf((*map[string][]int)(nil)).Elem()
 
-func (IDMapMapOutput) MarshalJSON() ([]byte, error) {
+// IntArrayMapInput is an input type that accepts IntArrayMap and IntArrayMapOutput values.
+type IntArrayMapInput interface {
+	Input
+
+	ToIntArrayMapOutput() IntArrayMapOutput
+	ToIntArrayMapOutputWithContext(ctx context.Context) IntArrayMapOutput
+}
+
+// IntArrayMap is an input type for map[string]IntArrayInput values.
+type IntArrayMap map[string]IntArrayInput
+
+// ElementType returns the element type of this Input (map[string][]int).
+func (IntArrayMap) ElementType() reflect.Type {
+	return intArrayMapType
+}
+
+func (in IntArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]int] {
+	return pulumix.Output[map[string][]int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IntArrayMap) ToIntArrayMapOutput() IntArrayMapOutput {
+	return ToOutput(in).(IntArrayMapOutput)
+}
+
+func (in IntArrayMap) ToIntArrayMapOutputWithContext(ctx context.Context) IntArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(IntArrayMapOutput)
+}
+
+// IntArrayMapOutput is an Output that returns map[string][]int values.
+type IntArrayMapOutput struct{ *OutputState }
+
+func (IntArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]ID] {
-	return pulumix.Output[map[string]map[string]ID]{
+func (o IntArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]int] {
+	return pulumix.Output[map[string][]int]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]map[string]ID).
-func (IDMapMapOutput) ElementType() reflect.Type {
-	return iDMapMapType
+// ElementType returns the element type of this Output (map[string][]int).
+func (IntArrayMapOutput) ElementType() reflect.Type {
+	return intArrayMapType
 }
 
-func (o IDMapMapOutput) ToIDMapMapOutput() IDMapMapOutput {
+func (o IntArrayMapOutput) ToIntArrayMapOutput() IntArrayMapOutput {
 	return o
 }
 
-func (o IDMapMapOutput) ToIDMapMapOutputWithContext(ctx context.Context) IDMapMapOutput {
+func (o IntArrayMapOutput) ToIntArrayMapOutputWithContext(ctx context.Context) IntArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o IDMapMapOutput) MapIndex(k StringInput) IDMapOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) map[string]ID {
-		return vs[0].(map[string]map[string]ID)[vs[1].(string)]
-	}).(IDMapOutput)
+func (o IntArrayMapOutput) MapIndex(k StringInput) IntArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []int {
+		return vs[0].(map[string][]int)[vs[1].(string)]
+	}).(IntArrayOutput)
 }
 
-func ToIDMapMap(in map[string]map[string]ID) IDMapMap {
-	m := make(IDMapMap)
+func ToIntArrayMap(in map[string][]int) IntArrayMap {
+	m := make(IntArrayMap)
 	for k, v := range in {
-		m[k] = ToIDMap(v)
+		m[k] = ToIntArray(v)
 	}
 	return m
 }
 
-func ToIDMapMapOutput(in map[string]IDMapOutput) IDMapMapOutput {
-	m := make(IDMapMap)
+func ToIntArrayMapOutput(in map[string]IntArrayOutput) IntArrayMapOutput {
+	m := make(IntArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToIDMapMapOutput()
+	return m.ToIntArrayMapOutput()
 }
 
-var iDArrayArrayType = reflect.TypeOf((*[][]ID)(nil)).Elem()
+var intMapArrayType = reflect.TypeOf((*[]map[string]int)(nil)).Elem()
 
-// IDArrayArrayInput is an input type that accepts IDArrayArray and IDArrayArrayOutput values.
-type IDArrayArrayInput interface {
+// IntMapArrayInput is an input type that accepts IntMapArray and IntMapArrayOutput values.
+type IntMapArrayInput interface {
+	Input
+
+	ToIntMapArrayOutput() IntMapArrayOutput
+	ToIntMapArrayOutputWithContext(ctx context.Context) IntMapArrayOutput
+}
+
+// IntMapArray is an input type for []IntMapInput values.
+type IntMapArray []IntMapInput
+
+// ElementType returns the element type of this Input ([]map[string]int).
+func (IntMapArray) ElementType() reflect.Type {
+	return intMapArrayType
+}
+
+func (in IntMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]int] {
+	return pulumix.Output[[]map[string]int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IntMapArray) ToIntMapArrayOutput() IntMapArrayOutput {
+	return ToOutput(in).(IntMapArrayOutput)
+}
+
+func (in IntMapArray) ToIntMapArrayOutputWithContext(ctx context.Context) IntMapArrayOutput {
+	return ToOutputWithContext(ctx, in).(IntMapArrayOutput)
+}
+
+// IntMapArrayOutput is an Output that returns []map[string]int values.
+type IntMapArrayOutput struct{ *OutputState }
+
+func (IntMapArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IntMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]int] {
+	return pulumix.Output[[]map[string]int]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([]map[string]int).
+func (IntMapArrayOutput) ElementType() reflect.Type {
+	return intMapArrayType
+}
+
+func (o IntMapArrayOutput) ToIntMapArrayOutput() IntMapArrayOutput {
+	return o
+}
+
+func (o IntMapArrayOutput) ToIntMapArrayOutputWithContext(ctx context.Context) IntMapArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o IntMapArrayOutput) Index(i IntInput) IntMapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]int {
+		arr := vs[0].([]map[string]int)
+		idx := vs[1].(int)
+		var ret map[string]int
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(IntMapOutput)
+}
+
+func ToIntMapArray(in []map[string]int) IntMapArray {
+	a := make(IntMapArray, len(in))
+	for i, v := range in {
+		a[i] = ToIntMap(v)
+	}
+	return a
+}
+
+func ToIntMapArrayOutput(in []IntMapOutput) IntMapArrayOutput {
+	a := make(IntMapArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToIntMapArrayOutput()
+}
+
+var intMapMapType = reflect.TypeOf((*map[string]map[string]int)(nil)).Elem()
+
+// IntMapMapInput is an input type that accepts IntMapMap and IntMapMapOutput values.
+type IntMapMapInput interface {
+	Input
+
+	ToIntMapMapOutput() IntMapMapOutput
+	ToIntMapMapOutputWithContext(ctx context.Context) IntMapMapOutput
+}
+
+// IntMapMap is an input type for map[string]IntMapInput values.
+type IntMapMap map[string]IntMapInput
+
+// ElementType returns the element type of this Input (map[string]map[string]int).
+func (IntMapMap) ElementType() reflect.Type {
+	return intMapMapType
+}
+
+func (in IntMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]int] {
+	return pulumix.Output[map[string]map[string]int]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
+
+func (in IntMapMap) ToIntMapMapOutput() IntMapMapOutput {
+	return ToOutput(in).(IntMapMapOutput)
+}
+
+func (in IntMapMap) ToIntMapMapOutputWithContext(ctx context.Context) IntMapMapOutput {
+	return ToOutputWithContext(ctx, in).(IntMapMapOutput)
+}
+
+// IntMapMapOutput is an Output that returns map[string]map[string]int values.
+type IntMapMapOutput struct{ *OutputState }
+
+func (IntMapMapOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o IntMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]int] {
+	return pulumix.Output[map[string]map[string]int]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output (map[string]map[string]int).
+func (IntMapMapOutput) ElementType() reflect.Type {
+	return intMapMapType
+}
+
+func (o IntMapMapOutput) ToIntMapMapOutput() IntMapMapOutput {
+	return o
+}
+
+func (o IntMapMapOutput) ToIntMapMapOutputWithContext(ctx context.Context) IntMapMapOutput {
+	return o
+}
+
+// MapIndex looks up the key k in the map.
+func (o IntMapMapOutput) MapIndex(k StringInput) IntMapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]int {
+		return vs[0].(map[string]map[string]int)[vs[1].(string)]
+	}).(IntMapOutput)
+}
+
+func ToIntMapMap(in map[string]map[string]int) IntMapMap {
+	m := make(IntMapMap)
+	for k, v := range in {
+		m[k] = ToIntMap(v)
+	}
+	return m
+}
+
+func ToIntMapMapOutput(in map[string]IntMapOutput) IntMapMapOutput {
+	m := make(IntMapMap)
+	for k, v := range in {
+		m[k] = v
+	}
+	return m.ToIntMapMapOutput()
+}
+
+var intArrayArrayType = reflect.TypeOf((*[][]int)(nil)).Elem()
+
+// IntArrayArrayInput is an input type that accepts IntArrayArray and IntArrayArrayOutput values.
+type IntArrayArrayInput interface {
 	Input
 
-	ToIDArrayArrayOutput() IDArrayArrayOutput
-	ToIDArrayArrayOutputWithContext(ctx context.Context) IDArrayArrayOutput
+	ToIntArrayArrayOutput() IntArrayArrayOutput
+	ToIntArrayArrayOutputWithContext(ctx context.Context) IntArrayArrayOutput
 }
 
-// IDArrayArray is an input type for []IDArrayInput values.
-type IDArrayArray []IDArrayInput
+// IntArrayArray is an input type for []IntArrayInput values.
+type IntArrayArray []IntArrayInput
 
-// ElementType returns the element type of this Input ([][]ID).
-func (IDArrayArray) ElementType() reflect.Type {
-	return iDArrayArrayType
+// ElementType returns the element type of this Input ([][]int).
+func (IntArrayArray) ElementType() reflect.Type {
+	return intArrayArrayType
 }
 
-func (in IDArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]ID] {
-	return pulumix.Output[[][]ID]{
+func (in IntArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]int] {
+	return pulumix.Output[[][]int]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IDArrayArray) ToIDArrayArrayOutput() IDArrayArrayOutput {
-	return ToOutput(in).(IDArrayArrayOutput)
+func (in IntArrayArray) ToIntArrayArrayOutput() IntArrayArrayOutput {
+	return ToOutput(in).(IntArrayArrayOutput)
 }
 
-func (in IDArrayArray) ToIDArrayArrayOutputWithContext(ctx context.Context) IDArrayArrayOutput {
-	return ToOutputWithContext(ctx, in).(IDArrayArrayOutput)
+func (in IntArrayArray) ToIntArrayArrayOutputWithContext(ctx context.Context) IntArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(IntArrayArrayOutput)
 }
 
-// IDArrayArrayOutput is an Output that returns [][]ID values.
-type IDArrayArrayOutput struct{ *OutputState }
+// IntArrayArrayOutput is an Output that returns [][]int values.
+type IntArrayArrayOutput struct{ *OutputState }
 
-func (IDArrayArrayOutput) MarshalJSON() ([]byte, error) {
+func (IntArrayArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IDArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]ID] {
-	return pulumix.Output[[][]ID]{
+func (o IntArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]int] {
+	return pulumix.Output[[][]int]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([][]ID).
-func (IDArrayArrayOutput) ElementType() reflect.Type {
-	return iDArrayArrayType
+// ElementType returns the element type of this Output ([][]int).
+func (IntArrayArrayOutput) ElementType() reflect.Type {
+	return intArrayArrayType
 }
 
-func (o IDArrayArrayOutput) ToIDArrayArrayOutput() IDArrayArrayOutput {
+func (o IntArrayArrayOutput) ToIntArrayArrayOutput() IntArrayArrayOutput {
 	return o
 }
 
-func (o IDArrayArrayOutput) ToIDArrayArrayOutputWithContext(ctx context.Context) IDArrayArrayOutput {
+func (o IntArrayArrayOutput) ToIntArrayArrayOutputWithContext(ctx context.Context) IntArrayArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o IDArrayArrayOutput) Index(i IntInput) IDArrayOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) []ID {
-		arr := vs[0].([][]ID)
+func (o IntArrayArrayOutput) Index(i IntInput) IntArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []int {
+		arr := vs[0].([][]int)
 		idx := vs[1].(int)
-		var ret []ID
+		var ret []int
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(IDArrayOutput)
+	}).(IntArrayOutput)
 }
 
-func ToIDArrayArray(in [][]ID) IDArrayArray {
-	a := make(IDArrayArray, len(in))
+func ToIntArrayArray(in [][]int) IntArrayArray {
+	a := make(IntArrayArray, len(in))
 	for i, v := range in {
-		a[i] = ToIDArray(v)
+		a[i] = ToIntArray(v)
 	}
 	return a
 }
 
-func ToIDArrayArrayOutput(in []IDArrayOutput) IDArrayArrayOutput {
-	a := make(IDArrayArray, len(in))
+func ToIntArrayArrayOutput(in []IntArrayOutput) IntArrayArrayOutput {
+	a := make(IntArrayArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToIDArrayArrayOutput()
+	return a.ToIntArrayArrayOutput()
 }
 
-var arrayType = reflect.TypeOf((*[]interface{})(nil)).Elem()
+var int64Type = reflect.TypeOf((*int64)(nil)).Elem()
 
-// ArrayInput is an input type that accepts Array and ArrayOutput values.
-type ArrayInput interface {
+// Int64Input is an input type that accepts Int64 and Int64Output values.
+type Int64Input interface {
 	Input
 
-	ToArrayOutput() ArrayOutput
-	ToArrayOutputWithContext(ctx context.Context) ArrayOutput
+	ToInt64Output() Int64Output
+	ToInt64OutputWithContext(ctx context.Context) Int64Output
+
+	ToInt64PtrOutput() Int64PtrOutput
+	ToInt64PtrOutputWithContext(ctx context.Context) Int64PtrOutput
 }
 
-// Array is an input type for []Input values.
-type Array []Input
+// Int64 is an input type for int64 values.
+type Int64 int64
 
-// ElementType returns the element type of this Input ([]interface{}).
-func (Array) ElementType() reflect.Type {
-	return arrayType
+// ElementType returns the element type of this Input (int64).
+func (Int64) ElementType() reflect.Type {
+	return int64Type
 }
 
-func (in Array) ToOutput(ctx context.Context) pulumix.Output[[]interface{}] {
-	return pulumix.Output[[]interface{}]{
+func (in Int64) ToOutput(ctx context.Context) pulumix.Output[int64] {
+	return pulumix.Output[int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Array) ToArrayOutput() ArrayOutput {
-	return ToOutput(in).(ArrayOutput)
+func (in Int64) ToInt64Output() Int64Output {
+	return ToOutput(in).(Int64Output)
 }
 
-func (in Array) ToArrayOutputWithContext(ctx context.Context) ArrayOutput {
-	return ToOutputWithContext(ctx, in).(ArrayOutput)
+func (in Int64) ToInt64OutputWithContext(ctx context.Context) Int64Output {
+	return ToOutputWithContext(ctx, in).(Int64Output)
 }
 
-// ArrayOutput is an Output that returns []interface{} values.
-type ArrayOutput struct{ *OutputState }
+func (in Int64) ToInt64PtrOutput() Int64PtrOutput {
+	return in.ToInt64PtrOutputWithContext(context.Background())
+}
 
-func (ArrayOutput) MarshalJSON() ([]byte, error) {
+func (in Int64) ToInt64PtrOutputWithContext(ctx context.Context) Int64PtrOutput {
+	return in.ToInt64OutputWithContext(ctx).ToInt64PtrOutputWithContext(ctx)
+}
+
+// Int64Output is an Output that returns int64 values.
+type Int64Output struct{ *OutputState }
+
+func (Int64Output) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]interface{}] {
-	return pulumix.Output[[]interface{}]{
+func (o Int64Output) ToOutput(ctx context.Context) pulumix.Output[int64] {
+	return pulumix.Output[int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]interface{}).
-func (ArrayOutput) ElementType() reflect.Type {
-	return arrayType
+// ElementType returns the element type of this Output (int64).
+func (Int64Output) ElementType() reflect.Type {
+	return int64Type
 }
 
-func (o ArrayOutput) ToArrayOutput() ArrayOutput {
+func (o Int64Output) ToInt64Output() Int64Output {
 	return o
 }
 
-func (o ArrayOutput) ToArrayOutputWithContext(ctx context.Context) ArrayOutput {
+func (o Int64Output) ToInt64OutputWithContext(ctx context.Context) Int64Output {
 	return o
 }
 
-// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
-// type if the index is out of bounds.
-func (o ArrayOutput) Index(i IntInput) Output {
-	return All(o, i).ApplyT(func(vs []interface{}) interface{} {
-		arr := vs[0].([]interface{})
-		idx := vs[1].(int)
-		var ret interface{}
-		if idx >= 0 && idx < len(arr) {
-			ret = arr[idx]
-		}
-		return ret
-	}).(Output)
-}
-
-func ToArray(in []interface{}) Array {
-	a := make(Array, len(in))
-	for i, v := range in {
-		a[i] = ToOutput(v)
-	}
-	return a
+func (o Int64Output) ToInt64PtrOutput() Int64PtrOutput {
+	return o.ToInt64PtrOutputWithContext(context.Background())
 }
 
-func ToArrayOutput(in []Output) ArrayOutput {
-	a := make(Array, len(in))
-	for i, v := range in {
-		a[i] = v
-	}
-	return a.ToArrayOutput()
+func (o Int64Output) ToInt64PtrOutputWithContext(ctx context.Context) Int64PtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v int64) *int64 {
+		return &v
+	}).(Int64PtrOutput)
 }
 
-var mapType = reflect.TypeOf((*map[string]interface{})(nil)).Elem()
+var int64PtrType = reflect.TypeOf((**int64)(nil)).Elem()
 
-// MapInput is an input type that accepts Map and MapOutput values.
-type MapInput interface {
+// Int64PtrInput is an input type that accepts Int64Ptr and Int64PtrOutput values.
+type Int64PtrInput interface {
 	Input
 
-	ToMapOutput() MapOutput
-	ToMapOutputWithContext(ctx context.Context) MapOutput
+	ToInt64PtrOutput() Int64PtrOutput
+	ToInt64PtrOutputWithContext(ctx context.Context) Int64PtrOutput
 }
 
-// Map is an input type for map[string]Input values.
-type Map map[string]Input
+type int64Ptr int64
 
-// ElementType returns the element type of this Input (map[string]interface{}).
-func (Map) ElementType() reflect.Type {
-	return mapType
+// Int64Ptr is an input type for *int64 values.
+func Int64Ptr(v int64) Int64PtrInput {
+	return (*int64Ptr)(&v)
+}
+func Int64PtrFromPtr(v *int64) Int64PtrInput {
+	if v == nil {
+		return nil
+	}
+	return (*int64Ptr)(v)
 }
 
-func (in Map) ToOutput(ctx context.Context) pulumix.Output[map[string]interface{}] {
-	return pulumix.Output[map[string]interface{}]{
+// ElementType returns the element type of this Input (*int64).
+func (*int64Ptr) ElementType() reflect.Type {
+	return int64PtrType
+}
+
+func (in *int64Ptr) ToOutput(ctx context.Context) pulumix.Output[*int64] {
+	return pulumix.Output[*int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Map) ToMapOutput() MapOutput {
-	return ToOutput(in).(MapOutput)
+func (in *int64Ptr) ToInt64PtrOutput() Int64PtrOutput {
+	return ToOutput(in).(Int64PtrOutput)
 }
 
-func (in Map) ToMapOutputWithContext(ctx context.Context) MapOutput {
-	return ToOutputWithContext(ctx, in).(MapOutput)
+func (in *int64Ptr) ToInt64PtrOutputWithContext(ctx context.Context) Int64PtrOutput {
+	return ToOutputWithContext(ctx, in).(Int64PtrOutput)
 }
 
-// MapOutput is an Output that returns map[string]interface{} values.
-type MapOutput struct{ *OutputState }
+// Int64PtrOutput is an Output that returns *int64 values.
+type Int64PtrOutput struct{ *OutputState }
 
-func (MapOutput) MarshalJSON() ([]byte, error) {
+func (Int64PtrOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]interface{}] {
-	return pulumix.Output[map[string]interface{}]{
+func (o Int64PtrOutput) ToOutput(ctx context.Context) pulumix.Output[*int64] {
+	return pulumix.Output[*int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]interface{}).
-func (MapOutput) ElementType() reflect.Type {
-	return mapType
+// ElementType returns the element type of this Output (*int64).
+func (Int64PtrOutput) ElementType() reflect.Type {
+	return int64PtrType
 }
 
-func (o MapOutput) ToMapOutput() MapOutput {
+func (o Int64PtrOutput) ToInt64PtrOutput() Int64PtrOutput {
 	return o
 }
 
-func (o MapOutput) ToMapOutputWithContext(ctx context.Context) MapOutput {
+func (o Int64PtrOutput) ToInt64PtrOutputWithContext(ctx context.Context) Int64PtrOutput {
 	return o
 }
 
-// MapIndex looks up the key k in the map.
-func (o MapOutput) MapIndex(k StringInput) Output {
-	return All(o, k).ApplyT(func(vs []interface{}) interface{} {
-		return vs[0].(map[string]interface{})[vs[1].(string)]
-	}).(Output)
-}
-
-func ToMap(in map[string]interface{}) Map {
-	m := make(Map)
-	for k, v := range in {
-		m[k] = ToOutput(v)
-	}
-	return m
-}
-
-func ToMapOutput(in map[string]Output) MapOutput {
-	m := make(Map)
-	for k, v := range in {
-		m[k] = v
-	}
-	return m.ToMapOutput()
+// Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
+func (o Int64PtrOutput) Elem() Int64Output {
+	return o.ApplyT(func(v *int64) int64 {
+		var ret int64
+		if v != nil {
+			ret = *v
+		}
+		return ret
+	}).(Int64Output)
 }
 
-var arrayMapType = reflect.TypeOf((*map[string][]interface{})(nil)).Elem()
+var int64ArrayType = reflect.TypeOf((*[]int64)(nil)).Elem()
 
-// ArrayMapInput is an input type that accepts ArrayMap and ArrayMapOutput values.
-type ArrayMapInput interface {
+// Int64ArrayInput is an input type that accepts Int64Array and Int64ArrayOutput values.
+type Int64ArrayInput interface {
 	Input
 
-	ToArrayMapOutput() ArrayMapOutput
-	ToArrayMapOutputWithContext(ctx context.Context) ArrayMapOutput
+	ToInt64ArrayOutput() Int64ArrayOutput
+	ToInt64ArrayOutputWithContext(ctx context.Context) Int64ArrayOutput
 }
 
-// ArrayMap is an input type for map[string]ArrayInput values.
-type ArrayMap map[string]ArrayInput
+// Int64Array is an input type for []Int64Input values.
+type Int64Array []Int64Input
 
-// ElementType returns the element type of this Input (map[string][]interface{}).
-func (ArrayMap) ElementType() reflect.Type {
-	return arrayMapType
+// ElementType returns the element type of this Input ([]int64).
+func (Int64Array) ElementType() reflect.Type {
+	return int64ArrayType
 }
 
-func (in ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]interface{}] {
-	return pulumix.Output[map[string][]interface{}]{
+func (in Int64Array) ToOutput(ctx context.Context) pulumix.Output[[]int64] {
+	return pulumix.Output[[]int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in ArrayMap) ToArrayMapOutput() ArrayMapOutput {
-	return ToOutput(in).(ArrayMapOutput)
+func (in Int64Array) ToInt64ArrayOutput() Int64ArrayOutput {
+	return ToOutput(in).(Int64ArrayOutput)
 }
 
-func (in ArrayMap) ToArrayMapOutputWithContext(ctx context.Context) ArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(ArrayMapOutput)
+func (in Int64Array) ToInt64ArrayOutputWithContext(ctx context.Context) Int64ArrayOutput {
+	return ToOutputWithContext(ctx, in).(Int64ArrayOutput)
 }
 
-// ArrayMapOutput is an Output that returns map[string][]interface{} values.
-type ArrayMapOutput struct{ *OutputState }
+// Int64ArrayOutput is an Output that returns []int64 values.
+type Int64ArrayOutput struct{ *OutputState }
 
-func (ArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (Int64ArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]interface{}] {
-	return pulumix.Output[map[string][]interface{}]{
+func (o Int64ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]int64] {
+	return pulumix.Output[[]int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][]interface{}).
-func (ArrayMapOutput) ElementType() reflect.Type {
-	return arrayMapType
+// ElementType returns the element type of this Output ([]int64).
+func (Int64ArrayOutput) ElementType() reflect.Type {
+	return int64ArrayType
 }
 
-func (o ArrayMapOutput) ToArrayMapOutput() ArrayMapOutput {
+func (o Int64ArrayOutput) ToInt64ArrayOutput() Int64ArrayOutput {
 	return o
 }
 
-func (o ArrayMapOutput) ToArrayMapOutputWithContext(ctx context.Context) ArrayMapOutput {
+func (o Int64ArrayOutput) ToInt64ArrayOutputWithContext(ctx context.Context) Int64ArrayOutput {
 	return o
 }
 
-// MapIndex looks up the key k in the map.
-func (o ArrayMapOutput) MapIndex(k StringInput) ArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) []interface{} {
-		return vs[0].(map[string][]interface{})[vs[1].(string)]
-	}).(ArrayOutput)
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o Int64ArrayOutput) Index(i IntInput) Int64Output {
+	return All(o, i).ApplyT(func(vs []interface{}) int64 {
+		arr := vs[0].([]int64)
+		idx := vs[1].(int)
+		var ret int64
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(Int64Output)
 }
 
-func ToArrayMap(in map[string][]interface{}) ArrayMap {
-	m := make(ArrayMap)
-	for k, v := range in {
-		m[k] = ToArray(v)
+func ToInt64Array(in []int64) Int64Array {
+	a := make(Int64Array, len(in))
+	for i, v := range in {
+		a[i] = Int64(v)
 	}
-	return m
+	return a
 }
 
-func ToArrayMapOutput(in map[string]ArrayOutput) ArrayMapOutput {
-	m := make(ArrayMap)
-	for k, v := range in {
-		m[k] = v
+func ToInt64ArrayOutput(in []Int64Output) Int64ArrayOutput {
+	a := make(Int64Array, len(in))
+	for i, v := range in {
+		a[i] = v
 	}
-	return m.ToArrayMapOutput()
+	return a.ToInt64ArrayOutput()
 }
 
-var mapArrayType = reflect.TypeOf((*[]map[string]interface{})(nil)).Elem()
+var int64MapType = reflect.TypeOf((*map[string]int64)(nil)).Elem()
 
-// MapArrayInput is an input type that accepts MapArray and MapArrayOutput values.
-type MapArrayInput interface {
+// Int64MapInput is an input type that accepts Int64Map and Int64MapOutput values.
+type Int64MapInput interface {
 	Input
 
-	ToMapArrayOutput() MapArrayOutput
-	ToMapArrayOutputWithContext(ctx context.Context) MapArrayOutput
+	ToInt64MapOutput() Int64MapOutput
+	ToInt64MapOutputWithContext(ctx context.Context) Int64MapOutput
 }
 
-// MapArray is an input type for []MapInput values.
-type MapArray []MapInput
+// Int64Map is an input type for map[string]Int64Input values.
+type Int64Map map[string]Int64Input
 
-// ElementType returns the element type of this Input ([]map[string]interface{}).
-func (MapArray) ElementType() reflect.Type {
-	return mapArrayType
+// ElementType returns the element type of this Input (map[string]int64).
+func (Int64Map) ElementType() reflect.Type {
+	return int64MapType
 }
 
-func (in MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]interface{}] {
-	return pulumix.Output[[]map[string]interface{}]{
+func (in Int64Map) ToOutput(ctx context.Context) pulumix.Output[map[string]int64] {
+	return pulumix.Output[map[string]int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in MapArray) ToMapArrayOutput() MapArrayOutput {
-	return ToOutput(in).(MapArrayOutput)
+func (in Int64Map) ToInt64MapOutput() Int64MapOutput {
+	return ToOutput(in).(Int64MapOutput)
 }
-
-func (in MapArray) ToMapArrayOutputWithContext(ctx context.Context) MapArrayOutput {
-	return ToOutputWithContext(ctx, in).(MapArrayOutput)
+
+func (in Int64Map) ToInt64MapOutputWithContext(ctx context.Context) Int64MapOutput {
+	return ToOutputWithContext(ctx, in).(Int64MapOutput)
 }
 
-// MapArrayOutput is an Output that returns []map[string]interface{} values.
-type MapArrayOutput struct{ *OutputState }
+// Int64MapOutput is an Output that returns map[string]int64 values.
+type Int64MapOutput struct{ *OutputState }
 
-func (MapArrayOutput) MarshalJSON() ([]byte, error) {
+func (Int64MapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]interface{}] {
-	return pulumix.Output[[]map[string]interface{}]{
+func (o Int64MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]int64] {
+	return pulumix.Output[map[string]int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]map[string]interface{}).
-func (MapArrayOutput) ElementType() reflect.Type {
-	return mapArrayType
+// ElementType returns the element type of this Output (map[string]int64).
+func (Int64MapOutput) ElementType() reflect.Type {
+	return int64MapType
 }
 
-func (o MapArrayOutput) ToMapArrayOutput() MapArrayOutput {
+func (o Int64MapOutput) ToInt64MapOutput() Int64MapOutput {
 	return o
 }
 
-func (o MapArrayOutput) ToMapArrayOutputWithContext(ctx context.Context) MapArrayOutput {
+func (o Int64MapOutput) ToInt64MapOutputWithContext(ctx context.Context) Int64MapOutput {
 	return o
 }
 
-// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
-// type if the index is out of bounds.
-func (o MapArrayOutput) Index(i IntInput) MapOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) map[string]interface{} {
-		arr := vs[0].([]map[string]interface{})
-		idx := vs[1].(int)
-		var ret map[string]interface{}
-		if idx >= 0 && idx < len(arr) {
-			ret = arr[idx]
-		}
-		return ret
-	}).(MapOutput)
+// MapIndex looks up the key k in the map.
+func (o Int64MapOutput) MapIndex(k StringInput) Int64Output {
+	return All(o, k).ApplyT(func(vs []interface{}) int64 {
+		return vs[0].(map[string]int64)[vs[1].(string)]
+	}).(Int64Output)
 }
 
-func ToMapArray(in []map[string]interface{}) MapArray {
-	a := make(MapArray, len(in))
-	for i, v := range in {
-		a[i] = ToMap(v)
+func ToInt64Map(in map[string]int64) Int64Map {
+	m := make(Int64Map)
+	for k, v := range in {
+		m[k] = Int64(v)
 	}
-	return a
+	return m
 }
 
-func ToMapArrayOutput(in []MapOutput) MapArrayOutput {
-	a := make(MapArray, len(in))
-	for i, v := range in {
-		a[i] = v
+func ToInt64MapOutput(in map[string]Int64Output) Int64MapOutput {
+	m := make(Int64Map)
+	for k, v := range in {
+		m[k] = v
 	}
-	return a.ToMapArrayOutput()
+	return m.ToInt64MapOutput()
 }
 
-var mapMapType = reflect.TypeOf((*map[string]map[string]interface{})(nil)).Elem()
+var int64ArrayMapType = reflect.TypeOf((*map[string][]int64)(nil)).Elem()
 
-// MapMapInput is an input type that accepts MapMap and MapMapOutput values.
-type MapMapInput interface {
+// Int64ArrayMapInput is an input type that accepts Int64ArrayMap and Int64ArrayMapOutput values.
+type Int64ArrayMapInput interface {
 	Input
 
-	ToMapMapOutput() MapMapOutput
-	ToMapMapOutputWithContext(ctx context.Context) MapMapOutput
+	ToInt64ArrayMapOutput() Int64ArrayMapOutput
+	ToInt64ArrayMapOutputWithContext(ctx context.Context) Int64ArrayMapOutput
 }
 
-// MapMap is an input type for map[string]MapInput values.
-type MapMap map[string]MapInput
+// Int64ArrayMap is an input type for map[string]Int64ArrayInput values.
+type Int64ArrayMap map[string]Int64ArrayInput
 
-// ElementType returns the element type of this Input (map[string]map[string]interface{}).
-func (MapMap) ElementType() reflect.Type {
-	return mapMapType
+// ElementType returns the element type of this Input (map[string][]int64).
+func (Int64ArrayMap) ElementType() reflect.Type {
+	return int64ArrayMapType
 }
 
-func (in MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]interface{}] {
-	return pulumix.Output[map[string]map[string]interface{}]{
+func (in Int64ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]int64] {
+	return pulumix.Output[map[string][]int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in MapMap) ToMapMapOutput() MapMapOutput {
-	return ToOutput(in).(MapMapOutput)
+func (in Int64ArrayMap) ToInt64ArrayMapOutput() Int64ArrayMapOutput {
+	return ToOutput(in).(Int64ArrayMapOutput)
 }
 
-func (in MapMap) ToMapMapOutputWithContext(ctx context.Context) MapMapOutput {
-	return ToOutputWithContext(ctx, in).(MapMapOutput)
+func (in Int64ArrayMap) ToInt64ArrayMapOutputWithContext(ctx context.Context) Int64ArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(Int64ArrayMapOutput)
 }
 
-// MapMapOutput is an Output that returns map[string]map[string]interface{} values.
-type MapMapOutput struct{ *OutputState }
+// Int64ArrayMapOutput is an Output that returns map[string][]int64 values.
+type Int64ArrayMapOutput struct{ *OutputState }
 
-func (MapMapOutput) MarshalJSON() ([]byte, error) {
+func (Int64ArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]interface{}] {
-	return pulumix.Output[map[string]map[string]interface{}]{
+func (o Int64ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]int64] {
+	return pulumix.Output[map[string][]int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]map[string]interface{}).
-func (MapMapOutput) ElementType() reflect.Type {
-	return mapMapType
+// ElementType returns the element type of this Output (map[string][]int64).
+func (Int64ArrayMapOutput) ElementType() reflect.Type {
+	return int64ArrayMapType
 }
 
-func (o MapMapOutput) ToMapMapOutput() MapMapOutput {
+func (o Int64ArrayMapOutput) ToInt64ArrayMapOutput() Int64ArrayMapOutput {
 	return o
 }
 
-func (o MapMapOutput) ToMapMapOutputWithContext(ctx context.Context) MapMapOutput {
+func (o Int64ArrayMapOutput) ToInt64ArrayMapOutputWithContext(ctx context.Context) Int64ArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o MapMapOutput) MapIndex(k StringInput) MapOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) map[string]interface{} {
-		return vs[0].(map[string]map[string]interface{})[vs[1].(string)]
-	}).(MapOutput)
+func (o Int64ArrayMapOutput) MapIndex(k StringInput) Int64ArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []int64 {
+		return vs[0].(map[string][]int64)[vs[1].(string)]
+	}).(Int64ArrayOutput)
 }
 
-func ToMapMap(in map[string]map[string]interface{}) MapMap {
-	m := make(MapMap)
+func ToInt64ArrayMap(in map[string][]int64) Int64ArrayMap {
+	m := make(Int64ArrayMap)
 	for k, v := range in {
-		m[k] = ToMap(v)
+		m[k] = ToInt64Array(v)
 	}
 	return m
 }
 
-func ToMapMapOutput(in map[string]MapOutput) MapMapOutput {
-	m := make(MapMap)
+func ToInt64ArrayMapOutput(in map[string]Int64ArrayOutput) Int64ArrayMapOutput {
+	m := make(Int64ArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToMapMapOutput()
+	return m.ToInt64ArrayMapOutput()
 }
 
-var arrayArrayType = reflect.TypeOf((*[][]interface{})(nil)).Elem()
+var int64MapArrayType = reflect.TypeOf((*[]map[string]int64)(nil)).Elem()
 
-// ArrayArrayInput is an input type that accepts ArrayArray and ArrayArrayOutput values.
-type ArrayArrayInput interface {
+// Int64MapArrayInput is an input type that accepts Int64MapArray and Int64MapArrayOutput values.
+type Int64MapArrayInput interface {
 	Input
 
-	ToArrayArrayOutput() ArrayArrayOutput
-	ToArrayArrayOutputWithContext(ctx context.Context) ArrayArrayOutput
+	ToInt64MapArrayOutput() Int64MapArrayOutput
+	ToInt64MapArrayOutputWithContext(ctx context.Context) Int64MapArrayOutput
 }
 
-// ArrayArray is an input type for []ArrayInput values.
-type ArrayArray []ArrayInput
+// Int64MapArray is an input type for []Int64MapInput values.
+type Int64MapArray []Int64MapInput
 
-// ElementType returns the element type of this Input ([][]interface{}).
-func (ArrayArray) ElementType() reflect.Type {
-	return arrayArrayType
+// ElementType returns the element type of this Input ([]map[string]int64).
+func (Int64MapArray) ElementType() reflect.Type {
+	return int64MapArrayType
 }
 
-func (in ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]interface{}] {
-	return pulumix.Output[[][]interface{}]{
+func (in Int64MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]int64] {
+	return pulumix.Output[[]map[string]int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in ArrayArray) ToArrayArrayOutput() ArrayArrayOutput {
-	return ToOutput(in).(ArrayArrayOutput)
+func (in Int64MapArray) ToInt64MapArrayOutput() Int64MapArrayOutput {
+	return ToOutput(in).(Int64MapArrayOutput)
 }
 
-func (in ArrayArray) ToArrayArrayOutputWithContext(ctx context.Context) ArrayArrayOutput {
-	return ToOutputWithContext(ctx, in).(ArrayArrayOutput)
+func (in Int64MapArray) ToInt64MapArrayOutputWithContext(ctx context.Context) Int64MapArrayOutput {
+	return ToOutputWithContext(ctx, in).(Int64MapArrayOutput)
 }
 
-// ArrayArrayOutput is an Output that returns [][]interface{} values.
-type ArrayArrayOutput struct{ *OutputState }
+// Int64MapArrayOutput is an Output that returns []map[string]int64 values.
+type Int64MapArrayOutput struct{ *OutputState }
 
-func (ArrayArrayOutput) MarshalJSON() ([]byte, error) {
+func (Int64MapArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]interface{}] {
-	return pulumix.Output[[][]interface{}]{
+func (o Int64MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]int64] {
+	return pulumix.Output[[]map[string]int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([][]interface{}).
-func (ArrayArrayOutput) ElementType() reflect.Type {
-	return arrayArrayType
+// ElementType returns the element type of this Output ([]map[string]int64).
+func (Int64MapArrayOutput) ElementType() reflect.Type {
+	return int64MapArrayType
 }
 
-func (o ArrayArrayOutput) ToArrayArrayOutput() ArrayArrayOutput {
+func (o Int64MapArrayOutput) ToInt64MapArrayOutput() Int64MapArrayOutput {
 	return o
 }
 
-func (o ArrayArrayOutput) ToArrayArrayOutputWithContext(ctx context.Context) ArrayArrayOutput {
+func (o Int64MapArrayOutput) ToInt64MapArrayOutputWithContext(ctx context.Context) Int64MapArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o ArrayArrayOutput) Index(i IntInput) ArrayOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) []interface{} {
-		arr := vs[0].([][]interface{})
+func (o Int64MapArrayOutput) Index(i IntInput) Int64MapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]int64 {
+		arr := vs[0].([]map[string]int64)
 		idx := vs[1].(int)
-		var ret []interface{}
+		var ret map[string]int64
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(ArrayOutput)
+	}).(Int64MapOutput)
 }
 
-func ToArrayArray(in [][]interface{}) ArrayArray {
-	a := make(ArrayArray, len(in))
+func ToInt64MapArray(in []map[string]int64) Int64MapArray {
+	a := make(Int64MapArray, len(in))
 	for i, v := range in {
-		a[i] = ToArray(v)
+		a[i] = ToInt64Map(v)
 	}
 	return a
 }
 
-func ToArrayArrayOutput(in []ArrayOutput) ArrayArrayOutput {
-	a := make(ArrayArray, len(in))
+func ToInt64MapArrayOutput(in []Int64MapOutput) Int64MapArrayOutput {
+	a := make(Int64MapArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToArrayArrayOutput()
+	return a.ToInt64MapArrayOutput()
 }
 
-var arrayArrayMapType = reflect.TypeOf((*map[string][][]interface{})(nil)).Elem()
+var int64MapMapType = reflect.TypeOf((*map[string]map[string]int64)(nil)).Elem()
 
-// ArrayArrayMapInput is an input type that accepts ArrayArrayMap and ArrayArrayMapOutput values.
-type ArrayArrayMapInput interface {
+// Int64MapMapInput is an input type that accepts Int64MapMap and Int64MapMapOutput values.
+type Int64MapMapInput interface {
 	Input
 
-	ToArrayArrayMapOutput() ArrayArrayMapOutput
-	ToArrayArrayMapOutputWithContext(ctx context.Context) ArrayArrayMapOutput
+	ToInt64MapMapOutput() Int64MapMapOutput
+	ToInt64MapMapOutputWithContext(ctx context.Context) Int64MapMapOutput
 }
 
-// ArrayArrayMap is an input type for map[string]ArrayArrayInput values.
-type ArrayArrayMap map[string]ArrayArrayInput
+// Int64MapMap is an input type for map[string]Int64MapInput values.
+type Int64MapMap map[string]Int64MapInput
 
-// ElementType returns the element type of this Input (map[string][][]interface{}).
-func (ArrayArrayMap) ElementType() reflect.Type {
-	return arrayArrayMapType
+// ElementType returns the element type of this Input (map[string]map[string]int64).
+func (Int64MapMap) ElementType() reflect.Type {
+	return int64MapMapType
 }
 
-func (in ArrayArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][][]interface{}] {
-	return pulumix.Output[map[string][][]interface{}]{
+func (in Int64MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]int64] {
+	return pulumix.Output[map[string]map[string]int64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in ArrayArrayMap) ToArrayArrayMapOutput() ArrayArrayMapOutput {
-	return ToOutput(in).(ArrayArrayMapOutput)
+func (in Int64MapMap) ToInt64MapMapOutput() Int64MapMapOutput {
+	return ToOutput(in).(Int64MapMapOutput)
 }
 
-func (in ArrayArrayMap) ToArrayArrayMapOutputWithContext(ctx context.Context) ArrayArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(ArrayArrayMapOutput)
+func (in Int64MapMap) ToInt64MapMapOutputWithContext(ctx context.Context) Int64MapMapOutput {
+	return ToOutputWithContext(ctx, in).(Int64MapMapOutput)
 }
 
-// ArrayArrayMapOutput is an Output that returns map[string][][]interface{} values.
-type ArrayArrayMapOutput struct{ *OutputState }
+// Int64MapMapOutput is an Output that returns map[string]map[string]int64 values.
+type Int64MapMapOutput struct{ *OutputState }
 
-func (ArrayArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (Int64MapMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o ArrayArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][][]interface{}] {
-	return pulumix.Output[map[string][][]interface{}]{
+func (o Int64MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]int64] {
+	return pulumix.Output[map[string]map[string]int64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][][]interface{}).
-func (ArrayArrayMapOutput) ElementType() reflect.Type {
-	return arrayArrayMapType
+// ElementType returns the element type of this Output (map[string]map[string]int64).
+func (Int64MapMapOutput) ElementType() reflect.Type {
+	return int64MapMapType
 }
 
-func (o ArrayArrayMapOutput) ToArrayArrayMapOutput() ArrayArrayMapOutput {
+func (o Int64MapMapOutput) ToInt64MapMapOutput() Int64MapMapOutput {
 	return o
 }
 
-func (o ArrayArrayMapOutput) ToArrayArrayMapOutputWithContext(ctx context.Context) ArrayArrayMapOutput {
+func (o Int64MapMapOutput) ToInt64MapMapOutputWithContext(ctx context.Context) Int64MapMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o ArrayArrayMapOutput) MapIndex(k StringInput) ArrayArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) [][]interface{} {
-		return vs[0].(map[string][][]interface{})[vs[1].(string)]
-	}).(ArrayArrayOutput)
+func (o Int64MapMapOutput) MapIndex(k StringInput) Int64MapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]int64 {
+		return vs[0].(map[string]map[string]int64)[vs[1].(string)]
+	}).(Int64MapOutput)
 }
 
-func ToArrayArrayMap(in map[string][][]interface{}) ArrayArrayMap {
-	m := make(ArrayArrayMap)
+func ToInt64MapMap(in map[string]map[string]int64) Int64MapMap {
+	m := make(Int64MapMap)
 	for k, v := range in {
-		m[k] = ToArrayArray(v)
+		m[k] = ToInt64Map(v)
 	}
 	return m
 }
 
-func ToArrayArrayMapOutput(in map[string]ArrayArrayOutput) ArrayArrayMapOutput {
-	m := make(ArrayArrayMap)
+func ToInt64MapMapOutput(in map[string]Int64MapOutput) Int64MapMapOutput {
+	m := make(Int64MapMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToArrayArrayMapOutput()
+	return m.ToInt64MapMapOutput()
 }
 
-var intType = reflect.TypeOf((*int)(nil)).Elem()
+var int64ArrayArrayType = reflect.TypeOf((*[][]int64)(nil)).Elem()
 
-// IntInput is an input type that accepts Int and IntOutput values.
-type IntInput interface {
+// Int64ArrayArrayInput is an input type that accepts Int64ArrayArray and Int64ArrayArrayOutput values.
+type Int64ArrayArrayInput interface {
 	Input
 
-	ToIntOutput() IntOutput
-	ToIntOutputWithContext(ctx context.Context) IntOutput
+	ToInt64ArrayArrayOutput() Int64ArrayArrayOutput
+	ToInt64ArrayArrayOutputWithContext(ctx context.Context) Int64ArrayArrayOutput
+}
 
-	ToIntPtrOutput() IntPtrOutput
-	ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput
+// Int64ArrayArray is an input type for []Int64ArrayInput values.
+type Int64ArrayArray []Int64ArrayInput
+
+// ElementType returns the element type of this Input ([][]int64).
+func (Int64ArrayArray) ElementType() reflect.Type {
+	return int64ArrayArrayType
 }
 
-// Int is an input type for int values.
-type Int int
+func (in Int64ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]int64] {
+	return pulumix.Output[[][]int64]{
+		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
+	}
+}
 
-// ElementType returns the element type of this Input (int).
-func (Int) ElementType() reflect.Type {
-	return intType
+func (in Int64ArrayArray) ToInt64ArrayArrayOutput() Int64ArrayArrayOutput {
+	return ToOutput(in).(Int64ArrayArrayOutput)
 }
 
-func (in Int) ToOutput(ctx context.Context) pulumix.Output[int] {
-	return pulumix.Output[int]{
+func (in Int64ArrayArray) ToInt64ArrayArrayOutputWithContext(ctx context.Context) Int64ArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(Int64ArrayArrayOutput)
+}
+
+// Int64ArrayArrayOutput is an Output that returns [][]int64 values.
+type Int64ArrayArrayOutput struct{ *OutputState }
+
+func (Int64ArrayArrayOutput) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Outputs can not be marshaled to JSON")
+}
+
+func (o Int64ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]int64] {
+	return pulumix.Output[[][]int64]{
+		OutputState: o.OutputState,
+	}
+}
+
+// ElementType returns the element type of this Output ([][]int64).
+func (Int64ArrayArrayOutput) ElementType() reflect.Type {
+	return int64ArrayArrayType
+}
+
+func (o Int64ArrayArrayOutput) ToInt64ArrayArrayOutput() Int64ArrayArrayOutput {
+	return o
+}
+
+func (o Int64ArrayArrayOutput) ToInt64ArrayArrayOutputWithContext(ctx context.Context) Int64ArrayArrayOutput {
+	return o
+}
+
+// Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
+// type if the index is out of bounds.
+func (o Int64ArrayArrayOutput) Index(i IntInput) Int64ArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []int64 {
+		arr := vs[0].([][]int64)
+		idx := vs[1].(int)
+		var ret []int64
+		if idx >= 0 && idx < len(arr) {
+			ret = arr[idx]
+		}
+		return ret
+	}).(Int64ArrayOutput)
+}
+
+func ToInt64ArrayArray(in [][]int64) Int64ArrayArray {
+	a := make(Int64ArrayArray, len(in))
+	for i, v := range in {
+		a[i] = ToInt64Array(v)
+	}
+	return a
+}
+
+func ToInt64ArrayArrayOutput(in []Int64ArrayOutput) Int64ArrayArrayOutput {
+	a := make(Int64ArrayArray, len(in))
+	for i, v := range in {
+		a[i] = v
+	}
+	return a.ToInt64ArrayArrayOutput()
+}
+
+var stringType = reflect.TypeOf((*string)(nil)).Elem()
+
+// StringInput is an input type that accepts String and StringOutput values.
+type StringInput interface {
+	Input
+
+	ToStringOutput() StringOutput
+	ToStringOutputWithContext(ctx context.Context) StringOutput
+
+	ToStringPtrOutput() StringPtrOutput
+	ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput
+}
+
+// String is an input type for string values.
+type String string
+
+// ElementType returns the element type of this Input (string).
+func (String) ElementType() reflect.Type {
+	return stringType
+}
+
+func (in String) ToOutput(ctx context.Context) pulumix.Output[string] {
+	return pulumix.Output[string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in Int) ToIntOutput() IntOutput {
-	return ToOutput(in).(IntOutput)
+func (in String) ToStringOutput() StringOutput {
+	return ToOutput(in).(StringOutput)
 }
 
-func (in Int) ToIntOutputWithContext(ctx context.Context) IntOutput {
-	return ToOutputWithContext(ctx, in).(IntOutput)
+func (in String) ToStringOutputWithContext(ctx context.Context) StringOutput {
+	return ToOutputWithContext(ctx, in).(StringOutput)
 }
 
-func (in Int) ToIntPtrOutput() IntPtrOutput {
-	return in.ToIntPtrOutputWithContext(context.Background())
+func (in String) ToStringPtrOutput() StringPtrOutput {
+	return in.ToStringPtrOutputWithContext(context.Background())
 }
 
-func (in Int) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
-	return in.ToIntOutputWithContext(ctx).ToIntPtrOutputWithContext(ctx)
+func (in String) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
+	return in.ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
 }
 
-// IntOutput is an Output that returns int values.
-type IntOutput struct{ *OutputState }
+// StringOutput is an Output that returns string values.
+type StringOutput struct{ *OutputState }
 
-func (IntOutput) MarshalJSON() ([]byte, error) {
+func (StringOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntOutput) ToOutput(ctx context.Context) pulumix.Output[int] {
-	return pulumix.Output[int]{
+func (o StringOutput) ToOutput(ctx context.Context) pulumix.Output[string] {
+	return pulumix.Output[string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (int).
-func (IntOutput) ElementType() reflect.Type {
-	return intType
+// ElementType returns the element type of this Output (string).
+func (StringOutput) ElementType() reflect.Type {
+	return stringType
 }
 
-func (o IntOutput) ToIntOutput() IntOutput {
+func (o StringOutput) ToStringOutput() StringOutput {
 	return o
 }
 
-func (o IntOutput) ToIntOutputWithContext(ctx context.Context) IntOutput {
+func (o StringOutput) ToStringOutputWithContext(ctx context.Context) StringOutput {
 	return o
 }
 
-func (o IntOutput) ToIntPtrOutput() IntPtrOutput {
-	return o.ToIntPtrOutputWithContext(context.Background())
+func (o StringOutput) ToStringPtrOutput() StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
 }
 
-func (o IntOutput) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v int) *int {
+func (o StringOutput) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v string) *string {
 		return &v
-	}).(IntPtrOutput)
+	}).(StringPtrOutput)
 }
 
-var intPtrType = reflect.TypeOf((**int)(nil)).Elem()
+var stringPtrType = reflect.TypeOf((**string)(nil)).Elem()
 
-// IntPtrInput is an input type that accepts IntPtr and IntPtrOutput values.
-type IntPtrInput interface {
+// StringPtrInput is an input type that accepts StringPtr and StringPtrOutput values.
+type StringPtrInput interface {
 	Input
 
-	ToIntPtrOutput() IntPtrOutput
-	ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput
+	ToStringPtrOutput() StringPtrOutput
+	ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput
 }
 
-type intPtr int
+type stringPtr string
 
-// IntPtr is an input type for *int values.
-func IntPtr(v int) IntPtrInput {
-	return (*intPtr)(&v)
+// StringPtr is an input type for *string values.
+func StringPtr(v string) StringPtrInput {
+	return (*stringPtr)(&v)
 }
-func IntPtrFromPtr(v *int) IntPtrInput {
+func StringPtrFromPtr(v *string) StringPtrInput {
 	if v == nil {
 		return nil
 	}
-	return (*intPtr)(v)
+	return (*stringPtr)(v)
 }
 
-// ElementType returns the element type of this Input (*int).
-func (*intPtr) ElementType() reflect.Type {
-	return intPtrType
+// ElementType returns the element type of this Input (*string).
+func (*stringPtr) ElementType() reflect.Type {
+	return stringPtrType
 }
 
-func (in *intPtr) ToOutput(ctx context.Context) pulumix.Output[*int] {
-	return pulumix.Output[*int]{
+func (in *stringPtr) ToOutput(ctx context.Context) pulumix.Output[*string] {
+	return pulumix.Output[*string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in *intPtr) ToIntPtrOutput() IntPtrOutput {
-	return ToOutput(in).(IntPtrOutput)
+func (in *stringPtr) ToStringPtrOutput() StringPtrOutput {
+	return ToOutput(in).(StringPtrOutput)
 }
 
-func (in *intPtr) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
-	return ToOutputWithContext(ctx, in).(IntPtrOutput)
+func (in *stringPtr) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
+	return ToOutputWithContext(ctx, in).(StringPtrOutput)
 }
 
-// IntPtrOutput is an Output that returns *int values.
-type IntPtrOutput struct{ *OutputState }
+// StringPtrOutput is an Output that returns *string values.
+type StringPtrOutput struct{ *OutputState }
 
-func (IntPtrOutput) MarshalJSON() ([]byte, error) {
+func (StringPtrOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*int] {
-	return pulumix.Output[*int]{
+func (o StringPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*string] {
+	return pulumix.Output[*string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (*int).
-func (IntPtrOutput) ElementType() reflect.Type {
-	return intPtrType
+// ElementType returns the element type of this Output (*string).
+func (StringPtrOutput) ElementType() reflect.Type {
+	return stringPtrType
 }
 
-func (o IntPtrOutput) ToIntPtrOutput() IntPtrOutput {
+func (o StringPtrOutput) ToStringPtrOutput() StringPtrOutput {
 	return o
 }
 
-func (o IntPtrOutput) ToIntPtrOutputWithContext(ctx context.Context) IntPtrOutput {
+func (o StringPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
 	return o
 }
 
 // Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
-func (o IntPtrOutput) Elem() IntOutput {
-	return o.ApplyT(func(v *int) int {
-		var ret int
+func (o StringPtrOutput) Elem() StringOutput {
+	return o.ApplyT(func(v *string) string {
+		var ret string
 		if v != nil {
 			ret = *v
 		}
 		return ret
-	}).(IntOutput)
+	}).(StringOutput)
 }
 
-var intArrayType = reflect.TypeOf((*[]int)(nil)).Elem()
+var stringArrayType = reflect.TypeOf((*[]string)(nil)).Elem()
 
-// IntArrayInput is an input type that accepts IntArray and IntArrayOutput values.
-type IntArrayInput interface {
+// StringArrayInput is an input type that accepts StringArray and StringArrayOutput values.
+type StringArrayInput interface {
 	Input
 
-	ToIntArrayOutput() IntArrayOutput
-	ToIntArrayOutputWithContext(ctx context.Context) IntArrayOutput
+	ToStringArrayOutput() StringArrayOutput
+	ToStringArrayOutputWithContext(ctx context.Context) StringArrayOutput
 }
 
-// IntArray is an input type for []IntInput values.
-type IntArray []IntInput
+// StringArray is an input type for []StringInput values.
+type StringArray []StringInput
 
-// ElementType returns the element type of this Input ([]int).
-func (IntArray) ElementType() reflect.Type {
-	return intArrayType
+// ElementType returns the element type of this Input ([]string).
+func (StringArray) ElementType() reflect.Type {
+	return stringArrayType
 }
 
-func (in IntArray) ToOutput(ctx context.Context) pulumix.Output[[]int] {
-	return pulumix.Output[[]int]{
+func (in StringArray) ToOutput(ctx context.Context) pulumix.Output[[]string] {
+	return pulumix.Output[[]string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IntArray) ToIntArrayOutput() IntArrayOutput {
-	return ToOutput(in).(IntArrayOutput)
+func (in StringArray) ToStringArrayOutput() StringArrayOutput {
+	return ToOutput(in).(StringArrayOutput)
 }
 
-func (in IntArray) ToIntArrayOutputWithContext(ctx context.Context) IntArrayOutput {
-	return ToOutputWithContext(ctx, in).(IntArrayOutput)
+func (in StringArray) ToStringArrayOutputWithContext(ctx context.Context) StringArrayOutput {
+	return ToOutputWithContext(ctx, in).(StringArrayOutput)
 }
 
-// IntArrayOutput is an Output that returns []int values.
-type IntArrayOutput struct{ *OutputState }
+// StringArrayOutput is an Output that returns []string values.
+type StringArrayOutput struct{ *OutputState }
 
-func (IntArrayOutput) MarshalJSON() ([]byte, error) {
+func (StringArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]int] {
-	return pulumix.Output[[]int]{
+func (o StringArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]string] {
+	return pulumix.Output[[]string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]int).
-func (IntArrayOutput) ElementType() reflect.Type {
-	return intArrayType
+// ElementType returns the element type of this Output ([]string).
+func (StringArrayOutput) ElementType() reflect.Type {
+	return stringArrayType
 }
 
-func (o IntArrayOutput) ToIntArrayOutput() IntArrayOutput {
+func (o StringArrayOutput) ToStringArrayOutput() StringArrayOutput {
 	return o
 }
 
-func (o IntArrayOutput) ToIntArrayOutputWithContext(ctx context.Context) IntArrayOutput {
+func (o StringArrayOutput) ToStringArrayOutputWithContext(ctx context.Context) StringArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o IntArrayOutput) Index(i IntInput) IntOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) int {
-		arr := vs[0].([]int)
+func (o StringArrayOutput) Index(i IntInput) StringOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) string {
+		arr := vs[0].([]string)
 		idx := vs[1].(int)
-		var ret int
+		var ret string
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(IntOutput)
+	}).(StringOutput)
 }
 
-func ToIntArray(in []int) IntArray {
-	a := make(IntArray, len(in))
+func ToStringArray(in []string) StringArray {
+	a := make(StringArray, len(in))
 	for i, v := range in {
-		a[i] = Int(v)
+		a[i] = String(v)
 	}
 	return a
 }
 
-func ToIntArrayOutput(in []IntOutput) IntArrayOutput {
-	a := make(IntArray, len(in))
+func ToStringArrayOutput(in []StringOutput) StringArrayOutput {
+	a := make(StringArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToIntArrayOutput()
+	return a.ToStringArrayOutput()
 }
 
-var intMapType = reflect.TypeOf((*map[string]int)(nil)).Elem()
+var stringMapType = reflect.TypeOf((*map[string]string)(nil)).Elem()
 
-// IntMapInput is an input type that accepts IntMap and IntMapOutput values.
-type IntMapInput interface {
+// StringMapInput is an input type that accepts StringMap and StringMapOutput values.
+type StringMapInput interface {
 	Input
 
-	ToIntMapOutput() IntMapOutput
-	ToIntMapOutputWithContext(ctx context.Context) IntMapOutput
+	ToStringMapOutput() StringMapOutput
+	ToStringMapOutputWithContext(ctx context.Context) StringMapOutput
 }
 
-// IntMap is an input type for map[string]IntInput values.
-type IntMap map[string]IntInput
+// StringMap is an input type for map[string]StringInput values.
+type StringMap map[string]StringInput
 
-// ElementType returns the element type of this Input (map[string]int).
-func (IntMap) ElementType() reflect.Type {
-	return intMapType
+// ElementType returns the element type of this Input (map[string]string).
+func (StringMap) ElementType() reflect.Type {
+	return stringMapType
 }
 
-func (in IntMap) ToOutput(ctx context.Context) pulumix.Output[map[string]int] {
-	return pulumix.Output[map[string]int]{
+func (in StringMap) ToOutput(ctx context.Context) pulumix.Output[map[string]string] {
+	return pulumix.Output[map[string]string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IntMap) ToIntMapOutput() IntMapOutput {
-	return ToOutput(in).(IntMapOutput)
+func (in StringMap) ToStringMapOutput() StringMapOutput {
+	return ToOutput(in).(StringMapOutput)
 }
 
-func (in IntMap) ToIntMapOutputWithContext(ctx context.Context) IntMapOutput {
-	return ToOutputWithContext(ctx, in).(IntMapOutput)
+func (in StringMap) ToStringMapOutputWithContext(ctx context.Context) StringMapOutput {
+	return ToOutputWithContext(ctx, in).(StringMapOutput)
 }
 
-// IntMapOutput is an Output that returns map[string]int values.
-type IntMapOutput struct{ *OutputState }
+// StringMapOutput is an Output that returns map[string]string values.
+type StringMapOutput struct{ *OutputState }
 
-func (IntMapOutput) MarshalJSON() ([]byte, error) {
+func (StringMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]int] {
-	return pulumix.Output[map[string]int]{
+func (o StringMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]string] {
+	return pulumix.Output[map[string]string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]int).
-func (IntMapOutput) ElementType() reflect.Type {
-	return intMapType
+// ElementType returns the element type of this Output (map[string]string).
+func (StringMapOutput) ElementType() reflect.Type {
+	return stringMapType
 }
 
-func (o IntMapOutput) ToIntMapOutput() IntMapOutput {
+func (o StringMapOutput) ToStringMapOutput() StringMapOutput {
 	return o
 }
 
-func (o IntMapOutput) ToIntMapOutputWithContext(ctx context.Context) IntMapOutput {
+func (o StringMapOutput) ToStringMapOutputWithContext(ctx context.Context) StringMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o IntMapOutput) MapIndex(k StringInput) IntOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) int {
-		return vs[0].(map[string]int)[vs[1].(string)]
-	}).(IntOutput)
+func (o StringMapOutput) MapIndex(k StringInput) StringOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) string {
+		return vs[0].(map[string]string)[vs[1].(string)]
+	}).(StringOutput)
 }
 
-func ToIntMap(in map[string]int) IntMap {
-	m := make(IntMap)
+func ToStringMap(in map[string]string) StringMap {
+	m := make(StringMap)
 	for k, v := range in {
-		m[k] = Int(v)
+		m[k] = String(v)
 	}
 	return m
 }
 
-func ToIntMapOutput(in map[string]IntOutput) IntMapOutput {
-	m := make(IntMap)
+func ToStringMapOutput(in map[string]StringOutput) StringMapOutput {
+	m := make(StringMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToIntMapOutput()
+	return m.ToStringMapOutput()
 }
 
-var intArrayMapType = reflect.TypeOf((*map[string][]int)(nil)).Elem()
+var stringArrayMapType = reflect.TypeOf((*map[string][]string)(nil)).Elem()
 
-// IntArrayMapInput is an input type that accepts IntArrayMap and IntArrayMapOutput values.
-type IntArrayMapInput interface {
+// StringArrayMapInput is an input type that accepts StringArrayMap and StringArrayMapOutput values.
+type StringArrayMapInput interface {
 	Input
 
-	ToIntArrayMapOutput() IntArrayMapOutput
-	ToIntArrayMapOutputWithContext(ctx context.Context) IntArrayMapOutput
+	ToStringArrayMapOutput() StringArrayMapOutput
+	ToStringArrayMapOutputWithContext(ctx context.Context) StringArrayMapOutput
 }
 
-// IntArrayMap is an input type for map[string]IntArrayInput values.
-type IntArrayMap map[string]IntArrayInput
+// StringArrayMap is an input type for map[string]StringArrayInput values.
+type StringArrayMap map[string]StringArrayInput
 
-// ElementType returns the element type of this Input (map[string][]int).
-func (IntArrayMap) ElementType() reflect.Type {
-	return intArrayMapType
+// ElementType returns the element type of this Input (map[string][]string).
+func (StringArrayMap) ElementType() reflect.Type {
+	return stringArrayMapType
 }
 
-func (in IntArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]int] {
-	return pulumix.Output[map[string][]int]{
+func (in StringArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]string] {
+	return pulumix.Output[map[string][]string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IntArrayMap) ToIntArrayMapOutput() IntArrayMapOutput {
-	return ToOutput(in).(IntArrayMapOutput)
+func (in StringArrayMap) ToStringArrayMapOutput() StringArrayMapOutput {
+	return ToOutput(in).(StringArrayMapOutput)
 }
 
-func (in IntArrayMap) ToIntArrayMapOutputWithContext(ctx context.Context) IntArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(IntArrayMapOutput)
+func (in StringArrayMap) ToStringArrayMapOutputWithContext(ctx context.Context) StringArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(StringArrayMapOutput)
 }
 
-// IntArrayMapOutput is an Output that returns map[string][]int values.
-type IntArrayMapOutput struct{ *OutputState }
+// StringArrayMapOutput is an Output that returns map[string][]string values.
+type StringArrayMapOutput struct{ *OutputState }
 
-func (IntArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (StringArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]int] {
-	return pulumix.Output[map[string][]int]{
+func (o StringArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]string] {
+	return pulumix.Output[map[string][]string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][]int).
-func (IntArrayMapOutput) ElementType() reflect.Type {
-	return intArrayMapType
+// ElementType returns the element type of this Output (map[string][]string).
+func (StringArrayMapOutput) ElementType() reflect.Type {
+	return stringArrayMapType
 }
 
-func (o IntArrayMapOutput) ToIntArrayMapOutput() IntArrayMapOutput {
+func (o StringArrayMapOutput) ToStringArrayMapOutput() StringArrayMapOutput {
 	return o
 }
 
-func (o IntArrayMapOutput) ToIntArrayMapOutputWithContext(ctx context.Context) IntArrayMapOutput {
+func (o StringArrayMapOutput) ToStringArrayMapOutputWithContext(ctx context.Context) StringArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o IntArrayMapOutput) MapIndex(k StringInput) IntArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) []int {
-		return vs[0].(map[string][]int)[vs[1].(string)]
-	}).(IntArrayOutput)
+func (o StringArrayMapOutput) MapIndex(k StringInput) StringArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []string {
+		return vs[0].(map[string][]string)[vs[1].(string)]
+	}).(StringArrayOutput)
 }
 
-func ToIntArrayMap(in map[string][]int) IntArrayMap {
-	m := make(IntArrayMap)
+func ToStringArrayMap(in map[string][]string) StringArrayMap {
+	m := make(StringArrayMap)
 	for k, v := range in {
-		m[k] = ToIntArray(v)
+		m[k] = ToStringArray(v)
 	}
 	return m
 }
 
-func ToIntArrayMapOutput(in map[string]IntArrayOutput) IntArrayMapOutput {
-	m := make(IntArrayMap)
+func ToStringArrayMapOutput(in map[string]StringArrayOutput) StringArrayMapOutput {
+	m := make(StringArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToIntArrayMapOutput()
+	return m.ToStringArrayMapOutput()
 }
 
-var intMapArrayType = reflect.TypeOf((*[]map[string]int)(nil)).Elem()
+var stringMapArrayType = reflect.TypeOf((*[]map[string]string)(nil)).Elem()
 
-// IntMapArrayInput is an input type that accepts IntMapArray and IntMapArrayOutput values.
-type IntMapArrayInput interface {
+// StringMapArrayInput is an input type that accepts StringMapArray and StringMapArrayOutput values.
+type StringMapArrayInput interface {
 	Input
 
-	ToIntMapArrayOutput() IntMapArrayOutput
-	ToIntMapArrayOutputWithContext(ctx context.Context) IntMapArrayOutput
+	ToStringMapArrayOutput() StringMapArrayOutput
+	ToStringMapArrayOutputWithContext(ctx context.Context) StringMapArrayOutput
 }
 
-// IntMapArray is an input type for []IntMapInput values.
-type IntMapArray []IntMapInput
+// StringMapArray is an input type for []StringMapInput values.
+type StringMapArray []StringMapInput
 
-// ElementType returns the element type of this Input ([]map[string]int).
-func (IntMapArray) ElementType() reflect.Type {
-	return intMapArrayType
+// ElementType returns the element type of this Input ([]map[string]string).
+func (StringMapArray) ElementType() reflect.Type {
+	return stringMapArrayType
 }
 
-func (in IntMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]int] {
-	return pulumix.Output[[]map[string]int]{
+func (in StringMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]string] {
+	return pulumix.Output[[]map[string]string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IntMapArray) ToIntMapArrayOutput() IntMapArrayOutput {
-	return ToOutput(in).(IntMapArrayOutput)
+func (in StringMapArray) ToStringMapArrayOutput() StringMapArrayOutput {
+	return ToOutput(in).(StringMapArrayOutput)
 }
 
-func (in IntMapArray) ToIntMapArrayOutputWithContext(ctx context.Context) IntMapArrayOutput {
-	return ToOutputWithContext(ctx, in).(IntMapArrayOutput)
+func (in StringMapArray) ToStringMapArrayOutputWithContext(ctx context.Context) StringMapArrayOutput {
+	return ToOutputWithContext(ctx, in).(StringMapArrayOutput)
 }
 
-// IntMapArrayOutput is an Output that returns []map[string]int values.
-type IntMapArrayOutput struct{ *OutputState }
+// StringMapArrayOutput is an Output that returns []map[string]string values.
+type StringMapArrayOutput struct{ *OutputState }
 
-func (IntMapArrayOutput) MarshalJSON() ([]byte, error) {
+func (StringMapArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]int] {
-	return pulumix.Output[[]map[string]int]{
+func (o StringMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]string] {
+	return pulumix.Output[[]map[string]string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]map[string]int).
-func (IntMapArrayOutput) ElementType() reflect.Type {
-	return intMapArrayType
+// ElementType returns the element type of this Output ([]map[string]string).
+func (StringMapArrayOutput) ElementType() reflect.Type {
+	return stringMapArrayType
 }
 
-func (o IntMapArrayOutput) ToIntMapArrayOutput() IntMapArrayOutput {
+func (o StringMapArrayOutput) ToStringMapArrayOutput() StringMapArrayOutput {
 	return o
 }
 
-func (o IntMapArrayOutput) ToIntMapArrayOutputWithContext(ctx context.Context) IntMapArrayOutput {
+func (o StringMapArrayOutput) ToStringMapArrayOutputWithContext(ctx context.Context) StringMapArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o IntMapArrayOutput) Index(i IntInput) IntMapOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) map[string]int {
-		arr := vs[0].([]map[string]int)
+func (o StringMapArrayOutput) Index(i IntInput) StringMapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]string {
+		arr := vs[0].([]map[string]string)
 		idx := vs[1].(int)
-		var ret map[string]int
+		var ret map[string]string
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(IntMapOutput)
+	}).(StringMapOutput)
 }
 
-func ToIntMapArray(in []map[string]int) IntMapArray {
-	a := make(IntMapArray, len(in))
+func ToStringMapArray(in []map[string]string) StringMapArray {
+	a := make(StringMapArray, len(in))
 	for i, v := range in {
-		a[i] = ToIntMap(v)
+		a[i] = ToStringMap(v)
 	}
 	return a
 }
 
-func ToIntMapArrayOutput(in []IntMapOutput) IntMapArrayOutput {
-	a := make(IntMapArray, len(in))
+func ToStringMapArrayOutput(in []StringMapOutput) StringMapArrayOutput {
+	a := make(StringMapArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToIntMapArrayOutput()
+	return a.ToStringMapArrayOutput()
 }
 
-var intMapMapType = reflect.TypeOf((*map[string]map[string]int)(nil)).Elem()
+var stringMapMapType = reflect.TypeOf((*map[string]map[string]string)(nil)).Elem()
 
-// IntMapMapInput is an input type that accepts IntMapMap and IntMapMapOutput values.
-type IntMapMapInput interface {
+// StringMapMapInput is an input type that accepts StringMapMap and StringMapMapOutput values.
+type StringMapMapInput interface {
 	Input
 
-	ToIntMapMapOutput() IntMapMapOutput
-	ToIntMapMapOutputWithContext(ctx context.Context) IntMapMapOutput
+	ToStringMapMapOutput() StringMapMapOutput
+	ToStringMapMapOutputWithContext(ctx context.Context) StringMapMapOutput
 }
 
-// IntMapMap is an input type for map[string]IntMapInput values.
-type IntMapMap map[string]IntMapInput
+// StringMapMap is an input type for map[string]StringMapInput values.
+type StringMapMap map[string]StringMapInput
 
-// ElementType returns the element type of this Input (map[string]map[string]int).
-func (IntMapMap) ElementType() reflect.Type {
-	return intMapMapType
+// ElementType returns the element type of this Input (map[string]map[string]string).
+func (StringMapMap) ElementType() reflect.Type {
+	return stringMapMapType
 }
 
-func (in IntMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]int] {
-	return pulumix.Output[map[string]map[string]int]{
+func (in StringMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]string] {
+	return pulumix.Output[map[string]map[string]string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IntMapMap) ToIntMapMapOutput() IntMapMapOutput {
-	return ToOutput(in).(IntMapMapOutput)
+func (in StringMapMap) ToStringMapMapOutput() StringMapMapOutput {
+	return ToOutput(in).(StringMapMapOutput)
 }
 
-func (in IntMapMap) ToIntMapMapOutputWithContext(ctx context.Context) IntMapMapOutput {
-	return ToOutputWithContext(ctx, in).(IntMapMapOutput)
+func (in StringMapMap) ToStringMapMapOutputWithContext(ctx context.Context) StringMapMapOutput {
+	return ToOutputWithContext(ctx, in).(StringMapMapOutput)
 }
 
-// IntMapMapOutput is an Output that returns map[string]map[string]int values.
-type IntMapMapOutput struct{ *OutputState }
+// StringMapMapOutput is an Output that returns map[string]map[string]string values.
+type StringMapMapOutput struct{ *OutputState }
 
-func (IntMapMapOutput) MarshalJSON() ([]byte, error) {
+func (StringMapMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]int] {
-	return pulumix.Output[map[string]map[string]int]{
+func (o StringMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]string] {
+	return pulumix.Output[map[string]map[string]string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]map[string]int).
-func (IntMapMapOutput) ElementType() reflect.Type {
-	return intMapMapType
+// ElementType returns the element type of this Output (map[string]map[string]string).
+func (StringMapMapOutput) ElementType() reflect.Type {
+	return stringMapMapType
 }
 
-func (o IntMapMapOutput) ToIntMapMapOutput() IntMapMapOutput {
+func (o StringMapMapOutput) ToStringMapMapOutput() StringMapMapOutput {
 	return o
 }
 
-func (o IntMapMapOutput) ToIntMapMapOutputWithContext(ctx context.Context) IntMapMapOutput {
+func (o StringMapMapOutput) ToStringMapMapOutputWithContext(ctx context.Context) StringMapMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o IntMapMapOutput) MapIndex(k StringInput) IntMapOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) map[string]int {
-		return vs[0].(map[string]map[string]int)[vs[1].(string)]
-	}).(IntMapOutput)
+func (o StringMapMapOutput) MapIndex(k StringInput) StringMapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]string {
+		return vs[0].(map[string]map[string]string)[vs[1].(string)]
+	}).(StringMapOutput)
 }
 
-func ToIntMapMap(in map[string]map[string]int) IntMapMap {
-	m := make(IntMapMap)
+func ToStringMapMap(in map[string]map[string]string) StringMapMap {
+	m := make(StringMapMap)
 	for k, v := range in {
-		m[k] = ToIntMap(v)
+		m[k] = ToStringMap(v)
 	}
 	return m
 }
 
-func ToIntMapMapOutput(in map[string]IntMapOutput) IntMapMapOutput {
-	m := make(IntMapMap)
+func ToStringMapMapOutput(in map[string]StringMapOutput) StringMapMapOutput {
+	m := make(StringMapMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToIntMapMapOutput()
+	return m.ToStringMapMapOutput()
 }
 
-var intArrayArrayType = reflect.TypeOf((*[][]int)(nil)).Elem()
+var stringArrayArrayType = reflect.TypeOf((*[][]string)(nil)).Elem()
 
-// IntArrayArrayInput is an input type that accepts IntArrayArray and IntArrayArrayOutput values.
-type IntArrayArrayInput interface {
+// StringArrayArrayInput is an input type that accepts StringArrayArray and StringArrayArrayOutput values.
+type StringArrayArrayInput interface {
 	Input
 
-	ToIntArrayArrayOutput() IntArrayArrayOutput
-	ToIntArrayArrayOutputWithContext(ctx context.Context) IntArrayArrayOutput
+	ToStringArrayArrayOutput() StringArrayArrayOutput
+	ToStringArrayArrayOutputWithContext(ctx context.Context) StringArrayArrayOutput
 }
 
-// IntArrayArray is an input type for []IntArrayInput values.
-type IntArrayArray []IntArrayInput
+// StringArrayArray is an input type for []StringArrayInput values.
+type StringArrayArray []StringArrayInput
 
-// ElementType returns the element type of this Input ([][]int).
-func (IntArrayArray) ElementType() reflect.Type {
-	return intArrayArrayType
+// ElementType returns the element type of this Input ([][]string).
+func (StringArrayArray) ElementType() reflect.Type {
+	return stringArrayArrayType
 }
 
-func (in IntArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]int] {
-	return pulumix.Output[[][]int]{
+func (in StringArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]string] {
+	return pulumix.Output[[][]string]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in IntArrayArray) ToIntArrayArrayOutput() IntArrayArrayOutput {
-	return ToOutput(in).(IntArrayArrayOutput)
+func (in StringArrayArray) ToStringArrayArrayOutput() StringArrayArrayOutput {
+	return ToOutput(in).(StringArrayArrayOutput)
 }
 
-func (in IntArrayArray) ToIntArrayArrayOutputWithContext(ctx context.Context) IntArrayArrayOutput {
-	return ToOutputWithContext(ctx, in).(IntArrayArrayOutput)
+func (in StringArrayArray) ToStringArrayArrayOutputWithContext(ctx context.Context) StringArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(StringArrayArrayOutput)
 }
 
-// IntArrayArrayOutput is an Output that returns [][]int values.
-type IntArrayArrayOutput struct{ *OutputState }
+// StringArrayArrayOutput is an Output that returns [][]string values.
+type StringArrayArrayOutput struct{ *OutputState }
 
-func (IntArrayArrayOutput) MarshalJSON() ([]byte, error) {
+func (StringArrayArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o IntArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]int] {
-	return pulumix.Output[[][]int]{
+func (o StringArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]string] {
+	return pulumix.Output[[][]string]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([][]int).
-func (IntArrayArrayOutput) ElementType() reflect.Type {
-	return intArrayArrayType
+// ElementType returns the element type of this Output ([][]string).
+func (StringArrayArrayOutput) ElementType() reflect.Type {
+	return stringArrayArrayType
 }
 
-func (o IntArrayArrayOutput) ToIntArrayArrayOutput() IntArrayArrayOutput {
+func (o StringArrayArrayOutput) ToStringArrayArrayOutput() StringArrayArrayOutput {
 	return o
 }
 
-func (o IntArrayArrayOutput) ToIntArrayArrayOutputWithContext(ctx context.Context) IntArrayArrayOutput {
+func (o StringArrayArrayOutput) ToStringArrayArrayOutputWithContext(ctx context.Context) StringArrayArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o IntArrayArrayOutput) Index(i IntInput) IntArrayOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) []int {
-		arr := vs[0].([][]int)
+func (o StringArrayArrayOutput) Index(i IntInput) StringArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []string {
+		arr := vs[0].([][]string)
 		idx := vs[1].(int)
-		var ret []int
+		var ret []string
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(IntArrayOutput)
+	}).(StringArrayOutput)
 }
 
-func ToIntArrayArray(in [][]int) IntArrayArray {
-	a := make(IntArrayArray, len(in))
+func ToStringArrayArray(in [][]string) StringArrayArray {
+	a := make(StringArrayArray, len(in))
 	for i, v := range in {
-		a[i] = ToIntArray(v)
+		a[i] = ToStringArray(v)
 	}
 	return a
 }
 
-func ToIntArrayArrayOutput(in []IntArrayOutput) IntArrayArrayOutput {
-	a := make(IntArrayArray, len(in))
+func ToStringArrayArrayOutput(in []StringArrayOutput) StringArrayArrayOutput {
+	a := make(StringArrayArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToIntArrayArrayOutput()
+	return a.ToStringArrayArrayOutput()
 }
 
-var stringType = reflect.TypeOf((*string)(nil)).Elem()
+var urnType = reflect.TypeOf((*URN)(nil)).Elem()
 
-// StringInput is an input type that accepts String and StringOutput values.
-type StringInput interface {
+// URNInput is an input type that accepts URN and URNOutput values.
+type URNInput interface {
 	Input
 
-	ToStringOutput() StringOutput
-	ToStringOutputWithContext(ctx context.Context) StringOutput
+	ToURNOutput() URNOutput
+	ToURNOutputWithContext(ctx context.Context) URNOutput
 
-	ToStringPtrOutput() StringPtrOutput
-	ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput
+	ToURNPtrOutput() URNPtrOutput
+	ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput
 }
 
-// String is an input type for string values.
-type String string
-
-// ElementType returns the element type of this Input (string).
-func (String) ElementType() reflect.Type {
-	return stringType
+// ElementType returns the element type of this Input (URN).
+func (URN) ElementType() reflect.Type {
+	return urnType
 }
 
-func (in String) ToOutput(ctx context.Context) pulumix.Output[string] {
-	return pulumix.Output[string]{
+func (in URN) ToOutput(ctx context.Context) pulumix.Output[URN] {
+	return pulumix.Output[URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in String) ToStringOutput() StringOutput {
-	return ToOutput(in).(StringOutput)
+func (in URN) ToURNOutput() URNOutput {
+	return ToOutput(in).(URNOutput)
 }
 
-func (in String) ToStringOutputWithContext(ctx context.Context) StringOutput {
-	return ToOutputWithContext(ctx, in).(StringOutput)
+func (in URN) ToURNOutputWithContext(ctx context.Context) URNOutput {
+	return ToOutputWithContext(ctx, in).(URNOutput)
 }
 
-func (in String) ToStringPtrOutput() StringPtrOutput {
-	return in.ToStringPtrOutputWithContext(context.Background())
+func (in URN) ToStringOutput() StringOutput {
+	return in.ToStringOutputWithContext(context.Background())
 }
 
-func (in String) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
-	return in.ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+func (in URN) ToStringOutputWithContext(ctx context.Context) StringOutput {
+	return in.ToURNOutputWithContext(ctx).ToStringOutputWithContext(ctx)
 }
 
-// StringOutput is an Output that returns string values.
-type StringOutput struct{ *OutputState }
+func (in URN) ToURNPtrOutput() URNPtrOutput {
+	return in.ToURNPtrOutputWithContext(context.Background())
+}
 
-func (StringOutput) MarshalJSON() ([]byte, error) {
+func (in URN) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
+	return in.ToURNOutputWithContext(ctx).ToURNPtrOutputWithContext(ctx)
+}
+
+// URNOutput is an Output that returns URN values.
+type URNOutput struct{ *OutputState }
+
+func (URNOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringOutput) ToOutput(ctx context.Context) pulumix.Output[string] {
-	return pulumix.Output[string]{
+func (o URNOutput) ToOutput(ctx context.Context) pulumix.Output[URN] {
+	return pulumix.Output[URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (string).
-func (StringOutput) ElementType() reflect.Type {
-	return stringType
+// ElementType returns the element type of this Output (URN).
+func (URNOutput) ElementType() reflect.Type {
+	return urnType
 }
 
-func (o StringOutput) ToStringOutput() StringOutput {
+func (o URNOutput) ToURNOutput() URNOutput {
 	return o
 }
 
-func (o StringOutput) ToStringOutputWithContext(ctx context.Context) StringOutput {
+func (o URNOutput) ToURNOutputWithContext(ctx context.Context) URNOutput {
 	return o
 }
 
-func (o StringOutput) ToStringPtrOutput() StringPtrOutput {
-	return o.ToStringPtrOutputWithContext(context.Background())
+func (o URNOutput) ToStringOutput() StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
 }
 
-func (o StringOutput) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v string) *string {
+func (o URNOutput) ToStringOutputWithContext(ctx context.Context) StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v URN) string {
+		return (string)(v)
+	}).(StringOutput)
+}
+
+func (o URNOutput) ToURNPtrOutput() URNPtrOutput {
+	return o.ToURNPtrOutputWithContext(context.Background())
+}
+
+func (o URNOutput) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v URN) *URN {
 		return &v
-	}).(StringPtrOutput)
+	}).(URNPtrOutput)
 }
 
-var stringPtrType = reflect.TypeOf((**string)(nil)).Elem()
+var uRNPtrType = reflect.TypeOf((**URN)(nil)).Elem()
 
-// StringPtrInput is an input type that accepts StringPtr and StringPtrOutput values.
-type StringPtrInput interface {
+// URNPtrInput is an input type that accepts URNPtr and URNPtrOutput values.
+type URNPtrInput interface {
 	Input
 
-	ToStringPtrOutput() StringPtrOutput
-	ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput
+	ToURNPtrOutput() URNPtrOutput
+	ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput
 }
 
-type stringPtr string
+type urnPtr URN
 
-// StringPtr is an input type for *string values.
-func StringPtr(v string) StringPtrInput {
-	return (*stringPtr)(&v)
+// URNPtr is an input type for *URN values.
+func URNPtr(v URN) URNPtrInput {
+	return (*urnPtr)(&v)
 }
-func StringPtrFromPtr(v *string) StringPtrInput {
+func URNPtrFromPtr(v *URN) URNPtrInput {
 	if v == nil {
 		return nil
 	}
-	return (*stringPtr)(v)
+	return (*urnPtr)(v)
 }
 
-// ElementType returns the element type of this Input (*string).
-func (*stringPtr) ElementType() reflect.Type {
-	return stringPtrType
+// ElementType returns the element type of this Input (*URN).
+func (*urnPtr) ElementType() reflect.Type {
+	return uRNPtrType
 }
 
-func (in *stringPtr) ToOutput(ctx context.Context) pulumix.Output[*string] {
-	return pulumix.Output[*string]{
+func (in *urnPtr) ToOutput(ctx context.Context) pulumix.Output[*URN] {
+	return pulumix.Output[*URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in *stringPtr) ToStringPtrOutput() StringPtrOutput {
-	return ToOutput(in).(StringPtrOutput)
+func (in *urnPtr) ToURNPtrOutput() URNPtrOutput {
+	return ToOutput(in).(URNPtrOutput)
 }
 
-func (in *stringPtr) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
-	return ToOutputWithContext(ctx, in).(StringPtrOutput)
+func (in *urnPtr) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
+	return ToOutputWithContext(ctx, in).(URNPtrOutput)
 }
 
-// StringPtrOutput is an Output that returns *string values.
-type StringPtrOutput struct{ *OutputState }
+// URNPtrOutput is an Output that returns *URN values.
+type URNPtrOutput struct{ *OutputState }
 
-func (StringPtrOutput) MarshalJSON() ([]byte, error) {
+func (URNPtrOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*string] {
-	return pulumix.Output[*string]{
+func (o URNPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*URN] {
+	return pulumix.Output[*URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (*string).
-func (StringPtrOutput) ElementType() reflect.Type {
-	return stringPtrType
+// ElementType returns the element type of this Output (*URN).
+func (URNPtrOutput) ElementType() reflect.Type {
+	return uRNPtrType
 }
 
-func (o StringPtrOutput) ToStringPtrOutput() StringPtrOutput {
+func (o URNPtrOutput) ToURNPtrOutput() URNPtrOutput {
 	return o
 }
 
-func (o StringPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) StringPtrOutput {
+func (o URNPtrOutput) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
 	return o
 }
 
 // Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
-func (o StringPtrOutput) Elem() StringOutput {
-	return o.ApplyT(func(v *string) string {
-		var ret string
+func (o URNPtrOutput) Elem() URNOutput {
+	return o.ApplyT(func(v *URN) URN {
+		var ret URN
 		if v != nil {
 			ret = *v
 		}
 		return ret
-	}).(StringOutput)
+	}).(URNOutput)
 }
 
-var stringArrayType = reflect.TypeOf((*[]string)(nil)).Elem()
+var uRNArrayType = reflect.TypeOf((*[]URN)(nil)).Elem()
 
-// StringArrayInput is an input type that accepts StringArray and StringArrayOutput values.
-type StringArrayInput interface {
+// URNArrayInput is an input type that accepts URNArray and URNArrayOutput values.
+type URNArrayInput interface {
 	Input
 
-	ToStringArrayOutput() StringArrayOutput
-	ToStringArrayOutputWithContext(ctx context.Context) StringArrayOutput
+	ToURNArrayOutput() URNArrayOutput
+	ToURNArrayOutputWithContext(ctx context.Context) URNArrayOutput
 }
 
-// StringArray is an input type for []StringInput values.
-type StringArray []StringInput
+// URNArray is an input type for []URNInput values.
+type URNArray []URNInput
 
-// ElementType returns the element type of this Input ([]string).
-func (StringArray) ElementType() reflect.Type {
-	return stringArrayType
+// ElementType returns the element type of this Input ([]URN).
+func (URNArray) ElementType() reflect.Type {
+	return uRNArrayType
 }
 
-func (in StringArray) ToOutput(ctx context.Context) pulumix.Output[[]string] {
-	return pulumix.Output[[]string]{
+func (in URNArray) ToOutput(ctx context.Context) pulumix.Output[[]URN] {
+	return pulumix.Output[[]URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in StringArray) ToStringArrayOutput() StringArrayOutput {
-	return ToOutput(in).(StringArrayOutput)
+func (in URNArray) ToURNArrayOutput() URNArrayOutput {
+	return ToOutput(in).(URNArrayOutput)
 }
 
-func (in StringArray) ToStringArrayOutputWithContext(ctx context.Context) StringArrayOutput {
-	return ToOutputWithContext(ctx, in).(StringArrayOutput)
+func (in URNArray) ToURNArrayOutputWithContext(ctx context.Context) URNArrayOutput {
+	return ToOutputWithContext(ctx, in).(URNArrayOutput)
 }
 
-// StringArrayOutput is an Output that returns []string values.
-type StringArrayOutput struct{ *OutputState }
+// URNArrayOutput is an Output that returns []URN values.
+type URNArrayOutput struct{ *OutputState }
 
-func (StringArrayOutput) MarshalJSON() ([]byte, error) {
+func (URNArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]string] {
-	return pulumix.Output[[]string]{
+func (o URNArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]URN] {
+	return pulumix.Output[[]URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]string).
-func (StringArrayOutput) ElementType() reflect.Type {
-	return stringArrayType
+// ElementType returns the element type of this Output ([]URN).
+func (URNArrayOutput) ElementType() reflect.Type {
+	return uRNArrayType
 }
 
-func (o StringArrayOutput) ToStringArrayOutput() StringArrayOutput {
+func (o URNArrayOutput) ToURNArrayOutput() URNArrayOutput {
 	return o
 }
 
-func (o StringArrayOutput) ToStringArrayOutputWithContext(ctx context.Context) StringArrayOutput {
+func (o URNArrayOutput) ToURNArrayOutputWithContext(ctx context.Context) URNArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o StringArrayOutput) Index(i IntInput) StringOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) string {
-		arr := vs[0].([]string)
+func (o URNArrayOutput) Index(i IntInput) URNOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) URN {
+		arr := vs[0].([]URN)
 		idx := vs[1].(int)
-		var ret string
+		var ret URN
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(StringOutput)
+	}).(URNOutput)
 }
 
-func ToStringArray(in []string) StringArray {
-	a := make(StringArray, len(in))
+func ToURNArray(in []URN) URNArray {
+	a := make(URNArray, len(in))
 	for i, v := range in {
-		a[i] = String(v)
+		a[i] = (v)
 	}
 	return a
 }
 
-func ToStringArrayOutput(in []StringOutput) StringArrayOutput {
-	a := make(StringArray, len(in))
+func ToURNArrayOutput(in []URNOutput) URNArrayOutput {
+	a := make(URNArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToStringArrayOutput()
+	return a.ToURNArrayOutput()
 }
 
-var stringMapType = reflect.TypeOf((*map[string]string)(nil)).Elem()
+var uRNMapType = reflect.TypeOf((*map[string]URN)(nil)).Elem()
 
-// StringMapInput is an input type that accepts StringMap and StringMapOutput values.
-type StringMapInput interface {
+// URNMapInput is an input type that accepts URNMap and URNMapOutput values.
+type URNMapInput interface {
 	Input
 
-	ToStringMapOutput() StringMapOutput
-	ToStringMapOutputWithContext(ctx context.Context) StringMapOutput
+	ToURNMapOutput() URNMapOutput
+	ToURNMapOutputWithContext(ctx context.Context) URNMapOutput
 }
 
-// StringMap is an input type for map[string]StringInput values.
-type StringMap map[string]StringInput
+// URNMap is an input type for map[string]URNInput values.
+type URNMap map[string]URNInput
 
-// ElementType returns the element type of this Input (map[string]string).
-func (StringMap) ElementType() reflect.Type {
-	return stringMapType
+// ElementType returns the element type of this Input (map[string]URN).
+func (URNMap) ElementType() reflect.Type {
+	return uRNMapType
 }
 
-func (in StringMap) ToOutput(ctx context.Context) pulumix.Output[map[string]string] {
-	return pulumix.Output[map[string]string]{
+func (in URNMap) ToOutput(ctx context.Context) pulumix.Output[map[string]URN] {
+	return pulumix.Output[map[string]URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in StringMap) ToStringMapOutput() StringMapOutput {
-	return ToOutput(in).(StringMapOutput)
+func (in URNMap) ToURNMapOutput() URNMapOutput {
+	return ToOutput(in).(URNMapOutput)
 }
 
-func (in StringMap) ToStringMapOutputWithContext(ctx context.Context) StringMapOutput {
-	return ToOutputWithContext(ctx, in).(StringMapOutput)
+func (in URNMap) ToURNMapOutputWithContext(ctx context.Context) URNMapOutput {
+	return ToOutputWithContext(ctx, in).(URNMapOutput)
 }
 
-// StringMapOutput is an Output that returns map[string]string values.
-type StringMapOutput struct{ *OutputState }
+// URNMapOutput is an Output that returns map[string]URN values.
+type URNMapOutput struct{ *OutputState }
 
-func (StringMapOutput) MarshalJSON() ([]byte, error) {
+func (URNMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]string] {
-	return pulumix.Output[map[string]string]{
+func (o URNMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]URN] {
+	return pulumix.Output[map[string]URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]string).
-func (StringMapOutput) ElementType() reflect.Type {
-	return stringMapType
+// ElementType returns the element type of this Output (map[string]URN).
+func (URNMapOutput) ElementType() reflect.Type {
+	return uRNMapType
 }
 
-func (o StringMapOutput) ToStringMapOutput() StringMapOutput {
+func (o URNMapOutput) ToURNMapOutput() URNMapOutput {
 	return o
 }
 
-func (o StringMapOutput) ToStringMapOutputWithContext(ctx context.Context) StringMapOutput {
+func (o URNMapOutput) ToURNMapOutputWithContext(ctx context.Context) URNMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o StringMapOutput) MapIndex(k StringInput) StringOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) string {
-		return vs[0].(map[string]string)[vs[1].(string)]
-	}).(StringOutput)
+func (o URNMapOutput) MapIndex(k StringInput) URNOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) URN {
+		return vs[0].(map[string]URN)[vs[1].(string)]
+	}).(URNOutput)
 }
 
-func ToStringMap(in map[string]string) StringMap {
-	m := make(StringMap)
+func ToURNMap(in map[string]URN) URNMap {
+	m := make(URNMap)
 	for k, v := range in {
-		m[k] = String(v)
+		m[k] = (v)
 	}
 	return m
 }
 
-func ToStringMapOutput(in map[string]StringOutput) StringMapOutput {
-	m := make(StringMap)
+func ToURNMapOutput(in map[string]URNOutput) URNMapOutput {
+	m := make(URNMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToStringMapOutput()
+	return m.ToURNMapOutput()
 }
 
-var stringArrayMapType = reflect.TypeOf((*map[string][]string)(nil)).Elem()
+var uRNArrayMapType = reflect.TypeOf((*map[string][]URN)(nil)).Elem()
 
-// StringArrayMapInput is an input type that accepts StringArrayMap and StringArrayMapOutput values.
-type StringArrayMapInput interface {
+// URNArrayMapInput is an input type that accepts URNArrayMap and URNArrayMapOutput values.
+type URNArrayMapInput interface {
 	Input
 
-	ToStringArrayMapOutput() StringArrayMapOutput
-	ToStringArrayMapOutputWithContext(ctx context.Context) StringArrayMapOutput
+	ToURNArrayMapOutput() URNArrayMapOutput
+	ToURNArrayMapOutputWithContext(ctx context.Context) URNArrayMapOutput
 }
 
-// StringArrayMap is an input type for map[string]StringArrayInput values.
-type StringArrayMap map[string]StringArrayInput
+// URNArrayMap is an input type for map[string]URNArrayInput values.
+type URNArrayMap map[string]URNArrayInput
 
-// ElementType returns the element type of this Input (map[string][]string).
-func (StringArrayMap) ElementType() reflect.Type {
-	return stringArrayMapType
+// ElementType returns the element type of this Input (map[string][]URN).
+func (URNArrayMap) ElementType() reflect.Type {
+	return uRNArrayMapType
 }
 
-func (in StringArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]string] {
-	return pulumix.Output[map[string][]string]{
+func (in URNArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]URN] {
+	return pulumix.Output[map[string][]URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in StringArrayMap) ToStringArrayMapOutput() StringArrayMapOutput {
-	return ToOutput(in).(StringArrayMapOutput)
+func (in URNArrayMap) ToURNArrayMapOutput() URNArrayMapOutput {
+	return ToOutput(in).(URNArrayMapOutput)
 }
 
-func (in StringArrayMap) ToStringArrayMapOutputWithContext(ctx context.Context) StringArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(StringArrayMapOutput)
+func (in URNArrayMap) ToURNArrayMapOutputWithContext(ctx context.Context) URNArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(URNArrayMapOutput)
 }
 
-// StringArrayMapOutput is an Output that returns map[string][]string values.
-type StringArrayMapOutput struct{ *OutputState }
+// URNArrayMapOutput is an Output that returns map[string][]URN values.
+type URNArrayMapOutput struct{ *OutputState }
 
-func (StringArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (URNArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]string] {
-	return pulumix.Output[map[string][]string]{
+func (o URNArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]URN] {
+	return pulumix.Output[map[string][]URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][]string).
-func (StringArrayMapOutput) ElementType() reflect.Type {
-	return stringArrayMapType
+// ElementType returns the element type of this Output (map[string][]URN).
+func (URNArrayMapOutput) ElementType() reflect.Type {
+	return uRNArrayMapType
 }
 
-func (o StringArrayMapOutput) ToStringArrayMapOutput() StringArrayMapOutput {
+func (o URNArrayMapOutput) ToURNArrayMapOutput() URNArrayMapOutput {
 	return o
 }
 
-func (o StringArrayMapOutput) ToStringArrayMapOutputWithContext(ctx context.Context) StringArrayMapOutput {
+func (o URNArrayMapOutput) ToURNArrayMapOutputWithContext(ctx context.Context) URNArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o StringArrayMapOutput) MapIndex(k StringInput) StringArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) []string {
-		return vs[0].(map[string][]string)[vs[1].(string)]
-	}).(StringArrayOutput)
+func (o URNArrayMapOutput) MapIndex(k StringInput) URNArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []URN {
+		return vs[0].(map[string][]URN)[vs[1].(string)]
+	}).(URNArrayOutput)
 }
 
-func ToStringArrayMap(in map[string][]string) StringArrayMap {
-	m := make(StringArrayMap)
+func ToURNArrayMap(in map[string][]URN) URNArrayMap {
+	m := make(URNArrayMap)
 	for k, v := range in {
-		m[k] = ToStringArray(v)
+		m[k] = ToURNArray(v)
 	}
 	return m
 }
 
-func ToStringArrayMapOutput(in map[string]StringArrayOutput) StringArrayMapOutput {
-	m := make(StringArrayMap)
+func ToURNArrayMapOutput(in map[string]URNArrayOutput) URNArrayMapOutput {
+	m := make(URNArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToStringArrayMapOutput()
+	return m.ToURNArrayMapOutput()
 }
 
-var stringMapArrayType = reflect.TypeOf((*[]map[string]string)(nil)).Elem()
+var uRNMapArrayType = reflect.TypeOf((*[]map[string]URN)(nil)).Elem()
 
-// StringMapArrayInput is an input type that accepts StringMapArray and StringMapArrayOutput values.
-type StringMapArrayInput interface {
+// URNMapArrayInput is an input type that accepts URNMapArray and URNMapArrayOutput values.
+type URNMapArrayInput interface {
 	Input
 
-	ToStringMapArrayOutput() StringMapArrayOutput
-	ToStringMapArrayOutputWithContext(ctx context.Context) StringMapArrayOutput
+	ToURNMapArrayOutput() URNMapArrayOutput
+	ToURNMapArrayOutputWithContext(ctx context.Context) URNMapArrayOutput
 }
 
-// StringMapArray is an input type for []StringMapInput values.
-type StringMapArray []StringMapInput
+// URNMapArray is an input type for []URNMapInput values.
+type URNMapArray []URNMapInput
 
-// ElementType returns the element type of this Input ([]map[string]string).
-func (StringMapArray) ElementType() reflect.Type {
-	return stringMapArrayType
+// ElementType returns the element type of this Input ([]map[string]URN).
+func (URNMapArray) ElementType() reflect.Type {
+	return uRNMapArrayType
 }
 
-func (in StringMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]string] {
-	return pulumix.Output[[]map[string]string]{
+func (in URNMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]URN] {
+	return pulumix.Output[[]map[string]URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in StringMapArray) ToStringMapArrayOutput() StringMapArrayOutput {
-	return ToOutput(in).(StringMapArrayOutput)
+func (in URNMapArray) ToURNMapArrayOutput() URNMapArrayOutput {
+	return ToOutput(in).(URNMapArrayOutput)
 }
 
-func (in StringMapArray) ToStringMapArrayOutputWithContext(ctx context.Context) StringMapArrayOutput {
-	return ToOutputWithContext(ctx, in).(StringMapArrayOutput)
+func (in URNMapArray) ToURNMapArrayOutputWithContext(ctx context.Context) URNMapArrayOutput {
+	return ToOutputWithContext(ctx, in).(URNMapArrayOutput)
 }
 
-// StringMapArrayOutput is an Output that returns []map[string]string values.
-type StringMapArrayOutput struct{ *OutputState }
+// URNMapArrayOutput is an Output that returns []map[string]URN values.
+type URNMapArrayOutput struct{ *OutputState }
 
-func (StringMapArrayOutput) MarshalJSON() ([]byte, error) {
+func (URNMapArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]string] {
-	return pulumix.Output[[]map[string]string]{
+func (o URNMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]URN] {
+	return pulumix.Output[[]map[string]URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]map[string]string).
-func (StringMapArrayOutput) ElementType() reflect.Type {
-	return stringMapArrayType
+// ElementType returns the element type of this Output ([]map[string]URN).
+func (URNMapArrayOutput) ElementType() reflect.Type {
+	return uRNMapArrayType
 }
 
-func (o StringMapArrayOutput) ToStringMapArrayOutput() StringMapArrayOutput {
+func (o URNMapArrayOutput) ToURNMapArrayOutput() URNMapArrayOutput {
 	return o
 }
 
-func (o StringMapArrayOutput) ToStringMapArrayOutputWithContext(ctx context.Context) StringMapArrayOutput {
+func (o URNMapArrayOutput) ToURNMapArrayOutputWithContext(ctx context.Context) URNMapArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o StringMapArrayOutput) Index(i IntInput) StringMapOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) map[string]string {
-		arr := vs[0].([]map[string]string)
+func (o URNMapArrayOutput) Index(i IntInput) URNMapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]URN {
+		arr := vs[0].([]map[string]URN)
 		idx := vs[1].(int)
-		var ret map[string]string
+		var ret map[string]URN
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(StringMapOutput)
+	}).(URNMapOutput)
 }
 
-func ToStringMapArray(in []map[string]string) StringMapArray {
-	a := make(StringMapArray, len(in))
+func ToURNMapArray(in []map[string]URN) URNMapArray {
+	a := make(URNMapArray, len(in))
 	for i, v := range in {
-		a[i] = ToStringMap(v)
+		a[i] = ToURNMap(v)
 	}
 	return a
 }
 
-func ToStringMapArrayOutput(in []StringMapOutput) StringMapArrayOutput {
-	a := make(StringMapArray, len(in))
+func ToURNMapArrayOutput(in []URNMapOutput) URNMapArrayOutput {
+	a := make(URNMapArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToStringMapArrayOutput()
+	return a.ToURNMapArrayOutput()
 }
 
-var stringMapMapType = reflect.TypeOf((*map[string]map[string]string)(nil)).Elem()
+var uRNMapMapType = reflect.TypeOf((*map[string]map[string]URN)(nil)).Elem()
 
-// StringMapMapInput is an input type that accepts StringMapMap and StringMapMapOutput values.
-type StringMapMapInput interface {
+// URNMapMapInput is an input type that accepts URNMapMap and URNMapMapOutput values.
+type URNMapMapInput interface {
 	Input
 
-	ToStringMapMapOutput() StringMapMapOutput
-	ToStringMapMapOutputWithContext(ctx context.Context) StringMapMapOutput
+	ToURNMapMapOutput() URNMapMapOutput
+	ToURNMapMapOutputWithContext(ctx context.Context) URNMapMapOutput
 }
 
-// StringMapMap is an input type for map[string]StringMapInput values.
-type StringMapMap map[string]StringMapInput
+// URNMapMap is an input type for map[string]URNMapInput values.
+type URNMapMap map[string]URNMapInput
 
-// ElementType returns the element type of this Input (map[string]map[string]string).
-func (StringMapMap) ElementType() reflect.Type {
-	return stringMapMapType
+// ElementType returns the element type of this Input (map[string]map[string]URN).
+func (URNMapMap) ElementType() reflect.Type {
+	return uRNMapMapType
 }
 
-func (in StringMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]string] {
-	return pulumix.Output[map[string]map[string]string]{
+func (in URNMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]URN] {
+	return pulumix.Output[map[string]map[string]URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in StringMapMap) ToStringMapMapOutput() StringMapMapOutput {
-	return ToOutput(in).(StringMapMapOutput)
+func (in URNMapMap) ToURNMapMapOutput() URNMapMapOutput {
+	return ToOutput(in).(URNMapMapOutput)
 }
 
-func (in StringMapMap) ToStringMapMapOutputWithContext(ctx context.Context) StringMapMapOutput {
-	return ToOutputWithContext(ctx, in).(StringMapMapOutput)
+func (in URNMapMap) ToURNMapMapOutputWithContext(ctx context.Context) URNMapMapOutput {
+	return ToOutputWithContext(ctx, in).(URNMapMapOutput)
 }
 
-// StringMapMapOutput is an Output that returns map[string]map[string]string values.
-type StringMapMapOutput struct{ *OutputState }
+// URNMapMapOutput is an Output that returns map[string]map[string]URN values.
+type URNMapMapOutput struct{ *OutputState }
 
-func (StringMapMapOutput) MarshalJSON() ([]byte, error) {
+func (URNMapMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]string] {
-	return pulumix.Output[map[string]map[string]string]{
+func (o URNMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]URN] {
+	return pulumix.Output[map[string]map[string]URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]map[string]string).
-func (StringMapMapOutput) ElementType() reflect.Type {
-	return stringMapMapType
+// ElementType returns the element type of this Output (map[string]map[string]URN).
+func (URNMapMapOutput) ElementType() reflect.Type {
+	return uRNMapMapType
 }
 
-func (o StringMapMapOutput) ToStringMapMapOutput() StringMapMapOutput {
+func (o URNMapMapOutput) ToURNMapMapOutput() URNMapMapOutput {
 	return o
 }
 
-func (o StringMapMapOutput) ToStringMapMapOutputWithContext(ctx context.Context) StringMapMapOutput {
+func (o URNMapMapOutput) ToURNMapMapOutputWithContext(ctx context.Context) URNMapMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o StringMapMapOutput) MapIndex(k StringInput) StringMapOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) map[string]string {
-		return vs[0].(map[string]map[string]string)[vs[1].(string)]
-	}).(StringMapOutput)
+func (o URNMapMapOutput) MapIndex(k StringInput) URNMapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]URN {
+		return vs[0].(map[string]map[string]URN)[vs[1].(string)]
+	}).(URNMapOutput)
 }
 
-func ToStringMapMap(in map[string]map[string]string) StringMapMap {
-	m := make(StringMapMap)
+func ToURNMapMap(in map[string]map[string]URN) URNMapMap {
+	m := make(URNMapMap)
 	for k, v := range in {
-		m[k] = ToStringMap(v)
+		m[k] = ToURNMap(v)
 	}
 	return m
 }
 
-func ToStringMapMapOutput(in map[string]StringMapOutput) StringMapMapOutput {
-	m := make(StringMapMap)
+func ToURNMapMapOutput(in map[string]URNMapOutput) URNMapMapOutput {
+	m := make(URNMapMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToStringMapMapOutput()
+	return m.ToURNMapMapOutput()
 }
 
-var stringArrayArrayType = reflect.TypeOf((*[][]string)(nil)).Elem()
+var uRNArrayArrayType = reflect.TypeOf((*[][]URN)(nil)).Elem()
 
-// StringArrayArrayInput is an input type that accepts StringArrayArray and StringArrayArrayOutput values.
-type StringArrayArrayInput interface {
+// URNArrayArrayInput is an input type that accepts URNArrayArray and URNArrayArrayOutput values.
+type URNArrayArrayInput interface {
 	Input
 
-	ToStringArrayArrayOutput() StringArrayArrayOutput
-	ToStringArrayArrayOutputWithContext(ctx context.Context) StringArrayArrayOutput
+	ToURNArrayArrayOutput() URNArrayArrayOutput
+	ToURNArrayArrayOutputWithContext(ctx context.Context) URNArrayArrayOutput
 }
 
-// StringArrayArray is an input type for []StringArrayInput values.
-type StringArrayArray []StringArrayInput
+// URNArrayArray is an input type for []URNArrayInput values.
+type URNArrayArray []URNArrayInput
 
-// ElementType returns the element type of this Input ([][]string).
-func (StringArrayArray) ElementType() reflect.Type {
-	return stringArrayArrayType
+// ElementType returns the element type of this Input ([][]URN).
+func (URNArrayArray) ElementType() reflect.Type {
+	return uRNArrayArrayType
 }
 
-func (in StringArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]string] {
-	return pulumix.Output[[][]string]{
+func (in URNArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]URN] {
+	return pulumix.Output[[][]URN]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in StringArrayArray) ToStringArrayArrayOutput() StringArrayArrayOutput {
-	return ToOutput(in).(StringArrayArrayOutput)
+func (in URNArrayArray) ToURNArrayArrayOutput() URNArrayArrayOutput {
+	return ToOutput(in).(URNArrayArrayOutput)
 }
 
-func (in StringArrayArray) ToStringArrayArrayOutputWithContext(ctx context.Context) StringArrayArrayOutput {
-	return ToOutputWithContext(ctx, in).(StringArrayArrayOutput)
+func (in URNArrayArray) ToURNArrayArrayOutputWithContext(ctx context.Context) URNArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(URNArrayArrayOutput)
 }
 
-// StringArrayArrayOutput is an Output that returns [][]string values.
-type StringArrayArrayOutput struct{ *OutputState }
+// URNArrayArrayOutput is an Output that returns [][]URN values.
+type URNArrayArrayOutput struct{ *OutputState }
 
-func (StringArrayArrayOutput) MarshalJSON() ([]byte, error) {
+func (URNArrayArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o StringArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]string] {
-	return pulumix.Output[[][]string]{
+func (o URNArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]URN] {
+	return pulumix.Output[[][]URN]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([][]string).
-func (StringArrayArrayOutput) ElementType() reflect.Type {
-	return stringArrayArrayType
+// ElementType returns the element type of this Output ([][]URN).
+func (URNArrayArrayOutput) ElementType() reflect.Type {
+	return uRNArrayArrayType
 }
 
-func (o StringArrayArrayOutput) ToStringArrayArrayOutput() StringArrayArrayOutput {
+func (o URNArrayArrayOutput) ToURNArrayArrayOutput() URNArrayArrayOutput {
 	return o
 }
 
-func (o StringArrayArrayOutput) ToStringArrayArrayOutputWithContext(ctx context.Context) StringArrayArrayOutput {
+func (o URNArrayArrayOutput) ToURNArrayArrayOutputWithContext(ctx context.Context) URNArrayArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o StringArrayArrayOutput) Index(i IntInput) StringArrayOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) []string {
-		arr := vs[0].([][]string)
+func (o URNArrayArrayOutput) Index(i IntInput) URNArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []URN {
+		arr := vs[0].([][]URN)
 		idx := vs[1].(int)
-		var ret []string
+		var ret []URN
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(StringArrayOutput)
+	}).(URNArrayOutput)
 }
 
-func ToStringArrayArray(in [][]string) StringArrayArray {
-	a := make(StringArrayArray, len(in))
+func ToURNArrayArray(in [][]URN) URNArrayArray {
+	a := make(URNArrayArray, len(in))
 	for i, v := range in {
-		a[i] = ToStringArray(v)
+		a[i] = ToURNArray(v)
 	}
 	return a
 }
 
-func ToStringArrayArrayOutput(in []StringArrayOutput) StringArrayArrayOutput {
-	a := make(StringArrayArray, len(in))
+func ToURNArrayArrayOutput(in []URNArrayOutput) URNArrayArrayOutput {
+	a := make(URNArrayArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToStringArrayArrayOutput()
+	return a.ToURNArrayArrayOutput()
 }
 
-var urnType = reflect.TypeOf((*URN)(nil)).Elem()
+var uint64Type = reflect.TypeOf((*uint64)(nil)).Elem()
 
-// URNInput is an input type that accepts URN and URNOutput values.
-type URNInput interface {
+// Uint64Input is an input type that accepts Uint64 and Uint64Output values.
+type Uint64Input interface {
 	Input
 
-	ToURNOutput() URNOutput
-	ToURNOutputWithContext(ctx context.Context) URNOutput
+	ToUint64Output() Uint64Output
+	ToUint64OutputWithContext(ctx context.Context) Uint64Output
 
-	ToURNPtrOutput() URNPtrOutput
-	ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput
+	ToUint64PtrOutput() Uint64PtrOutput
+	ToUint64PtrOutputWithContext(ctx context.Context) Uint64PtrOutput
 }
 
-// ElementType returns the element type of this Input (URN).
-func (URN) ElementType() reflect.Type {
-	return urnType
+// Uint64 is an input type for uint64 values.
+type Uint64 uint64
+
+// ElementType returns the element type of this Input (uint64).
+func (Uint64) ElementType() reflect.Type {
+	return uint64Type
 }
 
-func (in URN) ToOutput(ctx context.Context) pulumix.Output[URN] {
-	return pulumix.Output[URN]{
+func (in Uint64) ToOutput(ctx context.Context) pulumix.Output[uint64] {
+	return pulumix.Output[uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URN) ToURNOutput() URNOutput {
-	return ToOutput(in).(URNOutput)
-}
-
-func (in URN) ToURNOutputWithContext(ctx context.Context) URNOutput {
-	return ToOutputWithContext(ctx, in).(URNOutput)
-}
-
-func (in URN) ToStringOutput() StringOutput {
-	return in.ToStringOutputWithContext(context.Background())
+func (in Uint64) ToUint64Output() Uint64Output {
+	return ToOutput(in).(Uint64Output)
 }
 
-func (in URN) ToStringOutputWithContext(ctx context.Context) StringOutput {
-	return in.ToURNOutputWithContext(ctx).ToStringOutputWithContext(ctx)
+func (in Uint64) ToUint64OutputWithContext(ctx context.Context) Uint64Output {
+	return ToOutputWithContext(ctx, in).(Uint64Output)
 }
 
-func (in URN) ToURNPtrOutput() URNPtrOutput {
-	return in.ToURNPtrOutputWithContext(context.Background())
+func (in Uint64) ToUint64PtrOutput() Uint64PtrOutput {
+	return in.ToUint64PtrOutputWithContext(context.Background())
 }
 
-func (in URN) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
-	return in.ToURNOutputWithContext(ctx).ToURNPtrOutputWithContext(ctx)
+func (in Uint64) ToUint64PtrOutputWithContext(ctx context.Context) Uint64PtrOutput {
+	return in.ToUint64OutputWithContext(ctx).ToUint64PtrOutputWithContext(ctx)
 }
 
-// URNOutput is an Output that returns URN values.
-type URNOutput struct{ *OutputState }
+// Uint64Output is an Output that returns uint64 values.
+type Uint64Output struct{ *OutputState }
 
-func (URNOutput) MarshalJSON() ([]byte, error) {
+func (Uint64Output) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNOutput) ToOutput(ctx context.Context) pulumix.Output[URN] {
-	return pulumix.Output[URN]{
+func (o Uint64Output) ToOutput(ctx context.Context) pulumix.Output[uint64] {
+	return pulumix.Output[uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (URN).
-func (URNOutput) ElementType() reflect.Type {
-	return urnType
+// ElementType returns the element type of this Output (uint64).
+func (Uint64Output) ElementType() reflect.Type {
+	return uint64Type
 }
 
-func (o URNOutput) ToURNOutput() URNOutput {
+func (o Uint64Output) ToUint64Output() Uint64Output {
 	return o
 }
 
-func (o URNOutput) ToURNOutputWithContext(ctx context.Context) URNOutput {
+func (o Uint64Output) ToUint64OutputWithContext(ctx context.Context) Uint64Output {
 	return o
 }
 
-func (o URNOutput) ToStringOutput() StringOutput {
-	return o.ToStringOutputWithContext(context.Background())
-}
-
-func (o URNOutput) ToStringOutputWithContext(ctx context.Context) StringOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v URN) string {
-		return (string)(v)
-	}).(StringOutput)
-}
-
-func (o URNOutput) ToURNPtrOutput() URNPtrOutput {
-	return o.ToURNPtrOutputWithContext(context.Background())
+func (o Uint64Output) ToUint64PtrOutput() Uint64PtrOutput {
+	return o.ToUint64PtrOutputWithContext(context.Background())
 }
 
-func (o URNOutput) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v URN) *URN {
+func (o Uint64Output) ToUint64PtrOutputWithContext(ctx context.Context) Uint64PtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v uint64) *uint64 {
 		return &v
-	}).(URNPtrOutput)
+	}).(Uint64PtrOutput)
 }
 
-var uRNPtrType = reflect.TypeOf((**URN)(nil)).Elem()
+var uint64PtrType = reflect.TypeOf((**uint64)(nil)).Elem()
 
-// URNPtrInput is an input type that accepts URNPtr and URNPtrOutput values.
-type URNPtrInput interface {
+// Uint64PtrInput is an input type that accepts Uint64Ptr and Uint64PtrOutput values.
+type Uint64PtrInput interface {
 	Input
 
-	ToURNPtrOutput() URNPtrOutput
-	ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput
+	ToUint64PtrOutput() Uint64PtrOutput
+	ToUint64PtrOutputWithContext(ctx context.Context) Uint64PtrOutput
 }
 
-type urnPtr URN
+type uint64Ptr uint64
 
-// URNPtr is an input type for *URN values.
-func URNPtr(v URN) URNPtrInput {
-	return (*urnPtr)(&v)
+// Uint64Ptr is an input type for *uint64 values.
+func Uint64Ptr(v uint64) Uint64PtrInput {
+	return (*uint64Ptr)(&v)
 }
-func URNPtrFromPtr(v *URN) URNPtrInput {
+func Uint64PtrFromPtr(v *uint64) Uint64PtrInput {
 	if v == nil {
 		return nil
 	}
-	return (*urnPtr)(v)
+	return (*uint64Ptr)(v)
 }
 
-// ElementType returns the element type of this Input (*URN).
-func (*urnPtr) ElementType() reflect.Type {
-	return uRNPtrType
+// ElementType returns the element type of this Input (*uint64).
+func (*uint64Ptr) ElementType() reflect.Type {
+	return uint64PtrType
 }
 
-func (in *urnPtr) ToOutput(ctx context.Context) pulumix.Output[*URN] {
-	return pulumix.Output[*URN]{
+func (in *uint64Ptr) ToOutput(ctx context.Context) pulumix.Output[*uint64] {
+	return pulumix.Output[*uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in *urnPtr) ToURNPtrOutput() URNPtrOutput {
-	return ToOutput(in).(URNPtrOutput)
+func (in *uint64Ptr) ToUint64PtrOutput() Uint64PtrOutput {
+	return ToOutput(in).(Uint64PtrOutput)
 }
 
-func (in *urnPtr) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
-	return ToOutputWithContext(ctx, in).(URNPtrOutput)
+func (in *uint64Ptr) ToUint64PtrOutputWithContext(ctx context.Context) Uint64PtrOutput {
+	return ToOutputWithContext(ctx, in).(Uint64PtrOutput)
 }
 
-// URNPtrOutput is an Output that returns *URN values.
-type URNPtrOutput struct{ *OutputState }
+// Uint64PtrOutput is an Output that returns *uint64 values.
+type Uint64PtrOutput struct{ *OutputState }
 
-func (URNPtrOutput) MarshalJSON() ([]byte, error) {
+func (Uint64PtrOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*URN] {
-	return pulumix.Output[*URN]{
+func (o Uint64PtrOutput) ToOutput(ctx context.Context) pulumix.Output[*uint64] {
+	return pulumix.Output[*uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (*URN).
-func (URNPtrOutput) ElementType() reflect.Type {
-	return uRNPtrType
+// ElementType returns the element type of this Output (*uint64).
+func (Uint64PtrOutput) ElementType() reflect.Type {
+	return uint64PtrType
 }
 
-func (o URNPtrOutput) ToURNPtrOutput() URNPtrOutput {
+func (o Uint64PtrOutput) ToUint64PtrOutput() Uint64PtrOutput {
 	return o
 }
 
-func (o URNPtrOutput) ToURNPtrOutputWithContext(ctx context.Context) URNPtrOutput {
+func (o Uint64PtrOutput) ToUint64PtrOutputWithContext(ctx context.Context) Uint64PtrOutput {
 	return o
 }
 
 // Elem dereferences the pointer value or returns the zero value of the approporiate type if the pointer is nil.
-func (o URNPtrOutput) Elem() URNOutput {
-	return o.ApplyT(func(v *URN) URN {
-		var ret URN
+func (o Uint64PtrOutput) Elem() Uint64Output {
+	return o.ApplyT(func(v *uint64) uint64 {
+		var ret uint64
 		if v != nil {
 			ret = *v
 		}
 		return ret
-	}).(URNOutput)
+	}).(Uint64Output)
 }
 
-var uRNArrayType = reflect.TypeOf((*[]URN)(nil)).Elem()
+var uint64ArrayType = reflect.TypeOf((*[]uint64)(nil)).Elem()
 
-// URNArrayInput is an input type that accepts URNArray and URNArrayOutput values.
-type URNArrayInput interface {
+// Uint64ArrayInput is an input type that accepts Uint64Array and Uint64ArrayOutput values.
+type Uint64ArrayInput interface {
 	Input
 
-	ToURNArrayOutput() URNArrayOutput
-	ToURNArrayOutputWithContext(ctx context.Context) URNArrayOutput
+	ToUint64ArrayOutput() Uint64ArrayOutput
+	ToUint64ArrayOutputWithContext(ctx context.Context) Uint64ArrayOutput
 }
 
-// URNArray is an input type for []URNInput values.
-type URNArray []URNInput
+// Uint64Array is an input type for []Uint64Input values.
+type Uint64Array []Uint64Input
 
-// ElementType returns the element type of this Input ([]URN).
-func (URNArray) ElementType() reflect.Type {
-	return uRNArrayType
+// ElementType returns the element type of this Input ([]uint64).
+func (Uint64Array) ElementType() reflect.Type {
+	return uint64ArrayType
 }
 
-func (in URNArray) ToOutput(ctx context.Context) pulumix.Output[[]URN] {
-	return pulumix.Output[[]URN]{
+func (in Uint64Array) ToOutput(ctx context.Context) pulumix.Output[[]uint64] {
+	return pulumix.Output[[]uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URNArray) ToURNArrayOutput() URNArrayOutput {
-	return ToOutput(in).(URNArrayOutput)
+func (in Uint64Array) ToUint64ArrayOutput() Uint64ArrayOutput {
+	return ToOutput(in).(Uint64ArrayOutput)
 }
 
-func (in URNArray) ToURNArrayOutputWithContext(ctx context.Context) URNArrayOutput {
-	return ToOutputWithContext(ctx, in).(URNArrayOutput)
+func (in Uint64Array) ToUint64ArrayOutputWithContext(ctx context.Context) Uint64ArrayOutput {
+	return ToOutputWithContext(ctx, in).(Uint64ArrayOutput)
 }
 
-// URNArrayOutput is an Output that returns []URN values.
-type URNArrayOutput struct{ *OutputState }
+// Uint64ArrayOutput is an Output that returns []uint64 values.
+type Uint64ArrayOutput struct{ *OutputState }
 
-func (URNArrayOutput) MarshalJSON() ([]byte, error) {
+func (Uint64ArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]URN] {
-	return pulumix.Output[[]URN]{
+func (o Uint64ArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]uint64] {
+	return pulumix.Output[[]uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]URN).
-func (URNArrayOutput) ElementType() reflect.Type {
-	return uRNArrayType
+// ElementType returns the element type of this Output ([]uint64).
+func (Uint64ArrayOutput) ElementType() reflect.Type {
+	return uint64ArrayType
 }
 
-func (o URNArrayOutput) ToURNArrayOutput() URNArrayOutput {
+func (o Uint64ArrayOutput) ToUint64ArrayOutput() Uint64ArrayOutput {
 	return o
 }
 
-func (o URNArrayOutput) ToURNArrayOutputWithContext(ctx context.Context) URNArrayOutput {
+func (o Uint64ArrayOutput) ToUint64ArrayOutputWithContext(ctx context.Context) Uint64ArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o URNArrayOutput) Index(i IntInput) URNOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) URN {
-		arr := vs[0].([]URN)
+func (o Uint64ArrayOutput) Index(i IntInput) Uint64Output {
+	return All(o, i).ApplyT(func(vs []interface{}) uint64 {
+		arr := vs[0].([]uint64)
 		idx := vs[1].(int)
-		var ret URN
+		var ret uint64
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(URNOutput)
+	}).(Uint64Output)
 }
 
-func ToURNArray(in []URN) URNArray {
-	a := make(URNArray, len(in))
+func ToUint64Array(in []uint64) Uint64Array {
+	a := make(Uint64Array, len(in))
 	for i, v := range in {
-		a[i] = (v)
+		a[i] = Uint64(v)
 	}
 	return a
 }
 
-func ToURNArrayOutput(in []URNOutput) URNArrayOutput {
-	a := make(URNArray, len(in))
+func ToUint64ArrayOutput(in []Uint64Output) Uint64ArrayOutput {
+	a := make(Uint64Array, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToURNArrayOutput()
+	return a.ToUint64ArrayOutput()
 }
 
-var uRNMapType = reflect.TypeOf((*map[string]URN)(nil)).Elem()
+var uint64MapType = reflect.TypeOf((*map[string]uint64)(nil)).Elem()
 
-// URNMapInput is an input type that accepts URNMap and URNMapOutput values.
-type URNMapInput interface {
+// Uint64MapInput is an input type that accepts Uint64Map and Uint64MapOutput values.
+type Uint64MapInput interface {
 	Input
 
-	ToURNMapOutput() URNMapOutput
-	ToURNMapOutputWithContext(ctx context.Context) URNMapOutput
+	ToUint64MapOutput() Uint64MapOutput
+	ToUint64MapOutputWithContext(ctx context.Context) Uint64MapOutput
 }
 
-// URNMap is an input type for map[string]URNInput values.
-type URNMap map[string]URNInput
+// Uint64Map is an input type for map[string]Uint64Input values.
+type Uint64Map map[string]Uint64Input
 
-// ElementType returns the element type of this Input (map[string]URN).
-func (URNMap) ElementType() reflect.Type {
-	return uRNMapType
+// ElementType returns the element type of this Input (map[string]uint64).
+func (Uint64Map) ElementType() reflect.Type {
+	return uint64MapType
 }
 
-func (in URNMap) ToOutput(ctx context.Context) pulumix.Output[map[string]URN] {
-	return pulumix.Output[map[string]URN]{
+func (in Uint64Map) ToOutput(ctx context.Context) pulumix.Output[map[string]uint64] {
+	return pulumix.Output[map[string]uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URNMap) ToURNMapOutput() URNMapOutput {
-	return ToOutput(in).(URNMapOutput)
+func (in Uint64Map) ToUint64MapOutput() Uint64MapOutput {
+	return ToOutput(in).(Uint64MapOutput)
 }
 
-func (in URNMap) ToURNMapOutputWithContext(ctx context.Context) URNMapOutput {
-	return ToOutputWithContext(ctx, in).(URNMapOutput)
+func (in Uint64Map) ToUint64MapOutputWithContext(ctx context.Context) Uint64MapOutput {
+	return ToOutputWithContext(ctx, in).(Uint64MapOutput)
 }
 
-// URNMapOutput is an Output that returns map[string]URN values.
-type URNMapOutput struct{ *OutputState }
+// Uint64MapOutput is an Output that returns map[string]uint64 values.
+type Uint64MapOutput struct{ *OutputState }
 
-func (URNMapOutput) MarshalJSON() ([]byte, error) {
+func (Uint64MapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]URN] {
-	return pulumix.Output[map[string]URN]{
+func (o Uint64MapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]uint64] {
+	return pulumix.Output[map[string]uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]URN).
-func (URNMapOutput) ElementType() reflect.Type {
-	return uRNMapType
+// ElementType returns the element type of this Output (map[string]uint64).
+func (Uint64MapOutput) ElementType() reflect.Type {
+	return uint64MapType
 }
 
-func (o URNMapOutput) ToURNMapOutput() URNMapOutput {
+func (o Uint64MapOutput) ToUint64MapOutput() Uint64MapOutput {
 	return o
 }
 
-func (o URNMapOutput) ToURNMapOutputWithContext(ctx context.Context) URNMapOutput {
+func (o Uint64MapOutput) ToUint64MapOutputWithContext(ctx context.Context) Uint64MapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o URNMapOutput) MapIndex(k StringInput) URNOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) URN {
-		return vs[0].(map[string]URN)[vs[1].(string)]
-	}).(URNOutput)
+func (o Uint64MapOutput) MapIndex(k StringInput) Uint64Output {
+	return All(o, k).ApplyT(func(vs []interface{}) uint64 {
+		return vs[0].(map[string]uint64)[vs[1].(string)]
+	}).(Uint64Output)
 }
 
-func ToURNMap(in map[string]URN) URNMap {
-	m := make(URNMap)
+func ToUint64Map(in map[string]uint64) Uint64Map {
+	m := make(Uint64Map)
 	for k, v := range in {
-		m[k] = (v)
+		m[k] = Uint64(v)
 	}
 	return m
 }
-
-func ToURNMapOutput(in map[string]URNOutput) URNMapOutput {
-	m := make(URNMap)
+
+func ToUint64MapOutput(in map[string]Uint64Output) Uint64MapOutput {
+	m := make(Uint64Map)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToURNMapOutput()
+	return m.ToUint64MapOutput()
 }
 
-var uRNArrayMapType = reflect.TypeOf((*map[string][]URN)(nil)).Elem()
+var uint64ArrayMapType = reflect.TypeOf((*map[string][]uint64)(nil)).Elem()
 
-// URNArrayMapInput is an input type that accepts URNArrayMap and URNArrayMapOutput values.
-type URNArrayMapInput interface {
+// Uint64ArrayMapInput is an input type that accepts Uint64ArrayMap and Uint64ArrayMapOutput values.
+type Uint64ArrayMapInput interface {
 	Input
 
-	ToURNArrayMapOutput() URNArrayMapOutput
-	ToURNArrayMapOutputWithContext(ctx context.Context) URNArrayMapOutput
+	ToUint64ArrayMapOutput() Uint64ArrayMapOutput
+	ToUint64ArrayMapOutputWithContext(ctx context.Context) Uint64ArrayMapOutput
 }
 
-// URNArrayMap is an input type for map[string]URNArrayInput values.
-type URNArrayMap map[string]URNArrayInput
+// Uint64ArrayMap is an input type for map[string]Uint64ArrayInput values.
+type Uint64ArrayMap map[string]Uint64ArrayInput
 
-// ElementType returns the element type of this Input (map[string][]URN).
-func (URNArrayMap) ElementType() reflect.Type {
-	return uRNArrayMapType
+// ElementType returns the element type of this Input (map[string][]uint64).
+func (Uint64ArrayMap) ElementType() reflect.Type {
+	return uint64ArrayMapType
 }
 
-func (in URNArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]URN] {
-	return pulumix.Output[map[string][]URN]{
+func (in Uint64ArrayMap) ToOutput(ctx context.Context) pulumix.Output[map[string][]uint64] {
+	return pulumix.Output[map[string][]uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URNArrayMap) ToURNArrayMapOutput() URNArrayMapOutput {
-	return ToOutput(in).(URNArrayMapOutput)
+func (in Uint64ArrayMap) ToUint64ArrayMapOutput() Uint64ArrayMapOutput {
+	return ToOutput(in).(Uint64ArrayMapOutput)
 }
 
-func (in URNArrayMap) ToURNArrayMapOutputWithContext(ctx context.Context) URNArrayMapOutput {
-	return ToOutputWithContext(ctx, in).(URNArrayMapOutput)
+func (in Uint64ArrayMap) ToUint64ArrayMapOutputWithContext(ctx context.Context) Uint64ArrayMapOutput {
+	return ToOutputWithContext(ctx, in).(Uint64ArrayMapOutput)
 }
 
-// URNArrayMapOutput is an Output that returns map[string][]URN values.
-type URNArrayMapOutput struct{ *OutputState }
+// Uint64ArrayMapOutput is an Output that returns map[string][]uint64 values.
+type Uint64ArrayMapOutput struct{ *OutputState }
 
-func (URNArrayMapOutput) MarshalJSON() ([]byte, error) {
+func (Uint64ArrayMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]URN] {
-	return pulumix.Output[map[string][]URN]{
+func (o Uint64ArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]uint64] {
+	return pulumix.Output[map[string][]uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string][]URN).
-func (URNArrayMapOutput) ElementType() reflect.Type {
-	return uRNArrayMapType
+// ElementType returns the element type of this Output (map[string][]uint64).
+func (Uint64ArrayMapOutput) ElementType() reflect.Type {
+	return uint64ArrayMapType
 }
 
-func (o URNArrayMapOutput) ToURNArrayMapOutput() URNArrayMapOutput {
+func (o Uint64ArrayMapOutput) ToUint64ArrayMapOutput() Uint64ArrayMapOutput {
 	return o
 }
 
-func (o URNArrayMapOutput) ToURNArrayMapOutputWithContext(ctx context.Context) URNArrayMapOutput {
+func (o Uint64ArrayMapOutput) ToUint64ArrayMapOutputWithContext(ctx context.Context) Uint64ArrayMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o URNArrayMapOutput) MapIndex(k StringInput) URNArrayOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) []URN {
-		return vs[0].(map[string][]URN)[vs[1].(string)]
-	}).(URNArrayOutput)
+func (o Uint64ArrayMapOutput) MapIndex(k StringInput) Uint64ArrayOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) []uint64 {
+		return vs[0].(map[string][]uint64)[vs[1].(string)]
+	}).(Uint64ArrayOutput)
 }
 
-func ToURNArrayMap(in map[string][]URN) URNArrayMap {
-	m := make(URNArrayMap)
+func ToUint64ArrayMap(in map[string][]uint64) Uint64ArrayMap {
+	m := make(Uint64ArrayMap)
 	for k, v := range in {
-		m[k] = ToURNArray(v)
+		m[k] = ToUint64Array(v)
 	}
 	return m
 }
 
-func ToURNArrayMapOutput(in map[string]URNArrayOutput) URNArrayMapOutput {
-	m := make(URNArrayMap)
+func ToUint64ArrayMapOutput(in map[string]Uint64ArrayOutput) Uint64ArrayMapOutput {
+	m := make(Uint64ArrayMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToURNArrayMapOutput()
+	return m.ToUint64ArrayMapOutput()
 }
 
-var uRNMapArrayType = reflect.TypeOf((*[]map[string]URN)(nil)).Elem()
+var uint64MapArrayType = reflect.TypeOf((*[]map[string]uint64)(nil)).Elem()
 
-// URNMapArrayInput is an input type that accepts URNMapArray and URNMapArrayOutput values.
-type URNMapArrayInput interface {
+// Uint64MapArrayInput is an input type that accepts Uint64MapArray and Uint64MapArrayOutput values.
+type Uint64MapArrayInput interface {
 	Input
 
-	ToURNMapArrayOutput() URNMapArrayOutput
-	ToURNMapArrayOutputWithContext(ctx context.Context) URNMapArrayOutput
+	ToUint64MapArrayOutput() Uint64MapArrayOutput
+	ToUint64MapArrayOutputWithContext(ctx context.Context) Uint64MapArrayOutput
 }
 
-// URNMapArray is an input type for []URNMapInput values.
-type URNMapArray []URNMapInput
+// Uint64MapArray is an input type for []Uint64MapInput values.
+type Uint64MapArray []Uint64MapInput
 
-// ElementType returns the element type of this Input ([]map[string]URN).
-func (URNMapArray) ElementType() reflect.Type {
-	return uRNMapArrayType
+// ElementType returns the element type of this Input ([]map[string]uint64).
+func (Uint64MapArray) ElementType() reflect.Type {
+	return uint64MapArrayType
 }
 
-func (in URNMapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]URN] {
-	return pulumix.Output[[]map[string]URN]{
+func (in Uint64MapArray) ToOutput(ctx context.Context) pulumix.Output[[]map[string]uint64] {
+	return pulumix.Output[[]map[string]uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URNMapArray) ToURNMapArrayOutput() URNMapArrayOutput {
-	return ToOutput(in).(URNMapArrayOutput)
+func (in Uint64MapArray) ToUint64MapArrayOutput() Uint64MapArrayOutput {
+	return ToOutput(in).(Uint64MapArrayOutput)
 }
 
-func (in URNMapArray) ToURNMapArrayOutputWithContext(ctx context.Context) URNMapArrayOutput {
-	return ToOutputWithContext(ctx, in).(URNMapArrayOutput)
+func (in Uint64MapArray) ToUint64MapArrayOutputWithContext(ctx context.Context) Uint64MapArrayOutput {
+	return ToOutputWithContext(ctx, in).(Uint64MapArrayOutput)
 }
 
-// URNMapArrayOutput is an Output that returns []map[string]URN values.
-type URNMapArrayOutput struct{ *OutputState }
+// Uint64MapArrayOutput is an Output that returns []map[string]uint64 values.
+type Uint64MapArrayOutput struct{ *OutputState }
 
-func (URNMapArrayOutput) MarshalJSON() ([]byte, error) {
+func (Uint64MapArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNMapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]URN] {
-	return pulumix.Output[[]map[string]URN]{
+func (o Uint64MapArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]map[string]uint64] {
+	return pulumix.Output[[]map[string]uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([]map[string]URN).
-func (URNMapArrayOutput) ElementType() reflect.Type {
-	return uRNMapArrayType
+// ElementType returns the element type of this Output ([]map[string]uint64).
+func (Uint64MapArrayOutput) ElementType() reflect.Type {
+	return uint64MapArrayType
 }
 
-func (o URNMapArrayOutput) ToURNMapArrayOutput() URNMapArrayOutput {
+func (o Uint64MapArrayOutput) ToUint64MapArrayOutput() Uint64MapArrayOutput {
 	return o
 }
 
-func (o URNMapArrayOutput) ToURNMapArrayOutputWithContext(ctx context.Context) URNMapArrayOutput {
+func (o Uint64MapArrayOutput) ToUint64MapArrayOutputWithContext(ctx context.Context) Uint64MapArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o URNMapArrayOutput) Index(i IntInput) URNMapOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) map[string]URN {
-		arr := vs[0].([]map[string]URN)
+func (o Uint64MapArrayOutput) Index(i IntInput) Uint64MapOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) map[string]uint64 {
+		arr := vs[0].([]map[string]uint64)
 		idx := vs[1].(int)
-		var ret map[string]URN
+		var ret map[string]uint64
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(URNMapOutput)
+	}).(Uint64MapOutput)
 }
 
-func ToURNMapArray(in []map[string]URN) URNMapArray {
-	a := make(URNMapArray, len(in))
+func ToUint64MapArray(in []map[string]uint64) Uint64MapArray {
+	a := make(Uint64MapArray, len(in))
 	for i, v := range in {
-		a[i] = ToURNMap(v)
+		a[i] = ToUint64Map(v)
 	}
 	return a
 }
 
-func ToURNMapArrayOutput(in []URNMapOutput) URNMapArrayOutput {
-	a := make(URNMapArray, len(in))
+func ToUint64MapArrayOutput(in []Uint64MapOutput) Uint64MapArrayOutput {
+	a := make(Uint64MapArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToURNMapArrayOutput()
+	return a.ToUint64MapArrayOutput()
 }
 
-var uRNMapMapType = reflect.TypeOf((*map[string]map[string]URN)(nil)).Elem()
+var uint64MapMapType = reflect.TypeOf((*map[string]map[string]uint64)(nil)).Elem()
 
-// URNMapMapInput is an input type that accepts URNMapMap and URNMapMapOutput values.
-type URNMapMapInput interface {
+// Uint64MapMapInput is an input type that accepts Uint64MapMap and Uint64MapMapOutput values.
+type Uint64MapMapInput interface {
 	Input
 
-	ToURNMapMapOutput() URNMapMapOutput
-	ToURNMapMapOutputWithContext(ctx context.Context) URNMapMapOutput
+	ToUint64MapMapOutput() Uint64MapMapOutput
+	ToUint64MapMapOutputWithContext(ctx context.Context) Uint64MapMapOutput
 }
 
-// URNMapMap is an input type for map[string]URNMapInput values.
-type URNMapMap map[string]URNMapInput
+// Uint64MapMap is an input type for map[string]Uint64MapInput values.
+type Uint64MapMap map[string]Uint64MapInput
 
-// ElementType returns the element type of this Input (map[string]map[string]URN).
-func (URNMapMap) ElementType() reflect.Type {
-	return uRNMapMapType
+// ElementType returns the element type of this Input (map[string]map[string]uint64).
+func (Uint64MapMap) ElementType() reflect.Type {
+	return uint64MapMapType
 }
 
-func (in URNMapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]URN] {
-	return pulumix.Output[map[string]map[string]URN]{
+func (in Uint64MapMap) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]uint64] {
+	return pulumix.Output[map[string]map[string]uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URNMapMap) ToURNMapMapOutput() URNMapMapOutput {
-	return ToOutput(in).(URNMapMapOutput)
+func (in Uint64MapMap) ToUint64MapMapOutput() Uint64MapMapOutput {
+	return ToOutput(in).(Uint64MapMapOutput)
 }
 
-func (in URNMapMap) ToURNMapMapOutputWithContext(ctx context.Context) URNMapMapOutput {
-	return ToOutputWithContext(ctx, in).(URNMapMapOutput)
+func (in Uint64MapMap) ToUint64MapMapOutputWithContext(ctx context.Context) Uint64MapMapOutput {
+	return ToOutputWithContext(ctx, in).(Uint64MapMapOutput)
 }
 
-// URNMapMapOutput is an Output that returns map[string]map[string]URN values.
-type URNMapMapOutput struct{ *OutputState }
+// Uint64MapMapOutput is an Output that returns map[string]map[string]uint64 values.
+type Uint64MapMapOutput struct{ *OutputState }
 
-func (URNMapMapOutput) MarshalJSON() ([]byte, error) {
+func (Uint64MapMapOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNMapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]URN] {
-	return pulumix.Output[map[string]map[string]URN]{
+func (o Uint64MapMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]map[string]uint64] {
+	return pulumix.Output[map[string]map[string]uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output (map[string]map[string]URN).
-func (URNMapMapOutput) ElementType() reflect.Type {
-	return uRNMapMapType
+// ElementType returns the element type of this Output (map[string]map[string]uint64).
+func (Uint64MapMapOutput) ElementType() reflect.Type {
+	return uint64MapMapType
 }
 
-func (o URNMapMapOutput) ToURNMapMapOutput() URNMapMapOutput {
+func (o Uint64MapMapOutput) ToUint64MapMapOutput() Uint64MapMapOutput {
 	return o
 }
 
-func (o URNMapMapOutput) ToURNMapMapOutputWithContext(ctx context.Context) URNMapMapOutput {
+func (o Uint64MapMapOutput) ToUint64MapMapOutputWithContext(ctx context.Context) Uint64MapMapOutput {
 	return o
 }
 
 // MapIndex looks up the key k in the map.
-func (o URNMapMapOutput) MapIndex(k StringInput) URNMapOutput {
-	return All(o, k).ApplyT(func(vs []interface{}) map[string]URN {
-		return vs[0].(map[string]map[string]URN)[vs[1].(string)]
-	}).(URNMapOutput)
+func (o Uint64MapMapOutput) MapIndex(k StringInput) Uint64MapOutput {
+	return All(o, k).ApplyT(func(vs []interface{}) map[string]uint64 {
+		return vs[0].(map[string]map[string]uint64)[vs[1].(string)]
+	}).(Uint64MapOutput)
 }
 
-func ToURNMapMap(in map[string]map[string]URN) URNMapMap {
-	m := make(URNMapMap)
+func ToUint64MapMap(in map[string]map[string]uint64) Uint64MapMap {
+	m := make(Uint64MapMap)
 	for k, v := range in {
-		m[k] = ToURNMap(v)
+		m[k] = ToUint64Map(v)
 	}
 	return m
 }
 
-func ToURNMapMapOutput(in map[string]URNMapOutput) URNMapMapOutput {
-	m := make(URNMapMap)
+func ToUint64MapMapOutput(in map[string]Uint64MapOutput) Uint64MapMapOutput {
+	m := make(Uint64MapMap)
 	for k, v := range in {
 		m[k] = v
 	}
-	return m.ToURNMapMapOutput()
+	return m.ToUint64MapMapOutput()
 }
 
-var uRNArrayArrayType = reflect.TypeOf((*[][]URN)(nil)).Elem()
+var uint64ArrayArrayType = reflect.TypeOf((*[][]uint64)(nil)).Elem()
 
-// URNArrayArrayInput is an input type that accepts URNArrayArray and URNArrayArrayOutput values.
-type URNArrayArrayInput interface {
+// Uint64ArrayArrayInput is an input type that accepts Uint64ArrayArray and Uint64ArrayArrayOutput values.
+type Uint64ArrayArrayInput interface {
 	Input
 
-	ToURNArrayArrayOutput() URNArrayArrayOutput
-	ToURNArrayArrayOutputWithContext(ctx context.Context) URNArrayArrayOutput
+	ToUint64ArrayArrayOutput() Uint64ArrayArrayOutput
+	ToUint64ArrayArrayOutputWithContext(ctx context.Context) Uint64ArrayArrayOutput
 }
 
-// URNArrayArray is an input type for []URNArrayInput values.
-type URNArrayArray []URNArrayInput
+// Uint64ArrayArray is an input type for []Uint64ArrayInput values.
+type Uint64ArrayArray []Uint64ArrayInput
 
-// ElementType returns the element type of this Input ([][]URN).
-func (URNArrayArray) ElementType() reflect.Type {
-	return uRNArrayArrayType
+// ElementType returns the element type of this Input ([][]uint64).
+func (Uint64ArrayArray) ElementType() reflect.Type {
+	return uint64ArrayArrayType
 }
 
-func (in URNArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]URN] {
-	return pulumix.Output[[][]URN]{
+func (in Uint64ArrayArray) ToOutput(ctx context.Context) pulumix.Output[[][]uint64] {
+	return pulumix.Output[[][]uint64]{
 		OutputState: internal.GetOutputState(ToOutputWithContext(ctx, in)),
 	}
 }
 
-func (in URNArrayArray) ToURNArrayArrayOutput() URNArrayArrayOutput {
-	return ToOutput(in).(URNArrayArrayOutput)
+func (in Uint64ArrayArray) ToUint64ArrayArrayOutput() Uint64ArrayArrayOutput {
+	return ToOutput(in).(Uint64ArrayArrayOutput)
 }
 
-func (in URNArrayArray) ToURNArrayArrayOutputWithContext(ctx context.Context) URNArrayArrayOutput {
-	return ToOutputWithContext(ctx, in).(URNArrayArrayOutput)
+func (in Uint64ArrayArray) ToUint64ArrayArrayOutputWithContext(ctx context.Context) Uint64ArrayArrayOutput {
+	return ToOutputWithContext(ctx, in).(Uint64ArrayArrayOutput)
 }
 
-// URNArrayArrayOutput is an Output that returns [][]URN values.
-type URNArrayArrayOutput struct{ *OutputState }
+// Uint64ArrayArrayOutput is an Output that returns [][]uint64 values.
+type Uint64ArrayArrayOutput struct{ *OutputState }
 
-func (URNArrayArrayOutput) MarshalJSON() ([]byte, error) {
+func (Uint64ArrayArrayOutput) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("Outputs can not be marshaled to JSON")
 }
 
-func (o URNArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]URN] {
-	return pulumix.Output[[][]URN]{
+func (o Uint64ArrayArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[][]uint64] {
+	return pulumix.Output[[][]uint64]{
 		OutputState: o.OutputState,
 	}
 }
 
-// ElementType returns the element type of this Output ([][]URN).
-func (URNArrayArrayOutput) ElementType() reflect.Type {
-	return uRNArrayArrayType
+// ElementType returns the element type of this Output ([][]uint64).
+func (Uint64ArrayArrayOutput) ElementType() reflect.Type {
+	return uint64ArrayArrayType
 }
 
-func (o URNArrayArrayOutput) ToURNArrayArrayOutput() URNArrayArrayOutput {
+func (o Uint64ArrayArrayOutput) ToUint64ArrayArrayOutput() Uint64ArrayArrayOutput {
 	return o
 }
 
-func (o URNArrayArrayOutput) ToURNArrayArrayOutputWithContext(ctx context.Context) URNArrayArrayOutput {
+func (o Uint64ArrayArrayOutput) ToUint64ArrayArrayOutputWithContext(ctx context.Context) Uint64ArrayArrayOutput {
 	return o
 }
 
 // Index looks up the i'th element of the array if it is in bounds or returns the zero value of the appropriate
 // type if the index is out of bounds.
-func (o URNArrayArrayOutput) Index(i IntInput) URNArrayOutput {
-	return All(o, i).ApplyT(func(vs []interface{}) []URN {
-		arr := vs[0].([][]URN)
+func (o Uint64ArrayArrayOutput) Index(i IntInput) Uint64ArrayOutput {
+	return All(o, i).ApplyT(func(vs []interface{}) []uint64 {
+		arr := vs[0].([][]uint64)
 		idx := vs[1].(int)
-		var ret []URN
+		var ret []uint64
 		if idx >= 0 && idx < len(arr) {
 			ret = arr[idx]
 		}
 		return ret
-	}).(URNArrayOutput)
+	}).(Uint64ArrayOutput)
 }
 
-func ToURNArrayArray(in [][]URN) URNArrayArray {
-	a := make(URNArrayArray, len(in))
+func ToUint64ArrayArray(in [][]uint64) Uint64ArrayArray {
+	a := make(Uint64ArrayArray, len(in))
 	for i, v := range in {
-		a[i] = ToURNArray(v)
+		a[i] = ToUint64Array(v)
 	}
 	return a
 }
 
-func ToURNArrayArrayOutput(in []URNArrayOutput) URNArrayArrayOutput {
-	a := make(URNArrayArray, len(in))
+func ToUint64ArrayArrayOutput(in []Uint64ArrayOutput) Uint64ArrayArrayOutput {
+	a := make(Uint64ArrayArray, len(in))
 	for i, v := range in {
 		a[i] = v
 	}
-	return a.ToURNArrayArrayOutput()
+	return a.ToUint64ArrayArrayOutput()
 }
 
 func init() {
@@ -6607,6 +8602,106 @@ func (a AnyOutput) AsBoolArrayArrayOutput() BoolArrayArrayOutput {
 	}).(BoolArrayArrayOutput)
 }
 
+// AsFloat32Output asserts that the type of the AnyOutput's underlying interface{} value is
+// float32 and returns a `Float32Output` with that value. AsFloat32Output panics if the value
+// was not the expected type.
+func (a AnyOutput) AsFloat32Output() Float32Output {
+	return a.ApplyT(func(i interface{}) float32 {
+		return i.(float32)
+	}).(Float32Output)
+}
+
+// AsFloat32PtrOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// *float32 or a compatible type and returns a `Float32PtrOutput` with that value.
+// AsFloat32PtrOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32PtrOutput() Float32PtrOutput {
+	return a.ApplyT(func(i interface{}) (*float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((**float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(*float32), nil
+	}).(Float32PtrOutput)
+}
+
+// AsFloat32ArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// []float32 or a compatible type and returns a `Float32ArrayOutput` with that value.
+// AsFloat32ArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32ArrayOutput() Float32ArrayOutput {
+	return a.ApplyT(func(i interface{}) ([]float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[]float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([]float32), nil
+	}).(Float32ArrayOutput)
+}
+
+// AsFloat32MapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string]float32 or a compatible type and returns a `Float32MapOutput` with that value.
+// AsFloat32MapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32MapOutput() Float32MapOutput {
+	return a.ApplyT(func(i interface{}) (map[string]float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string]float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string]float32), nil
+	}).(Float32MapOutput)
+}
+
+// AsFloat32ArrayMapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string][]float32 or a compatible type and returns a `Float32ArrayMapOutput` with that value.
+// AsFloat32ArrayMapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32ArrayMapOutput() Float32ArrayMapOutput {
+	return a.ApplyT(func(i interface{}) (map[string][]float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string][]float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string][]float32), nil
+	}).(Float32ArrayMapOutput)
+}
+
+// AsFloat32MapArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// []map[string]float32 or a compatible type and returns a `Float32MapArrayOutput` with that value.
+// AsFloat32MapArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32MapArrayOutput() Float32MapArrayOutput {
+	return a.ApplyT(func(i interface{}) ([]map[string]float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[]map[string]float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([]map[string]float32), nil
+	}).(Float32MapArrayOutput)
+}
+
+// AsFloat32MapMapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string]map[string]float32 or a compatible type and returns a `Float32MapMapOutput` with that value.
+// AsFloat32MapMapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32MapMapOutput() Float32MapMapOutput {
+	return a.ApplyT(func(i interface{}) (map[string]map[string]float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string]map[string]float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string]map[string]float32), nil
+	}).(Float32MapMapOutput)
+}
+
+// AsFloat32ArrayArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// [][]float32 or a compatible type and returns a `Float32ArrayArrayOutput` with that value.
+// AsFloat32ArrayArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsFloat32ArrayArrayOutput() Float32ArrayArrayOutput {
+	return a.ApplyT(func(i interface{}) ([][]float32, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[][]float32)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([][]float32), nil
+	}).(Float32ArrayArrayOutput)
+}
+
 // AsFloat64Output asserts that the type of the AnyOutput's underlying interface{} value is
 // float64 and returns a `Float64Output` with that value. AsFloat64Output panics if the value
 // was not the expected type.
@@ -6998,6 +9093,106 @@ func (a AnyOutput) AsIntArrayArrayOutput() IntArrayArrayOutput {
 	}).(IntArrayArrayOutput)
 }
 
+// AsInt64Output asserts that the type of the AnyOutput's underlying interface{} value is
+// int64 and returns a `Int64Output` with that value. AsInt64Output panics if the value
+// was not the expected type.
+func (a AnyOutput) AsInt64Output() Int64Output {
+	return a.ApplyT(func(i interface{}) int64 {
+		return i.(int64)
+	}).(Int64Output)
+}
+
+// AsInt64PtrOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// *int64 or a compatible type and returns a `Int64PtrOutput` with that value.
+// AsInt64PtrOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64PtrOutput() Int64PtrOutput {
+	return a.ApplyT(func(i interface{}) (*int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((**int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(*int64), nil
+	}).(Int64PtrOutput)
+}
+
+// AsInt64ArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// []int64 or a compatible type and returns a `Int64ArrayOutput` with that value.
+// AsInt64ArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64ArrayOutput() Int64ArrayOutput {
+	return a.ApplyT(func(i interface{}) ([]int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[]int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([]int64), nil
+	}).(Int64ArrayOutput)
+}
+
+// AsInt64MapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string]int64 or a compatible type and returns a `Int64MapOutput` with that value.
+// AsInt64MapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64MapOutput() Int64MapOutput {
+	return a.ApplyT(func(i interface{}) (map[string]int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string]int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string]int64), nil
+	}).(Int64MapOutput)
+}
+
+// AsInt64ArrayMapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string][]int64 or a compatible type and returns a `Int64ArrayMapOutput` with that value.
+// AsInt64ArrayMapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64ArrayMapOutput() Int64ArrayMapOutput {
+	return a.ApplyT(func(i interface{}) (map[string][]int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string][]int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string][]int64), nil
+	}).(Int64ArrayMapOutput)
+}
+
+// AsInt64MapArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// []map[string]int64 or a compatible type and returns a `Int64MapArrayOutput` with that value.
+// AsInt64MapArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64MapArrayOutput() Int64MapArrayOutput {
+	return a.ApplyT(func(i interface{}) ([]map[string]int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[]map[string]int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([]map[string]int64), nil
+	}).(Int64MapArrayOutput)
+}
+
+// AsInt64MapMapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string]map[string]int64 or a compatible type and returns a `Int64MapMapOutput` with that value.
+// AsInt64MapMapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64MapMapOutput() Int64MapMapOutput {
+	return a.ApplyT(func(i interface{}) (map[string]map[string]int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string]map[string]int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string]map[string]int64), nil
+	}).(Int64MapMapOutput)
+}
+
+// AsInt64ArrayArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// [][]int64 or a compatible type and returns a `Int64ArrayArrayOutput` with that value.
+// AsInt64ArrayArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsInt64ArrayArrayOutput() Int64ArrayArrayOutput {
+	return a.ApplyT(func(i interface{}) ([][]int64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[][]int64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([][]int64), nil
+	}).(Int64ArrayArrayOutput)
+}
+
 // AsStringOutput asserts that the type of the AnyOutput's underlying interface{} value is
 // string and returns a `StringOutput` with that value. AsStringOutput panics if the value
 // was not the expected type.
@@ -7198,6 +9393,106 @@ func (a AnyOutput) AsURNArrayArrayOutput() URNArrayArrayOutput {
 	}).(URNArrayArrayOutput)
 }
 
+// AsUint64Output asserts that the type of the AnyOutput's underlying interface{} value is
+// uint64 and returns a `Uint64Output` with that value. AsUint64Output panics if the value
+// was not the expected type.
+func (a AnyOutput) AsUint64Output() Uint64Output {
+	return a.ApplyT(func(i interface{}) uint64 {
+		return i.(uint64)
+	}).(Uint64Output)
+}
+
+// AsUint64PtrOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// *uint64 or a compatible type and returns a `Uint64PtrOutput` with that value.
+// AsUint64PtrOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64PtrOutput() Uint64PtrOutput {
+	return a.ApplyT(func(i interface{}) (*uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((**uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(*uint64), nil
+	}).(Uint64PtrOutput)
+}
+
+// AsUint64ArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// []uint64 or a compatible type and returns a `Uint64ArrayOutput` with that value.
+// AsUint64ArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64ArrayOutput() Uint64ArrayOutput {
+	return a.ApplyT(func(i interface{}) ([]uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[]uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([]uint64), nil
+	}).(Uint64ArrayOutput)
+}
+
+// AsUint64MapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string]uint64 or a compatible type and returns a `Uint64MapOutput` with that value.
+// AsUint64MapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64MapOutput() Uint64MapOutput {
+	return a.ApplyT(func(i interface{}) (map[string]uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string]uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string]uint64), nil
+	}).(Uint64MapOutput)
+}
+
+// AsUint64ArrayMapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string][]uint64 or a compatible type and returns a `Uint64ArrayMapOutput` with that value.
+// AsUint64ArrayMapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64ArrayMapOutput() Uint64ArrayMapOutput {
+	return a.ApplyT(func(i interface{}) (map[string][]uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string][]uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string][]uint64), nil
+	}).(Uint64ArrayMapOutput)
+}
+
+// AsUint64MapArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// []map[string]uint64 or a compatible type and returns a `Uint64MapArrayOutput` with that value.
+// AsUint64MapArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64MapArrayOutput() Uint64MapArrayOutput {
+	return a.ApplyT(func(i interface{}) ([]map[string]uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[]map[string]uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([]map[string]uint64), nil
+	}).(Uint64MapArrayOutput)
+}
+
+// AsUint64MapMapOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// map[string]map[string]uint64 or a compatible type and returns a `Uint64MapMapOutput` with that value.
+// AsUint64MapMapOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64MapMapOutput() Uint64MapMapOutput {
+	return a.ApplyT(func(i interface{}) (map[string]map[string]uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*map[string]map[string]uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.(map[string]map[string]uint64), nil
+	}).(Uint64MapMapOutput)
+}
+
+// AsUint64ArrayArrayOutput asserts that the type of the AnyOutput's underlying interface{} value is
+// [][]uint64 or a compatible type and returns a `Uint64ArrayArrayOutput` with that value.
+// AsUint64ArrayArrayOutput panics if the value was not the expected type or a compatible type.
+func (a AnyOutput) AsUint64ArrayArrayOutput() Uint64ArrayArrayOutput {
+	return a.ApplyT(func(i interface{}) ([][]uint64, error) {
+		v, err := coerceTypeConversion(i, reflect.TypeOf((*[][]uint64)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		return v.([][]uint64), nil
+	}).(Uint64ArrayArrayOutput)
+}
+
 func init() {
 	RegisterInputType(reflect.TypeOf((*ArchiveArrayInput)(nil)).Elem(), ArchiveArray{})
 	RegisterInputType(reflect.TypeOf((*ArchiveMapInput)(nil)).Elem(), ArchiveMap{})
@@ -7225,6 +9520,14 @@ func init() {
 	RegisterInputType(reflect.TypeOf((*BoolMapArrayInput)(nil)).Elem(), BoolMapArray{})
 	RegisterInputType(reflect.TypeOf((*BoolMapMapInput)(nil)).Elem(), BoolMapMap{})
 	RegisterInputType(reflect.TypeOf((*BoolArrayArrayInput)(nil)).Elem(), BoolArrayArray{})
+	RegisterInputType(reflect.TypeOf((*Float32Input)(nil)).Elem(), Float32(0))
+	RegisterInputType(reflect.TypeOf((*Float32PtrInput)(nil)).Elem(), Float32(0))
+	RegisterInputType(reflect.TypeOf((*Float32ArrayInput)(nil)).Elem(), Float32Array{})
+	RegisterInputType(reflect.TypeOf((*Float32MapInput)(nil)).Elem(), Float32Map{})
+	RegisterInputType(reflect.TypeOf((*Float32ArrayMapInput)(nil)).Elem(), Float32ArrayMap{})
+	RegisterInputType(reflect.TypeOf((*Float32MapArrayInput)(nil)).Elem(), Float32MapArray{})
+	RegisterInputType(reflect.TypeOf((*Float32MapMapInput)(nil)).Elem(), Float32MapMap{})
+	RegisterInputType(reflect.TypeOf((*Float32ArrayArrayInput)(nil)).Elem(), Float32ArrayArray{})
 	RegisterInputType(reflect.TypeOf((*Float64Input)(nil)).Elem(), Float64(0))
 	RegisterInputType(reflect.TypeOf((*Float64PtrInput)(nil)).Elem(), Float64(0))
 	RegisterInputType(reflect.TypeOf((*Float64ArrayInput)(nil)).Elem(), Float64Array{})
@@ -7256,6 +9559,14 @@ func init() {
 	RegisterInputType(reflect.TypeOf((*IntMapArrayInput)(nil)).Elem(), IntMapArray{})
 	RegisterInputType(reflect.TypeOf((*IntMapMapInput)(nil)).Elem(), IntMapMap{})
 	RegisterInputType(reflect.TypeOf((*IntArrayArrayInput)(nil)).Elem(), IntArrayArray{})
+	RegisterInputType(reflect.TypeOf((*Int64Input)(nil)).Elem(), Int64(0))
+	RegisterInputType(reflect.TypeOf((*Int64PtrInput)(nil)).Elem(), Int64(0))
+	RegisterInputType(reflect.TypeOf((*Int64ArrayInput)(nil)).Elem(), Int64Array{})
+	RegisterInputType(reflect.TypeOf((*Int64MapInput)(nil)).Elem(), Int64Map{})
+	RegisterInputType(reflect.TypeOf((*Int64ArrayMapInput)(nil)).Elem(), Int64ArrayMap{})
+	RegisterInputType(reflect.TypeOf((*Int64MapArrayInput)(nil)).Elem(), Int64MapArray{})
+	RegisterInputType(reflect.TypeOf((*Int64MapMapInput)(nil)).Elem(), Int64MapMap{})
+	RegisterInputType(reflect.TypeOf((*Int64ArrayArrayInput)(nil)).Elem(), Int64ArrayArray{})
 	RegisterInputType(reflect.TypeOf((*StringInput)(nil)).Elem(), String(""))
 	RegisterInputType(reflect.TypeOf((*StringPtrInput)(nil)).Elem(), String(""))
 	RegisterInputType(reflect.TypeOf((*StringArrayInput)(nil)).Elem(), StringArray{})
@@ -7272,6 +9583,14 @@ func init() {
 	RegisterInputType(reflect.TypeOf((*URNMapArrayInput)(nil)).Elem(), URNMapArray{})
 	RegisterInputType(reflect.TypeOf((*URNMapMapInput)(nil)).Elem(), URNMapMap{})
 	RegisterInputType(reflect.TypeOf((*URNArrayArrayInput)(nil)).Elem(), URNArrayArray{})
+	RegisterInputType(reflect.TypeOf((*Uint64Input)(nil)).Elem(), Uint64(0))
+	RegisterInputType(reflect.TypeOf((*Uint64PtrInput)(nil)).Elem(), Uint64(0))
+	RegisterInputType(reflect.TypeOf((*Uint64ArrayInput)(nil)).Elem(), Uint64Array{})
+	RegisterInputType(reflect.TypeOf((*Uint64MapInput)(nil)).Elem(), Uint64Map{})
+	RegisterInputType(reflect.TypeOf((*Uint64ArrayMapInput)(nil)).Elem(), Uint64ArrayMap{})
+	RegisterInputType(reflect.TypeOf((*Uint64MapArrayInput)(nil)).Elem(), Uint64MapArray{})
+	RegisterInputType(reflect.TypeOf((*Uint64MapMapInput)(nil)).Elem(), Uint64MapMap{})
+	RegisterInputType(reflect.TypeOf((*Uint64ArrayArrayInput)(nil)).Elem(), Uint64ArrayArray{})
 	RegisterOutputType(ArchiveOutput{})
 	RegisterOutputType(ArchiveArrayOutput{})
 	RegisterOutputType(ArchiveMapOutput{})
@@ -7301,6 +9620,14 @@ func init() {
 	RegisterOutputType(BoolMapArrayOutput{})
 	RegisterOutputType(BoolMapMapOutput{})
 	RegisterOutputType(BoolArrayArrayOutput{})
+	RegisterOutputType(Float32Output{})
+	RegisterOutputType(Float32PtrOutput{})
+	RegisterOutputType(Float32ArrayOutput{})
+	RegisterOutputType(Float32MapOutput{})
+	RegisterOutputType(Float32ArrayMapOutput{})
+	RegisterOutputType(Float32MapArrayOutput{})
+	RegisterOutputType(Float32MapMapOutput{})
+	RegisterOutputType(Float32ArrayArrayOutput{})
 	RegisterOutputType(Float64Output{})
 	RegisterOutputType(Float64PtrOutput{})
 	RegisterOutputType(Float64ArrayOutput{})
@@ -7332,6 +9659,14 @@ func init() {
 	RegisterOutputType(IntMapArrayOutput{})
 	RegisterOutputType(IntMapMapOutput{})
 	RegisterOutputType(IntArrayArrayOutput{})
+	RegisterOutputType(Int64Output{})
+	RegisterOutputType(Int64PtrOutput{})
+	RegisterOutputType(Int64ArrayOutput{})
+	RegisterOutputType(Int64MapOutput{})
+	RegisterOutputType(Int64ArrayMapOutput{})
+	RegisterOutputType(Int64MapArrayOutput{})
+	RegisterOutputType(Int64MapMapOutput{})
+	RegisterOutputType(Int64ArrayArrayOutput{})
 	RegisterOutputType(StringOutput{})
 	RegisterOutputType(StringPtrOutput{})
 	RegisterOutputType(StringArrayOutput{})
@@ -7348,4 +9683,12 @@ func init() {
 	RegisterOutputType(URNMapArrayOutput{})
 	RegisterOutputType(URNMapMapOutput{})
 	RegisterOutputType(URNArrayArrayOutput{})
+	RegisterOutputType(Uint64Output{})
+	RegisterOutputType(Uint64PtrOutput{})
+	RegisterOutputType(Uint64ArrayOutput{})
+	RegisterOutputType(Uint64MapOutput{})
+	RegisterOutputType(Uint64ArrayMapOutput{})
+	RegisterOutputType(Uint64MapArrayOutput{})
+	RegisterOutputType(Uint64MapMapOutput{})
+	RegisterOutputType(Uint64ArrayArrayOutput{})
 }
@@ -310,6 +310,54 @@ func TestOutputApply(t *testing.T) {
 			assert.True(t, ok)
 		})
 
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32Output", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) float32 { return *new(float32) }).(Float32Output)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32PtrOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) *float32 { return *new(*float32) }).(Float32PtrOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32ArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) []float32 { return *new([]float32) }).(Float32ArrayOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32MapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string]float32 { return *new(map[string]float32) }).(Float32MapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32ArrayMapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string][]float32 { return *new(map[string][]float32) }).(Float32ArrayMapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32MapArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) []map[string]float32 { return *new([]map[string]float32) }).(Float32MapArrayOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32MapMapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string]map[string]float32 { return *new(map[string]map[string]float32) }).(Float32MapMapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Float32ArrayArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) [][]float32 { return *new([][]float32) }).(Float32ArrayArrayOutput)
+			assert.True(t, ok)
+		})
+
 		//nolint:paralleltest // uses shared state with parent
 		t.Run("ApplyT::Float64Output", func(t *testing.T) {
 			_, ok := out.ApplyT(func(v int) float64 { return *new(float64) }).(Float64Output)
@@ -496,6 +544,54 @@ func TestOutputApply(t *testing.T) {
 			assert.True(t, ok)
 		})
 
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64Output", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) int64 { return *new(int64) }).(Int64Output)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64PtrOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) *int64 { return *new(*int64) }).(Int64PtrOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64ArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) []int64 { return *new([]int64) }).(Int64ArrayOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64MapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string]int64 { return *new(map[string]int64) }).(Int64MapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64ArrayMapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string][]int64 { return *new(map[string][]int64) }).(Int64ArrayMapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64MapArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) []map[string]int64 { return *new([]map[string]int64) }).(Int64MapArrayOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64MapMapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string]map[string]int64 { return *new(map[string]map[string]int64) }).(Int64MapMapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Int64ArrayArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) [][]int64 { return *new([][]int64) }).(Int64ArrayArrayOutput)
+			assert.True(t, ok)
+		})
+
 		//nolint:paralleltest // uses shared state with parent
 		t.Run("ApplyT::StringOutput", func(t *testing.T) {
 			_, ok := out.ApplyT(func(v int) string { return *new(string) }).(StringOutput)
@@ -592,6 +688,54 @@ func TestOutputApply(t *testing.T) {
 			assert.True(t, ok)
 		})
 
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64Output", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) uint64 { return *new(uint64) }).(Uint64Output)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64PtrOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) *uint64 { return *new(*uint64) }).(Uint64PtrOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64ArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) []uint64 { return *new([]uint64) }).(Uint64ArrayOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64MapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string]uint64 { return *new(map[string]uint64) }).(Uint64MapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64ArrayMapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string][]uint64 { return *new(map[string][]uint64) }).(Uint64ArrayMapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64MapArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) []map[string]uint64 { return *new([]map[string]uint64) }).(Uint64MapArrayOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64MapMapOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) map[string]map[string]uint64 { return *new(map[string]map[string]uint64) }).(Uint64MapMapOutput)
+			assert.True(t, ok)
+		})
+
+		//nolint:paralleltest // uses shared state with parent
+		t.Run("ApplyT::Uint64ArrayArrayOutput", func(t *testing.T) {
+			_, ok := out.ApplyT(func(v int) [][]uint64 { return *new([][]uint64) }).(Uint64ArrayArrayOutput)
+			assert.True(t, ok)
+		})
+
 	}
 	// Test some chained applies.
 	{
@@ -1284,6 +1428,166 @@ func TestToOutputBoolArrayArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestToOutputFloat32(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32(999.9))
+	_, ok := out.(Float32Input)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32Input)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32Ptr(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32Ptr(float32(Float32(999.9))))
+	_, ok := out.(Float32PtrInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32PtrInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32Array(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32Array{Float32(999.9)})
+	_, ok := out.(Float32ArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32ArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32Map(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32Map{"baz": Float32(999.9)})
+	_, ok := out.(Float32MapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32MapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32ArrayMap(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32ArrayMap{"baz": Float32Array{Float32(999.9)}})
+	_, ok := out.(Float32ArrayMapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32ArrayMapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32MapArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32MapArray{Float32Map{"baz": Float32(999.9)}})
+	_, ok := out.(Float32MapArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32MapArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32MapMap(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32MapMap{"baz": Float32Map{"baz": Float32(999.9)}})
+	_, ok := out.(Float32MapMapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32MapMapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToOutputFloat32ArrayArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToOutput(Float32ArrayArray{Float32Array{Float32(999.9)}})
+	_, ok := out.(Float32ArrayArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = ToOutput(out)
+	_, ok = out.(Float32ArrayArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
 func TestToOutputFloat64(t *testing.T) {
 	t.Parallel()
 
@@ -1904,11 +2208,11 @@ func TestToOutputIntArrayArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputString(t *testing.T) {
+func TestToOutputInt64(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(String("foo"))
-	_, ok := out.(StringInput)
+	out := ToOutput(Int64(42))
+	_, ok := out.(Int64Input)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -1916,7 +2220,7 @@ func TestToOutputString(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringInput)
+	_, ok = out.(Int64Input)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -1924,11 +2228,11 @@ func TestToOutputString(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringPtr(t *testing.T) {
+func TestToOutputInt64Ptr(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringPtr(string(String("foo"))))
-	_, ok := out.(StringPtrInput)
+	out := ToOutput(Int64Ptr(int64(Int64(42))))
+	_, ok := out.(Int64PtrInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -1936,7 +2240,7 @@ func TestToOutputStringPtr(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringPtrInput)
+	_, ok = out.(Int64PtrInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -1944,11 +2248,11 @@ func TestToOutputStringPtr(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringArray(t *testing.T) {
+func TestToOutputInt64Array(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringArray{String("foo")})
-	_, ok := out.(StringArrayInput)
+	out := ToOutput(Int64Array{Int64(42)})
+	_, ok := out.(Int64ArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -1956,7 +2260,7 @@ func TestToOutputStringArray(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringArrayInput)
+	_, ok = out.(Int64ArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -1964,11 +2268,11 @@ func TestToOutputStringArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringMap(t *testing.T) {
+func TestToOutputInt64Map(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringMap{"baz": String("foo")})
-	_, ok := out.(StringMapInput)
+	out := ToOutput(Int64Map{"baz": Int64(42)})
+	_, ok := out.(Int64MapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -1976,7 +2280,7 @@ func TestToOutputStringMap(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringMapInput)
+	_, ok = out.(Int64MapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -1984,11 +2288,11 @@ func TestToOutputStringMap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringArrayMap(t *testing.T) {
+func TestToOutputInt64ArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringArrayMap{"baz": StringArray{String("foo")}})
-	_, ok := out.(StringArrayMapInput)
+	out := ToOutput(Int64ArrayMap{"baz": Int64Array{Int64(42)}})
+	_, ok := out.(Int64ArrayMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -1996,7 +2300,7 @@ func TestToOutputStringArrayMap(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringArrayMapInput)
+	_, ok = out.(Int64ArrayMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2004,11 +2308,11 @@ func TestToOutputStringArrayMap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringMapArray(t *testing.T) {
+func TestToOutputInt64MapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringMapArray{StringMap{"baz": String("foo")}})
-	_, ok := out.(StringMapArrayInput)
+	out := ToOutput(Int64MapArray{Int64Map{"baz": Int64(42)}})
+	_, ok := out.(Int64MapArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2016,7 +2320,7 @@ func TestToOutputStringMapArray(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringMapArrayInput)
+	_, ok = out.(Int64MapArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2024,11 +2328,11 @@ func TestToOutputStringMapArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringMapMap(t *testing.T) {
+func TestToOutputInt64MapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringMapMap{"baz": StringMap{"baz": String("foo")}})
-	_, ok := out.(StringMapMapInput)
+	out := ToOutput(Int64MapMap{"baz": Int64Map{"baz": Int64(42)}})
+	_, ok := out.(Int64MapMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2036,7 +2340,7 @@ func TestToOutputStringMapMap(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringMapMapInput)
+	_, ok = out.(Int64MapMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2044,11 +2348,11 @@ func TestToOutputStringMapMap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputStringArrayArray(t *testing.T) {
+func TestToOutputInt64ArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(StringArrayArray{StringArray{String("foo")}})
-	_, ok := out.(StringArrayArrayInput)
+	out := ToOutput(Int64ArrayArray{Int64Array{Int64(42)}})
+	_, ok := out.(Int64ArrayArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2056,7 +2360,7 @@ func TestToOutputStringArrayArray(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(StringArrayArrayInput)
+	_, ok = out.(Int64ArrayArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2064,11 +2368,11 @@ func TestToOutputStringArrayArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURN(t *testing.T) {
+func TestToOutputString(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URN("foo"))
-	_, ok := out.(URNInput)
+	out := ToOutput(String("foo"))
+	_, ok := out.(StringInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2076,7 +2380,7 @@ func TestToOutputURN(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNInput)
+	_, ok = out.(StringInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2084,11 +2388,11 @@ func TestToOutputURN(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNPtr(t *testing.T) {
+func TestToOutputStringPtr(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNPtr(URN(URN("foo"))))
-	_, ok := out.(URNPtrInput)
+	out := ToOutput(StringPtr(string(String("foo"))))
+	_, ok := out.(StringPtrInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2096,7 +2400,7 @@ func TestToOutputURNPtr(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNPtrInput)
+	_, ok = out.(StringPtrInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2104,11 +2408,11 @@ func TestToOutputURNPtr(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNArray(t *testing.T) {
+func TestToOutputStringArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNArray{URN("foo")})
-	_, ok := out.(URNArrayInput)
+	out := ToOutput(StringArray{String("foo")})
+	_, ok := out.(StringArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2116,7 +2420,7 @@ func TestToOutputURNArray(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNArrayInput)
+	_, ok = out.(StringArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2124,11 +2428,11 @@ func TestToOutputURNArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNMap(t *testing.T) {
+func TestToOutputStringMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNMap{"baz": URN("foo")})
-	_, ok := out.(URNMapInput)
+	out := ToOutput(StringMap{"baz": String("foo")})
+	_, ok := out.(StringMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2136,7 +2440,7 @@ func TestToOutputURNMap(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNMapInput)
+	_, ok = out.(StringMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2144,11 +2448,11 @@ func TestToOutputURNMap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNArrayMap(t *testing.T) {
+func TestToOutputStringArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNArrayMap{"baz": URNArray{URN("foo")}})
-	_, ok := out.(URNArrayMapInput)
+	out := ToOutput(StringArrayMap{"baz": StringArray{String("foo")}})
+	_, ok := out.(StringArrayMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2156,7 +2460,7 @@ func TestToOutputURNArrayMap(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNArrayMapInput)
+	_, ok = out.(StringArrayMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2164,11 +2468,11 @@ func TestToOutputURNArrayMap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNMapArray(t *testing.T) {
+func TestToOutputStringMapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNMapArray{URNMap{"baz": URN("foo")}})
-	_, ok := out.(URNMapArrayInput)
+	out := ToOutput(StringMapArray{StringMap{"baz": String("foo")}})
+	_, ok := out.(StringMapArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2176,7 +2480,7 @@ func TestToOutputURNMapArray(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNMapArrayInput)
+	_, ok = out.(StringMapArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2184,11 +2488,11 @@ func TestToOutputURNMapArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNMapMap(t *testing.T) {
+func TestToOutputStringMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNMapMap{"baz": URNMap{"baz": URN("foo")}})
-	_, ok := out.(URNMapMapInput)
+	out := ToOutput(StringMapMap{"baz": StringMap{"baz": String("foo")}})
+	_, ok := out.(StringMapMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2196,7 +2500,7 @@ func TestToOutputURNMapMap(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNMapMapInput)
+	_, ok = out.(StringMapMapInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2204,11 +2508,11 @@ func TestToOutputURNMapMap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToOutputURNArrayArray(t *testing.T) {
+func TestToOutputStringArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToOutput(URNArrayArray{URNArray{URN("foo")}})
-	_, ok := out.(URNArrayArrayInput)
+	out := ToOutput(StringArrayArray{StringArray{String("foo")}})
+	_, ok := out.(StringArrayArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
@@ -2216,7 +2520,7 @@ func TestToOutputURNArrayArray(t *testing.T) {
 	assert.NoError(t, err)
 
 	out = ToOutput(out)
-	_, ok = out.(URNArrayArrayInput)
+	_, ok = out.(StringArrayArrayInput)
 	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
@@ -2224,272 +2528,592 @@ func TestToOutputURNArrayArray(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-// Test that type-specific ToOutput methods work with all builtin input and output types
-
-func TestToArchiveOutput(t *testing.T) {
+func TestToOutputURN(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveInput(NewFileArchive("foo.zip"))
-
-	out := in.ToArchiveOutput()
+	out := ToOutput(URN("foo"))
+	_, ok := out.(URNInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveOutput()
+	out = ToOutput(out)
+	_, ok = out.(URNInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveOutputWithContext(context.Background())
+func TestToOutputURNPtr(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(URNPtr(URN(URN("foo"))))
+	_, ok := out.(URNPtrInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(URNPtrInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToArchiveArrayOutput(t *testing.T) {
+func TestToOutputURNArray(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveArrayInput(ArchiveArray{NewFileArchive("foo.zip")})
-
-	out := in.ToArchiveArrayOutput()
+	out := ToOutput(URNArray{URN("foo")})
+	_, ok := out.(URNArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveArrayOutput()
+	out = ToOutput(out)
+	_, ok = out.(URNArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveArrayOutputWithContext(context.Background())
+func TestToOutputURNMap(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(URNMap{"baz": URN("foo")})
+	_, ok := out.(URNMapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveArrayOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(URNMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToArchiveMapOutput(t *testing.T) {
+func TestToOutputURNArrayMap(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveMapInput(ArchiveMap{"baz": NewFileArchive("foo.zip")})
-
-	out := in.ToArchiveMapOutput()
+	out := ToOutput(URNArrayMap{"baz": URNArray{URN("foo")}})
+	_, ok := out.(URNArrayMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveMapOutput()
+	out = ToOutput(out)
+	_, ok = out.(URNArrayMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveMapOutputWithContext(context.Background())
+func TestToOutputURNMapArray(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(URNMapArray{URNMap{"baz": URN("foo")}})
+	_, ok := out.(URNMapArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveMapOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(URNMapArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToArchiveArrayMapOutput(t *testing.T) {
+func TestToOutputURNMapMap(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveArrayMapInput(ArchiveArrayMap{"baz": ArchiveArray{NewFileArchive("foo.zip")}})
-
-	out := in.ToArchiveArrayMapOutput()
+	out := ToOutput(URNMapMap{"baz": URNMap{"baz": URN("foo")}})
+	_, ok := out.(URNMapMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveArrayMapOutput()
+	out = ToOutput(out)
+	_, ok = out.(URNMapMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveArrayMapOutputWithContext(context.Background())
+func TestToOutputURNArrayArray(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(URNArrayArray{URNArray{URN("foo")}})
+	_, ok := out.(URNArrayArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveArrayMapOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(URNArrayArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToArchiveMapArrayOutput(t *testing.T) {
+func TestToOutputUint64(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveMapArrayInput(ArchiveMapArray{ArchiveMap{"baz": NewFileArchive("foo.zip")}})
-
-	out := in.ToArchiveMapArrayOutput()
+	out := ToOutput(Uint64(42))
+	_, ok := out.(Uint64Input)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveMapArrayOutput()
+	out = ToOutput(out)
+	_, ok = out.(Uint64Input)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveMapArrayOutputWithContext(context.Background())
+func TestToOutputUint64Ptr(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(Uint64Ptr(uint64(Uint64(42))))
+	_, ok := out.(Uint64PtrInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveMapArrayOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(Uint64PtrInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToArchiveMapMapOutput(t *testing.T) {
+func TestToOutputUint64Array(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveMapMapInput(ArchiveMapMap{"baz": ArchiveMap{"baz": NewFileArchive("foo.zip")}})
-
-	out := in.ToArchiveMapMapOutput()
+	out := ToOutput(Uint64Array{Uint64(42)})
+	_, ok := out.(Uint64ArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveMapMapOutput()
+	out = ToOutput(out)
+	_, ok = out.(Uint64ArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveMapMapOutputWithContext(context.Background())
+func TestToOutputUint64Map(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(Uint64Map{"baz": Uint64(42)})
+	_, ok := out.(Uint64MapInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveMapMapOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(Uint64MapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToArchiveArrayArrayOutput(t *testing.T) {
+func TestToOutputUint64ArrayMap(t *testing.T) {
 	t.Parallel()
 
-	in := ArchiveArrayArrayInput(ArchiveArrayArray{ArchiveArray{NewFileArchive("foo.zip")}})
-
-	out := in.ToArchiveArrayArrayOutput()
+	out := ToOutput(Uint64ArrayMap{"baz": Uint64Array{Uint64(42)}})
+	_, ok := out.(Uint64ArrayMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveArrayArrayOutput()
+	out = ToOutput(out)
+	_, ok = out.(Uint64ArrayMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToArchiveArrayArrayOutputWithContext(context.Background())
+func TestToOutputUint64MapArray(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(Uint64MapArray{Uint64Map{"baz": Uint64(42)}})
+	_, ok := out.(Uint64MapArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToArchiveArrayArrayOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(Uint64MapArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToAssetOutput(t *testing.T) {
+func TestToOutputUint64MapMap(t *testing.T) {
 	t.Parallel()
 
-	in := AssetInput(NewFileAsset("foo.txt"))
-
-	out := in.ToAssetOutput()
+	out := ToOutput(Uint64MapMap{"baz": Uint64Map{"baz": Uint64(42)}})
+	_, ok := out.(Uint64MapMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToAssetOutput()
+	out = ToOutput(out)
+	_, ok = out.(Uint64MapMapInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
+}
 
-	out = in.ToAssetOutputWithContext(context.Background())
+func TestToOutputUint64ArrayArray(t *testing.T) {
+	t.Parallel()
 
-	_, known, _, _, err = await(out)
+	out := ToOutput(Uint64ArrayArray{Uint64Array{Uint64(42)}})
+	_, ok := out.(Uint64ArrayArrayInput)
+	assert.True(t, ok)
+
+	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToAssetOutputWithContext(context.Background())
+	out = ToOutput(out)
+	_, ok = out.(Uint64ArrayArrayInput)
+	assert.True(t, ok)
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToAssetArrayOutput(t *testing.T) {
+// Test that type-specific ToOutput methods work with all builtin input and output types
+
+func TestToArchiveOutput(t *testing.T) {
 	t.Parallel()
 
-	in := AssetArrayInput(AssetArray{NewFileAsset("foo.txt")})
+	in := ArchiveInput(NewFileArchive("foo.zip"))
 
-	out := in.ToAssetArrayOutput()
+	out := in.ToArchiveOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToAssetArrayOutput()
+	out = out.ToArchiveOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToAssetArrayOutputWithContext(context.Background())
+	out = in.ToArchiveOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToAssetArrayOutputWithContext(context.Background())
+	out = out.ToArchiveOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToArchiveArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := ArchiveArrayInput(ArchiveArray{NewFileArchive("foo.zip")})
+
+	out := in.ToArchiveArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToArchiveArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToArchiveMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := ArchiveMapInput(ArchiveMap{"baz": NewFileArchive("foo.zip")})
+
+	out := in.ToArchiveMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToArchiveMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToArchiveArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := ArchiveArrayMapInput(ArchiveArrayMap{"baz": ArchiveArray{NewFileArchive("foo.zip")}})
+
+	out := in.ToArchiveArrayMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveArrayMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToArchiveArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToArchiveMapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := ArchiveMapArrayInput(ArchiveMapArray{ArchiveMap{"baz": NewFileArchive("foo.zip")}})
+
+	out := in.ToArchiveMapArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveMapArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToArchiveMapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveMapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToArchiveMapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := ArchiveMapMapInput(ArchiveMapMap{"baz": ArchiveMap{"baz": NewFileArchive("foo.zip")}})
+
+	out := in.ToArchiveMapMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveMapMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToArchiveMapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveMapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToArchiveArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := ArchiveArrayArrayInput(ArchiveArrayArray{ArchiveArray{NewFileArchive("foo.zip")}})
+
+	out := in.ToArchiveArrayArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveArrayArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToArchiveArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToArchiveArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToAssetOutput(t *testing.T) {
+	t.Parallel()
+
+	in := AssetInput(NewFileAsset("foo.txt"))
+
+	out := in.ToAssetOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToAssetOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToAssetOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToAssetOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToAssetArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := AssetArrayInput(AssetArray{NewFileAsset("foo.txt")})
+
+	out := in.ToAssetArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToAssetArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToAssetArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToAssetArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
@@ -3096,78 +3720,318 @@ func TestToBoolArrayArrayOutput(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToFloat64Output(t *testing.T) {
+func TestToFloat32Output(t *testing.T) {
 	t.Parallel()
 
-	in := Float64Input(Float64(999.9))
+	in := Float32Input(Float32(999.9))
 
-	out := in.ToFloat64Output()
+	out := in.ToFloat32Output()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToFloat64Output()
+	out = out.ToFloat32Output()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToFloat64OutputWithContext(context.Background())
+	out = in.ToFloat32OutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToFloat64OutputWithContext(context.Background())
+	out = out.ToFloat32OutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToFloat64PtrOutput(t *testing.T) {
+func TestToFloat32PtrOutput(t *testing.T) {
 	t.Parallel()
 
-	in := Float64PtrInput(Float64Ptr(float64(Float64(999.9))))
+	in := Float32PtrInput(Float32Ptr(float32(Float32(999.9))))
 
-	out := in.ToFloat64PtrOutput()
+	out := in.ToFloat32PtrOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToFloat64PtrOutput()
+	out = out.ToFloat32PtrOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToFloat64PtrOutputWithContext(context.Background())
+	out = in.ToFloat32PtrOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToFloat64PtrOutputWithContext(context.Background())
+	out = out.ToFloat32PtrOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToFloat64ArrayOutput(t *testing.T) {
+func TestToFloat32ArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	in := Float64ArrayInput(Float64Array{Float64(999.9)})
+	in := Float32ArrayInput(Float32Array{Float32(999.9)})
 
-	out := in.ToFloat64ArrayOutput()
+	out := in.ToFloat32ArrayOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToFloat64ArrayOutput()
+	out = out.ToFloat32ArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat32ArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32ArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat32MapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float32MapInput(Float32Map{"baz": Float32(999.9)})
+
+	out := in.ToFloat32MapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32MapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat32MapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32MapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat32ArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float32ArrayMapInput(Float32ArrayMap{"baz": Float32Array{Float32(999.9)}})
+
+	out := in.ToFloat32ArrayMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32ArrayMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat32ArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32ArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat32MapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float32MapArrayInput(Float32MapArray{Float32Map{"baz": Float32(999.9)}})
+
+	out := in.ToFloat32MapArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32MapArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat32MapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32MapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat32MapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float32MapMapInput(Float32MapMap{"baz": Float32Map{"baz": Float32(999.9)}})
+
+	out := in.ToFloat32MapMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32MapMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat32MapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32MapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat32ArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float32ArrayArrayInput(Float32ArrayArray{Float32Array{Float32(999.9)}})
+
+	out := in.ToFloat32ArrayArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32ArrayArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat32ArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat32ArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat64Output(t *testing.T) {
+	t.Parallel()
+
+	in := Float64Input(Float64(999.9))
+
+	out := in.ToFloat64Output()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat64Output()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat64OutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat64OutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat64PtrOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float64PtrInput(Float64Ptr(float64(Float64(999.9))))
+
+	out := in.ToFloat64PtrOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat64PtrOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToFloat64PtrOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat64PtrOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToFloat64ArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Float64ArrayInput(Float64Array{Float64(999.9)})
+
+	out := in.ToFloat64ArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToFloat64ArrayOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
@@ -4026,240 +4890,480 @@ func TestToIntArrayArrayOutput(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestToStringOutput(t *testing.T) {
+func TestToInt64Output(t *testing.T) {
 	t.Parallel()
 
-	in := StringInput(String("foo"))
+	in := Int64Input(Int64(42))
 
-	out := in.ToStringOutput()
+	out := in.ToInt64Output()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringOutput()
+	out = out.ToInt64Output()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringOutputWithContext(context.Background())
+	out = in.ToInt64OutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringOutputWithContext(context.Background())
+	out = out.ToInt64OutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringPtrOutput(t *testing.T) {
+func TestToInt64PtrOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringPtrInput(StringPtr(string(String("foo"))))
+	in := Int64PtrInput(Int64Ptr(int64(Int64(42))))
 
-	out := in.ToStringPtrOutput()
+	out := in.ToInt64PtrOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringPtrOutput()
+	out = out.ToInt64PtrOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringPtrOutputWithContext(context.Background())
+	out = in.ToInt64PtrOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringPtrOutputWithContext(context.Background())
+	out = out.ToInt64PtrOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringArrayOutput(t *testing.T) {
+func TestToInt64ArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringArrayInput(StringArray{String("foo")})
+	in := Int64ArrayInput(Int64Array{Int64(42)})
 
-	out := in.ToStringArrayOutput()
+	out := in.ToInt64ArrayOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringArrayOutput()
+	out = out.ToInt64ArrayOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringArrayOutputWithContext(context.Background())
+	out = in.ToInt64ArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringArrayOutputWithContext(context.Background())
+	out = out.ToInt64ArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringMapOutput(t *testing.T) {
+func TestToInt64MapOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringMapInput(StringMap{"baz": String("foo")})
+	in := Int64MapInput(Int64Map{"baz": Int64(42)})
 
-	out := in.ToStringMapOutput()
+	out := in.ToInt64MapOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringMapOutput()
+	out = out.ToInt64MapOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringMapOutputWithContext(context.Background())
+	out = in.ToInt64MapOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringMapOutputWithContext(context.Background())
+	out = out.ToInt64MapOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringArrayMapOutput(t *testing.T) {
+func TestToInt64ArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringArrayMapInput(StringArrayMap{"baz": StringArray{String("foo")}})
+	in := Int64ArrayMapInput(Int64ArrayMap{"baz": Int64Array{Int64(42)}})
 
-	out := in.ToStringArrayMapOutput()
+	out := in.ToInt64ArrayMapOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringArrayMapOutput()
+	out = out.ToInt64ArrayMapOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringArrayMapOutputWithContext(context.Background())
+	out = in.ToInt64ArrayMapOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringArrayMapOutputWithContext(context.Background())
+	out = out.ToInt64ArrayMapOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringMapArrayOutput(t *testing.T) {
+func TestToInt64MapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringMapArrayInput(StringMapArray{StringMap{"baz": String("foo")}})
+	in := Int64MapArrayInput(Int64MapArray{Int64Map{"baz": Int64(42)}})
 
-	out := in.ToStringMapArrayOutput()
+	out := in.ToInt64MapArrayOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringMapArrayOutput()
+	out = out.ToInt64MapArrayOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringMapArrayOutputWithContext(context.Background())
+	out = in.ToInt64MapArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringMapArrayOutputWithContext(context.Background())
+	out = out.ToInt64MapArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringMapMapOutput(t *testing.T) {
+func TestToInt64MapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringMapMapInput(StringMapMap{"baz": StringMap{"baz": String("foo")}})
+	in := Int64MapMapInput(Int64MapMap{"baz": Int64Map{"baz": Int64(42)}})
 
-	out := in.ToStringMapMapOutput()
+	out := in.ToInt64MapMapOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringMapMapOutput()
+	out = out.ToInt64MapMapOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringMapMapOutputWithContext(context.Background())
+	out = in.ToInt64MapMapOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringMapMapOutputWithContext(context.Background())
+	out = out.ToInt64MapMapOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 }
 
-func TestToStringArrayArrayOutput(t *testing.T) {
+func TestToInt64ArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	in := StringArrayArrayInput(StringArrayArray{StringArray{String("foo")}})
+	in := Int64ArrayArrayInput(Int64ArrayArray{Int64Array{Int64(42)}})
 
-	out := in.ToStringArrayArrayOutput()
+	out := in.ToInt64ArrayArrayOutput()
 
 	_, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringArrayArrayOutput()
+	out = out.ToInt64ArrayArrayOutput()
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToStringArrayArrayOutputWithContext(context.Background())
+	out = in.ToInt64ArrayArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToStringArrayArrayOutputWithContext(context.Background())
+	out = out.ToInt64ArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringInput(String("foo"))
+
+	out := in.ToStringOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringPtrOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringPtrInput(StringPtr(string(String("foo"))))
+
+	out := in.ToStringPtrOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringPtrOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringPtrOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringPtrOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringArrayInput(StringArray{String("foo")})
+
+	out := in.ToStringArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringMapInput(StringMap{"baz": String("foo")})
+
+	out := in.ToStringMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringArrayMapInput(StringArrayMap{"baz": StringArray{String("foo")}})
+
+	out := in.ToStringArrayMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringArrayMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringMapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringMapArrayInput(StringMapArray{StringMap{"baz": String("foo")}})
+
+	out := in.ToStringMapArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringMapArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringMapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringMapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringMapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringMapMapInput(StringMapMap{"baz": StringMap{"baz": String("foo")}})
+
+	out := in.ToStringMapMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringMapMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringMapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringMapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToStringArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := StringArrayArrayInput(StringArrayArray{StringArray{String("foo")}})
+
+	out := in.ToStringArrayArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringArrayArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToStringArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToStringArrayArrayOutputWithContext(context.Background())
 
 	_, known, _, _, err = await(out)
 	assert.True(t, known)
@@ -4471,206 +5575,1438 @@ func TestToURNMapMapOutput(t *testing.T) {
 
 	out = out.ToURNMapMapOutputWithContext(context.Background())
 
-	_, known, _, _, err = await(out)
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToURNArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := URNArrayArrayInput(URNArrayArray{URNArray{URN("foo")}})
+
+	out := in.ToURNArrayArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToURNArrayArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToURNArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToURNArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64Output(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64Input(Uint64(42))
+
+	out := in.ToUint64Output()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64Output()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64OutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64OutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64PtrOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64PtrInput(Uint64Ptr(uint64(Uint64(42))))
+
+	out := in.ToUint64PtrOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64PtrOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64PtrOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64PtrOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64ArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64ArrayInput(Uint64Array{Uint64(42)})
+
+	out := in.ToUint64ArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64ArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64ArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64ArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64MapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64MapInput(Uint64Map{"baz": Uint64(42)})
+
+	out := in.ToUint64MapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64MapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64MapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64MapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64ArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64ArrayMapInput(Uint64ArrayMap{"baz": Uint64Array{Uint64(42)}})
+
+	out := in.ToUint64ArrayMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64ArrayMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64ArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64ArrayMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64MapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64MapArrayInput(Uint64MapArray{Uint64Map{"baz": Uint64(42)}})
+
+	out := in.ToUint64MapArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64MapArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64MapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64MapArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64MapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64MapMapInput(Uint64MapMap{"baz": Uint64Map{"baz": Uint64(42)}})
+
+	out := in.ToUint64MapMapOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64MapMapOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64MapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64MapMapOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+func TestToUint64ArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	in := Uint64ArrayArrayInput(Uint64ArrayArray{Uint64Array{Uint64(42)}})
+
+	out := in.ToUint64ArrayArrayOutput()
+
+	_, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64ArrayArrayOutput()
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = in.ToUint64ArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	out = out.ToUint64ArrayArrayOutputWithContext(context.Background())
+
+	_, known, _, _, err = await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+}
+
+// Test type-specific ToOutput methods for builtins that implement other builtin input types.
+func TestBuiltinConversions(t *testing.T) {
+	t.Parallel()
+
+	archiveIn := NewFileArchive("foo.zip")
+	assetOrArchiveOut := archiveIn.ToAssetOrArchiveOutput()
+	archiveV, known, _, _, err := await(assetOrArchiveOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, archiveIn, archiveV)
+
+	archiveOut := archiveIn.ToArchiveOutput()
+	assetOrArchiveOut = archiveOut.ToAssetOrArchiveOutput()
+	archiveV, known, _, _, err = await(assetOrArchiveOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, archiveIn, archiveV)
+
+	assetIn := NewFileAsset("foo.zip")
+	assetOrArchiveOut = assetIn.ToAssetOrArchiveOutput()
+	assetV, known, _, _, err := await(assetOrArchiveOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, assetIn, assetV)
+
+	assetOut := assetIn.ToAssetOutput()
+	assetOrArchiveOut = assetOut.ToAssetOrArchiveOutput()
+	assetV, known, _, _, err = await(assetOrArchiveOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, assetIn, assetV)
+
+	idIn := ID("foo")
+	stringOut := idIn.ToStringOutput()
+	stringV, known, _, _, err := await(stringOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, string(idIn), stringV)
+
+	idOut := idIn.ToIDOutput()
+	stringOut = idOut.ToStringOutput()
+	stringV, known, _, _, err = await(stringOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, string(idIn), stringV)
+
+	urnIn := URN("foo")
+	stringOut = urnIn.ToStringOutput()
+	stringV, known, _, _, err = await(stringOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, string(urnIn), stringV)
+
+	urnOut := urnIn.ToURNOutput()
+	stringOut = urnOut.ToStringOutput()
+	stringV, known, _, _, err = await(stringOut)
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Equal(t, string(urnIn), stringV)
+}
+
+// Test pointer types.
+
+func TestBoolPtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (BoolPtr(bool(Bool(true)))).ToBoolPtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*bool)), iv)
+}
+
+func TestFloat32PtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (Float32Ptr(float32(Float32(999.9)))).ToFloat32PtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*float32)), iv)
+}
+
+func TestFloat64PtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (Float64Ptr(float64(Float64(999.9)))).ToFloat64PtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*float64)), iv)
+}
+
+func TestIDPtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (IDPtr(ID(ID("foo")))).ToIDPtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*ID)), iv)
+}
+
+func TestIntPtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (IntPtr(int(Int(42)))).ToIntPtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*int)), iv)
+}
+
+func TestInt64PtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (Int64Ptr(int64(Int64(42)))).ToInt64PtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*int64)), iv)
+}
+
+func TestStringPtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (StringPtr(string(String("foo")))).ToStringPtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*string)), iv)
+}
+
+func TestURNPtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (URNPtr(URN(URN("foo")))).ToURNPtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*URN)), iv)
+}
+
+func TestUint64PtrElem(t *testing.T) {
+	t.Parallel()
+
+	out := (Uint64Ptr(uint64(Uint64(42)))).ToUint64PtrOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Elem())
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, *(av.(*uint64)), iv)
+}
+
+// Test array indexers.
+
+func TestArchiveArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (ArchiveArray{NewFileArchive("foo.zip")}).ToArchiveArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]Archive)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToArchiveArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToArchiveArray([]Archive{NewFileArchive("foo.zip")}).ToArchiveArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]Archive)[0], iv)
+}
+
+func TestTopLevelToArchiveArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToArchiveArrayOutput([]ArchiveOutput{ToOutput(NewFileArchive("foo.zip")).(ArchiveOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]Archive)[0], iv)
+}
+
+func TestArchiveMapArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (ArchiveMapArray{ArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToArchiveMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]Archive)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToArchiveMapArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToArchiveMapArray([]map[string]Archive{{"baz": NewFileArchive("foo.zip")}}).ToArchiveMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]Archive)[0], iv)
+}
+
+func TestTopLevelToArchiveMapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToArchiveMapArrayOutput([]ArchiveMapOutput{ToOutput(ArchiveMap{"baz": NewFileArchive("foo.zip")}).(ArchiveMapOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]Archive)[0], iv)
+}
+
+func TestArchiveArrayArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (ArchiveArrayArray{ArchiveArray{NewFileArchive("foo.zip")}}).ToArchiveArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([][]Archive)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToArchiveArrayArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToArchiveArrayArray([][]Archive{{NewFileArchive("foo.zip")}}).ToArchiveArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]Archive)[0], iv)
+}
+
+func TestTopLevelToArchiveArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToArchiveArrayArrayOutput([]ArchiveArrayOutput{ToOutput(ArchiveArray{NewFileArchive("foo.zip")}).(ArchiveArrayOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]Archive)[0], iv)
+}
+
+func TestAssetArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (AssetArray{NewFileAsset("foo.txt")}).ToAssetArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]Asset)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToAssetArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToAssetArray([]Asset{NewFileAsset("foo.txt")}).ToAssetArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]Asset)[0], iv)
+}
+
+func TestTopLevelToAssetArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToAssetArrayOutput([]AssetOutput{ToOutput(NewFileAsset("foo.txt")).(AssetOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]Asset)[0], iv)
+}
+
+func TestAssetMapArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (AssetMapArray{AssetMap{"baz": NewFileAsset("foo.txt")}}).ToAssetMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]Asset)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToAssetMapArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToAssetMapArray([]map[string]Asset{{"baz": NewFileAsset("foo.txt")}}).ToAssetMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]Asset)[0], iv)
+}
+
+func TestTopLevelToAssetMapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToAssetMapArrayOutput([]AssetMapOutput{ToOutput(AssetMap{"baz": NewFileAsset("foo.txt")}).(AssetMapOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]Asset)[0], iv)
+}
+
+func TestAssetArrayArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (AssetArrayArray{AssetArray{NewFileAsset("foo.txt")}}).ToAssetArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([][]Asset)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToAssetArrayArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToAssetArrayArray([][]Asset{{NewFileAsset("foo.txt")}}).ToAssetArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]Asset)[0], iv)
+}
+
+func TestTopLevelToAssetArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToAssetArrayArrayOutput([]AssetArrayOutput{ToOutput(AssetArray{NewFileAsset("foo.txt")}).(AssetArrayOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]Asset)[0], iv)
+}
+
+func TestAssetOrArchiveArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (AssetOrArchiveArray{NewFileArchive("foo.zip")}).ToAssetOrArchiveArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]AssetOrArchive)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestAssetOrArchiveMapArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (AssetOrArchiveMapArray{AssetOrArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToAssetOrArchiveMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]AssetOrArchive)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestAssetOrArchiveArrayArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (AssetOrArchiveArrayArray{AssetOrArchiveArray{NewFileArchive("foo.zip")}}).ToAssetOrArchiveArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([][]AssetOrArchive)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestBoolArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (BoolArray{Bool(true)}).ToBoolArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]bool)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToBoolArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToBoolArray([]bool{true}).ToBoolArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]bool)[0], iv)
+}
+
+func TestTopLevelToBoolArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToBoolArrayOutput([]BoolOutput{ToOutput(Bool(true)).(BoolOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]bool)[0], iv)
+}
+
+func TestBoolMapArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (BoolMapArray{BoolMap{"baz": Bool(true)}}).ToBoolMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]bool)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToBoolMapArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToBoolMapArray([]map[string]bool{{"baz": true}}).ToBoolMapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]bool)[0], iv)
+}
+
+func TestTopLevelToBoolMapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToBoolMapArrayOutput([]BoolMapOutput{ToOutput(BoolMap{"baz": Bool(true)}).(BoolMapOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]bool)[0], iv)
+}
+
+func TestBoolArrayArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (BoolArrayArray{BoolArray{Bool(true)}}).ToBoolArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([][]bool)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToBoolArrayArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToBoolArrayArray([][]bool{{true}}).ToBoolArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]bool)[0], iv)
+}
+
+func TestTopLevelToBoolArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToBoolArrayArrayOutput([]BoolArrayOutput{ToOutput(BoolArray{Bool(true)}).(BoolArrayOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]bool)[0], iv)
+}
+
+func TestFloat32ArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (Float32Array{Float32(999.9)}).ToFloat32ArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]float32)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToFloat32Array(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat32Array([]float32{999.9}).ToFloat32ArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]float32)[0], iv)
+}
+
+func TestTopLevelToFloat32ArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat32ArrayOutput([]Float32Output{ToOutput(Float32(999.9)).(Float32Output)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]float32)[0], iv)
+}
+
+func TestFloat32MapArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (Float32MapArray{Float32Map{"baz": Float32(999.9)}}).ToFloat32MapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]float32)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToFloat32MapArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat32MapArray([]map[string]float32{{"baz": 999.9}}).ToFloat32MapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]float32)[0], iv)
+}
+
+func TestTopLevelToFloat32MapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat32MapArrayOutput([]Float32MapOutput{ToOutput(Float32Map{"baz": Float32(999.9)}).(Float32MapOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]float32)[0], iv)
+}
+
+func TestFloat32ArrayArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (Float32ArrayArray{Float32Array{Float32(999.9)}}).ToFloat32ArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([][]float32)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToFloat32ArrayArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat32ArrayArray([][]float32{{999.9}}).ToFloat32ArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]float32)[0], iv)
+}
+
+func TestTopLevelToFloat32ArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat32ArrayArrayOutput([]Float32ArrayOutput{ToOutput(Float32Array{Float32(999.9)}).(Float32ArrayOutput)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([][]float32)[0], iv)
+}
+
+func TestFloat64ArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (Float64Array{Float64(999.9)}).ToFloat64ArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]float64)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
+}
+
+func TestToFloat64Array(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat64Array([]float64{999.9}).ToFloat64ArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]float64)[0], iv)
+}
+
+func TestTopLevelToFloat64ArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat64ArrayOutput([]Float64Output{ToOutput(Float64(999.9)).(Float64Output)})
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]float64)[0], iv)
+}
+
+func TestFloat64MapArrayIndex(t *testing.T) {
+	t.Parallel()
+
+	out := (Float64MapArray{Float64Map{"baz": Float64(999.9)}}).ToFloat64MapArrayOutput()
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	iv, known, _, _, err := await(out.Index(Int(0)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]float64)[0], iv)
+
+	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
 	assert.NoError(t, err)
+	assert.Zero(t, iv)
 }
 
-func TestToURNArrayArrayOutput(t *testing.T) {
+func TestToFloat64MapArray(t *testing.T) {
 	t.Parallel()
 
-	in := URNArrayArrayInput(URNArrayArray{URNArray{URN("foo")}})
-
-	out := in.ToURNArrayArrayOutput()
+	out := ToFloat64MapArray([]map[string]float64{{"baz": 999.9}}).ToFloat64MapArrayOutput()
 
-	_, known, _, _, err := await(out)
+	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToURNArrayArrayOutput()
-
-	_, known, _, _, err = await(out)
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = in.ToURNArrayArrayOutputWithContext(context.Background())
+	assert.EqualValues(t, av.([]map[string]float64)[0], iv)
+}
 
-	_, known, _, _, err = await(out)
+func TestTopLevelToFloat64MapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat64MapArrayOutput([]Float64MapOutput{ToOutput(Float64Map{"baz": Float64(999.9)}).(Float64MapOutput)})
+
+	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	out = out.ToURNArrayArrayOutputWithContext(context.Background())
-
-	_, known, _, _, err = await(out)
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
+
+	assert.EqualValues(t, av.([]map[string]float64)[0], iv)
 }
 
-// Test type-specific ToOutput methods for builtins that implement other builtin input types.
-func TestBuiltinConversions(t *testing.T) {
+func TestFloat64ArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	archiveIn := NewFileArchive("foo.zip")
-	assetOrArchiveOut := archiveIn.ToAssetOrArchiveOutput()
-	archiveV, known, _, _, err := await(assetOrArchiveOut)
-	assert.True(t, known)
-	assert.NoError(t, err)
-	assert.Equal(t, archiveIn, archiveV)
+	out := (Float64ArrayArray{Float64Array{Float64(999.9)}}).ToFloat64ArrayArrayOutput()
 
-	archiveOut := archiveIn.ToArchiveOutput()
-	assetOrArchiveOut = archiveOut.ToAssetOrArchiveOutput()
-	archiveV, known, _, _, err = await(assetOrArchiveOut)
+	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, archiveIn, archiveV)
 
-	assetIn := NewFileAsset("foo.zip")
-	assetOrArchiveOut = assetIn.ToAssetOrArchiveOutput()
-	assetV, known, _, _, err := await(assetOrArchiveOut)
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, assetIn, assetV)
+	assert.EqualValues(t, av.([][]float64)[0], iv)
 
-	assetOut := assetIn.ToAssetOutput()
-	assetOrArchiveOut = assetOut.ToAssetOrArchiveOutput()
-	assetV, known, _, _, err = await(assetOrArchiveOut)
+	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, assetIn, assetV)
+	assert.Zero(t, iv)
+}
 
-	idIn := ID("foo")
-	stringOut := idIn.ToStringOutput()
-	stringV, known, _, _, err := await(stringOut)
+func TestToFloat64ArrayArray(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat64ArrayArray([][]float64{{999.9}}).ToFloat64ArrayArrayOutput()
+
+	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, string(idIn), stringV)
 
-	idOut := idIn.ToIDOutput()
-	stringOut = idOut.ToStringOutput()
-	stringV, known, _, _, err = await(stringOut)
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, string(idIn), stringV)
 
-	urnIn := URN("foo")
-	stringOut = urnIn.ToStringOutput()
-	stringV, known, _, _, err = await(stringOut)
+	assert.EqualValues(t, av.([][]float64)[0], iv)
+}
+
+func TestTopLevelToFloat64ArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	out := ToFloat64ArrayArrayOutput([]Float64ArrayOutput{ToOutput(Float64Array{Float64(999.9)}).(Float64ArrayOutput)})
+
+	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, string(urnIn), stringV)
 
-	urnOut := urnIn.ToURNOutput()
-	stringOut = urnOut.ToStringOutput()
-	stringV, known, _, _, err = await(stringOut)
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.Equal(t, string(urnIn), stringV)
-}
 
-// Test pointer types.
+	assert.EqualValues(t, av.([][]float64)[0], iv)
+}
 
-func TestBoolPtrElem(t *testing.T) {
+func TestIDArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolPtr(bool(Bool(true)))).ToBoolPtrOutput()
+	out := (IDArray{ID("foo")}).ToIDArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Elem())
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]ID)[0], iv)
 
-	assert.EqualValues(t, *(av.(*bool)), iv)
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
 }
 
-func TestFloat64PtrElem(t *testing.T) {
+func TestToIDArray(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64Ptr(float64(Float64(999.9)))).ToFloat64PtrOutput()
+	out := ToIDArray([]ID{ID("foo")}).ToIDArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Elem())
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, *(av.(*float64)), iv)
+	assert.EqualValues(t, av.([]ID)[0], iv)
 }
 
-func TestIDPtrElem(t *testing.T) {
+func TestTopLevelToIDArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := (IDPtr(ID(ID("foo")))).ToIDPtrOutput()
+	out := ToIDArrayOutput([]IDOutput{ToOutput(ID("foo")).(IDOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Elem())
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, *(av.(*ID)), iv)
+	assert.EqualValues(t, av.([]ID)[0], iv)
 }
 
-func TestIntPtrElem(t *testing.T) {
+func TestIDMapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntPtr(int(Int(42)))).ToIntPtrOutput()
+	out := (IDMapArray{IDMap{"baz": ID("foo")}}).ToIDMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Elem())
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
+	assert.EqualValues(t, av.([]map[string]ID)[0], iv)
 
-	assert.EqualValues(t, *(av.(*int)), iv)
+	iv, known, _, _, err = await(out.Index(Int(-1)))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
 }
 
-func TestStringPtrElem(t *testing.T) {
+func TestToIDMapArray(t *testing.T) {
 	t.Parallel()
 
-	out := (StringPtr(string(String("foo")))).ToStringPtrOutput()
+	out := ToIDMapArray([]map[string]ID{{"baz": ID("foo")}}).ToIDMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Elem())
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, *(av.(*string)), iv)
+	assert.EqualValues(t, av.([]map[string]ID)[0], iv)
 }
 
-func TestURNPtrElem(t *testing.T) {
+func TestTopLevelToIDMapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := (URNPtr(URN(URN("foo")))).ToURNPtrOutput()
+	out := ToIDMapArrayOutput([]IDMapOutput{ToOutput(IDMap{"baz": ID("foo")}).(IDMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Elem())
+	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, *(av.(*URN)), iv)
+	assert.EqualValues(t, av.([]map[string]ID)[0], iv)
 }
 
-// Test array indexers.
-
-func TestArchiveArrayIndex(t *testing.T) {
+func TestIDArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArchiveArray{NewFileArchive("foo.zip")}).ToArchiveArrayOutput()
+	out := (IDArrayArray{IDArray{ID("foo")}}).ToIDArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4679,7 +7015,7 @@ func TestArchiveArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]Archive)[0], iv)
+	assert.EqualValues(t, av.([][]ID)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4687,10 +7023,10 @@ func TestArchiveArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArchiveArray(t *testing.T) {
+func TestToIDArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveArray([]Archive{NewFileArchive("foo.zip")}).ToArchiveArrayOutput()
+	out := ToIDArrayArray([][]ID{{ID("foo")}}).ToIDArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4700,13 +7036,13 @@ func TestToArchiveArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]Archive)[0], iv)
+	assert.EqualValues(t, av.([][]ID)[0], iv)
 }
 
-func TestTopLevelToArchiveArrayOutput(t *testing.T) {
+func TestTopLevelToIDArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveArrayOutput([]ArchiveOutput{ToOutput(NewFileArchive("foo.zip")).(ArchiveOutput)})
+	out := ToIDArrayArrayOutput([]IDArrayOutput{ToOutput(IDArray{ID("foo")}).(IDArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4716,13 +7052,13 @@ func TestTopLevelToArchiveArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]Archive)[0], iv)
+	assert.EqualValues(t, av.([][]ID)[0], iv)
 }
 
-func TestArchiveMapArrayIndex(t *testing.T) {
+func TestArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArchiveMapArray{ArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToArchiveMapArrayOutput()
+	out := (Array{String("any")}).ToArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4731,7 +7067,7 @@ func TestArchiveMapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]Archive)[0], iv)
+	assert.EqualValues(t, av.([]interface{})[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4739,10 +7075,10 @@ func TestArchiveMapArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArchiveMapArray(t *testing.T) {
+func TestToArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveMapArray([]map[string]Archive{{"baz": NewFileArchive("foo.zip")}}).ToArchiveMapArrayOutput()
+	out := ToArray([]interface{}{String("any")}).ToArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4752,13 +7088,13 @@ func TestToArchiveMapArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]Archive)[0], iv)
+	assert.EqualValues(t, av.([]interface{})[0], iv)
 }
 
-func TestTopLevelToArchiveMapArrayOutput(t *testing.T) {
+func TestTopLevelToArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveMapArrayOutput([]ArchiveMapOutput{ToOutput(ArchiveMap{"baz": NewFileArchive("foo.zip")}).(ArchiveMapOutput)})
+	out := ToArrayOutput([]Output{ToOutput(String("any")).(Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4768,13 +7104,13 @@ func TestTopLevelToArchiveMapArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]Archive)[0], iv)
+	assert.EqualValues(t, av.([]interface{})[0], iv)
 }
 
-func TestArchiveArrayArrayIndex(t *testing.T) {
+func TestMapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArchiveArrayArray{ArchiveArray{NewFileArchive("foo.zip")}}).ToArchiveArrayArrayOutput()
+	out := (MapArray{Map{"baz": String("any")}}).ToMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4783,7 +7119,7 @@ func TestArchiveArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]Archive)[0], iv)
+	assert.EqualValues(t, av.([]map[string]interface{})[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4791,10 +7127,10 @@ func TestArchiveArrayArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArchiveArrayArray(t *testing.T) {
+func TestToMapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveArrayArray([][]Archive{{NewFileArchive("foo.zip")}}).ToArchiveArrayArrayOutput()
+	out := ToMapArray([]map[string]interface{}{{"baz": String("any")}}).ToMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4804,13 +7140,13 @@ func TestToArchiveArrayArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]Archive)[0], iv)
+	assert.EqualValues(t, av.([]map[string]interface{})[0], iv)
 }
 
-func TestTopLevelToArchiveArrayArrayOutput(t *testing.T) {
+func TestTopLevelToMapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveArrayArrayOutput([]ArchiveArrayOutput{ToOutput(ArchiveArray{NewFileArchive("foo.zip")}).(ArchiveArrayOutput)})
+	out := ToMapArrayOutput([]MapOutput{ToOutput(Map{"baz": String("any")}).(MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4820,13 +7156,13 @@ func TestTopLevelToArchiveArrayArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]Archive)[0], iv)
+	assert.EqualValues(t, av.([]map[string]interface{})[0], iv)
 }
 
-func TestAssetArrayIndex(t *testing.T) {
+func TestArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetArray{NewFileAsset("foo.txt")}).ToAssetArrayOutput()
+	out := (ArrayArray{Array{String("any")}}).ToArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4835,7 +7171,7 @@ func TestAssetArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]Asset)[0], iv)
+	assert.EqualValues(t, av.([][]interface{})[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4843,10 +7179,10 @@ func TestAssetArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToAssetArray(t *testing.T) {
+func TestToArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetArray([]Asset{NewFileAsset("foo.txt")}).ToAssetArrayOutput()
+	out := ToArrayArray([][]interface{}{{String("any")}}).ToArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4856,13 +7192,13 @@ func TestToAssetArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]Asset)[0], iv)
+	assert.EqualValues(t, av.([][]interface{})[0], iv)
 }
 
-func TestTopLevelToAssetArrayOutput(t *testing.T) {
+func TestTopLevelToArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetArrayOutput([]AssetOutput{ToOutput(NewFileAsset("foo.txt")).(AssetOutput)})
+	out := ToArrayArrayOutput([]ArrayOutput{ToOutput(Array{String("any")}).(ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4872,13 +7208,13 @@ func TestTopLevelToAssetArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]Asset)[0], iv)
+	assert.EqualValues(t, av.([][]interface{})[0], iv)
 }
 
-func TestAssetMapArrayIndex(t *testing.T) {
+func TestIntArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetMapArray{AssetMap{"baz": NewFileAsset("foo.txt")}}).ToAssetMapArrayOutput()
+	out := (IntArray{Int(42)}).ToIntArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4887,7 +7223,7 @@ func TestAssetMapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]Asset)[0], iv)
+	assert.EqualValues(t, av.([]int)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4895,10 +7231,10 @@ func TestAssetMapArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToAssetMapArray(t *testing.T) {
+func TestToIntArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetMapArray([]map[string]Asset{{"baz": NewFileAsset("foo.txt")}}).ToAssetMapArrayOutput()
+	out := ToIntArray([]int{42}).ToIntArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4908,13 +7244,13 @@ func TestToAssetMapArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]Asset)[0], iv)
+	assert.EqualValues(t, av.([]int)[0], iv)
 }
 
-func TestTopLevelToAssetMapArrayOutput(t *testing.T) {
+func TestTopLevelToIntArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetMapArrayOutput([]AssetMapOutput{ToOutput(AssetMap{"baz": NewFileAsset("foo.txt")}).(AssetMapOutput)})
+	out := ToIntArrayOutput([]IntOutput{ToOutput(Int(42)).(IntOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4924,13 +7260,13 @@ func TestTopLevelToAssetMapArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]Asset)[0], iv)
+	assert.EqualValues(t, av.([]int)[0], iv)
 }
 
-func TestAssetArrayArrayIndex(t *testing.T) {
+func TestIntMapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetArrayArray{AssetArray{NewFileAsset("foo.txt")}}).ToAssetArrayArrayOutput()
+	out := (IntMapArray{IntMap{"baz": Int(42)}}).ToIntMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4939,7 +7275,7 @@ func TestAssetArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]Asset)[0], iv)
+	assert.EqualValues(t, av.([]map[string]int)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4947,10 +7283,10 @@ func TestAssetArrayArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToAssetArrayArray(t *testing.T) {
+func TestToIntMapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetArrayArray([][]Asset{{NewFileAsset("foo.txt")}}).ToAssetArrayArrayOutput()
+	out := ToIntMapArray([]map[string]int{{"baz": 42}}).ToIntMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4960,13 +7296,13 @@ func TestToAssetArrayArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]Asset)[0], iv)
+	assert.EqualValues(t, av.([]map[string]int)[0], iv)
 }
 
-func TestTopLevelToAssetArrayArrayOutput(t *testing.T) {
+func TestTopLevelToIntMapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetArrayArrayOutput([]AssetArrayOutput{ToOutput(AssetArray{NewFileAsset("foo.txt")}).(AssetArrayOutput)})
+	out := ToIntMapArrayOutput([]IntMapOutput{ToOutput(IntMap{"baz": Int(42)}).(IntMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4976,13 +7312,13 @@ func TestTopLevelToAssetArrayArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]Asset)[0], iv)
+	assert.EqualValues(t, av.([]map[string]int)[0], iv)
 }
 
-func TestAssetOrArchiveArrayIndex(t *testing.T) {
+func TestIntArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetOrArchiveArray{NewFileArchive("foo.zip")}).ToAssetOrArchiveArrayOutput()
+	out := (IntArrayArray{IntArray{Int(42)}}).ToIntArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -4991,7 +7327,7 @@ func TestAssetOrArchiveArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]AssetOrArchive)[0], iv)
+	assert.EqualValues(t, av.([][]int)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -4999,10 +7335,10 @@ func TestAssetOrArchiveArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestAssetOrArchiveMapArrayIndex(t *testing.T) {
+func TestToIntArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetOrArchiveMapArray{AssetOrArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToAssetOrArchiveMapArrayOutput()
+	out := ToIntArrayArray([][]int{{42}}).ToIntArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5011,18 +7347,14 @@ func TestAssetOrArchiveMapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]AssetOrArchive)[0], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
-	assert.True(t, known)
-	assert.NoError(t, err)
-	assert.Zero(t, iv)
+	assert.EqualValues(t, av.([][]int)[0], iv)
 }
 
-func TestAssetOrArchiveArrayArrayIndex(t *testing.T) {
+func TestTopLevelToIntArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetOrArchiveArrayArray{AssetOrArchiveArray{NewFileArchive("foo.zip")}}).ToAssetOrArchiveArrayArrayOutput()
+	out := ToIntArrayArrayOutput([]IntArrayOutput{ToOutput(IntArray{Int(42)}).(IntArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5031,18 +7363,14 @@ func TestAssetOrArchiveArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]AssetOrArchive)[0], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
-	assert.True(t, known)
-	assert.NoError(t, err)
-	assert.Zero(t, iv)
+	assert.EqualValues(t, av.([][]int)[0], iv)
 }
 
-func TestBoolArrayIndex(t *testing.T) {
+func TestInt64ArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolArray{Bool(true)}).ToBoolArrayOutput()
+	out := (Int64Array{Int64(42)}).ToInt64ArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5051,7 +7379,7 @@ func TestBoolArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]bool)[0], iv)
+	assert.EqualValues(t, av.([]int64)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5059,10 +7387,10 @@ func TestBoolArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToBoolArray(t *testing.T) {
+func TestToInt64Array(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolArray([]bool{true}).ToBoolArrayOutput()
+	out := ToInt64Array([]int64{42}).ToInt64ArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5072,13 +7400,13 @@ func TestToBoolArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]bool)[0], iv)
+	assert.EqualValues(t, av.([]int64)[0], iv)
 }
 
-func TestTopLevelToBoolArrayOutput(t *testing.T) {
+func TestTopLevelToInt64ArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolArrayOutput([]BoolOutput{ToOutput(Bool(true)).(BoolOutput)})
+	out := ToInt64ArrayOutput([]Int64Output{ToOutput(Int64(42)).(Int64Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5088,13 +7416,13 @@ func TestTopLevelToBoolArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]bool)[0], iv)
+	assert.EqualValues(t, av.([]int64)[0], iv)
 }
 
-func TestBoolMapArrayIndex(t *testing.T) {
+func TestInt64MapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolMapArray{BoolMap{"baz": Bool(true)}}).ToBoolMapArrayOutput()
+	out := (Int64MapArray{Int64Map{"baz": Int64(42)}}).ToInt64MapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5103,7 +7431,7 @@ func TestBoolMapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]bool)[0], iv)
+	assert.EqualValues(t, av.([]map[string]int64)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5111,10 +7439,10 @@ func TestBoolMapArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToBoolMapArray(t *testing.T) {
+func TestToInt64MapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolMapArray([]map[string]bool{{"baz": true}}).ToBoolMapArrayOutput()
+	out := ToInt64MapArray([]map[string]int64{{"baz": 42}}).ToInt64MapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5124,13 +7452,13 @@ func TestToBoolMapArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]bool)[0], iv)
+	assert.EqualValues(t, av.([]map[string]int64)[0], iv)
 }
 
-func TestTopLevelToBoolMapArrayOutput(t *testing.T) {
+func TestTopLevelToInt64MapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolMapArrayOutput([]BoolMapOutput{ToOutput(BoolMap{"baz": Bool(true)}).(BoolMapOutput)})
+	out := ToInt64MapArrayOutput([]Int64MapOutput{ToOutput(Int64Map{"baz": Int64(42)}).(Int64MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5140,13 +7468,13 @@ func TestTopLevelToBoolMapArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]bool)[0], iv)
+	assert.EqualValues(t, av.([]map[string]int64)[0], iv)
 }
 
-func TestBoolArrayArrayIndex(t *testing.T) {
+func TestInt64ArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolArrayArray{BoolArray{Bool(true)}}).ToBoolArrayArrayOutput()
+	out := (Int64ArrayArray{Int64Array{Int64(42)}}).ToInt64ArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5155,7 +7483,7 @@ func TestBoolArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]bool)[0], iv)
+	assert.EqualValues(t, av.([][]int64)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5163,10 +7491,10 @@ func TestBoolArrayArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToBoolArrayArray(t *testing.T) {
+func TestToInt64ArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolArrayArray([][]bool{{true}}).ToBoolArrayArrayOutput()
+	out := ToInt64ArrayArray([][]int64{{42}}).ToInt64ArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5176,13 +7504,13 @@ func TestToBoolArrayArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]bool)[0], iv)
+	assert.EqualValues(t, av.([][]int64)[0], iv)
 }
 
-func TestTopLevelToBoolArrayArrayOutput(t *testing.T) {
+func TestTopLevelToInt64ArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolArrayArrayOutput([]BoolArrayOutput{ToOutput(BoolArray{Bool(true)}).(BoolArrayOutput)})
+	out := ToInt64ArrayArrayOutput([]Int64ArrayOutput{ToOutput(Int64Array{Int64(42)}).(Int64ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5192,13 +7520,13 @@ func TestTopLevelToBoolArrayArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]bool)[0], iv)
+	assert.EqualValues(t, av.([][]int64)[0], iv)
 }
 
-func TestFloat64ArrayIndex(t *testing.T) {
+func TestStringArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64Array{Float64(999.9)}).ToFloat64ArrayOutput()
+	out := (StringArray{String("foo")}).ToStringArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5207,7 +7535,7 @@ func TestFloat64ArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]float64)[0], iv)
+	assert.EqualValues(t, av.([]string)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5215,10 +7543,10 @@ func TestFloat64ArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToFloat64Array(t *testing.T) {
+func TestToStringArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64Array([]float64{999.9}).ToFloat64ArrayOutput()
+	out := ToStringArray([]string{"foo"}).ToStringArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5228,13 +7556,13 @@ func TestToFloat64Array(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]float64)[0], iv)
+	assert.EqualValues(t, av.([]string)[0], iv)
 }
 
-func TestTopLevelToFloat64ArrayOutput(t *testing.T) {
+func TestTopLevelToStringArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64ArrayOutput([]Float64Output{ToOutput(Float64(999.9)).(Float64Output)})
+	out := ToStringArrayOutput([]StringOutput{ToOutput(String("foo")).(StringOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5244,13 +7572,13 @@ func TestTopLevelToFloat64ArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]float64)[0], iv)
+	assert.EqualValues(t, av.([]string)[0], iv)
 }
 
-func TestFloat64MapArrayIndex(t *testing.T) {
+func TestStringMapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64MapArray{Float64Map{"baz": Float64(999.9)}}).ToFloat64MapArrayOutput()
+	out := (StringMapArray{StringMap{"baz": String("foo")}}).ToStringMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5259,7 +7587,7 @@ func TestFloat64MapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]float64)[0], iv)
+	assert.EqualValues(t, av.([]map[string]string)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5267,10 +7595,10 @@ func TestFloat64MapArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToFloat64MapArray(t *testing.T) {
+func TestToStringMapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64MapArray([]map[string]float64{{"baz": 999.9}}).ToFloat64MapArrayOutput()
+	out := ToStringMapArray([]map[string]string{{"baz": "foo"}}).ToStringMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5280,13 +7608,13 @@ func TestToFloat64MapArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]float64)[0], iv)
+	assert.EqualValues(t, av.([]map[string]string)[0], iv)
 }
 
-func TestTopLevelToFloat64MapArrayOutput(t *testing.T) {
+func TestTopLevelToStringMapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64MapArrayOutput([]Float64MapOutput{ToOutput(Float64Map{"baz": Float64(999.9)}).(Float64MapOutput)})
+	out := ToStringMapArrayOutput([]StringMapOutput{ToOutput(StringMap{"baz": String("foo")}).(StringMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5296,13 +7624,13 @@ func TestTopLevelToFloat64MapArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]float64)[0], iv)
+	assert.EqualValues(t, av.([]map[string]string)[0], iv)
 }
 
-func TestFloat64ArrayArrayIndex(t *testing.T) {
+func TestStringArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64ArrayArray{Float64Array{Float64(999.9)}}).ToFloat64ArrayArrayOutput()
+	out := (StringArrayArray{StringArray{String("foo")}}).ToStringArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5311,7 +7639,7 @@ func TestFloat64ArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]float64)[0], iv)
+	assert.EqualValues(t, av.([][]string)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5319,10 +7647,10 @@ func TestFloat64ArrayArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToFloat64ArrayArray(t *testing.T) {
+func TestToStringArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64ArrayArray([][]float64{{999.9}}).ToFloat64ArrayArrayOutput()
+	out := ToStringArrayArray([][]string{{"foo"}}).ToStringArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5332,13 +7660,13 @@ func TestToFloat64ArrayArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]float64)[0], iv)
+	assert.EqualValues(t, av.([][]string)[0], iv)
 }
 
-func TestTopLevelToFloat64ArrayArrayOutput(t *testing.T) {
+func TestTopLevelToStringArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64ArrayArrayOutput([]Float64ArrayOutput{ToOutput(Float64Array{Float64(999.9)}).(Float64ArrayOutput)})
+	out := ToStringArrayArrayOutput([]StringArrayOutput{ToOutput(StringArray{String("foo")}).(StringArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5348,13 +7676,13 @@ func TestTopLevelToFloat64ArrayArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]float64)[0], iv)
+	assert.EqualValues(t, av.([][]string)[0], iv)
 }
 
-func TestIDArrayIndex(t *testing.T) {
+func TestURNArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IDArray{ID("foo")}).ToIDArrayOutput()
+	out := (URNArray{URN("foo")}).ToURNArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5363,7 +7691,7 @@ func TestIDArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]ID)[0], iv)
+	assert.EqualValues(t, av.([]URN)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5371,10 +7699,10 @@ func TestIDArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIDArray(t *testing.T) {
+func TestToURNArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDArray([]ID{ID("foo")}).ToIDArrayOutput()
+	out := ToURNArray([]URN{URN("foo")}).ToURNArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5384,13 +7712,13 @@ func TestToIDArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]ID)[0], iv)
+	assert.EqualValues(t, av.([]URN)[0], iv)
 }
 
-func TestTopLevelToIDArrayOutput(t *testing.T) {
+func TestTopLevelToURNArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDArrayOutput([]IDOutput{ToOutput(ID("foo")).(IDOutput)})
+	out := ToURNArrayOutput([]URNOutput{ToOutput(URN("foo")).(URNOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5400,13 +7728,13 @@ func TestTopLevelToIDArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]ID)[0], iv)
+	assert.EqualValues(t, av.([]URN)[0], iv)
 }
 
-func TestIDMapArrayIndex(t *testing.T) {
+func TestURNMapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IDMapArray{IDMap{"baz": ID("foo")}}).ToIDMapArrayOutput()
+	out := (URNMapArray{URNMap{"baz": URN("foo")}}).ToURNMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5415,7 +7743,7 @@ func TestIDMapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]ID)[0], iv)
+	assert.EqualValues(t, av.([]map[string]URN)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5423,10 +7751,10 @@ func TestIDMapArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIDMapArray(t *testing.T) {
+func TestToURNMapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDMapArray([]map[string]ID{{"baz": ID("foo")}}).ToIDMapArrayOutput()
+	out := ToURNMapArray([]map[string]URN{{"baz": URN("foo")}}).ToURNMapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5436,13 +7764,13 @@ func TestToIDMapArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]ID)[0], iv)
+	assert.EqualValues(t, av.([]map[string]URN)[0], iv)
 }
 
-func TestTopLevelToIDMapArrayOutput(t *testing.T) {
+func TestTopLevelToURNMapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDMapArrayOutput([]IDMapOutput{ToOutput(IDMap{"baz": ID("foo")}).(IDMapOutput)})
+	out := ToURNMapArrayOutput([]URNMapOutput{ToOutput(URNMap{"baz": URN("foo")}).(URNMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5452,13 +7780,13 @@ func TestTopLevelToIDMapArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]ID)[0], iv)
+	assert.EqualValues(t, av.([]map[string]URN)[0], iv)
 }
 
-func TestIDArrayArrayIndex(t *testing.T) {
+func TestURNArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IDArrayArray{IDArray{ID("foo")}}).ToIDArrayArrayOutput()
+	out := (URNArrayArray{URNArray{URN("foo")}}).ToURNArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5467,7 +7795,7 @@ func TestIDArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]ID)[0], iv)
+	assert.EqualValues(t, av.([][]URN)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5475,10 +7803,10 @@ func TestIDArrayArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIDArrayArray(t *testing.T) {
+func TestToURNArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDArrayArray([][]ID{{ID("foo")}}).ToIDArrayArrayOutput()
+	out := ToURNArrayArray([][]URN{{URN("foo")}}).ToURNArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5488,13 +7816,13 @@ func TestToIDArrayArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]ID)[0], iv)
+	assert.EqualValues(t, av.([][]URN)[0], iv)
 }
 
-func TestTopLevelToIDArrayArrayOutput(t *testing.T) {
+func TestTopLevelToURNArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDArrayArrayOutput([]IDArrayOutput{ToOutput(IDArray{ID("foo")}).(IDArrayOutput)})
+	out := ToURNArrayArrayOutput([]URNArrayOutput{ToOutput(URNArray{URN("foo")}).(URNArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5504,13 +7832,13 @@ func TestTopLevelToIDArrayArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]ID)[0], iv)
+	assert.EqualValues(t, av.([][]URN)[0], iv)
 }
 
-func TestArrayIndex(t *testing.T) {
+func TestUint64ArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Array{String("any")}).ToArrayOutput()
+	out := (Uint64Array{Uint64(42)}).ToUint64ArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5519,7 +7847,7 @@ func TestArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]interface{})[0], iv)
+	assert.EqualValues(t, av.([]uint64)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5527,10 +7855,10 @@ func TestArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArray(t *testing.T) {
+func TestToUint64Array(t *testing.T) {
 	t.Parallel()
 
-	out := ToArray([]interface{}{String("any")}).ToArrayOutput()
+	out := ToUint64Array([]uint64{42}).ToUint64ArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5540,13 +7868,13 @@ func TestToArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]interface{})[0], iv)
+	assert.EqualValues(t, av.([]uint64)[0], iv)
 }
 
-func TestTopLevelToArrayOutput(t *testing.T) {
+func TestTopLevelToUint64ArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayOutput([]Output{ToOutput(String("any")).(Output)})
+	out := ToUint64ArrayOutput([]Uint64Output{ToOutput(Uint64(42)).(Uint64Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5556,13 +7884,13 @@ func TestTopLevelToArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]interface{})[0], iv)
+	assert.EqualValues(t, av.([]uint64)[0], iv)
 }
 
-func TestMapArrayIndex(t *testing.T) {
+func TestUint64MapArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (MapArray{Map{"baz": String("any")}}).ToMapArrayOutput()
+	out := (Uint64MapArray{Uint64Map{"baz": Uint64(42)}}).ToUint64MapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5571,7 +7899,7 @@ func TestMapArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]interface{})[0], iv)
+	assert.EqualValues(t, av.([]map[string]uint64)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5579,10 +7907,10 @@ func TestMapArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToMapArray(t *testing.T) {
+func TestToUint64MapArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToMapArray([]map[string]interface{}{{"baz": String("any")}}).ToMapArrayOutput()
+	out := ToUint64MapArray([]map[string]uint64{{"baz": 42}}).ToUint64MapArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5592,13 +7920,13 @@ func TestToMapArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]interface{})[0], iv)
+	assert.EqualValues(t, av.([]map[string]uint64)[0], iv)
 }
 
-func TestTopLevelToMapArrayOutput(t *testing.T) {
+func TestTopLevelToUint64MapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToMapArrayOutput([]MapOutput{ToOutput(Map{"baz": String("any")}).(MapOutput)})
+	out := ToUint64MapArrayOutput([]Uint64MapOutput{ToOutput(Uint64Map{"baz": Uint64(42)}).(Uint64MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5608,13 +7936,13 @@ func TestTopLevelToMapArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]interface{})[0], iv)
+	assert.EqualValues(t, av.([]map[string]uint64)[0], iv)
 }
 
-func TestArrayArrayIndex(t *testing.T) {
+func TestUint64ArrayArrayIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArrayArray{Array{String("any")}}).ToArrayArrayOutput()
+	out := (Uint64ArrayArray{Uint64Array{Uint64(42)}}).ToUint64ArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5623,7 +7951,7 @@ func TestArrayArrayIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.Index(Int(0)))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]interface{})[0], iv)
+	assert.EqualValues(t, av.([][]uint64)[0], iv)
 
 	iv, known, _, _, err = await(out.Index(Int(-1)))
 	assert.True(t, known)
@@ -5631,10 +7959,10 @@ func TestArrayArrayIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArrayArray(t *testing.T) {
+func TestToUint64ArrayArray(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayArray([][]interface{}{{String("any")}}).ToArrayArrayOutput()
+	out := ToUint64ArrayArray([][]uint64{{42}}).ToUint64ArrayArrayOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5644,13 +7972,13 @@ func TestToArrayArray(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]interface{})[0], iv)
+	assert.EqualValues(t, av.([][]uint64)[0], iv)
 }
 
-func TestTopLevelToArrayArrayOutput(t *testing.T) {
+func TestTopLevelToUint64ArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayArrayOutput([]ArrayOutput{ToOutput(Array{String("any")}).(ArrayOutput)})
+	out := ToUint64ArrayArrayOutput([]Uint64ArrayOutput{ToOutput(Uint64Array{Uint64(42)}).(Uint64ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -5660,483 +7988,491 @@ func TestTopLevelToArrayArrayOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]interface{})[0], iv)
+	assert.EqualValues(t, av.([][]uint64)[0], iv)
 }
 
-func TestIntArrayIndex(t *testing.T) {
+// Test map indexers.
+
+func TestArchiveMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntArray{Int(42)}).ToIntArrayOutput()
+	out := (ArchiveMap{"baz": NewFileArchive("foo.zip")}).ToArchiveMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]int)[0], iv)
+	assert.EqualValues(t, av.(map[string]Archive)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToIntArray(t *testing.T) {
+func TestToArchiveMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntArray([]int{42}).ToIntArrayOutput()
+	out := ToArchiveMap(map[string]Archive{"baz": NewFileArchive("foo.zip")}).ToArchiveMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]int)[0], iv)
+	assert.EqualValues(t, av.(map[string]Archive)["baz"], iv)
 }
 
-func TestTopLevelToIntArrayOutput(t *testing.T) {
+func TestTopLevelToArchiveMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntArrayOutput([]IntOutput{ToOutput(Int(42)).(IntOutput)})
+	out := ToArchiveMapOutput(map[string]ArchiveOutput{"baz": ToOutput(NewFileArchive("foo.zip")).(ArchiveOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]int)[0], iv)
+	assert.EqualValues(t, av.(map[string]Archive)["baz"], iv)
 }
 
-func TestIntMapArrayIndex(t *testing.T) {
+func TestArchiveArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntMapArray{IntMap{"baz": Int(42)}}).ToIntMapArrayOutput()
+	out := (ArchiveArrayMap{"baz": ArchiveArray{NewFileArchive("foo.zip")}}).ToArchiveArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]int)[0], iv)
+	assert.EqualValues(t, av.(map[string][]Archive)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToIntMapArray(t *testing.T) {
+func TestToArchiveArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntMapArray([]map[string]int{{"baz": 42}}).ToIntMapArrayOutput()
+	out := ToArchiveArrayMap(map[string][]Archive{"baz": {NewFileArchive("foo.zip")}}).ToArchiveArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]int)[0], iv)
+	assert.EqualValues(t, av.(map[string][]Archive)["baz"], iv)
 }
 
-func TestTopLevelToIntMapArrayOutput(t *testing.T) {
+func TestTopLevelToArchiveArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntMapArrayOutput([]IntMapOutput{ToOutput(IntMap{"baz": Int(42)}).(IntMapOutput)})
+	out := ToArchiveArrayMapOutput(map[string]ArchiveArrayOutput{"baz": ToOutput(ArchiveArray{NewFileArchive("foo.zip")}).(ArchiveArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]int)[0], iv)
+	assert.EqualValues(t, av.(map[string][]Archive)["baz"], iv)
 }
 
-func TestIntArrayArrayIndex(t *testing.T) {
+func TestArchiveMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntArrayArray{IntArray{Int(42)}}).ToIntArrayArrayOutput()
+	out := (ArchiveMapMap{"baz": ArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToArchiveMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]int)[0], iv)
+	assert.EqualValues(t, av.(map[string]map[string]Archive)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToIntArrayArray(t *testing.T) {
+func TestToArchiveMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntArrayArray([][]int{{42}}).ToIntArrayArrayOutput()
+	out := ToArchiveMapMap(map[string]map[string]Archive{"baz": {"baz": NewFileArchive("foo.zip")}}).ToArchiveMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]int)[0], iv)
+	assert.EqualValues(t, av.(map[string]map[string]Archive)["baz"], iv)
 }
 
-func TestTopLevelToIntArrayArrayOutput(t *testing.T) {
+func TestTopLevelToArchiveMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntArrayArrayOutput([]IntArrayOutput{ToOutput(IntArray{Int(42)}).(IntArrayOutput)})
+	out := ToArchiveMapMapOutput(map[string]ArchiveMapOutput{"baz": ToOutput(ArchiveMap{"baz": NewFileArchive("foo.zip")}).(ArchiveMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]int)[0], iv)
+	assert.EqualValues(t, av.(map[string]map[string]Archive)["baz"], iv)
 }
 
-func TestStringArrayIndex(t *testing.T) {
+func TestAssetMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (StringArray{String("foo")}).ToStringArrayOutput()
+	out := (AssetMap{"baz": NewFileAsset("foo.txt")}).ToAssetMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]string)[0], iv)
+	assert.EqualValues(t, av.(map[string]Asset)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToStringArray(t *testing.T) {
+func TestToAssetMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringArray([]string{"foo"}).ToStringArrayOutput()
+	out := ToAssetMap(map[string]Asset{"baz": NewFileAsset("foo.txt")}).ToAssetMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]string)[0], iv)
+	assert.EqualValues(t, av.(map[string]Asset)["baz"], iv)
 }
 
-func TestTopLevelToStringArrayOutput(t *testing.T) {
+func TestTopLevelToAssetMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringArrayOutput([]StringOutput{ToOutput(String("foo")).(StringOutput)})
+	out := ToAssetMapOutput(map[string]AssetOutput{"baz": ToOutput(NewFileAsset("foo.txt")).(AssetOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]string)[0], iv)
+	assert.EqualValues(t, av.(map[string]Asset)["baz"], iv)
 }
 
-func TestStringMapArrayIndex(t *testing.T) {
+func TestAssetArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (StringMapArray{StringMap{"baz": String("foo")}}).ToStringMapArrayOutput()
+	out := (AssetArrayMap{"baz": AssetArray{NewFileAsset("foo.txt")}}).ToAssetArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]string)[0], iv)
+	assert.EqualValues(t, av.(map[string][]Asset)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToStringMapArray(t *testing.T) {
+func TestToAssetArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringMapArray([]map[string]string{{"baz": "foo"}}).ToStringMapArrayOutput()
+	out := ToAssetArrayMap(map[string][]Asset{"baz": {NewFileAsset("foo.txt")}}).ToAssetArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]string)[0], iv)
+	assert.EqualValues(t, av.(map[string][]Asset)["baz"], iv)
 }
 
-func TestTopLevelToStringMapArrayOutput(t *testing.T) {
+func TestTopLevelToAssetArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringMapArrayOutput([]StringMapOutput{ToOutput(StringMap{"baz": String("foo")}).(StringMapOutput)})
+	out := ToAssetArrayMapOutput(map[string]AssetArrayOutput{"baz": ToOutput(AssetArray{NewFileAsset("foo.txt")}).(AssetArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]string)[0], iv)
+	assert.EqualValues(t, av.(map[string][]Asset)["baz"], iv)
 }
 
-func TestStringArrayArrayIndex(t *testing.T) {
+func TestAssetMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (StringArrayArray{StringArray{String("foo")}}).ToStringArrayArrayOutput()
+	out := (AssetMapMap{"baz": AssetMap{"baz": NewFileAsset("foo.txt")}}).ToAssetMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]string)[0], iv)
+	assert.EqualValues(t, av.(map[string]map[string]Asset)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToStringArrayArray(t *testing.T) {
+func TestToAssetMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringArrayArray([][]string{{"foo"}}).ToStringArrayArrayOutput()
+	out := ToAssetMapMap(map[string]map[string]Asset{"baz": {"baz": NewFileAsset("foo.txt")}}).ToAssetMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]string)[0], iv)
+	assert.EqualValues(t, av.(map[string]map[string]Asset)["baz"], iv)
 }
 
-func TestTopLevelToStringArrayArrayOutput(t *testing.T) {
+func TestTopLevelToAssetMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringArrayArrayOutput([]StringArrayOutput{ToOutput(StringArray{String("foo")}).(StringArrayOutput)})
+	out := ToAssetMapMapOutput(map[string]AssetMapOutput{"baz": ToOutput(AssetMap{"baz": NewFileAsset("foo.txt")}).(AssetMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]string)[0], iv)
+	assert.EqualValues(t, av.(map[string]map[string]Asset)["baz"], iv)
 }
 
-func TestURNArrayIndex(t *testing.T) {
+func TestAssetOrArchiveMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (URNArray{URN("foo")}).ToURNArrayOutput()
+	out := (AssetOrArchiveMap{"baz": NewFileArchive("foo.zip")}).ToAssetOrArchiveMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string]AssetOrArchive)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToURNArray(t *testing.T) {
+func TestAssetOrArchiveArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNArray([]URN{URN("foo")}).ToURNArrayOutput()
+	out := (AssetOrArchiveArrayMap{"baz": AssetOrArchiveArray{NewFileArchive("foo.zip")}}).ToAssetOrArchiveArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
+	assert.EqualValues(t, av.(map[string][]AssetOrArchive)["baz"], iv)
 
-	assert.EqualValues(t, av.([]URN)[0], iv)
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
 }
 
-func TestTopLevelToURNArrayOutput(t *testing.T) {
+func TestAssetOrArchiveMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNArrayOutput([]URNOutput{ToOutput(URN("foo")).(URNOutput)})
+	out := (AssetOrArchiveMapMap{"baz": AssetOrArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToAssetOrArchiveMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
+	assert.EqualValues(t, av.(map[string]map[string]AssetOrArchive)["baz"], iv)
 
-	assert.EqualValues(t, av.([]URN)[0], iv)
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
+	assert.True(t, known)
+	assert.NoError(t, err)
+	assert.Zero(t, iv)
 }
 
-func TestURNMapArrayIndex(t *testing.T) {
+func TestBoolMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (URNMapArray{URNMap{"baz": URN("foo")}}).ToURNMapArrayOutput()
+	out := (BoolMap{"baz": Bool(true)}).ToBoolMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([]map[string]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string]bool)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToURNMapArray(t *testing.T) {
+func TestToBoolMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNMapArray([]map[string]URN{{"baz": URN("foo")}}).ToURNMapArrayOutput()
+	out := ToBoolMap(map[string]bool{"baz": true}).ToBoolMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string]bool)["baz"], iv)
 }
 
-func TestTopLevelToURNMapArrayOutput(t *testing.T) {
+func TestTopLevelToBoolMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNMapArrayOutput([]URNMapOutput{ToOutput(URNMap{"baz": URN("foo")}).(URNMapOutput)})
+	out := ToBoolMapOutput(map[string]BoolOutput{"baz": ToOutput(Bool(true)).(BoolOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([]map[string]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string]bool)["baz"], iv)
 }
 
-func TestURNArrayArrayIndex(t *testing.T) {
+func TestBoolArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (URNArrayArray{URNArray{URN("foo")}}).ToURNArrayArrayOutput()
+	out := (BoolArrayMap{"baz": BoolArray{Bool(true)}}).ToBoolArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.([][]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string][]bool)["baz"], iv)
 
-	iv, known, _, _, err = await(out.Index(Int(-1)))
+	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 	assert.Zero(t, iv)
 }
 
-func TestToURNArrayArray(t *testing.T) {
+func TestToBoolArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNArrayArray([][]URN{{URN("foo")}}).ToURNArrayArrayOutput()
+	out := ToBoolArrayMap(map[string][]bool{"baz": {true}}).ToBoolArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string][]bool)["baz"], iv)
 }
 
-func TestTopLevelToURNArrayArrayOutput(t *testing.T) {
+func TestTopLevelToBoolArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNArrayArrayOutput([]URNArrayOutput{ToOutput(URNArray{URN("foo")}).(URNArrayOutput)})
+	out := ToBoolArrayMapOutput(map[string]BoolArrayOutput{"baz": ToOutput(BoolArray{Bool(true)}).(BoolArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	iv, known, _, _, err := await(out.Index(Int(0)))
+	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.([][]URN)[0], iv)
+	assert.EqualValues(t, av.(map[string][]bool)["baz"], iv)
 }
 
-// Test map indexers.
-
-func TestArchiveMapIndex(t *testing.T) {
+func TestBoolMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArchiveMap{"baz": NewFileArchive("foo.zip")}).ToArchiveMapOutput()
+	out := (BoolMapMap{"baz": BoolMap{"baz": Bool(true)}}).ToBoolMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6145,7 +8481,7 @@ func TestArchiveMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]bool)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6153,10 +8489,10 @@ func TestArchiveMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArchiveMap(t *testing.T) {
+func TestToBoolMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveMap(map[string]Archive{"baz": NewFileArchive("foo.zip")}).ToArchiveMapOutput()
+	out := ToBoolMapMap(map[string]map[string]bool{"baz": {"baz": true}}).ToBoolMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6166,13 +8502,13 @@ func TestToArchiveMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]bool)["baz"], iv)
 }
 
-func TestTopLevelToArchiveMapOutput(t *testing.T) {
+func TestTopLevelToBoolMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveMapOutput(map[string]ArchiveOutput{"baz": ToOutput(NewFileArchive("foo.zip")).(ArchiveOutput)})
+	out := ToBoolMapMapOutput(map[string]BoolMapOutput{"baz": ToOutput(BoolMap{"baz": Bool(true)}).(BoolMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6182,13 +8518,13 @@ func TestTopLevelToArchiveMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]bool)["baz"], iv)
 }
 
-func TestArchiveArrayMapIndex(t *testing.T) {
+func TestFloat32MapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArchiveArrayMap{"baz": ArchiveArray{NewFileArchive("foo.zip")}}).ToArchiveArrayMapOutput()
+	out := (Float32Map{"baz": Float32(999.9)}).ToFloat32MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6197,7 +8533,7 @@ func TestArchiveArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]float32)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6205,10 +8541,10 @@ func TestArchiveArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArchiveArrayMap(t *testing.T) {
+func TestToFloat32Map(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveArrayMap(map[string][]Archive{"baz": {NewFileArchive("foo.zip")}}).ToArchiveArrayMapOutput()
+	out := ToFloat32Map(map[string]float32{"baz": 999.9}).ToFloat32MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6218,13 +8554,13 @@ func TestToArchiveArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]float32)["baz"], iv)
 }
 
-func TestTopLevelToArchiveArrayMapOutput(t *testing.T) {
+func TestTopLevelToFloat32MapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveArrayMapOutput(map[string]ArchiveArrayOutput{"baz": ToOutput(ArchiveArray{NewFileArchive("foo.zip")}).(ArchiveArrayOutput)})
+	out := ToFloat32MapOutput(map[string]Float32Output{"baz": ToOutput(Float32(999.9)).(Float32Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6234,13 +8570,13 @@ func TestTopLevelToArchiveArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]float32)["baz"], iv)
 }
 
-func TestArchiveMapMapIndex(t *testing.T) {
+func TestFloat32ArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArchiveMapMap{"baz": ArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToArchiveMapMapOutput()
+	out := (Float32ArrayMap{"baz": Float32Array{Float32(999.9)}}).ToFloat32ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6249,7 +8585,7 @@ func TestArchiveMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]float32)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6257,10 +8593,10 @@ func TestArchiveMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArchiveMapMap(t *testing.T) {
+func TestToFloat32ArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveMapMap(map[string]map[string]Archive{"baz": {"baz": NewFileArchive("foo.zip")}}).ToArchiveMapMapOutput()
+	out := ToFloat32ArrayMap(map[string][]float32{"baz": {999.9}}).ToFloat32ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6270,13 +8606,13 @@ func TestToArchiveMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]float32)["baz"], iv)
 }
 
-func TestTopLevelToArchiveMapMapOutput(t *testing.T) {
+func TestTopLevelToFloat32ArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArchiveMapMapOutput(map[string]ArchiveMapOutput{"baz": ToOutput(ArchiveMap{"baz": NewFileArchive("foo.zip")}).(ArchiveMapOutput)})
+	out := ToFloat32ArrayMapOutput(map[string]Float32ArrayOutput{"baz": ToOutput(Float32Array{Float32(999.9)}).(Float32ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6286,13 +8622,13 @@ func TestTopLevelToArchiveMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]Archive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]float32)["baz"], iv)
 }
 
-func TestAssetMapIndex(t *testing.T) {
+func TestFloat32MapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetMap{"baz": NewFileAsset("foo.txt")}).ToAssetMapOutput()
+	out := (Float32MapMap{"baz": Float32Map{"baz": Float32(999.9)}}).ToFloat32MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6301,7 +8637,7 @@ func TestAssetMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]float32)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6309,10 +8645,10 @@ func TestAssetMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToAssetMap(t *testing.T) {
+func TestToFloat32MapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetMap(map[string]Asset{"baz": NewFileAsset("foo.txt")}).ToAssetMapOutput()
+	out := ToFloat32MapMap(map[string]map[string]float32{"baz": {"baz": 999.9}}).ToFloat32MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6322,13 +8658,13 @@ func TestToAssetMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]float32)["baz"], iv)
 }
 
-func TestTopLevelToAssetMapOutput(t *testing.T) {
+func TestTopLevelToFloat32MapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetMapOutput(map[string]AssetOutput{"baz": ToOutput(NewFileAsset("foo.txt")).(AssetOutput)})
+	out := ToFloat32MapMapOutput(map[string]Float32MapOutput{"baz": ToOutput(Float32Map{"baz": Float32(999.9)}).(Float32MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6338,13 +8674,13 @@ func TestTopLevelToAssetMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]float32)["baz"], iv)
 }
 
-func TestAssetArrayMapIndex(t *testing.T) {
+func TestFloat64MapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetArrayMap{"baz": AssetArray{NewFileAsset("foo.txt")}}).ToAssetArrayMapOutput()
+	out := (Float64Map{"baz": Float64(999.9)}).ToFloat64MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6353,7 +8689,7 @@ func TestAssetArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]float64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6361,10 +8697,10 @@ func TestAssetArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToAssetArrayMap(t *testing.T) {
+func TestToFloat64Map(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetArrayMap(map[string][]Asset{"baz": {NewFileAsset("foo.txt")}}).ToAssetArrayMapOutput()
+	out := ToFloat64Map(map[string]float64{"baz": 999.9}).ToFloat64MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6374,13 +8710,13 @@ func TestToAssetArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]float64)["baz"], iv)
 }
 
-func TestTopLevelToAssetArrayMapOutput(t *testing.T) {
+func TestTopLevelToFloat64MapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetArrayMapOutput(map[string]AssetArrayOutput{"baz": ToOutput(AssetArray{NewFileAsset("foo.txt")}).(AssetArrayOutput)})
+	out := ToFloat64MapOutput(map[string]Float64Output{"baz": ToOutput(Float64(999.9)).(Float64Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6390,13 +8726,13 @@ func TestTopLevelToAssetArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]float64)["baz"], iv)
 }
 
-func TestAssetMapMapIndex(t *testing.T) {
+func TestFloat64ArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetMapMap{"baz": AssetMap{"baz": NewFileAsset("foo.txt")}}).ToAssetMapMapOutput()
+	out := (Float64ArrayMap{"baz": Float64Array{Float64(999.9)}}).ToFloat64ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6405,7 +8741,7 @@ func TestAssetMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]float64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6413,10 +8749,10 @@ func TestAssetMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToAssetMapMap(t *testing.T) {
+func TestToFloat64ArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetMapMap(map[string]map[string]Asset{"baz": {"baz": NewFileAsset("foo.txt")}}).ToAssetMapMapOutput()
+	out := ToFloat64ArrayMap(map[string][]float64{"baz": {999.9}}).ToFloat64ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6426,13 +8762,13 @@ func TestToAssetMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]float64)["baz"], iv)
 }
 
-func TestTopLevelToAssetMapMapOutput(t *testing.T) {
+func TestTopLevelToFloat64ArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToAssetMapMapOutput(map[string]AssetMapOutput{"baz": ToOutput(AssetMap{"baz": NewFileAsset("foo.txt")}).(AssetMapOutput)})
+	out := ToFloat64ArrayMapOutput(map[string]Float64ArrayOutput{"baz": ToOutput(Float64Array{Float64(999.9)}).(Float64ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6442,13 +8778,13 @@ func TestTopLevelToAssetMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]Asset)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]float64)["baz"], iv)
 }
 
-func TestAssetOrArchiveMapIndex(t *testing.T) {
+func TestFloat64MapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetOrArchiveMap{"baz": NewFileArchive("foo.zip")}).ToAssetOrArchiveMapOutput()
+	out := (Float64MapMap{"baz": Float64Map{"baz": Float64(999.9)}}).ToFloat64MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6457,7 +8793,7 @@ func TestAssetOrArchiveMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]AssetOrArchive)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]float64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6465,10 +8801,10 @@ func TestAssetOrArchiveMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestAssetOrArchiveArrayMapIndex(t *testing.T) {
+func TestToFloat64MapMap(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetOrArchiveArrayMap{"baz": AssetOrArchiveArray{NewFileArchive("foo.zip")}}).ToAssetOrArchiveArrayMapOutput()
+	out := ToFloat64MapMap(map[string]map[string]float64{"baz": {"baz": 999.9}}).ToFloat64MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6477,18 +8813,14 @@ func TestAssetOrArchiveArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]AssetOrArchive)["baz"], iv)
 
-	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
-	assert.True(t, known)
-	assert.NoError(t, err)
-	assert.Zero(t, iv)
+	assert.EqualValues(t, av.(map[string]map[string]float64)["baz"], iv)
 }
 
-func TestAssetOrArchiveMapMapIndex(t *testing.T) {
+func TestTopLevelToFloat64MapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := (AssetOrArchiveMapMap{"baz": AssetOrArchiveMap{"baz": NewFileArchive("foo.zip")}}).ToAssetOrArchiveMapMapOutput()
+	out := ToFloat64MapMapOutput(map[string]Float64MapOutput{"baz": ToOutput(Float64Map{"baz": Float64(999.9)}).(Float64MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6497,18 +8829,14 @@ func TestAssetOrArchiveMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]AssetOrArchive)["baz"], iv)
 
-	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
-	assert.True(t, known)
-	assert.NoError(t, err)
-	assert.Zero(t, iv)
+	assert.EqualValues(t, av.(map[string]map[string]float64)["baz"], iv)
 }
 
-func TestBoolMapIndex(t *testing.T) {
+func TestIDMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolMap{"baz": Bool(true)}).ToBoolMapOutput()
+	out := (IDMap{"baz": ID("foo")}).ToIDMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6517,7 +8845,7 @@ func TestBoolMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]ID)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6525,10 +8853,10 @@ func TestBoolMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToBoolMap(t *testing.T) {
+func TestToIDMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolMap(map[string]bool{"baz": true}).ToBoolMapOutput()
+	out := ToIDMap(map[string]ID{"baz": ID("foo")}).ToIDMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6538,13 +8866,13 @@ func TestToBoolMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]ID)["baz"], iv)
 }
 
-func TestTopLevelToBoolMapOutput(t *testing.T) {
+func TestTopLevelToIDMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolMapOutput(map[string]BoolOutput{"baz": ToOutput(Bool(true)).(BoolOutput)})
+	out := ToIDMapOutput(map[string]IDOutput{"baz": ToOutput(ID("foo")).(IDOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6554,13 +8882,13 @@ func TestTopLevelToBoolMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]ID)["baz"], iv)
 }
 
-func TestBoolArrayMapIndex(t *testing.T) {
+func TestIDArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolArrayMap{"baz": BoolArray{Bool(true)}}).ToBoolArrayMapOutput()
+	out := (IDArrayMap{"baz": IDArray{ID("foo")}}).ToIDArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6569,7 +8897,7 @@ func TestBoolArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]ID)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6577,10 +8905,10 @@ func TestBoolArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToBoolArrayMap(t *testing.T) {
+func TestToIDArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolArrayMap(map[string][]bool{"baz": {true}}).ToBoolArrayMapOutput()
+	out := ToIDArrayMap(map[string][]ID{"baz": {ID("foo")}}).ToIDArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6590,13 +8918,13 @@ func TestToBoolArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]ID)["baz"], iv)
 }
 
-func TestTopLevelToBoolArrayMapOutput(t *testing.T) {
+func TestTopLevelToIDArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolArrayMapOutput(map[string]BoolArrayOutput{"baz": ToOutput(BoolArray{Bool(true)}).(BoolArrayOutput)})
+	out := ToIDArrayMapOutput(map[string]IDArrayOutput{"baz": ToOutput(IDArray{ID("foo")}).(IDArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6606,13 +8934,13 @@ func TestTopLevelToBoolArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]ID)["baz"], iv)
 }
 
-func TestBoolMapMapIndex(t *testing.T) {
+func TestIDMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (BoolMapMap{"baz": BoolMap{"baz": Bool(true)}}).ToBoolMapMapOutput()
+	out := (IDMapMap{"baz": IDMap{"baz": ID("foo")}}).ToIDMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6621,7 +8949,7 @@ func TestBoolMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]ID)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6629,10 +8957,10 @@ func TestBoolMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToBoolMapMap(t *testing.T) {
+func TestToIDMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolMapMap(map[string]map[string]bool{"baz": {"baz": true}}).ToBoolMapMapOutput()
+	out := ToIDMapMap(map[string]map[string]ID{"baz": {"baz": ID("foo")}}).ToIDMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6642,13 +8970,13 @@ func TestToBoolMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]ID)["baz"], iv)
 }
 
-func TestTopLevelToBoolMapMapOutput(t *testing.T) {
+func TestTopLevelToIDMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToBoolMapMapOutput(map[string]BoolMapOutput{"baz": ToOutput(BoolMap{"baz": Bool(true)}).(BoolMapOutput)})
+	out := ToIDMapMapOutput(map[string]IDMapOutput{"baz": ToOutput(IDMap{"baz": ID("foo")}).(IDMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6658,13 +8986,13 @@ func TestTopLevelToBoolMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]bool)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]ID)["baz"], iv)
 }
 
-func TestFloat64MapIndex(t *testing.T) {
+func TestMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64Map{"baz": Float64(999.9)}).ToFloat64MapOutput()
+	out := (Map{"baz": String("any")}).ToMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6673,7 +9001,7 @@ func TestFloat64MapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]interface{})["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6681,10 +9009,10 @@ func TestFloat64MapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToFloat64Map(t *testing.T) {
+func TestToMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64Map(map[string]float64{"baz": 999.9}).ToFloat64MapOutput()
+	out := ToMap(map[string]interface{}{"baz": String("any")}).ToMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6694,13 +9022,13 @@ func TestToFloat64Map(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]interface{})["baz"], iv)
 }
 
-func TestTopLevelToFloat64MapOutput(t *testing.T) {
+func TestTopLevelToMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64MapOutput(map[string]Float64Output{"baz": ToOutput(Float64(999.9)).(Float64Output)})
+	out := ToMapOutput(map[string]Output{"baz": ToOutput(String("any")).(Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6710,13 +9038,13 @@ func TestTopLevelToFloat64MapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]interface{})["baz"], iv)
 }
 
-func TestFloat64ArrayMapIndex(t *testing.T) {
+func TestArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64ArrayMap{"baz": Float64Array{Float64(999.9)}}).ToFloat64ArrayMapOutput()
+	out := (ArrayMap{"baz": Array{String("any")}}).ToArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6725,7 +9053,7 @@ func TestFloat64ArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]interface{})["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6733,10 +9061,10 @@ func TestFloat64ArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToFloat64ArrayMap(t *testing.T) {
+func TestToArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64ArrayMap(map[string][]float64{"baz": {999.9}}).ToFloat64ArrayMapOutput()
+	out := ToArrayMap(map[string][]interface{}{"baz": {String("any")}}).ToArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6746,13 +9074,13 @@ func TestToFloat64ArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]interface{})["baz"], iv)
 }
 
-func TestTopLevelToFloat64ArrayMapOutput(t *testing.T) {
+func TestTopLevelToArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64ArrayMapOutput(map[string]Float64ArrayOutput{"baz": ToOutput(Float64Array{Float64(999.9)}).(Float64ArrayOutput)})
+	out := ToArrayMapOutput(map[string]ArrayOutput{"baz": ToOutput(Array{String("any")}).(ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6762,13 +9090,13 @@ func TestTopLevelToFloat64ArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]interface{})["baz"], iv)
 }
 
-func TestFloat64MapMapIndex(t *testing.T) {
+func TestMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Float64MapMap{"baz": Float64Map{"baz": Float64(999.9)}}).ToFloat64MapMapOutput()
+	out := (MapMap{"baz": Map{"baz": String("any")}}).ToMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6777,7 +9105,7 @@ func TestFloat64MapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]interface{})["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6785,10 +9113,10 @@ func TestFloat64MapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToFloat64MapMap(t *testing.T) {
+func TestToMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64MapMap(map[string]map[string]float64{"baz": {"baz": 999.9}}).ToFloat64MapMapOutput()
+	out := ToMapMap(map[string]map[string]interface{}{"baz": {"baz": String("any")}}).ToMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6798,13 +9126,13 @@ func TestToFloat64MapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]interface{})["baz"], iv)
 }
 
-func TestTopLevelToFloat64MapMapOutput(t *testing.T) {
+func TestTopLevelToMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToFloat64MapMapOutput(map[string]Float64MapOutput{"baz": ToOutput(Float64Map{"baz": Float64(999.9)}).(Float64MapOutput)})
+	out := ToMapMapOutput(map[string]MapOutput{"baz": ToOutput(Map{"baz": String("any")}).(MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6814,13 +9142,13 @@ func TestTopLevelToFloat64MapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]float64)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]interface{})["baz"], iv)
 }
 
-func TestIDMapIndex(t *testing.T) {
+func TestArrayArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IDMap{"baz": ID("foo")}).ToIDMapOutput()
+	out := (ArrayArrayMap{"baz": ArrayArray{Array{String("any")}}}).ToArrayArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6829,7 +9157,7 @@ func TestIDMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][][]interface{})["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6837,10 +9165,10 @@ func TestIDMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIDMap(t *testing.T) {
+func TestToArrayArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDMap(map[string]ID{"baz": ID("foo")}).ToIDMapOutput()
+	out := ToArrayArrayMap(map[string][][]interface{}{"baz": {{String("any")}}}).ToArrayArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6850,13 +9178,13 @@ func TestToIDMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][][]interface{})["baz"], iv)
 }
 
-func TestTopLevelToIDMapOutput(t *testing.T) {
+func TestTopLevelToArrayArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDMapOutput(map[string]IDOutput{"baz": ToOutput(ID("foo")).(IDOutput)})
+	out := ToArrayArrayMapOutput(map[string]ArrayArrayOutput{"baz": ToOutput(ArrayArray{Array{String("any")}}).(ArrayArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6866,13 +9194,13 @@ func TestTopLevelToIDMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][][]interface{})["baz"], iv)
 }
 
-func TestIDArrayMapIndex(t *testing.T) {
+func TestIntMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IDArrayMap{"baz": IDArray{ID("foo")}}).ToIDArrayMapOutput()
+	out := (IntMap{"baz": Int(42)}).ToIntMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6881,7 +9209,7 @@ func TestIDArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]int)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6889,10 +9217,10 @@ func TestIDArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIDArrayMap(t *testing.T) {
+func TestToIntMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDArrayMap(map[string][]ID{"baz": {ID("foo")}}).ToIDArrayMapOutput()
+	out := ToIntMap(map[string]int{"baz": 42}).ToIntMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6902,13 +9230,13 @@ func TestToIDArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]int)["baz"], iv)
 }
 
-func TestTopLevelToIDArrayMapOutput(t *testing.T) {
+func TestTopLevelToIntMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDArrayMapOutput(map[string]IDArrayOutput{"baz": ToOutput(IDArray{ID("foo")}).(IDArrayOutput)})
+	out := ToIntMapOutput(map[string]IntOutput{"baz": ToOutput(Int(42)).(IntOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6918,13 +9246,13 @@ func TestTopLevelToIDArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]int)["baz"], iv)
 }
 
-func TestIDMapMapIndex(t *testing.T) {
+func TestIntArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IDMapMap{"baz": IDMap{"baz": ID("foo")}}).ToIDMapMapOutput()
+	out := (IntArrayMap{"baz": IntArray{Int(42)}}).ToIntArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6933,7 +9261,7 @@ func TestIDMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]int)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6941,10 +9269,10 @@ func TestIDMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIDMapMap(t *testing.T) {
+func TestToIntArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDMapMap(map[string]map[string]ID{"baz": {"baz": ID("foo")}}).ToIDMapMapOutput()
+	out := ToIntArrayMap(map[string][]int{"baz": {42}}).ToIntArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6954,13 +9282,13 @@ func TestToIDMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]int)["baz"], iv)
 }
 
-func TestTopLevelToIDMapMapOutput(t *testing.T) {
+func TestTopLevelToIntArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIDMapMapOutput(map[string]IDMapOutput{"baz": ToOutput(IDMap{"baz": ID("foo")}).(IDMapOutput)})
+	out := ToIntArrayMapOutput(map[string]IntArrayOutput{"baz": ToOutput(IntArray{Int(42)}).(IntArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6970,13 +9298,13 @@ func TestTopLevelToIDMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]ID)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]int)["baz"], iv)
 }
 
-func TestMapIndex(t *testing.T) {
+func TestIntMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (Map{"baz": String("any")}).ToMapOutput()
+	out := (IntMapMap{"baz": IntMap{"baz": Int(42)}}).ToIntMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -6985,7 +9313,7 @@ func TestMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]int)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -6993,10 +9321,10 @@ func TestMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToMap(t *testing.T) {
+func TestToIntMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToMap(map[string]interface{}{"baz": String("any")}).ToMapOutput()
+	out := ToIntMapMap(map[string]map[string]int{"baz": {"baz": 42}}).ToIntMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7006,13 +9334,13 @@ func TestToMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]int)["baz"], iv)
 }
 
-func TestTopLevelToMapOutput(t *testing.T) {
+func TestTopLevelToIntMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToMapOutput(map[string]Output{"baz": ToOutput(String("any")).(Output)})
+	out := ToIntMapMapOutput(map[string]IntMapOutput{"baz": ToOutput(IntMap{"baz": Int(42)}).(IntMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7022,13 +9350,13 @@ func TestTopLevelToMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]int)["baz"], iv)
 }
 
-func TestArrayMapIndex(t *testing.T) {
+func TestInt64MapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArrayMap{"baz": Array{String("any")}}).ToArrayMapOutput()
+	out := (Int64Map{"baz": Int64(42)}).ToInt64MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7037,7 +9365,7 @@ func TestArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]int64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7045,10 +9373,10 @@ func TestArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArrayMap(t *testing.T) {
+func TestToInt64Map(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayMap(map[string][]interface{}{"baz": {String("any")}}).ToArrayMapOutput()
+	out := ToInt64Map(map[string]int64{"baz": 42}).ToInt64MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7058,13 +9386,13 @@ func TestToArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]int64)["baz"], iv)
 }
 
-func TestTopLevelToArrayMapOutput(t *testing.T) {
+func TestTopLevelToInt64MapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayMapOutput(map[string]ArrayOutput{"baz": ToOutput(Array{String("any")}).(ArrayOutput)})
+	out := ToInt64MapOutput(map[string]Int64Output{"baz": ToOutput(Int64(42)).(Int64Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7074,13 +9402,13 @@ func TestTopLevelToArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]int64)["baz"], iv)
 }
 
-func TestMapMapIndex(t *testing.T) {
+func TestInt64ArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (MapMap{"baz": Map{"baz": String("any")}}).ToMapMapOutput()
+	out := (Int64ArrayMap{"baz": Int64Array{Int64(42)}}).ToInt64ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7089,7 +9417,7 @@ func TestMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]int64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7097,10 +9425,10 @@ func TestMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToMapMap(t *testing.T) {
+func TestToInt64ArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToMapMap(map[string]map[string]interface{}{"baz": {"baz": String("any")}}).ToMapMapOutput()
+	out := ToInt64ArrayMap(map[string][]int64{"baz": {42}}).ToInt64ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7110,13 +9438,13 @@ func TestToMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]int64)["baz"], iv)
 }
 
-func TestTopLevelToMapMapOutput(t *testing.T) {
+func TestTopLevelToInt64ArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToMapMapOutput(map[string]MapOutput{"baz": ToOutput(Map{"baz": String("any")}).(MapOutput)})
+	out := ToInt64ArrayMapOutput(map[string]Int64ArrayOutput{"baz": ToOutput(Int64Array{Int64(42)}).(Int64ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7126,13 +9454,13 @@ func TestTopLevelToMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]int64)["baz"], iv)
 }
 
-func TestArrayArrayMapIndex(t *testing.T) {
+func TestInt64MapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (ArrayArrayMap{"baz": ArrayArray{Array{String("any")}}}).ToArrayArrayMapOutput()
+	out := (Int64MapMap{"baz": Int64Map{"baz": Int64(42)}}).ToInt64MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7141,7 +9469,7 @@ func TestArrayArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][][]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]int64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7149,10 +9477,10 @@ func TestArrayArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToArrayArrayMap(t *testing.T) {
+func TestToInt64MapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayArrayMap(map[string][][]interface{}{"baz": {{String("any")}}}).ToArrayArrayMapOutput()
+	out := ToInt64MapMap(map[string]map[string]int64{"baz": {"baz": 42}}).ToInt64MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7162,13 +9490,13 @@ func TestToArrayArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][][]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]int64)["baz"], iv)
 }
 
-func TestTopLevelToArrayArrayMapOutput(t *testing.T) {
+func TestTopLevelToInt64MapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToArrayArrayMapOutput(map[string]ArrayArrayOutput{"baz": ToOutput(ArrayArray{Array{String("any")}}).(ArrayArrayOutput)})
+	out := ToInt64MapMapOutput(map[string]Int64MapOutput{"baz": ToOutput(Int64Map{"baz": Int64(42)}).(Int64MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7178,13 +9506,13 @@ func TestTopLevelToArrayArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][][]interface{})["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]int64)["baz"], iv)
 }
 
-func TestIntMapIndex(t *testing.T) {
+func TestStringMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntMap{"baz": Int(42)}).ToIntMapOutput()
+	out := (StringMap{"baz": String("foo")}).ToStringMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7193,7 +9521,7 @@ func TestIntMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]string)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7201,10 +9529,10 @@ func TestIntMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIntMap(t *testing.T) {
+func TestToStringMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntMap(map[string]int{"baz": 42}).ToIntMapOutput()
+	out := ToStringMap(map[string]string{"baz": "foo"}).ToStringMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7214,13 +9542,13 @@ func TestToIntMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]string)["baz"], iv)
 }
 
-func TestTopLevelToIntMapOutput(t *testing.T) {
+func TestTopLevelToStringMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntMapOutput(map[string]IntOutput{"baz": ToOutput(Int(42)).(IntOutput)})
+	out := ToStringMapOutput(map[string]StringOutput{"baz": ToOutput(String("foo")).(StringOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7230,13 +9558,13 @@ func TestTopLevelToIntMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]string)["baz"], iv)
 }
 
-func TestIntArrayMapIndex(t *testing.T) {
+func TestStringArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntArrayMap{"baz": IntArray{Int(42)}}).ToIntArrayMapOutput()
+	out := (StringArrayMap{"baz": StringArray{String("foo")}}).ToStringArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7245,7 +9573,7 @@ func TestIntArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]string)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7253,10 +9581,10 @@ func TestIntArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIntArrayMap(t *testing.T) {
+func TestToStringArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntArrayMap(map[string][]int{"baz": {42}}).ToIntArrayMapOutput()
+	out := ToStringArrayMap(map[string][]string{"baz": {"foo"}}).ToStringArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7266,13 +9594,13 @@ func TestToIntArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]string)["baz"], iv)
 }
 
-func TestTopLevelToIntArrayMapOutput(t *testing.T) {
+func TestTopLevelToStringArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntArrayMapOutput(map[string]IntArrayOutput{"baz": ToOutput(IntArray{Int(42)}).(IntArrayOutput)})
+	out := ToStringArrayMapOutput(map[string]StringArrayOutput{"baz": ToOutput(StringArray{String("foo")}).(StringArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7282,13 +9610,13 @@ func TestTopLevelToIntArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]string)["baz"], iv)
 }
 
-func TestIntMapMapIndex(t *testing.T) {
+func TestStringMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (IntMapMap{"baz": IntMap{"baz": Int(42)}}).ToIntMapMapOutput()
+	out := (StringMapMap{"baz": StringMap{"baz": String("foo")}}).ToStringMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7297,7 +9625,7 @@ func TestIntMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]string)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7305,10 +9633,10 @@ func TestIntMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToIntMapMap(t *testing.T) {
+func TestToStringMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntMapMap(map[string]map[string]int{"baz": {"baz": 42}}).ToIntMapMapOutput()
+	out := ToStringMapMap(map[string]map[string]string{"baz": {"baz": "foo"}}).ToStringMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7318,13 +9646,13 @@ func TestToIntMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]string)["baz"], iv)
 }
 
-func TestTopLevelToIntMapMapOutput(t *testing.T) {
+func TestTopLevelToStringMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToIntMapMapOutput(map[string]IntMapOutput{"baz": ToOutput(IntMap{"baz": Int(42)}).(IntMapOutput)})
+	out := ToStringMapMapOutput(map[string]StringMapOutput{"baz": ToOutput(StringMap{"baz": String("foo")}).(StringMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7334,13 +9662,13 @@ func TestTopLevelToIntMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]int)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]string)["baz"], iv)
 }
 
-func TestStringMapIndex(t *testing.T) {
+func TestURNMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (StringMap{"baz": String("foo")}).ToStringMapOutput()
+	out := (URNMap{"baz": URN("foo")}).ToURNMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7349,7 +9677,7 @@ func TestStringMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]URN)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7357,10 +9685,10 @@ func TestStringMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToStringMap(t *testing.T) {
+func TestToURNMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringMap(map[string]string{"baz": "foo"}).ToStringMapOutput()
+	out := ToURNMap(map[string]URN{"baz": URN("foo")}).ToURNMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7370,13 +9698,13 @@ func TestToStringMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]URN)["baz"], iv)
 }
 
-func TestTopLevelToStringMapOutput(t *testing.T) {
+func TestTopLevelToURNMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringMapOutput(map[string]StringOutput{"baz": ToOutput(String("foo")).(StringOutput)})
+	out := ToURNMapOutput(map[string]URNOutput{"baz": ToOutput(URN("foo")).(URNOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7386,13 +9714,13 @@ func TestTopLevelToStringMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]URN)["baz"], iv)
 }
 
-func TestStringArrayMapIndex(t *testing.T) {
+func TestURNArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (StringArrayMap{"baz": StringArray{String("foo")}}).ToStringArrayMapOutput()
+	out := (URNArrayMap{"baz": URNArray{URN("foo")}}).ToURNArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7401,7 +9729,7 @@ func TestStringArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]URN)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7409,10 +9737,10 @@ func TestStringArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToStringArrayMap(t *testing.T) {
+func TestToURNArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringArrayMap(map[string][]string{"baz": {"foo"}}).ToStringArrayMapOutput()
+	out := ToURNArrayMap(map[string][]URN{"baz": {URN("foo")}}).ToURNArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7422,13 +9750,13 @@ func TestToStringArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]URN)["baz"], iv)
 }
 
-func TestTopLevelToStringArrayMapOutput(t *testing.T) {
+func TestTopLevelToURNArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringArrayMapOutput(map[string]StringArrayOutput{"baz": ToOutput(StringArray{String("foo")}).(StringArrayOutput)})
+	out := ToURNArrayMapOutput(map[string]URNArrayOutput{"baz": ToOutput(URNArray{URN("foo")}).(URNArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7438,13 +9766,13 @@ func TestTopLevelToStringArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]URN)["baz"], iv)
 }
 
-func TestStringMapMapIndex(t *testing.T) {
+func TestURNMapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (StringMapMap{"baz": StringMap{"baz": String("foo")}}).ToStringMapMapOutput()
+	out := (URNMapMap{"baz": URNMap{"baz": URN("foo")}}).ToURNMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7453,7 +9781,7 @@ func TestStringMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]URN)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7461,10 +9789,10 @@ func TestStringMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToStringMapMap(t *testing.T) {
+func TestToURNMapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringMapMap(map[string]map[string]string{"baz": {"baz": "foo"}}).ToStringMapMapOutput()
+	out := ToURNMapMap(map[string]map[string]URN{"baz": {"baz": URN("foo")}}).ToURNMapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7474,13 +9802,13 @@ func TestToStringMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]URN)["baz"], iv)
 }
 
-func TestTopLevelToStringMapMapOutput(t *testing.T) {
+func TestTopLevelToURNMapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToStringMapMapOutput(map[string]StringMapOutput{"baz": ToOutput(StringMap{"baz": String("foo")}).(StringMapOutput)})
+	out := ToURNMapMapOutput(map[string]URNMapOutput{"baz": ToOutput(URNMap{"baz": URN("foo")}).(URNMapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7490,13 +9818,13 @@ func TestTopLevelToStringMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]string)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]URN)["baz"], iv)
 }
 
-func TestURNMapIndex(t *testing.T) {
+func TestUint64MapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (URNMap{"baz": URN("foo")}).ToURNMapOutput()
+	out := (Uint64Map{"baz": Uint64(42)}).ToUint64MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7505,7 +9833,7 @@ func TestURNMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]uint64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7513,10 +9841,10 @@ func TestURNMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToURNMap(t *testing.T) {
+func TestToUint64Map(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNMap(map[string]URN{"baz": URN("foo")}).ToURNMapOutput()
+	out := ToUint64Map(map[string]uint64{"baz": 42}).ToUint64MapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7526,13 +9854,13 @@ func TestToURNMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]uint64)["baz"], iv)
 }
 
-func TestTopLevelToURNMapOutput(t *testing.T) {
+func TestTopLevelToUint64MapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNMapOutput(map[string]URNOutput{"baz": ToOutput(URN("foo")).(URNOutput)})
+	out := ToUint64MapOutput(map[string]Uint64Output{"baz": ToOutput(Uint64(42)).(Uint64Output)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7542,13 +9870,13 @@ func TestTopLevelToURNMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]uint64)["baz"], iv)
 }
 
-func TestURNArrayMapIndex(t *testing.T) {
+func TestUint64ArrayMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (URNArrayMap{"baz": URNArray{URN("foo")}}).ToURNArrayMapOutput()
+	out := (Uint64ArrayMap{"baz": Uint64Array{Uint64(42)}}).ToUint64ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7557,7 +9885,7 @@ func TestURNArrayMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string][]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]uint64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7565,10 +9893,10 @@ func TestURNArrayMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToURNArrayMap(t *testing.T) {
+func TestToUint64ArrayMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNArrayMap(map[string][]URN{"baz": {URN("foo")}}).ToURNArrayMapOutput()
+	out := ToUint64ArrayMap(map[string][]uint64{"baz": {42}}).ToUint64ArrayMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7578,13 +9906,13 @@ func TestToURNArrayMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]uint64)["baz"], iv)
 }
 
-func TestTopLevelToURNArrayMapOutput(t *testing.T) {
+func TestTopLevelToUint64ArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNArrayMapOutput(map[string]URNArrayOutput{"baz": ToOutput(URNArray{URN("foo")}).(URNArrayOutput)})
+	out := ToUint64ArrayMapOutput(map[string]Uint64ArrayOutput{"baz": ToOutput(Uint64Array{Uint64(42)}).(Uint64ArrayOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7594,13 +9922,13 @@ func TestTopLevelToURNArrayMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string][]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string][]uint64)["baz"], iv)
 }
 
-func TestURNMapMapIndex(t *testing.T) {
+func TestUint64MapMapIndex(t *testing.T) {
 	t.Parallel()
 
-	out := (URNMapMap{"baz": URNMap{"baz": URN("foo")}}).ToURNMapMapOutput()
+	out := (Uint64MapMap{"baz": Uint64Map{"baz": Uint64(42)}}).ToUint64MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7609,7 +9937,7 @@ func TestURNMapMapIndex(t *testing.T) {
 	iv, known, _, _, err := await(out.MapIndex(String("baz")))
 	assert.True(t, known)
 	assert.NoError(t, err)
-	assert.EqualValues(t, av.(map[string]map[string]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]uint64)["baz"], iv)
 
 	iv, known, _, _, err = await(out.MapIndex(String("notfound")))
 	assert.True(t, known)
@@ -7617,10 +9945,10 @@ func TestURNMapMapIndex(t *testing.T) {
 	assert.Zero(t, iv)
 }
 
-func TestToURNMapMap(t *testing.T) {
+func TestToUint64MapMap(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNMapMap(map[string]map[string]URN{"baz": {"baz": URN("foo")}}).ToURNMapMapOutput()
+	out := ToUint64MapMap(map[string]map[string]uint64{"baz": {"baz": 42}}).ToUint64MapMapOutput()
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7630,13 +9958,13 @@ func TestToURNMapMap(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]uint64)["baz"], iv)
 }
 
-func TestTopLevelToURNMapMapOutput(t *testing.T) {
+func TestTopLevelToUint64MapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	out := ToURNMapMapOutput(map[string]URNMapOutput{"baz": ToOutput(URNMap{"baz": URN("foo")}).(URNMapOutput)})
+	out := ToUint64MapMapOutput(map[string]Uint64MapOutput{"baz": ToOutput(Uint64Map{"baz": Uint64(42)}).(Uint64MapOutput)})
 
 	av, known, _, _, err := await(out)
 	assert.True(t, known)
@@ -7646,7 +9974,7 @@ func TestTopLevelToURNMapMapOutput(t *testing.T) {
 	assert.True(t, known)
 	assert.NoError(t, err)
 
-	assert.EqualValues(t, av.(map[string]map[string]URN)["baz"], iv)
+	assert.EqualValues(t, av.(map[string]map[string]uint64)["baz"], iv)
 }
 
 // Test AnyOutput `To*Output` functions
@@ -7991,11 +10319,147 @@ func TestAnyOutputAsAssetOrArchiveMapMapOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsAssetOrArchiveArrayArrayOutput(t *testing.T) {
+func TestAnyOutputAsAssetOrArchiveArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(AssetOrArchiveArrayArray{AssetOrArchiveArray{NewFileArchive("foo.zip")}})
+	out := anyout.AsAssetOrArchiveArrayArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Bool(true))
+	out := anyout.AsBoolOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolPtrOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(BoolPtr(bool(Bool(true))))
+	out := anyout.AsBoolPtrOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(BoolArray{Bool(true)})
+	out := anyout.AsBoolArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(BoolMap{"baz": Bool(true)})
+	out := anyout.AsBoolMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(BoolArrayMap{"baz": BoolArray{Bool(true)}})
+	out := anyout.AsBoolArrayMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolMapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(BoolMapArray{BoolMap{"baz": Bool(true)}})
+	out := anyout.AsBoolMapArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolMapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(BoolMapMap{"baz": BoolMap{"baz": Bool(true)}})
+	out := anyout.AsBoolMapMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsBoolArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(AssetOrArchiveArrayArray{AssetOrArchiveArray{NewFileArchive("foo.zip")}})
-	out := anyout.AsAssetOrArchiveArrayArrayOutput()
+	anyout := Any(BoolArrayArray{BoolArray{Bool(true)}})
+	out := anyout.AsBoolArrayArrayOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8008,11 +10472,11 @@ func TestAnyOutputAsAssetOrArchiveArrayArrayOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolOutput(t *testing.T) {
+func TestAnyOutputAsFloat32Output(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(Bool(true))
-	out := anyout.AsBoolOutput()
+	anyout := Any(Float32(999.9))
+	out := anyout.AsFloat32Output()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8025,11 +10489,11 @@ func TestAnyOutputAsBoolOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolPtrOutput(t *testing.T) {
+func TestAnyOutputAsFloat32PtrOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolPtr(bool(Bool(true))))
-	out := anyout.AsBoolPtrOutput()
+	anyout := Any(Float32Ptr(float32(Float32(999.9))))
+	out := anyout.AsFloat32PtrOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8042,11 +10506,11 @@ func TestAnyOutputAsBoolPtrOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolArrayOutput(t *testing.T) {
+func TestAnyOutputAsFloat32ArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolArray{Bool(true)})
-	out := anyout.AsBoolArrayOutput()
+	anyout := Any(Float32Array{Float32(999.9)})
+	out := anyout.AsFloat32ArrayOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8059,11 +10523,11 @@ func TestAnyOutputAsBoolArrayOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolMapOutput(t *testing.T) {
+func TestAnyOutputAsFloat32MapOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolMap{"baz": Bool(true)})
-	out := anyout.AsBoolMapOutput()
+	anyout := Any(Float32Map{"baz": Float32(999.9)})
+	out := anyout.AsFloat32MapOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8076,11 +10540,11 @@ func TestAnyOutputAsBoolMapOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolArrayMapOutput(t *testing.T) {
+func TestAnyOutputAsFloat32ArrayMapOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolArrayMap{"baz": BoolArray{Bool(true)}})
-	out := anyout.AsBoolArrayMapOutput()
+	anyout := Any(Float32ArrayMap{"baz": Float32Array{Float32(999.9)}})
+	out := anyout.AsFloat32ArrayMapOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8093,11 +10557,11 @@ func TestAnyOutputAsBoolArrayMapOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolMapArrayOutput(t *testing.T) {
+func TestAnyOutputAsFloat32MapArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolMapArray{BoolMap{"baz": Bool(true)}})
-	out := anyout.AsBoolMapArrayOutput()
+	anyout := Any(Float32MapArray{Float32Map{"baz": Float32(999.9)}})
+	out := anyout.AsFloat32MapArrayOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8110,11 +10574,11 @@ func TestAnyOutputAsBoolMapArrayOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolMapMapOutput(t *testing.T) {
+func TestAnyOutputAsFloat32MapMapOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolMapMap{"baz": BoolMap{"baz": Bool(true)}})
-	out := anyout.AsBoolMapMapOutput()
+	anyout := Any(Float32MapMap{"baz": Float32Map{"baz": Float32(999.9)}})
+	out := anyout.AsFloat32MapMapOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8127,11 +10591,11 @@ func TestAnyOutputAsBoolMapMapOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
-func TestAnyOutputAsBoolArrayArrayOutput(t *testing.T) {
+func TestAnyOutputAsFloat32ArrayArrayOutput(t *testing.T) {
 	t.Parallel()
 
-	anyout := Any(BoolArrayArray{BoolArray{Bool(true)}})
-	out := anyout.AsBoolArrayArrayOutput()
+	anyout := Any(Float32ArrayArray{Float32Array{Float32(999.9)}})
+	out := anyout.AsFloat32ArrayArrayOutput()
 
 	ev, known, _, _, err := await(anyout)
 	assert.True(t, known)
@@ -8671,6 +11135,142 @@ func TestAnyOutputAsIntArrayArrayOutput(t *testing.T) {
 	assert.EqualValues(t, ev, av)
 }
 
+func TestAnyOutputAsInt64Output(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64(42))
+	out := anyout.AsInt64Output()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64PtrOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64Ptr(int64(Int64(42))))
+	out := anyout.AsInt64PtrOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64ArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64Array{Int64(42)})
+	out := anyout.AsInt64ArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64MapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64Map{"baz": Int64(42)})
+	out := anyout.AsInt64MapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64ArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64ArrayMap{"baz": Int64Array{Int64(42)}})
+	out := anyout.AsInt64ArrayMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64MapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64MapArray{Int64Map{"baz": Int64(42)}})
+	out := anyout.AsInt64MapArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64MapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64MapMap{"baz": Int64Map{"baz": Int64(42)}})
+	out := anyout.AsInt64MapMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsInt64ArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Int64ArrayArray{Int64Array{Int64(42)}})
+	out := anyout.AsInt64ArrayArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
 func TestAnyOutputAsStringOutput(t *testing.T) {
 	t.Parallel()
 
@@ -8942,3 +11542,139 @@ func TestAnyOutputAsURNArrayArrayOutput(t *testing.T) {
 
 	assert.EqualValues(t, ev, av)
 }
+
+func TestAnyOutputAsUint64Output(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64(42))
+	out := anyout.AsUint64Output()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64PtrOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64Ptr(uint64(Uint64(42))))
+	out := anyout.AsUint64PtrOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64ArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64Array{Uint64(42)})
+	out := anyout.AsUint64ArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64MapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64Map{"baz": Uint64(42)})
+	out := anyout.AsUint64MapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64ArrayMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64ArrayMap{"baz": Uint64Array{Uint64(42)}})
+	out := anyout.AsUint64ArrayMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64MapArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64MapArray{Uint64Map{"baz": Uint64(42)}})
+	out := anyout.AsUint64MapArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64MapMapOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64MapMap{"baz": Uint64Map{"baz": Uint64(42)}})
+	out := anyout.AsUint64MapMapOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
+
+func TestAnyOutputAsUint64ArrayArrayOutput(t *testing.T) {
+	t.Parallel()
+
+	anyout := Any(Uint64ArrayArray{Uint64Array{Uint64(42)}})
+	out := anyout.AsUint64ArrayArrayOutput()
+
+	ev, known, _, _, err := await(anyout)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	av, known, _, _, err := await(out)
+	assert.True(t, known)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, ev, av)
+}
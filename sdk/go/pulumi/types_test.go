@@ -20,9 +20,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 	"github.com/pulumi/pulumi/sdk/v3/go/internal"
 	"github.com/stretchr/testify/assert"
@@ -1219,3 +1221,76 @@ func TestApplyTCoerceRejectDifferentKinds(t *testing.T) {
 		})
 	}, "int-string should not be allowed")
 }
+
+//nolint:paralleltest // enables provenance tracking
+func TestOutputProvenance(t *testing.T) {
+	SetProvenanceTracking(true)
+	defer SetProvenanceTracking(false)
+
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			return "someID", resource.PropertyMap{"foo": resource.NewStringProperty("qux")}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		require.NoError(t, ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{}, &res))
+
+		upper := res.Foo.ApplyT(strings.ToUpper).(StringOutput)
+		combined := Sprintf("%s/%s", upper, res.ID())
+		_, _, _, _, err := await(combined)
+		require.NoError(t, err)
+
+		p := res.Foo.Provenance()
+		assert.Equal(t, Resource(&res), p.Resource)
+		assert.Equal(t, "foo", p.Property)
+		assert.False(t, p.ResolvedAt.IsZero())
+		assert.Empty(t, p.Sources)
+
+		// Sprintf is an All followed by an apply.
+		p = combined.Provenance()
+		assert.Nil(t, p.Resource)
+		require.Len(t, p.Sources, 1)
+		all := p.Sources[0]
+		require.Len(t, all.Sources, 2)
+
+		applied := all.Sources[0]
+		require.Len(t, applied.Sources, 1)
+		assert.Equal(t, Resource(&res), applied.Sources[0].Resource)
+		assert.Equal(t, "foo", applied.Sources[0].Property)
+
+		assert.Equal(t, Resource(&res), all.Sources[1].Resource)
+		assert.Equal(t, "id", all.Sources[1].Property)
+		assert.False(t, all.Sources[1].ResolvedAt.After(p.ResolvedAt))
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+}
+
+//nolint:paralleltest // enables provenance tracking
+func TestOutputProvenanceSharedSources(t *testing.T) {
+	out := String("a").ToStringOutput().ApplyT(strings.ToUpper).(StringOutput)
+	assert.Empty(t, out.Provenance().Sources, "sources are only recorded when tracking is enabled")
+
+	SetProvenanceTracking(true)
+	defer SetProvenanceTracking(false)
+
+	// Each level derives from the previous one twice, so walking the sources without deduplication would visit
+	// 2^64 outputs.
+	out = String("a").ToStringOutput()
+	for i := 0; i < 64; i++ {
+		out = All(out, out).ApplyT(func([]interface{}) string { return "a" }).(StringOutput)
+	}
+	_, _, _, _, err := await(out)
+	require.NoError(t, err)
+
+	p := out.Provenance()
+	for i := 0; i < 64; i++ {
+		require.Len(t, p.Sources, 1)
+		all := p.Sources[0]
+		require.Len(t, all.Sources, 2)
+		p = all.Sources[0]
+	}
+	assert.Empty(t, p.Sources)
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"fmt"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// ArgsValidator may be implemented by the args of a resource to validate them before the resource is registered.
+//
+// Validate is called by RegisterResource and RegisterRemoteComponentResource after any transformations have run.
+// If it returns an error, the resource is not registered and its outputs are rejected,
+// but the program continues to run so that the failures of all resources can be reported together:
+// the program fails once it completes, during both previews and updates.
+//
+// Errors may identify the offending properties by being, wrapping, or joining PropertyErrors.
+type ArgsValidator interface {
+	Validate(ctx *Context) error
+}
+
+// PropertyError reports that the value at a property path of a resource's args is invalid.
+type PropertyError struct {
+	// Path is the path to the property, e.g. "tags" or "rules[0].port".
+	Path string
+	// Message describes why the value is invalid.
+	Message string
+}
+
+// NewPropertyError returns a PropertyError for the property at the given path.
+func NewPropertyError(path, format string, args ...interface{}) *PropertyError {
+	return &PropertyError{Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *PropertyError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ArgsValidationError reports that the args of a resource failed validation.
+type ArgsValidationError struct {
+	// Type is the type token of the resource.
+	Type string
+	// Name is the name of the resource.
+	Name string
+	// Err is the error returned by Validate.
+	Err error
+}
+
+func (e *ArgsValidationError) Error() string {
+	return fmt.Sprintf("invalid args for %s resource %q: %v", e.Type, e.Name, e.Err)
+}
+
+func (e *ArgsValidationError) Unwrap() error {
+	return e.Err
+}
+
+// validateArgs runs the ArgsValidator implemented by props, if any.
+func (ctx *Context) validateArgs(t, name string, props Input) error {
+	v, ok := props.(ArgsValidator)
+	if !ok {
+		return nil
+	}
+	if err := v.Validate(ctx); err != nil {
+		err = &ArgsValidationError{Type: t, Name: name, Err: err}
+
+		ctx.rpcsLock.Lock()
+		ctx.validationErrors = append(ctx.validationErrors, err)
+		ctx.rpcsLock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// validationError returns the aggregated args validation failures, if any.
+// The caller must hold rpcsLock.
+func (ctx *Context) validationError() error {
+	if len(ctx.validationErrors) == 0 {
+		return nil
+	}
+	return &multierror.Error{
+		Errors: ctx.validationErrors,
+		ErrorFormat: func(errs []error) string {
+			msgs := make([]string, len(errs))
+			for i, err := range errs {
+				msgs[i] = "\t* " + err.Error()
+			}
+			return fmt.Sprintf("%d resource(s) failed validation:\n%s", len(errs), strings.Join(msgs, "\n"))
+		},
+	}
+}
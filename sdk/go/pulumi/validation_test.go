@@ -0,0 +1,92 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatedArgs struct {
+	Foo StringInput
+	Bar StringInput
+}
+
+func (validatedArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*testResource2Args)(nil)).Elem()
+}
+
+func (args *validatedArgs) Validate(ctx *Context) error {
+	var errs []error
+	if args.Foo == nil {
+		errs = append(errs, NewPropertyError("foo", "is required"))
+	}
+	if s, ok := args.Bar.(String); ok && len(s) > 3 {
+		errs = append(errs, NewPropertyError("bar", "must be at most 3 characters, got %d", len(s)))
+	}
+	return errors.Join(errs...)
+}
+
+func TestArgsValidator(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var registered []string
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			registered = append(registered, args.Name)
+			return args.Name, resource.PropertyMap{}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var valid, missing, tooLong testResource2
+		require.NoError(t, ctx.RegisterResource("test:resource:type", "valid",
+			&validatedArgs{Foo: String("foo"), Bar: String("bar")}, &valid))
+		// Registration failures are deferred until the program completes.
+		require.NoError(t, ctx.RegisterResource("test:resource:type", "missing",
+			&validatedArgs{}, &missing))
+		require.NoError(t, ctx.RegisterResource("test:resource:type", "tooLong",
+			&validatedArgs{Foo: String("foo"), Bar: String("barbaz")}, &tooLong))
+
+		_, _, _, _, err := await(missing.URN())
+		assert.ErrorContains(t, err, "foo: is required")
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	require.Error(t, err)
+
+	assert.ErrorContains(t, err, "2 resource(s) failed validation")
+	assert.ErrorContains(t, err, `invalid args for test:resource:type resource "missing": foo: is required`)
+	assert.ErrorContains(t, err,
+		`invalid args for test:resource:type resource "tooLong": bar: must be at most 3 characters, got 6`)
+
+	var argsErr *ArgsValidationError
+	require.ErrorAs(t, err, &argsErr)
+	var propErr *PropertyError
+	require.ErrorAs(t, err, &propErr)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"valid"}, registered)
+}
@@ -61,3 +61,128 @@ func Flatten[A any, I Input[A]](i Input[I]) Output[A] {
 func All(args ...Input[any]) Output[[]any] {
 	return Array[any](args).ToOutput(context.Background())
 }
+
+// AllMapContext combines a map of outputs into a single output
+// that produces a map of all the output values under the same keys.
+//
+// The resulting output depends on all the outputs in the map,
+// and is secret if any of them are secret.
+func AllMapContext[V any](ctx context.Context, m map[string]Output[V]) Output[map[string]V] {
+	inputs := make(Map[V], len(m))
+	for k, v := range m {
+		inputs[k] = v
+	}
+	return inputs.ToOutput(ctx)
+}
+
+// AllMap combines a map of outputs into a single output
+// that produces a map of all the output values under the same keys.
+//
+// This is a variant of AllMapContext
+// that uses the background context.
+func AllMap[V any](m map[string]Output[V]) Output[map[string]V] {
+	return AllMapContext(context.Background(), m)
+}
+
+// ToMapOutput combines a map of outputs into a single MapOutput.
+// This is similar to AllMap, but the result
+// supports map operations like MapIndex.
+func ToMapOutput[V any](m map[string]Output[V]) MapOutput[V] {
+	return MapOutput[V]{OutputState: AllMap(m).OutputState}
+}
+
+// IfThenElseContext produces an output with the value of 'then'
+// if the condition is true, and the value of 'els' otherwise.
+//
+// Only the selected branch is awaited:
+// the result is unknown if the condition is unknown
+// or if the selected branch is unknown,
+// but an unknown value in the other branch has no effect.
+// The result depends on the condition and the selected branch.
+func IfThenElseContext[T any](ctx context.Context, cond Input[bool], then, els Input[T]) Output[T] {
+	condOutput := cond.ToOutput(ctx)
+	condState := internal.GetOutputState(condOutput)
+
+	stateT := internal.NewOutputState(
+		internal.OutputJoinGroup(condState),
+		typeOf[T](),
+		internal.OutputDependencies(condState)...,
+	)
+	go func() {
+		var c bool
+		var v T
+
+		applier := newApplyNState[T](stateT)
+		applyNStep(ctx, &applier, condOutput, &c)
+		if c {
+			applyNStep(ctx, &applier, then.ToOutput(ctx), &v)
+		} else {
+			applyNStep(ctx, &applier, els.ToOutput(ctx), &v)
+		}
+
+		if applier.ok {
+			applier.finish(v, nil /* err */)
+		}
+	}()
+
+	return Output[T]{OutputState: stateT}
+}
+
+// IfThenElse produces an output with the value of 'then'
+// if the condition is true, and the value of 'els' otherwise.
+//
+// This is a variant of IfThenElseContext
+// that uses the background context.
+func IfThenElse[T any](cond Input[bool], then, els Input[T]) Output[T] {
+	return IfThenElseContext(context.Background(), cond, then, els)
+}
+
+// CoalesceContext produces an output with the first input value
+// that is not the zero value of T.
+// If all inputs hold the zero value (or there are no inputs),
+// the result is the zero value.
+//
+// Inputs are awaited in order, stopping at the first non-zero value.
+// The result is unknown if any input awaited before that is unknown.
+func CoalesceContext[T comparable](ctx context.Context, inputs ...Input[T]) Output[T] {
+	outputs := make([]Output[T], len(inputs))
+	for i, in := range inputs {
+		outputs[i] = in.ToOutput(ctx)
+	}
+
+	var join *internal.WorkGroup
+	if len(outputs) > 0 {
+		join = internal.OutputJoinGroup(internal.GetOutputState(outputs[0]))
+	}
+
+	stateT := internal.NewOutputState(join, typeOf[T]())
+	go func() {
+		var zero T
+
+		applier := newApplyNState[T](stateT)
+		for _, o := range outputs {
+			var v T
+			applyNStep(ctx, &applier, o, &v)
+			if !applier.ok {
+				return
+			}
+			if v != zero {
+				applier.finish(v, nil /* err */)
+				return
+			}
+		}
+
+		applier.finish(zero, nil /* err */)
+	}()
+
+	return Output[T]{OutputState: stateT}
+}
+
+// Coalesce produces an output with the first input value
+// that is not the zero value of T.
+//
+// This is a variant of CoalesceContext
+// that uses the background context.
+func Coalesce[T comparable](inputs ...Input[T]) Output[T] {
+	return CoalesceContext(context.Background(), inputs...)
+}
@@ -92,3 +92,219 @@ func TestAll(t *testing.T) {
 		map[string]int{"d": 3, "e": 4},
 	}, v)
 }
+
+func TestAllMap(t *testing.T) {
+	t.Parallel()
+
+	o := pulumix.AllMap(map[string]pulumix.Output[int]{
+		"a": pulumix.Val(1),
+		"b": pulumix.Val(2),
+	})
+	v, known, secret, deps, err := internal.AwaitOutput(context.Background(), o)
+	require.NoError(t, err)
+	assert.True(t, known)
+	assert.False(t, secret)
+	assert.Empty(t, deps)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, v)
+}
+
+func TestAllMap_empty(t *testing.T) {
+	t.Parallel()
+
+	v, known, _, _, err := internal.AwaitOutput(context.Background(), pulumix.AllMap[string](nil))
+	require.NoError(t, err)
+	assert.True(t, known)
+	assert.Equal(t, map[string]string{}, v)
+}
+
+func TestAllMap_secret(t *testing.T) {
+	t.Parallel()
+
+	secretOut := pulumix.Output[string]{
+		OutputState: internal.NewOutputState(nil, reflect.TypeOf("")),
+	}
+	internal.ResolveOutput(secretOut, "y", true, true, nil)
+
+	o := pulumix.AllMap(map[string]pulumix.Output[string]{
+		"a": pulumix.Val("x"),
+		"b": secretOut,
+	})
+	v, known, secret, _, err := internal.AwaitOutput(context.Background(), o)
+	require.NoError(t, err)
+	assert.True(t, known)
+	assert.True(t, secret)
+	assert.Equal(t, map[string]string{"a": "x", "b": "y"}, v)
+}
+
+func TestAllMap_unknown(t *testing.T) {
+	t.Parallel()
+
+	unknown := pulumix.Output[string]{
+		OutputState: internal.NewOutputState(nil, reflect.TypeOf("")),
+	}
+	internal.ResolveOutput(unknown, "", false, false, nil)
+
+	o := pulumix.AllMap(map[string]pulumix.Output[string]{
+		"a": pulumix.Val("x"),
+		"b": unknown,
+	})
+	_, known, _, _, err := internal.AwaitOutput(context.Background(), o)
+	require.NoError(t, err)
+	assert.False(t, known)
+}
+
+func TestToMapOutput(t *testing.T) {
+	t.Parallel()
+
+	o := pulumix.ToMapOutput(map[string]pulumix.Output[int]{
+		"a": pulumix.Val(1),
+		"b": pulumix.Val(2),
+	})
+	v, _, _, _, err := internal.AwaitOutput(context.Background(), o.MapIndex(pulumix.Val("b")))
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+// unknownOutput returns an output that resolves to an unknown value.
+func unknownOutput[T any]() pulumix.Output[T] {
+	var zero T
+	o := pulumix.Output[T]{
+		OutputState: internal.NewOutputState(nil, reflect.TypeOf(&zero).Elem()),
+	}
+	internal.ResolveOutput(o, zero, false, false, nil)
+	return o
+}
+
+func TestIfThenElse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		cond pulumix.Input[bool]
+		then pulumix.Input[string]
+		els  pulumix.Input[string]
+
+		want      string
+		wantKnown bool
+	}{
+		{
+			desc:      "true",
+			cond:      pulumi.Bool(true),
+			then:      pulumix.Val("a"),
+			els:       pulumix.Val("b"),
+			want:      "a",
+			wantKnown: true,
+		},
+		{
+			desc:      "false",
+			cond:      pulumi.Bool(false),
+			then:      pulumix.Val("a"),
+			els:       pulumix.Val("b"),
+			want:      "b",
+			wantKnown: true,
+		},
+		{
+			desc:      "unknown condition",
+			cond:      unknownOutput[bool](),
+			then:      pulumix.Val("a"),
+			els:       pulumix.Val("b"),
+			wantKnown: false,
+		},
+		{
+			desc:      "unknown unselected branch",
+			cond:      pulumi.Bool(true),
+			then:      pulumix.Val("a"),
+			els:       unknownOutput[string](),
+			want:      "a",
+			wantKnown: true,
+		},
+		{
+			desc:      "unknown selected branch",
+			cond:      pulumi.Bool(false),
+			then:      pulumix.Val("a"),
+			els:       unknownOutput[string](),
+			wantKnown: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+
+			o := pulumix.IfThenElse(tt.cond, tt.then, tt.els)
+			v, known, _, _, err := internal.AwaitOutput(context.Background(), o)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKnown, known)
+			if tt.wantKnown {
+				assert.Equal(t, tt.want, v)
+			}
+		})
+	}
+}
+
+func TestIfThenElse_secretCondition(t *testing.T) {
+	t.Parallel()
+
+	cond := pulumi.ToSecret(pulumi.Bool(true)).(pulumi.BoolOutput)
+	o := pulumix.IfThenElse[string](cond, pulumix.Val("a"), pulumix.Val("b"))
+	v, known, secret, _, err := internal.AwaitOutput(context.Background(), o)
+	require.NoError(t, err)
+	assert.True(t, known)
+	assert.True(t, secret)
+	assert.Equal(t, "a", v)
+}
+
+func TestCoalesce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		inputs []pulumix.Input[string]
+
+		want      string
+		wantKnown bool
+	}{
+		{
+			desc:      "empty",
+			wantKnown: true,
+		},
+		{
+			desc:      "all zero",
+			inputs:    []pulumix.Input[string]{pulumix.Val(""), pulumi.String("")},
+			wantKnown: true,
+		},
+		{
+			desc:      "first non-zero",
+			inputs:    []pulumix.Input[string]{pulumix.Val(""), pulumix.Val("a"), pulumix.Val("b")},
+			want:      "a",
+			wantKnown: true,
+		},
+		{
+			desc:      "unknown after value",
+			inputs:    []pulumix.Input[string]{pulumix.Val("a"), unknownOutput[string]()},
+			want:      "a",
+			wantKnown: true,
+		},
+		{
+			desc:      "unknown before value",
+			inputs:    []pulumix.Input[string]{unknownOutput[string](), pulumix.Val("a")},
+			wantKnown: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+
+			o := pulumix.Coalesce(tt.inputs...)
+			v, known, _, _, err := internal.AwaitOutput(context.Background(), o)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKnown, known)
+			if tt.wantKnown {
+				assert.Equal(t, tt.want, v)
+			}
+		})
+	}
+}
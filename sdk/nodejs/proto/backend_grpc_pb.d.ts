@@ -0,0 +1,144 @@
+// package: pulumirpc
+// file: pulumi/backend.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "@grpc/grpc-js";
+import * as pulumi_backend_pb from "./backend_pb";
+import * as google_protobuf_empty_pb from "google-protobuf/google/protobuf/empty_pb";
+
+interface IBackendService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    configure: IBackendService_IConfigure;
+    attributes: IBackendService_IAttributes;
+    list: IBackendService_IList;
+    read: IBackendService_IRead;
+    write: IBackendService_IWrite;
+    copy: IBackendService_ICopy;
+    delete: IBackendService_IDelete;
+}
+
+interface IBackendService_IConfigure extends grpc.MethodDefinition<pulumi_backend_pb.BackendConfigureRequest, google_protobuf_empty_pb.Empty> {
+    path: "/pulumirpc.Backend/Configure";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendConfigureRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendConfigureRequest>;
+    responseSerialize: grpc.serialize<google_protobuf_empty_pb.Empty>;
+    responseDeserialize: grpc.deserialize<google_protobuf_empty_pb.Empty>;
+}
+interface IBackendService_IAttributes extends grpc.MethodDefinition<pulumi_backend_pb.BackendAttributesRequest, pulumi_backend_pb.BackendObject> {
+    path: "/pulumirpc.Backend/Attributes";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendAttributesRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendAttributesRequest>;
+    responseSerialize: grpc.serialize<pulumi_backend_pb.BackendObject>;
+    responseDeserialize: grpc.deserialize<pulumi_backend_pb.BackendObject>;
+}
+interface IBackendService_IList extends grpc.MethodDefinition<pulumi_backend_pb.BackendListRequest, pulumi_backend_pb.BackendListResponse> {
+    path: "/pulumirpc.Backend/List";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendListRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendListRequest>;
+    responseSerialize: grpc.serialize<pulumi_backend_pb.BackendListResponse>;
+    responseDeserialize: grpc.deserialize<pulumi_backend_pb.BackendListResponse>;
+}
+interface IBackendService_IRead extends grpc.MethodDefinition<pulumi_backend_pb.BackendReadRequest, pulumi_backend_pb.BackendReadResponse> {
+    path: "/pulumirpc.Backend/Read";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendReadRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendReadRequest>;
+    responseSerialize: grpc.serialize<pulumi_backend_pb.BackendReadResponse>;
+    responseDeserialize: grpc.deserialize<pulumi_backend_pb.BackendReadResponse>;
+}
+interface IBackendService_IWrite extends grpc.MethodDefinition<pulumi_backend_pb.BackendWriteRequest, google_protobuf_empty_pb.Empty> {
+    path: "/pulumirpc.Backend/Write";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendWriteRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendWriteRequest>;
+    responseSerialize: grpc.serialize<google_protobuf_empty_pb.Empty>;
+    responseDeserialize: grpc.deserialize<google_protobuf_empty_pb.Empty>;
+}
+interface IBackendService_ICopy extends grpc.MethodDefinition<pulumi_backend_pb.BackendCopyRequest, google_protobuf_empty_pb.Empty> {
+    path: "/pulumirpc.Backend/Copy";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendCopyRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendCopyRequest>;
+    responseSerialize: grpc.serialize<google_protobuf_empty_pb.Empty>;
+    responseDeserialize: grpc.deserialize<google_protobuf_empty_pb.Empty>;
+}
+interface IBackendService_IDelete extends grpc.MethodDefinition<pulumi_backend_pb.BackendDeleteRequest, google_protobuf_empty_pb.Empty> {
+    path: "/pulumirpc.Backend/Delete";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_backend_pb.BackendDeleteRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_backend_pb.BackendDeleteRequest>;
+    responseSerialize: grpc.serialize<google_protobuf_empty_pb.Empty>;
+    responseDeserialize: grpc.deserialize<google_protobuf_empty_pb.Empty>;
+}
+
+export const BackendService: IBackendService;
+
+export interface IBackendServer extends grpc.UntypedServiceImplementation {
+    configure: grpc.handleUnaryCall<pulumi_backend_pb.BackendConfigureRequest, google_protobuf_empty_pb.Empty>;
+    attributes: grpc.handleUnaryCall<pulumi_backend_pb.BackendAttributesRequest, pulumi_backend_pb.BackendObject>;
+    list: grpc.handleUnaryCall<pulumi_backend_pb.BackendListRequest, pulumi_backend_pb.BackendListResponse>;
+    read: grpc.handleUnaryCall<pulumi_backend_pb.BackendReadRequest, pulumi_backend_pb.BackendReadResponse>;
+    write: grpc.handleUnaryCall<pulumi_backend_pb.BackendWriteRequest, google_protobuf_empty_pb.Empty>;
+    copy: grpc.handleUnaryCall<pulumi_backend_pb.BackendCopyRequest, google_protobuf_empty_pb.Empty>;
+    delete: grpc.handleUnaryCall<pulumi_backend_pb.BackendDeleteRequest, google_protobuf_empty_pb.Empty>;
+}
+
+export interface IBackendClient {
+    configure(request: pulumi_backend_pb.BackendConfigureRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    configure(request: pulumi_backend_pb.BackendConfigureRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    configure(request: pulumi_backend_pb.BackendConfigureRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    attributes(request: pulumi_backend_pb.BackendAttributesRequest, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendObject) => void): grpc.ClientUnaryCall;
+    attributes(request: pulumi_backend_pb.BackendAttributesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendObject) => void): grpc.ClientUnaryCall;
+    attributes(request: pulumi_backend_pb.BackendAttributesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendObject) => void): grpc.ClientUnaryCall;
+    list(request: pulumi_backend_pb.BackendListRequest, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendListResponse) => void): grpc.ClientUnaryCall;
+    list(request: pulumi_backend_pb.BackendListRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendListResponse) => void): grpc.ClientUnaryCall;
+    list(request: pulumi_backend_pb.BackendListRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendListResponse) => void): grpc.ClientUnaryCall;
+    read(request: pulumi_backend_pb.BackendReadRequest, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendReadResponse) => void): grpc.ClientUnaryCall;
+    read(request: pulumi_backend_pb.BackendReadRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendReadResponse) => void): grpc.ClientUnaryCall;
+    read(request: pulumi_backend_pb.BackendReadRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendReadResponse) => void): grpc.ClientUnaryCall;
+    write(request: pulumi_backend_pb.BackendWriteRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    write(request: pulumi_backend_pb.BackendWriteRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    write(request: pulumi_backend_pb.BackendWriteRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    copy(request: pulumi_backend_pb.BackendCopyRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    copy(request: pulumi_backend_pb.BackendCopyRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    copy(request: pulumi_backend_pb.BackendCopyRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    delete(request: pulumi_backend_pb.BackendDeleteRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    delete(request: pulumi_backend_pb.BackendDeleteRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    delete(request: pulumi_backend_pb.BackendDeleteRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+}
+
+export class BackendClient extends grpc.Client implements IBackendClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: Partial<grpc.ClientOptions>);
+    public configure(request: pulumi_backend_pb.BackendConfigureRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public configure(request: pulumi_backend_pb.BackendConfigureRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public configure(request: pulumi_backend_pb.BackendConfigureRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public attributes(request: pulumi_backend_pb.BackendAttributesRequest, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendObject) => void): grpc.ClientUnaryCall;
+    public attributes(request: pulumi_backend_pb.BackendAttributesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendObject) => void): grpc.ClientUnaryCall;
+    public attributes(request: pulumi_backend_pb.BackendAttributesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendObject) => void): grpc.ClientUnaryCall;
+    public list(request: pulumi_backend_pb.BackendListRequest, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendListResponse) => void): grpc.ClientUnaryCall;
+    public list(request: pulumi_backend_pb.BackendListRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendListResponse) => void): grpc.ClientUnaryCall;
+    public list(request: pulumi_backend_pb.BackendListRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendListResponse) => void): grpc.ClientUnaryCall;
+    public read(request: pulumi_backend_pb.BackendReadRequest, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendReadResponse) => void): grpc.ClientUnaryCall;
+    public read(request: pulumi_backend_pb.BackendReadRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendReadResponse) => void): grpc.ClientUnaryCall;
+    public read(request: pulumi_backend_pb.BackendReadRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_backend_pb.BackendReadResponse) => void): grpc.ClientUnaryCall;
+    public write(request: pulumi_backend_pb.BackendWriteRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public write(request: pulumi_backend_pb.BackendWriteRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public write(request: pulumi_backend_pb.BackendWriteRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public copy(request: pulumi_backend_pb.BackendCopyRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public copy(request: pulumi_backend_pb.BackendCopyRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public copy(request: pulumi_backend_pb.BackendCopyRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public delete(request: pulumi_backend_pb.BackendDeleteRequest, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public delete(request: pulumi_backend_pb.BackendDeleteRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+    public delete(request: pulumi_backend_pb.BackendDeleteRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: google_protobuf_empty_pb.Empty) => void): grpc.ClientUnaryCall;
+}
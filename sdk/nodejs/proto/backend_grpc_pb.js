@@ -0,0 +1,239 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+// Original file comments:
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var pulumi_backend_pb = require('./backend_pb.js');
+var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
+
+function serialize_google_protobuf_Empty(arg) {
+  if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
+    throw new Error('Expected argument of type google.protobuf.Empty');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_google_protobuf_Empty(buffer_arg) {
+  return google_protobuf_empty_pb.Empty.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendAttributesRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendAttributesRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendAttributesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendAttributesRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendAttributesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendConfigureRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendConfigureRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendConfigureRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendConfigureRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendConfigureRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendCopyRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendCopyRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendCopyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendCopyRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendCopyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendDeleteRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendDeleteRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendDeleteRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendDeleteRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendDeleteRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendListRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendListRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendListRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendListRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendListRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendListResponse(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendListResponse)) {
+    throw new Error('Expected argument of type pulumirpc.BackendListResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendListResponse(buffer_arg) {
+  return pulumi_backend_pb.BackendListResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendObject(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendObject)) {
+    throw new Error('Expected argument of type pulumirpc.BackendObject');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendObject(buffer_arg) {
+  return pulumi_backend_pb.BackendObject.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendReadRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendReadRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendReadRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendReadRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendReadRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendReadResponse(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendReadResponse)) {
+    throw new Error('Expected argument of type pulumirpc.BackendReadResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendReadResponse(buffer_arg) {
+  return pulumi_backend_pb.BackendReadResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_BackendWriteRequest(arg) {
+  if (!(arg instanceof pulumi_backend_pb.BackendWriteRequest)) {
+    throw new Error('Expected argument of type pulumirpc.BackendWriteRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_BackendWriteRequest(buffer_arg) {
+  return pulumi_backend_pb.BackendWriteRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// Backend is a service for storing the state of self-managed stacks somewhere other than the object stores that
+// Pulumi supports natively. A backend plugin is discovered by the scheme of the URL passed to `pulumi login`: logging
+// into `<scheme>://...` loads the `pulumi-backend-<scheme>` plugin.
+//
+// The plugin stores the files that make up a self-managed backend (stack checkpoints, history, locks and so on) as
+// opaque objects, identified by slash-separated keys. Missing objects must be reported with a NOT_FOUND status.
+// This is currently unstable and experimental.
+var BackendService = exports.BackendService = {
+  // Configure passes the URL that was logged into to the plugin. It is called once, before any other method.
+configure: {
+    path: '/pulumirpc.Backend/Configure',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendConfigureRequest,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_pulumirpc_BackendConfigureRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendConfigureRequest,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Attributes returns the attributes of a single object.
+attributes: {
+    path: '/pulumirpc.Backend/Attributes',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendAttributesRequest,
+    responseType: pulumi_backend_pb.BackendObject,
+    requestSerialize: serialize_pulumirpc_BackendAttributesRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendAttributesRequest,
+    responseSerialize: serialize_pulumirpc_BackendObject,
+    responseDeserialize: deserialize_pulumirpc_BackendObject,
+  },
+  // List returns a page of the objects whose keys start with a prefix, ordered by key.
+list: {
+    path: '/pulumirpc.Backend/List',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendListRequest,
+    responseType: pulumi_backend_pb.BackendListResponse,
+    requestSerialize: serialize_pulumirpc_BackendListRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendListRequest,
+    responseSerialize: serialize_pulumirpc_BackendListResponse,
+    responseDeserialize: deserialize_pulumirpc_BackendListResponse,
+  },
+  // Read returns the content of an object.
+read: {
+    path: '/pulumirpc.Backend/Read',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendReadRequest,
+    responseType: pulumi_backend_pb.BackendReadResponse,
+    requestSerialize: serialize_pulumirpc_BackendReadRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendReadRequest,
+    responseSerialize: serialize_pulumirpc_BackendReadResponse,
+    responseDeserialize: deserialize_pulumirpc_BackendReadResponse,
+  },
+  // Write creates an object, or replaces an existing one.
+write: {
+    path: '/pulumirpc.Backend/Write',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendWriteRequest,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_pulumirpc_BackendWriteRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendWriteRequest,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Copy copies an object to another key, replacing any object already stored there.
+copy: {
+    path: '/pulumirpc.Backend/Copy',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendCopyRequest,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_pulumirpc_BackendCopyRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendCopyRequest,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Delete deletes an object.
+delete: {
+    path: '/pulumirpc.Backend/Delete',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_backend_pb.BackendDeleteRequest,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_pulumirpc_BackendDeleteRequest,
+    requestDeserialize: deserialize_pulumirpc_BackendDeleteRequest,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+};
+
+exports.BackendClient = grpc.makeGenericClientConstructor(BackendService);
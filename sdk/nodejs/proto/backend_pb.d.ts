@@ -0,0 +1,250 @@
+// package: pulumirpc
+// file: pulumi/backend.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as google_protobuf_empty_pb from "google-protobuf/google/protobuf/empty_pb";
+
+export class BackendConfigureRequest extends jspb.Message { 
+    getUrl(): string;
+    setUrl(value: string): BackendConfigureRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendConfigureRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendConfigureRequest): BackendConfigureRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendConfigureRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendConfigureRequest;
+    static deserializeBinaryFromReader(message: BackendConfigureRequest, reader: jspb.BinaryReader): BackendConfigureRequest;
+}
+
+export namespace BackendConfigureRequest {
+    export type AsObject = {
+        url: string,
+    }
+}
+
+export class BackendObject extends jspb.Message { 
+    getKey(): string;
+    setKey(value: string): BackendObject;
+    getSize(): number;
+    setSize(value: number): BackendObject;
+    getModTime(): number;
+    setModTime(value: number): BackendObject;
+    getContentType(): string;
+    setContentType(value: string): BackendObject;
+    getIsDir(): boolean;
+    setIsDir(value: boolean): BackendObject;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendObject.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendObject): BackendObject.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendObject, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendObject;
+    static deserializeBinaryFromReader(message: BackendObject, reader: jspb.BinaryReader): BackendObject;
+}
+
+export namespace BackendObject {
+    export type AsObject = {
+        key: string,
+        size: number,
+        modTime: number,
+        contentType: string,
+        isDir: boolean,
+    }
+}
+
+export class BackendAttributesRequest extends jspb.Message { 
+    getKey(): string;
+    setKey(value: string): BackendAttributesRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendAttributesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendAttributesRequest): BackendAttributesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendAttributesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendAttributesRequest;
+    static deserializeBinaryFromReader(message: BackendAttributesRequest, reader: jspb.BinaryReader): BackendAttributesRequest;
+}
+
+export namespace BackendAttributesRequest {
+    export type AsObject = {
+        key: string,
+    }
+}
+
+export class BackendListRequest extends jspb.Message { 
+    getPrefix(): string;
+    setPrefix(value: string): BackendListRequest;
+    getDelimiter(): string;
+    setDelimiter(value: string): BackendListRequest;
+    getPageSize(): number;
+    setPageSize(value: number): BackendListRequest;
+    getPageToken(): string;
+    setPageToken(value: string): BackendListRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendListRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendListRequest): BackendListRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendListRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendListRequest;
+    static deserializeBinaryFromReader(message: BackendListRequest, reader: jspb.BinaryReader): BackendListRequest;
+}
+
+export namespace BackendListRequest {
+    export type AsObject = {
+        prefix: string,
+        delimiter: string,
+        pageSize: number,
+        pageToken: string,
+    }
+}
+
+export class BackendListResponse extends jspb.Message { 
+    clearObjectsList(): void;
+    getObjectsList(): Array<BackendObject>;
+    setObjectsList(value: Array<BackendObject>): BackendListResponse;
+    addObjects(value?: BackendObject, index?: number): BackendObject;
+    getNextPageToken(): string;
+    setNextPageToken(value: string): BackendListResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendListResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendListResponse): BackendListResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendListResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendListResponse;
+    static deserializeBinaryFromReader(message: BackendListResponse, reader: jspb.BinaryReader): BackendListResponse;
+}
+
+export namespace BackendListResponse {
+    export type AsObject = {
+        objectsList: Array<BackendObject.AsObject>,
+        nextPageToken: string,
+    }
+}
+
+export class BackendReadRequest extends jspb.Message { 
+    getKey(): string;
+    setKey(value: string): BackendReadRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendReadRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendReadRequest): BackendReadRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendReadRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendReadRequest;
+    static deserializeBinaryFromReader(message: BackendReadRequest, reader: jspb.BinaryReader): BackendReadRequest;
+}
+
+export namespace BackendReadRequest {
+    export type AsObject = {
+        key: string,
+    }
+}
+
+export class BackendReadResponse extends jspb.Message { 
+    getContent(): Uint8Array | string;
+    getContent_asU8(): Uint8Array;
+    getContent_asB64(): string;
+    setContent(value: Uint8Array | string): BackendReadResponse;
+    getContentType(): string;
+    setContentType(value: string): BackendReadResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendReadResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendReadResponse): BackendReadResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendReadResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendReadResponse;
+    static deserializeBinaryFromReader(message: BackendReadResponse, reader: jspb.BinaryReader): BackendReadResponse;
+}
+
+export namespace BackendReadResponse {
+    export type AsObject = {
+        content: Uint8Array | string,
+        contentType: string,
+    }
+}
+
+export class BackendWriteRequest extends jspb.Message { 
+    getKey(): string;
+    setKey(value: string): BackendWriteRequest;
+    getContent(): Uint8Array | string;
+    getContent_asU8(): Uint8Array;
+    getContent_asB64(): string;
+    setContent(value: Uint8Array | string): BackendWriteRequest;
+    getContentType(): string;
+    setContentType(value: string): BackendWriteRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendWriteRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendWriteRequest): BackendWriteRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendWriteRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendWriteRequest;
+    static deserializeBinaryFromReader(message: BackendWriteRequest, reader: jspb.BinaryReader): BackendWriteRequest;
+}
+
+export namespace BackendWriteRequest {
+    export type AsObject = {
+        key: string,
+        content: Uint8Array | string,
+        contentType: string,
+    }
+}
+
+export class BackendCopyRequest extends jspb.Message { 
+    getDstKey(): string;
+    setDstKey(value: string): BackendCopyRequest;
+    getSrcKey(): string;
+    setSrcKey(value: string): BackendCopyRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendCopyRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendCopyRequest): BackendCopyRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendCopyRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendCopyRequest;
+    static deserializeBinaryFromReader(message: BackendCopyRequest, reader: jspb.BinaryReader): BackendCopyRequest;
+}
+
+export namespace BackendCopyRequest {
+    export type AsObject = {
+        dstKey: string,
+        srcKey: string,
+    }
+}
+
+export class BackendDeleteRequest extends jspb.Message { 
+    getKey(): string;
+    setKey(value: string): BackendDeleteRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BackendDeleteRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: BackendDeleteRequest): BackendDeleteRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BackendDeleteRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BackendDeleteRequest;
+    static deserializeBinaryFromReader(message: BackendDeleteRequest, reader: jspb.BinaryReader): BackendDeleteRequest;
+}
+
+export namespace BackendDeleteRequest {
+    export type AsObject = {
+        key: string,
+    }
+}
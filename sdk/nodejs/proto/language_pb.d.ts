@@ -245,6 +245,9 @@ export class RunRequest extends jspb.Message {
     getInfo(): ProgramInfo | undefined;
     setInfo(value?: ProgramInfo): RunRequest;
 
+    getStacktagsMap(): jspb.Map<string, string>;
+    clearStacktagsMap(): void;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): RunRequest.AsObject;
     static toObject(includeInstance: boolean, msg: RunRequest): RunRequest.AsObject;
@@ -272,6 +275,8 @@ export namespace RunRequest {
         organization: string,
         configpropertymap?: google_protobuf_struct_pb.Struct.AsObject,
         info?: ProgramInfo.AsObject,
+
+        stacktagsMap: Array<[string, string]>,
     }
 }
 
@@ -1964,7 +1964,8 @@ proto.pulumirpc.RunRequest.toObject = function(includeInstance, msg) {
     configsecretkeysList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
     organization: jspb.Message.getFieldWithDefault(msg, 12, ""),
     configpropertymap: (f = msg.getConfigpropertymap()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    info: (f = msg.getInfo()) && proto.pulumirpc.ProgramInfo.toObject(includeInstance, f)
+    info: (f = msg.getInfo()) && proto.pulumirpc.ProgramInfo.toObject(includeInstance, f),
+    stacktagsMap: (f = msg.getStacktagsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2061,6 +2062,12 @@ proto.pulumirpc.RunRequest.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.pulumirpc.ProgramInfo.deserializeBinaryFromReader);
       msg.setInfo(value);
       break;
+    case 15:
+      var value = msg.getStacktagsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
     default:
       reader.skipField();
       break;
@@ -2187,6 +2194,10 @@ proto.pulumirpc.RunRequest.serializeBinaryToWriter = function(message, writer) {
       proto.pulumirpc.ProgramInfo.serializeBinaryToWriter
     );
   }
+  f = message.getStacktagsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(15, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
@@ -2522,6 +2533,28 @@ proto.pulumirpc.RunRequest.prototype.hasInfo = function() {
 };
 
 
+/**
+ * map<string, string> stackTags = 15;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.RunRequest.prototype.getStacktagsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 15, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.RunRequest} returns this
+ */
+proto.pulumirpc.RunRequest.prototype.clearStacktagsMap = function() {
+  this.getStacktagsMap().clear();
+  return this;};
+
+
 
 
 
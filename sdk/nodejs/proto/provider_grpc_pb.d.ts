@@ -31,6 +31,7 @@ interface IResourceProviderService extends grpc.ServiceDefinition<grpc.UntypedSe
     attach: IResourceProviderService_IAttach;
     getMapping: IResourceProviderService_IGetMapping;
     getMappings: IResourceProviderService_IGetMappings;
+    discover: IResourceProviderService_IDiscover;
 }
 
 interface IResourceProviderService_IGetSchema extends grpc.MethodDefinition<pulumi_provider_pb.GetSchemaRequest, pulumi_provider_pb.GetSchemaResponse> {
@@ -204,6 +205,15 @@ interface IResourceProviderService_IGetMappings extends grpc.MethodDefinition<pu
     responseSerialize: grpc.serialize<pulumi_provider_pb.GetMappingsResponse>;
     responseDeserialize: grpc.deserialize<pulumi_provider_pb.GetMappingsResponse>;
 }
+interface IResourceProviderService_IDiscover extends grpc.MethodDefinition<pulumi_provider_pb.DiscoverRequest, pulumi_provider_pb.DiscoverResponse> {
+    path: "/pulumirpc.ResourceProvider/Discover";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<pulumi_provider_pb.DiscoverRequest>;
+    requestDeserialize: grpc.deserialize<pulumi_provider_pb.DiscoverRequest>;
+    responseSerialize: grpc.serialize<pulumi_provider_pb.DiscoverResponse>;
+    responseDeserialize: grpc.deserialize<pulumi_provider_pb.DiscoverResponse>;
+}
 
 export const ResourceProviderService: IResourceProviderService;
 
@@ -227,6 +237,7 @@ export interface IResourceProviderServer extends grpc.UntypedServiceImplementati
     attach: grpc.handleUnaryCall<pulumi_plugin_pb.PluginAttach, google_protobuf_empty_pb.Empty>;
     getMapping: grpc.handleUnaryCall<pulumi_provider_pb.GetMappingRequest, pulumi_provider_pb.GetMappingResponse>;
     getMappings: grpc.handleUnaryCall<pulumi_provider_pb.GetMappingsRequest, pulumi_provider_pb.GetMappingsResponse>;
+    discover: grpc.handleUnaryCall<pulumi_provider_pb.DiscoverRequest, pulumi_provider_pb.DiscoverResponse>;
 }
 
 export interface IResourceProviderClient {
@@ -286,6 +297,9 @@ export interface IResourceProviderClient {
     getMappings(request: pulumi_provider_pb.GetMappingsRequest, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.GetMappingsResponse) => void): grpc.ClientUnaryCall;
     getMappings(request: pulumi_provider_pb.GetMappingsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.GetMappingsResponse) => void): grpc.ClientUnaryCall;
     getMappings(request: pulumi_provider_pb.GetMappingsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.GetMappingsResponse) => void): grpc.ClientUnaryCall;
+    discover(request: pulumi_provider_pb.DiscoverRequest, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.DiscoverResponse) => void): grpc.ClientUnaryCall;
+    discover(request: pulumi_provider_pb.DiscoverRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.DiscoverResponse) => void): grpc.ClientUnaryCall;
+    discover(request: pulumi_provider_pb.DiscoverRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.DiscoverResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class ResourceProviderClient extends grpc.Client implements IResourceProviderClient {
@@ -346,4 +360,7 @@ export class ResourceProviderClient extends grpc.Client implements IResourceProv
     public getMappings(request: pulumi_provider_pb.GetMappingsRequest, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.GetMappingsResponse) => void): grpc.ClientUnaryCall;
     public getMappings(request: pulumi_provider_pb.GetMappingsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.GetMappingsResponse) => void): grpc.ClientUnaryCall;
     public getMappings(request: pulumi_provider_pb.GetMappingsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.GetMappingsResponse) => void): grpc.ClientUnaryCall;
+    public discover(request: pulumi_provider_pb.DiscoverRequest, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.DiscoverResponse) => void): grpc.ClientUnaryCall;
+    public discover(request: pulumi_provider_pb.DiscoverRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.DiscoverResponse) => void): grpc.ClientUnaryCall;
+    public discover(request: pulumi_provider_pb.DiscoverRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: pulumi_provider_pb.DiscoverResponse) => void): grpc.ClientUnaryCall;
 }
@@ -177,6 +177,28 @@ function deserialize_pulumirpc_DiffResponse(buffer_arg) {
   return pulumi_provider_pb.DiffResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_DiscoverRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.DiscoverRequest)) {
+    throw new Error('Expected argument of type pulumirpc.DiscoverRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_DiscoverRequest(buffer_arg) {
+  return pulumi_provider_pb.DiscoverRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_DiscoverResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.DiscoverResponse)) {
+    throw new Error('Expected argument of type pulumirpc.DiscoverResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_DiscoverResponse(buffer_arg) {
+  return pulumi_provider_pb.DiscoverResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_GetMappingRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.GetMappingRequest)) {
     throw new Error('Expected argument of type pulumirpc.GetMappingRequest');
@@ -577,6 +599,19 @@ getMappings: {
     responseSerialize: serialize_pulumirpc_GetMappingsResponse,
     responseDeserialize: deserialize_pulumirpc_GetMappingsResponse,
   },
+  // Discover is an optional method that enumerates the resources a provider is able to import, such as all the
+// resources in an account with a given tag. A provider that does not support discovery should return UNIMPLEMENTED.
+discover: {
+    path: '/pulumirpc.ResourceProvider/Discover',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.DiscoverRequest,
+    responseType: pulumi_provider_pb.DiscoverResponse,
+    requestSerialize: serialize_pulumirpc_DiscoverRequest,
+    requestDeserialize: deserialize_pulumirpc_DiscoverRequest,
+    responseSerialize: serialize_pulumirpc_DiscoverResponse,
+    responseDeserialize: deserialize_pulumirpc_DiscoverResponse,
+  },
 };
 
 exports.ResourceProviderClient = grpc.makeGenericClientConstructor(ResourceProviderService);
@@ -1182,3 +1182,83 @@ export namespace GetMappingsResponse {
         providersList: Array<string>,
     }
 }
+
+export class DiscoverRequest extends jspb.Message { 
+    clearTypesList(): void;
+    getTypesList(): Array<string>;
+    setTypesList(value: Array<string>): DiscoverRequest;
+    addTypes(value: string, index?: number): string;
+
+    getFiltersMap(): jspb.Map<string, string>;
+    clearFiltersMap(): void;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DiscoverRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: DiscoverRequest): DiscoverRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DiscoverRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DiscoverRequest;
+    static deserializeBinaryFromReader(message: DiscoverRequest, reader: jspb.BinaryReader): DiscoverRequest;
+}
+
+export namespace DiscoverRequest {
+    export type AsObject = {
+        typesList: Array<string>,
+
+        filtersMap: Array<[string, string]>,
+    }
+}
+
+export class DiscoveredResource extends jspb.Message { 
+    getType(): string;
+    setType(value: string): DiscoveredResource;
+    getName(): string;
+    setName(value: string): DiscoveredResource;
+    getId(): string;
+    setId(value: string): DiscoveredResource;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DiscoveredResource.AsObject;
+    static toObject(includeInstance: boolean, msg: DiscoveredResource): DiscoveredResource.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DiscoveredResource, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DiscoveredResource;
+    static deserializeBinaryFromReader(message: DiscoveredResource, reader: jspb.BinaryReader): DiscoveredResource;
+}
+
+export namespace DiscoveredResource {
+    export type AsObject = {
+        type: string,
+        name: string,
+        id: string,
+    }
+}
+
+export class DiscoverResponse extends jspb.Message { 
+    clearResourcesList(): void;
+    getResourcesList(): Array<DiscoveredResource>;
+    setResourcesList(value: Array<DiscoveredResource>): DiscoverResponse;
+    addResources(value?: DiscoveredResource, index?: number): DiscoveredResource;
+    clearTypesList(): void;
+    getTypesList(): Array<string>;
+    setTypesList(value: Array<string>): DiscoverResponse;
+    addTypes(value: string, index?: number): string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DiscoverResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: DiscoverResponse): DiscoverResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DiscoverResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DiscoverResponse;
+    static deserializeBinaryFromReader(message: DiscoverResponse, reader: jspb.BinaryReader): DiscoverResponse;
+}
+
+export namespace DiscoverResponse {
+    export type AsObject = {
+        resourcesList: Array<DiscoveredResource.AsObject>,
+        typesList: Array<string>,
+    }
+}
@@ -234,6 +234,13 @@ export class RegisterResourceRequest extends jspb.Message {
     getSourceposition(): pulumi_source_pb.SourcePosition | undefined;
     setSourceposition(value?: pulumi_source_pb.SourcePosition): RegisterResourceRequest;
 
+    hasRetrypolicy(): boolean;
+    clearRetrypolicy(): void;
+    getRetrypolicy(): RegisterResourceRequest.RetryPolicy | undefined;
+    setRetrypolicy(value?: RegisterResourceRequest.RetryPolicy): RegisterResourceRequest;
+    getFrozen(): boolean;
+    setFrozen(value: boolean): RegisterResourceRequest;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): RegisterResourceRequest.AsObject;
     static toObject(includeInstance: boolean, msg: RegisterResourceRequest): RegisterResourceRequest.AsObject;
@@ -279,6 +286,8 @@ export namespace RegisterResourceRequest {
         deletedwith: string,
         aliasspecs: boolean,
         sourceposition?: pulumi_source_pb.SourcePosition.AsObject,
+        retrypolicy?: RegisterResourceRequest.RetryPolicy.AsObject,
+        frozen: boolean,
     }
 
 
@@ -330,6 +339,37 @@ export namespace RegisterResourceRequest {
         }
     }
 
+    export class RetryPolicy extends jspb.Message { 
+        getMaxretries(): number;
+        setMaxretries(value: number): RetryPolicy;
+        getInitialdelay(): string;
+        setInitialdelay(value: string): RetryPolicy;
+        getMaxdelay(): string;
+        setMaxdelay(value: string): RetryPolicy;
+        clearRetryonList(): void;
+        getRetryonList(): Array<string>;
+        setRetryonList(value: Array<string>): RetryPolicy;
+        addRetryon(value: string, index?: number): string;
+
+        serializeBinary(): Uint8Array;
+        toObject(includeInstance?: boolean): RetryPolicy.AsObject;
+        static toObject(includeInstance: boolean, msg: RetryPolicy): RetryPolicy.AsObject;
+        static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+        static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+        static serializeBinaryToWriter(message: RetryPolicy, writer: jspb.BinaryWriter): void;
+        static deserializeBinary(bytes: Uint8Array): RetryPolicy;
+        static deserializeBinaryFromReader(message: RetryPolicy, reader: jspb.BinaryReader): RetryPolicy;
+    }
+
+    export namespace RetryPolicy {
+        export type AsObject = {
+            maxretries: number,
+            initialdelay: string,
+            maxdelay: string,
+            retryonList: Array<string>,
+        }
+    }
+
 }
 
 export class RegisterResourceResponse extends jspb.Message { 
@@ -407,6 +447,9 @@ export class RegisterResourceOutputsRequest extends jspb.Message {
     getOutputs(): google_protobuf_struct_pb.Struct | undefined;
     setOutputs(value?: google_protobuf_struct_pb.Struct): RegisterResourceOutputsRequest;
 
+    getOutputmetadataMap(): jspb.Map<string, RegisterResourceOutputsRequest.OutputMetadata>;
+    clearOutputmetadataMap(): void;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): RegisterResourceOutputsRequest.AsObject;
     static toObject(includeInstance: boolean, msg: RegisterResourceOutputsRequest): RegisterResourceOutputsRequest.AsObject;
@@ -421,7 +464,34 @@ export namespace RegisterResourceOutputsRequest {
     export type AsObject = {
         urn: string,
         outputs?: google_protobuf_struct_pb.Struct.AsObject,
+
+        outputmetadataMap: Array<[string, RegisterResourceOutputsRequest.OutputMetadata.AsObject]>,
     }
+
+
+    export class OutputMetadata extends jspb.Message { 
+        getDescription(): string;
+        setDescription(value: string): OutputMetadata;
+        getSchema(): string;
+        setSchema(value: string): OutputMetadata;
+
+        serializeBinary(): Uint8Array;
+        toObject(includeInstance?: boolean): OutputMetadata.AsObject;
+        static toObject(includeInstance: boolean, msg: OutputMetadata): OutputMetadata.AsObject;
+        static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+        static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+        static serializeBinaryToWriter(message: OutputMetadata, writer: jspb.BinaryWriter): void;
+        static deserializeBinary(bytes: Uint8Array): OutputMetadata;
+        static deserializeBinaryFromReader(message: OutputMetadata, reader: jspb.BinaryReader): OutputMetadata;
+    }
+
+    export namespace OutputMetadata {
+        export type AsObject = {
+            description: string,
+            schema: string,
+        }
+    }
+
 }
 
 export class ResourceInvokeRequest extends jspb.Message { 
@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: pulumi/backend.proto
+
+package pulumirpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BackendClient is the client API for Backend service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BackendClient interface {
+	// Configure passes the URL that was logged into to the plugin. It is called once, before any other method.
+	Configure(ctx context.Context, in *BackendConfigureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Attributes returns the attributes of a single object.
+	Attributes(ctx context.Context, in *BackendAttributesRequest, opts ...grpc.CallOption) (*BackendObject, error)
+	// List returns a page of the objects whose keys start with a prefix, ordered by key.
+	List(ctx context.Context, in *BackendListRequest, opts ...grpc.CallOption) (*BackendListResponse, error)
+	// Read returns the content of an object.
+	Read(ctx context.Context, in *BackendReadRequest, opts ...grpc.CallOption) (*BackendReadResponse, error)
+	// Write creates an object, or replaces an existing one.
+	Write(ctx context.Context, in *BackendWriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Copy copies an object to another key, replacing any object already stored there.
+	Copy(ctx context.Context, in *BackendCopyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Delete deletes an object.
+	Delete(ctx context.Context, in *BackendDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type backendClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackendClient(cc grpc.ClientConnInterface) BackendClient {
+	return &backendClient{cc}
+}
+
+func (c *backendClient) Configure(ctx context.Context, in *BackendConfigureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/Configure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Attributes(ctx context.Context, in *BackendAttributesRequest, opts ...grpc.CallOption) (*BackendObject, error) {
+	out := new(BackendObject)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/Attributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) List(ctx context.Context, in *BackendListRequest, opts ...grpc.CallOption) (*BackendListResponse, error) {
+	out := new(BackendListResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Read(ctx context.Context, in *BackendReadRequest, opts ...grpc.CallOption) (*BackendReadResponse, error) {
+	out := new(BackendReadResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Write(ctx context.Context, in *BackendWriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/Write", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Copy(ctx context.Context, in *BackendCopyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Delete(ctx context.Context, in *BackendDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Backend/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackendServer is the server API for Backend service.
+// All implementations must embed UnimplementedBackendServer
+// for forward compatibility
+type BackendServer interface {
+	// Configure passes the URL that was logged into to the plugin. It is called once, before any other method.
+	Configure(context.Context, *BackendConfigureRequest) (*emptypb.Empty, error)
+	// Attributes returns the attributes of a single object.
+	Attributes(context.Context, *BackendAttributesRequest) (*BackendObject, error)
+	// List returns a page of the objects whose keys start with a prefix, ordered by key.
+	List(context.Context, *BackendListRequest) (*BackendListResponse, error)
+	// Read returns the content of an object.
+	Read(context.Context, *BackendReadRequest) (*BackendReadResponse, error)
+	// Write creates an object, or replaces an existing one.
+	Write(context.Context, *BackendWriteRequest) (*emptypb.Empty, error)
+	// Copy copies an object to another key, replacing any object already stored there.
+	Copy(context.Context, *BackendCopyRequest) (*emptypb.Empty, error)
+	// Delete deletes an object.
+	Delete(context.Context, *BackendDeleteRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBackendServer()
+}
+
+// UnimplementedBackendServer must be embedded to have forward compatible implementations.
+type UnimplementedBackendServer struct {
+}
+
+func (UnimplementedBackendServer) Configure(context.Context, *BackendConfigureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (UnimplementedBackendServer) Attributes(context.Context, *BackendAttributesRequest) (*BackendObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attributes not implemented")
+}
+func (UnimplementedBackendServer) List(context.Context, *BackendListRequest) (*BackendListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedBackendServer) Read(context.Context, *BackendReadRequest) (*BackendReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedBackendServer) Write(context.Context, *BackendWriteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (UnimplementedBackendServer) Copy(context.Context, *BackendCopyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Copy not implemented")
+}
+func (UnimplementedBackendServer) Delete(context.Context, *BackendDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedBackendServer) mustEmbedUnimplementedBackendServer() {}
+
+// UnsafeBackendServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackendServer will
+// result in compilation errors.
+type UnsafeBackendServer interface {
+	mustEmbedUnimplementedBackendServer()
+}
+
+func RegisterBackendServer(s grpc.ServiceRegistrar, srv BackendServer) {
+	s.RegisterService(&Backend_ServiceDesc, srv)
+}
+
+func _Backend_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Configure(ctx, req.(*BackendConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Attributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Attributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/Attributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Attributes(ctx, req.(*BackendAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).List(ctx, req.(*BackendListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Read(ctx, req.(*BackendReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Write(ctx, req.(*BackendWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Copy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/Copy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Copy(ctx, req.(*BackendCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Backend/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Delete(ctx, req.(*BackendDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Backend_ServiceDesc is the grpc.ServiceDesc for Backend service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Backend_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pulumirpc.Backend",
+	HandlerType: (*BackendServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Configure",
+			Handler:    _Backend_Configure_Handler,
+		},
+		{
+			MethodName: "Attributes",
+			Handler:    _Backend_Attributes_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Backend_List_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Backend_Read_Handler,
+		},
+		{
+			MethodName: "Write",
+			Handler:    _Backend_Write_Handler,
+		},
+		{
+			MethodName: "Copy",
+			Handler:    _Backend_Copy_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Backend_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pulumi/backend.proto",
+}
@@ -75,6 +75,9 @@ type ResourceProviderClient interface {
 	// implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
 	// If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
 	GetMappings(ctx context.Context, in *GetMappingsRequest, opts ...grpc.CallOption) (*GetMappingsResponse, error)
+	// Discover is an optional method that enumerates the resources a provider is able to import, such as all the
+	// resources in an account with a given tag. A provider that does not support discovery should return UNIMPLEMENTED.
+	Discover(ctx context.Context, in *DiscoverRequest, opts ...grpc.CallOption) (*DiscoverResponse, error)
 }
 
 type resourceProviderClient struct {
@@ -279,6 +282,15 @@ func (c *resourceProviderClient) GetMappings(ctx context.Context, in *GetMapping
 	return out, nil
 }
 
+func (c *resourceProviderClient) Discover(ctx context.Context, in *DiscoverRequest, opts ...grpc.CallOption) (*DiscoverResponse, error) {
+	out := new(DiscoverResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Discover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceProviderServer is the server API for ResourceProvider service.
 // All implementations must embed UnimplementedResourceProviderServer
 // for forward compatibility
@@ -335,6 +347,9 @@ type ResourceProviderServer interface {
 	// implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
 	// If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
 	GetMappings(context.Context, *GetMappingsRequest) (*GetMappingsResponse, error)
+	// Discover is an optional method that enumerates the resources a provider is able to import, such as all the
+	// resources in an account with a given tag. A provider that does not support discovery should return UNIMPLEMENTED.
+	Discover(context.Context, *DiscoverRequest) (*DiscoverResponse, error)
 	mustEmbedUnimplementedResourceProviderServer()
 }
 
@@ -399,6 +414,9 @@ func (UnimplementedResourceProviderServer) GetMapping(context.Context, *GetMappi
 func (UnimplementedResourceProviderServer) GetMappings(context.Context, *GetMappingsRequest) (*GetMappingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMappings not implemented")
 }
+func (UnimplementedResourceProviderServer) Discover(context.Context, *DiscoverRequest) (*DiscoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Discover not implemented")
+}
 func (UnimplementedResourceProviderServer) mustEmbedUnimplementedResourceProviderServer() {}
 
 // UnsafeResourceProviderServer may be embedded to opt out of forward compatibility for this service.
@@ -757,6 +775,24 @@ func _ResourceProvider_GetMappings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceProvider_Discover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Discover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Discover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Discover(ctx, req.(*DiscoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceProvider_ServiceDesc is the grpc.ServiceDesc for ResourceProvider service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -836,6 +872,10 @@ var ResourceProvider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMappings",
 			Handler:    _ResourceProvider_GetMappings_Handler,
 		},
+		{
+			MethodName: "Discover",
+			Handler:    _ResourceProvider_Discover_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
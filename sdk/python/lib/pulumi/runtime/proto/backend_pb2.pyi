@@ -0,0 +1,243 @@
+"""
+@generated by mypy-protobuf.  Do not edit manually!
+isort:skip_file
+Copyright 2016-2024, Pulumi Corporation.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+"""
+import builtins
+import collections.abc
+import google.protobuf.descriptor
+import google.protobuf.internal.containers
+import google.protobuf.message
+import sys
+
+if sys.version_info >= (3, 8):
+    import typing as typing_extensions
+else:
+    import typing_extensions
+
+DESCRIPTOR: google.protobuf.descriptor.FileDescriptor
+
+@typing_extensions.final
+class BackendConfigureRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    URL_FIELD_NUMBER: builtins.int
+    url: builtins.str
+    """the backend URL, including the scheme."""
+    def __init__(
+        self,
+        *,
+        url: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["url", b"url"]) -> None: ...
+
+global___BackendConfigureRequest = BackendConfigureRequest
+
+@typing_extensions.final
+class BackendObject(google.protobuf.message.Message):
+    """BackendObject describes an object stored by a backend plugin."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    SIZE_FIELD_NUMBER: builtins.int
+    MOD_TIME_FIELD_NUMBER: builtins.int
+    CONTENT_TYPE_FIELD_NUMBER: builtins.int
+    IS_DIR_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """the key of the object."""
+    size: builtins.int
+    """the size of the object's content, in bytes."""
+    mod_time: builtins.int
+    """the time the object was last modified, in milliseconds since the Unix epoch."""
+    content_type: builtins.str
+    """the MIME type of the object's content, if known."""
+    is_dir: builtins.bool
+    """true if this is a "directory" of objects returned by a List with a delimiter."""
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+        size: builtins.int = ...,
+        mod_time: builtins.int = ...,
+        content_type: builtins.str = ...,
+        is_dir: builtins.bool = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["content_type", b"content_type", "is_dir", b"is_dir", "key", b"key", "mod_time", b"mod_time", "size", b"size"]) -> None: ...
+
+global___BackendObject = BackendObject
+
+@typing_extensions.final
+class BackendAttributesRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """the key of the object."""
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["key", b"key"]) -> None: ...
+
+global___BackendAttributesRequest = BackendAttributesRequest
+
+@typing_extensions.final
+class BackendListRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    PREFIX_FIELD_NUMBER: builtins.int
+    DELIMITER_FIELD_NUMBER: builtins.int
+    PAGE_SIZE_FIELD_NUMBER: builtins.int
+    PAGE_TOKEN_FIELD_NUMBER: builtins.int
+    prefix: builtins.str
+    """only objects whose keys start with this prefix are listed."""
+    delimiter: builtins.str
+    """if set, keys that contain the delimiter after the prefix are grouped into a single "directory" object whose key
+    is the prefix of the key up to and including the delimiter.
+    """
+    page_size: builtins.int
+    """the maximum number of objects to return; the plugin chooses a page size if this is 0."""
+    page_token: builtins.str
+    """the token returned by the previous call to List, if any."""
+    def __init__(
+        self,
+        *,
+        prefix: builtins.str = ...,
+        delimiter: builtins.str = ...,
+        page_size: builtins.int = ...,
+        page_token: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["delimiter", b"delimiter", "page_size", b"page_size", "page_token", b"page_token", "prefix", b"prefix"]) -> None: ...
+
+global___BackendListRequest = BackendListRequest
+
+@typing_extensions.final
+class BackendListResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    OBJECTS_FIELD_NUMBER: builtins.int
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: builtins.int
+    @property
+    def objects(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___BackendObject]:
+        """the objects in this page."""
+    next_page_token: builtins.str
+    """the token for the next page, or empty if this is the last page."""
+    def __init__(
+        self,
+        *,
+        objects: collections.abc.Iterable[global___BackendObject] | None = ...,
+        next_page_token: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["next_page_token", b"next_page_token", "objects", b"objects"]) -> None: ...
+
+global___BackendListResponse = BackendListResponse
+
+@typing_extensions.final
+class BackendReadRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """the key of the object."""
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["key", b"key"]) -> None: ...
+
+global___BackendReadRequest = BackendReadRequest
+
+@typing_extensions.final
+class BackendReadResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    CONTENT_FIELD_NUMBER: builtins.int
+    CONTENT_TYPE_FIELD_NUMBER: builtins.int
+    content: builtins.bytes
+    """the content of the object."""
+    content_type: builtins.str
+    """the MIME type of the content, if known."""
+    def __init__(
+        self,
+        *,
+        content: builtins.bytes = ...,
+        content_type: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["content", b"content", "content_type", b"content_type"]) -> None: ...
+
+global___BackendReadResponse = BackendReadResponse
+
+@typing_extensions.final
+class BackendWriteRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    CONTENT_FIELD_NUMBER: builtins.int
+    CONTENT_TYPE_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """the key of the object."""
+    content: builtins.bytes
+    """the content of the object."""
+    content_type: builtins.str
+    """the MIME type of the content, if known."""
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+        content: builtins.bytes = ...,
+        content_type: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["content", b"content", "content_type", b"content_type", "key", b"key"]) -> None: ...
+
+global___BackendWriteRequest = BackendWriteRequest
+
+@typing_extensions.final
+class BackendCopyRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    DST_KEY_FIELD_NUMBER: builtins.int
+    SRC_KEY_FIELD_NUMBER: builtins.int
+    dst_key: builtins.str
+    """the key to copy the object to."""
+    src_key: builtins.str
+    """the key of the object to copy."""
+    def __init__(
+        self,
+        *,
+        dst_key: builtins.str = ...,
+        src_key: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["dst_key", b"dst_key", "src_key", b"src_key"]) -> None: ...
+
+global___BackendCopyRequest = BackendCopyRequest
+
+@typing_extensions.final
+class BackendDeleteRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """the key of the object."""
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["key", b"key"]) -> None: ...
+
+global___BackendDeleteRequest = BackendDeleteRequest
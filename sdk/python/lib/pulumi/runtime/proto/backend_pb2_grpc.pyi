@@ -0,0 +1,132 @@
+"""
+@generated by mypy-protobuf.  Do not edit manually!
+isort:skip_file
+Copyright 2016-2024, Pulumi Corporation.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+"""
+import abc
+import google.protobuf.empty_pb2
+import grpc
+import grpc.aio
+import typing
+import pulumi.backend_pb2
+
+class BackendStub:
+    """Backend is a service for storing the state of self-managed stacks somewhere other than the object stores that
+    Pulumi supports natively. A backend plugin is discovered by the scheme of the URL passed to `pulumi login`: logging
+    into `<scheme>://...` loads the `pulumi-backend-<scheme>` plugin.
+
+    The plugin stores the files that make up a self-managed backend (stack checkpoints, history, locks and so on) as
+    opaque objects, identified by slash-separated keys. Missing objects must be reported with a NOT_FOUND status.
+    This is currently unstable and experimental.
+    """
+
+    def __init__(self, channel: grpc.Channel) -> None: ...
+    Configure: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendConfigureRequest,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """Configure passes the URL that was logged into to the plugin. It is called once, before any other method."""
+    Attributes: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendAttributesRequest,
+        pulumi.backend_pb2.BackendObject,
+    ]
+    """Attributes returns the attributes of a single object."""
+    List: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendListRequest,
+        pulumi.backend_pb2.BackendListResponse,
+    ]
+    """List returns a page of the objects whose keys start with a prefix, ordered by key."""
+    Read: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendReadRequest,
+        pulumi.backend_pb2.BackendReadResponse,
+    ]
+    """Read returns the content of an object."""
+    Write: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendWriteRequest,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """Write creates an object, or replaces an existing one."""
+    Copy: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendCopyRequest,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """Copy copies an object to another key, replacing any object already stored there."""
+    Delete: grpc.UnaryUnaryMultiCallable[
+        pulumi.backend_pb2.BackendDeleteRequest,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """Delete deletes an object."""
+
+class BackendServicer(metaclass=abc.ABCMeta):
+    """Backend is a service for storing the state of self-managed stacks somewhere other than the object stores that
+    Pulumi supports natively. A backend plugin is discovered by the scheme of the URL passed to `pulumi login`: logging
+    into `<scheme>://...` loads the `pulumi-backend-<scheme>` plugin.
+
+    The plugin stores the files that make up a self-managed backend (stack checkpoints, history, locks and so on) as
+    opaque objects, identified by slash-separated keys. Missing objects must be reported with a NOT_FOUND status.
+    This is currently unstable and experimental.
+    """
+
+    
+    def Configure(
+        self,
+        request: pulumi.backend_pb2.BackendConfigureRequest,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """Configure passes the URL that was logged into to the plugin. It is called once, before any other method."""
+    
+    def Attributes(
+        self,
+        request: pulumi.backend_pb2.BackendAttributesRequest,
+        context: grpc.ServicerContext,
+    ) -> pulumi.backend_pb2.BackendObject:
+        """Attributes returns the attributes of a single object."""
+    
+    def List(
+        self,
+        request: pulumi.backend_pb2.BackendListRequest,
+        context: grpc.ServicerContext,
+    ) -> pulumi.backend_pb2.BackendListResponse:
+        """List returns a page of the objects whose keys start with a prefix, ordered by key."""
+    
+    def Read(
+        self,
+        request: pulumi.backend_pb2.BackendReadRequest,
+        context: grpc.ServicerContext,
+    ) -> pulumi.backend_pb2.BackendReadResponse:
+        """Read returns the content of an object."""
+    
+    def Write(
+        self,
+        request: pulumi.backend_pb2.BackendWriteRequest,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """Write creates an object, or replaces an existing one."""
+    
+    def Copy(
+        self,
+        request: pulumi.backend_pb2.BackendCopyRequest,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """Copy copies an object to another key, replacing any object already stored there."""
+    
+    def Delete(
+        self,
+        request: pulumi.backend_pb2.BackendDeleteRequest,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """Delete deletes an object."""
+
+def add_BackendServicer_to_server(servicer: BackendServicer, server: typing.Union[grpc.Server, grpc.aio.Server]) -> None: ...
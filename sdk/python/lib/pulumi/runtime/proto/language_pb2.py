@@ -17,7 +17,7 @@ from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15pulumi/language.proto\x12\tpulumirpc\x1a\x18pulumi/codegen/hcl.proto\x1a\x13pulumi/plugin.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x7f\n\x0bProgramInfo\x12\x16\n\x0eroot_directory\x18\x01 \x01(\t\x12\x19\n\x11program_directory\x18\x02 \x01(\t\x12\x13\n\x0b\x65ntry_point\x18\x03 \x01(\t\x12(\n\x07options\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\"\x9f\x01\n\rAboutResponse\x12\x12\n\nexecutable\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x38\n\x08metadata\x18\x03 \x03(\x0b\x32&.pulumirpc.AboutResponse.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa0\x01\n\x1dGetProgramDependenciesRequest\x12\x13\n\x07project\x18\x01 \x01(\tB\x02\x18\x01\x12\x0f\n\x03pwd\x18\x02 \x01(\tB\x02\x18\x01\x12\x13\n\x07program\x18\x03 \x01(\tB\x02\x18\x01\x12\x1e\n\x16transitiveDependencies\x18\x04 \x01(\x08\x12$\n\x04info\x18\x05 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"/\n\x0e\x44\x65pendencyInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"Q\n\x1eGetProgramDependenciesResponse\x12/\n\x0c\x64\x65pendencies\x18\x01 \x03(\x0b\x32\x19.pulumirpc.DependencyInfo\"|\n\x19GetRequiredPluginsRequest\x12\x13\n\x07project\x18\x01 \x01(\tB\x02\x18\x01\x12\x0f\n\x03pwd\x18\x02 \x01(\tB\x02\x18\x01\x12\x13\n\x07program\x18\x03 \x01(\tB\x02\x18\x01\x12$\n\x04info\x18\x04 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"J\n\x1aGetRequiredPluginsResponse\x12,\n\x07plugins\x18\x01 \x03(\x0b\x32\x1b.pulumirpc.PluginDependency\"\x96\x03\n\nRunRequest\x12\x0f\n\x07project\x18\x01 \x01(\t\x12\r\n\x05stack\x18\x02 \x01(\t\x12\x0b\n\x03pwd\x18\x03 \x01(\t\x12\x13\n\x07program\x18\x04 \x01(\tB\x02\x18\x01\x12\x0c\n\x04\x61rgs\x18\x05 \x03(\t\x12\x31\n\x06\x63onfig\x18\x06 \x03(\x0b\x32!.pulumirpc.RunRequest.ConfigEntry\x12\x0e\n\x06\x64ryRun\x18\x07 \x01(\x08\x12\x10\n\x08parallel\x18\x08 \x01(\x05\x12\x17\n\x0fmonitor_address\x18\t \x01(\t\x12\x11\n\tqueryMode\x18\n \x01(\x08\x12\x18\n\x10\x63onfigSecretKeys\x18\x0b \x03(\t\x12\x14\n\x0corganization\x18\x0c \x01(\t\x12\x32\n\x11\x63onfigPropertyMap\x18\r \x01(\x0b\x32\x17.google.protobuf.Struct\x12$\n\x04info\x18\x0e \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"*\n\x0bRunResponse\x12\r\n\x05\x65rror\x18\x01 \x01(\t\x12\x0c\n\x04\x62\x61il\x18\x02 \x01(\x08\"n\n\x1aInstallDependenciesRequest\x12\x15\n\tdirectory\x18\x01 \x01(\tB\x02\x18\x01\x12\x13\n\x0bis_terminal\x18\x02 \x01(\x08\x12$\n\x04info\x18\x03 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"=\n\x1bInstallDependenciesResponse\x12\x0e\n\x06stdout\x18\x01 \x01(\x0c\x12\x0e\n\x06stderr\x18\x02 \x01(\x0c\"u\n\x10RunPluginRequest\x12\x0b\n\x03pwd\x18\x01 \x01(\t\x12\x13\n\x07program\x18\x02 \x01(\tB\x02\x18\x01\x12\x0c\n\x04\x61rgs\x18\x03 \x03(\t\x12\x0b\n\x03\x65nv\x18\x04 \x03(\t\x12$\n\x04info\x18\x05 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"U\n\x11RunPluginResponse\x12\x10\n\x06stdout\x18\x01 \x01(\x0cH\x00\x12\x10\n\x06stderr\x18\x02 \x01(\x0cH\x00\x12\x12\n\x08\x65xitcode\x18\x03 \x01(\x05H\x00\x42\x08\n\x06output\"\x9d\x01\n\x16GenerateProgramRequest\x12=\n\x06source\x18\x01 \x03(\x0b\x32-.pulumirpc.GenerateProgramRequest.SourceEntry\x12\x15\n\rloader_target\x18\x02 \x01(\t\x1a-\n\x0bSourceEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xbc\x01\n\x17GenerateProgramResponse\x12\x32\n\x0b\x64iagnostics\x18\x01 \x03(\x0b\x32\x1d.pulumirpc.codegen.Diagnostic\x12>\n\x06source\x18\x02 \x03(\x0b\x32..pulumirpc.GenerateProgramResponse.SourceEntry\x1a-\n\x0bSourceEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\"\x94\x02\n\x16GenerateProjectRequest\x12\x18\n\x10source_directory\x18\x01 \x01(\t\x12\x18\n\x10target_directory\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12\x0e\n\x06strict\x18\x04 \x01(\x08\x12\x15\n\rloader_target\x18\x05 \x01(\t\x12T\n\x12local_dependencies\x18\x06 \x03(\x0b\x32\x38.pulumirpc.GenerateProjectRequest.LocalDependenciesEntry\x1a\x38\n\x16LocalDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"M\n\x17GenerateProjectResponse\x12\x32\n\x0b\x64iagnostics\x18\x01 \x03(\x0b\x32\x1d.pulumirpc.codegen.Diagnostic\"\xcd\x01\n\x16GeneratePackageRequest\x12\x11\n\tdirectory\x18\x01 \x01(\t\x12\x0e\n\x06schema\x18\x02 \x01(\t\x12\x46\n\x0b\x65xtra_files\x18\x03 \x03(\x0b\x32\x31.pulumirpc.GeneratePackageRequest.ExtraFilesEntry\x12\x15\n\rloader_target\x18\x04 \x01(\t\x1a\x31\n\x0f\x45xtraFilesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\"M\n\x17GeneratePackageResponse\x12\x32\n\x0b\x64iagnostics\x18\x01 \x03(\x0b\x32\x1d.pulumirpc.codegen.Diagnostic\"X\n\x0bPackRequest\x12\x19\n\x11package_directory\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x1d\n\x15\x64\x65stination_directory\x18\x03 \x01(\t\"%\n\x0cPackResponse\x12\x15\n\rartifact_path\x18\x01 \x01(\t2\xa3\x07\n\x0fLanguageRuntime\x12\x63\n\x12GetRequiredPlugins\x12$.pulumirpc.GetRequiredPluginsRequest\x1a%.pulumirpc.GetRequiredPluginsResponse\"\x00\x12\x36\n\x03Run\x12\x15.pulumirpc.RunRequest\x1a\x16.pulumirpc.RunResponse\"\x00\x12@\n\rGetPluginInfo\x12\x16.google.protobuf.Empty\x1a\x15.pulumirpc.PluginInfo\"\x00\x12h\n\x13InstallDependencies\x12%.pulumirpc.InstallDependenciesRequest\x1a&.pulumirpc.InstallDependenciesResponse\"\x00\x30\x01\x12;\n\x05\x41\x62out\x12\x16.google.protobuf.Empty\x1a\x18.pulumirpc.AboutResponse\"\x00\x12o\n\x16GetProgramDependencies\x12(.pulumirpc.GetProgramDependenciesRequest\x1a).pulumirpc.GetProgramDependenciesResponse\"\x00\x12J\n\tRunPlugin\x12\x1b.pulumirpc.RunPluginRequest\x1a\x1c.pulumirpc.RunPluginResponse\"\x00\x30\x01\x12Z\n\x0fGenerateProgram\x12!.pulumirpc.GenerateProgramRequest\x1a\".pulumirpc.GenerateProgramResponse\"\x00\x12Z\n\x0fGenerateProject\x12!.pulumirpc.GenerateProjectRequest\x1a\".pulumirpc.GenerateProjectResponse\"\x00\x12Z\n\x0fGeneratePackage\x12!.pulumirpc.GeneratePackageRequest\x1a\".pulumirpc.GeneratePackageResponse\"\x00\x12\x39\n\x04Pack\x12\x16.pulumirpc.PackRequest\x1a\x17.pulumirpc.PackResponse\"\x00\x42\x34Z2github.com/pulumi/pulumi/sdk/v3/proto/go;pulumirpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15pulumi/language.proto\x12\tpulumirpc\x1a\x18pulumi/codegen/hcl.proto\x1a\x13pulumi/plugin.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x7f\n\x0bProgramInfo\x12\x16\n\x0eroot_directory\x18\x01 \x01(\t\x12\x19\n\x11program_directory\x18\x02 \x01(\t\x12\x13\n\x0b\x65ntry_point\x18\x03 \x01(\t\x12(\n\x07options\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\"\x9f\x01\n\rAboutResponse\x12\x12\n\nexecutable\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x38\n\x08metadata\x18\x03 \x03(\x0b\x32&.pulumirpc.AboutResponse.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa0\x01\n\x1dGetProgramDependenciesRequest\x12\x13\n\x07project\x18\x01 \x01(\tB\x02\x18\x01\x12\x0f\n\x03pwd\x18\x02 \x01(\tB\x02\x18\x01\x12\x13\n\x07program\x18\x03 \x01(\tB\x02\x18\x01\x12\x1e\n\x16transitiveDependencies\x18\x04 \x01(\x08\x12$\n\x04info\x18\x05 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"/\n\x0e\x44\x65pendencyInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"Q\n\x1eGetProgramDependenciesResponse\x12/\n\x0c\x64\x65pendencies\x18\x01 \x03(\x0b\x32\x19.pulumirpc.DependencyInfo\"|\n\x19GetRequiredPluginsRequest\x12\x13\n\x07project\x18\x01 \x01(\tB\x02\x18\x01\x12\x0f\n\x03pwd\x18\x02 \x01(\tB\x02\x18\x01\x12\x13\n\x07program\x18\x03 \x01(\tB\x02\x18\x01\x12$\n\x04info\x18\x04 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"J\n\x1aGetRequiredPluginsResponse\x12,\n\x07plugins\x18\x01 \x03(\x0b\x32\x1b.pulumirpc.PluginDependency\"\x81\x04\n\nRunRequest\x12\x0f\n\x07project\x18\x01 \x01(\t\x12\r\n\x05stack\x18\x02 \x01(\t\x12\x0b\n\x03pwd\x18\x03 \x01(\t\x12\x13\n\x07program\x18\x04 \x01(\tB\x02\x18\x01\x12\x0c\n\x04\x61rgs\x18\x05 \x03(\t\x12\x31\n\x06\x63onfig\x18\x06 \x03(\x0b\x32!.pulumirpc.RunRequest.ConfigEntry\x12\x0e\n\x06\x64ryRun\x18\x07 \x01(\x08\x12\x10\n\x08parallel\x18\x08 \x01(\x05\x12\x17\n\x0fmonitor_address\x18\t \x01(\t\x12\x11\n\tqueryMode\x18\n \x01(\x08\x12\x18\n\x10\x63onfigSecretKeys\x18\x0b \x03(\t\x12\x14\n\x0corganization\x18\x0c \x01(\t\x12\x32\n\x11\x63onfigPropertyMap\x18\r \x01(\x0b\x32\x17.google.protobuf.Struct\x12$\n\x04info\x18\x0e \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\x12\x37\n\tstackTags\x18\x0f \x03(\x0b\x32$.pulumirpc.RunRequest.StackTagsEntry\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x30\n\x0eStackTagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"*\n\x0bRunResponse\x12\r\n\x05\x65rror\x18\x01 \x01(\t\x12\x0c\n\x04\x62\x61il\x18\x02 \x01(\x08\"n\n\x1aInstallDependenciesRequest\x12\x15\n\tdirectory\x18\x01 \x01(\tB\x02\x18\x01\x12\x13\n\x0bis_terminal\x18\x02 \x01(\x08\x12$\n\x04info\x18\x03 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"=\n\x1bInstallDependenciesResponse\x12\x0e\n\x06stdout\x18\x01 \x01(\x0c\x12\x0e\n\x06stderr\x18\x02 \x01(\x0c\"u\n\x10RunPluginRequest\x12\x0b\n\x03pwd\x18\x01 \x01(\t\x12\x13\n\x07program\x18\x02 \x01(\tB\x02\x18\x01\x12\x0c\n\x04\x61rgs\x18\x03 \x03(\t\x12\x0b\n\x03\x65nv\x18\x04 \x03(\t\x12$\n\x04info\x18\x05 \x01(\x0b\x32\x16.pulumirpc.ProgramInfo\"U\n\x11RunPluginResponse\x12\x10\n\x06stdout\x18\x01 \x01(\x0cH\x00\x12\x10\n\x06stderr\x18\x02 \x01(\x0cH\x00\x12\x12\n\x08\x65xitcode\x18\x03 \x01(\x05H\x00\x42\x08\n\x06output\"\x9d\x01\n\x16GenerateProgramRequest\x12=\n\x06source\x18\x01 \x03(\x0b\x32-.pulumirpc.GenerateProgramRequest.SourceEntry\x12\x15\n\rloader_target\x18\x02 \x01(\t\x1a-\n\x0bSourceEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xbc\x01\n\x17GenerateProgramResponse\x12\x32\n\x0b\x64iagnostics\x18\x01 \x03(\x0b\x32\x1d.pulumirpc.codegen.Diagnostic\x12>\n\x06source\x18\x02 \x03(\x0b\x32..pulumirpc.GenerateProgramResponse.SourceEntry\x1a-\n\x0bSourceEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\"\x94\x02\n\x16GenerateProjectRequest\x12\x18\n\x10source_directory\x18\x01 \x01(\t\x12\x18\n\x10target_directory\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12\x0e\n\x06strict\x18\x04 \x01(\x08\x12\x15\n\rloader_target\x18\x05 \x01(\t\x12T\n\x12local_dependencies\x18\x06 \x03(\x0b\x32\x38.pulumirpc.GenerateProjectRequest.LocalDependenciesEntry\x1a\x38\n\x16LocalDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"M\n\x17GenerateProjectResponse\x12\x32\n\x0b\x64iagnostics\x18\x01 \x03(\x0b\x32\x1d.pulumirpc.codegen.Diagnostic\"\xcd\x01\n\x16GeneratePackageRequest\x12\x11\n\tdirectory\x18\x01 \x01(\t\x12\x0e\n\x06schema\x18\x02 \x01(\t\x12\x46\n\x0b\x65xtra_files\x18\x03 \x03(\x0b\x32\x31.pulumirpc.GeneratePackageRequest.ExtraFilesEntry\x12\x15\n\rloader_target\x18\x04 \x01(\t\x1a\x31\n\x0f\x45xtraFilesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\"M\n\x17GeneratePackageResponse\x12\x32\n\x0b\x64iagnostics\x18\x01 \x03(\x0b\x32\x1d.pulumirpc.codegen.Diagnostic\"X\n\x0bPackRequest\x12\x19\n\x11package_directory\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x1d\n\x15\x64\x65stination_directory\x18\x03 \x01(\t\"%\n\x0cPackResponse\x12\x15\n\rartifact_path\x18\x01 \x01(\t2\xa3\x07\n\x0fLanguageRuntime\x12\x63\n\x12GetRequiredPlugins\x12$.pulumirpc.GetRequiredPluginsRequest\x1a%.pulumirpc.GetRequiredPluginsResponse\"\x00\x12\x36\n\x03Run\x12\x15.pulumirpc.RunRequest\x1a\x16.pulumirpc.RunResponse\"\x00\x12@\n\rGetPluginInfo\x12\x16.google.protobuf.Empty\x1a\x15.pulumirpc.PluginInfo\"\x00\x12h\n\x13InstallDependencies\x12%.pulumirpc.InstallDependenciesRequest\x1a&.pulumirpc.InstallDependenciesResponse\"\x00\x30\x01\x12;\n\x05\x41\x62out\x12\x16.google.protobuf.Empty\x1a\x18.pulumirpc.AboutResponse\"\x00\x12o\n\x16GetProgramDependencies\x12(.pulumirpc.GetProgramDependenciesRequest\x1a).pulumirpc.GetProgramDependenciesResponse\"\x00\x12J\n\tRunPlugin\x12\x1b.pulumirpc.RunPluginRequest\x1a\x1c.pulumirpc.RunPluginResponse\"\x00\x30\x01\x12Z\n\x0fGenerateProgram\x12!.pulumirpc.GenerateProgramRequest\x1a\".pulumirpc.GenerateProgramResponse\"\x00\x12Z\n\x0fGenerateProject\x12!.pulumirpc.GenerateProjectRequest\x1a\".pulumirpc.GenerateProjectResponse\"\x00\x12Z\n\x0fGeneratePackage\x12!.pulumirpc.GeneratePackageRequest\x1a\".pulumirpc.GeneratePackageResponse\"\x00\x12\x39\n\x04Pack\x12\x16.pulumirpc.PackRequest\x1a\x17.pulumirpc.PackResponse\"\x00\x42\x34Z2github.com/pulumi/pulumi/sdk/v3/proto/go;pulumirpcb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'pulumi.language_pb2', globals())
@@ -41,6 +41,8 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETREQUIREDPLUGINSREQUEST.fields_by_name['program']._serialized_options = b'\030\001'
   _RUNREQUEST_CONFIGENTRY._options = None
   _RUNREQUEST_CONFIGENTRY._serialized_options = b'8\001'
+  _RUNREQUEST_STACKTAGSENTRY._options = None
+  _RUNREQUEST_STACKTAGSENTRY._serialized_options = b'8\001'
   _RUNREQUEST.fields_by_name['program']._options = None
   _RUNREQUEST.fields_by_name['program']._serialized_options = b'\030\001'
   _INSTALLDEPENDENCIESREQUEST.fields_by_name['directory']._options = None
@@ -72,43 +74,45 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETREQUIREDPLUGINSRESPONSE._serialized_start=854
   _GETREQUIREDPLUGINSRESPONSE._serialized_end=928
   _RUNREQUEST._serialized_start=931
-  _RUNREQUEST._serialized_end=1337
-  _RUNREQUEST_CONFIGENTRY._serialized_start=1292
-  _RUNREQUEST_CONFIGENTRY._serialized_end=1337
-  _RUNRESPONSE._serialized_start=1339
-  _RUNRESPONSE._serialized_end=1381
-  _INSTALLDEPENDENCIESREQUEST._serialized_start=1383
-  _INSTALLDEPENDENCIESREQUEST._serialized_end=1493
-  _INSTALLDEPENDENCIESRESPONSE._serialized_start=1495
-  _INSTALLDEPENDENCIESRESPONSE._serialized_end=1556
-  _RUNPLUGINREQUEST._serialized_start=1558
-  _RUNPLUGINREQUEST._serialized_end=1675
-  _RUNPLUGINRESPONSE._serialized_start=1677
-  _RUNPLUGINRESPONSE._serialized_end=1762
-  _GENERATEPROGRAMREQUEST._serialized_start=1765
-  _GENERATEPROGRAMREQUEST._serialized_end=1922
-  _GENERATEPROGRAMREQUEST_SOURCEENTRY._serialized_start=1877
-  _GENERATEPROGRAMREQUEST_SOURCEENTRY._serialized_end=1922
-  _GENERATEPROGRAMRESPONSE._serialized_start=1925
-  _GENERATEPROGRAMRESPONSE._serialized_end=2113
-  _GENERATEPROGRAMRESPONSE_SOURCEENTRY._serialized_start=2068
-  _GENERATEPROGRAMRESPONSE_SOURCEENTRY._serialized_end=2113
-  _GENERATEPROJECTREQUEST._serialized_start=2116
-  _GENERATEPROJECTREQUEST._serialized_end=2392
-  _GENERATEPROJECTREQUEST_LOCALDEPENDENCIESENTRY._serialized_start=2336
-  _GENERATEPROJECTREQUEST_LOCALDEPENDENCIESENTRY._serialized_end=2392
-  _GENERATEPROJECTRESPONSE._serialized_start=2394
-  _GENERATEPROJECTRESPONSE._serialized_end=2471
-  _GENERATEPACKAGEREQUEST._serialized_start=2474
-  _GENERATEPACKAGEREQUEST._serialized_end=2679
-  _GENERATEPACKAGEREQUEST_EXTRAFILESENTRY._serialized_start=2630
-  _GENERATEPACKAGEREQUEST_EXTRAFILESENTRY._serialized_end=2679
-  _GENERATEPACKAGERESPONSE._serialized_start=2681
-  _GENERATEPACKAGERESPONSE._serialized_end=2758
-  _PACKREQUEST._serialized_start=2760
-  _PACKREQUEST._serialized_end=2848
-  _PACKRESPONSE._serialized_start=2850
-  _PACKRESPONSE._serialized_end=2887
-  _LANGUAGERUNTIME._serialized_start=2890
-  _LANGUAGERUNTIME._serialized_end=3821
+  _RUNREQUEST._serialized_end=1444
+  _RUNREQUEST_CONFIGENTRY._serialized_start=1349
+  _RUNREQUEST_CONFIGENTRY._serialized_end=1394
+  _RUNREQUEST_STACKTAGSENTRY._serialized_start=1396
+  _RUNREQUEST_STACKTAGSENTRY._serialized_end=1444
+  _RUNRESPONSE._serialized_start=1446
+  _RUNRESPONSE._serialized_end=1488
+  _INSTALLDEPENDENCIESREQUEST._serialized_start=1490
+  _INSTALLDEPENDENCIESREQUEST._serialized_end=1600
+  _INSTALLDEPENDENCIESRESPONSE._serialized_start=1602
+  _INSTALLDEPENDENCIESRESPONSE._serialized_end=1663
+  _RUNPLUGINREQUEST._serialized_start=1665
+  _RUNPLUGINREQUEST._serialized_end=1782
+  _RUNPLUGINRESPONSE._serialized_start=1784
+  _RUNPLUGINRESPONSE._serialized_end=1869
+  _GENERATEPROGRAMREQUEST._serialized_start=1872
+  _GENERATEPROGRAMREQUEST._serialized_end=2029
+  _GENERATEPROGRAMREQUEST_SOURCEENTRY._serialized_start=1984
+  _GENERATEPROGRAMREQUEST_SOURCEENTRY._serialized_end=2029
+  _GENERATEPROGRAMRESPONSE._serialized_start=2032
+  _GENERATEPROGRAMRESPONSE._serialized_end=2220
+  _GENERATEPROGRAMRESPONSE_SOURCEENTRY._serialized_start=2175
+  _GENERATEPROGRAMRESPONSE_SOURCEENTRY._serialized_end=2220
+  _GENERATEPROJECTREQUEST._serialized_start=2223
+  _GENERATEPROJECTREQUEST._serialized_end=2499
+  _GENERATEPROJECTREQUEST_LOCALDEPENDENCIESENTRY._serialized_start=2443
+  _GENERATEPROJECTREQUEST_LOCALDEPENDENCIESENTRY._serialized_end=2499
+  _GENERATEPROJECTRESPONSE._serialized_start=2501
+  _GENERATEPROJECTRESPONSE._serialized_end=2578
+  _GENERATEPACKAGEREQUEST._serialized_start=2581
+  _GENERATEPACKAGEREQUEST._serialized_end=2786
+  _GENERATEPACKAGEREQUEST_EXTRAFILESENTRY._serialized_start=2737
+  _GENERATEPACKAGEREQUEST_EXTRAFILESENTRY._serialized_end=2786
+  _GENERATEPACKAGERESPONSE._serialized_start=2788
+  _GENERATEPACKAGERESPONSE._serialized_end=2865
+  _PACKREQUEST._serialized_start=2867
+  _PACKREQUEST._serialized_end=2955
+  _PACKRESPONSE._serialized_start=2957
+  _PACKRESPONSE._serialized_end=2994
+  _LANGUAGERUNTIME._serialized_start=2997
+  _LANGUAGERUNTIME._serialized_end=3928
 # @@protoc_insertion_point(module_scope)
@@ -255,6 +255,22 @@ class RunRequest(google.protobuf.message.Message):
         ) -> None: ...
         def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
 
+    @typing_extensions.final
+    class StackTagsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     PROJECT_FIELD_NUMBER: builtins.int
     STACK_FIELD_NUMBER: builtins.int
     PWD_FIELD_NUMBER: builtins.int
@@ -269,6 +285,7 @@ class RunRequest(google.protobuf.message.Message):
     ORGANIZATION_FIELD_NUMBER: builtins.int
     CONFIGPROPERTYMAP_FIELD_NUMBER: builtins.int
     INFO_FIELD_NUMBER: builtins.int
+    STACKTAGS_FIELD_NUMBER: builtins.int
     project: builtins.str
     """the project name."""
     stack: builtins.str
@@ -302,6 +319,9 @@ class RunRequest(google.protobuf.message.Message):
     @property
     def info(self) -> global___ProgramInfo:
         """the program info to use to execute the program."""
+    @property
+    def stackTags(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """the tags of the stack being deployed into."""
     def __init__(
         self,
         *,
@@ -319,9 +339,10 @@ class RunRequest(google.protobuf.message.Message):
         organization: builtins.str = ...,
         configPropertyMap: google.protobuf.struct_pb2.Struct | None = ...,
         info: global___ProgramInfo | None = ...,
+        stackTags: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["configPropertyMap", b"configPropertyMap", "info", b"info"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "config", b"config", "configPropertyMap", b"configPropertyMap", "configSecretKeys", b"configSecretKeys", "dryRun", b"dryRun", "info", b"info", "monitor_address", b"monitor_address", "organization", b"organization", "parallel", b"parallel", "program", b"program", "project", b"project", "pwd", b"pwd", "queryMode", b"queryMode", "stack", b"stack"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "config", b"config", "configPropertyMap", b"configPropertyMap", "configSecretKeys", b"configSecretKeys", "dryRun", b"dryRun", "info", b"info", "monitor_address", b"monitor_address", "organization", b"organization", "parallel", b"parallel", "program", b"program", "project", b"project", "pwd", b"pwd", "queryMode", b"queryMode", "stack", b"stack", "stackTags", b"stackTags"]) -> None: ...
 
 global___RunRequest = RunRequest
 
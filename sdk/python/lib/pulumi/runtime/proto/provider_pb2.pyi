@@ -1352,3 +1352,95 @@ class GetMappingsResponse(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["providers", b"providers"]) -> None: ...
 
 global___GetMappingsResponse = GetMappingsResponse
+
+@typing_extensions.final
+class DiscoverRequest(google.protobuf.message.Message):
+    """DiscoverRequest asks a provider to enumerate the resources it is able to import."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class FiltersEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    TYPES_FIELD_NUMBER: builtins.int
+    FILTERS_FIELD_NUMBER: builtins.int
+    @property
+    def types(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """the types of resources to discover. If empty, the provider discovers resources of every type it supports."""
+    @property
+    def filters(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """provider-specific filters that the discovered resources must match, for example `tag:env` => `prod`."""
+    def __init__(
+        self,
+        *,
+        types: collections.abc.Iterable[builtins.str] | None = ...,
+        filters: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["filters", b"filters", "types", b"types"]) -> None: ...
+
+global___DiscoverRequest = DiscoverRequest
+
+@typing_extensions.final
+class DiscoveredResource(google.protobuf.message.Message):
+    """DiscoveredResource is a resource that a provider is able to import."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    TYPE_FIELD_NUMBER: builtins.int
+    NAME_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    type: builtins.str
+    """the type token of the resource."""
+    name: builtins.str
+    """a suggested name for the resource, such as its name in the cloud provider."""
+    id: builtins.str
+    """the ID to import the resource with."""
+    def __init__(
+        self,
+        *,
+        type: builtins.str = ...,
+        name: builtins.str = ...,
+        id: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "name", b"name", "type", b"type"]) -> None: ...
+
+global___DiscoveredResource = DiscoveredResource
+
+@typing_extensions.final
+class DiscoverResponse(google.protobuf.message.Message):
+    """DiscoverResponse returns the resources that a provider discovered."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    RESOURCES_FIELD_NUMBER: builtins.int
+    TYPES_FIELD_NUMBER: builtins.int
+    @property
+    def resources(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___DiscoveredResource]:
+        """the discovered resources."""
+    @property
+    def types(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """the types whose resources were all enumerated, so that a resource of one of these types that wasn't discovered
+        doesn't exist. Types that the provider could only partly enumerate, or not at all, are left out.
+        """
+    def __init__(
+        self,
+        *,
+        resources: collections.abc.Iterable[global___DiscoveredResource] | None = ...,
+        types: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["resources", b"resources", "types", b"types"]) -> None: ...
+
+global___DiscoverResponse = DiscoverResponse
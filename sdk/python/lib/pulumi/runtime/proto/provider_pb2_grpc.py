@@ -113,6 +113,11 @@ class ResourceProviderStub(object):
                 request_serializer=pulumi_dot_provider__pb2.GetMappingsRequest.SerializeToString,
                 response_deserializer=pulumi_dot_provider__pb2.GetMappingsResponse.FromString,
                 )
+        self.Discover = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/Discover',
+                request_serializer=pulumi_dot_provider__pb2.DiscoverRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.DiscoverResponse.FromString,
+                )
 
 
 class ResourceProviderServicer(object):
@@ -267,6 +272,14 @@ class ResourceProviderServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Discover(self, request, context):
+        """Discover is an optional method that enumerates the resources a provider is able to import, such as all the
+        resources in an account with a given tag. A provider that does not support discovery should return UNIMPLEMENTED.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ResourceProviderServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -365,6 +378,11 @@ def add_ResourceProviderServicer_to_server(servicer, server):
                     request_deserializer=pulumi_dot_provider__pb2.GetMappingsRequest.FromString,
                     response_serializer=pulumi_dot_provider__pb2.GetMappingsResponse.SerializeToString,
             ),
+            'Discover': grpc.unary_unary_rpc_method_handler(
+                    servicer.Discover,
+                    request_deserializer=pulumi_dot_provider__pb2.DiscoverRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.DiscoverResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'pulumirpc.ResourceProvider', rpc_method_handlers)
@@ -699,3 +717,20 @@ class ResourceProvider(object):
             pulumi_dot_provider__pb2.GetMappingsResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Discover(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/Discover',
+            pulumi_dot_provider__pb2.DiscoverRequest.SerializeToString,
+            pulumi_dot_provider__pb2.DiscoverResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -146,6 +146,13 @@ class ResourceProviderStub:
     implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
     If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
     """
+    Discover: grpc.UnaryUnaryMultiCallable[
+        pulumi.provider_pb2.DiscoverRequest,
+        pulumi.provider_pb2.DiscoverResponse,
+    ]
+    """Discover is an optional method that enumerates the resources a provider is able to import, such as all the
+    resources in an account with a given tag. A provider that does not support discovery should return UNIMPLEMENTED.
+    """
 
 class ResourceProviderServicer(metaclass=abc.ABCMeta):
     """ResourceProvider is a service that understands how to create, read, update, or delete resources for types defined
@@ -306,5 +313,14 @@ class ResourceProviderServicer(metaclass=abc.ABCMeta):
         implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
         If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
         """
+    
+    def Discover(
+        self,
+        request: pulumi.provider_pb2.DiscoverRequest,
+        context: grpc.ServicerContext,
+    ) -> pulumi.provider_pb2.DiscoverResponse:
+        """Discover is an optional method that enumerates the resources a provider is able to import, such as all the
+        resources in an account with a given tag. A provider that does not support discovery should return UNIMPLEMENTED.
+        """
 
 def add_ResourceProviderServicer_to_server(servicer: ResourceProviderServicer, server: typing.Union[grpc.Server, grpc.aio.Server]) -> None: ...
@@ -238,6 +238,35 @@ class RegisterResourceRequest(google.protobuf.message.Message):
         ) -> None: ...
         def ClearField(self, field_name: typing_extensions.Literal["create", b"create", "delete", b"delete", "update", b"update"]) -> None: ...
 
+    @typing_extensions.final
+    class RetryPolicy(google.protobuf.message.Message):
+        """RetryPolicy allows a user to have the engine retry a resource's CRUD operations when they fail."""
+
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        MAXRETRIES_FIELD_NUMBER: builtins.int
+        INITIALDELAY_FIELD_NUMBER: builtins.int
+        MAXDELAY_FIELD_NUMBER: builtins.int
+        RETRYON_FIELD_NUMBER: builtins.int
+        maxRetries: builtins.int
+        """The maximum number of times a failed operation is retried."""
+        initialDelay: builtins.str
+        """The delay before the first retry represented as a string e.g. 5s."""
+        maxDelay: builtins.str
+        """The upper bound for the exponentially increasing delay represented as a string e.g. 1m."""
+        @property
+        def retryOn(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+            """Regular expressions matching the errors to retry; if empty, every failure is retried."""
+        def __init__(
+            self,
+            *,
+            maxRetries: builtins.int = ...,
+            initialDelay: builtins.str = ...,
+            maxDelay: builtins.str = ...,
+            retryOn: collections.abc.Iterable[builtins.str] | None = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["initialDelay", b"initialDelay", "maxDelay", b"maxDelay", "maxRetries", b"maxRetries", "retryOn", b"retryOn"]) -> None: ...
+
     @typing_extensions.final
     class PropertyDependenciesEntry(google.protobuf.message.Message):
         DESCRIPTOR: google.protobuf.descriptor.Descriptor
@@ -318,6 +347,8 @@ class RegisterResourceRequest(google.protobuf.message.Message):
     DELETEDWITH_FIELD_NUMBER: builtins.int
     ALIASSPECS_FIELD_NUMBER: builtins.int
     SOURCEPOSITION_FIELD_NUMBER: builtins.int
+    RETRYPOLICY_FIELD_NUMBER: builtins.int
+    FROZEN_FIELD_NUMBER: builtins.int
     type: builtins.str
     """the type of the object allocated."""
     name: builtins.str
@@ -397,6 +428,11 @@ class RegisterResourceRequest(google.protobuf.message.Message):
     @property
     def sourcePosition(self) -> pulumi.source_pb2.SourcePosition:
         """the optional source position of the user code that initiated the register."""
+    @property
+    def retryPolicy(self) -> global___RegisterResourceRequest.RetryPolicy:
+        """an optional policy for retrying failed CRUD operations."""
+    frozen: builtins.bool
+    """if true the engine will refuse to update, replace or delete the resource."""
     def __init__(
         self,
         *,
@@ -430,9 +466,11 @@ class RegisterResourceRequest(google.protobuf.message.Message):
         deletedWith: builtins.str = ...,
         aliasSpecs: builtins.bool = ...,
         sourcePosition: pulumi.source_pb2.SourcePosition | None = ...,
+        retryPolicy: global___RegisterResourceRequest.RetryPolicy | None = ...,
+        frozen: builtins.bool = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["customTimeouts", b"customTimeouts", "object", b"object", "sourcePosition", b"sourcePosition"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["acceptResources", b"acceptResources", "acceptSecrets", b"acceptSecrets", "additionalSecretOutputs", b"additionalSecretOutputs", "aliasSpecs", b"aliasSpecs", "aliasURNs", b"aliasURNs", "aliases", b"aliases", "custom", b"custom", "customTimeouts", b"customTimeouts", "deleteBeforeReplace", b"deleteBeforeReplace", "deleteBeforeReplaceDefined", b"deleteBeforeReplaceDefined", "deletedWith", b"deletedWith", "dependencies", b"dependencies", "ignoreChanges", b"ignoreChanges", "importId", b"importId", "name", b"name", "object", b"object", "parent", b"parent", "pluginChecksums", b"pluginChecksums", "pluginDownloadURL", b"pluginDownloadURL", "propertyDependencies", b"propertyDependencies", "protect", b"protect", "provider", b"provider", "providers", b"providers", "remote", b"remote", "replaceOnChanges", b"replaceOnChanges", "retainOnDelete", b"retainOnDelete", "sourcePosition", b"sourcePosition", "supportsPartialValues", b"supportsPartialValues", "type", b"type", "version", b"version"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["customTimeouts", b"customTimeouts", "object", b"object", "retryPolicy", b"retryPolicy", "sourcePosition", b"sourcePosition"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["acceptResources", b"acceptResources", "acceptSecrets", b"acceptSecrets", "additionalSecretOutputs", b"additionalSecretOutputs", "aliasSpecs", b"aliasSpecs", "aliasURNs", b"aliasURNs", "aliases", b"aliases", "custom", b"custom", "customTimeouts", b"customTimeouts", "deleteBeforeReplace", b"deleteBeforeReplace", "deleteBeforeReplaceDefined", b"deleteBeforeReplaceDefined", "deletedWith", b"deletedWith", "dependencies", b"dependencies", "frozen", b"frozen", "ignoreChanges", b"ignoreChanges", "importId", b"importId", "name", b"name", "object", b"object", "parent", b"parent", "pluginChecksums", b"pluginChecksums", "pluginDownloadURL", b"pluginDownloadURL", "propertyDependencies", b"propertyDependencies", "protect", b"protect", "provider", b"provider", "providers", b"providers", "remote", b"remote", "replaceOnChanges", b"replaceOnChanges", "retainOnDelete", b"retainOnDelete", "retryPolicy", b"retryPolicy", "sourcePosition", b"sourcePosition", "supportsPartialValues", b"supportsPartialValues", "type", b"type", "version", b"version"]) -> None: ...
 
 global___RegisterResourceRequest = RegisterResourceRequest
 
@@ -521,21 +559,64 @@ class RegisterResourceOutputsRequest(google.protobuf.message.Message):
 
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    @typing_extensions.final
+    class OutputMetadata(google.protobuf.message.Message):
+        """OutputMetadata carries documentation and type information for a single output property."""
+
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        DESCRIPTION_FIELD_NUMBER: builtins.int
+        SCHEMA_FIELD_NUMBER: builtins.int
+        description: builtins.str
+        """an optional human-readable description of the output."""
+        schema: builtins.str
+        """an optional JSON schema document describing the output's type."""
+        def __init__(
+            self,
+            *,
+            description: builtins.str = ...,
+            schema: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["description", b"description", "schema", b"schema"]) -> None: ...
+
+    @typing_extensions.final
+    class OutputMetadataEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        @property
+        def value(self) -> global___RegisterResourceOutputsRequest.OutputMetadata: ...
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: global___RegisterResourceOutputsRequest.OutputMetadata | None = ...,
+        ) -> None: ...
+        def HasField(self, field_name: typing_extensions.Literal["value", b"value"]) -> builtins.bool: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     URN_FIELD_NUMBER: builtins.int
     OUTPUTS_FIELD_NUMBER: builtins.int
+    OUTPUTMETADATA_FIELD_NUMBER: builtins.int
     urn: builtins.str
     """the URN for the resource to attach output properties to."""
     @property
     def outputs(self) -> google.protobuf.struct_pb2.Struct:
         """additional output properties to add to the existing resource."""
+    @property
+    def outputMetadata(self) -> google.protobuf.internal.containers.MessageMap[builtins.str, global___RegisterResourceOutputsRequest.OutputMetadata]:
+        """optional metadata for the output properties, keyed by name."""
     def __init__(
         self,
         *,
         urn: builtins.str = ...,
         outputs: google.protobuf.struct_pb2.Struct | None = ...,
+        outputMetadata: collections.abc.Mapping[builtins.str, global___RegisterResourceOutputsRequest.OutputMetadata] | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["outputs", b"outputs"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["outputs", b"outputs", "urn", b"urn"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["outputMetadata", b"outputMetadata", "outputs", b"outputs", "urn", b"urn"]) -> None: ...
 
 global___RegisterResourceOutputsRequest = RegisterResourceOutputsRequest
 